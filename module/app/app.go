@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -18,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -70,6 +72,11 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
+	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	ibctransfer "github.com/cosmos/ibc-go/v3/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
@@ -82,9 +89,15 @@ import (
 	"github.com/gorilla/mux"
 	gravityparams "github.com/peggyjv/gravity-bridge/module/v3/app/params"
 	v2 "github.com/peggyjv/gravity-bridge/module/v3/app/upgrades/v2"
+	v3 "github.com/peggyjv/gravity-bridge/module/v3/app/upgrades/v3"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity"
 	gravityclient "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/client"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/ibccallback"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/ibcmiddleware"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/icq"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	gravitysnapshot "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/snapshot"
+	gravitystreaming "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/streaming"
 	gravitytypes "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
@@ -128,6 +141,14 @@ var (
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
 			gravityclient.ProposalHandler,
+			gravityclient.AddEVMChainProposalHandler,
+			gravityclient.ContractMigrationProposalHandler,
+			gravityclient.EVMChainPauseProposalHandler,
+			gravityclient.GravityIDRotationProposalHandler,
+			gravityclient.UpdateParamsProposalHandler,
+			gravityclient.RelayerIncentiveProposalHandler,
+			gravityclient.EthereumEventRejectionProposalHandler,
+			gravityclient.IncidentRecoveryProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -136,6 +157,9 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		ibctransfer.AppModuleBasic{},
+		icaAppModuleBasic{},
+		icq.AppModuleBasic{},
+		ibccallback.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		gravity.AppModuleBasic{},
 	)
@@ -150,6 +174,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:            nil,
 		gravitytypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 	}
 
@@ -204,11 +229,18 @@ type Gravity struct {
 	ibcKeeper        *ibckeeper.Keeper
 	evidenceKeeper   evidencekeeper.Keeper
 	transferKeeper   ibctransferkeeper.Keeper
+	icaHostKeeper    icahostkeeper.Keeper
 	gravityKeeper    keeper.Keeper
 
+	// streams the changes to the bridge state when configured in app.toml
+	gravityStreamer *gravitystreaming.StreamingService
+
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper
+	ScopedICQHostKeeper  capabilitykeeper.ScopedKeeper
+	ScopedCallbackKeeper capabilitykeeper.ScopedKeeper
 
 	// Module Manager
 	mm *module.Manager
@@ -256,9 +288,9 @@ func NewGravityApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		gravitytypes.StoreKey,
+		icahosttypes.StoreKey, gravitytypes.StoreKey,
 	)
-	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tKeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, gravitytypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	var app = &Gravity{
@@ -282,6 +314,9 @@ func NewGravityApp(
 	)
 	scopedIBCKeeper := app.capabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.capabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedICAHostKeeper := app.capabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedICQHostKeeper := app.capabilityKeeper.ScopeToModule(icq.ModuleName)
+	scopedCallbackKeeper := app.capabilityKeeper.ScopeToModule(ibccallback.ModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should
 	// call `Seal` after creating their scoped modules in the app via
@@ -360,6 +395,7 @@ func NewGravityApp(
 			app.distrKeeper.Hooks(),
 			app.slashingKeeper.Hooks(),
 			//app.gravityKeeper.Hooks(), TODO(bolten): this hook is broken, do not set it, to be fixed
+			app.gravityKeeper.VoterPowerHooks(),
 		),
 	)
 
@@ -374,15 +410,26 @@ func NewGravityApp(
 
 	app.transferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.ibcKeeper.ChannelKeeper, app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
+		ibcmiddleware.NewICS4Wrapper(app.ibcKeeper.ChannelKeeper, &app.gravityKeeper),
+		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
 		app.accountKeeper, app.bankKeeper, scopedTransferKeeper,
 	)
 	transferModule := ibctransfer.NewAppModule(app.transferKeeper)
 	transferIBCModule := ibctransfer.NewIBCModule(app.transferKeeper)
 
-	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
-	app.ibcKeeper.SetRouter(ibcRouter)
+	app.icaHostKeeper = icahostkeeper.NewKeeper(
+		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
+		app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
+		app.accountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+	)
+	icaModule := ica.NewAppModule(nil, &app.icaHostKeeper)
+	icaHostIBCModule := icahost.NewIBCModule(app.icaHostKeeper)
+
+	icqHost := icq.NewHost(scopedICQHostKeeper, &app.ibcKeeper.PortKeeper, app.GRPCQueryRouter())
+	icqModule := icq.NewAppModule(icqHost)
+
+	callbackSender := ibccallback.NewSender(scopedCallbackKeeper, &app.ibcKeeper.PortKeeper, app.ibcKeeper.ChannelKeeper)
+	callbackModule := ibccallback.NewAppModule(callbackSender)
 
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec,
@@ -395,20 +442,30 @@ func NewGravityApp(
 	app.gravityKeeper = keeper.NewKeeper(
 		appCodec,
 		keys[gravitytypes.StoreKey],
+		tKeys[gravitytypes.TStoreKey],
 		app.GetSubspace(gravitytypes.ModuleName),
 		app.accountKeeper,
 		stakingKeeper,
 		app.bankKeeper,
 		app.slashingKeeper,
 		app.distrKeeper,
+		app.transferKeeper,
 		sdk.DefaultPowerReduction,
 		app.ModuleAccountAddressesToNames([]string{}),
 		app.ModuleAccountAddressesToNames([]string{distrtypes.ModuleName}),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	ibcRouter := ibcporttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ibcmiddleware.NewIBCMiddleware(transferIBCModule, app.gravityKeeper, callbackSender))
+	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
+	ibcRouter.AddRoute(icq.PortID, icq.NewIBCModule(icqHost))
+	ibcRouter.AddRoute(ibccallback.PortID, ibccallback.NewIBCModule(callbackSender))
+	app.ibcKeeper.SetRouter(ibcRouter)
+
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(paramsproposal.RouterKey, newParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
@@ -492,8 +549,13 @@ func NewGravityApp(
 		ibc.NewAppModule(app.ibcKeeper),
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
+		icaModule,
+		icqModule,
+		callbackModule,
 		gravity.NewAppModule(
+			appCodec,
 			app.gravityKeeper,
+			app.accountKeeper,
 			app.bankKeeper,
 		),
 	)
@@ -512,6 +574,9 @@ func NewGravityApp(
 		crisistypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
+		icatypes.ModuleName,
+		icq.ModuleName,
+		ibccallback.ModuleName,
 		genutiltypes.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
@@ -523,6 +588,9 @@ func NewGravityApp(
 		stakingtypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
+		icatypes.ModuleName,
+		icq.ModuleName,
+		ibccallback.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -545,7 +613,6 @@ func NewGravityApp(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		ibchost.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -553,7 +620,12 @@ func NewGravityApp(
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
+		icq.ModuleName,
+		ibccallback.ModuleName,
 		gravitytypes.ModuleName,
+		// crisis asserts the invariants against the state of all the modules
+		crisistypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -576,6 +648,7 @@ func NewGravityApp(
 		evidence.NewAppModule(app.evidenceKeeper),
 		ibc.NewAppModule(app.ibcKeeper),
 		transferModule,
+		gravity.NewAppModule(appCodec, app.gravityKeeper, app.accountKeeper, app.bankKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -584,6 +657,26 @@ func NewGravityApp(
 	app.MountTransientStores(tKeys)
 	app.MountMemoryStores(memKeys)
 
+	// stream the bridge state in state sync snapshots, checked against the restored store
+	if manager := app.SnapshotManager(); manager != nil {
+		if err := manager.RegisterExtensions(gravitysnapshot.NewSnapshotter(app.CommitMultiStore(), keys[gravitytypes.StoreKey])); err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %s", err))
+		}
+	}
+
+	// stream the changes to the bridge state to the message bus configured in app.toml
+	streamer, err := gravitystreaming.NewStreamingServiceFromOptions(appOpts, homePath, keys[gravitytypes.StoreKey], logger)
+	if err != nil {
+		panic(fmt.Errorf("failed to create gravity streaming service: %s", err))
+	}
+	if streamer != nil {
+		app.SetStreamingService(streamer)
+		if err := streamer.Stream(new(sync.WaitGroup)); err != nil {
+			panic(fmt.Errorf("failed to start gravity streaming service: %s", err))
+		}
+		app.gravityStreamer = streamer
+	}
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
 			AccountKeeper:   app.accountKeeper,
@@ -610,6 +703,9 @@ func NewGravityApp(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedICAHostKeeper = scopedICAHostKeeper
+	app.ScopedICQHostKeeper = scopedICQHostKeeper
+	app.ScopedCallbackKeeper = scopedCallbackKeeper
 
 	return app
 }
@@ -625,6 +721,18 @@ func MakeCodecs() (codec.Codec, *codec.LegacyAmino) {
 // Name returns the name of the App
 func (app *Gravity) Name() string { return app.BaseApp.Name() }
 
+// Commit commits the block, then queues its changes to the bridge state for streaming, which
+// only reach the streaming service as the block is committed
+func (app *Gravity) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	if app.gravityStreamer != nil {
+		if err := app.gravityStreamer.Commit(); err != nil {
+			app.Logger().Error("failed to queue block for streaming", "height", app.LastBlockHeight(), "error", err)
+		}
+	}
+	return res
+}
+
 // BeginBlocker application updates every begin block
 func (app *Gravity) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -800,6 +908,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(gravitytypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 
 	return paramsKeeper
 }
@@ -819,16 +928,25 @@ func VerifyAddressFormat(bz []byte) error {
 }
 
 func (app *Gravity) setupUpgradeStoreLoaders() {
-	_, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Sprintf("failed to read upgrade info from disk %s", err))
 	}
 
+	if app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
 	// if upgradeInfo.Name matches a plan name with a module being added, renamed, or deleted,
 	// create a storetypes.StoreUpgrades struct and
 	// app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	// see also:
 	// https://github.com/cosmos/cosmos-sdk/blob/master/docs/core/upgrade.md#add-storeupgrades-for-new-modules
+	if upgradeInfo.Name == v3.UpgradeName {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{icahosttypes.StoreKey},
+		}))
+	}
 }
 
 func (app *Gravity) setupUpgradeHandlers() {
@@ -840,4 +958,14 @@ func (app *Gravity) setupUpgradeHandlers() {
 			app.bankKeeper,
 		),
 	)
+
+	app.upgradeKeeper.SetUpgradeHandler(
+		v3.UpgradeName,
+		v3.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+			app.mm.Modules[icatypes.ModuleName].(ica.AppModule),
+			&app.gravityKeeper,
+		),
+	)
 }
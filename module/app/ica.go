@@ -0,0 +1,25 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// icaAppModuleBasic defaults the interchain accounts host to allowing the gravity messages
+// of bridge users, so that remote chains can send to and cancel sends to Ethereum
+type icaAppModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the interchain accounts genesis with the gravity messages allowed
+func (icaAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := icatypes.DefaultGenesis()
+	genesis.HostGenesisState.Params = icahosttypes.NewParams(true, gravitytypes.InterchainAccountMsgs())
+	return cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// newParamChangeProposalHandler rejects param changes to the gravity subspace, the gravity
+// params are kept in the gravity store and updated with MsgUpdateParams or an
+// UpdateParamsProposal, so changes to the subspace would have no effect
+func newParamChangeProposalHandler(k paramskeeper.Keeper) govtypes.Handler {
+	handler := params.NewParamChangeProposalHandler(k)
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if c, ok := content.(*paramsproposal.ParameterChangeProposal); ok {
+			for _, change := range c.Changes {
+				if change.Subspace == gravitytypes.ModuleName {
+					return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "%s params are updated with an update params proposal", gravitytypes.ModuleName)
+				}
+			}
+		}
+		return handler(ctx, content)
+	}
+}
@@ -17,6 +17,12 @@ const (
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
 
+	DefaultWeightMsgSendToEthereum               int = 100
+	DefaultWeightMsgCancelSendToEthereum         int = 20
+	DefaultWeightMsgSubmitEthereumTxConfirmation int = 100
+	DefaultWeightMsgSubmitEthereumEvent          int = 100
+	DefaultWeightMsgDelegateKeys                 int = 10
+
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
 	DefaultWeightParamChangeProposal    int = 5
@@ -14,13 +14,14 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: audit this code when we hook up simulations
-
 // AppStateFn returns the initial application state using a genesis or the simulation parameters.
 // It panics if the user provides files for both of them.
 // If a file is not given for the genesis or the sim params, it creates a randomized one.
@@ -70,8 +71,56 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams)
 		}
 
-		return appState, simAccs, chainID, genesisTimestamp
+		return fundNotBondedPool(cdc, appState), simAccs, chainID, genesisTimestamp
+	}
+}
+
+// fundNotBondedPool adds the tokens of the unbonded genesis validators to the balance of the not
+// bonded pool, which the randomized staking genesis leaves out of the bank genesis
+func fundNotBondedPool(cdc codec.JSONCodec, appState json.RawMessage) json.RawMessage {
+	rawState := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(appState, &rawState); err != nil {
+		panic(err)
+	}
+
+	stakingStateBz, ok := rawState[stakingtypes.ModuleName]
+	if !ok {
+		panic("staking genesis state is missing")
+	}
+	stakingState := new(stakingtypes.GenesisState)
+	cdc.MustUnmarshalJSON(stakingStateBz, stakingState)
+
+	notBondedTokens := sdk.ZeroInt()
+	for _, val := range stakingState.Validators {
+		if val.Status == stakingtypes.Unbonded {
+			notBondedTokens = notBondedTokens.Add(val.GetTokens())
+		}
+	}
+
+	bankStateBz, ok := rawState[banktypes.ModuleName]
+	if !ok {
+		panic("bank genesis state is missing")
+	}
+	bankState := new(banktypes.GenesisState)
+	cdc.MustUnmarshalJSON(bankStateBz, bankState)
+
+	notBondedPool := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+	for _, balance := range bankState.Balances {
+		if balance.Address == notBondedPool {
+			return appState
+		}
+	}
+	bankState.Balances = append(bankState.Balances, banktypes.Balance{
+		Address: notBondedPool,
+		Coins:   sdk.NewCoins(sdk.NewCoin(stakingState.Params.BondDenom, notBondedTokens)),
+	})
+	rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+
+	appState, err := json.Marshal(rawState)
+	if err != nil {
+		panic(err)
 	}
+	return appState
 }
 
 // AppStateRandomizedFn creates calls each module's GenesisState generator function
@@ -0,0 +1,52 @@
+package upgrades
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// NormalizeGravityDenoms replaces the vouchers of gravity denoms with a wrongly capitalized
+// contract address in all balances with the vouchers of the normalized denom
+func NormalizeGravityDenoms(ctx sdk.Context, bankKeeper bankkeeper.Keeper) {
+	// Make a mapping of all existing, incorrect gravity denoms to their
+	// normalized versions
+	denomsToRepair := make(map[string]string)
+	bankKeeper.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		normalizedDenom := gravitytypes.NormalizeDenom(supply.Denom)
+
+		if normalizedDenom != supply.Denom {
+			denomsToRepair[supply.Denom] = normalizedDenom
+		}
+
+		return false
+	})
+
+	// If any account's balance appears in the list of denoms we have to normalize,
+	// transfer the coins to the gravity module, burn them, mint new coins with the new
+	// denom, and send them back to the account
+	bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if normalizedDenom, ok := denomsToRepair[coin.Denom]; ok {
+			oldCoins := sdk.NewCoins(coin)
+
+			if err := bankKeeper.SendCoinsFromAccountToModule(ctx, addr, gravitytypes.ModuleName, oldCoins); err != nil {
+				panic(err)
+			}
+			if err := bankKeeper.BurnCoins(ctx, gravitytypes.ModuleName, oldCoins); err != nil {
+				panic(err)
+			}
+
+			normalizedCoins := sdk.NewCoins(sdk.NewCoin(normalizedDenom, coin.Amount))
+
+			if err := bankKeeper.MintCoins(ctx, gravitytypes.ModuleName, normalizedCoins); err != nil {
+				panic(err)
+			}
+			if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, gravitytypes.ModuleName, addr, normalizedCoins); err != nil {
+				panic(err)
+			}
+
+		}
+
+		return false
+	})
+}
@@ -0,0 +1,71 @@
+package upgrades
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/gravity-bridge/module/v3/app/upgrades/upgradetest"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeGravityDenoms(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+
+	addr, _ := sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	erc20contract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	amount := sdk.NewInt(1000)
+
+	// mint some tokens
+	incorrectDenom := strings.ToLower(types.GravityDenom(erc20contract))
+	gravityCoins := sdk.NewCoins(sdk.NewCoin(incorrectDenom, amount))
+	err := input.BankKeeper.MintCoins(ctx, types.ModuleName, gravityCoins)
+	require.NoError(t, err)
+
+	// set account's balance
+	input.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, gravityCoins))
+	oldBalance := input.BankKeeper.GetAllBalances(ctx, addr)
+	require.Equal(t, oldBalance, gravityCoins)
+
+	NormalizeGravityDenoms(ctx, input.BankKeeper)
+
+	normalizedDenom := types.NormalizeDenom(incorrectDenom)
+	newBalance := input.BankKeeper.GetAllBalances(ctx, addr)
+	require.Equal(t, newBalance, sdk.NewCoins(sdk.NewCoin(normalizedDenom, amount)))
+}
+
+func TestNormalizeGravityDenomsConservesState(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+
+	// balances of vouchers of the same contract under differently capitalized denoms, and of
+	// an already normalized one
+	contracts := []common.Address{
+		common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"),
+		common.HexToAddress(keeper.TokenContractAddrs[0]),
+	}
+	for i, addr := range keeper.AccAddrs[:3] {
+		coins := sdk.NewCoins(
+			sdk.NewInt64Coin(strings.ToLower(types.GravityDenom(contracts[0])), int64(100*(i+1))),
+			sdk.NewInt64Coin(types.GravityDenom(contracts[1]), int64(10*(i+1))),
+		)
+		require.NoError(t, input.AddBalanceToBank(ctx, addr, coins))
+	}
+
+	before, after := upgradetest.Run(t, input, func(ctx sdk.Context) error {
+		NormalizeGravityDenoms(ctx, input.BankKeeper)
+		return nil
+	}, upgradetest.Options{
+		DenomMap: types.NormalizeDenom,
+		// the denoms were normalized in v2, long before the issuance of vouchers was tracked
+		SkipInvariants: []string{"voucher-supply"},
+		// the gravity state is left as is
+		Unchanged: [][]byte{{}},
+	})
+	require.True(t, before.Supply.AmountOf(types.NormalizeDenom(types.GravityDenom(contracts[0]))).IsZero())
+	require.Equal(t, sdk.NewInt(600), after.Supply.AmountOf(types.NormalizeDenom(types.GravityDenom(contracts[0]))))
+}
@@ -0,0 +1,43 @@
+package upgrades
+
+import (
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// WriteTransferHistory writes the completed transfers of all chains kept in state to the file
+// in the format, returning the number of transfers written
+func WriteTransferHistory(ctx sdk.Context, k *keeper.Keeper, path, format string) (int, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	writer, err := gravitytypes.NewTransferHistoryWriter(file, format)
+	if err != nil {
+		return 0, err
+	}
+
+	written := 0
+	for _, chain := range k.GetEVMChains(ctx) {
+		k.IterateTransferHistory(ctx, chain.ChainId, func(record gravitytypes.TransferRecord) bool {
+			if err = writer.Write(record); err != nil {
+				return true
+			}
+			written++
+			return false
+		})
+		if err != nil {
+			return written, err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return written, err
+	}
+	return written, file.Close()
+}
@@ -0,0 +1,136 @@
+// Package upgrades provides the in-place upgrade handler shared by the upgrades of this chain
+// and of the chains embedding x/gravity, configured by Options rather than copied per upgrade.
+package upgrades
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// Reindex rewrites the keys under a prefix of the gravity store, see migrations.ReindexKeys
+type Reindex struct {
+	Prefix []byte
+	Rekey  func(key, value []byte) []byte
+}
+
+// Options configure the steps of an upgrade handler, run in the order of the fields
+type Options struct {
+	// Name of the upgrade, used in logs
+	Name string
+
+	// InitVersionMap builds the version map from the consensus versions of the modules, for
+	// the first in-place upgrade of a chain whose InitChainer didn't store it at genesis.
+	// GravityFromVersion then sets the version the gravity migrations run from.
+	InitVersionMap     bool
+	GravityFromVersion uint64
+
+	// NormalizeGravityDenoms replaces the vouchers of wrongly capitalized gravity denoms in
+	// all balances with those of the normalized denom, it needs the BankKeeper
+	NormalizeGravityDenoms bool
+	BankKeeper             bankkeeper.Keeper
+
+	// TransferHistoryFile, if set, is where the completed transfers of all chains kept in state
+	// are written in the TransferHistoryFormat before the migrations, so that migrations pruning
+	// them keep the history available to analytics. It needs the GravityKeeper. The file isn't
+	// consensus state, failing to write it is logged rather than failing the upgrade.
+	TransferHistoryFile   string
+	TransferHistoryFormat string
+
+	// Reindexes run on the gravity store before the migrations, for key layout changes of
+	// forks the gravity migrations don't cover, they need the GravityStoreKey
+	Reindexes       []Reindex
+	GravityStoreKey storetypes.StoreKey
+
+	// PreMigrations runs before the module migrations, e.g. to initialize added modules
+	PreMigrations func(ctx sdk.Context, vm module.VersionMap) error
+
+	// ReplayPendingEventVotes revalidates the pending event vote records once migrated against
+	// the event validation of the upgraded chain, those failing it being grandfathered or, with
+	// ExpireInvalidEventVotes, expired and their voters rewound to resubmit the events from
+	// their nonce, see keeper.ReplayPendingEventVoteRecords. It needs the GravityKeeper.
+	ReplayPendingEventVotes bool
+	ExpireInvalidEventVotes bool
+
+	// SeedGravityParams updates the gravity params once migrated, e.g. to set params added
+	// by the upgrade to values other than their defaults, it needs the GravityKeeper
+	SeedGravityParams func(params *gravitytypes.Params)
+
+	GravityKeeper *keeper.Keeper
+}
+
+// CreateUpgradeHandler returns the upgrade handler running the steps enabled by the options
+// and the migrations of all modules
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator, opts Options) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", opts.Name)
+		logger.Info("entering handler")
+
+		if opts.InitVersionMap {
+			vm = make(module.VersionMap)
+			for moduleName, module := range mm.Modules {
+				vm[moduleName] = module.ConsensusVersion()
+			}
+			if opts.GravityFromVersion != 0 {
+				vm[gravitytypes.ModuleName] = opts.GravityFromVersion
+			}
+		}
+
+		if opts.NormalizeGravityDenoms {
+			logger.Info("normalizing gravity denoms in bank balances")
+			NormalizeGravityDenoms(ctx, opts.BankKeeper)
+		}
+
+		if opts.TransferHistoryFile != "" {
+			written, err := WriteTransferHistory(ctx, opts.GravityKeeper, opts.TransferHistoryFile, opts.TransferHistoryFormat)
+			if err != nil {
+				logger.Error("failed to write the transfer history", "file", opts.TransferHistoryFile, "error", err)
+			} else {
+				logger.Info("wrote the transfer history", "file", opts.TransferHistoryFile, "transfers", written)
+			}
+		}
+
+		if len(opts.Reindexes) > 0 {
+			logger.Info("reindexing gravity store keys")
+			store := ctx.KVStore(opts.GravityStoreKey)
+			for _, reindex := range opts.Reindexes {
+				migrations.ReindexKeys(store, reindex.Prefix, reindex.Rekey)
+			}
+		}
+
+		if opts.PreMigrations != nil {
+			if err := opts.PreMigrations(ctx, vm); err != nil {
+				return nil, err
+			}
+		}
+
+		logger.Info("running migrations")
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		if opts.ReplayPendingEventVotes {
+			grandfathered, expired := opts.GravityKeeper.ReplayPendingEventVoteRecords(ctx, opts.ExpireInvalidEventVotes)
+			logger.Info("replayed pending event votes", "grandfathered", grandfathered, "expired", expired)
+		}
+
+		if opts.SeedGravityParams != nil {
+			logger.Info("seeding gravity params")
+			if err := opts.GravityKeeper.UpgradeParams(ctx, opts.SeedGravityParams); err != nil {
+				return nil, fmt.Errorf("seed gravity params: %w", err)
+			}
+		}
+
+		logger.Info("exiting handler")
+		return vm, nil
+	}
+}
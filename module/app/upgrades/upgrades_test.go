@@ -0,0 +1,72 @@
+package upgrades
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestCreateUpgradeHandler(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+	store.Set([]byte{0xf0, 'A'}, []byte("reindexed"))
+
+	mm := module.NewManager()
+	cfg := module.NewConfigurator(input.Marshaler, baseapp.NewMsgServiceRouter(), baseapp.NewGRPCQueryRouter())
+
+	history := filepath.Join(t.TempDir(), "history.csv")
+
+	var steps []string
+	handler := CreateUpgradeHandler(mm, cfg, Options{
+		Name:                  "test",
+		TransferHistoryFile:   history,
+		TransferHistoryFormat: types.TransferHistoryFormatCSV,
+		Reindexes: []Reindex{{
+			Prefix: []byte{0xf0},
+			Rekey:  func(key, _ []byte) []byte { return []byte{key[0] + 'a' - 'A'} },
+		}},
+		GravityStoreKey: input.GravityStoreKey,
+		PreMigrations: func(_ sdk.Context, _ module.VersionMap) error {
+			steps = append(steps, "pre")
+			return nil
+		},
+		ReplayPendingEventVotes: true,
+		SeedGravityParams: func(params *types.Params) {
+			steps = append(steps, "seed")
+			params.BridgeReportPeriod = 100
+		},
+		GravityKeeper: &input.GravityKeeper,
+	})
+
+	_, err := handler(ctx, upgradetypes.Plan{Name: "test"}, module.VersionMap{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"pre", "seed"}, steps)
+
+	require.Nil(t, store.Get([]byte{0xf0, 'A'}))
+	require.Equal(t, []byte("reindexed"), store.Get([]byte{0xf0, 'a'}))
+	require.Equal(t, uint64(100), input.GravityKeeper.GetParams(ctx).BridgeReportPeriod)
+
+	// the transfer history is written, here with no transfers
+	bz, err := os.ReadFile(history)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(bz), "evm_chain_id,event_nonce,"))
+
+	// params left invalid by the seeding fail the upgrade
+	handler = CreateUpgradeHandler(mm, cfg, Options{
+		SeedGravityParams: func(params *types.Params) { params.GravityId = strings.Repeat("a", 33) },
+		GravityKeeper:     &input.GravityKeeper,
+	})
+	_, err = handler(ctx, upgradetypes.Plan{Name: "test"}, module.VersionMap{})
+	require.Error(t, err)
+}
@@ -0,0 +1,255 @@
+// Package upgradetest runs store migrations and upgrade handlers on a test environment and
+// compares the state before and after them: the coins must be conserved, only moved between
+// the bridge accounts or renamed as the migration declares, and the gravity state must still
+// satisfy the module invariants and export to a valid genesis that imports back unchanged.
+package upgradetest
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// Options configures what a migration is allowed to change
+type Options struct {
+	// DenomMap returns the denom the coins of a denom are known by after the migration, the
+	// coins keep their denom if nil
+	DenomMap func(denom string) string
+	// BridgeAccounts are accounts, besides the gravity module account and the escrows of the
+	// EVM chains, the migration may move coins between, only their total is conserved
+	BridgeAccounts []sdk.AccAddress
+	// SkipInvariants are the routes of the gravity invariants not checked, for migrations of
+	// state from before the invariant was introduced
+	SkipInvariants []string
+	// Unchanged are prefixes of the gravity store keys the migration must not write
+	Unchanged [][]byte
+}
+
+func (o Options) mapDenom(denom string) string {
+	if o.DenomMap == nil {
+		return denom
+	}
+	return o.DenomMap(denom)
+}
+
+// Snapshot is the state of the bank and gravity stores at some height
+type Snapshot struct {
+	Supply   sdk.Coins
+	Balances map[string]sdk.Coins
+	// the escrows of the EVM chains
+	Escrows []sdk.AccAddress
+	// the gravity store, by key
+	Store map[string][]byte
+}
+
+// TakeSnapshot copies the supply, all the balances and the gravity store
+func TakeSnapshot(ctx sdk.Context, input keeper.TestInput) Snapshot {
+	s := Snapshot{
+		Supply:   sdk.NewCoins(),
+		Balances: map[string]sdk.Coins{},
+		Store:    map[string][]byte{},
+	}
+	input.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		s.Supply = s.Supply.Add(coin)
+		return false
+	})
+	input.BankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		s.Balances[addr.String()] = s.Balances[addr.String()].Add(coin)
+		return false
+	})
+	for _, chain := range input.GravityKeeper.GetEVMChains(ctx) {
+		s.Escrows = append(s.Escrows, types.EVMChainEscrowAddress(chain.ChainId))
+	}
+
+	iter := ctx.KVStore(input.GravityStoreKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		s.Store[string(iter.Key())] = append([]byte{}, iter.Value()...)
+	}
+	return s
+}
+
+// StoreDiff lists the keys of the gravity store a migration added, removed and changed the
+// value of, in order
+type StoreDiff struct {
+	Added   [][]byte
+	Removed [][]byte
+	Changed [][]byte
+}
+
+// Diff compares the gravity stores of two snapshots
+func Diff(before, after Snapshot) StoreDiff {
+	var diff StoreDiff
+	for key, value := range after.Store {
+		if prev, ok := before.Store[key]; !ok {
+			diff.Added = append(diff.Added, []byte(key))
+		} else if !bytes.Equal(prev, value) {
+			diff.Changed = append(diff.Changed, []byte(key))
+		}
+	}
+	for key := range before.Store {
+		if _, ok := after.Store[key]; !ok {
+			diff.Removed = append(diff.Removed, []byte(key))
+		}
+	}
+	for _, keys := range [][][]byte{diff.Added, diff.Removed, diff.Changed} {
+		sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	}
+	return diff
+}
+
+// CheckConservation checks that the migration from before to after conserved the supply, the
+// balances of the user accounts and the total of the bridge accounts, with the denoms mapped
+// as the options declare, and did not write the gravity store prefixes declared unchanged
+func CheckConservation(before, after Snapshot, opts Options) error {
+	var errs []string
+
+	if supply := mapCoins(before.Supply, opts); !equalCoins(supply, after.Supply) {
+		errs = append(errs, fmt.Sprintf("supply went from %s to %s", supply, after.Supply))
+	}
+
+	bridge := map[string]bool{authtypes.NewModuleAddress(types.ModuleName).String(): true}
+	for _, addrs := range [][]sdk.AccAddress{before.Escrows, after.Escrows, opts.BridgeAccounts} {
+		for _, addr := range addrs {
+			bridge[addr.String()] = true
+		}
+	}
+
+	bridgeBefore, bridgeAfter := sdk.NewCoins(), sdk.NewCoins()
+	for _, addr := range sortedAccounts(before.Balances, after.Balances) {
+		balanceBefore, balanceAfter := mapCoins(before.Balances[addr], opts), after.Balances[addr]
+		if bridge[addr] {
+			bridgeBefore = bridgeBefore.Add(balanceBefore...)
+			bridgeAfter = bridgeAfter.Add(balanceAfter...)
+		} else if !equalCoins(balanceBefore, balanceAfter) {
+			errs = append(errs, fmt.Sprintf("balance of %s went from %s to %s", addr, balanceBefore, balanceAfter))
+		}
+	}
+	if !equalCoins(bridgeBefore, bridgeAfter) {
+		errs = append(errs, fmt.Sprintf("bridge accounts went from %s to %s", bridgeBefore, bridgeAfter))
+	}
+
+	diff := Diff(before, after)
+	for _, prefix := range opts.Unchanged {
+		for _, keys := range [][][]byte{diff.Added, diff.Removed, diff.Changed} {
+			for _, key := range keys {
+				if bytes.HasPrefix(key, prefix) {
+					errs = append(errs, fmt.Sprintf("store key %X written under the unchanged prefix %X", key, prefix))
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("migration did not conserve the state:\n\t%s", strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+// CheckIntegrity checks that the gravity state satisfies the module invariants not skipped,
+// exports to a valid genesis and that the genesis imports into a new environment and exports
+// back the same, so that no state refers to state that is gone
+func CheckIntegrity(t testing.TB, ctx sdk.Context, input keeper.TestInput, opts Options) error {
+	t.Helper()
+
+	skip := map[string]bool{}
+	for _, route := range opts.SkipInvariants {
+		skip[route] = true
+	}
+	var registry invariantRegistry
+	keeper.RegisterInvariants(&registry, input.GravityKeeper)
+
+	var errs []string
+	for _, route := range registry {
+		if skip[route.route] {
+			continue
+		}
+		if msg, broken := route.invariant(ctx); broken {
+			errs = append(errs, msg)
+		}
+	}
+
+	genesis := keeper.ExportGenesis(ctx, input.GravityKeeper)
+	if err := genesis.ValidateBasic(); err != nil {
+		errs = append(errs, fmt.Sprintf("exported genesis is invalid: %s", err))
+	} else {
+		imported := keeper.CreateTestEnv(t)
+		keeper.InitGenesis(imported.Context, imported.GravityKeeper, genesis)
+		reexported := keeper.ExportGenesis(imported.Context, imported.GravityKeeper)
+		if !bytes.Equal(input.Marshaler.MustMarshalJSON(&genesis), input.Marshaler.MustMarshalJSON(&reexported)) {
+			errs = append(errs, "exported genesis does not import back the same")
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("gravity state is inconsistent:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// Run checks the integrity of the state of the input, migrates it and checks that the
+// migration conserved the state and kept its integrity. It returns the snapshots of the
+// state before and after the migration for further checks.
+func Run(t testing.TB, input keeper.TestInput, migrate func(ctx sdk.Context) error, opts Options) (before, after Snapshot) {
+	t.Helper()
+	ctx := input.Context
+
+	require.NoError(t, CheckIntegrity(t, ctx, input, opts), "state before the migration")
+	before = TakeSnapshot(ctx, input)
+
+	require.NoError(t, migrate(ctx))
+
+	after = TakeSnapshot(ctx, input)
+	require.NoError(t, CheckConservation(before, after, opts))
+	require.NoError(t, CheckIntegrity(t, ctx, input, opts), "state after the migration")
+	return before, after
+}
+
+func mapCoins(coins sdk.Coins, opts Options) sdk.Coins {
+	mapped := sdk.NewCoins()
+	for _, coin := range coins {
+		mapped = mapped.Add(sdk.NewCoin(opts.mapDenom(coin.Denom), coin.Amount))
+	}
+	return mapped
+}
+
+// equalCoins compares coins of any denoms, unlike Coins.IsEqual which panics on different ones
+func equalCoins(a, b sdk.Coins) bool {
+	return a.IsAllGTE(b) && b.IsAllGTE(a)
+}
+
+func sortedAccounts(balances ...map[string]sdk.Coins) []string {
+	seen := map[string]bool{}
+	var addrs []string
+	for _, b := range balances {
+		for addr := range b {
+			if !seen[addr] {
+				seen[addr] = true
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+type invariantRoute struct {
+	route     string
+	invariant sdk.Invariant
+}
+
+// invariantRegistry collects the invariants a module registers
+type invariantRegistry []invariantRoute
+
+func (r *invariantRegistry) RegisterRoute(_, route string, invariant sdk.Invariant) {
+	*r = append(*r, invariantRoute{route, invariant})
+}
@@ -0,0 +1,73 @@
+package upgradetest
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestRun(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	stake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	require.NoError(t, input.AddBalanceToBank(ctx, keeper.AccAddrs[0], stake))
+
+	// moving coins between the bridge accounts conserves them
+	before, after := Run(t, input, func(ctx sdk.Context) error {
+		if err := input.BankKeeper.SendCoinsFromAccountToModule(ctx, keeper.AccAddrs[0], types.ModuleName, stake); err != nil {
+			return err
+		}
+		return input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.EVMChainEscrowAddress(chainID), stake)
+	}, Options{BridgeAccounts: []sdk.AccAddress{keeper.AccAddrs[0]}})
+	require.Equal(t, stake, after.Balances[types.EVMChainEscrowAddress(chainID).String()])
+	require.Equal(t, StoreDiff{}, Diff(before, after))
+
+	// taking coins from a user account does not
+	before = TakeSnapshot(ctx, input)
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, keeper.AccAddrs[1], types.ModuleName, stake))
+	require.ErrorContains(t, CheckConservation(before, TakeSnapshot(ctx, input), Options{}), "balance of "+keeper.AccAddrs[1].String())
+
+	// nor does minting coins
+	before = TakeSnapshot(ctx, input)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, stake))
+	require.ErrorContains(t, CheckConservation(before, TakeSnapshot(ctx, input), Options{}), "supply went from")
+
+	// nor renaming them unless the migration declares it
+	original, renamed := sdk.NewCoins(sdk.NewInt64Coin("original", 10)), sdk.NewCoins(sdk.NewInt64Coin("renamed", 10))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, original))
+	before = TakeSnapshot(ctx, input)
+	require.NoError(t, input.BankKeeper.BurnCoins(ctx, types.ModuleName, original))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, renamed))
+	after = TakeSnapshot(ctx, input)
+	require.Error(t, CheckConservation(before, after, Options{}))
+	require.NoError(t, CheckConservation(before, after, Options{DenomMap: func(denom string) string {
+		if denom == "original" {
+			return "renamed"
+		}
+		return denom
+	}}))
+
+	// the store writes are listed and checked against the unchanged prefixes
+	before = TakeSnapshot(ctx, input)
+	ctx.KVStore(input.GravityStoreKey).Set([]byte{0xf0, 1}, []byte{1})
+	after = TakeSnapshot(ctx, input)
+	require.Equal(t, StoreDiff{Added: [][]byte{{0xf0, 1}}}, Diff(before, after))
+	require.NoError(t, CheckConservation(before, after, Options{Unchanged: [][]byte{{0xf1}}}))
+	require.ErrorContains(t, CheckConservation(before, after, Options{Unchanged: [][]byte{{0xf0}}}), "F001")
+}
+
+func TestCheckIntegrity(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	require.NoError(t, CheckIntegrity(t, ctx, input, Options{}))
+
+	// vouchers minted outside of the bridge break the voucher supply
+	vouchers := sdk.NewCoins(types.NewERC20Token(10, gethcommon.HexToAddress(keeper.TokenContractAddrs[0])).GravityCoin())
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.ErrorContains(t, CheckIntegrity(t, ctx, input, Options{}), "voucher-supply")
+	require.NoError(t, CheckIntegrity(t, ctx, input, Options{SkipInvariants: []string{"voucher-supply"}}))
+}
@@ -0,0 +1,47 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
+	icacontrollertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/app/upgrades"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	gravitytypes "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	icaModule ica.AppModule,
+	gravityKeeper *keeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	// the version map was stored by the v2 upgrade, so the gravity migrations from
+	// consensus version 2 onwards run from it
+	return upgrades.CreateUpgradeHandler(mm, configurator, upgrades.Options{
+		Name: UpgradeName,
+
+		// the interchain accounts host is added in this upgrade, it is initialized with the
+		// gravity messages of bridge users allowed rather than from its default genesis
+		PreMigrations: func(ctx sdk.Context, vm module.VersionMap) error {
+			ctx.Logger().Info("v3 upgrade: initializing interchain accounts host")
+			vm[icatypes.ModuleName] = icaModule.ConsensusVersion()
+			icaModule.InitModule(
+				ctx,
+				icacontrollertypes.Params{},
+				icahosttypes.NewParams(true, gravitytypes.InterchainAccountMsgs()),
+			)
+			return nil
+		},
+
+		// pending events voted for under the validation of the previous version which fail the
+		// current one are expired, rather than left half-voted, for their voters to resubmit
+		ReplayPendingEventVotes: true,
+		ExpireInvalidEventVotes: true,
+		GravityKeeper:           gravityKeeper,
+	})
+}
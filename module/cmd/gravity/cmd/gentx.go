@@ -13,7 +13,6 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -46,13 +45,19 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 	fsCreateValidator, defaultsDesc := cli.CreateValidatorMsgFlagSet(ipDefault)
 
 	cmd := &cobra.Command{
-		Use:   "gentx [key_name] [amount] [eth-address] [orchestrator-address] [eth-sig]",
+		Use:   "gentx [key_name] [amount] [[eth-address] [orchestrator-address] [eth-sig]]",
 		Short: "Generate a genesis tx carrying a self delegation, oracle key delegation and orchestrator key delegation",
-		Args:  cobra.ExactArgs(5),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 && len(args) != 5 {
+				return fmt.Errorf("accepts 2 or 5 arg(s), received %d", len(args))
+			}
+			return nil
+		},
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key
 delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node
 ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the
-priv_validator.json file. The following default parameters are included:
+priv_validator.json file. The key delegations may be left out, the validator then sets them with the set-delegate-keys
+tx once the chain has started. The following default parameters are included:
     %s
 
 Example:
@@ -116,17 +121,6 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrapf(err, "failed to fetch '%s' from the keyring", name)
 			}
 
-			ethAddress := args[2]
-
-			if !common.IsHexAddress(ethAddress) {
-				return errors.Wrapf(gravitytypes.ErrInvalid, "invalid ethereum address")
-			}
-
-			orchAddress, err := sdk.AccAddressFromBech32(args[3])
-			if err != nil {
-				return errors.Wrapf(err, "failed to parse orchAddress(%s)", args[3])
-			}
-
 			moniker := config.Moniker
 			if m, _ := cmd.Flags().GetString(cli.FlagMoniker); m != "" {
 				moniker = m
@@ -179,20 +173,15 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
-			ethSig, err := hexutil.Decode(args[4])
-			if err != nil {
-				return err
-			}
-
-			delegateGravityMsg := &gravitytypes.MsgDelegateKeys{
-				ValidatorAddress:    sdk.ValAddress(key.GetAddress()).String(),
-				OrchestratorAddress: orchAddress.String(),
-				EthereumAddress:     ethAddress,
-				EthSignature:        ethSig,
+			msgs := []sdk.Msg{msg}
+			if len(args) == 5 {
+				delegateGravityMsg, err := buildGenTxDelegateKeysMsg(sdk.ValAddress(key.GetAddress()), args[2], args[3], args[4])
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, delegateGravityMsg)
 			}
 
-			msgs := []sdk.Msg{msg, delegateGravityMsg}
-
 			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti {
 				cmd.PrintErrln("Offline key passed in. Use `tx sign` command to sign.")
 				return tx.GenerateTx(clientCtx, txFactory, msgs...)
@@ -249,6 +238,29 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 	return cmd
 }
 
+func buildGenTxDelegateKeysMsg(valAddress sdk.ValAddress, ethAddress, orchestrator, sig string) (*gravitytypes.MsgDelegateKeys, error) {
+	if gravitytypes.ValidateEthereumAddress(ethAddress) != nil {
+		return nil, errors.Wrapf(gravitytypes.ErrInvalid, "invalid ethereum address")
+	}
+
+	orchAddress, err := sdk.AccAddressFromBech32(orchestrator)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse orchAddress(%s)", orchestrator)
+	}
+
+	ethSig, err := hexutil.Decode(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gravitytypes.MsgDelegateKeys{
+		ValidatorAddress:    valAddress.String(),
+		OrchestratorAddress: orchAddress.String(),
+		EthereumAddress:     ethAddress,
+		EthSignature:        ethSig,
+	}, nil
+}
+
 func makeOutputFilepath(rootDir, nodeID string) (string, error) {
 	writePath := filepath.Join(rootDir, "config", "gentx")
 	if err := tmos.EnsureDir(writePath, 0700); err != nil {
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGenTxCmdArgs(t *testing.T) {
+	cmd := GenTxCmd(testMbm, simapp.MakeTestEncodingConfig().TxConfig, banktypes.GenesisBalancesIterator{}, t.TempDir())
+
+	// the key and amount, with or without the three delegate keys arguments
+	for n, valid := range map[int]bool{0: false, 1: false, 2: true, 3: false, 4: false, 5: true, 6: false} {
+		args := make([]string, n)
+		if valid {
+			require.NoError(t, cmd.Args(cmd, args), "%d args", n)
+		} else {
+			require.Error(t, cmd.Args(cmd, args), "%d args", n)
+		}
+	}
+}
+
+func TestBuildGenTxDelegateKeysMsg(t *testing.T) {
+	_, _, orchAddr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(orchAddr)
+	ethAddr := "0x033030FEeBd93E3178487c35A9c8cA80874353C9"
+
+	msg, err := buildGenTxDelegateKeysMsg(valAddr, ethAddr, orchAddr.String(), "0x1234")
+	require.NoError(t, err)
+	require.Equal(t, valAddr.String(), msg.ValidatorAddress)
+	require.Equal(t, orchAddr.String(), msg.OrchestratorAddress)
+	require.Equal(t, ethAddr, msg.EthereumAddress)
+	require.Equal(t, []byte{0x12, 0x34}, msg.EthSignature)
+
+	_, err = buildGenTxDelegateKeysMsg(valAddr, "0x0330", orchAddr.String(), "0x1234")
+	require.Error(t, err)
+	_, err = buildGenTxDelegateKeysMsg(valAddr, ethAddr, "cosmos1invalid", "0x1234")
+	require.Error(t, err)
+	_, err = buildGenTxDelegateKeysMsg(valAddr, ethAddr, orchAddr.String(), "1234")
+	require.Error(t, err)
+}
@@ -0,0 +1,425 @@
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/app"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+const (
+	flagFixturesSeed         = "seed"
+	flagFixturesFormat       = "format"
+	flagFixturesValidators   = "validators"
+	flagFixturesTokens       = "tokens"
+	flagFixturesPoolSize     = "pool-size"
+	flagFixturesBatches      = "batches"
+	flagFixturesBatchSize    = "batch-size"
+	flagFixturesSignerSets   = "signer-sets"
+	flagFixturesAttestations = "attestations"
+
+	fixturesFormatGenesis = "genesis"
+	fixturesFormatStore   = "store"
+)
+
+// fixturesConfig is the scale of the generated fixtures
+type fixturesConfig struct {
+	Validators   int
+	Tokens       int
+	PoolSize     int
+	Batches      int
+	BatchSize    int
+	SignerSets   int
+	Attestations int
+}
+
+// fixtureValidator is a generated validator, with the ethereum key its signatures are made with
+type fixtureValidator struct {
+	valAddress  sdk.ValAddress
+	accAddress  sdk.AccAddress
+	ethereumKey *ecdsa.PrivateKey
+}
+
+func (v fixtureValidator) ethereumAddress() common.Address {
+	return ethcrypto.PubkeyToAddress(v.ethereumKey.PublicKey)
+}
+
+// GenFixturesCmd returns the testdata cobra Command, generating reproducible gravity
+// fixtures into the genesis file or as a dump of the gravity store.
+func GenFixturesCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "testdata",
+		Short: "Generate reproducible gravity fixtures into genesis.json or a store dump",
+		Long: `Generate a reproducible set of gravity fixtures for load testing and client development:
+validators with delegated keys, pools of unbatched transfers and batches of each token, signer
+sets, the confirmations of all the validators and accepted deposit attestations. The same seed
+and scale always generate the same fixtures.
+
+With --format=genesis the gravity state of genesis.json is replaced by the fixtures, keeping its
+params, and the vouchers escrowed for the transfers are added to the gravity module account.
+With --format=store the same genesis is initialized in memory and the gravity store is written
+as JSON lines of hex encoded keys and values, to --output-document or stdout.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			seed, _ := cmd.Flags().GetInt64(flagFixturesSeed)
+			format, _ := cmd.Flags().GetString(flagFixturesFormat)
+			if format != fixturesFormatGenesis && format != fixturesFormatStore {
+				return fmt.Errorf("invalid format %s, expected %s or %s", format, fixturesFormatGenesis, fixturesFormatStore)
+			}
+			var cfg fixturesConfig
+			cfg.Validators, _ = cmd.Flags().GetInt(flagFixturesValidators)
+			cfg.Tokens, _ = cmd.Flags().GetInt(flagFixturesTokens)
+			cfg.PoolSize, _ = cmd.Flags().GetInt(flagFixturesPoolSize)
+			cfg.Batches, _ = cmd.Flags().GetInt(flagFixturesBatches)
+			cfg.BatchSize, _ = cmd.Flags().GetInt(flagFixturesBatchSize)
+			cfg.SignerSets, _ = cmd.Flags().GetInt(flagFixturesSignerSets)
+			cfg.Attestations, _ = cmd.Flags().GetInt(flagFixturesAttestations)
+			if cfg.Validators < 1 {
+				return fmt.Errorf("at least one validator is needed")
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+			if err := addFixturesToAppState(cdc, appState, rand.New(rand.NewSource(seed)), cfg); err != nil {
+				return err
+			}
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+			genDoc.AppState = appStateJSON
+
+			if format == fixturesFormatGenesis {
+				return genutil.ExportGenesisFile(genDoc, genFile)
+			}
+
+			out := cmd.OutOrStdout()
+			if outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument); outputDocument != "" {
+				f, err := os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			return dumpGravityStore(genDoc, out)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the store dump to the given file instead of stdout")
+	cmd.Flags().String(flagFixturesFormat, fixturesFormatGenesis, "Output of the fixtures (genesis|store)")
+	cmd.Flags().Int64(flagFixturesSeed, 1, "Seed of the generated fixtures")
+	cmd.Flags().Int(flagFixturesValidators, 4, "Number of validators delegating their keys")
+	cmd.Flags().Int(flagFixturesTokens, 2, "Number of ERC20 tokens with a pool and batches")
+	cmd.Flags().Int(flagFixturesPoolSize, 100, "Number of unbatched transfers in the pool of each token")
+	cmd.Flags().Int(flagFixturesBatches, 5, "Number of batches of each token")
+	cmd.Flags().Int(flagFixturesBatchSize, 10, "Number of transfers in each batch")
+	cmd.Flags().Int(flagFixturesSignerSets, 3, "Number of signer sets")
+	cmd.Flags().Int(flagFixturesAttestations, 20, "Number of accepted deposit attestations")
+
+	return cmd
+}
+
+// addFixturesToAppState replaces the gravity genesis of the app state by fixtures, keeping
+// its params, and adds the vouchers escrowed by the transfers to the gravity module account
+func addFixturesToAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage, r *rand.Rand, cfg fixturesConfig) error {
+	var gravityGenState types.GenesisState
+	if err := cdc.UnmarshalJSON(appState[types.ModuleName], &gravityGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal gravity genesis state: %w", err)
+	}
+	if gravityGenState.Params == nil {
+		return fmt.Errorf("no gravity params in genesis state")
+	}
+
+	fixtures, escrow, err := generateFixtures(r, *gravityGenState.Params, cfg)
+	if err != nil {
+		return err
+	}
+	if err := fixtures.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid fixtures: %w", err)
+	}
+	fixturesBz, err := cdc.MarshalJSON(&fixtures)
+	if err != nil {
+		return fmt.Errorf("failed to marshal gravity genesis state: %w", err)
+	}
+	appState[types.ModuleName] = fixturesBz
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	escrowAddress := authtypes.NewModuleAddress(types.ModuleName).String()
+	escrowed := false
+	for i, balance := range bankGenState.Balances {
+		if balance.Address == escrowAddress {
+			bankGenState.Balances[i].Coins = balance.Coins.Add(escrow...)
+			escrowed = true
+		}
+	}
+	if !escrowed && !escrow.IsZero() {
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: escrowAddress, Coins: escrow})
+	}
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	bankGenState.Supply = bankGenState.Supply.Add(escrow...)
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+	appState[banktypes.ModuleName] = bankGenStateBz
+
+	return nil
+}
+
+// generateFixtures returns the gravity genesis state of the fixtures, drawn from r, and the
+// vouchers its transfers escrow
+func generateFixtures(r *rand.Rand, params types.Params, cfg fixturesConfig) (types.GenesisState, sdk.Coins, error) {
+	genState := types.GenesisState{Params: &params}
+	gravityID := []byte(params.GravityId)
+	escrow := sdk.NewCoins()
+
+	validators := make([]fixtureValidator, cfg.Validators)
+	for i := range validators {
+		validators[i] = randFixtureValidator(r)
+		signature, err := delegateKeysFixtureSignature(validators[i])
+		if err != nil {
+			return genState, nil, err
+		}
+		genState.DelegateKeys = append(genState.DelegateKeys, types.NewMsgDelegateKeys(
+			validators[i].valAddress,
+			validators[i].accAddress,
+			validators[i].ethereumAddress().Hex(),
+			signature,
+		))
+	}
+
+	// every validator confirms every outgoing tx
+	addOutgoingTx := func(otx types.OutgoingTx, confirmation func(signer common.Address, signature []byte) types.EthereumTxConfirmation) error {
+		packed, err := types.PackOutgoingTx(otx)
+		if err != nil {
+			return err
+		}
+		genState.OutgoingTxs = append(genState.OutgoingTxs, packed)
+		checkpoint := otx.GetCheckpoint(gravityID)
+		for _, val := range validators {
+			signature, err := types.NewEthereumSignature(checkpoint, val.ethereumKey)
+			if err != nil {
+				return err
+			}
+			packed, err := types.PackConfirmation(confirmation(val.ethereumAddress(), signature))
+			if err != nil {
+				return err
+			}
+			genState.Confirmations = append(genState.Confirmations, packed)
+		}
+		return nil
+	}
+
+	for nonce := uint64(1); nonce <= uint64(cfg.SignerSets); nonce++ {
+		signerSet := types.NewSignerSetTx(nonce, nonce, randFixtureSigners(r, validators))
+		if err := addOutgoingTx(signerSet, func(signer common.Address, signature []byte) types.EthereumTxConfirmation {
+			return &types.SignerSetTxConfirmation{SignerSetNonce: nonce, EthereumSigner: signer.Hex(), Signature: signature}
+		}); err != nil {
+			return genState, nil, err
+		}
+	}
+	genState.LatestSignerSetTxNonce = uint64(cfg.SignerSets)
+
+	var sendID, batchNonce uint64
+	newSend := func(contract common.Address) *types.SendToEthereum {
+		sendID++
+		send := &types.SendToEthereum{
+			Id:                sendID,
+			Sender:            validators[r.Intn(len(validators))].accAddress.String(),
+			EthereumRecipient: randFixtureAddress(r).Hex(),
+			Erc20Token:        types.NewERC20Token(uint64(r.Intn(1_000_000)+1), contract),
+			Erc20Fee:          types.NewERC20Token(uint64(r.Intn(1_000)), contract),
+		}
+		escrow = escrow.Add(send.Erc20Token.GravityCoin()).Add(send.Erc20Fee.GravityCoin())
+		return send
+	}
+
+	tokens := make([]common.Address, cfg.Tokens)
+	for i := range tokens {
+		tokens[i] = randFixtureAddress(r)
+		for j := 0; j < cfg.PoolSize; j++ {
+			genState.UnbatchedSendToEthereumTxs = append(genState.UnbatchedSendToEthereumTxs, newSend(tokens[i]))
+		}
+		for j := 0; j < cfg.Batches; j++ {
+			batchNonce++
+			batch := &types.BatchTx{
+				BatchNonce:    batchNonce,
+				Timeout:       params.TargetEthTxTimeout + uint64(r.Intn(1_000_000)),
+				TokenContract: tokens[i].Hex(),
+				Height:        1,
+			}
+			for k := 0; k < cfg.BatchSize; k++ {
+				batch.Transactions = append(batch.Transactions, newSend(tokens[i]))
+			}
+			if err := addOutgoingTx(batch, func(signer common.Address, signature []byte) types.EthereumTxConfirmation {
+				return &types.BatchTxConfirmation{
+					TokenContract:  batch.TokenContract,
+					BatchNonce:     batch.BatchNonce,
+					EthereumSigner: signer.Hex(),
+					Signature:      signature,
+				}
+			}); err != nil {
+				return genState, nil, err
+			}
+		}
+	}
+	genState.LastSendToEthereumId = sendID
+	genState.LastOutgoingBatchNonce = batchNonce
+
+	// accepted deposits of the tokens, voted by all the validators
+	var ethereumHeight uint64
+	for nonce := uint64(1); nonce <= uint64(cfg.Attestations); nonce++ {
+		ethereumHeight += uint64(r.Intn(10) + 1)
+		event := &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  randFixtureAddress(r).Hex(),
+			Amount:         sdk.NewInt(int64(r.Intn(1_000_000) + 1)),
+			EthereumSender: randFixtureAddress(r).Hex(),
+			CosmosReceiver: validators[r.Intn(len(validators))].accAddress.String(),
+			EthereumHeight: ethereumHeight,
+		}
+		if len(tokens) > 0 {
+			event.TokenContract = tokens[r.Intn(len(tokens))].Hex()
+		}
+		packed, err := types.PackEvent(event)
+		if err != nil {
+			return genState, nil, err
+		}
+		record := &types.EthereumEventVoteRecord{Event: packed, Accepted: true, FirstVoteHeight: 1}
+		for _, val := range validators {
+			record.Votes = append(record.Votes, val.valAddress.String())
+		}
+		genState.EthereumEventVoteRecords = append(genState.EthereumEventVoteRecords, record)
+	}
+	genState.LastObservedEventNonce = uint64(cfg.Attestations)
+	genState.LastObservedEthereumHeight = types.LatestEthereumBlockHeight{EthereumHeight: ethereumHeight}
+
+	return genState, escrow, nil
+}
+
+// randFixtureValidator returns a validator whose operator and orchestrator are the same key
+func randFixtureValidator(r *rand.Rand) fixtureValidator {
+	secret := make([]byte, 32)
+	r.Read(secret)
+	pubKey := secp256k1.GenPrivKeyFromSecret(secret).PubKey()
+
+	var ethereumKey *ecdsa.PrivateKey
+	for ethereumKey == nil {
+		r.Read(secret)
+		ethereumKey, _ = ethcrypto.ToECDSA(secret)
+	}
+
+	return fixtureValidator{
+		valAddress:  sdk.ValAddress(pubKey.Address()),
+		accAddress:  sdk.AccAddress(pubKey.Address()),
+		ethereumKey: ethereumKey,
+	}
+}
+
+func delegateKeysFixtureSignature(val fixtureValidator) ([]byte, error) {
+	signMsg := types.DelegateKeysSignMsg{ValidatorAddress: val.valAddress.String()}
+	bz, err := signMsg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return types.NewEthereumSignature(ethcrypto.Keccak256(bz), val.ethereumKey)
+}
+
+// randFixtureSigners returns the signers of the validators with random powers normalized
+// to the total power of the contract
+func randFixtureSigners(r *rand.Rand, validators []fixtureValidator) types.EthereumSigners {
+	powers := make([]uint64, len(validators))
+	var total uint64
+	for i := range powers {
+		powers[i] = uint64(r.Intn(100) + 1)
+		total += powers[i]
+	}
+	signers := make(types.EthereumSigners, len(validators))
+	for i, val := range validators {
+		signers[i] = &types.EthereumSigner{
+			Power:           powers[i] * (1 << 32) / total,
+			EthereumAddress: val.ethereumAddress().Hex(),
+		}
+	}
+	return signers
+}
+
+func randFixtureAddress(r *rand.Rand) common.Address {
+	var addr common.Address
+	r.Read(addr[:])
+	return addr
+}
+
+// fixturesStoreEntry is a line of the dump of the gravity store
+type fixturesStoreEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// dumpGravityStore initializes the chain of the genesis in memory and writes the
+// entries of the gravity store to out
+func dumpGravityStore(genDoc *tmtypes.GenesisDoc, out io.Writer) error {
+	gravityApp := app.NewGravityApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, "", 0,
+		app.MakeEncodingConfig(), viper.New(),
+	)
+	gravityApp.InitChain(abci.RequestInitChain{
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+		AppStateBytes:   genDoc.AppState,
+	})
+	gravityApp.Commit()
+
+	store := gravityApp.CommitMultiStore().GetKVStore(gravityApp.GetKey(types.StoreKey))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	encoder := json.NewEncoder(out)
+	for ; iter.Valid(); iter.Next() {
+		if err := encoder.Encode(fixturesStoreEntry{
+			Key:   hex.EncodeToString(iter.Key()),
+			Value: hex.EncodeToString(iter.Value()),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/app"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func execGenFixturesCmd(t *testing.T, home string, args ...string) []byte {
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+
+	appCodec := app.MakeEncodingConfig().Marshaler
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	clientCtx := client.Context{}.WithCodec(appCodec).WithHomeDir(home)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	out := bytes.NewBuffer(nil)
+	cmd := GenFixturesCmd(home)
+	cmd.SetOut(out)
+	cmd.SetArgs(args)
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	return out.Bytes()
+}
+
+func initFixturesHome(t *testing.T) string {
+	home := t.TempDir()
+	_, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+	require.NoError(t, genutiltest.ExecInitCmd(app.ModuleBasics, home, app.MakeEncodingConfig().Marshaler))
+	return home
+}
+
+func TestGenFixturesCmdGenesis(t *testing.T) {
+	appCodec := app.MakeEncodingConfig().Marshaler
+	args := []string{"--validators=3", "--tokens=2", "--pool-size=7", "--batches=2", "--batch-size=3", "--signer-sets=2", "--attestations=4"}
+
+	homeA, homeB := initFixturesHome(t), initFixturesHome(t)
+	execGenFixturesCmd(t, homeA, args...)
+	execGenFixturesCmd(t, homeB, args...)
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(homeA + "/config/genesis.json")
+	require.NoError(t, err)
+	appStateB, _, err := genutiltypes.GenesisStateFromGenFile(homeB + "/config/genesis.json")
+	require.NoError(t, err)
+	// the same seed and scale generate the same fixtures
+	require.JSONEq(t, string(appState[types.ModuleName]), string(appStateB[types.ModuleName]))
+
+	var genState types.GenesisState
+	appCodec.MustUnmarshalJSON(appState[types.ModuleName], &genState)
+	require.NoError(t, genState.ValidateBasic())
+	require.Len(t, genState.DelegateKeys, 3)
+	require.Len(t, genState.UnbatchedSendToEthereumTxs, 14)
+	// two signer sets and two batches of each token, confirmed by all the validators
+	require.Len(t, genState.OutgoingTxs, 6)
+	require.Len(t, genState.Confirmations, 18)
+	require.Len(t, genState.EthereumEventVoteRecords, 4)
+	require.Equal(t, uint64(4), genState.LastObservedEventNonce)
+	require.Equal(t, uint64(26), genState.LastSendToEthereumId)
+
+	// the transfers are escrowed by the gravity module account
+	bankGenState := banktypes.GetGenesisStateFromAppState(appCodec, appState)
+	require.Len(t, bankGenState.Balances, 1)
+	require.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), bankGenState.Balances[0].Address)
+	require.Equal(t, bankGenState.Supply, bankGenState.Balances[0].Coins)
+	require.Len(t, bankGenState.Supply, 2)
+
+	// another seed generates other fixtures
+	homeC := initFixturesHome(t)
+	execGenFixturesCmd(t, homeC, append(args, "--seed=2")...)
+	appStateC, _, err := genutiltypes.GenesisStateFromGenFile(homeC + "/config/genesis.json")
+	require.NoError(t, err)
+	require.NotEqual(t, string(appState[types.ModuleName]), string(appStateC[types.ModuleName]))
+}
+
+func TestGenFixturesCmdStore(t *testing.T) {
+	home := initFixturesHome(t)
+	dumpFile := home + "/dump.jsonl"
+	execGenFixturesCmd(t, home, "--format=store", "--pool-size=5", "--batches=1", fmt.Sprintf("--output-document=%s", dumpFile))
+
+	f, err := os.Open(dumpFile)
+	require.NoError(t, err)
+	defer f.Close()
+
+	prefixes := map[byte]int{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var entry fixturesStoreEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		key, err := hex.DecodeString(entry.Key)
+		require.NoError(t, err)
+		if key[0] == types.EVMChainStoreKey {
+			prefixes[key[9]]++
+		} else {
+			prefixes[key[0]]++
+		}
+	}
+	require.NoError(t, scanner.Err())
+
+	// the pools of the two tokens, their batches and the signer sets, and the deposits
+	require.Equal(t, 10, prefixes[types.SendToEthereumKey])
+	require.Equal(t, 5, prefixes[types.OutgoingTxKey])
+	require.Equal(t, 20, prefixes[types.EthereumEventVoteRecordKey])
+	require.Equal(t, 4, prefixes[types.OrchestratorValidatorAddressKey])
+}
@@ -0,0 +1,47 @@
+package calldata
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// The ABIs of abi_json.go, each parsed once on first use rather than for every checkpoint
+var (
+	batchCheckpointABI        = newLazyABI(OutgoingBatchTxCheckpointABIJSON)
+	erc1155BatchCheckpointABI = newLazyABI(OutgoingERC1155BatchTxCheckpointABIJSON)
+	valsetCheckpointABI       = newLazyABI(ValsetCheckpointABIJSON)
+	logicCallCheckpointABI    = newLazyABI(OutgoingLogicCallABIJSON)
+	gravityRelayABI           = newLazyABI(GravityRelayABIJSON)
+)
+
+// GravityRelayABI returns the parsed GravityRelayABIJSON
+func GravityRelayABI() abi.ABI {
+	return gravityRelayABI.ABI()
+}
+
+// lazyABI is an ABI JSON parsed the first time it is needed, safe for concurrent use
+type lazyABI struct {
+	json string
+
+	once   sync.Once
+	parsed abi.ABI
+}
+
+func newLazyABI(json string) *lazyABI {
+	return &lazyABI{json: json}
+}
+
+// ABI returns the parsed ABI, it panics if the JSON is invalid
+func (l *lazyABI) ABI() abi.ABI {
+	l.once.Do(func() {
+		parsed, err := abi.JSON(strings.NewReader(l.json))
+		if err != nil {
+			panic(fmt.Errorf("bad ABI definition in code: %w", err))
+		}
+		l.parsed = parsed
+	})
+	return l.parsed
+}
@@ -0,0 +1,217 @@
+// Package calldata builds what the Gravity contract is given for an outgoing tx: the
+// checkpoint the validators sign, which the module stores their confirmations under, and the
+// calldata of the contract call relaying the signed tx. The module and the relayers encode
+// both from the one set of ABI definitions here so that they can't drift apart, and the
+// builders take the contract arguments rather than the module's txs so that a relayer only
+// needs what it reads from the chain.
+package calldata
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ValsetArgs mirrors the ValsetArgs struct of the Gravity contract
+type ValsetArgs struct {
+	Validators   []common.Address `abi:"validators"`
+	Powers       []*big.Int       `abi:"powers"`
+	Nonce        *big.Int         `abi:"valsetNonce"`
+	RewardAmount *big.Int         `abi:"rewardAmount"`
+	RewardToken  common.Address   `abi:"rewardToken"`
+}
+
+// ValSignature mirrors the ValSignature struct of the Gravity contract
+type ValSignature struct {
+	V uint8    `abi:"v"`
+	R [32]byte `abi:"r"`
+	S [32]byte `abi:"s"`
+}
+
+// BatchArgs are the arguments of a batch of ERC20 transfers to submitBatch
+type BatchArgs struct {
+	Amounts       []*big.Int
+	Destinations  []common.Address
+	Fees          []*big.Int
+	BatchNonce    *big.Int
+	TokenContract common.Address
+	BatchTimeout  *big.Int
+}
+
+// ERC1155BatchArgs are the arguments of a batch of ERC1155 transfers to submitERC1155Batch,
+// one entry per id moved to a recipient
+type ERC1155BatchArgs struct {
+	Destinations  []common.Address
+	IDs           []*big.Int
+	Amounts       []*big.Int
+	BatchNonce    *big.Int
+	TokenContract common.Address
+	BatchTimeout  *big.Int
+}
+
+// LogicCallArgs mirrors the LogicCallArgs struct of the Gravity contract
+type LogicCallArgs struct {
+	TransferAmounts        []*big.Int       `abi:"transferAmounts"`
+	TransferTokenContracts []common.Address `abi:"transferTokenContracts"`
+	FeeAmounts             []*big.Int       `abi:"feeAmounts"`
+	FeeTokenContracts      []common.Address `abi:"feeTokenContracts"`
+	LogicContractAddress   common.Address   `abi:"logicContractAddress"`
+	Payload                []byte           `abi:"payload"`
+	TimeOut                *big.Int         `abi:"timeOut"`
+	InvalidationId         [32]byte         `abi:"invalidationId"`
+	InvalidationNonce      *big.Int         `abi:"invalidationNonce"`
+}
+
+// NewValSignature returns the 65 byte [R || S || V] signature as the contract takes it,
+// with the 27 or 28 form of v. Shorter signatures, the empty ones of the signers who didn't
+// sign, are left empty.
+func NewValSignature(signature []byte) ValSignature {
+	var sig ValSignature
+	if len(signature) < 65 {
+		return sig
+	}
+	copy(sig.R[:], signature[:32])
+	copy(sig.S[:], signature[32:64])
+	sig.V = signature[64]
+	if sig.V < 27 {
+		sig.V += 27
+	}
+	return sig
+}
+
+/////////////////
+// Checkpoints //
+/////////////////
+
+// The checkpoints are the hashes of the arguments as the contract abi.encodes them, salted
+// with the gravity id and a method name which, unlike the name of the function the ABIs
+// declare, is part of the encoding.
+
+func methodName(name string) (out [32]byte) {
+	copy(out[:], name)
+	return out
+}
+
+// ValsetCheckpoint returns the checkpoint of the valset under the gravity id
+func ValsetCheckpoint(gravityID [32]byte, valset ValsetArgs) []byte {
+	return checkpoint(valsetCheckpointABI, "checkpoint",
+		gravityID,
+		methodName("checkpoint"),
+		valset.Nonce,
+		valset.Validators,
+		valset.Powers,
+		valset.RewardAmount,
+		valset.RewardToken,
+	)
+}
+
+// BatchCheckpoint returns the checkpoint of the batch under the gravity id
+func BatchCheckpoint(gravityID [32]byte, batch BatchArgs) []byte {
+	return checkpoint(batchCheckpointABI, "submitBatch",
+		gravityID,
+		methodName("transactionBatch"),
+		batch.Amounts,
+		batch.Destinations,
+		batch.Fees,
+		batch.BatchNonce,
+		batch.TokenContract,
+		batch.BatchTimeout,
+	)
+}
+
+// ERC1155BatchCheckpoint returns the checkpoint of the ERC1155 batch under the gravity id
+func ERC1155BatchCheckpoint(gravityID [32]byte, batch ERC1155BatchArgs) []byte {
+	return checkpoint(erc1155BatchCheckpointABI, "submitERC1155Batch",
+		gravityID,
+		methodName("erc1155Batch"),
+		batch.Destinations,
+		batch.IDs,
+		batch.Amounts,
+		batch.BatchNonce,
+		batch.TokenContract,
+		batch.BatchTimeout,
+	)
+}
+
+// LogicCallCheckpoint returns the checkpoint of the logic call under the gravity id
+func LogicCallCheckpoint(gravityID [32]byte, call LogicCallArgs) []byte {
+	return checkpoint(logicCallCheckpointABI, "checkpoint",
+		gravityID,
+		methodName("logicCall"),
+		call.TransferAmounts,
+		call.TransferTokenContracts,
+		call.FeeAmounts,
+		call.FeeTokenContracts,
+		call.LogicContractAddress,
+		call.Payload,
+		call.TimeOut,
+		call.InvalidationId,
+		call.InvalidationNonce,
+	)
+}
+
+// checkpoint returns the hash of the arguments packed without the function selector, it
+// panics if they don't fit the ABI, which the types of the builders above rule out but for
+// nil integers
+func checkpoint(contractABI *lazyABI, method string, args ...interface{}) []byte {
+	abiEncodedCall, err := contractABI.ABI().Pack(method, args...)
+	if err != nil {
+		panic(fmt.Errorf("packing checkpoint: %w", err))
+	}
+	return crypto.Keccak256Hash(abiEncodedCall[4:]).Bytes()
+}
+
+///////////////////////
+// Relaying calldata //
+///////////////////////
+
+// UpdateValset returns the calldata of the updateValset call moving the contract from the
+// current valset to the new one
+func UpdateValset(newValset, currentValset ValsetArgs, sigs []ValSignature) ([]byte, error) {
+	return pack("updateValset", newValset, currentValset, sigs)
+}
+
+// SubmitBatch returns the calldata of the submitBatch call of the batch
+func SubmitBatch(currentValset ValsetArgs, sigs []ValSignature, batch BatchArgs) ([]byte, error) {
+	return pack(
+		"submitBatch",
+		currentValset,
+		sigs,
+		batch.Amounts,
+		batch.Destinations,
+		batch.Fees,
+		batch.BatchNonce,
+		batch.TokenContract,
+		batch.BatchTimeout,
+	)
+}
+
+// SubmitERC1155Batch returns the calldata of the submitERC1155Batch call of the batch
+func SubmitERC1155Batch(currentValset ValsetArgs, sigs []ValSignature, batch ERC1155BatchArgs) ([]byte, error) {
+	return pack(
+		"submitERC1155Batch",
+		currentValset,
+		sigs,
+		batch.Destinations,
+		batch.IDs,
+		batch.Amounts,
+		batch.BatchNonce,
+		batch.TokenContract,
+		batch.BatchTimeout,
+	)
+}
+
+// SubmitLogicCall returns the calldata of the submitLogicCall call of the logic call
+func SubmitLogicCall(currentValset ValsetArgs, sigs []ValSignature, call LogicCallArgs) ([]byte, error) {
+	return pack("submitLogicCall", currentValset, sigs, call)
+}
+
+func pack(method string, args ...interface{}) ([]byte, error) {
+	calldata, err := gravityRelayABI.ABI().Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("packing %s: %w", method, err)
+	}
+	return calldata, nil
+}
@@ -0,0 +1,355 @@
+package calldata
+
+import (
+	"encoding/json"
+	"flag"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The checkpoints and calldata are consensus critical for the module and must be what the
+// contract decodes for the relayers, the vectors pin both so that a change to an ABI
+// definition or to the arguments shows up as a diff of testdata/calldata_vectors.json.
+
+var updateCalldataVectors = flag.Bool("update-calldata-vectors", false, "rewrite testdata/calldata_vectors.json")
+
+const (
+	calldataVectorsFile = "testdata/calldata_vectors.json"
+	calldataVectorsSeed = 1
+	calldataVectorsN    = 4
+)
+
+func TestGravityRelayABI(t *testing.T) {
+	// the signatures of the functions of the Gravity contract
+	for name, sig := range map[string]string{
+		"updateValset":       "updateValset((address[],uint256[],uint256,uint256,address),(address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[])",
+		"submitBatch":        "submitBatch((address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[],uint256[],address[],uint256[],uint256,address,uint256)",
+		"submitERC1155Batch": "submitERC1155Batch((address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[],address[],uint256[],uint256[],uint256,address,uint256)",
+		"submitLogicCall":    "submitLogicCall((address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[],(uint256[],address[],uint256[],address[],address,bytes,uint256,bytes32,uint256))",
+	} {
+		assert.Equal(t, sig, GravityRelayABI().Methods[name].Sig)
+	}
+}
+
+// TestCheckpoints anchors the checkpoints to the hashes computed by the bridge contract
+func TestCheckpoints(t *testing.T) {
+	gravityID := methodName("foo")
+	erc20Addr := common.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+
+	valset := ValsetArgs{
+		Validators:   []common.Address{common.HexToAddress("0xc783df8a850f42e7F7e57013759C285caa701eB6")},
+		Powers:       []*big.Int{big.NewInt(6667)},
+		Nonce:        big.NewInt(0),
+		RewardAmount: big.NewInt(0),
+	}
+	require.Equal(t,
+		"0x89731c26bab12cf0cb5363ef9abab6f9bd5496cf758a2309311c7946d54bca85",
+		hexutil.Encode(ValsetCheckpoint(gravityID, valset)),
+	)
+
+	batch := BatchArgs{
+		Amounts:       []*big.Int{big.NewInt(1)},
+		Destinations:  []common.Address{common.HexToAddress("0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39")},
+		Fees:          []*big.Int{big.NewInt(1)},
+		BatchNonce:    big.NewInt(1),
+		TokenContract: erc20Addr,
+		BatchTimeout:  big.NewInt(2111),
+	}
+	require.Equal(t,
+		"0xa3a7ee0a363b8ad2514e7ee8f110d7449c0d88f3b0913c28c1751e6e0079a9b2",
+		hexutil.Encode(BatchCheckpoint(gravityID, batch)),
+	)
+
+	tokenAddr := common.HexToAddress("0xC26eFfa98B8A2632141562Ae7E34953Cfe5B4888")
+	call := LogicCallArgs{
+		TransferAmounts:        []*big.Int{big.NewInt(1)},
+		TransferTokenContracts: []common.Address{tokenAddr},
+		FeeAmounts:             []*big.Int{big.NewInt(1)},
+		FeeTokenContracts:      []common.Address{tokenAddr},
+		LogicContractAddress:   common.HexToAddress("0x17c1736CcF692F653c433d7aa2aB45148C016F68"),
+		Payload:                hexutil.MustDecode("0x74657374696e675061796c6f6164000000000000000000000000000000000000"),
+		TimeOut:                big.NewInt(4766922941000),
+		InvalidationId:         methodName("invalidationId"),
+		InvalidationNonce:      big.NewInt(1),
+	}
+	require.Equal(t,
+		"0x1de95c9ace999f8ec70c6dc8d045942da2612950567c4861aca959c0650194da",
+		hexutil.Encode(LogicCallCheckpoint(gravityID, call)),
+	)
+}
+
+func TestNewValSignature(t *testing.T) {
+	require.Equal(t, ValSignature{}, NewValSignature(nil))
+	require.Equal(t, ValSignature{}, NewValSignature(make([]byte, 64)))
+
+	sig := make([]byte, 65)
+	sig[0], sig[32] = 1, 2
+	for v, expected := range map[byte]uint8{0: 27, 1: 28, 27: 27, 28: 28} {
+		sig[64] = v
+		out := NewValSignature(sig)
+		require.Equal(t, expected, out.V)
+		require.Equal(t, byte(1), out.R[0])
+		require.Equal(t, byte(2), out.S[0])
+	}
+}
+
+/////////////
+// Vectors //
+/////////////
+
+type calldataVectors struct {
+	GravityID      hexutil.Bytes      `json:"gravity_id"`
+	CurrentValset  valsetVector       `json:"current_valset"`
+	Signatures     []hexutil.Bytes    `json:"signatures"`
+	Valsets        []valsetCase       `json:"valsets"`
+	Batches        []batchCase        `json:"batches"`
+	ERC1155Batches []erc1155BatchCase `json:"erc1155_batches"`
+	LogicCalls     []logicCallCase    `json:"logic_calls"`
+}
+
+type valsetVector struct {
+	Validators   []common.Address `json:"validators"`
+	Powers       []*hexutil.Big   `json:"powers"`
+	ValsetNonce  *hexutil.Big     `json:"valset_nonce"`
+	RewardAmount *hexutil.Big     `json:"reward_amount"`
+	RewardToken  common.Address   `json:"reward_token"`
+}
+
+type valsetCase struct {
+	Valset     valsetVector  `json:"valset"`
+	Checkpoint hexutil.Bytes `json:"checkpoint"`
+	Calldata   hexutil.Bytes `json:"calldata"`
+}
+
+type batchCase struct {
+	Amounts       []*hexutil.Big   `json:"amounts"`
+	Destinations  []common.Address `json:"destinations"`
+	Fees          []*hexutil.Big   `json:"fees"`
+	BatchNonce    *hexutil.Big     `json:"batch_nonce"`
+	TokenContract common.Address   `json:"token_contract"`
+	BatchTimeout  *hexutil.Big     `json:"batch_timeout"`
+	Checkpoint    hexutil.Bytes    `json:"checkpoint"`
+	Calldata      hexutil.Bytes    `json:"calldata"`
+}
+
+type erc1155BatchCase struct {
+	Destinations  []common.Address `json:"destinations"`
+	IDs           []*hexutil.Big   `json:"ids"`
+	Amounts       []*hexutil.Big   `json:"amounts"`
+	BatchNonce    *hexutil.Big     `json:"batch_nonce"`
+	TokenContract common.Address   `json:"token_contract"`
+	BatchTimeout  *hexutil.Big     `json:"batch_timeout"`
+	Checkpoint    hexutil.Bytes    `json:"checkpoint"`
+	Calldata      hexutil.Bytes    `json:"calldata"`
+}
+
+type logicCallCase struct {
+	TransferAmounts        []*hexutil.Big   `json:"transfer_amounts"`
+	TransferTokenContracts []common.Address `json:"transfer_token_contracts"`
+	FeeAmounts             []*hexutil.Big   `json:"fee_amounts"`
+	FeeTokenContracts      []common.Address `json:"fee_token_contracts"`
+	LogicContractAddress   common.Address   `json:"logic_contract_address"`
+	Payload                hexutil.Bytes    `json:"payload"`
+	TimeOut                *hexutil.Big     `json:"time_out"`
+	InvalidationID         hexutil.Bytes    `json:"invalidation_id"`
+	InvalidationNonce      *hexutil.Big     `json:"invalidation_nonce"`
+	Checkpoint             hexutil.Bytes    `json:"checkpoint"`
+	Calldata               hexutil.Bytes    `json:"calldata"`
+}
+
+func hexBigs(vs []*big.Int) []*hexutil.Big {
+	out := make([]*hexutil.Big, len(vs))
+	for i, v := range vs {
+		out[i] = (*hexutil.Big)(v)
+	}
+	return out
+}
+
+func newValsetVector(args ValsetArgs) valsetVector {
+	return valsetVector{
+		Validators:   args.Validators,
+		Powers:       hexBigs(args.Powers),
+		ValsetNonce:  (*hexutil.Big)(args.Nonce),
+		RewardAmount: (*hexutil.Big)(args.RewardAmount),
+		RewardToken:  args.RewardToken,
+	}
+}
+
+func randAddresses(r *rand.Rand, n int) []common.Address {
+	out := make([]common.Address, n)
+	for i := range out {
+		r.Read(out[i][:])
+	}
+	return out
+}
+
+// randUints returns integers of up to 256 bits, favouring the boundaries of the words
+func randUints(r *rand.Rand, n int) []*big.Int {
+	out := make([]*big.Int, n)
+	for i := range out {
+		switch r.Intn(4) {
+		case 0:
+			out[i] = big.NewInt(0)
+		case 1:
+			out[i] = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+		default:
+			out[i] = new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(r.Intn(256)+1)))
+		}
+	}
+	return out
+}
+
+func randUint(r *rand.Rand) *big.Int {
+	return randUints(r, 1)[0]
+}
+
+func randValset(r *rand.Rand) ValsetArgs {
+	n := r.Intn(8)
+	powers := make([]*big.Int, n)
+	for i := range powers {
+		powers[i] = big.NewInt(r.Int63n(1 << 32))
+	}
+	return ValsetArgs{
+		Validators:   randAddresses(r, n),
+		Powers:       powers,
+		Nonce:        randUint(r),
+		RewardAmount: randUint(r),
+		RewardToken:  randAddresses(r, 1)[0],
+	}
+}
+
+func randCalldataVectors(t *testing.T, r *rand.Rand, n int) calldataVectors {
+	var gravityID [32]byte
+	copy(gravityID[:], "calldata-vectors")
+
+	current := randValset(r)
+	current.Validators = append(current.Validators, randAddresses(r, 1)...)
+	current.Powers = append(current.Powers, big.NewInt(1<<32))
+	vectors := calldataVectors{
+		GravityID:     gravityID[:],
+		CurrentValset: newValsetVector(current),
+	}
+	sigs := make([]ValSignature, len(current.Validators))
+	for i := range sigs {
+		// some of the signers don't sign
+		sig := make([]byte, r.Intn(2)*65)
+		if len(sig) > 0 {
+			r.Read(sig)
+			sig[64] = byte(r.Intn(2))
+		}
+		vectors.Signatures = append(vectors.Signatures, sig)
+		sigs[i] = NewValSignature(sig)
+	}
+
+	for i := 0; i < n; i++ {
+		valset := randValset(r)
+		bz, err := UpdateValset(valset, current, sigs)
+		require.NoError(t, err)
+		vectors.Valsets = append(vectors.Valsets, valsetCase{
+			Valset:     newValsetVector(valset),
+			Checkpoint: ValsetCheckpoint(gravityID, valset),
+			Calldata:   bz,
+		})
+
+		m := r.Intn(6)
+		batch := BatchArgs{
+			Amounts:       randUints(r, m),
+			Destinations:  randAddresses(r, m),
+			Fees:          randUints(r, m),
+			BatchNonce:    randUint(r),
+			TokenContract: randAddresses(r, 1)[0],
+			BatchTimeout:  randUint(r),
+		}
+		bz, err = SubmitBatch(current, sigs, batch)
+		require.NoError(t, err)
+		vectors.Batches = append(vectors.Batches, batchCase{
+			Amounts:       hexBigs(batch.Amounts),
+			Destinations:  batch.Destinations,
+			Fees:          hexBigs(batch.Fees),
+			BatchNonce:    (*hexutil.Big)(batch.BatchNonce),
+			TokenContract: batch.TokenContract,
+			BatchTimeout:  (*hexutil.Big)(batch.BatchTimeout),
+			Checkpoint:    BatchCheckpoint(gravityID, batch),
+			Calldata:      bz,
+		})
+
+		m = r.Intn(6)
+		erc1155Batch := ERC1155BatchArgs{
+			Destinations:  randAddresses(r, m),
+			IDs:           randUints(r, m),
+			Amounts:       randUints(r, m),
+			BatchNonce:    randUint(r),
+			TokenContract: randAddresses(r, 1)[0],
+			BatchTimeout:  randUint(r),
+		}
+		bz, err = SubmitERC1155Batch(current, sigs, erc1155Batch)
+		require.NoError(t, err)
+		vectors.ERC1155Batches = append(vectors.ERC1155Batches, erc1155BatchCase{
+			Destinations:  erc1155Batch.Destinations,
+			IDs:           hexBigs(erc1155Batch.IDs),
+			Amounts:       hexBigs(erc1155Batch.Amounts),
+			BatchNonce:    (*hexutil.Big)(erc1155Batch.BatchNonce),
+			TokenContract: erc1155Batch.TokenContract,
+			BatchTimeout:  (*hexutil.Big)(erc1155Batch.BatchTimeout),
+			Checkpoint:    ERC1155BatchCheckpoint(gravityID, erc1155Batch),
+			Calldata:      bz,
+		})
+
+		transfers, fees := r.Intn(4), r.Intn(4)
+		call := LogicCallArgs{
+			TransferAmounts:        randUints(r, transfers),
+			TransferTokenContracts: randAddresses(r, transfers),
+			FeeAmounts:             randUints(r, fees),
+			FeeTokenContracts:      randAddresses(r, fees),
+			LogicContractAddress:   randAddresses(r, 1)[0],
+			Payload:                make([]byte, r.Intn(100)),
+			TimeOut:                randUint(r),
+			InvalidationNonce:      randUint(r),
+		}
+		r.Read(call.Payload)
+		r.Read(call.InvalidationId[:])
+		bz, err = SubmitLogicCall(current, sigs, call)
+		require.NoError(t, err)
+		vectors.LogicCalls = append(vectors.LogicCalls, logicCallCase{
+			TransferAmounts:        hexBigs(call.TransferAmounts),
+			TransferTokenContracts: call.TransferTokenContracts,
+			FeeAmounts:             hexBigs(call.FeeAmounts),
+			FeeTokenContracts:      call.FeeTokenContracts,
+			LogicContractAddress:   call.LogicContractAddress,
+			Payload:                call.Payload,
+			TimeOut:                (*hexutil.Big)(call.TimeOut),
+			InvalidationID:         call.InvalidationId[:],
+			InvalidationNonce:      (*hexutil.Big)(call.InvalidationNonce),
+			Checkpoint:             LogicCallCheckpoint(gravityID, call),
+			Calldata:               bz,
+		})
+	}
+	return vectors
+}
+
+// TestCalldataVectors checks the checkpoints and calldata against the golden vectors, run
+// it with -update-calldata-vectors to rewrite them
+func TestCalldataVectors(t *testing.T) {
+	vectors := randCalldataVectors(t, rand.New(rand.NewSource(calldataVectorsSeed)), calldataVectorsN)
+
+	bz, err := json.MarshalIndent(vectors, "", "  ")
+	require.NoError(t, err)
+	bz = append(bz, '\n')
+
+	if *updateCalldataVectors {
+		require.NoError(t, os.MkdirAll(filepath.Dir(calldataVectorsFile), 0o755))
+		require.NoError(t, os.WriteFile(calldataVectorsFile, bz, 0o644))
+	}
+
+	expected, err := os.ReadFile(calldataVectorsFile)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(bz))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -17,52 +18,96 @@ import (
 // clients listening to the chain and creating transactions
 // based on the events (i.e. orchestrators)
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
-	cleanupTimedOutBatchTxs(ctx, k)
-	cleanupTimedOutContractCallTxs(ctx, k)
-	createSignerSetTxs(ctx, k)
-	createBatchTxs(ctx, k)
-	pruneSignerSetTxs(ctx, k)
+	profileSection(ctx, profileSectionScheduledParams, func() { k.ApplyScheduledParamsUpdate(ctx) })
+	for _, chain := range k.GetEVMChains(ctx) {
+		chainID := chain.ChainId
+		profileChainSection(ctx, profileSectionTimeouts, chainID, func() {
+			cleanupTimedOutBatchTxs(ctx, k, chainID)
+			cleanupTimedOutContractCallTxs(ctx, k, chainID)
+			cleanupTimedOutERC1155BatchTxs(ctx, k, chainID)
+		})
+		profileChainSection(ctx, profileSectionSignerSetTxs, chainID, func() { createSignerSetTxs(ctx, k, chainID) })
+		profileChainSection(ctx, profileSectionBatchCreation, chainID, func() {
+			createBatchTxs(ctx, k, chainID)
+			createERC1155BatchTxs(ctx, k, chainID)
+		})
+		profileChainSection(ctx, profileSectionSignerSetPruning, chainID, func() { pruneSignerSetTxs(ctx, k, chainID) })
+	}
 }
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
-	outgoingTxSlashing(ctx, k)
-	eventVoteRecordTally(ctx, k)
-	updateObservedEthereumHeight(ctx, k)
+	// voters flagged by the staking hooks are updated before the chains tally their votes
+	profileSection(ctx, profileSectionVoterPowers, func() { k.UpdateVoterPowers(ctx) })
+	for _, chain := range k.GetEVMChains(ctx) {
+		chainID := chain.ChainId
+		profileChainSection(ctx, profileSectionSlashing, chainID, func() { outgoingTxSlashing(ctx, k, chainID) })
+		profileChainSection(ctx, profileSectionEventVoteTally, chainID, func() { eventVoteRecordTally(ctx, k, chainID) })
+		profileChainSection(ctx, profileSectionObservedHeight, chainID, func() { updateObservedEthereumHeight(ctx, k, chainID) })
+		profileChainSection(ctx, profileSectionContractMigration, chainID, func() { k.CompleteContractMigration(ctx, chainID) })
+		profileChainSection(ctx, profileSectionGravityIDRotation, chainID, func() { k.PruneGravityIDRotation(ctx, chainID) })
+	}
+	profileSection(ctx, profileSectionRelayerIncentives, func() { k.DisburseRelayerIncentives(ctx) })
+	profileSection(ctx, profileSectionBridgeReport, func() { k.CloseBridgeReport(ctx) })
+	profileSection(ctx, profileSectionBlockSummary, func() { k.EmitBlockSummary(ctx) })
+	profileSection(ctx, profileSectionBridgeState, func() { k.CommitBridgeState(ctx) })
 }
 
-func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
+// createBatchTxs batches the pooled transfers of each ERC20 token every 10 blocks. The
+// tokens and the depth of the pool are read from the pool aggregates, so that the cost of
+// batching doesn't grow with the pool.
+func createBatchTxs(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
 	// TODO: this needs some more work, is super naieve
 	if ctx.BlockHeight()%10 == 0 {
-		cm := map[string]bool{}
-		k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
-			cm[ste.Erc20Token.Contract] = true
+		var (
+			contracts []common.Address
+			depth     uint64
+		)
+		k.IteratePoolAggregates(ctx, chainID, func(contract common.Address, aggregate types.PoolAggregate) bool {
+			contracts = append(contracts, contract)
+			depth += aggregate.Count
 			return false
 		})
+		types.SetMetricGauge(types.MetricKeyPoolDepth, chainID, float32(depth), telemetry.NewLabel(types.MetricLabelKind, types.MetricKindERC20))
 
-		var contracts []string
-		for k := range cm {
-			contracts = append(contracts, k)
+		for _, c := range contracts {
+			// NOTE: this doesn't emit events which would be helpful for client processes
+			k.CreateBatchTx(ctx, chainID, c, keeper.BatchTxSize)
 		}
-		sort.Strings(contracts)
+	}
+}
+
+// createERC1155BatchTxs batches the pooled ERC1155 transfers of each token, on the same
+// schedule as createBatchTxs
+func createERC1155BatchTxs(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
+	if ctx.BlockHeight()%10 == 0 {
+		var (
+			contracts []common.Address
+			depth     uint64
+		)
+		k.IterateERC1155PoolAggregates(ctx, chainID, func(contract common.Address, aggregate types.PoolAggregate) bool {
+			contracts = append(contracts, contract)
+			depth += aggregate.Count
+			return false
+		})
+		types.SetMetricGauge(types.MetricKeyPoolDepth, chainID, float32(depth), telemetry.NewLabel(types.MetricLabelKind, types.MetricKindERC1155))
 
 		for _, c := range contracts {
-			// NOTE: this doesn't emit events which would be helpful for client processes
-			k.CreateBatchTx(ctx, common.HexToAddress(c), 100)
+			k.CreateERC1155BatchTx(ctx, chainID, c, keeper.BatchTxSize)
 		}
 	}
 }
 
-func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
+func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
 	// Auto signerset tx creation.
 	// 1. If there are no signer set requests, create a new one.
 	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an ethereum signature to a new signer set tx
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
 	// 3. If power change between validators of Current signer set and latest signer set request is > 5%
-	latestSignerSetTx := k.GetLatestSignerSetTx(ctx)
+	latestSignerSetTx := k.GetLatestSignerSetTx(ctx, chainID)
 	if latestSignerSetTx == nil {
-		k.CreateSignerSetTx(ctx)
+		k.CreateSignerSetTx(ctx, chainID)
 		return
 	}
 
@@ -81,11 +126,11 @@ func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	)
 
 	if shouldCreate {
-		k.CreateSignerSetTx(ctx)
+		k.CreateSignerSetTx(ctx, chainID)
 	}
 }
 
-func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
+func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
 	params := k.GetParams(ctx)
 	// Validator set pruning
 	// prune all validator sets with a nonce less than the
@@ -93,14 +138,14 @@ func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	//
 	// Only prune valsets after the signed valsets window has passed
 	// so that slashing can occur the block before we remove them
-	lastObserved := k.GetLastObservedSignerSetTx(ctx)
+	lastObserved := k.GetLastObservedSignerSetTx(ctx, chainID)
 	currentBlock := uint64(ctx.BlockHeight())
 	tooEarly := currentBlock < params.SignedSignerSetTxsWindow
 	if lastObserved != nil && !tooEarly {
 		earliestToPrune := currentBlock - params.SignedSignerSetTxsWindow
-		for _, set := range k.GetSignerSetTxs(ctx) {
+		for _, set := range k.GetSignerSetTxs(ctx, chainID) {
 			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune {
-				k.DeleteOutgoingTx(ctx, set.GetStoreIndex())
+				k.DeleteOutgoingTx(ctx, chainID, set.GetStoreIndex())
 			}
 		}
 	}
@@ -109,8 +154,8 @@ func pruneSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 // Iterate over all attestations currently being voted on in order of nonce and
 // "Observe" those who have passed the threshold. Break the loop once we see
 // an attestation that has not passed the threshold
-func eventVoteRecordTally(ctx sdk.Context, k keeper.Keeper) {
-	attmap := k.GetEthereumEventVoteRecordMapping(ctx)
+func eventVoteRecordTally(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
+	attmap := k.GetEthereumEventVoteRecordMapping(ctx, chainID)
 
 	// We make a slice with all the event nonces that are in the attestation mapping
 	keys := make([]uint64, 0, len(attmap))
@@ -144,11 +189,20 @@ func eventVoteRecordTally(ctx sdk.Context, k keeper.Keeper) {
 			// we skip the other attestations and move on to the next nonce again.
 			// If no attestation becomes observed, when we get to the next nonce, every attestation in
 			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryEventVoteRecord(ctx, att)
+			if nonce == uint64(k.GetLastObservedEventNonce(ctx, chainID))+1 {
+				k.TryEventVoteRecord(ctx, chainID, att)
 			}
 		}
 	}
+
+	// the attestation lag, the number of nonces voted for but not yet observed
+	lag := uint64(0)
+	if len(keys) > 0 {
+		if lastObserved := k.GetLastObservedEventNonce(ctx, chainID); keys[len(keys)-1] > lastObserved {
+			lag = keys[len(keys)-1] - lastObserved
+		}
+	}
+	types.SetMetricGauge(types.MetricKeyEventNonceLag, chainID, float32(lag))
 }
 
 // Periodically, every orchestrator will submit their latest observed Ethereum and Cosmos heights in
@@ -160,7 +214,7 @@ func eventVoteRecordTally(ctx sdk.Context, k keeper.Keeper) {
 //     The highest height that meets this criteria will be the proposed height.
 //  2. The proposed consensus heights from this process are greater than the values stored from the last time
 //     we observed an Ethereum event from the bridge
-func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
+func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
 	// wait some minutes before checking the height votes
 	if ctx.BlockHeight()%50 != 0 {
 		return
@@ -172,7 +226,7 @@ func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
 	requiredPower := types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx))
 
 	// populate the list
-	k.IterateEthereumHeightVotes(ctx, func(valAddres sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
+	k.IterateEthereumHeightVotes(ctx, chainID, func(valAddres sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
 		if _, ok := ethereumHeightPowers[height.EthereumHeight]; !ok {
 			ethereumHeightPowers[height.EthereumHeight] = sdk.NewInt(0)
 		}
@@ -185,7 +239,7 @@ func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
 	})
 
 	// vote on acceptable height values (less than or equal to the validator's observed value)
-	k.IterateEthereumHeightVotes(ctx, func(valAddress sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
+	k.IterateEthereumHeightVotes(ctx, chainID, func(valAddress sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
 		validatorPower := sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, valAddress))
 
 		for ethereumVoteHeight, ethereumPower := range ethereumHeightPowers {
@@ -219,9 +273,9 @@ func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
 		}
 	}
 
-	lastObservedHeights := k.GetLastObservedEthereumBlockHeight(ctx)
+	lastObservedHeights := k.GetLastObservedEthereumBlockHeight(ctx, chainID)
 	if ethereumHeight > lastObservedHeights.EthereumHeight && cosmosHeight > lastObservedHeights.CosmosHeight {
-		k.SetLastObservedEthereumBlockHeightWithCosmos(ctx, ethereumHeight, cosmosHeight)
+		k.SetLastObservedEthereumBlockHeightWithCosmos(ctx, chainID, ethereumHeight, cosmosHeight)
 	}
 }
 
@@ -236,20 +290,37 @@ func updateObservedEthereumHeight(ctx sdk.Context, k keeper.Keeper) {
 //	here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
 //	project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
 //	AND any deposit or withdraw has occurred to update the Ethereum block height.
-func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
-	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
+	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx, chainID).EthereumHeight
+	k.IterateOutgoingTxsByType(ctx, chainID, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
 		btx, _ := otx.(*types.BatchTx)
 
 		if btx.Timeout < ethereumHeight {
-			k.CancelBatchTx(ctx, btx)
+			k.CancelBatchTx(ctx, chainID, btx)
+			k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.TimedOutOutgoingTxs++ })
 		}
 
 		return false
 	})
 }
 
-// cleanupTimedOutContractCallTxs deletes logic calls that have passed their expiration on Ethereum
+// cleanupTimedOutERC1155BatchTxs returns the transfers of ERC1155 batches that have
+// passed their expiration on Ethereum to the pool, the same caveats as for
+// cleanupTimedOutBatchTxs apply
+func cleanupTimedOutERC1155BatchTxs(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
+	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx, chainID).EthereumHeight
+	k.IterateOutgoingTxsByType(ctx, chainID, types.ERC1155BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		btx, _ := otx.(*types.ERC1155BatchTx)
+		if btx.Timeout < ethereumHeight {
+			k.CancelERC1155BatchTx(ctx, chainID, btx)
+			k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.TimedOutOutgoingTxs++ })
+		}
+		return false
+	})
+}
+
+// cleanupTimedOutContractCallTxs deletes logic calls that have passed their expiration on Ethereum,
+// refunding those made with the tokens of an account
 // keep in mind several things when modifying this function
 // A) unlike nonces timeouts are not monotonically increasing, meaning call 5 can have a later timeout than batch 6
 //
@@ -261,18 +332,19 @@ func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 //	here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
 //	project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
 //	AND any deposit or withdraw has occurred to update the Ethereum block height.
-func cleanupTimedOutContractCallTxs(ctx sdk.Context, k keeper.Keeper) {
-	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
-	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+func cleanupTimedOutContractCallTxs(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
+	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx, chainID).EthereumHeight
+	k.IterateOutgoingTxsByType(ctx, chainID, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		cctx, _ := otx.(*types.ContractCallTx)
 		if cctx.Timeout < ethereumHeight {
-			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+			k.CancelContractCallTx(ctx, chainID, cctx)
+			k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.TimedOutOutgoingTxs++ })
 		}
 		return true
 	})
 }
 
-func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
+func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper, chainID uint64) {
 	params := k.GetParams(ctx)
 	maxHeight := uint64(0)
 	if uint64(ctx.BlockHeight()) > params.SignedBatchesWindow {
@@ -281,7 +353,7 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
-	usotxs := k.GetUnSlashedOutgoingTxs(ctx, maxHeight)
+	usotxs := k.GetUnSlashedOutgoingTxs(ctx, chainID, maxHeight)
 	if len(usotxs) == 0 {
 		return
 	}
@@ -337,7 +409,7 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 
 	for _, otx := range usotxs {
 		// SLASH BONDED VALIDATORS who didn't sign batch txs
-		signatures := k.GetEthereumSignatures(ctx, otx.GetStoreIndex())
+		signatures := k.GetEthereumSignatures(ctx, chainID, otx.GetStoreIndex())
 		for _, valInfo := range valInfos {
 			// Don't slash validators who joined after outgoingtx is created
 			if valInfo.exist && valInfo.sigs.StartHeight < int64(otx.GetCosmosHeight()) {
@@ -352,6 +424,8 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 							params.SlashFractionBatch,
 						)
 						k.StakingKeeper.Jail(ctx, valInfo.cons)
+						k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.ValidatorsSlashed++ })
+						types.IncrMetricCounter(types.MetricKeyValidatorsSlashed, chainID)
 
 						ctx.EventManager().EmitEvent(
 							sdk.NewEvent(
@@ -387,6 +461,8 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 								params.SlashFractionSignerSetTx,
 							)
 							k.StakingKeeper.Jail(ctx, valInfo.cons)
+							k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.ValidatorsSlashed++ })
+							types.IncrMetricCounter(types.MetricKeyValidatorsSlashed, chainID)
 
 							ctx.EventManager().EmitEvent(
 								sdk.NewEvent(
@@ -404,6 +480,6 @@ func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 		}
 
 		// then we set the latest slashed outgoing tx block
-		k.SetLastSlashedOutgoingTxBlockHeight(ctx, otx.GetCosmosHeight())
+		k.SetLastSlashedOutgoingTxBlockHeight(ctx, chainID, otx.GetCosmosHeight())
 	}
 }
@@ -1,7 +1,9 @@
 package gravity_test
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"sort"
 	"testing"
 	"time"
 
@@ -22,17 +24,17 @@ func TestSignerSetTxCreationIfNotAvailable(t *testing.T) {
 
 	// BeginBlocker should set a new validator set if not available
 	gravity.BeginBlocker(ctx, gravityKeeper)
-	otx := gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(1))
+	otx := gravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeSignerSetTxKey(1))
 	require.NotNil(t, otx)
 	_, ok := otx.(*types.SignerSetTx)
 	require.True(t, ok)
-	require.True(t, len(gravityKeeper.GetSignerSetTxs(ctx)) == 1)
+	require.True(t, len(gravityKeeper.GetSignerSetTxs(ctx, keeper.TestingGravityParams.BridgeChainId)) == 1)
 }
 
 func TestSignerSetTxCreationUponUnbonding(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gravityKeeper := input.GravityKeeper
-	gravityKeeper.CreateSignerSetTx(ctx)
+	gravityKeeper.CreateSignerSetTx(ctx, keeper.TestingGravityParams.BridgeChainId)
 
 	input.Context = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 	// begin unbonding
@@ -44,7 +46,7 @@ func TestSignerSetTxCreationUponUnbonding(t *testing.T) {
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 	gravity.BeginBlocker(input.Context, gravityKeeper)
 
-	require.EqualValues(t, 2, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+	require.EqualValues(t, 2, gravityKeeper.GetLatestSignerSetTxNonce(ctx, keeper.TestingGravityParams.BridgeChainId))
 }
 
 func TestSignerSetTxSlashing_SignerSetTxCreated_Before_ValidatorBonded(t *testing.T) {
@@ -54,10 +56,10 @@ func TestSignerSetTxSlashing_SignerSetTxCreated_Before_ValidatorBonded(t *testin
 	pk := input.GravityKeeper
 	params := input.GravityKeeper.GetParams(ctx)
 
-	signerSet := pk.CreateSignerSetTx(ctx)
+	signerSet := pk.CreateSignerSetTx(ctx, keeper.TestingGravityParams.BridgeChainId)
 	height := uint64(ctx.BlockHeight()) - (params.SignedSignerSetTxsWindow + 1)
 	signerSet.Height = height
-	pk.SetOutgoingTx(ctx, signerSet)
+	pk.SetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, signerSet)
 
 	gravity.EndBlocker(ctx, pk)
 
@@ -74,16 +76,16 @@ func TestSignerSetTxSlashing_SignerSetTxCreated_After_ValidatorBonded(t *testing
 	params := input.GravityKeeper.GetParams(ctx)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedSignerSetTxsWindow) + 2)
-	signerSet := pk.CreateSignerSetTx(ctx)
+	signerSet := pk.CreateSignerSetTx(ctx, keeper.TestingGravityParams.BridgeChainId)
 	height := uint64(ctx.BlockHeight()) - (params.SignedSignerSetTxsWindow + 1)
 	signerSet.Height = height
-	pk.SetOutgoingTx(ctx, signerSet)
+	pk.SetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, signerSet)
 
 	for i, val := range keeper.ValAddrs {
 		if i == 0 {
 			continue
 		}
-		pk.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{signerSet.Nonce, keeper.AccAddrs[i].String(), []byte("dummysig")}, val)
+		pk.SetEthereumSignature(ctx, keeper.TestingGravityParams.BridgeChainId, &types.SignerSetTxConfirmation{signerSet.Nonce, keeper.AccAddrs[i].String(), []byte("dummysig")}, val)
 	}
 
 	gravity.EndBlocker(ctx, pk)
@@ -122,10 +124,10 @@ func TestSignerSetTxSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testi
 
 	// Create signer set tx request
 	ctx = ctx.WithBlockHeight(signerSetTxHeight)
-	vs := gravityKeeper.CreateSignerSetTx(ctx)
+	vs := gravityKeeper.CreateSignerSetTx(ctx, keeper.TestingGravityParams.BridgeChainId)
 	vs.Height = uint64(signerSetTxHeight)
 	vs.Nonce = uint64(signerSetTxHeight)
-	gravityKeeper.SetOutgoingTx(ctx, vs)
+	gravityKeeper.SetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, vs)
 
 	// Start Unbonding validators
 	// Validator-1  Unbond slash window is not expired. if not attested, slash
@@ -142,7 +144,7 @@ func TestSignerSetTxSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testi
 			// don't sign with first validator
 			continue
 		}
-		gravityKeeper.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{vs.Nonce, keeper.EthAddrs[i].Hex(), []byte("dummySig")}, val)
+		gravityKeeper.SetEthereumSignature(ctx, keeper.TestingGravityParams.BridgeChainId, &types.SignerSetTxConfirmation{vs.Nonce, keeper.EthAddrs[i].Hex(), []byte("dummySig")}, val)
 	}
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 
@@ -175,7 +177,7 @@ func TestBatchSlashing(t *testing.T) {
 		TokenContract: keeper.TokenContractAddrs[0],
 		Height:        uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
 	}
-	gravityKeeper.SetOutgoingTx(ctx, batch)
+	gravityKeeper.SetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, batch)
 
 	for i, val := range keeper.ValAddrs {
 		if i == 0 {
@@ -190,7 +192,7 @@ func TestBatchSlashing(t *testing.T) {
 			input.SlashingKeeper.SetValidatorSigningInfo(ctx, valConsAddr, valSigningInfo)
 			continue
 		}
-		gravityKeeper.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+		gravityKeeper.SetEthereumSignature(ctx, keeper.TestingGravityParams.BridgeChainId, &types.BatchTxConfirmation{
 			BatchNonce:     batch.BatchNonce,
 			TokenContract:  keeper.TokenContractAddrs[0],
 			EthereumSigner: keeper.EthAddrs[i].String(),
@@ -207,7 +209,7 @@ func TestBatchSlashing(t *testing.T) {
 	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).IsJailed())
 
 	// Ensure that the last slashed signer set tx nonce is set properly
-	require.Equal(t, input.GravityKeeper.GetLastSlashedOutgoingTxBlockHeight(ctx), batch.Height)
+	require.Equal(t, input.GravityKeeper.GetLastSlashedOutgoingTxBlockHeight(ctx, keeper.TestingGravityParams.BridgeChainId), batch.Height)
 }
 
 func TestSignerSetTxEmission(t *testing.T) {
@@ -215,23 +217,23 @@ func TestSignerSetTxEmission(t *testing.T) {
 	gravityKeeper := input.GravityKeeper
 
 	// Store a validator set with a power change as the most recent validator set
-	sstx := gravityKeeper.CreateSignerSetTx(ctx)
+	sstx := gravityKeeper.CreateSignerSetTx(ctx, keeper.TestingGravityParams.BridgeChainId)
 	delta := float64(types.EthereumSigners(sstx.Signers).TotalPower()) * 0.05
 	sstx.Signers[0].Power = uint64(float64(sstx.Signers[0].Power) - delta/2)
 	sstx.Signers[1].Power = uint64(float64(sstx.Signers[1].Power) + delta/2)
-	gravityKeeper.SetOutgoingTx(ctx, sstx)
+	gravityKeeper.SetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, sstx)
 
 	// BeginBlocker should set a new validator set
 	gravity.BeginBlocker(ctx, gravityKeeper)
-	require.NotNil(t, gravityKeeper.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(2)))
-	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
+	require.NotNil(t, gravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeSignerSetTxKey(2)))
+	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx, keeper.TestingGravityParams.BridgeChainId)))
 }
 
 func TestSignerSetTxSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gk := input.GravityKeeper
-	gk.CreateSignerSetTx(ctx)
-	require.EqualValues(t, 1, len(gk.GetSignerSetTxs(ctx)))
+	gk.CreateSignerSetTx(ctx, keeper.TestingGravityParams.BridgeChainId)
+	require.EqualValues(t, 1, len(gk.GetSignerSetTxs(ctx, keeper.TestingGravityParams.BridgeChainId)))
 }
 
 // Test batch timeout
@@ -263,47 +265,47 @@ func TestBatchTxTimeout(t *testing.T) {
 	ctx = ctx.WithBlockTime(now).WithBlockHeight(250)
 
 	// check that we can make a batch without first setting an ethereum block height
-	b1 := gravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	b1 := gravityKeeper.CreateBatchTx(ctx, keeper.TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 	require.Equal(t, b1.Timeout, uint64(0))
 
-	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 500)
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, keeper.TestingGravityParams.BridgeChainId, 500)
 
-	b2 := gravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	b2 := gravityKeeper.CreateBatchTx(ctx, keeper.TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 	// this is exactly block 500 plus twelve hours
 	require.Equal(t, b2.Timeout, uint64(504))
 
 	// make sure the batches got stored in the first place
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
+	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
 	require.NotNil(t, gotFirstBatch)
-	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
+	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
 	require.NotNil(t, gotSecondBatch)
 
 	// when, way into the future
 	ctx = ctx.WithBlockTime(now).WithBlockHeight(9)
 
-	b3 := gravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	b3 := gravityKeeper.CreateBatchTx(ctx, keeper.TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 
 	gravity.BeginBlocker(ctx, gravityKeeper)
 
 	// this had a timeout of zero should be deleted.
-	gotFirstBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
+	gotFirstBatch = input.GravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
 	require.Nil(t, gotFirstBatch)
 	// make sure the end blocker does not delete these, as the block height has not officially
 	// been updated by a relay event
-	gotSecondBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
+	gotSecondBatch = input.GravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
 	require.NotNil(t, gotSecondBatch)
-	gotThirdBatch := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b3.TokenContract), b3.BatchNonce))
+	gotThirdBatch := input.GravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeBatchTxKey(common.HexToAddress(b3.TokenContract), b3.BatchNonce))
 	require.NotNil(t, gotThirdBatch)
 
-	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 5000)
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, keeper.TestingGravityParams.BridgeChainId, 5000)
 	gravity.BeginBlocker(ctx, gravityKeeper)
 
 	// make sure the end blocker does delete these, as we've got a new Ethereum block height
-	gotFirstBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
+	gotFirstBatch = input.GravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeBatchTxKey(common.HexToAddress(b1.TokenContract), b1.BatchNonce))
 	require.Nil(t, gotFirstBatch)
-	gotSecondBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
+	gotSecondBatch = input.GravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeBatchTxKey(common.HexToAddress(b2.TokenContract), b2.BatchNonce))
 	require.Nil(t, gotSecondBatch)
-	gotThirdBatch = input.GravityKeeper.GetOutgoingTx(ctx, types.MakeBatchTxKey(common.HexToAddress(b3.TokenContract), b3.BatchNonce))
+	gotThirdBatch = input.GravityKeeper.GetOutgoingTx(ctx, keeper.TestingGravityParams.BridgeChainId, types.MakeBatchTxKey(common.HexToAddress(b3.TokenContract), b3.BatchNonce))
 	require.NotNil(t, gotThirdBatch)
 }
 
@@ -311,37 +313,37 @@ func TestUpdateObservedEthereumHeight(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gravityKeeper := input.GravityKeeper
 
-	gravityKeeper.SetLastObservedEthereumBlockHeightWithCosmos(ctx, 2, 5)
+	gravityKeeper.SetLastObservedEthereumBlockHeightWithCosmos(ctx, keeper.TestingGravityParams.BridgeChainId, 2, 5)
 
 	// update runs on mod 50 block heights, no votes have been sent so it
 	// shoudl leave the set values alone
 	ctx = ctx.WithBlockHeight(50)
 	gravity.EndBlocker(ctx, gravityKeeper)
 
-	lastHeight := gravityKeeper.GetLastObservedEthereumBlockHeight(ctx)
+	lastHeight := gravityKeeper.GetLastObservedEthereumBlockHeight(ctx, keeper.TestingGravityParams.BridgeChainId)
 	require.Equal(t, lastHeight.EthereumHeight, uint64(2))
 	require.Equal(t, lastHeight.CosmosHeight, uint64(5))
 
 	ctx = ctx.WithBlockHeight(3)
-	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.ValAddrs[0], 10)
+	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.TestingGravityParams.BridgeChainId, keeper.ValAddrs[0], 10)
 
 	ctx = ctx.WithBlockHeight(33)
-	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.ValAddrs[1], 20)
+	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.TestingGravityParams.BridgeChainId, keeper.ValAddrs[1], 20)
 
 	ctx = ctx.WithBlockHeight(63)
-	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.ValAddrs[2], 30)
+	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.TestingGravityParams.BridgeChainId, keeper.ValAddrs[2], 30)
 
 	ctx = ctx.WithBlockHeight(93)
-	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.ValAddrs[3], 40)
+	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.TestingGravityParams.BridgeChainId, keeper.ValAddrs[3], 40)
 
 	ctx = ctx.WithBlockHeight(123)
-	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.ValAddrs[4], 50)
+	input.GravityKeeper.SetEthereumHeightVote(ctx, keeper.TestingGravityParams.BridgeChainId, keeper.ValAddrs[4], 50)
 
 	// run endblocker on a non-mod 50 block to ensure the update isn't being
 	// called and changing the set values
 	gravity.EndBlocker(ctx, gravityKeeper)
 
-	lastHeight = gravityKeeper.GetLastObservedEthereumBlockHeight(ctx)
+	lastHeight = gravityKeeper.GetLastObservedEthereumBlockHeight(ctx, keeper.TestingGravityParams.BridgeChainId)
 	require.Equal(t, lastHeight.EthereumHeight, uint64(2))
 	require.Equal(t, lastHeight.CosmosHeight, uint64(5))
 
@@ -353,11 +355,69 @@ func TestUpdateObservedEthereumHeight(t *testing.T) {
 	ctx = ctx.WithBlockHeight(150)
 	gravity.EndBlocker(ctx, gravityKeeper)
 
-	lastHeight = gravityKeeper.GetLastObservedEthereumBlockHeight(ctx)
+	lastHeight = gravityKeeper.GetLastObservedEthereumBlockHeight(ctx, keeper.TestingGravityParams.BridgeChainId)
 	require.Equal(t, lastHeight.EthereumHeight, uint64(20))
 	require.Equal(t, lastHeight.CosmosHeight, uint64(33))
 }
 
+// FuzzUpdateObservedEthereumHeight checks that the heights observed from the height votes,
+// which are tallied through maps, are the highest heights a consensus of validators voted at
+// or above, and that the blockers write the same state each time they run on the votes
+func FuzzUpdateObservedEthereumHeight(f *testing.F) {
+	f.Add([]byte{10, 20, 30, 40, 50}, []byte{3, 33, 63, 93, 123})
+	f.Add([]byte{7, 7, 7, 7, 7}, []byte{1, 2, 3, 4, 5})
+	f.Add([]byte{200, 1, 90, 90, 3}, []byte{40, 40, 2, 255, 9})
+	f.Fuzz(func(t *testing.T, ethereumHeights, cosmosHeights []byte) {
+		if len(ethereumHeights) < len(keeper.ValAddrs) || len(cosmosHeights) < len(keeper.ValAddrs) {
+			t.Skip()
+		}
+		input, ctx := keeper.SetupFiveValChain(t)
+		chainID := keeper.TestingGravityParams.BridgeChainId
+
+		var votedEthereum, votedCosmos []uint64
+		for i, val := range keeper.ValAddrs {
+			ethereumHeight, cosmosHeight := uint64(ethereumHeights[i])+1, uint64(cosmosHeights[i])+1
+			input.GravityKeeper.SetEthereumHeightVote(ctx.WithBlockHeight(int64(cosmosHeight)), chainID, val, ethereumHeight)
+			votedEthereum = append(votedEthereum, ethereumHeight)
+			votedCosmos = append(votedCosmos, cosmosHeight)
+		}
+		// with five validators of equal power, four of them make the consensus
+		consensusHeight := func(heights []uint64) uint64 {
+			sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+			return heights[3]
+		}
+
+		ctx = ctx.WithBlockHeight(300)
+		var digest []byte
+		for i := 0; i < 4; i++ {
+			branch := ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore()).WithEventManager(sdk.NewEventManager())
+			gravity.EndBlocker(branch, input.GravityKeeper)
+
+			lastHeight := input.GravityKeeper.GetLastObservedEthereumBlockHeight(branch, chainID)
+			require.Equal(t, consensusHeight(votedEthereum), lastHeight.EthereumHeight)
+			require.Equal(t, consensusHeight(votedCosmos), lastHeight.CosmosHeight)
+
+			got := storeDigest(branch, input.GravityStoreKey)
+			if digest != nil {
+				require.Equal(t, digest, got)
+			}
+			digest = got
+		}
+	})
+}
+
+// storeDigest returns the hash of the keys and values of the store
+func storeDigest(ctx sdk.Context, key sdk.StoreKey) []byte {
+	hash := sha256.New()
+	iter := ctx.KVStore(key).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		hash.Write(iter.Key())
+		hash.Write(iter.Value())
+	}
+	return hash.Sum(nil)
+}
+
 func fundAccount(ctx sdk.Context, bankKeeper types.BankKeeper, addr sdk.AccAddress, amounts sdk.Coins) error {
 	if err := bankKeeper.MintCoins(ctx, types.ModuleName, amounts); err != nil {
 		return err
@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 	"github.com/spf13/cobra"
@@ -46,7 +49,24 @@ func GetQueryCmd() *cobra.Command {
 		CmdDelegateKeysByOrchestrator(),
 		CmdDelegateKeys(),
 		CmdLastObservedEthereumHeight(),
+		CmdBridgeContract(),
+		CmdEVMChains(),
+		CmdDepositAddress(),
+		CmdERC1155BatchTxs(),
+		CmdERC1155BatchTxConfirmations(),
+		CmdUnsignedERC1155BatchTxs(),
+		CmdERC1155Token(),
+		CmdRelayerIncentives(),
+		CmdSimulateParamsChange(),
+		CmdIncidentRecords(),
+		CmdBridgeReports(),
+		CmdBridgeStateHash(),
+		CmdTransferHistory(),
+		CmdAttestationLatency(),
+		CmdRelayCalldata(),
+		CmdTypedData(),
 	)
+	gravityQueryCmd.PersistentFlags().Uint64(FlagEVMChainID, 0, "the EVM chain to query, the default chain if not set")
 
 	return gravityQueryCmd
 }
@@ -88,12 +108,17 @@ func CmdSignerSetTx() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			nonce, err := parseNonce(args[0])
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.SignerSetTx(cmd.Context(), &types.SignerSetTxRequest{SignerSetNonce: nonce})
+			res, err := queryClient.SignerSetTx(cmd.Context(), &types.SignerSetTxRequest{SignerSetNonce: nonce, EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -117,6 +142,11 @@ func CmdBatchTx() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			contractAddress, err := parseContractAddress(args[0])
 			if err != nil {
 				return nil
@@ -130,6 +160,7 @@ func CmdBatchTx() *cobra.Command {
 			res, err := queryClient.BatchTx(cmd.Context(), &types.BatchTxRequest{
 				TokenContract: contractAddress,
 				BatchNonce:    nonce,
+				EvmChainId:    evmChainID,
 			})
 
 			if err != nil {
@@ -155,6 +186,11 @@ func CmdContractCallTx() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			// TODO: validate this scope somehow
 			invalidationScope := []byte(args[0])
 
@@ -166,6 +202,7 @@ func CmdContractCallTx() *cobra.Command {
 			res, err := queryClient.ContractCallTx(cmd.Context(), &types.ContractCallTxRequest{
 				InvalidationScope: invalidationScope,
 				InvalidationNonce: invalidationNonce,
+				EvmChainId:        evmChainID,
 			})
 
 			if err != nil {
@@ -191,12 +228,17 @@ func CmdSignerSetTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.SignerSetTxs(cmd.Context(), &types.SignerSetTxsRequest{Pagination: pageReq})
+			res, err := queryClient.SignerSetTxs(cmd.Context(), &types.SignerSetTxsRequest{Pagination: pageReq, EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -221,12 +263,17 @@ func CmdBatchTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.BatchTxs(cmd.Context(), &types.BatchTxsRequest{Pagination: pageReq})
+			res, err := queryClient.BatchTxs(cmd.Context(), &types.BatchTxsRequest{Pagination: pageReq, EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -251,12 +298,17 @@ func CmdContractCallTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.ContractCallTxs(cmd.Context(), &types.ContractCallTxsRequest{Pagination: pageReq})
+			res, err := queryClient.ContractCallTxs(cmd.Context(), &types.ContractCallTxsRequest{Pagination: pageReq, EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -281,6 +333,11 @@ func CmdSignerSetTxConfirmations() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			nonce, err := parseNonce(args[0])
 			if err != nil {
 				return err
@@ -288,6 +345,7 @@ func CmdSignerSetTxConfirmations() *cobra.Command {
 
 			res, err := queryClient.SignerSetTxConfirmations(cmd.Context(), &types.SignerSetTxConfirmationsRequest{
 				SignerSetNonce: nonce,
+				EvmChainId:     evmChainID,
 			})
 			if err != nil {
 				return err
@@ -312,6 +370,11 @@ func CmdBatchTxConfirmations() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			nonce, err := parseNonce(args[0])
 			if err != nil {
 				return err
@@ -325,6 +388,7 @@ func CmdBatchTxConfirmations() *cobra.Command {
 			res, err := queryClient.BatchTxConfirmations(cmd.Context(), &types.BatchTxConfirmationsRequest{
 				BatchNonce:    nonce,
 				TokenContract: contractAddress,
+				EvmChainId:    evmChainID,
 			})
 
 			if err != nil {
@@ -350,6 +414,11 @@ func CmdContractCallTxConfirmations() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			// TODO: some sort of validation here?
 			invalidationScope := []byte(args[0])
 
@@ -361,6 +430,7 @@ func CmdContractCallTxConfirmations() *cobra.Command {
 			res, err := queryClient.ContractCallTxConfirmations(cmd.Context(), &types.ContractCallTxConfirmationsRequest{
 				InvalidationNonce: invalidationNonce,
 				InvalidationScope: invalidationScope,
+				EvmChainId:        evmChainID,
 			})
 
 			if err != nil {
@@ -386,13 +456,19 @@ func CmdUnsignedSignerSetTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			address, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.UnsignedSignerSetTxs(cmd.Context(), &types.UnsignedSignerSetTxsRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -418,13 +494,19 @@ func CmdUnsignedBatchTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			address, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.UnsignedBatchTxs(cmd.Context(), &types.UnsignedBatchTxsRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -450,13 +532,19 @@ func CmdUnsignedContractCallTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			address, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.UnsignedContractCallTxs(cmd.Context(), &types.UnsignedContractCallTxsRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -482,7 +570,12 @@ func CmdLatestSignerSetTx() *cobra.Command {
 				return err
 			}
 
-			req := &types.LatestSignerSetTxRequest{}
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			req := &types.LatestSignerSetTxRequest{EvmChainId: evmChainID}
 
 			res, err := queryClient.LatestSignerSetTx(cmd.Context(), req)
 			if err != nil {
@@ -508,13 +601,19 @@ func CmdLastSubmittedEthereumEvent() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			address, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.LastSubmittedEthereumEvent(cmd.Context(), &types.LastSubmittedEthereumEventRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -541,7 +640,12 @@ func CmdBatchTxFees() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.BatchTxFees(cmd.Context(), &types.BatchTxFeesRequest{})
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchTxFees(cmd.Context(), &types.BatchTxFeesRequest{EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -565,13 +669,19 @@ func CmdERC20ToDenom() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			contract, err := parseContractAddress(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.ERC20ToDenom(cmd.Context(), &types.ERC20ToDenomRequest{
-				Erc20: contract,
+				Erc20:      contract,
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -597,12 +707,18 @@ func CmdDenomToERC20Params() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			if err := sdk.ValidateDenom(args[0]); err != nil {
 				return err
 			}
 
 			req := &types.DenomToERC20ParamsRequest{
-				Denom: args[0],
+				Denom:      args[0],
+				EvmChainId: evmChainID,
 			}
 
 			res, err := queryClient.DenomToERC20Params(cmd.Context(), req)
@@ -629,12 +745,18 @@ func CmdDenomToERC20() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			if err := sdk.ValidateDenom(args[0]); err != nil {
 				return err
 			}
 
 			res, err := queryClient.DenomToERC20(cmd.Context(), &types.DenomToERC20Request{
-				Denom: args[0],
+				Denom:      args[0],
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -660,6 +782,11 @@ func CmdUnbatchedSendToEthereums() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -673,6 +800,7 @@ func CmdUnbatchedSendToEthereums() *cobra.Command {
 			res, err := queryClient.UnbatchedSendToEthereums(cmd.Context(), &types.UnbatchedSendToEthereumsRequest{
 				SenderAddress: sender.String(),
 				Pagination:    pageReq,
+				EvmChainId:    evmChainID,
 			})
 
 			if err != nil {
@@ -817,7 +945,65 @@ func CmdLastObservedEthereumHeight() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.LastObservedEthereumHeight(cmd.Context(), &types.LastObservedEthereumHeightRequest{})
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LastObservedEthereumHeight(cmd.Context(), &types.LastObservedEthereumHeightRequest{EvmChainId: evmChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgeContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-contract",
+		Args:  cobra.NoArgs,
+		Short: "query the gravity contract and bridging epoch of an evm chain, and any pending contract migration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgeContract(cmd.Context(), &types.BridgeContractRequest{EvmChainId: evmChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdEVMChains() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-chains",
+		Args:  cobra.NoArgs,
+		Short: "query the evm chains bridged to along with their last observed height and event nonce and whether they are paused",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EVMChains(cmd.Context(), &types.EVMChainsRequest{})
 			if err != nil {
 				return err
 			}
@@ -860,3 +1046,467 @@ func parseNonce(s string) (uint64, error) {
 	}
 	return nonce, nil
 }
+
+func CmdDepositAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-address [recipient]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the deposit address registered for a recipient on an evm chain, and the one the chain's factory derives for it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DepositAddress(cmd.Context(), &types.DepositAddressRequest{
+				Recipient:  args[0],
+				EvmChainId: evmChainID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdERC1155BatchTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc1155-batch-txs",
+		Args:  cobra.NoArgs,
+		Short: "query all the erc1155 batch transactions from the chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ERC1155BatchTxs(cmd.Context(), &types.ERC1155BatchTxsRequest{Pagination: pageReq, EvmChainId: evmChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "erc1155-batch-txs")
+	return cmd
+}
+
+func CmdERC1155BatchTxConfirmations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc1155-batch-tx-ethereum-signatures [nonce] [contract-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query signatures for a given erc1155 batch transaction identified by nonce and contract",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := parseNonce(args[0])
+			if err != nil {
+				return err
+			}
+
+			contractAddress, err := parseContractAddress(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ERC1155BatchTxConfirmations(cmd.Context(), &types.ERC1155BatchTxConfirmationsRequest{
+				BatchNonce:    nonce,
+				TokenContract: contractAddress,
+				EvmChainId:    evmChainID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUnsignedERC1155BatchTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-erc1155-batch-tx-ethereum-signatures [validator-or-orchestrator-acc-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query any pending erc1155 batch transactions given a validator or orchestrator address (sdk.AccAddress format)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnsignedERC1155BatchTxs(cmd.Context(), &types.UnsignedERC1155BatchTxsRequest{
+				Address:    address.String(),
+				EvmChainId: evmChainID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdERC1155Token() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc1155-token [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the erc1155 token id behind a gravity1155 voucher denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ERC1155Token(cmd.Context(), &types.ERC1155TokenRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRelayerIncentives() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-incentives",
+		Args:  cobra.NoArgs,
+		Short: "query the relayer incentives funded for an evm chain and the balance of the relayer reward account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RelayerIncentives(cmd.Context(), &types.RelayerIncentivesRequest{EvmChainId: evmChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSimulateParamsChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-params-change [params-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the effects the params of a JSON file would have if they replaced the current ones",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := parseProposalFile(clientCtx.Codec, args[0], &params); err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateParamsChange(cmd.Context(), &types.SimulateParamsChangeRequest{Params: params})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdIncidentRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incident-records",
+		Args:  cobra.NoArgs,
+		Short: "query the incident log of the bridged vouchers minted and burned by governance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.IncidentRecords(cmd.Context(), &types.IncidentRecordsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgeReports() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-reports",
+		Args:  cobra.NoArgs,
+		Short: "query the periodic reports of the bridge activity, fees, slashing and anomalies",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgeReports(cmd.Context(), &types.BridgeReportsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgeStateHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-state-hash",
+		Args:  cobra.NoArgs,
+		Short: "query the hash of the bridge state at the end of the last block and the state it commits to",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BridgeStateHash(cmd.Context(), &types.BridgeStateHashRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// FlagTransferHistoryFormat selects the format the transfer history is written in
+const FlagTransferHistoryFormat = "format"
+
+func CmdTransferHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-history [output-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "write the completed transfers of the chain kept in state to a file, as csv or json lines",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(FlagTransferHistoryFormat)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			writer, err := types.NewTransferHistoryWriter(file, format)
+			if err != nil {
+				return err
+			}
+
+			written := 0
+			pageReq := &query.PageRequest{Limit: 1000}
+			for {
+				res, err := queryClient.TransferHistory(cmd.Context(), &types.TransferHistoryRequest{
+					Pagination: pageReq,
+					EvmChainId: evmChainID,
+				})
+				if err != nil {
+					return err
+				}
+				for _, record := range res.Records {
+					if err := writer.Write(record); err != nil {
+						return err
+					}
+				}
+				written += len(res.Records)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: pageReq.Limit}
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("wrote %d transfers to %s\n", written, args[0]))
+		},
+	}
+
+	cmd.Flags().String(FlagTransferHistoryFormat, types.TransferHistoryFormatCSV, "the format of the file, csv or json")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdAttestationLatency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestation-latency",
+		Args:  cobra.NoArgs,
+		Short: "query the blocks the last observed events of an evm chain took to be observed from their first vote",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AttestationLatency(cmd.Context(), &types.AttestationLatencyRequest{EvmChainId: evmChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRelayCalldata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-calldata [store-index]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the calldata of the gravity contract call relaying a signed outgoing tx, given the hex store index of the tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			storeIndex, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid store index %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.RelayCalldata(cmd.Context(), &types.RelayCalldataRequest{EvmChainId: evmChainID, StoreIndex: storeIndex})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTypedData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "typed-data [store-index]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query the EIP-712 typed data of an outgoing tx, given the hex store index of the tx, to review it before signing",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			storeIndex, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid store index %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.TypedData(cmd.Context(), &types.TypedDataRequest{EvmChainId: evmChainID, StoreIndex: storeIndex})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -18,6 +18,9 @@ import (
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
+// FlagEVMChainID selects the EVM chain the gravity commands apply to
+const FlagEVMChainID = "evm-chain-id"
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	gravityTxCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -31,7 +34,16 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdSendToEthereum(),
 		CmdCancelSendToEthereum(),
 		CmdSetDelegateKeys(),
+		CmdRequestDepositAddress(),
+		CmdSendERC1155ToEthereum(),
+		CmdBridgeAdminPause(),
+		CmdBridgeAdminSetRateLimits(),
+		CmdBridgeAdminSetFeeFloors(),
+		CmdVetoBatchTx(),
+		CmdVetoContractCallTx(),
+		CmdInjectDeposit(),
 	)
+	gravityTxCmd.PersistentFlags().Uint64(FlagEVMChainID, 0, "the EVM chain to bridge to, the default chain if not set")
 
 	return gravityTxCmd
 }
@@ -68,7 +80,13 @@ func CmdSendToEthereum() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSendToEthereum(from, common.HexToAddress(args[0]).Hex(), sendCoin, feeCoin)
+			msg.EvmChainId = evmChainID
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -102,7 +120,13 @@ func CmdCancelSendToEthereum() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCancelSendToEthereum(id, from)
+			msg.EvmChainId = evmChainID
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -115,41 +139,130 @@ func CmdCancelSendToEthereum() *cobra.Command {
 	return cmd
 }
 
-func CmdSetDelegateKeys() *cobra.Command {
+func CmdRequestDepositAddress() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-delegate-keys [validator-address] [orchestrator-address] [ethereum-address] [ethereum-signature]",
-		Args:  cobra.ExactArgs(4),
-		Short: "Set gravity delegate keys",
-		Long: `Set a validator's Ethereum and orchestrator addresses. The validator must
-sign over a binary Proto-encoded DelegateKeysSignMsg message. The message contains
-the validator's address and operator account current nonce.`,
+		Use:   "request-deposit-address",
+		Args:  cobra.NoArgs,
+		Short: "Register a deposit address on the ethereum chain for the from account",
+		Long: `Register the address the deposit address factory of the ethereum chain derives for
+the from account. Tokens sent to it are bridged to the account once anyone calls forward
+on the factory, this allows deposits from senders that can't set a sendToCosmos destination.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
 			if err != nil {
 				return err
 			}
 
-			orcAddr, err := sdk.AccAddressFromBech32(args[1])
+			msg := types.NewMsgRequestDepositAddress(from)
+			msg.EvmChainId = evmChainID
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSendERC1155ToEthereum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-erc1155-to-ethereum [ethereum-reciever] [token-contract] [id:amount,...]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Send vouchers of ids of an ERC1155 token back to the connected ethereum chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			ethAddr, err := parseContractAddress(args[2])
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("must be a valid ethereum address got %s", args[0])
+			}
+			if !common.IsHexAddress(args[1]) {
+				return fmt.Errorf("must be a valid token contract address got %s", args[1])
+			}
+
+			var amounts []types.ERC1155Amount
+			for _, pair := range strings.Split(args[2], ",") {
+				parts := strings.Split(pair, ":")
+				if len(parts) != 2 {
+					return fmt.Errorf("amount %s is not of the form id:amount", pair)
+				}
+				id, ok := sdk.NewIntFromString(parts[0])
+				if !ok {
+					return fmt.Errorf("invalid id %s", parts[0])
+				}
+				amount, ok := sdk.NewIntFromString(parts[1])
+				if !ok {
+					return fmt.Errorf("invalid amount %s", parts[1])
+				}
+				amounts = append(amounts, types.ERC1155Amount{Id: id, Amount: amount})
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
 			if err != nil {
 				return err
 			}
 
-			ethSig, err := hexutil.Decode(args[3])
+			msg := types.NewMsgSendERC1155ToEthereum(from, common.HexToAddress(args[0]).Hex(), common.HexToAddress(args[1]).Hex(), amounts)
+			msg.EvmChainId = evmChainID
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgeAdminPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin-pause [true|false]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Pause or resume bridging to the ethereum chain as the bridge admin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgDelegateKeys(valAddr, orcAddr, ethAddr, ethSig)
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			paused, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBridgeAdminPause(from, evmChainID, paused)
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -162,30 +275,28 @@ the validator's address and operator account current nonce.`,
 	return cmd
 }
 
-func CmdSubmitCommunityPoolEthereumSpendProposal() *cobra.Command {
+func CmdBridgeAdminSetRateLimits() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "community-pool-ethereum-spend [proposal-file]",
+		Use:   "admin-set-rate-limits [rate-limits-file]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Submit a community pool Ethereum spend proposal",
+		Short: "Replace the rate limits of the ethereum chain as the bridge admin",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Submit a community pool Ethereum spend proposal along with an initial deposit.
-The proposal details must be supplied via a JSON file. The funds from the community pool
-will be bridged to Ethereum to the supplied recipient Ethereum address. Only one denomination
-of Cosmos token can be sent, and the bridge fee supplied along with the amount must be of the
-same denomination.
+			fmt.Sprintf(`Replace the rate limits of the ethereum chain as the bridge admin. The rate limits
+must be supplied via a JSON file, an empty list removes them all.
 
 Example:
-$ %s tx gov submit-proposal community-pool-ethereum-spend <path/to/proposal.json> --from=<key_or_address>
+$ %s tx gravity admin-set-rate-limits <path/to/rate_limits.json> --from=<key_or_address>
 
-Where proposal.json contains:
+Where rate_limits.json contains:
 
 {
-	"title": "Community Pool Ethereum Spend",
-	"description": "Bridge me some tokens to Ethereum!",
-	"recipient": "0x0000000000000000000000000000000000000000",
-	"amount": "20000stake",
-	"bridge_fee": "1000stake",
-	"deposit": "1000stake"
+	"rate_limits": [
+		{
+			"token_contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			"limit": "1000000000",
+			"window": "14400"
+		}
+	]
 }
 `,
 				version.AppName,
@@ -197,47 +308,902 @@ Where proposal.json contains:
 				return err
 			}
 
-			proposal, err := ParseCommunityPoolEthereumSpendProposal(clientCtx.Codec, args[0])
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			var limits types.MsgBridgeAdminSetRateLimits
+			if err := parseProposalFile(clientCtx.Codec, args[0], &limits); err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
 			if err != nil {
 				return err
 			}
 
-			if len(proposal.Title) == 0 {
-				return fmt.Errorf("title is empty")
+			msg := types.NewMsgBridgeAdminSetRateLimits(from, evmChainID, limits.RateLimits)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
 			}
 
-			if len(proposal.Description) == 0 {
-				return fmt.Errorf("description is empty")
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBridgeAdminSetFeeFloors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin-set-fee-floors [fee-floors-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replace the fee floors of the ethereum chain as the bridge admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the fee floors of the ethereum chain as the bridge admin. The fee floors
+must be supplied via a JSON file, an empty list removes them all.
+
+Example:
+$ %s tx gravity admin-set-fee-floors <path/to/fee_floors.json> --from=<key_or_address>
+
+Where fee_floors.json contains:
+
+{
+	"fee_floors": [
+		{
+			"token_contract": "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			"minimum_fee": "1000"
+		}
+	]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			if !common.IsHexAddress(proposal.Recipient) {
-				return fmt.Errorf("recipient is not a valid Ethereum address")
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
 			}
 
-			amount, err := sdk.ParseCoinNormalized(proposal.Amount)
+			var floors types.MsgBridgeAdminSetFeeFloors
+			if err := parseProposalFile(clientCtx.Codec, args[0], &floors); err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
 			if err != nil {
 				return err
 			}
 
-			bridgeFee, err := sdk.ParseCoinNormalized(proposal.BridgeFee)
+			msg := types.NewMsgBridgeAdminSetFeeFloors(from, evmChainID, floors.FeeFloors)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdVetoBatchTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto-batch-tx [token-contract] [batch-nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Veto a batch within its veto delay as the veto council, refunding its sends",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			if amount.Denom != bridgeFee.Denom {
-				return fmt.Errorf("amount and bridge fee denominations must match")
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
 			}
 
-			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid token contract %s", args[0])
+			}
+
+			batchNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVetoBatchTx(from, evmChainID, common.HexToAddress(args[0]), batchNonce)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdVetoContractCallTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto-contract-call-tx [invalidation-scope] [invalidation-nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Veto a contract call within its veto delay as the veto council",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
 			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
 
-			content := types.NewCommunityPoolEthereumSpendProposal(proposal.Title, proposal.Description, proposal.Recipient, amount, bridgeFee)
+			scope, err := hexutil.Decode(args[0])
+			if err != nil {
+				return err
+			}
 
-			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVetoContractCallTx(from, evmChainID, scope, nonce)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// FlagEthereumSender sets the ethereum sender of an injected deposit
+const FlagEthereumSender = "ethereum-sender"
+
+func CmdInjectDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inject-deposit [token-contract] [amount] [cosmos-receiver]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Observe a deposit from the ethereum chain without orchestrators, on devnets only",
+		Long: `Observe a deposit of the ERC20 token as if all the bonded validators had voted for it,
+at the event nonce following the last observed one of the ethereum chain. Only nodes built
+with the devnet build tag, e.g. with make install BUILD_TAGS=devnet, accept it.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid token contract %s", args[0])
+			}
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+			if _, err := sdk.AccAddressFromBech32(args[2]); err != nil {
+				return err
+			}
+
+			sender, err := cmd.Flags().GetString(FlagEthereumSender)
+			if err != nil {
+				return err
+			}
+			if !common.IsHexAddress(sender) {
+				return fmt.Errorf("invalid ethereum sender %s", sender)
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			// the deposit follows the last observed event and height of the chain, the
+			// default chain being the first one
+			res, err := types.NewQueryClient(clientCtx).EVMChains(cmd.Context(), &types.EVMChainsRequest{})
+			if err != nil {
+				return err
+			}
+			var status *types.EVMChainStatus
+			for i, chain := range res.Chains {
+				if (evmChainID == 0 && i == 0) || chain.Chain.ChainId == evmChainID {
+					status = &res.Chains[i]
+					break
+				}
+			}
+			if status == nil {
+				return fmt.Errorf("unknown evm chain id %d", evmChainID)
+			}
+
+			event := &types.SendToCosmosEvent{
+				EventNonce:     status.LastObservedEventNonce + 1,
+				TokenContract:  common.HexToAddress(args[0]).Hex(),
+				Amount:         amount,
+				EthereumSender: common.HexToAddress(sender).Hex(),
+				CosmosReceiver: args[2],
+				EthereumHeight: status.LastObservedEthereumHeight + 1,
+			}
+			msg, err := types.NewMsgInjectEthereumEvent(from, evmChainID, event)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagEthereumSender, common.Address{}.Hex(), "the ethereum address the deposit is made from")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSetDelegateKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-delegate-keys [validator-address] [orchestrator-address] [ethereum-address] [ethereum-signature]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Set gravity delegate keys",
+		Long: `Set a validator's Ethereum and orchestrator addresses. The validator must
+sign over a binary Proto-encoded DelegateKeysSignMsg message. The message contains
+the validator's address and operator account current nonce.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			orcAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			ethAddr, err := parseContractAddress(args[2])
+			if err != nil {
+				return err
+			}
+
+			ethSig, err := hexutil.Decode(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateKeys(valAddr, orcAddr, ethAddr, ethSig)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitCommunityPoolEthereumSpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-ethereum-spend [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool Ethereum spend proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool Ethereum spend proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. The funds from the community pool
+will be bridged to Ethereum to the supplied recipient Ethereum address. Only one denomination
+of Cosmos token can be sent, and the bridge fee supplied along with the amount must be of the
+same denomination.
+
+Example:
+$ %s tx gov submit-proposal community-pool-ethereum-spend <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Community Pool Ethereum Spend",
+	"description": "Bridge me some tokens to Ethereum!",
+	"recipient": "0x0000000000000000000000000000000000000000",
+	"amount": "20000stake",
+	"bridge_fee": "1000stake",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseCommunityPoolEthereumSpendProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(proposal.Title) == 0 {
+				return fmt.Errorf("title is empty")
+			}
+
+			if len(proposal.Description) == 0 {
+				return fmt.Errorf("description is empty")
+			}
+
+			if !common.IsHexAddress(proposal.Recipient) {
+				return fmt.Errorf("recipient is not a valid Ethereum address")
+			}
+
+			amount, err := sdk.ParseCoinNormalized(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			bridgeFee, err := sdk.ParseCoinNormalized(proposal.BridgeFee)
+			if err != nil {
+				return err
+			}
+
+			if amount.Denom != bridgeFee.Denom {
+				return fmt.Errorf("amount and bridge fee denominations must match")
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewCommunityPoolEthereumSpendProposal(proposal.Title, proposal.Description, proposal.Recipient, amount, bridgeFee)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitAddEVMChainProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-evm-chain [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add an EVM chain to bridge to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add an EVM chain along with an initial deposit.
+The proposal details must be supplied via a JSON file. The Gravity contract of the chain
+must already be deployed with the chain's gravity id and the current signer set.
+
+Example:
+$ %s tx gov submit-proposal add-evm-chain <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Add Arbitrum",
+	"description": "Bridge to Arbitrum One",
+	"chain": {
+		"chain_id": "42161",
+		"name": "arbitrum",
+		"gravity_id": "arbitrum-gravity",
+		"bridge_ethereum_address": "0x0000000000000000000000000000000000000000",
+		"finality": "FINALITY_FINALIZED"
+	},
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseAddEVMChainProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddEVMChainProposal(proposal.Title, proposal.Description, proposal.Chain)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitContractMigrationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-migration [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to migrate an EVM chain to a new Gravity contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to migrate an EVM chain to a new Gravity contract along with an
+initial deposit. The proposal details must be supplied via a JSON file. The ethereum height
+is the block the new contract was deployed at, an evm chain id of zero selects the default
+chain.
+
+Example:
+$ %s tx gov submit-proposal contract-migration <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Migrate the Gravity contract",
+	"description": "Move the bridge to the redeployed contract",
+	"evm_chain_id": "0",
+	"bridge_ethereum_address": "0x0000000000000000000000000000000000000000",
+	"ethereum_height": "15000000",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseContractMigrationProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewContractMigrationProposal(proposal.Title, proposal.Description, proposal.EvmChainId, proposal.BridgeEthereumAddress, proposal.EthereumHeight)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitEVMChainPauseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-chain-pause [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to pause or resume bridging to an EVM chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pause or resume bridging to an EVM chain along with an initial
+deposit. The proposal details must be supplied via a JSON file. While paused no transfers to
+the chain are accepted and no batches or contract calls are created for it, an evm chain id
+of zero selects the default chain.
+
+Example:
+$ %s tx gov submit-proposal evm-chain-pause <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Pause Arbitrum",
+	"description": "Pause the bridge while the incident is investigated",
+	"evm_chain_id": "42161",
+	"paused": true,
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseEVMChainPauseProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewEVMChainPauseProposal(proposal.Title, proposal.Description, proposal.EvmChainId, proposal.Paused)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitGravityIDRotationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gravity-id-rotation [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to rotate the gravity id of an EVM chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to rotate the gravity id of an EVM chain along with an initial
+deposit. The proposal details must be supplied via a JSON file. Once passed checkpoints are
+produced for the new gravity id, while confirmations for the previous one are still accepted
+for the acceptance window in blocks. An evm chain id of zero selects the default chain.
+
+Example:
+$ %s tx gov submit-proposal gravity-id-rotation <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Rotate the Arbitrum gravity id",
+	"description": "Move to the gravity id of the redeployed contract",
+	"evm_chain_id": "42161",
+	"gravity_id": "arbitrum-gravity-2",
+	"acceptance_window": "14400",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseGravityIDRotationProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewGravityIDRotationProposal(proposal.Title, proposal.Description, proposal.EvmChainId, proposal.GravityId, proposal.AcceptanceWindow)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitUpdateParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to replace the params of the gravity module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to replace the params of the gravity module along with an
+initial deposit. The proposal details must be supplied via a JSON file, and the params must be
+given in full, as returned by the params query. Once passed the params are validated as a whole
+and replace the current ones, or are scheduled to replace them at the effective height if one is
+set. A scheduled update replaces any update scheduled before it, and is cancelled by an update
+taking effect right away.
+
+Example:
+$ %s tx gov submit-proposal update-params <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Lengthen the batch timeout",
+	"description": "Give relayers more time to submit batches",
+	"params": {
+		"gravity_id": "gravity-bridge",
+		"target_eth_tx_timeout": "86400000",
+		...
+	},
+	"effective_height": "1200000",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseUpdateParamsProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateParamsProposal(proposal.Title, proposal.Description, proposal.Params, proposal.EffectiveHeight)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitRelayerIncentiveProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-incentive [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to fund relayer incentives on an EVM chain out of the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to fund relayer incentives on an EVM chain out of the community pool
+along with an initial deposit. The proposal details must be supplied via a JSON file. Once passed
+the amount is moved to the relayer reward account and paid out in the given number of equal
+disbursements, one every disbursement period in blocks, to the orchestrators of the chain's
+latest signer set in proportion to their power. An evm chain id of zero selects the default chain.
+
+Example:
+$ %s tx gov submit-proposal relayer-incentive <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Subsidize relaying to Arbitrum",
+	"description": "Pay orchestrators for relaying to Arbitrum over the next month",
+	"evm_chain_id": "42161",
+	"amount": "1000000stake",
+	"disbursement_period": "14400",
+	"disbursements": "30",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseRelayerIncentiveProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRelayerIncentiveProposal(proposal.Title, proposal.Description, proposal.EvmChainId, amount, proposal.DisbursementPeriod, proposal.Disbursements)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitEthereumEventRejectionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ethereum-event-rejection [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to reject the vote record of an event stuck at the next event nonce of an EVM chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to reject the vote record of an event stuck at the next event nonce
+of an EVM chain along with an initial deposit. The proposal details must be supplied via a JSON
+file. Once passed the nonce is skipped without any of the events voted for at it being applied,
+and the events after it are tallied again. It fails if any event at the nonce has the votes to be
+observed. An evm chain id of zero selects the default chain.
+
+Example:
+$ %s tx gov submit-proposal ethereum-event-rejection <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Reject the stuck deposit event",
+	"description": "Orchestrators of the faulty release voted for a malformed deposit",
+	"evm_chain_id": "42161",
+	"event_nonce": "1024",
+	"event_hash": "4ab7c5e9...",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseEthereumEventRejectionProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewEthereumEventRejectionProposal(proposal.Title, proposal.Description, proposal.EvmChainId, proposal.EventNonce, proposal.EventHash)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitIncidentRecoveryProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incident-recovery [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to mint bridged vouchers to an account or burn them from it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to mint bridged vouchers to an account or burn them from it along
+with an initial deposit, e.g. to reimburse users after an incident. The proposal details must be
+supplied via a JSON file. The action is either INCIDENT_ACTION_MINT or INCIDENT_ACTION_BURN, only
+the vouchers of tokens bridged from EVM chains may be adjusted, and a reason is required. Once
+passed the action is kept with its reason in the incident log.
+
+Example:
+$ %s tx gov submit-proposal incident-recovery <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "Reimburse the lost deposit",
+	"description": "The deposit of transaction 0x... was skipped by the rejected event",
+	"action": "INCIDENT_ACTION_MINT",
+	"account": "cosmos1...",
+	"amount": "1000000gravity0x...",
+	"reason": "reimburse the deposit at event nonce 1024 of the default chain",
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseIncidentRecoveryProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewIncidentRecoveryProposal(proposal.Title, proposal.Description, proposal.Action, proposal.Account, amount, proposal.Reason)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
 			if err != nil {
 				return err
 			}
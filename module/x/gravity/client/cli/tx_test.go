@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 func TestParseCommunityPoolEthereumSpendProposal(t *testing.T) {
@@ -32,3 +34,77 @@ func TestParseCommunityPoolEthereumSpendProposal(t *testing.T) {
 	require.Equal(t, "1000stake", proposal.BridgeFee)
 	require.Equal(t, "1000stake", proposal.Deposit)
 }
+
+func TestParseAddEVMChainProposal(t *testing.T) {
+	encodingConfig := params.MakeTestEncodingConfig()
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Add Arbitrum",
+  "description": "Bridge to Arbitrum One",
+  "chain": {
+    "chain_id": "42161",
+    "name": "arbitrum",
+    "gravity_id": "arbitrum-gravity",
+    "bridge_ethereum_address": "0x0000000000000000000000000000000000000000",
+    "finality": "FINALITY_FINALIZED"
+  },
+  "deposit": "1000stake"
+}
+`)
+
+	proposal, err := ParseAddEVMChainProposal(encodingConfig.Marshaler, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "Add Arbitrum", proposal.Title)
+	require.Equal(t, uint64(42161), proposal.Chain.ChainId)
+	require.Equal(t, "arbitrum-gravity", proposal.Chain.GravityId)
+	require.Equal(t, types.FinalityFinalized, proposal.Chain.Finality)
+	require.Equal(t, "1000stake", proposal.Deposit)
+}
+
+func TestParseEVMChainPauseProposal(t *testing.T) {
+	encodingConfig := params.MakeTestEncodingConfig()
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Pause Arbitrum",
+  "description": "Pause the bridge while the incident is investigated",
+  "evm_chain_id": "42161",
+  "paused": true,
+  "deposit": "1000stake"
+}
+`)
+
+	proposal, err := ParseEVMChainPauseProposal(encodingConfig.Marshaler, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(42161), proposal.EvmChainId)
+	require.True(t, proposal.Paused)
+	require.Equal(t, "1000stake", proposal.Deposit)
+}
+
+func TestParseRelayerIncentiveProposal(t *testing.T) {
+	encodingConfig := params.MakeTestEncodingConfig()
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "Subsidize relaying to Arbitrum",
+  "description": "Pay orchestrators for relaying to Arbitrum over the next month",
+  "evm_chain_id": "42161",
+  "amount": "1000000stake",
+  "disbursement_period": "14400",
+  "disbursements": "30",
+  "deposit": "1000stake"
+}
+`)
+
+	proposal, err := ParseRelayerIncentiveProposal(encodingConfig.Marshaler, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(42161), proposal.EvmChainId)
+	require.Equal(t, "1000000stake", proposal.Amount)
+	require.Equal(t, uint64(14400), proposal.DisbursementPeriod)
+	require.Equal(t, uint64(30), proposal.Disbursements)
+	require.Equal(t, "1000stake", proposal.Deposit)
+}
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gogo/protobuf/proto"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
@@ -22,3 +23,68 @@ func ParseCommunityPoolEthereumSpendProposal(cdc codec.JSONCodec, proposalFile s
 
 	return proposal, nil
 }
+
+// ParseAddEVMChainProposal reads and parses an AddEVMChainProposalForCLI from a file.
+func ParseAddEVMChainProposal(cdc codec.JSONCodec, proposalFile string) (types.AddEVMChainProposalForCLI, error) {
+	proposal := types.AddEVMChainProposalForCLI{}
+	err := parseProposalFile(cdc, proposalFile, &proposal)
+	return proposal, err
+}
+
+// ParseContractMigrationProposal reads and parses a ContractMigrationProposalForCLI from a file.
+func ParseContractMigrationProposal(cdc codec.JSONCodec, proposalFile string) (types.ContractMigrationProposalForCLI, error) {
+	proposal := types.ContractMigrationProposalForCLI{}
+	err := parseProposalFile(cdc, proposalFile, &proposal)
+	return proposal, err
+}
+
+// ParseEVMChainPauseProposal reads and parses an EVMChainPauseProposalForCLI from a file.
+func ParseEVMChainPauseProposal(cdc codec.JSONCodec, proposalFile string) (types.EVMChainPauseProposalForCLI, error) {
+	proposal := types.EVMChainPauseProposalForCLI{}
+	err := parseProposalFile(cdc, proposalFile, &proposal)
+	return proposal, err
+}
+
+// ParseGravityIDRotationProposal reads and parses a GravityIDRotationProposalForCLI from a file.
+func ParseGravityIDRotationProposal(cdc codec.JSONCodec, proposalFile string) (types.GravityIDRotationProposalForCLI, error) {
+	proposal := types.GravityIDRotationProposalForCLI{}
+	err := parseProposalFile(cdc, proposalFile, &proposal)
+	return proposal, err
+}
+
+// ParseUpdateParamsProposal reads and parses an UpdateParamsProposalForCLI from a file.
+func ParseUpdateParamsProposal(cdc codec.JSONCodec, proposalFile string) (types.UpdateParamsProposalForCLI, error) {
+	proposal := types.UpdateParamsProposalForCLI{}
+	err := parseProposalFile(cdc, proposalFile, &proposal)
+	return proposal, err
+}
+
+// ParseRelayerIncentiveProposal reads and parses a RelayerIncentiveProposalForCLI from a file.
+func ParseRelayerIncentiveProposal(cdc codec.JSONCodec, proposalFile string) (types.RelayerIncentiveProposalForCLI, error) {
+	proposal := types.RelayerIncentiveProposalForCLI{}
+	err := parseProposalFile(cdc, proposalFile, &proposal)
+	return proposal, err
+}
+
+// ParseEthereumEventRejectionProposal reads and parses an EthereumEventRejectionProposalForCLI from a file.
+func ParseEthereumEventRejectionProposal(cdc codec.JSONCodec, proposalFile string) (types.EthereumEventRejectionProposalForCLI, error) {
+	proposal := types.EthereumEventRejectionProposalForCLI{}
+	err := parseProposalFile(cdc, proposalFile, &proposal)
+	return proposal, err
+}
+
+// ParseIncidentRecoveryProposal reads and parses an IncidentRecoveryProposalForCLI from a file.
+func ParseIncidentRecoveryProposal(cdc codec.JSONCodec, proposalFile string) (types.IncidentRecoveryProposalForCLI, error) {
+	proposal := types.IncidentRecoveryProposalForCLI{}
+	err := parseProposalFile(cdc, proposalFile, &proposal)
+	return proposal, err
+}
+
+func parseProposalFile(cdc codec.JSONCodec, proposalFile string, proposal proto.Message) error {
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(contents, proposal)
+}
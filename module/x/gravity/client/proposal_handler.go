@@ -6,7 +6,16 @@ import (
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/client/rest"
 )
 
-// ProposalHandler is the community Ethereum spend proposal handler.
+// ProposalHandler is the community Ethereum spend proposal handler, the others manage the
+// EVM chains bridged to.
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal, rest.ProposalRESTHandler)
+	ProposalHandler                       = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal, rest.ProposalRESTHandler)
+	AddEVMChainProposalHandler            = govclient.NewProposalHandler(cli.CmdSubmitAddEVMChainProposal, rest.AddEVMChainProposalRESTHandler)
+	ContractMigrationProposalHandler      = govclient.NewProposalHandler(cli.CmdSubmitContractMigrationProposal, rest.ContractMigrationProposalRESTHandler)
+	EVMChainPauseProposalHandler          = govclient.NewProposalHandler(cli.CmdSubmitEVMChainPauseProposal, rest.EVMChainPauseProposalRESTHandler)
+	GravityIDRotationProposalHandler      = govclient.NewProposalHandler(cli.CmdSubmitGravityIDRotationProposal, rest.GravityIDRotationProposalRESTHandler)
+	UpdateParamsProposalHandler           = govclient.NewProposalHandler(cli.CmdSubmitUpdateParamsProposal, rest.UpdateParamsProposalRESTHandler)
+	RelayerIncentiveProposalHandler       = govclient.NewProposalHandler(cli.CmdSubmitRelayerIncentiveProposal, rest.RelayerIncentiveProposalRESTHandler)
+	EthereumEventRejectionProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitEthereumEventRejectionProposal, rest.EthereumEventRejectionProposalRESTHandler)
+	IncidentRecoveryProposalHandler       = govclient.NewProposalHandler(cli.CmdSubmitIncidentRecoveryProposal, rest.IncidentRecoveryProposalRESTHandler)
 )
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -44,3 +45,148 @@ func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+// AddEVMChainProposalRESTHandler returns a ProposalRESTHandler that exposes the add EVM chain REST handler with a given sub-route.
+func AddEVMChainProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_evm_chain",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req AddEVMChainProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewAddEVMChainProposal(req.Title, req.Description, req.Chain)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// ContractMigrationProposalRESTHandler returns a ProposalRESTHandler that exposes the contract migration REST handler with a given sub-route.
+func ContractMigrationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "contract_migration",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ContractMigrationProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewContractMigrationProposal(req.Title, req.Description, req.EVMChainID, req.BridgeEthereumAddress, req.EthereumHeight)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// EVMChainPauseProposalRESTHandler returns a ProposalRESTHandler that exposes the EVM chain pause REST handler with a given sub-route.
+func EVMChainPauseProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "evm_chain_pause",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req EVMChainPauseProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewEVMChainPauseProposal(req.Title, req.Description, req.EVMChainID, req.Paused)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// GravityIDRotationProposalRESTHandler returns a ProposalRESTHandler that exposes the gravity id rotation REST handler with a given sub-route.
+func GravityIDRotationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "gravity_id_rotation",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req GravityIDRotationProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewGravityIDRotationProposal(req.Title, req.Description, req.EVMChainID, req.GravityID, req.AcceptanceWindow)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// UpdateParamsProposalRESTHandler returns a ProposalRESTHandler that exposes the params update REST handler with a given sub-route.
+func UpdateParamsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_params",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UpdateParamsProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewUpdateParamsProposal(req.Title, req.Description, req.Params, req.EffectiveHeight)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// RelayerIncentiveProposalRESTHandler returns a ProposalRESTHandler that exposes the relayer incentive REST handler with a given sub-route.
+func RelayerIncentiveProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "relayer_incentive",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req RelayerIncentiveProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewRelayerIncentiveProposal(req.Title, req.Description, req.EVMChainID, req.Amount, req.DisbursementPeriod, req.Disbursements)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// EthereumEventRejectionProposalRESTHandler returns a ProposalRESTHandler that exposes the Ethereum event rejection REST handler with a given sub-route.
+func EthereumEventRejectionProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "ethereum_event_rejection",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req EthereumEventRejectionProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewEthereumEventRejectionProposal(req.Title, req.Description, req.EVMChainID, req.EventNonce, req.EventHash)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// IncidentRecoveryProposalRESTHandler returns a ProposalRESTHandler that exposes the incident recovery REST handler with a given sub-route.
+func IncidentRecoveryProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "incident_recovery",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req IncidentRecoveryProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewIncidentRecoveryProposal(req.Title, req.Description, req.Action, req.Account, req.Amount, req.Reason)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func writeProposalTx(clientCtx client.Context, w http.ResponseWriter, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
@@ -3,6 +3,8 @@ package rest
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 type (
@@ -18,4 +20,106 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// AddEVMChainProposalReq defines an add EVM chain proposal request body.
+	AddEVMChainProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Chain       types.EVMChain `json:"chain" yaml:"chain"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// ContractMigrationProposalReq defines a contract migration proposal request body.
+	ContractMigrationProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title                 string         `json:"title" yaml:"title"`
+		Description           string         `json:"description" yaml:"description"`
+		EVMChainID            uint64         `json:"evm_chain_id" yaml:"evm_chain_id"`
+		BridgeEthereumAddress string         `json:"bridge_ethereum_address" yaml:"bridge_ethereum_address"`
+		EthereumHeight        uint64         `json:"ethereum_height" yaml:"ethereum_height"`
+		Proposer              sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit               sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// EVMChainPauseProposalReq defines an EVM chain pause proposal request body.
+	EVMChainPauseProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		EVMChainID  uint64         `json:"evm_chain_id" yaml:"evm_chain_id"`
+		Paused      bool           `json:"paused" yaml:"paused"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// GravityIDRotationProposalReq defines a gravity id rotation proposal request body.
+	GravityIDRotationProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title            string         `json:"title" yaml:"title"`
+		Description      string         `json:"description" yaml:"description"`
+		EVMChainID       uint64         `json:"evm_chain_id" yaml:"evm_chain_id"`
+		GravityID        string         `json:"gravity_id" yaml:"gravity_id"`
+		AcceptanceWindow uint64         `json:"acceptance_window" yaml:"acceptance_window"`
+		Proposer         sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit          sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// UpdateParamsProposalReq defines a params update proposal request body.
+	UpdateParamsProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title           string         `json:"title" yaml:"title"`
+		Description     string         `json:"description" yaml:"description"`
+		Params          types.Params   `json:"params" yaml:"params"`
+		EffectiveHeight uint64         `json:"effective_height" yaml:"effective_height"`
+		Proposer        sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit         sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// RelayerIncentiveProposalReq defines a relayer incentive proposal request body.
+	RelayerIncentiveProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title              string         `json:"title" yaml:"title"`
+		Description        string         `json:"description" yaml:"description"`
+		EVMChainID         uint64         `json:"evm_chain_id" yaml:"evm_chain_id"`
+		Amount             sdk.Coins      `json:"amount" yaml:"amount"`
+		DisbursementPeriod uint64         `json:"disbursement_period" yaml:"disbursement_period"`
+		Disbursements      uint64         `json:"disbursements" yaml:"disbursements"`
+		Proposer           sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit            sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// EthereumEventRejectionProposalReq defines an Ethereum event rejection proposal request body.
+	EthereumEventRejectionProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		EVMChainID  uint64         `json:"evm_chain_id" yaml:"evm_chain_id"`
+		EventNonce  uint64         `json:"event_nonce" yaml:"event_nonce"`
+		EventHash   string         `json:"event_hash" yaml:"event_hash"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// IncidentRecoveryProposalReq defines an incident recovery proposal request body.
+	IncidentRecoveryProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string               `json:"title" yaml:"title"`
+		Description string               `json:"description" yaml:"description"`
+		Action      types.IncidentAction `json:"action" yaml:"action"`
+		Account     string               `json:"account" yaml:"account"`
+		Amount      sdk.Coins            `json:"amount" yaml:"amount"`
+		Reason      string               `json:"reason" yaml:"reason"`
+		Proposer    sdk.AccAddress       `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins            `json:"deposit" yaml:"deposit"`
+	}
 )
@@ -94,15 +94,15 @@ func addDenomToERC20Relation(tv *testingVars) {
 	gravity.EndBlocker(tv.ctx, tv.input.GravityKeeper)
 
 	// check if event vote record persisted
-	a := tv.input.GravityKeeper.GetEthereumEventVoteRecord(tv.ctx, myNonce, deployedEvent.Hash())
+	a := tv.input.GravityKeeper.GetEthereumEventVoteRecord(tv.ctx, keeper.TestingGravityParams.BridgeChainId, myNonce, deployedEvent.Hash())
 	require.NotNil(tv.t, a)
 
 	// check if erc20<>denom relation added to db
-	isCosmosOriginated, gotERC20, err := tv.input.GravityKeeper.DenomToERC20Lookup(tv.ctx, tv.denom)
+	isCosmosOriginated, gotERC20, err := tv.input.GravityKeeper.DenomToERC20Lookup(tv.ctx, keeper.TestingGravityParams.BridgeChainId, tv.denom)
 	require.NoError(tv.t, err)
 	assert.True(tv.t, isCosmosOriginated)
 
-	isCosmosOriginated, gotDenom := tv.input.GravityKeeper.ERC20ToDenomLookup(tv.ctx, common.HexToAddress(tv.erc20))
+	isCosmosOriginated, gotDenom := tv.input.GravityKeeper.ERC20ToDenomLookup(tv.ctx, keeper.TestingGravityParams.BridgeChainId, common.HexToAddress(tv.erc20))
 	assert.True(tv.t, isCosmosOriginated)
 
 	assert.Equal(tv.t, tv.denom, gotDenom)
@@ -143,11 +143,10 @@ func lockCoinsInModule(tv *testingVars) {
 	balance2 := tv.input.BankKeeper.GetAllBalances(tv.ctx, userCosmosAddr)
 	assert.Equal(tv.t, sdk.Coins{sdk.NewCoin(denom, startingCoinAmount.Sub(sendAmount).Sub(feeAmount))}, balance2)
 
-	// Check that gravity balance has gone up
-	gravityAddr := tv.input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	// Check that the escrow of the chain has gone up
 	assert.Equal(tv.t,
 		sdk.Coins{sdk.NewCoin(denom, sendAmount.Add(feeAmount))},
-		tv.input.BankKeeper.GetAllBalances(tv.ctx, gravityAddr),
+		tv.input.GravityKeeper.GetEscrowedCoins(tv.ctx, keeper.TestingGravityParams.BridgeChainId),
 	)
 }
 
@@ -176,13 +175,13 @@ func acceptDepositEvent(tv *testingVars) {
 	eva, err := types.PackEvent(sendToCosmosEvent)
 	require.NoError(tv.t, err)
 
-	msgSubmitEvent := &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String()}
+	msgSubmitEvent := &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String(), 0}
 	_, err = tv.h(tv.ctx, msgSubmitEvent)
 	require.NoError(tv.t, err)
 	gravity.EndBlocker(tv.ctx, tv.input.GravityKeeper)
 
 	// check that attestation persisted
-	a := tv.input.GravityKeeper.GetEthereumEventVoteRecord(tv.ctx, myNonce, sendToCosmosEvent.Hash())
+	a := tv.input.GravityKeeper.GetEthereumEventVoteRecord(tv.ctx, keeper.TestingGravityParams.BridgeChainId, myNonce, sendToCosmosEvent.Hash())
 	require.NotNil(tv.t, a)
 
 	// Check that user balance has gone up
@@ -190,10 +189,9 @@ func acceptDepositEvent(tv *testingVars) {
 		sdk.Coins{sdk.NewCoin(tv.denom, myErc20.Amount)},
 		tv.input.BankKeeper.GetAllBalances(tv.ctx, myCosmosAddr))
 
-	// Check that gravity balance has gone down
-	gravityAddr := tv.input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	// Check that the escrow of the chain has gone down
 	assert.Equal(tv.t,
 		sdk.Coins{sdk.NewCoin(tv.denom, sdk.NewIntFromUint64(55).Sub(myErc20.Amount))},
-		tv.input.BankKeeper.GetAllBalances(tv.ctx, gravityAddr),
+		tv.input.GravityKeeper.GetEscrowedCoins(tv.ctx, keeper.TestingGravityParams.BridgeChainId),
 	)
 }
@@ -0,0 +1,63 @@
+package e2e
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestDepositWithdrawBatchRelay(t *testing.T) {
+	h := newHarness(t)
+	k := h.input.GravityKeeper
+
+	token := h.deployERC20()
+	denom := types.GravityDenom(token)
+	receiver := sdk.AccAddress(crypto.Keccak256([]byte("receiver"))[:20])
+
+	// deposit and attest
+	h.sendToCosmos(token, receiver, depositAmount)
+	h.attestEvents()
+
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(h.ctx, keeper.TestingGravityParams.BridgeChainId))
+	balance := h.input.BankKeeper.GetBalance(h.ctx, receiver, denom)
+	require.Equal(t, sdk.NewInt(depositAmount), balance.Amount)
+
+	// withdraw, the batch is created in the begin blocker every 10 blocks
+	ethRecipient := crypto.PubkeyToAddress(h.validators[0].ethKey.PublicKey)
+	_, err := h.msgServer.SendToEthereum(sdk.WrapSDKContext(h.ctx), &types.MsgSendToEthereum{
+		Sender:            receiver.String(),
+		EthereumRecipient: ethRecipient.Hex(),
+		Amount:            sdk.NewInt64Coin(denom, 600),
+		BridgeFee:         sdk.NewInt64Coin(denom, 100),
+	})
+	require.NoError(t, err)
+
+	var batch *types.BatchTx
+	for i := 0; i < 10 && batch == nil; i++ {
+		h.signOutgoingTxs()
+		h.nextBlock()
+		batch = h.batchTx(token)
+	}
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 1)
+
+	// sign and relay
+	h.signOutgoingTxs()
+	h.relayBatch(batch)
+
+	require.Equal(t, big.NewInt(600), h.erc20Balance(token, ethRecipient))
+	require.Equal(t, big.NewInt(depositAmount-700), h.erc20Balance(token, h.gravityAddr))
+
+	// the executed batch is attested to and removed
+	h.attestEvents()
+
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(h.ctx, keeper.TestingGravityParams.BridgeChainId))
+	require.Nil(t, h.batchTx(token))
+	require.Equal(t, sdk.NewInt(depositAmount-700), h.input.BankKeeper.GetBalance(h.ctx, receiver, denom).Amount)
+}
@@ -0,0 +1,476 @@
+// Package e2e runs the whole bridge loop in a single process: the gravity module on top of the
+// keeper test environment, a go-ethereum simulated backend with the Gravity contract deployed,
+// and minimal stand-ins for the orchestrator and relayer which carry events and signatures
+// between the two. It needs the compiled contracts, run `npx hardhat compile` in solidity/ or
+// point GRAVITY_ARTIFACTS_DIR at the artifacts/contracts directory of a compiled checkout.
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/internal/calldata"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// ArtifactsDirEnv overrides where the hardhat contract artifacts are read from
+const ArtifactsDirEnv = "GRAVITY_ARTIFACTS_DIR"
+
+const (
+	// the power threshold the contract deployer uses, 66% of the normalized total power
+	powerThreshold = 2834678415
+
+	evmGasLimit = 30_000_000
+
+	// the test coin of the deposit and withdrawal
+	depositAmount = 1000
+)
+
+var (
+	evmChainID = big.NewInt(1337)
+
+	// the EtherBase of the test chains, the test ERC20s mint their supply to it
+	minerKey, _ = crypto.HexToECDSA("b1bab011e03a9862664706fc3bbaa1b16651528e5f0e7fbfcbfdd8be302a13e7")
+
+	minerBalance, _ = new(big.Int).SetString("1000000000000000000000000", 10)
+)
+
+// hardhatArtifact is the part of a hardhat compilation artifact needed to deploy a contract
+type hardhatArtifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode string          `json:"bytecode"`
+}
+
+// contractArtifact is a compiled contract ready to be deployed
+type contractArtifact struct {
+	abi      abi.ABI
+	bytecode []byte
+}
+
+// loadArtifact reads the compiled contract of the given name and skips the test if the
+// contracts haven't been compiled, on CI missing artifacts fail the test instead so the
+// end-to-end tests can't silently stop running
+func loadArtifact(t *testing.T, name string) contractArtifact {
+	t.Helper()
+
+	dir := os.Getenv(ArtifactsDirEnv)
+	if dir == "" {
+		dir = filepath.Join("..", "..", "..", "..", "solidity", "artifacts", "contracts")
+	}
+
+	bz, err := os.ReadFile(filepath.Join(dir, name+".sol", name+".json"))
+	if os.IsNotExist(err) {
+		if os.Getenv("CI") != "" {
+			t.Fatalf("no %s artifact in %s, the contracts must be compiled before running the end-to-end tests on CI", name, dir)
+		}
+		t.Skipf("no %s artifact in %s, compile the contracts with `npx hardhat compile` in solidity/ or set %s", name, dir, ArtifactsDirEnv)
+	}
+	require.NoError(t, err)
+
+	var artifact hardhatArtifact
+	require.NoError(t, json.Unmarshal(bz, &artifact))
+
+	contractABI, err := abi.JSON(bytes.NewReader(artifact.ABI))
+	require.NoError(t, err)
+	bytecode, err := hexutil.Decode(artifact.Bytecode)
+	require.NoError(t, err)
+
+	return contractArtifact{abi: contractABI, bytecode: bytecode}
+}
+
+// validator is a validator of the test chain together with its delegate keys
+type validator struct {
+	valAddr  sdk.ValAddress
+	orchAddr sdk.AccAddress
+	ethKey   *ecdsa.PrivateKey
+	ethAddr  common.Address
+}
+
+// harness is a gravity chain and an Ethereum chain with the Gravity contract deployed, each
+// of the validators runs an orchestrator that is stepped by the test
+type harness struct {
+	t *testing.T
+
+	input      keeper.TestInput
+	ctx        sdk.Context
+	msgServer  types.MsgServer
+	gravityID  string
+	validators []validator
+
+	evm         *backends.SimulatedBackend
+	miner       *bind.TransactOpts
+	gravity     *bind.BoundContract
+	gravityAddr common.Address
+	gravityABI  abi.ABI
+	erc20       contractArtifact
+
+	// the valset stored in the Gravity contract
+	contractValset calldata.ValsetArgs
+	// the last Gravity event nonce the orchestrators attested to
+	lastEventNonce uint64
+}
+
+func newHarness(t *testing.T) *harness {
+	t.Helper()
+
+	gravityArtifact := loadArtifact(t, "Gravity")
+	erc20Artifact := loadArtifact(t, "TestERC20A")
+
+	h := &harness{
+		t:     t,
+		input: keeper.CreateTestEnv(t),
+		erc20: erc20Artifact,
+	}
+	h.ctx = h.input.Context
+	h.msgServer = keeper.NewMsgServerImpl(h.input.GravityKeeper)
+	h.gravityID = h.input.GravityKeeper.GetParams(h.ctx).GravityId
+
+	h.setupValidators()
+
+	h.evm = backends.NewSimulatedBackend(core.GenesisAlloc{
+		crypto.PubkeyToAddress(minerKey.PublicKey): {Balance: minerBalance},
+	}, evmGasLimit)
+	t.Cleanup(func() { h.evm.Close() })
+
+	var err error
+	h.miner, err = bind.NewKeyedTransactorWithChainID(minerKey, evmChainID)
+	require.NoError(t, err)
+
+	h.deployGravity(gravityArtifact)
+	return h
+}
+
+// setupValidators bonds a validator for each of the test keys and registers a freshly
+// generated Ethereum key as its delegate key
+func (h *harness) setupValidators() {
+	t, k := h.t, h.input.GravityKeeper
+
+	h.input.StakingKeeper.SetParams(h.ctx, keeper.TestingStakeParams)
+	sh := staking.NewHandler(h.input.StakingKeeper)
+	for i, valAddr := range keeper.ValAddrs {
+		acc := h.input.AccountKeeper.NewAccount(
+			h.ctx,
+			authtypes.NewBaseAccount(keeper.AccAddrs[i], keeper.AccPubKeys[i], uint64(i), 0),
+		)
+		require.NoError(t, h.input.AddBalanceToBank(h.ctx, acc.GetAddress(), keeper.InitCoins))
+		h.input.AccountKeeper.SetAccount(h.ctx, acc)
+
+		_, err := sh(h.ctx, keeper.NewTestMsgCreateValidator(valAddr, keeper.ConsPubKeys[i], keeper.StakingAmount))
+		require.NoError(t, err)
+
+		ethKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		h.validators = append(h.validators, validator{
+			valAddr:  valAddr,
+			orchAddr: keeper.AccAddrs[i],
+			ethKey:   ethKey,
+			ethAddr:  crypto.PubkeyToAddress(ethKey.PublicKey),
+		})
+	}
+	staking.EndBlocker(h.ctx, h.input.StakingKeeper)
+
+	for _, v := range h.validators {
+		signMsg := h.input.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{ValidatorAddress: v.valAddr.String()})
+		sig, err := types.NewEthereumSignature(crypto.Keccak256Hash(signMsg).Bytes(), v.ethKey)
+		require.NoError(t, err)
+
+		_, err = h.msgServer.SetDelegateKeys(sdk.WrapSDKContext(h.ctx), &types.MsgDelegateKeys{
+			ValidatorAddress:    v.valAddr.String(),
+			OrchestratorAddress: v.orchAddr.String(),
+			EthereumAddress:     v.ethAddr.Hex(),
+			EthSignature:        sig,
+		})
+		require.NoError(t, err)
+	}
+	require.Len(t, k.CurrentSignerSet(h.ctx), len(h.validators))
+}
+
+// deployGravity deploys the Gravity contract with the current signer set of the chain
+func (h *harness) deployGravity(artifact contractArtifact) {
+	t := h.t
+	h.gravityABI = artifact.abi
+
+	// the relayer submits the calldata of the module's ABI definitions, which must be the
+	// functions of the compiled contract
+	for name, method := range calldata.GravityRelayABI().Methods {
+		require.Equal(t, method.ID, artifact.abi.Methods[name].ID, "%s of the Gravity contract", name)
+	}
+
+	signers := h.input.GravityKeeper.CurrentSignerSet(h.ctx)
+	signers.Sort()
+	h.contractValset = calldata.ValsetArgs{
+		Nonce:        big.NewInt(0),
+		RewardAmount: big.NewInt(0),
+	}
+	for _, s := range signers {
+		h.contractValset.Validators = append(h.contractValset.Validators, common.HexToAddress(s.EthereumAddress))
+		h.contractValset.Powers = append(h.contractValset.Powers, new(big.Int).SetUint64(s.Power))
+	}
+
+	var gravityID [32]byte
+	copy(gravityID[:], h.gravityID)
+
+	var err error
+	h.gravityAddr, _, h.gravity, err = bind.DeployContract(
+		h.miner,
+		artifact.abi,
+		artifact.bytecode,
+		h.evm,
+		gravityID,
+		big.NewInt(powerThreshold),
+		h.contractValset.Validators,
+		h.contractValset.Powers,
+	)
+	require.NoError(t, err)
+	h.evm.Commit()
+}
+
+// deployERC20 deploys a test token which mints its supply to the miner
+func (h *harness) deployERC20() common.Address {
+	addr, _, _, err := bind.DeployContract(h.miner, h.erc20.abi, h.erc20.bytecode, h.evm)
+	require.NoError(h.t, err)
+	h.evm.Commit()
+	return addr
+}
+
+func (h *harness) erc20Balance(token, owner common.Address) *big.Int {
+	contract := bind.NewBoundContract(token, h.erc20.abi, h.evm, h.evm, h.evm)
+
+	var out []interface{}
+	require.NoError(h.t, contract.Call(nil, &out, "balanceOf", owner))
+	return out[0].(*big.Int)
+}
+
+// sendToCosmos approves and deposits amount of the token held by the miner to receiver
+func (h *harness) sendToCosmos(token common.Address, receiver sdk.AccAddress, amount int64) {
+	t := h.t
+
+	contract := bind.NewBoundContract(token, h.erc20.abi, h.evm, h.evm, h.evm)
+	_, err := contract.Transact(h.miner, "approve", h.gravityAddr, big.NewInt(amount))
+	require.NoError(t, err)
+	h.evm.Commit()
+
+	var destination [32]byte
+	copy(destination[32-len(receiver):], receiver)
+	_, err = h.gravity.Transact(h.miner, "sendToCosmos", token, destination, big.NewInt(amount))
+	require.NoError(t, err)
+	h.evm.Commit()
+}
+
+// nextBlock ends the current block of the gravity chain and begins the next one
+func (h *harness) nextBlock() {
+	k := h.input.GravityKeeper
+
+	gravity.EndBlocker(h.ctx, k)
+	h.ctx = h.ctx.
+		WithBlockHeight(h.ctx.BlockHeight() + 1).
+		WithBlockTime(h.ctx.BlockTime().Add(5 * time.Second))
+	gravity.BeginBlocker(h.ctx, k)
+}
+
+// attestEvents does the work of the orchestrator Ethereum oracles, every validator submits
+// the Gravity events it hasn't attested to yet and a block is ended to tally the votes
+func (h *harness) attestEvents() {
+	t := h.t
+
+	logs, err := h.evm.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: big.NewInt(0),
+		Addresses: []common.Address{h.gravityAddr},
+	})
+	require.NoError(t, err)
+
+	var events []types.EthereumEvent
+	for _, log := range logs {
+		if event := h.parseEvent(log); event != nil && event.GetEventNonce() > h.lastEventNonce {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].GetEventNonce() < events[j].GetEventNonce() })
+
+	for _, event := range events {
+		for _, v := range h.validators {
+			any, err := types.PackEvent(event)
+			require.NoError(t, err)
+
+			_, err = h.msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(h.ctx), &types.MsgSubmitEthereumEvent{
+				Event:  any,
+				Signer: v.orchAddr.String(),
+			})
+			require.NoError(t, err)
+		}
+		h.lastEventNonce = event.GetEventNonce()
+	}
+	h.nextBlock()
+}
+
+// parseEvent converts a Gravity contract log into the event attested to on chain, logs that
+// aren't attested to are ignored
+func (h *harness) parseEvent(log ethtypes.Log) types.EthereumEvent {
+	t, contractABI := h.t, h.gravityABI
+
+	switch log.Topics[0] {
+	case contractABI.Events["SendToCosmosEvent"].ID:
+		data, err := contractABI.Unpack("SendToCosmosEvent", log.Data)
+		require.NoError(t, err)
+		return &types.SendToCosmosEvent{
+			EventNonce:     data[1].(*big.Int).Uint64(),
+			TokenContract:  common.BytesToAddress(log.Topics[1].Bytes()).Hex(),
+			Amount:         sdk.NewIntFromBigInt(data[0].(*big.Int)),
+			EthereumSender: common.BytesToAddress(log.Topics[2].Bytes()).Hex(),
+			CosmosReceiver: sdk.AccAddress(log.Topics[3].Bytes()[12:]).String(),
+			EthereumHeight: log.BlockNumber,
+		}
+	case contractABI.Events["TransactionBatchExecutedEvent"].ID:
+		data, err := contractABI.Unpack("TransactionBatchExecutedEvent", log.Data)
+		require.NoError(t, err)
+		return &types.BatchExecutedEvent{
+			TokenContract:  common.BytesToAddress(log.Topics[2].Bytes()).Hex(),
+			EventNonce:     data[0].(*big.Int).Uint64(),
+			EthereumHeight: log.BlockNumber,
+			BatchNonce:     log.Topics[1].Big().Uint64(),
+		}
+	case contractABI.Events["ValsetUpdatedEvent"].ID:
+		data, err := contractABI.Unpack("ValsetUpdatedEvent", log.Data)
+		require.NoError(t, err)
+		validators, powers := data[3].([]common.Address), data[4].([]*big.Int)
+		event := &types.SignerSetTxExecutedEvent{
+			EventNonce:       data[0].(*big.Int).Uint64(),
+			SignerSetTxNonce: log.Topics[1].Big().Uint64(),
+			EthereumHeight:   log.BlockNumber,
+		}
+		for i := range validators {
+			event.Members = append(event.Members, &types.EthereumSigner{
+				EthereumAddress: validators[i].Hex(),
+				Power:           powers[i].Uint64(),
+			})
+		}
+		return event
+	}
+	return nil
+}
+
+// signOutgoingTxs does the work of the orchestrator Ethereum signers, every validator signs
+// the signer set txs and batches it hasn't signed yet
+func (h *harness) signOutgoingTxs() {
+	t, k := h.t, h.input.GravityKeeper
+
+	var confirmations []func(v validator) types.EthereumTxConfirmation
+	k.IterateOutgoingTxsByType(h.ctx, keeper.TestingGravityParams.BridgeChainId, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		sstx := otx.(*types.SignerSetTx)
+		checkpoint := sstx.GetCheckpoint([]byte(h.gravityID))
+		confirmations = append(confirmations, func(v validator) types.EthereumTxConfirmation {
+			sig, err := types.NewEthereumSignature(checkpoint, v.ethKey)
+			require.NoError(t, err)
+			return &types.SignerSetTxConfirmation{
+				SignerSetNonce: sstx.Nonce,
+				EthereumSigner: v.ethAddr.Hex(),
+				Signature:      sig,
+			}
+		})
+		return false
+	})
+	k.IterateOutgoingTxsByType(h.ctx, keeper.TestingGravityParams.BridgeChainId, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		btx := otx.(*types.BatchTx)
+		checkpoint := btx.GetCheckpoint([]byte(h.gravityID))
+		confirmations = append(confirmations, func(v validator) types.EthereumTxConfirmation {
+			sig, err := types.NewEthereumSignature(checkpoint, v.ethKey)
+			require.NoError(t, err)
+			return &types.BatchTxConfirmation{
+				TokenContract:  btx.TokenContract,
+				BatchNonce:     btx.BatchNonce,
+				EthereumSigner: v.ethAddr.Hex(),
+				Signature:      sig,
+			}
+		})
+		return false
+	})
+
+	for _, confirm := range confirmations {
+		for _, v := range h.validators {
+			confirmation := confirm(v)
+			if _, signed := k.GetEthereumSignatures(h.ctx, keeper.TestingGravityParams.BridgeChainId, confirmation.GetStoreIndex())[v.valAddr.String()]; signed {
+				continue
+			}
+
+			any, err := types.PackConfirmation(confirmation)
+			require.NoError(t, err)
+			_, err = h.msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(h.ctx), &types.MsgSubmitEthereumTxConfirmation{
+				Confirmation: any,
+				Signer:       v.orchAddr.String(),
+			})
+			require.NoError(t, err)
+		}
+	}
+}
+
+// batchTx returns the pending batch of the token with the highest nonce
+func (h *harness) batchTx(token common.Address) (out *types.BatchTx) {
+	h.input.GravityKeeper.IterateOutgoingTxsByType(h.ctx, keeper.TestingGravityParams.BridgeChainId, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		btx := otx.(*types.BatchTx)
+		if common.HexToAddress(btx.TokenContract) == token && (out == nil || btx.BatchNonce > out.BatchNonce) {
+			out = btx
+		}
+		return false
+	})
+	return out
+}
+
+// relayBatch does the work of the relayer, submitting the signed batch to the Gravity contract
+// with the calldata the module's relayers build
+func (h *harness) relayBatch(btx *types.BatchTx) {
+	t, k := h.t, h.input.GravityKeeper
+
+	signatures := k.GetEthereumSignatures(h.ctx, keeper.TestingGravityParams.BridgeChainId, btx.GetStoreIndex())
+	sigs := make([]calldata.ValSignature, len(h.contractValset.Validators))
+	for _, v := range h.validators {
+		sig, ok := signatures[v.valAddr.String()]
+		if !ok {
+			continue
+		}
+		for i, addr := range h.contractValset.Validators {
+			if addr == v.ethAddr {
+				sigs[i] = calldata.NewValSignature(sig)
+			}
+		}
+	}
+
+	var batch calldata.BatchArgs
+	for _, tx := range btx.Transactions {
+		batch.Amounts = append(batch.Amounts, tx.Erc20Token.Amount.BigInt())
+		batch.Fees = append(batch.Fees, tx.Erc20Fee.Amount.BigInt())
+		batch.Destinations = append(batch.Destinations, common.HexToAddress(tx.EthereumRecipient))
+	}
+	batch.BatchNonce = new(big.Int).SetUint64(btx.BatchNonce)
+	batch.TokenContract = common.HexToAddress(btx.TokenContract)
+	batch.BatchTimeout = new(big.Int).SetUint64(btx.Timeout)
+
+	data, err := calldata.SubmitBatch(h.contractValset, sigs, batch)
+	require.NoError(t, err)
+	_, err = h.gravity.RawTransact(h.miner, data)
+	require.NoError(t, err)
+	h.evm.Commit()
+}
@@ -41,6 +41,50 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SubmitEthereumHeightVote(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgRequestDepositAddress:
+			res, err := msgServer.RequestDepositAddress(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSendERC1155ToEthereum:
+			res, err := msgServer.SendERC1155ToEthereum(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUpdateParams:
+			res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgBridgeAdminPause:
+			res, err := msgServer.BridgeAdminPause(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgBridgeAdminSetRateLimits:
+			res, err := msgServer.BridgeAdminSetRateLimits(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgBridgeAdminSetFeeFloors:
+			res, err := msgServer.BridgeAdminSetFeeFloors(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgMintVouchers:
+			res, err := msgServer.MintVouchers(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgBurnVouchers:
+			res, err := msgServer.BurnVouchers(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgVetoBatchTx:
+			res, err := msgServer.VetoBatchTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgVetoContractCallTx:
+			res, err := msgServer.VetoContractCallTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgInjectEthereumEvent:
+			res, err := msgServer.InjectEthereumEvent(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -52,6 +96,22 @@ func NewCommunityPoolEthereumSpendProposalHandler(k keeper.Keeper) govtypes.Hand
 		switch c := content.(type) {
 		case *types.CommunityPoolEthereumSpendProposal:
 			return k.HandleCommunityPoolEthereumSpendProposal(ctx, c)
+		case *types.AddEVMChainProposal:
+			return k.HandleAddEVMChainProposal(ctx, c)
+		case *types.ContractMigrationProposal:
+			return k.HandleContractMigrationProposal(ctx, c)
+		case *types.EVMChainPauseProposal:
+			return k.HandleEVMChainPauseProposal(ctx, c)
+		case *types.GravityIDRotationProposal:
+			return k.HandleGravityIDRotationProposal(ctx, c)
+		case *types.UpdateParamsProposal:
+			return k.HandleUpdateParamsProposal(ctx, c)
+		case *types.RelayerIncentiveProposal:
+			return k.HandleRelayerIncentiveProposal(ctx, c)
+		case *types.EthereumEventRejectionProposal:
+			return k.HandleEthereumEventRejectionProposal(ctx, c)
+		case *types.IncidentRecoveryProposal:
+			return k.HandleIncidentRecoveryProposal(ctx, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
 		}
@@ -116,7 +116,7 @@ func TestMsgSubmitEthreumEventSendToCosmosSingleValidator(t *testing.T) {
 	eva, err := types.PackEvent(sendToCosmosEvent)
 	require.NoError(t, err)
 
-	msgSubmitEvent := &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String()}
+	msgSubmitEvent := &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String(), 0}
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
 	_, err = h(ctx, msgSubmitEvent)
@@ -124,7 +124,7 @@ func TestMsgSubmitEthreumEventSendToCosmosSingleValidator(t *testing.T) {
 	require.NoError(t, err)
 
 	// and attestation persisted
-	a := gk.GetEthereumEventVoteRecord(ctx, myNonce, sendToCosmosEvent.Hash())
+	a := gk.GetEthereumEventVoteRecord(ctx, keeper.TestingGravityParams.BridgeChainId, myNonce, sendToCosmosEvent.Hash())
 	require.NotNil(t, a)
 	// and vouchers added to the account
 
@@ -154,7 +154,7 @@ func TestMsgSubmitEthreumEventSendToCosmosSingleValidator(t *testing.T) {
 	eva, err = types.PackEvent(sendToCosmosEvent)
 	require.NoError(t, err)
 
-	msgSubmitEvent = &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String()}
+	msgSubmitEvent = &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String(), 0}
 
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
@@ -178,7 +178,7 @@ func TestMsgSubmitEthreumEventSendToCosmosSingleValidator(t *testing.T) {
 	eva, err = types.PackEvent(sendToCosmosEvent)
 	require.NoError(t, err)
 
-	msgSubmitEvent = &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String()}
+	msgSubmitEvent = &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String(), 0}
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
 	_, err = h(ctx, msgSubmitEvent)
@@ -227,7 +227,7 @@ func TestMsgSubmitEthreumEventSendToCosmosMultiValidator(t *testing.T) {
 	}
 	ethClaim1a, err := types.PackEvent(ethClaim1)
 	require.NoError(t, err)
-	ethClaim1Msg := &types.MsgSubmitEthereumEvent{ethClaim1a, orchestratorAddr1.String()}
+	ethClaim1Msg := &types.MsgSubmitEthereumEvent{ethClaim1a, orchestratorAddr1.String(), 0}
 	ethClaim2 := &types.SendToCosmosEvent{
 		EventNonce:     myNonce,
 		TokenContract:  myErc20.Contract,
@@ -237,7 +237,7 @@ func TestMsgSubmitEthreumEventSendToCosmosMultiValidator(t *testing.T) {
 	}
 	ethClaim2a, err := types.PackEvent(ethClaim2)
 	require.NoError(t, err)
-	ethClaim2Msg := &types.MsgSubmitEthereumEvent{ethClaim2a, orchestratorAddr2.String()}
+	ethClaim2Msg := &types.MsgSubmitEthereumEvent{ethClaim2a, orchestratorAddr2.String(), 0}
 	ethClaim3 := &types.SendToCosmosEvent{
 		EventNonce:     myNonce,
 		TokenContract:  myErc20.Contract,
@@ -247,7 +247,7 @@ func TestMsgSubmitEthreumEventSendToCosmosMultiValidator(t *testing.T) {
 	}
 	ethClaim3a, err := types.PackEvent(ethClaim3)
 	require.NoError(t, err)
-	ethClaim3Msg := &types.MsgSubmitEthereumEvent{ethClaim3a, orchestratorAddr3.String()}
+	ethClaim3Msg := &types.MsgSubmitEthereumEvent{ethClaim3a, orchestratorAddr3.String(), 0}
 
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
@@ -255,7 +255,7 @@ func TestMsgSubmitEthreumEventSendToCosmosMultiValidator(t *testing.T) {
 	gravity.EndBlocker(ctx, input.GravityKeeper)
 	require.NoError(t, err)
 	// and attestation persisted
-	a1 := input.GravityKeeper.GetEthereumEventVoteRecord(ctx, myNonce, ethClaim1.Hash())
+	a1 := input.GravityKeeper.GetEthereumEventVoteRecord(ctx, keeper.TestingGravityParams.BridgeChainId, myNonce, ethClaim1.Hash())
 	require.NotNil(t, a1)
 	// and vouchers not yet added to the account
 	balance1 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
@@ -268,7 +268,7 @@ func TestMsgSubmitEthreumEventSendToCosmosMultiValidator(t *testing.T) {
 	require.NoError(t, err)
 
 	// and attestation persisted
-	a2 := input.GravityKeeper.GetEthereumEventVoteRecord(ctx, myNonce, ethClaim2.Hash())
+	a2 := input.GravityKeeper.GetEthereumEventVoteRecord(ctx, keeper.TestingGravityParams.BridgeChainId, myNonce, ethClaim2.Hash())
 	require.NotNil(t, a2)
 	// and vouchers now added to the account
 	balance2 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
@@ -281,7 +281,7 @@ func TestMsgSubmitEthreumEventSendToCosmosMultiValidator(t *testing.T) {
 	require.NoError(t, err)
 
 	// and attestation persisted
-	a3 := input.GravityKeeper.GetEthereumEventVoteRecord(ctx, myNonce, ethClaim3.Hash())
+	a3 := input.GravityKeeper.GetEthereumEventVoteRecord(ctx, keeper.TestingGravityParams.BridgeChainId, myNonce, ethClaim3.Hash())
 	require.NotNil(t, a3)
 	// and no additional added to the account
 	balance3 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
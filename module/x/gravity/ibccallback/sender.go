@@ -0,0 +1,92 @@
+package ibccallback
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+const (
+	// ModuleName is the name the sender's capabilities are scoped to
+	ModuleName = "gravitycallback"
+	// PortID is the port the sender is bound to
+	PortID = "gravitycallback"
+	// Version is the channel version of the sender
+	Version = "gravity-callback-1"
+)
+
+// PortKeeper defines the expected IBC port keeper methods
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// ChannelKeeper defines the expected IBC channel keeper methods
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+
+// Sender sends the outcome of deposits forwarded over IBC to the callback contracts of
+// their forward channels, giving the protocols deposits are made for a signal of their
+// completion. Callbacks are sent once, neither their acknowledgements nor timeouts are
+// acted on.
+type Sender struct {
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+	portKeeper    PortKeeper
+	channelKeeper ChannelKeeper
+}
+
+// NewSender returns the callback sender
+func NewSender(scopedKeeper capabilitykeeper.ScopedKeeper, portKeeper PortKeeper, channelKeeper ChannelKeeper) Sender {
+	return Sender{
+		scopedKeeper:  scopedKeeper,
+		portKeeper:    portKeeper,
+		channelKeeper: channelKeeper,
+	}
+}
+
+// BindPort binds the sender to its port unless it already is
+func (s Sender) BindPort(ctx sdk.Context) error {
+	if _, bound := s.scopedKeeper.GetCapability(ctx, host.PortPath(PortID)); bound {
+		return nil
+	}
+	cap := s.portKeeper.BindPort(ctx, PortID)
+	return s.scopedKeeper.ClaimCapability(ctx, cap, host.PortPath(PortID))
+}
+
+// SendDepositCompletion sends the outcome of a forwarded deposit on the channel, the
+// counterparty having until the timeout, in nanoseconds since the epoch, to receive it
+func (s Sender) SendDepositCompletion(ctx sdk.Context, channelID string, data types.DepositCompletionPacketData, timeoutTimestamp uint64) error {
+	channel, found := s.channelKeeper.GetChannel(ctx, PortID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", PortID, channelID)
+	}
+	chanCap, found := s.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(PortID, channelID))
+	if !found {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+	sequence, found := s.channelKeeper.GetNextSequenceSend(ctx, PortID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", PortID, channelID)
+	}
+
+	packet := channeltypes.NewPacket(
+		types.ModuleCdc.MustMarshalJSON(&data),
+		sequence,
+		PortID,
+		channelID,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+	)
+	return s.channelKeeper.SendPacket(ctx, chanCap, packet)
+}
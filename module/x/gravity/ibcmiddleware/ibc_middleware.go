@@ -0,0 +1,352 @@
+package ibcmiddleware
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// ExitMemo is the memo of an ICS-20 transfer that sends the transferred tokens on to an
+// EVM chain once they are received, either to a recipient or to a logic contract, e.g.
+//
+//	{"send_to_ethereum":{"ethereum_recipient":"0x...","bridge_fee":"100"}}
+//	{"logic_call":{"template":"swap","bridge_fee":"100"}}
+type ExitMemo struct {
+	SendToEthereum *SendToEthereumMemo `json:"send_to_ethereum,omitempty"`
+	LogicCall      *LogicCallMemo      `json:"logic_call,omitempty"`
+}
+
+// SendToEthereumMemo describes the send to Ethereum the transferred tokens are put in the
+// outgoing pool with. The bridge fee is paid out of the transferred amount, in its denom.
+// The chain id selects the EVM chain, zero being the default chain.
+type SendToEthereumMemo struct {
+	EthereumRecipient string `json:"ethereum_recipient"`
+	BridgeFee         string `json:"bridge_fee,omitempty"`
+	EvmChainId        uint64 `json:"evm_chain_id,omitempty"`
+}
+
+// LogicCallMemo names the logic call template the transferred tokens are sent to the logic
+// contract of. The bridge fee is paid out of the transferred amount, in its denom, and the
+// tokens are refunded to the receiver if the call times out.
+type LogicCallMemo struct {
+	Template  string `json:"template"`
+	BridgeFee string `json:"bridge_fee,omitempty"`
+}
+
+// DepositCompletionSender sends the outcome of deposits forwarded over IBC on the callback
+// channels of their forward channels
+type DepositCompletionSender interface {
+	SendDepositCompletion(ctx sdk.Context, channelID string, data types.DepositCompletionPacketData, timeoutTimestamp uint64) error
+}
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the ICS-20 transfer application and sends the tokens of received
+// transfers whose memo requests it on to Ethereum, or makes the logic call the memo names. The tokens are received by the transfer's
+// receiver, who then sends them to Ethereum, so a send canceled while still in the pool is
+// refunded to the receiver. The acknowledgement of the transfer carries the id of the send,
+// and is an error if the send isn't admitted to the pool, in which case the transfer is
+// reverted and refunded on the sending chain. Once the transfer of a deposit forwarded over
+// IBC is acknowledged or times out, the outcome is sent to the callback contract of the
+// forward channel.
+type IBCMiddleware struct {
+	app       porttypes.IBCModule
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	callbacks DepositCompletionSender
+}
+
+// NewIBCMiddleware returns the middleware wrapping the transfer application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper, callbacks DepositCompletionSender) IBCMiddleware {
+	return IBCMiddleware{
+		app:       app,
+		keeper:    k,
+		msgServer: keeper.NewMsgServerImpl(k),
+		callbacks: callbacks,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. Transfers without an exit memo are
+// left to the transfer application. Received gravity vouchers are reported with the ERC20
+// their denom traces back to, so vouchers returning over another path than they left on
+// can be recognized.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	var (
+		memo        ExitMemo
+		msg         *types.MsgSendToEthereum
+		amount, fee sdk.Coin
+		err         error
+	)
+	if json.Unmarshal([]byte(data.Memo), &memo) == nil {
+		switch {
+		case memo.SendToEthereum != nil && memo.LogicCall != nil:
+			return transfertypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "memo both sends to ethereum and makes a logic call"))
+		case memo.SendToEthereum != nil:
+			if msg, err = sendToEthereumMsg(packet, data, *memo.SendToEthereum); err != nil {
+				return transfertypes.NewErrorAcknowledgement(err)
+			}
+		case memo.LogicCall != nil:
+			if amount, fee, err = splitBridgeFee(packet, data, memo.LogicCall.BridgeFee); err != nil {
+				return transfertypes.NewErrorAcknowledgement(err)
+			}
+		}
+	}
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+	im.emitVoucherTrace(ctx, packet, data)
+	if memo.LogicCall != nil {
+		return im.makeLogicCall(ctx, data, *memo.LogicCall, amount, fee)
+	}
+	if msg == nil {
+		return ack
+	}
+
+	res, err := im.msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(res))
+}
+
+// makeLogicCall makes the logic call of the template with the tokens credited to the
+// receiver, acknowledging the transfer with the call
+func (im IBCMiddleware) makeLogicCall(
+	ctx sdk.Context,
+	data transfertypes.FungibleTokenPacketData,
+	memo LogicCallMemo,
+	amount sdk.Coin,
+	fee sdk.Coin,
+) ibcexported.Acknowledgement {
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+	cctx, err := im.keeper.CreateTemplateLogicCall(ctx, memo.Template, receiver, amount, fee)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalJSON(cctx))
+}
+
+// emitVoucherTrace emits the denom trace of received gravity vouchers
+func (im IBCMiddleware) emitVoucherTrace(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) {
+	denom := receivedDenom(packet, data.Denom)
+	chainID, tokenContract, found := im.keeper.ResolveVoucherTrace(ctx, denom)
+	if !found {
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeVoucherTrace,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyDenomTrace, receivedDenomTrace(packet, data.Denom).GetFullDenomPath()),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.FormatUint(chainID, 10)),
+		types.EthereumAddressAttribute(types.AttributeKeyTokenContract, tokenContract.Hex()),
+	))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil
+	}
+	im.completeForwardedDeposit(ctx, packet, ack.GetError())
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+	im.completeForwardedDeposit(ctx, packet, "transfer timed out")
+	return nil
+}
+
+// completeForwardedDeposit sends the outcome of the deposit a transfer forwarded, if it
+// forwarded one, on the callback channel of its forward channel. Callbacks that can't be
+// sent are dropped rather than failing the acknowledgement, which would hold up refunds.
+func (im IBCMiddleware) completeForwardedDeposit(ctx sdk.Context, packet channeltypes.Packet, errMsg string) {
+	deposit, found := im.keeper.CompleteForwardedDeposit(ctx, packet.GetSourceChannel(), packet.GetSequence(), errMsg)
+	if !found || im.callbacks == nil {
+		return
+	}
+	channel, found := im.keeper.GetParams(ctx).GetIBCForwardChannel(deposit.ChannelId)
+	if !found || channel.CallbackChannelId == "" {
+		return
+	}
+
+	data := types.DepositCompletionPacketData{
+		Contract: channel.CallbackContract,
+		Deposit:  deposit,
+		Success:  errMsg == "",
+		Error:    errMsg,
+	}
+	timeout := ctx.BlockTime().Add(time.Duration(channel.Timeout) * time.Second)
+	xCtx, commit := ctx.CacheContext()
+	if err := im.callbacks.SendDepositCompletion(xCtx, channel.CallbackChannelId, data, uint64(timeout.UnixNano())); err != nil {
+		im.keeper.Logger(ctx).Info(
+			"deposit completion could not be sent",
+			"channel", channel.CallbackChannelId,
+			"nonce", deposit.EventNonce,
+			"cause", err.Error(),
+		)
+		return
+	}
+	commit()
+}
+
+// sendToEthereumMsg builds the send of the tokens a transfer credits to its receiver, the
+// bridge fee being paid out of them
+func sendToEthereumMsg(
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	memo SendToEthereumMemo,
+) (*types.MsgSendToEthereum, error) {
+	amount, fee, err := splitBridgeFee(packet, data, memo.BridgeFee)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgSendToEthereum{
+		Sender:            data.Receiver,
+		EthereumRecipient: memo.EthereumRecipient,
+		Amount:            amount,
+		BridgeFee:         fee,
+		EvmChainId:        memo.EvmChainId,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// splitBridgeFee splits the tokens a transfer credits to its receiver into the amount
+// bridged and the bridge fee paid out of them
+func splitBridgeFee(
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	bridgeFee string,
+) (sdk.Coin, sdk.Coin, error) {
+	transferred, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "transfer amount %s", data.Amount)
+	}
+	fee := sdk.ZeroInt()
+	if bridgeFee != "" {
+		if fee, ok = sdk.NewIntFromString(bridgeFee); !ok || fee.IsNegative() {
+			return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bridge fee %s", bridgeFee)
+		}
+	}
+	if !fee.LT(transferred) {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "bridge fee %s is not below the transfer amount %s", fee, transferred)
+	}
+
+	denom := receivedDenom(packet, data.Denom)
+	return sdk.NewCoin(denom, transferred.Sub(fee)), sdk.NewCoin(denom, fee), nil
+}
+
+// receivedDenom returns the denom the transfer application credits the receiver of a
+// packet with
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	return receivedDenomTrace(packet, denom).IBCDenom()
+}
+
+// receivedDenomTrace returns the trace of the denom of a received packet, unwinding it if
+// the denom returns to this chain and extending it with the packet's destination otherwise
+func receivedDenomTrace(packet channeltypes.Packet, denom string) transfertypes.DenomTrace {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		unprefixed := denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		return transfertypes.ParseDenomTrace(unprefixed)
+	}
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixed)
+}
@@ -0,0 +1,229 @@
+package ibcmiddleware
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// transferAppMock credits the receiver of a packet with the denom the transfer application would
+type transferAppMock struct {
+	porttypes.IBCModule
+	input keeper.TestInput
+	recvs int
+}
+
+func (m *transferAppMock) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	m.recvs++
+	var data transfertypes.FungibleTokenPacketData
+	transfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+	amount, _ := sdk.NewIntFromString(data.Amount)
+	coins := sdk.NewCoins(sdk.NewCoin(receivedDenom(packet, data.Denom), amount))
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+	if err := m.input.BankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+	if err := m.input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (m *transferAppMock) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (m *transferAppMock) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+// callbacksMock records the deposit completions sent
+type callbacksMock struct {
+	channels    []string
+	completions []types.DepositCompletionPacketData
+}
+
+func (m *callbacksMock) SendDepositCompletion(_ sdk.Context, channelID string, data types.DepositCompletionPacketData, _ uint64) error {
+	m.channels = append(m.channels, channelID)
+	m.completions = append(m.completions, data)
+	return nil
+}
+
+func TestOnRecvPacketSendToEthereum(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	app := &transferAppMock{input: input}
+	middleware := NewIBCMiddleware(app, input.GravityKeeper, nil)
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom         = types.GravityDenom(tokenContract)
+		receiver      = keeper.AccAddrs[0]
+		recipient     = keeper.EthAddrs[0].Hex()
+	)
+	recv := func(denom string, amount string, memo string) ibcexported.Acknowledgement {
+		data := transfertypes.NewFungibleTokenPacketData(denom, amount, "osmo1sender", receiver.String())
+		data.Memo = memo
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-9", transfertypes.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0)
+		return middleware.OnRecvPacket(ctx, packet, nil)
+	}
+	exitMemo := func(fee string) string {
+		return fmt.Sprintf(`{"send_to_ethereum":{"ethereum_recipient":"%s","bridge_fee":"%s"}}`, recipient, fee)
+	}
+	// vouchers returning from the counterparty carry its trace of this chain's channel
+	returning := transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-9", denom)
+
+	// transfers without an exit memo are only received
+	ack := recv(returning, "100", `{"forward":{}}`)
+	require.True(t, ack.Success())
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount)
+
+	// the received vouchers are reported with their ERC20
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeVoucherTrace, events[len(events)-1].Type)
+	require.Contains(t, events[len(events)-1].Attributes, abci.EventAttribute{
+		Key: []byte(types.AttributeKeyTokenContract), Value: []byte(strings.ToLower(tokenContract.Hex())),
+	})
+
+	// the received vouchers are put in the pool, less the fee
+	ack = recv(returning, "1000", exitMemo("10"))
+	require.True(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "result")
+	var sends []*types.SendToEthereum
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, chainID, func(ste *types.SendToEthereum) bool {
+		sends = append(sends, ste)
+		return false
+	})
+	require.Len(t, sends, 1)
+	require.Equal(t, receiver.String(), sends[0].Sender)
+	require.Equal(t, recipient, sends[0].EthereumRecipient)
+	require.Equal(t, sdk.NewInt(990), sends[0].Erc20Token.Amount)
+	require.Equal(t, sdk.NewInt(10), sends[0].Erc20Fee.Amount)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount)
+
+	// fees that consume the transfer are rejected before it is received
+	recvs := app.recvs
+	require.False(t, recv(returning, "10", exitMemo("10")).Success())
+	require.Equal(t, recvs, app.recvs)
+
+	// tokens that can't be bridged fail the transfer
+	require.False(t, recv("uosmo", "1000", exitMemo("10")).Success())
+}
+
+func TestOnRecvPacketLogicCall(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	app := &transferAppMock{input: input}
+	middleware := NewIBCMiddleware(app, input.GravityKeeper, nil)
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		logicContract = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		denom         = types.GravityDenom(tokenContract)
+		receiver      = keeper.AccAddrs[0]
+		returning     = transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-9", denom)
+	)
+	params := input.GravityKeeper.GetParams(ctx)
+	params.LogicCallTemplates = []types.LogicCallTemplate{{Name: "swap", LogicContract: logicContract.Hex(), Payload: []byte("payload")}}
+	input.SetParams(ctx, params)
+
+	recv := func(amount string, memo string) ibcexported.Acknowledgement {
+		data := transfertypes.NewFungibleTokenPacketData(returning, amount, "osmo1sender", receiver.String())
+		data.Memo = memo
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-9", transfertypes.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0)
+		return middleware.OnRecvPacket(ctx, packet, nil)
+	}
+
+	// the received vouchers, less the fee, are sent to the logic contract
+	ack := recv("1000", `{"logic_call":{"template":"swap","bridge_fee":"10"}}`)
+	require.True(t, ack.Success())
+	var calls []*types.ContractCallTx
+	input.GravityKeeper.IterateOutgoingTxsByType(ctx, chainID, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		calls = append(calls, otx.(*types.ContractCallTx))
+		return false
+	})
+	require.Len(t, calls, 1)
+	require.Equal(t, logicContract.Hex(), calls[0].Address)
+	require.Equal(t, sdk.NewInt(990), calls[0].Tokens[0].Amount)
+	require.Equal(t, sdk.NewInt(10), calls[0].Fees[0].Amount)
+	require.Equal(t, receiver.String(), calls[0].RefundAddress)
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.IsZero())
+
+	// templates governance hasn't allowed fail the transfer
+	require.False(t, recv("1000", `{"logic_call":{"template":"lend"}}`).Success())
+
+	// as do memos that both send and call
+	recvs := app.recvs
+	memo := fmt.Sprintf(`{"send_to_ethereum":{"ethereum_recipient":"%s"},"logic_call":{"template":"swap"}}`, keeper.EthAddrs[0].Hex())
+	require.False(t, recv("1000", memo).Success())
+	require.Equal(t, recvs, app.recvs)
+}
+
+func TestForwardedDepositCompletion(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	callbacks := &callbacksMock{}
+	middleware := NewIBCMiddleware(&transferAppMock{input: input}, input.GravityKeeper, callbacks)
+
+	params := input.GravityKeeper.GetParams(ctx)
+	params.IbcForwardChannels = []types.IBCForwardChannel{
+		{ChannelId: "channel-3", Bech32Prefix: "osmo", Timeout: 600, CallbackChannelId: "channel-7", CallbackContract: "osmo1contract"},
+	}
+	input.SetParams(ctx, params)
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	deposit := func(nonce uint64) {
+		require.NoError(t, input.GravityKeeper.Handle(ctx, chainID, &types.SendToCosmosEvent{
+			EventNonce:        nonce,
+			TokenContract:     tokenContract.Hex(),
+			Amount:            sdk.NewInt(100),
+			EthereumSender:    keeper.EthAddrs[0].Hex(),
+			CosmosReceiver:    keeper.AccAddrs[0].String(),
+			EthereumHeight:    10,
+			ForwardIbcChannel: "channel-3",
+		}))
+	}
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.NewPacket(nil, sequence, transfertypes.PortID, "channel-3", transfertypes.PortID, "channel-9", clienttypes.NewHeight(0, 100), 0)
+	}
+	deposit(1)
+	deposit(2)
+
+	// acknowledged transfers complete their deposits, successfully or not
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet(1), ack.Acknowledgement(), nil))
+	require.Equal(t, []string{"channel-7"}, callbacks.channels)
+	require.True(t, callbacks.completions[0].Success)
+	require.Equal(t, "osmo1contract", callbacks.completions[0].Contract)
+	require.Equal(t, uint64(1), callbacks.completions[0].Deposit.EventNonce)
+
+	// as do timed out transfers
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, packet(2), nil))
+	require.Len(t, callbacks.completions, 2)
+	require.False(t, callbacks.completions[1].Success)
+	require.Equal(t, uint64(2), callbacks.completions[1].Deposit.EventNonce)
+
+	// transfers that didn't forward a deposit, or were already completed, send nothing
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet(1), ack.Acknowledgement(), nil))
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, packet(5), nil))
+	require.Len(t, callbacks.completions, 2)
+}
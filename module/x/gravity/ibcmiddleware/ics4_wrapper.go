@@ -0,0 +1,58 @@
+package ibcmiddleware
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+)
+
+var _ porttypes.ICS4Wrapper = ICS4Wrapper{}
+
+// ICS4Wrapper wraps the channel keeper the transfer application sends packets with, so
+// that gravity vouchers leaving over IBC count against the rate limits of their ERC20s
+// like transfers to their EVM chain do. Otherwise vouchers minted by a compromised EVM
+// chain could leave immediately over IBC.
+type ICS4Wrapper struct {
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      *keeper.Keeper
+}
+
+// NewICS4Wrapper returns the wrapper of the channel keeper. The gravity keeper is taken by
+// reference as the transfer keeper, which the gravity keeper depends on, is created first.
+func NewICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, k *keeper.Keeper) ICS4Wrapper {
+	return ICS4Wrapper{
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface, rejecting transfers of vouchers that
+// exceed the rate limit of their ERC20
+func (w ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if packet.GetSourcePort() == transfertypes.PortID &&
+		transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data) == nil {
+		amount, ok := sdk.NewIntFromString(data.Amount)
+		if !ok {
+			return transfertypes.ErrInvalidAmount
+		}
+		if err := w.keeper.ConsumeVoucherRateLimit(ctx, data.Denom, amount); err != nil {
+			return err
+		}
+	}
+	return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (w ICS4Wrapper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
@@ -0,0 +1,62 @@
+package ibcmiddleware
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// channelKeeperMock records the packets sent through it
+type channelKeeperMock struct {
+	porttypes.ICS4Wrapper
+	sent int
+}
+
+func (m *channelKeeperMock) SendPacket(sdk.Context, *capabilitytypes.Capability, ibcexported.PacketI) error {
+	m.sent++
+	return nil
+}
+
+func TestSendPacketRateLimit(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	chain := types.EVMChain{
+		ChainId:               42161,
+		Name:                  "arbitrum",
+		GravityId:             "arbitrum-gravity",
+		BridgeEthereumAddress: "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf",
+		RateLimits:            []types.RateLimit{{TokenContract: tokenContract.Hex(), Limit: sdk.NewInt(100), Window: 10}},
+	}
+	require.NoError(t, input.GravityKeeper.AddEVMChain(ctx, chain))
+	voucher := types.EVMChainGravityDenom(chain.ChainId, tokenContract)
+
+	channelKeeper := &channelKeeperMock{}
+	wrapper := NewICS4Wrapper(channelKeeper, &input.GravityKeeper)
+	send := func(port string, denom string, amount string) error {
+		data := transfertypes.NewFungibleTokenPacketData(denom, amount, keeper.AccAddrs[0].String(), "osmo1receiver")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, port, "channel-0", transfertypes.PortID, "channel-9", clienttypes.NewHeight(0, 100), 0)
+		return wrapper.SendPacket(ctx, nil, packet)
+	}
+
+	// vouchers leaving over IBC count against the rate limit of their ERC20
+	require.NoError(t, send(transfertypes.PortID, voucher, "100"))
+	require.ErrorIs(t, send(transfertypes.PortID, voucher, "1"), types.ErrRateLimited)
+	require.Equal(t, 1, channelKeeper.sent)
+
+	// other denoms and ports pass through
+	require.NoError(t, send(transfertypes.PortID, "uatom", "1000"))
+	require.NoError(t, send("icahost", voucher, "1"))
+	require.Equal(t, 3, channelKeeper.sent)
+}
@@ -0,0 +1,95 @@
+package icq
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+const (
+	// ModuleName is the name the host's capabilities are scoped to
+	ModuleName = "icqhost"
+	// PortID is the port the host is bound to, that of async-icq hosts
+	PortID = "icqhost"
+	// Version is the channel version of the host, that of async-icq
+	Version = "icq-1"
+	// MaxRequests is the most queries a packet may hold, the packets holding more are
+	// acknowledged with an error
+	MaxRequests = 16
+)
+
+// icqCdc encodes the packet data and acknowledgements as async-icq controllers expect
+var icqCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// PortKeeper defines the expected IBC port keeper methods
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// Host answers interchain queries of the bridge state. Only the gravity queries of
+// types.InterchainQueryPaths are answered, at the latest height and without proofs,
+// the light client of the querying chain verifying the acknowledgement instead.
+type Host struct {
+	scopedKeeper capabilitykeeper.ScopedKeeper
+	portKeeper   PortKeeper
+	queryRouter  *baseapp.GRPCQueryRouter
+}
+
+// NewHost returns the interchain query host
+func NewHost(scopedKeeper capabilitykeeper.ScopedKeeper, portKeeper PortKeeper, queryRouter *baseapp.GRPCQueryRouter) Host {
+	return Host{
+		scopedKeeper: scopedKeeper,
+		portKeeper:   portKeeper,
+		queryRouter:  queryRouter,
+	}
+}
+
+// BindPort binds the host to its port unless it already is
+func (h Host) BindPort(ctx sdk.Context) error {
+	if _, bound := h.scopedKeeper.GetCapability(ctx, host.PortPath(PortID)); bound {
+		return nil
+	}
+	cap := h.portKeeper.BindPort(ctx, PortID)
+	return h.scopedKeeper.ClaimCapability(ctx, cap, host.PortPath(PortID))
+}
+
+// executeQueries answers the queries in order, failing all of them if one fails. The queries
+// read the store with the gas meter of ctx, charging their reads to the tx relaying the packet.
+func (h Host) executeQueries(ctx sdk.Context, requests []abci.RequestQuery) ([]abci.ResponseQuery, error) {
+	if len(requests) > MaxRequests {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%d queries exceed the maximum of %d per packet", len(requests), MaxRequests)
+	}
+
+	allowed := make(map[string]bool)
+	for _, path := range types.InterchainQueryPaths() {
+		allowed[path] = true
+	}
+
+	responses := make([]abci.ResponseQuery, len(requests))
+	for i, request := range requests {
+		if !allowed[request.Path] {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "query path %s is not allowed", request.Path)
+		}
+		if request.Height != 0 || request.Prove {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query %s must be at the latest height without proof", request.Path)
+		}
+		route := h.queryRouter.Route(request.Path)
+		if route == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no route for query path %s", request.Path)
+		}
+		response, err := route(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = abci.ResponseQuery{Value: response.Value, Height: ctx.BlockHeight()}
+	}
+	return responses, nil
+}
@@ -0,0 +1,120 @@
+package icq
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS-26 callbacks of the interchain query host. Channels are
+// opened by the querying chain, the host never sends packets of its own.
+type IBCModule struct {
+	host Host
+}
+
+// NewIBCModule returns the IBC callbacks of the host
+func NewIBCModule(h Host) IBCModule {
+	return IBCModule{host: h}
+}
+
+// OnChanOpenInit implements the IBCModule interface, the host doesn't open channels
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return sdkerrors.Wrap(channeltypes.ErrInvalidChannel, "channel handshake must be initiated by the querying chain")
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if portID != PortID {
+		return "", sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, PortID)
+	}
+	if counterpartyVersion != Version {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelVersion, "invalid counterparty version: got %s, expected %s", counterpartyVersion, Version)
+	}
+	if err := im.host.scopedKeeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+	return Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	return sdkerrors.Wrap(channeltypes.ErrInvalidChannel, "channel handshake must be initiated by the querying chain")
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface, answering the queries of the packet
+// in its acknowledgement
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data types.InterchainQueryPacketData
+	if err := icqCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement("cannot unmarshal interchain query packet data")
+	}
+	var query types.CosmosQuery
+	if err := query.Unmarshal(data.Data); err != nil {
+		return channeltypes.NewErrorAcknowledgement("cannot unmarshal interchain queries")
+	}
+
+	responses, err := im.host.executeQueries(ctx, query.Requests)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+	bz, err := (&types.CosmosResponse{Responses: responses}).Marshal()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+	ack := types.InterchainQueryPacketAck{Data: bz}
+	return channeltypes.NewResultAcknowledgement(icqCdc.MustMarshalJSON(&ack))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the interchain query host sends no packets")
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the interchain query host sends no packets")
+}
@@ -0,0 +1,105 @@
+package icq
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestOnRecvPacketQueries(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	router := baseapp.NewGRPCQueryRouter()
+	router.SetInterfaceRegistry(registry)
+	types.RegisterQueryServer(keeper.NewTelemetryServer(router), input.GravityKeeper)
+	module := NewIBCModule(NewHost(capabilitykeeper.ScopedKeeper{}, nil, router))
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	erc20ToDenom, err := (&types.ERC20ToDenomRequest{Erc20: tokenContract.Hex()}).Marshal()
+	require.NoError(t, err)
+
+	recv := func(requests ...abci.RequestQuery) ([]abci.ResponseQuery, bool) {
+		queries, err := (&types.CosmosQuery{Requests: requests}).Marshal()
+		require.NoError(t, err)
+		data := icqCdc.MustMarshalJSON(&types.InterchainQueryPacketData{Data: queries})
+		packet := channeltypes.NewPacket(data, 1, "icqcontroller", "channel-3", PortID, "channel-0", clienttypes.NewHeight(0, 100), 0)
+		ack := module.OnRecvPacket(ctx, packet, nil)
+		if !ack.Success() {
+			return nil, false
+		}
+
+		var res channeltypes.Acknowledgement
+		require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &res))
+		var icqAck types.InterchainQueryPacketAck
+		require.NoError(t, icqCdc.UnmarshalJSON(res.GetResult(), &icqAck))
+		var responses types.CosmosResponse
+		require.NoError(t, responses.Unmarshal(icqAck.Data))
+		return responses.Responses, true
+	}
+
+	// allowed queries are answered in order, their reads charged to the gas of the packet
+	gasBefore := ctx.GasMeter().GasConsumed()
+	responses, ok := recv(
+		abci.RequestQuery{Path: "/gravity.v1.Query/ERC20ToDenom", Data: erc20ToDenom},
+		abci.RequestQuery{Path: "/gravity.v1.Query/Params"},
+	)
+	require.True(t, ok)
+	require.Len(t, responses, 2)
+	require.Greater(t, ctx.GasMeter().GasConsumed(), gasBefore)
+	var denom types.ERC20ToDenomResponse
+	require.NoError(t, denom.Unmarshal(responses[0].Value))
+	require.Equal(t, types.GravityDenom(tokenContract), denom.Denom)
+	require.False(t, denom.CosmosOriginated)
+	var params types.ParamsResponse
+	require.NoError(t, params.Unmarshal(responses[1].Value))
+	require.Equal(t, input.GravityKeeper.GetParams(ctx).GravityId, params.Params.GravityId)
+
+	// queries outside the allowlist fail the packet
+	_, ok = recv(
+		abci.RequestQuery{Path: "/gravity.v1.Query/ERC20ToDenom", Data: erc20ToDenom},
+		abci.RequestQuery{Path: "/gravity.v1.Query/DelegateKeys"},
+	)
+	require.False(t, ok)
+
+	// as do queries of past heights or with proofs
+	_, ok = recv(abci.RequestQuery{Path: "/gravity.v1.Query/ERC20ToDenom", Data: erc20ToDenom, Height: 5})
+	require.False(t, ok)
+	_, ok = recv(abci.RequestQuery{Path: "/gravity.v1.Query/ERC20ToDenom", Data: erc20ToDenom, Prove: true})
+	require.False(t, ok)
+
+	// and packets of more than MaxRequests queries
+	requests := make([]abci.RequestQuery, MaxRequests+1)
+	for i := range requests {
+		requests[i] = abci.RequestQuery{Path: "/gravity.v1.Query/Params"}
+	}
+	_, ok = recv(requests[:MaxRequests]...)
+	require.True(t, ok)
+	_, ok = recv(requests...)
+	require.False(t, ok)
+}
+
+func TestOnChanOpenTryValidation(t *testing.T) {
+	module := NewIBCModule(Host{})
+	ctx := keeper.CreateTestEnv(t).Context
+	counterparty := channeltypes.NewCounterparty("icqcontroller", "channel-3")
+
+	_, err := module.OnChanOpenTry(ctx, channeltypes.ORDERED, []string{"connection-0"}, PortID, "channel-0", nil, counterparty, Version)
+	require.Error(t, err)
+	_, err = module.OnChanOpenTry(ctx, channeltypes.UNORDERED, []string{"connection-0"}, "transfer", "channel-0", nil, counterparty, Version)
+	require.Error(t, err)
+	_, err = module.OnChanOpenTry(ctx, channeltypes.UNORDERED, []string{"connection-0"}, PortID, "channel-0", nil, counterparty, "ics20-1")
+	require.Error(t, err)
+}
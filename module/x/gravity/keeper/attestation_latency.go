@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetAttestationLatency returns the latencies of the last observed events of the chain, in
+// Cosmos blocks from the first vote for them
+func (k Keeper) GetAttestationLatency(ctx sdk.Context, chainID uint64) types.AttestationLatency {
+	var latency types.AttestationLatency
+	if bz := k.chainStore(ctx, chainID).Get([]byte{types.AttestationLatencyKey}); bz != nil {
+		k.cdc.MustUnmarshal(bz, &latency)
+	}
+	return latency
+}
+
+func (k Keeper) setAttestationLatency(ctx sdk.Context, chainID uint64, latency types.AttestationLatency) {
+	k.chainStore(ctx, chainID).Set([]byte{types.AttestationLatencyKey}, k.cdc.MustMarshal(&latency))
+}
+
+// recordAttestationLatency records the blocks the event of the record took to be observed,
+// the records created before their first vote height was kept are skipped
+func (k Keeper) recordAttestationLatency(ctx sdk.Context, chainID uint64, record *types.EthereumEventVoteRecord) {
+	if record.FirstVoteHeight == 0 {
+		return
+	}
+	latency := k.GetAttestationLatency(ctx, chainID)
+	latency.Record(uint64(ctx.BlockHeight()) - record.FirstVoteHeight)
+	k.setAttestationLatency(ctx, chainID, latency)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestAttestationLatency(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	start := ctx.BlockHeight()
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  EthAddrs[0].Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[1].String(),
+		EthereumHeight: 10,
+	}
+
+	// the record keeps the height of its first vote, the event is observed blocks later
+	for i, val := range ValAddrs {
+		if i == 2 {
+			ctx = ctx.WithBlockHeight(start + 7)
+		}
+		_, err := k.recordEventVote(ctx, chainID, event, val)
+		require.NoError(t, err)
+	}
+	record := k.GetEthereumEventVoteRecord(ctx, chainID, 1, event.Hash())
+	require.Equal(t, uint64(start), record.FirstVoteHeight)
+	k.TryEventVoteRecord(ctx, chainID, record)
+	require.True(t, k.GetEthereumEventVoteRecord(ctx, chainID, 1, event.Hash()).Accepted)
+
+	res, err := k.AttestationLatency(sdk.WrapSDKContext(ctx), &types.AttestationLatencyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.AttestationLatency{Samples: []uint64{7}, P50: 7, P90: 7, P99: 7, Max: 7}, res.Latency)
+
+	// the latencies are exported with the genesis state of the chain
+	genesis := ExportGenesis(ctx, k)
+	require.NoError(t, genesis.ValidateBasic())
+	require.Equal(t, &res.Latency, genesis.AttestationLatency)
+}
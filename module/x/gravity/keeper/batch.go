@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -18,21 +19,32 @@ const BatchTxSize = 100
 //   - find bridged denominator for given voucher type
 //   - determine if a an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //     have a higher total fees. If not exit withtout creating a batch
-//   - select available transactions from the unbatched SendToEthereums sorted by fee desc
+//   - select available transactions from the unbatched SendToEthereums sorted by fee desc, those below the
+//     chain's current fee floor for the token are left in the pool
 //   - persist an OutgoingTx (BatchTx) object with an incrementing ID = nonce
 //   - emit an event
-func (k Keeper) CreateBatchTx(ctx sdk.Context, contractAddress common.Address, maxElements int) *types.BatchTx {
+func (k Keeper) CreateBatchTx(ctx sdk.Context, chainID uint64, contractAddress common.Address, maxElements int) *types.BatchTx {
+	// the chain is paused or draining the outgoing txs of its current contract
+	if k.outgoingTxsPaused(ctx, chainID) {
+		return nil
+	}
+
 	// if there is a more profitable batch for this token type do not create a new batch
-	if lastBatch := k.getLastOutgoingBatchByTokenType(ctx, contractAddress); lastBatch != nil {
-		if lastBatch.GetFees().GTE(k.getBatchFeesByTokenType(ctx, contractAddress, maxElements)) {
+	if lastBatch := k.getLastOutgoingBatchByTokenType(ctx, chainID, contractAddress); lastBatch != nil {
+		if lastBatch.GetFees().GTE(k.getBatchFeesByTokenType(ctx, chainID, contractAddress, maxElements)) {
 			return nil
 		}
 	}
 
+	minimumFee := k.getMinimumFee(ctx, chainID, contractAddress)
 	var selectedStes []*types.SendToEthereum
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, contractAddress, func(ste *types.SendToEthereum) bool {
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, chainID, contractAddress, func(ste *types.SendToEthereum) bool {
+		// the pool is sorted by fee, the remaining txs are all below the floor
+		if ste.Erc20Fee.Amount.LT(minimumFee) {
+			return true
+		}
 		selectedStes = append(selectedStes, ste)
-		k.deleteUnbatchedSendToEthereum(ctx, ste.Id, ste.Erc20Fee)
+		k.deleteUnbatchedSendToEthereum(ctx, chainID, ste.Id, ste.Erc20Fee)
 		return len(selectedStes) == maxElements
 	})
 
@@ -42,56 +54,90 @@ func (k Keeper) CreateBatchTx(ctx sdk.Context, contractAddress common.Address, m
 	}
 
 	batch := &types.BatchTx{
-		BatchNonce:    k.incrementLastOutgoingBatchNonce(ctx),
-		Timeout:       k.getTimeoutHeight(ctx),
-		Transactions:  selectedStes,
-		TokenContract: contractAddress.Hex(),
-		Height:        uint64(ctx.BlockHeight()),
+		BatchNonce:        k.incrementLastOutgoingBatchNonce(ctx),
+		Timeout:           k.getTimeoutHeight(ctx, chainID),
+		Transactions:      selectedStes,
+		TokenContract:     contractAddress.Hex(),
+		Height:            uint64(ctx.BlockHeight()),
+		CheckpointVersion: k.checkpointVersion(ctx),
 	}
-	k.SetOutgoingTx(ctx, batch)
+	k.SetOutgoingTx(ctx, chainID, batch)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeOutgoingBatch,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+		k.bridgeContractAttribute(ctx, chainID),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		types.EthereumAddressAttribute(types.AttributeKeyTokenContract, batch.TokenContract),
 		sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(batch.BatchNonce)),
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(batch.BatchNonce)),
 	))
+	types.IncrMetricCounter(types.MetricKeyBatchTxsCreated, chainID, telemetry.NewLabel(types.MetricLabelKind, types.MetricKindERC20))
+	k.updateBlockSummary(ctx, func(summary *types.EventBlockSummary) { summary.BatchedTxs += uint64(len(batch.Transactions)) })
+	types.SetMetricGauge(types.MetricKeyBatchTxSize, chainID, float32(len(batch.Transactions)), telemetry.NewLabel(types.MetricLabelKind, types.MetricKindERC20))
+	emitTypedEvent(ctx, &types.EventOutgoingBatch{
+		EvmChainId:        chainID,
+		TokenContract:     batch.TokenContract,
+		BatchNonce:        batch.BatchNonce,
+		Timeout:           batch.Timeout,
+		SendToEthereumIds: batch.SendToEthereumIDs(),
+	})
 
 	return batch
 }
 
 // batchTxExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
 // It deletes all the transactions in the batch, then cancels all earlier batches
-func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, nonce uint64) {
-	otx := k.GetOutgoingTx(ctx, types.MakeBatchTxKey(tokenContract, nonce))
+func (k Keeper) batchTxExecuted(ctx sdk.Context, chainID uint64, tokenContract common.Address, nonce uint64) {
+	otx := k.GetOutgoingTx(ctx, chainID, types.MakeBatchTxKey(tokenContract, nonce))
 	if otx == nil {
 		k.Logger(ctx).Error("Failed to clean batches",
+			"chain id", chainID,
 			"token contract", tokenContract.Hex(),
 			"nonce", nonce)
 		return
 	}
 	batchTx, _ := otx.(*types.BatchTx)
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+	k.IterateOutgoingTxsByType(ctx, chainID, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
 		// If the iterated batches nonce is lower than the one that was just executed, cancel it
 		btx, _ := otx.(*types.BatchTx)
 		if (btx.BatchNonce < batchTx.BatchNonce) && (btx.TokenContract == batchTx.TokenContract) {
-			k.CancelBatchTx(ctx, btx)
+			k.CancelBatchTx(ctx, chainID, btx)
 		}
 		return false
 	})
-	k.DeleteOutgoingTx(ctx, batchTx.GetStoreIndex())
+	k.DeleteOutgoingTx(ctx, chainID, batchTx.GetStoreIndex())
+
+	emitTypedEvent(ctx, &types.EventOutgoingBatchExecuted{
+		EvmChainId:    chainID,
+		TokenContract: batchTx.TokenContract,
+		BatchNonce:    batchTx.BatchNonce,
+	})
+
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	_, denom := k.ERC20ToDenomLookup(ctx, chainID, tokenContract)
+	fees := sdk.ZeroInt()
+	for _, tx := range batchTx.Transactions {
+		fees = fees.Add(tx.Erc20Fee.Amount)
+	}
+	k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) {
+		report.BatchesExecuted++
+		report.FeesCollected = report.FeesCollected.Add(sdk.NewCoin(denom, chain.DenomAmount(denom, fees)))
+	})
 }
 
 // getBatchFeesByTokenType gets the fees the next batch of a given token type would
 // have if created. This info is both presented to relayers for the purpose of determining
 // when to request batches and also used by the batch creation process to decide not to create
 // a new batch
-func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
+func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, chainID uint64, tokenContractAddr common.Address, maxElements int) sdk.Int {
 	feeAmount := sdk.ZeroInt()
+	minimumFee := k.getMinimumFee(ctx, chainID, tokenContractAddr)
 	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, chainID, tokenContractAddr, func(tx *types.SendToEthereum) bool {
+		if tx.Erc20Fee.Amount.LT(minimumFee) {
+			return true
+		}
 		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
 		i++
 		return i == maxElements
@@ -104,10 +150,14 @@ func (k Keeper) getBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr commo
 // have if created. This info is both presented to relayers for the purpose of determining
 // when to request batches and also used by the batch creation process to decide not to create
 // a new batch
-func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) sdk.Int {
+func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, chainID uint64, tokenContractAddr common.Address, maxElements int) sdk.Int {
 	feeAmount := sdk.ZeroInt()
+	minimumFee := k.getMinimumFee(ctx, chainID, tokenContractAddr)
 	i := 0
-	k.iterateUnbatchedSendToEthereumsByContract(ctx, tokenContractAddr, func(tx *types.SendToEthereum) bool {
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, chainID, tokenContractAddr, func(tx *types.SendToEthereum) bool {
+		if tx.Erc20Fee.Amount.LT(minimumFee) {
+			return true
+		}
 		feeAmount = feeAmount.Add(tx.Erc20Fee.Amount)
 		i++
 		return i == maxElements
@@ -116,32 +166,38 @@ func (k Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr commo
 }
 
 // CancelBatchTx releases all TX in the batch and deletes the batch
-func (k Keeper) CancelBatchTx(ctx sdk.Context, batch *types.BatchTx) {
+func (k Keeper) CancelBatchTx(ctx sdk.Context, chainID uint64, batch *types.BatchTx) {
 	// free transactions from batch and reindex them
 	for _, tx := range batch.Transactions {
-		k.setUnbatchedSendToEthereum(ctx, tx)
+		k.setUnbatchedSendToEthereum(ctx, chainID, tx)
 	}
 
 	// Delete batch since it is finished
-	k.DeleteOutgoingTx(ctx, batch.GetStoreIndex())
+	k.DeleteOutgoingTx(ctx, chainID, batch.GetStoreIndex())
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeOutgoingBatchCanceled,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			k.bridgeContractAttribute(ctx, chainID),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+			types.EthereumAddressAttribute(types.AttributeKeyTokenContract, batch.TokenContract),
 			sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(batch.BatchNonce)),
 			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(batch.BatchNonce)),
 		),
 	)
+	emitTypedEvent(ctx, &types.EventOutgoingBatchCanceled{
+		EvmChainId:    chainID,
+		TokenContract: batch.TokenContract,
+		BatchNonce:    batch.BatchNonce,
+	})
 }
 
 // getLastOutgoingBatchByTokenType gets the latest outgoing tx batch by token type
-func (k Keeper) getLastOutgoingBatchByTokenType(ctx sdk.Context, token common.Address) *types.BatchTx {
+func (k Keeper) getLastOutgoingBatchByTokenType(ctx sdk.Context, chainID uint64, token common.Address) *types.BatchTx {
 	var lastBatch *types.BatchTx = nil
 	lastNonce := uint64(0)
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+	k.IterateOutgoingTxsByType(ctx, chainID, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
 		btx, _ := otx.(*types.BatchTx)
 		if common.HexToAddress(btx.TokenContract) == token && btx.BatchNonce > lastNonce {
 			lastBatch = btx
@@ -153,22 +209,22 @@ func (k Keeper) getLastOutgoingBatchByTokenType(ctx sdk.Context, token common.Ad
 }
 
 // SetLastSlashedOutgoingTxBlockHeight sets the latest slashed Batch block height
-func (k Keeper) SetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context, blockHeight uint64) {
-	ctx.KVStore(k.storeKey).Set([]byte{types.LastSlashedOutgoingTxBlockKey}, sdk.Uint64ToBigEndian(blockHeight))
+func (k Keeper) SetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context, chainID uint64, blockHeight uint64) {
+	k.chainStore(ctx, chainID).Set([]byte{types.LastSlashedOutgoingTxBlockKey}, sdk.Uint64ToBigEndian(blockHeight))
 }
 
 // GetLastSlashedOutgoingTxBlockHeight returns the latest slashed Batch block
-func (k Keeper) GetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context) uint64 {
-	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastSlashedOutgoingTxBlockKey}); bz == nil {
+func (k Keeper) GetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context, chainID uint64) uint64 {
+	if bz := k.chainStore(ctx, chainID).Get([]byte{types.LastSlashedOutgoingTxBlockKey}); bz == nil {
 		return 0
 	} else {
 		return binary.BigEndian.Uint64(bz)
 	}
 }
 
-func (k Keeper) GetUnSlashedOutgoingTxs(ctx sdk.Context, maxHeight uint64) (out []types.OutgoingTx) {
-	lastSlashed := k.GetLastSlashedOutgoingTxBlockHeight(ctx)
-	k.iterateOutgoingTxs(ctx, func(key []byte, otx types.OutgoingTx) bool {
+func (k Keeper) GetUnSlashedOutgoingTxs(ctx sdk.Context, chainID uint64, maxHeight uint64) (out []types.OutgoingTx) {
+	lastSlashed := k.GetLastSlashedOutgoingTxBlockHeight(ctx, chainID)
+	k.iterateOutgoingTxs(ctx, chainID, func(key []byte, otx types.OutgoingTx) bool {
 		if (otx.GetCosmosHeight() < maxHeight) && (otx.GetCosmosHeight() > lastSlashed) {
 			out = append(out, otx)
 		}
@@ -178,14 +234,19 @@ func (k Keeper) GetUnSlashedOutgoingTxs(ctx sdk.Context, maxHeight uint64) (out
 }
 
 func (k Keeper) incrementLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastOutgoingBatchNonceKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
-	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastOutgoingBatchNonceKey}, bz)
+	newId := k.getLastOutgoingBatchNonce(ctx) + 1
+	k.setLastOutgoingBatchNonce(ctx, newId)
 	return newId
 }
+
+// getLastOutgoingBatchNonce returns the nonce of the last batch, shared by all EVM chains
+func (k Keeper) getLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastOutgoingBatchNonceKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setLastOutgoingBatchNonce(ctx sdk.Context, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -41,34 +44,35 @@ func TestBatches(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	firstBatch := input.GravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	firstBatch := input.GravityKeeper.CreateBatchTx(ctx, TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, firstBatch.GetStoreIndex())
+	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, firstBatch.GetStoreIndex())
 	require.NotNil(t, gotFirstBatch)
 
 	gfb := gotFirstBatch.(*types.BatchTx)
 	expFirstBatch := &types.BatchTx{
 		BatchNonce: 1,
 		Transactions: []*types.SendToEthereum{
-			types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
-			types.NewSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+			types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+			types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 3, myTokenContractAddr, mySender, myReceiver, 102, 2),
 		},
-		TokenContract: myTokenContractAddr.Hex(),
-		Height:        1234567,
+		TokenContract:     myTokenContractAddr.Hex(),
+		Height:            1234567,
+		CheckpointVersion: types.CheckpointVersion1,
 	}
 
 	assert.Equal(t, expFirstBatch.Transactions, gfb.Transactions)
 
 	// and verify remaining available Tx in the pool
 	var gotUnbatchedTx []*types.SendToEthereum
-	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(tx *types.SendToEthereum) bool {
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, TestingGravityParams.BridgeChainId, func(tx *types.SendToEthereum) bool {
 		gotUnbatchedTx = append(gotUnbatchedTx, tx)
 		return false
 	})
 	expUnbatchedTx := []*types.SendToEthereum{
-		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
-		types.NewSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 1),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 1, myTokenContractAddr, mySender, myReceiver, 100, 2),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 4, myTokenContractAddr, mySender, myReceiver, 103, 1),
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
 
@@ -81,17 +85,18 @@ func TestBatches(t *testing.T) {
 	// create the more profitable batch
 	ctx = ctx.WithBlockTime(now)
 	// tx batch size is 2, so that some of them stay behind
-	secondBatch := input.GravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	secondBatch := input.GravityKeeper.CreateBatchTx(ctx, TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 
 	// check that the more profitable batch has the right txs in it
 	expSecondBatch := &types.BatchTx{
 		BatchNonce: 2,
 		Transactions: []*types.SendToEthereum{
-			types.NewSendToEthereumTx(6, myTokenContractAddr, mySender, myReceiver, 101, 5),
-			types.NewSendToEthereumTx(5, myTokenContractAddr, mySender, myReceiver, 100, 4),
+			types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 6, myTokenContractAddr, mySender, myReceiver, 101, 5),
+			types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 5, myTokenContractAddr, mySender, myReceiver, 100, 4),
 		},
-		TokenContract: myTokenContractAddr.Hex(),
-		Height:        1234567,
+		TokenContract:     myTokenContractAddr.Hex(),
+		Height:            1234567,
+		CheckpointVersion: types.CheckpointVersion1,
 	}
 
 	assert.Equal(t, expSecondBatch, secondBatch)
@@ -100,23 +105,23 @@ func TestBatches(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, TestingGravityParams.BridgeChainId, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
 
 	// check batch has been deleted
-	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
+	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, secondBatch.GetStoreIndex())
 	require.Nil(t, gotSecondBatch)
 
 	// check that txs from first batch have been freed
 	gotUnbatchedTx = nil
-	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(tx *types.SendToEthereum) bool {
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, TestingGravityParams.BridgeChainId, func(tx *types.SendToEthereum) bool {
 		gotUnbatchedTx = append(gotUnbatchedTx, tx)
 		return false
 	})
 	expUnbatchedTx = []*types.SendToEthereum{
-		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
-		types.NewSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
-		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
-		types.NewSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 1),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 1, myTokenContractAddr, mySender, myReceiver, 100, 2),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 4, myTokenContractAddr, mySender, myReceiver, 103, 1),
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
 }
@@ -152,7 +157,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		vAsSDKInt := sdk.NewIntFromUint64(v)
 		amount := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
 		fee := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
-		_, err := input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+		_, err := input.GravityKeeper.createSendToEthereum(ctx, TestingGravityParams.BridgeChainId, mySender, myReceiver.Hex(), amount, fee)
 		require.NoError(t, err)
 	}
 
@@ -160,10 +165,10 @@ func TestBatchesFullCoins(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	firstBatch := input.GravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	firstBatch := input.GravityKeeper.CreateBatchTx(ctx, TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, firstBatch.GetStoreIndex())
+	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, firstBatch.GetStoreIndex())
 	require.NotNil(t, gotFirstBatch)
 
 	expFirstBatch := &types.BatchTx{
@@ -175,6 +180,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:            mySender.String(),
 				EthereumRecipient: myReceiver.Hex(),
 				Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				EvmChainId:        TestingGravityParams.BridgeChainId,
 			},
 			{
 				Id:                3,
@@ -182,16 +188,18 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:            mySender.String(),
 				EthereumRecipient: myReceiver.Hex(),
 				Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				EvmChainId:        TestingGravityParams.BridgeChainId,
 			},
 		},
-		TokenContract: myTokenContractAddr.Hex(),
-		Height:        1234567,
+		TokenContract:     myTokenContractAddr.Hex(),
+		Height:            1234567,
+		CheckpointVersion: types.CheckpointVersion1,
 	}
 	assert.Equal(t, expFirstBatch, gotFirstBatch)
 
 	// and verify remaining available Tx in the pool
 	var gotUnbatchedTx []*types.SendToEthereum
-	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(tx *types.SendToEthereum) bool {
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, TestingGravityParams.BridgeChainId, func(tx *types.SendToEthereum) bool {
 		gotUnbatchedTx = append(gotUnbatchedTx, tx)
 		return false
 	})
@@ -202,6 +210,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+			EvmChainId:        TestingGravityParams.BridgeChainId,
 		},
 		{
 			Id:                4,
@@ -209,6 +218,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+			EvmChainId:        TestingGravityParams.BridgeChainId,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -221,14 +231,14 @@ func TestBatchesFullCoins(t *testing.T) {
 		vAsSDKInt := sdk.NewIntFromUint64(v)
 		amount := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
 		fee := types.NewSDKIntERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr).GravityCoin()
-		_, err := input.GravityKeeper.createSendToEthereum(ctx, mySender, myReceiver.Hex(), amount, fee)
+		_, err := input.GravityKeeper.createSendToEthereum(ctx, TestingGravityParams.BridgeChainId, mySender, myReceiver.Hex(), amount, fee)
 		require.NoError(t, err)
 	}
 
 	// create the more profitable batch
 	ctx = ctx.WithBlockTime(now)
 	// tx batch size is 2, so that some of them stay behind
-	secondBatch := input.GravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	secondBatch := input.GravityKeeper.CreateBatchTx(ctx, TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 
 	// check that the more profitable batch has the right txs in it
 	expSecondBatch := &types.BatchTx{
@@ -240,6 +250,7 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:            mySender.String(),
 				EthereumRecipient: myReceiver.Hex(),
 				Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr),
+				EvmChainId:        TestingGravityParams.BridgeChainId,
 			},
 			{
 				Id:                4,
@@ -247,10 +258,12 @@ func TestBatchesFullCoins(t *testing.T) {
 				Sender:            mySender.String(),
 				EthereumRecipient: myReceiver.Hex(),
 				Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr),
+				EvmChainId:        TestingGravityParams.BridgeChainId,
 			},
 		},
-		TokenContract: myTokenContractAddr.Hex(),
-		Height:        1234567,
+		TokenContract:     myTokenContractAddr.Hex(),
+		Height:            1234567,
+		CheckpointVersion: types.CheckpointVersion1,
 	}
 
 	assert.Equal(t, expSecondBatch, secondBatch)
@@ -259,15 +272,15 @@ func TestBatchesFullCoins(t *testing.T) {
 	// =================================
 
 	// Execute the batch
-	input.GravityKeeper.batchTxExecuted(ctx, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
+	input.GravityKeeper.batchTxExecuted(ctx, TestingGravityParams.BridgeChainId, common.HexToAddress(secondBatch.TokenContract), secondBatch.BatchNonce)
 
 	// check batch has been deleted
-	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, secondBatch.GetStoreIndex())
+	gotSecondBatch := input.GravityKeeper.GetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, secondBatch.GetStoreIndex())
 	require.Nil(t, gotSecondBatch)
 
 	// check that txs from first batch have been freed
 	gotUnbatchedTx = nil
-	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(tx *types.SendToEthereum) bool {
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, TestingGravityParams.BridgeChainId, func(tx *types.SendToEthereum) bool {
 		gotUnbatchedTx = append(gotUnbatchedTx, tx)
 		return false
 	})
@@ -278,6 +291,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+			EvmChainId:        TestingGravityParams.BridgeChainId,
 		},
 		{
 			Id:                3,
@@ -285,6 +299,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+			EvmChainId:        TestingGravityParams.BridgeChainId,
 		},
 		{
 			Id:                6,
@@ -292,6 +307,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(5)), myTokenContractAddr),
+			EvmChainId:        TestingGravityParams.BridgeChainId,
 		},
 		{
 			Id:                5,
@@ -299,6 +315,7 @@ func TestBatchesFullCoins(t *testing.T) {
 			Sender:            mySender.String(),
 			EthereumRecipient: myReceiver.Hex(),
 			Erc20Token:        types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(4)), myTokenContractAddr),
+			EvmChainId:        TestingGravityParams.BridgeChainId,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -340,14 +357,18 @@ func TestPoolTxRefund(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	input.GravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	input.GravityKeeper.CreateBatchTx(ctx, TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 
 	// try to refund a tx that's in a batch
-	err := input.GravityKeeper.cancelSendToEthereum(ctx, 2, mySender.String())
-	require.Error(t, err)
+	err := input.GravityKeeper.cancelSendToEthereum(ctx, TestingGravityParams.BridgeChainId, 2, mySender.String())
+	require.ErrorIs(t, err, types.ErrPoolEntryNotFound)
+
+	// try to refund a tx someone else sent
+	err = input.GravityKeeper.cancelSendToEthereum(ctx, TestingGravityParams.BridgeChainId, 4, AccAddrs[2].String())
+	require.ErrorIs(t, err, types.ErrNotSender)
 
 	// try to refund a tx that's in the pool
-	err = input.GravityKeeper.cancelSendToEthereum(ctx, 4, mySender.String())
+	err = input.GravityKeeper.cancelSendToEthereum(ctx, TestingGravityParams.BridgeChainId, 4, mySender.String())
 	require.NoError(t, err)
 
 	// make sure refund was issued
@@ -376,7 +397,163 @@ func TestEmptyBatch(t *testing.T) {
 
 	// no transactions should be included in this batch
 	ctx = ctx.WithBlockTime(now)
-	batchTx := input.GravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	batchTx := input.GravityKeeper.CreateBatchTx(ctx, TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 
 	require.Nil(t, batchTx)
 }
+
+func TestBatchedSendsInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		sender        = AccAddrs[0]
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		vouchers      = sdk.NewCoins(types.NewERC20Token(99999, tokenContract).GravityCoin())
+	)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, sender, vouchers))
+	input.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, EthAddrs[0], 2, 3, 2, 1)
+	batch := k.CreateBatchTx(ctx, chainID, tokenContract, 2)
+	require.NotNil(t, batch)
+
+	_, broken := BatchedSendsInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// a batch holding a send still in the pool breaks the invariant
+	pooled := k.getUnbatchedSendToEthereums(ctx, chainID)[0]
+	batch.Transactions = append(batch.Transactions, pooled)
+	k.SetOutgoingTx(ctx, chainID, batch)
+	_, broken = BatchedSendsInvariant(k)(ctx)
+	require.True(t, broken)
+
+	// as does one holding a send never created
+	batch.Transactions[len(batch.Transactions)-1] = &types.SendToEthereum{
+		Id:                k.getLastSendToEthereumID(ctx) + 1,
+		Sender:            sender.String(),
+		EthereumRecipient: EthAddrs[0].Hex(),
+		Erc20Token:        types.NewERC20Token(100, tokenContract),
+		Erc20Fee:          types.NewERC20Token(1, tokenContract),
+	}
+	k.SetOutgoingTx(ctx, chainID, batch)
+	_, broken = BatchedSendsInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestBatchTelemetry(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+	input.AddSendToEthTxsToPool(t, ctx, myTokenContractAddr, mySender, myReceiver, 2, 3, 2, 1)
+
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname, cfg.EnableRuntimeMetrics = false, false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{}) })
+
+	chainID := TestingGravityParams.BridgeChainId
+	input.GravityKeeper.CreateBatchTx(ctx, chainID, myTokenContractAddr, 3)
+
+	labels := fmt.Sprintf(";evm_chain_id=%d;kind=erc20", chainID)
+	data := sink.Data()[0]
+	require.Equal(t, 1, data.Counters["gravity.batch_txs_created"+labels].Count)
+	require.Equal(t, float32(3), data.Gauges["gravity.batch_tx_size"+labels].Value)
+}
+
+func BenchmarkCreateBatchTx(b *testing.B) {
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+	for _, poolSize := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("pool=%d", poolSize), func(b *testing.B) {
+			input := CreateTestEnv(b)
+			ctx := input.Context
+			allVouchers := sdk.NewCoins(types.NewERC20Token(uint64(poolSize)*(uint64(poolSize)+200), myTokenContractAddr).GravityCoin())
+			require.NoError(b, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+			input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+			require.NoError(b, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+			fees := make([]uint64, poolSize)
+			for i := range fees {
+				fees[i] = uint64(i%50 + 1)
+			}
+			input.AddSendToEthTxsToPool(b, ctx, myTokenContractAddr, mySender, myReceiver, fees...)
+
+			// each batch is built from the full pool, in a cache of the store discarded after it
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				cacheCtx, _ := ctx.CacheContext()
+				b.StartTimer()
+				if batch := input.GravityKeeper.CreateBatchTx(cacheCtx, TestingGravityParams.BridgeChainId, myTokenContractAddr, poolSize); batch == nil {
+					b.Fatal("no batch created")
+				}
+			}
+		})
+	}
+}
+
+// FuzzCreateBatchTx checks that the sends of a batch only depend on the fees and ids of the
+// pooled sends, the highest fees first and the latest of equal fees first, so that every node
+// batches the same sends whatever the order it reads them in
+func FuzzCreateBatchTx(f *testing.F) {
+	f.Add([]byte{2, 3, 2, 1})
+	f.Add([]byte{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5})
+	f.Add([]byte{0, 1, 255, 7, 7, 0, 3, 200, 3, 9, 1, 1, 4})
+	f.Fuzz(func(t *testing.T, fees []byte) {
+		if len(fees) == 0 || len(fees) > 30 {
+			t.Skip()
+		}
+		var (
+			sender        = AccAddrs[0]
+			receiver      = EthAddrs[0]
+			tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+			maxElements   = 10
+		)
+		input := CreateTestEnv(t)
+		ctx := input.Context
+		vouchers := sdk.NewCoins(types.NewERC20Token(100000, tokenContract).GravityCoin())
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+		require.NoError(t, fundAccount(ctx, input.BankKeeper, sender, vouchers))
+
+		type pooled struct{ id, fee uint64 }
+		var expected []pooled
+		poolFees := make([]uint64, len(fees))
+		for i, fee := range fees {
+			poolFees[i] = uint64(fee)
+			expected = append(expected, pooled{uint64(i + 1), uint64(fee)})
+		}
+		input.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, receiver, poolFees...)
+		sort.Slice(expected, func(i, j int) bool {
+			if expected[i].fee != expected[j].fee {
+				return expected[i].fee > expected[j].fee
+			}
+			return expected[i].id > expected[j].id
+		})
+		if len(expected) > maxElements {
+			expected = expected[:maxElements]
+		}
+
+		batch := input.GravityKeeper.CreateBatchTx(ctx, TestingGravityParams.BridgeChainId, tokenContract, maxElements)
+		require.NotNil(t, batch)
+		var got []pooled
+		for _, send := range batch.Transactions {
+			got = append(got, pooled{send.Id, send.Erc20Fee.Amount.Uint64()})
+		}
+		require.Equal(t, expected, got)
+	})
+}
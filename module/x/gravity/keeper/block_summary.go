@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetBlockSummary returns the counts of the bridge activity of the block in progress
+func (k Keeper) GetBlockSummary(ctx sdk.Context) types.EventBlockSummary {
+	var summary types.EventBlockSummary
+	if bz := ctx.TransientStore(k.transientKey).Get([]byte{types.BlockSummaryKey}); bz != nil {
+		k.cdc.MustUnmarshal(bz, &summary)
+	}
+	return summary
+}
+
+// updateBlockSummary records bridge activity in the summary of the block in progress, the
+// summary is kept in the transient store so that the activity of failed txs is reverted with
+// them without writing the summary to the state
+func (k Keeper) updateBlockSummary(ctx sdk.Context, update func(summary *types.EventBlockSummary)) {
+	summary := k.GetBlockSummary(ctx)
+	update(&summary)
+	ctx.TransientStore(k.transientKey).Set([]byte{types.BlockSummaryKey}, k.cdc.MustMarshal(&summary))
+}
+
+// EmitBlockSummary emits the summary of the bridge activity of the block and clears it, it is
+// emitted for every block, including those without activity
+func (k Keeper) EmitBlockSummary(ctx sdk.Context) {
+	summary := k.GetBlockSummary(ctx)
+	ctx.TransientStore(k.transientKey).Delete([]byte{types.BlockSummaryKey})
+	emitTypedEvent(ctx, &summary)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestBlockSummary(t *testing.T) {
+	var (
+		env           = CreateTestEnv(t)
+		ctx           = env.Context
+		k             = env.GravityKeeper
+		chainID       = TestingGravityParams.BridgeChainId
+		sender        = AccAddrs[1]
+		tokenContract = EthAddrs[0]
+		vouchers      = sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(tokenContract), 1000))
+	)
+	require.NoError(t, env.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	env.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, env.BankKeeper, sender, vouchers))
+
+	env.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, EthAddrs[1], 2, 3, 4)
+	k.CreateBatchTx(ctx, chainID, tokenContract, 2)
+	// the summary is kept out of the state
+	require.False(t, ctx.KVStore(env.GravityStoreKey).Has([]byte{types.BlockSummaryKey}))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.EmitBlockSummary(ctx)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	event, err := sdk.ParseTypedEvent(events.ToABCIEvents()[0])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventBlockSummary{NewSends: 3, BatchedTxs: 2}, event)
+
+	// the next block starts with an empty summary, which is emitted all the same
+	require.Equal(t, types.EventBlockSummary{}, k.GetBlockSummary(ctx))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.EmitBlockSummary(ctx)
+	require.Len(t, ctx.EventManager().Events(), 1)
+}
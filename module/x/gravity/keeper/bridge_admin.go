@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// checkBridgeAdmin returns an error unless the address is the bridge admin and holds
+// the permission
+func (k Keeper) checkBridgeAdmin(ctx sdk.Context, address string, permission types.BridgeAdminPermission) error {
+	admin := k.GetParams(ctx).BridgeAdmin
+	if !admin.IsPermitted(address, permission) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the bridge admin or lacks the %s permission", address, permission)
+	}
+	return nil
+}
+
+// setEVMChainRateLimits replaces the rate limits of the EVM chain, those of the default
+// chain being kept in the params
+func (k Keeper) setEVMChainRateLimits(ctx sdk.Context, chainID uint64, limits []types.RateLimit) {
+	if chainID == k.getBridgeChainID(ctx) {
+		params := k.GetParams(ctx)
+		params.EthereumRateLimits = limits
+		k.setParams(ctx, params)
+		return
+	}
+
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	chain.RateLimits = limits
+	k.setEVMChain(ctx, chain)
+}
+
+// setEVMChainFeeFloors replaces the fee floors of the EVM chain, those of the default
+// chain being kept in the params
+func (k Keeper) setEVMChainFeeFloors(ctx sdk.Context, chainID uint64, floors []types.FeeFloor) {
+	types.NormalizeFeeFloors(floors)
+	if chainID == k.getBridgeChainID(ctx) {
+		params := k.GetParams(ctx)
+		params.EthereumFeeFloors = floors
+		k.setParams(ctx, params)
+		return
+	}
+
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	chain.FeeFloors = floors
+	k.setEVMChain(ctx, chain)
+}
+
+// changeEVMChainPause pauses or unpauses bridging to the EVM chain, for governance and
+// the bridge admin alike
+func (k Keeper) changeEVMChainPause(ctx sdk.Context, chainID uint64, paused bool) error {
+	if k.IsEVMChainPaused(ctx, chainID) == paused {
+		return sdkerrors.Wrapf(types.ErrInvalid, "evm chain %d already has paused set to %t", chainID, paused)
+	}
+	k.setEVMChainPaused(ctx, chainID, paused)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEVMChainPause,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(paused)),
+	))
+	k.Logger(ctx).Info("evm chain pause changed", "chain id", chainID, "paused", paused)
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetBridgeReport returns the closed bridge report with the id
+func (k Keeper) GetBridgeReport(ctx sdk.Context, id uint64) (types.BridgeReport, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeBridgeReportKey(id))
+	if bz == nil {
+		return types.BridgeReport{}, false
+	}
+	var report types.BridgeReport
+	k.cdc.MustUnmarshal(bz, &report)
+	return report, true
+}
+
+func (k Keeper) setBridgeReport(ctx sdk.Context, report types.BridgeReport) {
+	ctx.KVStore(k.storeKey).Set(types.MakeBridgeReportKey(report.Id), k.cdc.MustMarshal(&report))
+}
+
+// IterateBridgeReports iterates over the closed bridge reports by id
+func (k Keeper) IterateBridgeReports(ctx sdk.Context, cb func(types.BridgeReport) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgeReportKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var report types.BridgeReport
+		k.cdc.MustUnmarshal(iter.Value(), &report)
+		if cb(report) {
+			break
+		}
+	}
+}
+
+// GetCurrentBridgeReport returns the report of the period in progress, a period starts at
+// the first block after the last one closed
+func (k Keeper) GetCurrentBridgeReport(ctx sdk.Context) types.BridgeReport {
+	if report := k.getStartedBridgeReport(ctx); report != nil {
+		return *report
+	}
+
+	report := types.BridgeReport{Id: 1, StartHeight: uint64(ctx.BlockHeight())}
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.BridgeReportKey}).ReverseIterator(nil, nil)
+	defer iter.Close()
+	if iter.Valid() {
+		report.Id = sdk.BigEndianToUint64(iter.Key()) + 1
+	}
+	return report
+}
+
+// getStartedBridgeReport returns the report of the period in progress, nil until the first
+// block of the period has been reported
+func (k Keeper) getStartedBridgeReport(ctx sdk.Context) *types.BridgeReport {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.CurrentBridgeReportKey})
+	if bz == nil {
+		return nil
+	}
+	var report types.BridgeReport
+	k.cdc.MustUnmarshal(bz, &report)
+	return &report
+}
+
+func (k Keeper) setCurrentBridgeReport(ctx sdk.Context, report types.BridgeReport) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.CurrentBridgeReportKey}, k.cdc.MustMarshal(&report))
+}
+
+func (k Keeper) deleteCurrentBridgeReport(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete([]byte{types.CurrentBridgeReportKey})
+}
+
+// UpdateBridgeReport records bridge activity in the report of the period in progress, nothing
+// is recorded while the reports are disabled
+func (k Keeper) UpdateBridgeReport(ctx sdk.Context, update func(report *types.BridgeReport)) {
+	if k.GetParams(ctx).BridgeReportPeriod == 0 {
+		return
+	}
+	report := k.GetCurrentBridgeReport(ctx)
+	update(&report)
+	k.setCurrentBridgeReport(ctx, report)
+}
+
+// CloseBridgeReport closes the report of the period in progress once the period has passed,
+// keeping the last MaxBridgeReports closed reports
+func (k Keeper) CloseBridgeReport(ctx sdk.Context) {
+	period := k.GetParams(ctx).BridgeReportPeriod
+	if period == 0 {
+		k.deleteCurrentBridgeReport(ctx)
+		return
+	}
+
+	report := k.GetCurrentBridgeReport(ctx)
+	height := uint64(ctx.BlockHeight())
+	if height+1 < report.StartHeight+period {
+		k.setCurrentBridgeReport(ctx, report)
+		return
+	}
+
+	report.EndHeight = height
+	k.setBridgeReport(ctx, report)
+	k.deleteCurrentBridgeReport(ctx)
+	if report.Id > types.MaxBridgeReports {
+		ctx.KVStore(k.storeKey).Delete(types.MakeBridgeReportKey(report.Id - types.MaxBridgeReports))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeReport,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeReportID, strconv.FormatUint(report.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatUint(report.StartHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatUint(report.EndHeight, 10)),
+	))
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestBridgeReports(t *testing.T) {
+	var (
+		env           = CreateTestEnv(t)
+		ctx           = env.Context
+		k             = env.GravityKeeper
+		chainID       = TestingGravityParams.BridgeChainId
+		sender        = AccAddrs[1]
+		tokenContract = EthAddrs[0]
+		denom         = types.GravityDenom(tokenContract)
+		vouchers      = sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	)
+	require.NoError(t, env.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	env.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, env.BankKeeper, sender, vouchers))
+
+	params := k.GetParams(ctx)
+	params.BridgeReportPeriod = 3
+	k.setParams(ctx, params)
+	start := ctx.BlockHeight()
+
+	// the activity of the period is recorded as it happens
+	env.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, EthAddrs[1], 2, 3)
+	k.CloseBridgeReport(ctx)
+	ctx = ctx.WithBlockHeight(start + 1)
+	batch := k.CreateBatchTx(ctx, chainID, tokenContract, 10)
+	k.batchTxExecuted(ctx, chainID, tokenContract, batch.BatchNonce)
+	k.CloseBridgeReport(ctx)
+
+	res, err := k.BridgeReports(sdk.WrapSDKContext(ctx), &types.BridgeReportsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Reports)
+	require.Equal(t, uint64(2), res.Current.SendsToEthereum)
+
+	// and the report is closed once the period has passed
+	ctx = ctx.WithBlockHeight(start + 2)
+	k.CloseBridgeReport(ctx)
+	report, found := k.GetBridgeReport(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.BridgeReport{
+		Id:              1,
+		StartHeight:     uint64(start),
+		EndHeight:       uint64(start + 2),
+		SendsToEthereum: 2,
+		BatchesExecuted: 1,
+		FeesCollected:   sdk.NewCoins(sdk.NewInt64Coin(denom, 5)),
+	}, report)
+
+	// the next period starts with the next block, even without activity
+	ctx = ctx.WithBlockHeight(start + 3)
+	k.CloseBridgeReport(ctx)
+	require.Equal(t, types.BridgeReport{Id: 2, StartHeight: uint64(start + 3)}, k.GetCurrentBridgeReport(ctx))
+
+	// the closed reports are exported with the genesis state
+	genesis := ExportGenesis(ctx, k)
+	require.NoError(t, genesis.ValidateBasic())
+	require.Equal(t, []types.BridgeReport{report}, genesis.BridgeReports)
+
+	// nothing is recorded while the reports are disabled
+	params.BridgeReportPeriod = 0
+	k.setParams(ctx, params)
+	k.CloseBridgeReport(ctx)
+	res, err = k.BridgeReports(sdk.WrapSDKContext(ctx), &types.BridgeReportsRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.Current)
+}
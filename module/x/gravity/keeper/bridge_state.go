@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetBridgeState returns the bridge critical state of all chains at the current height
+func (k Keeper) GetBridgeState(ctx sdk.Context) types.BridgeState {
+	state := types.BridgeState{
+		Height:                 uint64(ctx.BlockHeight()),
+		LastOutgoingBatchNonce: k.getLastOutgoingBatchNonce(ctx),
+		LastSendToEthereumId:   k.getLastSendToEthereumID(ctx),
+		VoucherSupply:          sdk.NewCoins(),
+	}
+
+	chains := k.GetEVMChains(ctx)
+	sort.Slice(chains, func(i, j int) bool { return chains[i].ChainId < chains[j].ChainId })
+	for _, chain := range chains {
+		chainState := types.EVMChainBridgeState{
+			ChainId:                chain.ChainId,
+			LastObservedEventNonce: k.GetLastObservedEventNonce(ctx, chain.ChainId),
+			LatestSignerSetTxNonce: k.GetLatestSignerSetTxNonce(ctx, chain.ChainId),
+			Escrowed:               k.GetEscrowedCoins(ctx, chain.ChainId),
+		}
+		if signerSet := k.GetLastObservedSignerSetTx(ctx, chain.ChainId); signerSet != nil {
+			chainState.LastObservedCheckpoint = k.outgoingTxCheckpoint(signerSet, chain.GravityId)
+		}
+		state.Chains = append(state.Chains, chainState)
+	}
+
+	k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		if types.IsBridgedVoucherDenom(coin.Denom) {
+			state.VoucherSupply = state.VoucherSupply.Add(coin)
+		}
+		return false
+	})
+
+	return state
+}
+
+// GetBridgeStateHash returns the hash of the bridge state committed to at the end of the
+// last block
+func (k Keeper) GetBridgeStateHash(ctx sdk.Context) *types.BridgeStateHash {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.BridgeStateHashKey})
+	if bz == nil {
+		return nil
+	}
+	var hash types.BridgeStateHash
+	k.cdc.MustUnmarshal(bz, &hash)
+	return &hash
+}
+
+// CommitBridgeState stores and emits the hash of the bridge state at the end of the block,
+// so that watchdogs can compare it between nodes, and check the state it commits to against
+// the contracts, without reading the whole bridge state
+func (k Keeper) CommitBridgeState(ctx sdk.Context) {
+	hash := types.BridgeStateHash{
+		Height: uint64(ctx.BlockHeight()),
+		Hash:   k.GetBridgeState(ctx).Hash(),
+	}
+	ctx.KVStore(k.storeKey).Set([]byte{types.BridgeStateHashKey}, k.cdc.MustMarshal(&hash))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeStateHash,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(hash.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyBridgeStateHash, hex.EncodeToString(hash.Hash)),
+	))
+}
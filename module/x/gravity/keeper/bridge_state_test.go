@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestBridgeStateHash(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := k.getBridgeChainID(ctx)
+
+	require.Nil(t, k.GetBridgeStateHash(ctx))
+
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(EthAddrs[0]), 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	k.setLastObservedEventNonce(ctx, chainID, 3)
+
+	k.CommitBridgeState(ctx)
+	res, err := k.BridgeStateHash(sdk.WrapSDKContext(ctx), &types.BridgeStateHashRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(ctx.BlockHeight()), res.Hash.Height)
+	require.Equal(t, res.State.Hash(), res.Hash.Hash)
+
+	// the state holds the nonces of each chain and the supply of the bridged vouchers only
+	require.Equal(t, vouchers, res.State.VoucherSupply)
+	require.Equal(t, chainID, res.State.Chains[0].ChainId)
+	require.Equal(t, uint64(3), res.State.Chains[0].LastObservedEventNonce)
+
+	// any change to the state changes the hash
+	k.setLastObservedEventNonce(ctx, chainID, 4)
+	k.CommitBridgeState(ctx)
+	require.NotEqual(t, res.Hash.Hash, k.GetBridgeStateHash(ctx).Hash)
+}
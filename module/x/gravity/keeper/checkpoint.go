@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// checkpointCacheSize is the number of checkpoints memoized by the keeper, enough for the
+// outgoing txs pending on all chains to be confirmed without recomputing theirs
+const checkpointCacheSize = 1024
+
+func newCheckpointCache() *lru.Cache {
+	cache, err := lru.New(checkpointCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+// checkpointVersion returns the checkpoint version of the outgoing txs created in the block,
+// the one last activated by the params
+func (k Keeper) checkpointVersion(ctx sdk.Context) uint32 {
+	return types.ActiveCheckpointVersion(k.GetParams(ctx).CheckpointVersionActivations, uint64(ctx.BlockHeight()))
+}
+
+// outgoingTxCheckpoint returns the checkpoint of the outgoing tx under the gravity id, every
+// validator's confirmation of an outgoing tx being checked against it. Checkpoints are
+// memoized by the encoding of the outgoing tx rather than its store index, so that a memoized
+// checkpoint can't outlive the tx it was computed for.
+func (k Keeper) outgoingTxCheckpoint(otx types.OutgoingTx, gravityID string) []byte {
+	if k.checkpoints == nil {
+		return otx.GetCheckpoint([]byte(gravityID))
+	}
+	any, err := types.PackOutgoingTx(otx)
+	if err != nil {
+		panic(err)
+	}
+
+	key := string(sdk.Uint64ToBigEndian(uint64(len(gravityID)))) + gravityID + any.TypeUrl + "\x00" + string(any.Value)
+	if checkpoint, ok := k.checkpoints.Get(key); ok {
+		return append([]byte(nil), checkpoint.([]byte)...)
+	}
+	checkpoint := otx.GetCheckpoint([]byte(gravityID))
+	k.checkpoints.Add(key, append([]byte(nil), checkpoint...))
+	return checkpoint
+}
+
+// outgoingTxTypedDataDigest returns the digest of the EIP-712 typed data of the outgoing tx
+// under the gravity id and EVM chain, memoized alongside the checkpoints. Its keys start with
+// a prefix, where those of the checkpoints start with the zero high bytes of a length.
+func (k Keeper) outgoingTxTypedDataDigest(otx types.OutgoingTx, gravityID string, chainID uint64) []byte {
+	if k.checkpoints == nil {
+		return types.TypedDataDigest(otx.GetTypedData([]byte(gravityID), chainID))
+	}
+	any, err := types.PackOutgoingTx(otx)
+	if err != nil {
+		panic(err)
+	}
+
+	key := "eip712\x00" + string(sdk.Uint64ToBigEndian(chainID)) + string(sdk.Uint64ToBigEndian(uint64(len(gravityID)))) + gravityID + any.TypeUrl + "\x00" + string(any.Value)
+	if digest, ok := k.checkpoints.Get(key); ok {
+		return append([]byte(nil), digest.([]byte)...)
+	}
+	digest := types.TypedDataDigest(otx.GetTypedData([]byte(gravityID), chainID))
+	k.checkpoints.Add(key, append([]byte(nil), digest...))
+	return digest
+}
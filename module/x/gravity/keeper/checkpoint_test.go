@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestOutgoingTxCheckpoint(t *testing.T) {
+	k := CreateTestEnv(t).GravityKeeper
+
+	batch := &types.BatchTx{BatchNonce: 1, Timeout: 10, TokenContract: EthAddrs[0].Hex()}
+	checkpoint := k.outgoingTxCheckpoint(batch, "foo")
+	require.Equal(t, batch.GetCheckpoint([]byte("foo")), checkpoint)
+	require.Equal(t, 1, k.checkpoints.Len())
+
+	// memoized checkpoints are returned as copies
+	checkpoint[0]++
+	require.Equal(t, batch.GetCheckpoint([]byte("foo")), k.outgoingTxCheckpoint(batch, "foo"))
+	require.Equal(t, 1, k.checkpoints.Len())
+
+	// a gravity id or a tx at the same store index with other contents has its own checkpoint
+	require.Equal(t, batch.GetCheckpoint([]byte("bar")), k.outgoingTxCheckpoint(batch, "bar"))
+	changed := &types.BatchTx{BatchNonce: 1, Timeout: 20, TokenContract: EthAddrs[0].Hex()}
+	require.Equal(t, changed.GetStoreIndex(), batch.GetStoreIndex())
+	require.Equal(t, changed.GetCheckpoint([]byte("foo")), k.outgoingTxCheckpoint(changed, "foo"))
+	require.NotEqual(t, batch.GetCheckpoint([]byte("foo")), changed.GetCheckpoint([]byte("foo")))
+	require.Equal(t, 3, k.checkpoints.Len())
+}
+
+func TestOutgoingTxCheckpointVersion(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	k.StakingKeeper = NewStakingKeeperMock(sdk.ValAddress(AccAddrs[0]))
+	k.setValidatorEthereumAddress(ctx, sdk.ValAddress(AccAddrs[0]), EthAddrs[0])
+
+	// the txs are created with the first version until governance activates another
+	require.Equal(t, types.CheckpointVersion1, k.CreateSignerSetTx(ctx, chainID).CheckpointVersion)
+}
@@ -5,11 +5,15 @@ import (
 	"encoding/hex"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
-func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte, invalidationNonce uint64) {
-	otx := k.GetOutgoingTx(ctx, types.MakeContractCallTxKey(invalidationScope, invalidationNonce))
+func (k Keeper) contractCallExecuted(ctx sdk.Context, chainID uint64, invalidationScope []byte, invalidationNonce uint64) {
+	otx := k.GetOutgoingTx(ctx, chainID, types.MakeContractCallTxKey(invalidationScope, invalidationNonce))
 	if otx == nil {
 		k.Logger(ctx).Error("Failed to clean contract calls",
 			"invalidation scope", hex.EncodeToString(invalidationScope),
@@ -18,15 +22,116 @@ func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte,
 	}
 
 	completedCallTx, _ := otx.(*types.ContractCallTx)
-	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+	k.IterateOutgoingTxsByType(ctx, chainID, types.ContractCallTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
 		// If the iterated contract call's nonce is lower than the one that was just executed, delete it
 		cctx, _ := otx.(*types.ContractCallTx)
 		if (cctx.InvalidationNonce < completedCallTx.InvalidationNonce) &&
 			bytes.Equal(cctx.InvalidationScope, completedCallTx.InvalidationScope) {
-			k.DeleteOutgoingTx(ctx, cctx.GetStoreIndex())
+			k.DeleteOutgoingTx(ctx, chainID, cctx.GetStoreIndex())
 		}
 		return false
 	})
 
-	k.DeleteOutgoingTx(ctx, completedCallTx.GetStoreIndex())
+	k.DeleteOutgoingTx(ctx, chainID, completedCallTx.GetStoreIndex())
+	k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.ContractCallsExecuted++ })
+
+	emitTypedEvent(ctx, &types.EventContractCallTxExecuted{
+		EvmChainId:        chainID,
+		InvalidationScope: completedCallTx.InvalidationScope,
+		InvalidationNonce: completedCallTx.InvalidationNonce,
+	})
+}
+
+// CreateTemplateLogicCall makes the logic call of the named template with the sender's
+// tokens, the fee being paid to the relayer out of them. Like sends to Ethereum, gravity
+// vouchers are burned and Cosmos originated tokens locked. Each call gets its own
+// invalidation scope, so calls can't invalidate one another by executing out of order.
+func (k Keeper) CreateTemplateLogicCall(ctx sdk.Context, name string, sender sdk.AccAddress, amount sdk.Coin, fee sdk.Coin) (*types.ContractCallTx, error) {
+	template, found := k.GetParams(ctx).GetLogicCallTemplate(name)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownLogicCallTemplate, name)
+	}
+	chainID, err := k.resolveEVMChainID(ctx, template.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	if k.IsEVMChainPaused(ctx, chainID) {
+		return nil, sdkerrors.Wrapf(types.ErrEVMChainPaused, "chain id %d", chainID)
+	}
+	if k.isMigrating(ctx, chainID) {
+		return nil, sdkerrors.Wrapf(types.ErrContractMigration, "chain id %d", chainID)
+	}
+
+	total := amount.Add(fee)
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, chainID, total.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	erc20Amount, err := chain.ERC20Amount(amount.Denom, amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+	erc20Fee, err := chain.ERC20Amount(fee.Denom, fee.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if minimumFee := chain.MinimumFee(tokenContract); erc20Fee.LT(minimumFee) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientFee, "fee %s is below the minimum of %s on chain id %d", erc20Fee, minimumFee, chainID)
+	}
+	if err := k.consumeRateLimit(ctx, chainID, tokenContract, erc20Amount.Add(erc20Fee)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{total}); err != nil {
+		return nil, err
+	}
+	if !isCosmosOriginated {
+		if err := k.retireVouchers(ctx, sdk.Coins{total}); err != nil {
+			panic(err)
+		}
+	} else if err := k.escrowCoins(ctx, chainID, sdk.Coins{total}); err != nil {
+		return nil, err
+	}
+
+	nonce := k.incrementLastSendToEthereumIDKey(ctx)
+	scope := crypto.Keccak256([]byte(template.Name), sdk.Uint64ToBigEndian(nonce))
+	cctx := k.CreateContractCallTx(
+		ctx,
+		chainID,
+		nonce,
+		scope,
+		common.HexToAddress(template.LogicContract),
+		template.Payload,
+		[]types.ERC20Token{types.NewSDKIntERC20Token(erc20Amount, tokenContract)},
+		[]types.ERC20Token{types.NewSDKIntERC20Token(erc20Fee, tokenContract)},
+	)
+	cctx.RefundAddress = sender.String()
+	k.SetOutgoingTx(ctx, chainID, cctx)
+
+	return cctx, nil
+}
+
+// CancelContractCallTx deletes a timed out or vetoed contract call, refunding its tokens and fees
+// to its refund address if it has one
+func (k Keeper) CancelContractCallTx(ctx sdk.Context, chainID uint64, cctx *types.ContractCallTx) {
+	if cctx.RefundAddress != "" {
+		refundAddress, _ := sdk.AccAddressFromBech32(cctx.RefundAddress)
+		refunds := newRefunds(chainID)
+		for _, token := range append(cctx.Tokens, cctx.Fees...) {
+			k.addTokenRefund(ctx, refunds, refundAddress, token)
+		}
+		if err := k.issueRefunds(ctx, refunds); err != nil {
+			panic(err)
+		}
+	}
+
+	k.DeleteOutgoingTx(ctx, chainID, cctx.GetStoreIndex())
+
+	emitTypedEvent(ctx, &types.EventContractCallTxCanceled{
+		EvmChainId:        chainID,
+		InvalidationScope: cctx.InvalidationScope,
+		InvalidationNonce: cctx.InvalidationNonce,
+	})
 }
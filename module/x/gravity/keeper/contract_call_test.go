@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestContractCallTxExecuted(t *testing.T) {
@@ -35,7 +36,7 @@ func TestContractCallTxExecuted(t *testing.T) {
 	}
 
 	input.GravityKeeper.CreateContractCallTx(
-		ctx,
+		ctx, TestingGravityParams.BridgeChainId,
 		nonce1,
 		scope,
 		contract,
@@ -45,7 +46,7 @@ func TestContractCallTxExecuted(t *testing.T) {
 	)
 
 	input.GravityKeeper.CreateContractCallTx(
-		ctx,
+		ctx, TestingGravityParams.BridgeChainId,
 		nonce2,
 		scope,
 		contract,
@@ -54,7 +55,7 @@ func TestContractCallTxExecuted(t *testing.T) {
 		erc20Tokens,
 	)
 
-	cctx1 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce1)).(*types.ContractCallTx)
+	cctx1 := input.GravityKeeper.GetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, types.MakeContractCallTxKey(scope, nonce1)).(*types.ContractCallTx)
 	assert.Equal(t, cctx1.InvalidationScope, scope)
 	assert.Equal(t, cctx1.InvalidationNonce, nonce1)
 	assert.Equal(t, cctx1.Address, contract.Hex())
@@ -62,7 +63,7 @@ func TestContractCallTxExecuted(t *testing.T) {
 	assert.Equal(t, cctx1.Tokens, erc20Tokens)
 	assert.Equal(t, cctx1.Fees, erc20Tokens)
 
-	cctx2 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce2)).(*types.ContractCallTx)
+	cctx2 := input.GravityKeeper.GetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, types.MakeContractCallTxKey(scope, nonce2)).(*types.ContractCallTx)
 	assert.Equal(t, cctx2.InvalidationScope, scope)
 	assert.Equal(t, cctx2.InvalidationNonce, nonce2)
 	assert.Equal(t, cctx2.Address, contract.Hex())
@@ -70,11 +71,56 @@ func TestContractCallTxExecuted(t *testing.T) {
 	assert.Equal(t, cctx2.Tokens, erc20Tokens)
 	assert.Equal(t, cctx2.Fees, erc20Tokens)
 
-	input.GravityKeeper.contractCallExecuted(ctx, scope, nonce2)
+	input.GravityKeeper.contractCallExecuted(ctx, TestingGravityParams.BridgeChainId, scope, nonce2)
 
-	otx1 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce1))
-	otx2 := input.GravityKeeper.GetOutgoingTx(ctx, types.MakeContractCallTxKey(scope, nonce2))
+	otx1 := input.GravityKeeper.GetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, types.MakeContractCallTxKey(scope, nonce1))
+	otx2 := input.GravityKeeper.GetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, types.MakeContractCallTxKey(scope, nonce2))
 
 	assert.Nil(t, otx1)
 	assert.Nil(t, otx2)
 }
+
+func TestCreateTemplateLogicCall(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		logicContract = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		denom         = types.GravityDenom(tokenContract)
+		sender        = AccAddrs[0]
+		vouchers      = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1000)))
+	)
+	params := k.GetParams(ctx)
+	params.LogicCallTemplates = []types.LogicCallTemplate{{Name: "swap", LogicContract: logicContract.Hex(), Payload: []byte("payload")}}
+	k.setParams(ctx, params)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, vouchers))
+
+	_, err := k.CreateTemplateLogicCall(ctx, "lend", sender, sdk.NewCoin(denom, sdk.NewInt(100)), sdk.NewCoin(denom, sdk.NewInt(1)))
+	require.Error(t, err)
+
+	// the vouchers are burned and the call made with their ERC20
+	cctx1, err := k.CreateTemplateLogicCall(ctx, "swap", sender, sdk.NewCoin(denom, sdk.NewInt(400)), sdk.NewCoin(denom, sdk.NewInt(10)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(590), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+	require.Equal(t, logicContract.Hex(), cctx1.Address)
+	require.Equal(t, []byte("payload"), cctx1.Payload)
+	require.Equal(t, []types.ERC20Token{types.NewSDKIntERC20Token(sdk.NewInt(400), tokenContract)}, cctx1.Tokens)
+	require.Equal(t, []types.ERC20Token{types.NewSDKIntERC20Token(sdk.NewInt(10), tokenContract)}, cctx1.Fees)
+	require.Equal(t, sender.String(), cctx1.RefundAddress)
+	stored := k.GetOutgoingTx(ctx, chainID, types.MakeContractCallTxKey(cctx1.InvalidationScope, cctx1.InvalidationNonce))
+	require.Equal(t, cctx1, stored)
+
+	// every call has its own invalidation scope
+	cctx2, err := k.CreateTemplateLogicCall(ctx, "swap", sender, sdk.NewCoin(denom, sdk.NewInt(100)), sdk.NewCoin(denom, sdk.NewInt(10)))
+	require.NoError(t, err)
+	require.NotEqual(t, cctx1.InvalidationScope, cctx2.InvalidationScope)
+
+	// timed out calls are refunded, tokens and fees
+	k.CancelContractCallTx(ctx, chainID, cctx1)
+	require.Nil(t, k.GetOutgoingTx(ctx, chainID, cctx1.GetStoreIndex()))
+	require.Equal(t, sdk.NewInt(890), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetBridgeContract returns the Gravity contract the EVM chain is currently bridged
+// through along with the bridging epoch it was cut over to in
+func (k Keeper) GetBridgeContract(ctx sdk.Context, chainID uint64) types.BridgeContract {
+	var contract types.BridgeContract
+	if bz := k.chainStore(ctx, chainID).Get([]byte{types.BridgeContractKey}); bz != nil {
+		k.cdc.MustUnmarshal(bz, &contract)
+	}
+	// the address itself is kept with the rest of the chain's description
+	contract.BridgeEthereumAddress = k.getBridgeContractAddress(ctx, chainID)
+	return contract
+}
+
+func (k Keeper) setBridgeContract(ctx sdk.Context, chainID uint64, contract types.BridgeContract) {
+	k.chainStore(ctx, chainID).Set([]byte{types.BridgeContractKey}, k.cdc.MustMarshal(&contract))
+}
+
+// GetContractMigration returns the pending migration of the EVM chain to a new
+// Gravity contract, if any
+func (k Keeper) GetContractMigration(ctx sdk.Context, chainID uint64) (types.ContractMigration, bool) {
+	bz := k.chainStore(ctx, chainID).Get([]byte{types.ContractMigrationKey})
+	if bz == nil {
+		return types.ContractMigration{}, false
+	}
+	var migration types.ContractMigration
+	k.cdc.MustUnmarshal(bz, &migration)
+	return migration, true
+}
+
+func (k Keeper) setContractMigration(ctx sdk.Context, chainID uint64, migration types.ContractMigration) {
+	k.chainStore(ctx, chainID).Set([]byte{types.ContractMigrationKey}, k.cdc.MustMarshal(&migration))
+}
+
+func (k Keeper) deleteContractMigration(ctx sdk.Context, chainID uint64) {
+	k.chainStore(ctx, chainID).Delete([]byte{types.ContractMigrationKey})
+}
+
+// isMigrating returns true while the EVM chain waits to cut over to a new contract,
+// no batches or contract calls are created for the old contract in the meantime
+func (k Keeper) isMigrating(ctx sdk.Context, chainID uint64) bool {
+	return k.chainStore(ctx, chainID).Has([]byte{types.ContractMigrationKey})
+}
+
+// startContractMigration records a pending migration of the EVM chain to a new Gravity
+// contract deployed at the ethereum height, the cutover happens once the old contract's
+// outgoing txs drain
+func (k Keeper) startContractMigration(ctx sdk.Context, chainID uint64, contract string, ethereumHeight uint64) error {
+	if existing, found := k.GetContractMigration(ctx, chainID); found {
+		return sdkerrors.Wrapf(types.ErrContractMigration, "evm chain %d is already migrating to %s", chainID, existing.BridgeEthereumAddress)
+	}
+
+	current := k.getBridgeContractAddress(ctx, chainID)
+	if strings.EqualFold(current, contract) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "evm chain %d is already bridged through %s", chainID, current)
+	}
+
+	migration := types.ContractMigration{
+		BridgeEthereumAddress: types.NormalizeEthereumAddress(contract),
+		EthereumHeight:        ethereumHeight,
+		Height:                uint64(ctx.BlockHeight()),
+	}
+	k.setContractMigration(ctx, chainID, migration)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeContractMigration,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		types.EthereumAddressAttribute(types.AttributeKeyContract, current),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		types.EthereumAddressAttribute(types.AttributeKeyNewContract, migration.BridgeEthereumAddress),
+	))
+
+	return nil
+}
+
+// CompleteContractMigration cuts the EVM chain over to the contract of its pending
+// migration once no batches or contract calls of the old contract are left, either
+// executed or timed out. It returns true if the cutover happened.
+func (k Keeper) CompleteContractMigration(ctx sdk.Context, chainID uint64) bool {
+	migration, found := k.GetContractMigration(ctx, chainID)
+	if !found {
+		return false
+	}
+
+	drained := true
+	k.iterateOutgoingTxs(ctx, chainID, func(_ []byte, otx types.OutgoingTx) bool {
+		switch otx.(type) {
+		case *types.BatchTx, *types.ContractCallTx, *types.ERC1155BatchTx:
+			drained = false
+			return true
+		}
+		return false
+	})
+	if !drained {
+		return false
+	}
+
+	old := k.GetBridgeContract(ctx, chainID)
+	k.MigrateGravityContract(ctx, chainID, migration.BridgeEthereumAddress, migration.EthereumHeight)
+	k.deleteContractMigration(ctx, chainID)
+
+	contract := types.BridgeContract{
+		BridgeEthereumAddress: migration.BridgeEthereumAddress,
+		Epoch:                 old.Epoch + 1,
+		EthereumHeight:        migration.EthereumHeight,
+	}
+	k.setBridgeContract(ctx, chainID, contract)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgingEpoch,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		types.EthereumAddressAttribute(types.AttributeKeyContract, contract.BridgeEthereumAddress),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyBridgingEpoch, fmt.Sprint(contract.Epoch)),
+		types.EthereumAddressAttribute(types.AttributeKeyPreviousContract, old.BridgeEthereumAddress),
+		sdk.NewAttribute(types.AttributeKeyEthereumHeight, fmt.Sprint(contract.EthereumHeight)),
+	))
+	k.Logger(ctx).Info(
+		"contract migration completed",
+		"chain id", chainID,
+		"bridge contract", contract.BridgeEthereumAddress,
+		"epoch", contract.Epoch,
+		"ethereum height", contract.EthereumHeight,
+	)
+
+	return true
+}
+
+// validateEventContract rejects events that were emitted before the current contract
+// was deployed, after a migration these can only come from a previous contract
+func (k Keeper) validateEventContract(ctx sdk.Context, chainID uint64, event types.EthereumEvent) error {
+	contract := k.GetBridgeContract(ctx, chainID)
+	if contract.Epoch > 0 && event.GetEthereumHeight() < contract.EthereumHeight {
+		return sdkerrors.Wrapf(
+			types.ErrInvalid,
+			"event nonce %d at ethereum height %d predates bridge contract %s deployed at height %d",
+			event.GetEventNonce(), event.GetEthereumHeight(), contract.BridgeEthereumAddress, contract.EthereumHeight,
+		)
+	}
+	return nil
+}
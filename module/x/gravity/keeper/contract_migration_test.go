@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestContractMigration(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	newContract := "0x5e175bE4d23Fa25604CE7848F60FB340894D5CDA"
+
+	before := k.GetBridgeContract(ctx, chainID)
+	require.Equal(t, uint64(0), before.Epoch)
+	require.Equal(t, TestingGravityParams.BridgeEthereumAddress, before.BridgeEthereumAddress)
+
+	// a batch of the old contract is still outstanding when the migration passes
+	batch := &types.BatchTx{
+		BatchNonce:    1,
+		Timeout:       10000,
+		TokenContract: TokenContractAddrs[0],
+		Height:        uint64(ctx.BlockHeight()),
+	}
+	k.SetOutgoingTx(ctx, chainID, batch)
+
+	proposal := types.NewContractMigrationProposal("migrate", "move to the new contract", 0, newContract, 1000)
+	require.NoError(t, k.HandleContractMigrationProposal(ctx, proposal))
+	require.ErrorIs(t, k.HandleContractMigrationProposal(ctx, proposal), types.ErrContractMigration)
+
+	// no new outgoing txs are created for the old contract while it drains
+	require.Nil(t, k.CreateBatchTx(ctx, chainID, common.HexToAddress(TokenContractAddrs[0]), 100))
+	require.Nil(t, k.CreateContractCallTx(ctx, chainID, 1, []byte("scope"), common.HexToAddress(newContract), nil, nil, nil))
+
+	require.False(t, k.CompleteContractMigration(ctx, chainID))
+	_, found := k.GetContractMigration(ctx, chainID)
+	require.True(t, found)
+
+	k.DeleteOutgoingTx(ctx, chainID, batch.GetStoreIndex())
+	require.True(t, k.CompleteContractMigration(ctx, chainID))
+
+	_, found = k.GetContractMigration(ctx, chainID)
+	require.False(t, found)
+	after := k.GetBridgeContract(ctx, chainID)
+	require.Equal(t, types.BridgeContract{BridgeEthereumAddress: newContract, Epoch: 1, EthereumHeight: 1000}, after)
+	require.Equal(t, newContract, k.GetParams(ctx).BridgeEthereumAddress)
+
+	// events emitted before the new contract was deployed are rejected
+	old := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+		EthereumHeight: 999,
+	}
+	require.Error(t, k.validateEventContract(ctx, chainID, old))
+	current := *old
+	current.EthereumHeight = 1000
+	require.NoError(t, k.validateEventContract(ctx, chainID, &current))
+
+	// the chain can't be migrated to the contract it is already bridged through
+	require.Error(t, k.HandleContractMigrationProposal(ctx, proposal))
+}
+
+func TestContractMigrationIsScoped(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	require.NoError(t, k.AddEVMChain(ctx, testEVMChain))
+	proposal := types.NewContractMigrationProposal("migrate", "move to the new contract", testEVMChain.ChainId, "0x5e175bE4d23Fa25604CE7848F60FB340894D5CDA", 50)
+	require.NoError(t, k.HandleContractMigrationProposal(ctx, proposal))
+
+	require.True(t, k.isMigrating(ctx, testEVMChain.ChainId))
+	require.False(t, k.isMigrating(ctx, TestingGravityParams.BridgeChainId))
+
+	require.True(t, k.CompleteContractMigration(ctx, testEVMChain.ChainId))
+	chain, _ := k.GetEVMChain(ctx, testEVMChain.ChainId)
+	require.Equal(t, proposal.BridgeEthereumAddress, chain.BridgeEthereumAddress)
+	require.Equal(t, uint64(0), k.GetBridgeContract(ctx, TestingGravityParams.BridgeChainId).Epoch)
+
+	proposal.EvmChainId = 10
+	require.ErrorIs(t, k.HandleContractMigrationProposal(ctx, proposal), types.ErrUnknownEVMChain)
+}
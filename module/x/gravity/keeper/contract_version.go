@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetContractVersion returns the version of the EVM chain's Gravity contract attested by
+// its ContractVersionEvent, zero until one is observed
+func (k Keeper) GetContractVersion(ctx sdk.Context, chainID uint64) uint64 {
+	if bz := k.chainStore(ctx, chainID).Get([]byte{types.ContractVersionKey}); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setContractVersion(ctx sdk.Context, chainID uint64, version uint64) {
+	if version == 0 {
+		k.chainStore(ctx, chainID).Delete([]byte{types.ContractVersionKey})
+		return
+	}
+	k.chainStore(ctx, chainID).Set([]byte{types.ContractVersionKey}, sdk.Uint64ToBigEndian(version))
+}
+
+// contractSupports returns true if the EVM chain's contract is able to verify the
+// checkpoints of the feature, that is governance hasn't set a minimum contract version
+// for it or the attested version reaches it
+func (k Keeper) contractSupports(ctx sdk.Context, chainID uint64, feature types.ContractFeature) bool {
+	for _, minimum := range k.GetParams(ctx).MinimumContractVersions {
+		if minimum.Feature == feature {
+			return k.GetContractVersion(ctx, chainID) >= minimum.Version
+		}
+	}
+	return true
+}
+
+// acceptedSigningPayloads returns whether the confirmations of the EVM chain's outgoing txs may sign
+// their checkpoint and their EIP-712 typed data. Typed data is accepted once the attested
+// contract version verifies it, checkpoints until the TypedDataSignaturesOnly param retires
+// them on such chains.
+func (k Keeper) acceptedSigningPayloads(ctx sdk.Context, chainID uint64) (checkpoints bool, typedData bool) {
+	typedData = k.GetContractVersion(ctx, chainID) >= types.TypedDataContractVersion
+	return !typedData || !k.GetParams(ctx).TypedDataSignaturesOnly, typedData
+}
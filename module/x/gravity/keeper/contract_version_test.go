@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestContractVersionGating(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		receiver      = AccAddrs[0]
+	)
+	input.AccountKeeper.NewAccountWithAddress(ctx, receiver)
+	require.NoError(t, k.Handle(ctx, chainID, &types.SendERC1155ToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amounts:        []types.ERC1155Amount{{Id: sdk.NewInt(1), Amount: sdk.NewInt(10)}},
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 10,
+	}))
+	_, err := k.createSendERC1155ToEthereum(ctx, chainID, receiver, EthAddrs[1].Hex(), tokenContract, []types.ERC1155Amount{{Id: sdk.NewInt(1), Amount: sdk.NewInt(4)}})
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	params.MinimumContractVersions = []types.MinimumContractVersion{{Feature: types.ContractFeatureERC1155Batches, Version: 2}}
+	require.NoError(t, params.ValidateBasic())
+	k.setParams(ctx, params)
+
+	// no batches are created until the contract is attested to support them
+	require.Zero(t, k.GetContractVersion(ctx, chainID))
+	require.Nil(t, k.CreateERC1155BatchTx(ctx, chainID, tokenContract, BatchTxSize))
+
+	require.NoError(t, k.Handle(ctx, chainID, &types.ContractVersionEvent{EventNonce: 2, Version: 1, EthereumHeight: 11}))
+	require.Nil(t, k.CreateERC1155BatchTx(ctx, chainID, tokenContract, BatchTxSize))
+
+	require.NoError(t, k.Handle(ctx, chainID, &types.ContractVersionEvent{EventNonce: 3, Version: 2, EthereumHeight: 12}))
+	require.Equal(t, uint64(2), k.GetContractVersion(ctx, chainID))
+	require.NotNil(t, k.CreateERC1155BatchTx(ctx, chainID, tokenContract, BatchTxSize))
+
+	// features without a minimum version are never gated
+	require.True(t, k.contractSupports(ctx, chainID, types.ContractFeatureContractCalls))
+
+	// the version is forgotten when the chain moves to a new contract
+	k.MigrateGravityContract(ctx, chainID, EthAddrs[2].Hex(), 100)
+	require.Zero(t, k.GetContractVersion(ctx, chainID))
+
+	// duplicate and unspecified features are rejected
+	params.MinimumContractVersions = append(params.MinimumContractVersions, types.MinimumContractVersion{Feature: types.ContractFeatureERC1155Batches, Version: 3})
+	require.Error(t, params.ValidateBasic())
+	params.MinimumContractVersions = []types.MinimumContractVersion{{Feature: types.ContractFeatureUnspecified, Version: 1}}
+	require.Error(t, params.ValidateBasic())
+}
@@ -1,17 +1,16 @@
 package keeper
 
 import (
-	"fmt"
-
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
-func (k Keeper) getCosmosOriginatedDenom(ctx sdk.Context, tokenContract common.Address) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) getCosmosOriginatedDenom(ctx sdk.Context, chainID uint64, tokenContract common.Address) (string, bool) {
+	store := k.chainStore(ctx, chainID)
 	bz := store.Get(types.MakeERC20ToDenomKey(tokenContract))
 
 	if bz != nil {
@@ -20,8 +19,8 @@ func (k Keeper) getCosmosOriginatedDenom(ctx sdk.Context, tokenContract common.A
 	return "", false
 }
 
-func (k Keeper) getCosmosOriginatedERC20(ctx sdk.Context, denom string) (common.Address, bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) getCosmosOriginatedERC20(ctx sdk.Context, chainID uint64, denom string) (common.Address, bool) {
+	store := k.chainStore(ctx, chainID)
 	bz := store.Get(types.MakeDenomToERC20Key(denom))
 
 	if bz != nil {
@@ -30,59 +29,73 @@ func (k Keeper) getCosmosOriginatedERC20(ctx sdk.Context, denom string) (common.
 	return common.BytesToAddress([]byte{}), false
 }
 
-func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, tokenContract common.Address) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, chainID uint64, denom string, tokenContract common.Address) {
+	store := k.chainStore(ctx, chainID)
 	store.Set(types.MakeDenomToERC20Key(denom), tokenContract.Bytes())
 	store.Set(types.MakeERC20ToDenomKey(tokenContract), []byte(denom))
 }
 
 // DenomToERC20 returns (bool isCosmosOriginated, string ERC20, err)
 // Using this information, you can see if an asset is native to Cosmos or Ethereum,
-// and get its corresponding ERC20 address.
+// and get its corresponding ERC20 address on the given EVM chain.
 // This will return an error if it cant parse the denom as a gravity denom, and then also can't find the denom
-// in an index of ERC20 contracts deployed on Ethereum to serve as synthetic Cosmos assets.
-func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, common.Address, error) {
+// in an index of ERC20 contracts deployed on Ethereum to serve as synthetic Cosmos assets. Vouchers of an
+// ERC20 of another EVM chain can only be sent to the given chain if an ERC20 was deployed for them there,
+// on that chain they are then Cosmos originated.
+func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, chainID uint64, denom string) (bool, common.Address, error) {
 	// First try parsing the ERC20 out of the denom
+	var voucherChainID uint64
 	tc1, err := types.GravityDenomToERC20(denom)
-	if err != nil {
-		// Look up ERC20 contract in index and error if it's not in there.
-		tc2, exists := k.getCosmosOriginatedERC20(ctx, denom)
-		if !exists {
-			return false, common.Address{},
-				fmt.Errorf("denom not a gravity voucher coin: %s, and also not in cosmos-originated ERC20 index", err)
-		}
-		// This is a cosmos-originated asset
-		return true, tc2, nil
+	if err == nil {
+		voucherChainID = k.getBridgeChainID(ctx)
+	} else {
+		voucherChainID, tc1, err = types.EVMChainGravityDenomToERC20(denom)
+	}
+	if err == nil && voucherChainID == chainID {
+		// This is an asset originated on the given chain
+		return false, common.HexToAddress(tc1), nil
 	}
 
-	// This is an ethereum-originated asset
-	return false, common.HexToAddress(tc1), nil
+	// Look up ERC20 contract in index and error if it's not in there.
+	tc2, exists := k.getCosmosOriginatedERC20(ctx, chainID, denom)
+	if !exists {
+		if err == nil {
+			return false, common.Address{}, sdkerrors.Wrapf(types.ErrUnknownDenom, "denom %s is a voucher of evm chain %d, not %d", denom, voucherChainID, chainID)
+		}
+		return false, common.Address{},
+			sdkerrors.Wrapf(types.ErrUnknownDenom, "denom not a gravity voucher coin: %s, and also not in cosmos-originated ERC20 index", denom)
+	}
+	// This is a cosmos-originated asset
+	return true, tc2, nil
 }
 
 // ERC20ToDenom returns (bool isCosmosOriginated, string denom, err)
 // Using this information, you can see if an ERC20 address represents an asset is native to Cosmos or Ethereum,
 // and get its corresponding denom
-func (k Keeper) ERC20ToDenomLookup(ctx sdk.Context, tokenContract common.Address) (bool, string) {
+func (k Keeper) ERC20ToDenomLookup(ctx sdk.Context, chainID uint64, tokenContract common.Address) (bool, string) {
 	// First try looking up tokenContract in index
-	dn1, exists := k.getCosmosOriginatedDenom(ctx, tokenContract)
+	dn1, exists := k.getCosmosOriginatedDenom(ctx, chainID, tokenContract)
 	if exists {
 		// It is a cosmos originated asset
 		return true, dn1
 	}
 
 	// If it is not in there, it is not a cosmos originated token, turn the ERC20 into a gravity denom
-	return false, types.GravityDenom(tokenContract)
+	if chainID == k.getBridgeChainID(ctx) {
+		return false, types.GravityDenom(tokenContract)
+	}
+	return false, types.EVMChainGravityDenom(chainID, tokenContract)
 }
 
 // iterateERC20ToDenom iterates over erc20 to denom relations
-func (k Keeper) iterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC20ToDenom) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ERC20ToDenomKey})
+func (k Keeper) iterateERC20ToDenom(ctx sdk.Context, chainID uint64, cb func([]byte, *types.ERC20ToDenom) bool) {
+	prefixStore := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.ERC20ToDenomKey})
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
 		erc20ToDenom := types.ERC20ToDenom{
-			Erc20: string(iter.Key()),
+			Erc20: common.BytesToAddress(iter.Key()).Hex(),
 			Denom: string(iter.Value()),
 		}
 		// cb returns true to stop early
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keycodec"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetDepositAddress returns the deposit address registered for the recipient on the
+// EVM chain, if any
+func (k Keeper) GetDepositAddress(ctx sdk.Context, chainID uint64, recipient sdk.AccAddress) (common.Address, bool) {
+	bz := k.chainStore(ctx, chainID).Get(types.MakeDepositAddressKey(recipient))
+	if bz == nil {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+func (k Keeper) setDepositAddress(ctx sdk.Context, chainID uint64, recipient sdk.AccAddress, depositAddress common.Address) {
+	k.chainStore(ctx, chainID).Set(types.MakeDepositAddressKey(recipient), depositAddress.Bytes())
+}
+
+// iterateDepositAddresses iterates over the deposit addresses registered on the EVM chain
+func (k Keeper) iterateDepositAddresses(ctx sdk.Context, chainID uint64, cb func(recipient sdk.AccAddress, depositAddress common.Address) bool) {
+	iter := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.DepositAddressKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(keycodec.NewReader(iter.Key()).LengthPrefixed(), common.BytesToAddress(iter.Value())) {
+			break
+		}
+	}
+}
+
+// deriveDepositAddress returns the address the deposit address factory of the EVM chain
+// would clone for the recipient
+func (k Keeper) deriveDepositAddress(ctx sdk.Context, chainID uint64, recipient sdk.AccAddress) (common.Address, error) {
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	if chain.DepositAddressFactory == "" {
+		return common.Address{}, sdkerrors.Wrapf(types.ErrNoDepositAddressFactory, "evm chain %d", chainID)
+	}
+	return types.DeriveDepositAddress(common.HexToAddress(chain.DepositAddressFactory), recipient), nil
+}
+
+// registerDepositAddress registers the deposit address the EVM chain's factory derives
+// for the recipient, a recipient that already has one keeps it
+func (k Keeper) registerDepositAddress(ctx sdk.Context, chainID uint64, recipient sdk.AccAddress) (common.Address, error) {
+	if depositAddress, found := k.GetDepositAddress(ctx, chainID, recipient); found {
+		return depositAddress, nil
+	}
+
+	depositAddress, err := k.deriveDepositAddress(ctx, chainID, recipient)
+	if err != nil {
+		return common.Address{}, err
+	}
+	k.setDepositAddress(ctx, chainID, recipient, depositAddress)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDepositAddress,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		types.EthereumAddressAttribute(types.AttributeKeyDepositAddress, depositAddress.Hex()),
+	))
+
+	return depositAddress, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// injectEthereumEvent observes the event as if all the bonded validators had voted for it,
+// for devnets without an Ethereum chain and orchestrators. The validators behind the last
+// observed nonce are caught up to it and those that already voted at the nonce keep their
+// vote, the event then goes through the same records, handlers and events as one voted by
+// orchestrators.
+func (k Keeper) injectEthereumEvent(ctx sdk.Context, chainID uint64, event types.EthereumEvent) error {
+	lastEventNonce := k.GetLastObservedEventNonce(ctx, chainID)
+	if event.GetEventNonce() != lastEventNonce+1 {
+		return sdkerrors.Wrapf(types.ErrStaleNonce, "expected event nonce %d, got %d", lastEventNonce+1, event.GetEventNonce())
+	}
+
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		val := validator.GetOperator()
+		switch last := k.getLastEventNonceByValidator(ctx, chainID, val); {
+		case last >= event.GetEventNonce():
+			// the validator's orchestrator already voted at the nonce
+			continue
+		case last < lastEventNonce:
+			k.setLastEventNonceByValidator(ctx, chainID, val, lastEventNonce)
+		}
+		if _, err := k.recordEventVote(ctx, chainID, event, val); err != nil {
+			return err
+		}
+	}
+	record := k.GetEthereumEventVoteRecord(ctx, chainID, event.GetEventNonce(), event.Hash())
+	if record == nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "no bonded validators voted for the event")
+	}
+
+	k.TryEventVoteRecord(ctx, chainID, record)
+	if !record.Accepted {
+		return sdkerrors.Wrap(types.ErrInvalid, "the votes of the bonded validators did not observe the event")
+	}
+	return nil
+}
@@ -0,0 +1,6 @@
+//go:build !devnet
+
+package keeper
+
+// devnetBuild is set by the devnet build tag, the binary then accepts injected events
+const devnetBuild = false
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestInjectEthereumEvent(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	deposit := func(nonce uint64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[1].Hex(),
+			CosmosReceiver: AccAddrs[1].String(),
+			EthereumHeight: 10,
+		}
+	}
+
+	// the vote of a running orchestrator counts
+	_, err := k.recordEventVote(ctx, chainID, deposit(1), ValAddrs[0])
+	require.NoError(t, err)
+	require.NoError(t, k.injectEthereumEvent(ctx, chainID, deposit(1)))
+
+	// a validator that missed events is caught up
+	k.setLastEventNonceByValidator(ctx, chainID, ValAddrs[4], 0)
+	require.NoError(t, k.injectEthereumEvent(ctx, chainID, deposit(2)))
+
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(ctx, chainID))
+	require.True(t, k.GetEthereumEventVoteRecord(ctx, chainID, 2, deposit(2).Hash()).Accepted)
+	for _, val := range ValAddrs {
+		require.Equal(t, uint64(2), k.getLastEventNonceByValidator(ctx, chainID, val))
+	}
+	require.Equal(t, sdk.NewInt(200), input.BankKeeper.GetBalance(ctx, AccAddrs[1], types.GravityDenom(EthAddrs[0])).Amount)
+
+	// the events are observed in order
+	require.ErrorIs(t, k.injectEthereumEvent(ctx, chainID, deposit(2)), types.ErrStaleNonce)
+	require.ErrorIs(t, k.injectEthereumEvent(ctx, chainID, deposit(4)), types.ErrStaleNonce)
+
+	// release builds reject the msg
+	msg, err := types.NewMsgInjectEthereumEvent(AccAddrs[0], chainID, deposit(3))
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	_, err = NewMsgServerImpl(k).InjectEthereumEvent(sdk.WrapSDKContext(ctx), msg)
+	if devnetBuild {
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), k.GetLastObservedEventNonce(ctx, chainID))
+	} else {
+		require.ErrorIs(t, err, types.ErrDevnetOnly)
+	}
+}
@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetERC1155Token returns the ERC1155 token id behind the vouchers of the denom
+func (k Keeper) GetERC1155Token(ctx sdk.Context, denom string) (types.ERC1155Token, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeERC1155TokenKey(denom))
+	if bz == nil {
+		return types.ERC1155Token{}, false
+	}
+	var token types.ERC1155Token
+	k.cdc.MustUnmarshal(bz, &token)
+	return token, true
+}
+
+func (k Keeper) setERC1155Token(ctx sdk.Context, token types.ERC1155Token) {
+	ctx.KVStore(k.storeKey).Set(types.MakeERC1155TokenKey(token.Denom()), k.cdc.MustMarshal(&token))
+}
+
+func (k Keeper) iterateERC1155Tokens(ctx sdk.Context, cb func(types.ERC1155Token) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ERC1155TokenKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var token types.ERC1155Token
+		k.cdc.MustUnmarshal(iter.Value(), &token)
+		if cb(token) {
+			break
+		}
+	}
+}
+
+// sendERC1155ToCosmos mints vouchers for the ids of an ERC1155 token deposited on the
+// EVM chain and sends them to the receiver
+func (k Keeper) sendERC1155ToCosmos(ctx sdk.Context, chainID uint64, event *types.SendERC1155ToCosmosEvent) error {
+	var coins sdk.Coins
+	for _, amount := range event.Amounts {
+		token := types.NewERC1155Token(chainID, common.HexToAddress(event.TokenContract), amount.Id)
+		if err := k.DetectMaliciousSupply(ctx, token.Denom(), amount.Amount); err != nil {
+			return err
+		}
+		k.setERC1155Token(ctx, token)
+		coins = coins.Add(sdk.NewCoin(token.Denom(), amount.Amount))
+	}
+
+	if err := k.issueVouchers(ctx, coins); err != nil {
+		return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
+	}
+	emitDepositReceivedEvent(ctx, chainID, event.EventNonce, event.EthereumSender, event.CosmosReceiver, event.TokenContract, coins)
+	emitTypedEvent(ctx, &types.EventERC1155DepositObserved{
+		EvmChainId:     chainID,
+		EventNonce:     event.EventNonce,
+		TokenContract:  event.TokenContract,
+		EthereumSender: event.EthereumSender,
+		CosmosReceiver: event.CosmosReceiver,
+		Amounts:        event.Amounts,
+	})
+	if recipientModule, ok := k.ReceiverModuleAccounts[event.CosmosReceiver]; ok {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, coins)
+	}
+	addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
+
+// createSendERC1155ToEthereum burns the sender's vouchers of the ids of an ERC1155 token
+// and adds the transfer to the chain's ERC1155 pool. Only ids deposited from the chain
+// can be sent back to it.
+func (k Keeper) createSendERC1155ToEthereum(ctx sdk.Context, chainID uint64, sender sdk.AccAddress, counterpartReceiver string, tokenContract common.Address, amounts []types.ERC1155Amount) (uint64, error) {
+	if k.IsEVMChainPaused(ctx, chainID) {
+		return 0, sdkerrors.Wrapf(types.ErrEVMChainPaused, "chain id %d", chainID)
+	}
+
+	var vouchers sdk.Coins
+	for _, amount := range amounts {
+		token := types.NewERC1155Token(chainID, tokenContract, amount.Id)
+		if _, found := k.GetERC1155Token(ctx, token.Denom()); !found {
+			return 0, sdkerrors.Wrapf(types.ErrUnknownDenom, "id %s of erc1155 token %s was never deposited from chain id %d", amount.Id, tokenContract.Hex(), chainID)
+		}
+		vouchers = vouchers.Add(sdk.NewCoin(token.Denom(), amount.Amount))
+	}
+
+	if senderModule, ok := k.SenderModuleAccounts[sender.String()]; ok {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, vouchers); err != nil {
+			return 0, err
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, vouchers); err != nil {
+			return 0, err
+		}
+	}
+	if err := k.retireVouchers(ctx, vouchers); err != nil {
+		panic(err)
+	}
+
+	counterpartReceiver = types.NormalizeEthereumAddress(counterpartReceiver)
+	nextID := k.incrementLastSendToEthereumIDKey(ctx)
+	k.updateBlockSummary(ctx, func(summary *types.EventBlockSummary) { summary.NewSends++ })
+	k.emitSendToEthereumEvent(ctx, types.EventTypeBridgeWithdrawalReceived, chainID, nextID, sender.String(), counterpartReceiver, tokenContract.Hex())
+	k.setUnbatchedSendERC1155ToEthereum(ctx, chainID, &types.SendERC1155ToEthereum{
+		Id:                nextID,
+		Sender:            sender.String(),
+		EthereumRecipient: counterpartReceiver,
+		TokenContract:     tokenContract.Hex(),
+		Amounts:           amounts,
+	})
+	emitTypedEvent(ctx, &types.EventSendERC1155ToEthereum{
+		EvmChainId:        chainID,
+		Id:                nextID,
+		Sender:            sender.String(),
+		EthereumRecipient: counterpartReceiver,
+		TokenContract:     tokenContract.Hex(),
+		Amounts:           amounts,
+	})
+
+	return nextID, nil
+}
+
+// setUnbatchedSendERC1155ToEthereum puts the transfer in the pool, counting it in the
+// aggregate of its token
+func (k Keeper) setUnbatchedSendERC1155ToEthereum(ctx sdk.Context, chainID uint64, send *types.SendERC1155ToEthereum) {
+	contract := common.HexToAddress(send.TokenContract)
+	key := types.MakeSendERC1155ToEthereumKey(contract, send.Id)
+	store := k.chainStore(ctx, chainID)
+	if !store.Has(key) {
+		k.updatePoolAggregate(ctx, chainID, types.MakeERC1155PoolAggregateKey(contract), func(aggregate *types.PoolAggregate) {
+			aggregate.Count++
+		})
+	}
+	store.Set(key, k.cdc.MustMarshal(send))
+}
+
+// deleteUnbatchedSendERC1155ToEthereum removes the transfer from the pool and from the
+// aggregate of its token
+func (k Keeper) deleteUnbatchedSendERC1155ToEthereum(ctx sdk.Context, chainID uint64, send *types.SendERC1155ToEthereum) {
+	contract := common.HexToAddress(send.TokenContract)
+	key := types.MakeSendERC1155ToEthereumKey(contract, send.Id)
+	store := k.chainStore(ctx, chainID)
+	if !store.Has(key) {
+		return
+	}
+	k.updatePoolAggregate(ctx, chainID, types.MakeERC1155PoolAggregateKey(contract), func(aggregate *types.PoolAggregate) {
+		aggregate.Count--
+	})
+	store.Delete(key)
+}
+
+// iterateUnbatchedSendERC1155ToEthereumsByContract iterates the pooled transfers of the
+// token oldest first
+func (k Keeper) iterateUnbatchedSendERC1155ToEthereumsByContract(ctx sdk.Context, chainID uint64, contract common.Address, cb func(*types.SendERC1155ToEthereum) bool) {
+	iter := prefix.NewStore(k.chainStore(ctx, chainID), append([]byte{types.SendERC1155ToEthereumKey}, contract.Bytes()...)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var send types.SendERC1155ToEthereum
+		k.cdc.MustUnmarshal(iter.Value(), &send)
+		if cb(&send) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateUnbatchedSendERC1155ToEthereums(ctx sdk.Context, chainID uint64, cb func(*types.SendERC1155ToEthereum) bool) {
+	iter := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.SendERC1155ToEthereumKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var send types.SendERC1155ToEthereum
+		k.cdc.MustUnmarshal(iter.Value(), &send)
+		if cb(&send) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getUnbatchedSendERC1155ToEthereums(ctx sdk.Context, chainID uint64) []*types.SendERC1155ToEthereum {
+	var out []*types.SendERC1155ToEthereum
+	k.IterateUnbatchedSendERC1155ToEthereums(ctx, chainID, func(send *types.SendERC1155ToEthereum) bool {
+		out = append(out, send)
+		return false
+	})
+	return out
+}
+
+// CreateERC1155BatchTx batches up to maxElements of the pooled transfers of the ERC1155
+// token, oldest first. With no fees to compete on there is only ever one batch of a
+// token pending, the next one is created once it has been executed or timed out.
+func (k Keeper) CreateERC1155BatchTx(ctx sdk.Context, chainID uint64, contractAddress common.Address, maxElements int) *types.ERC1155BatchTx {
+	if k.outgoingTxsPaused(ctx, chainID) || !k.contractSupports(ctx, chainID, types.ContractFeatureERC1155Batches) {
+		return nil
+	}
+	if k.getLastERC1155BatchTxByTokenType(ctx, chainID, contractAddress) != nil {
+		return nil
+	}
+
+	var selected []*types.SendERC1155ToEthereum
+	k.iterateUnbatchedSendERC1155ToEthereumsByContract(ctx, chainID, contractAddress, func(send *types.SendERC1155ToEthereum) bool {
+		selected = append(selected, send)
+		return len(selected) == maxElements
+	})
+	if len(selected) == 0 {
+		return nil
+	}
+	for _, send := range selected {
+		k.deleteUnbatchedSendERC1155ToEthereum(ctx, chainID, send)
+	}
+
+	batch := &types.ERC1155BatchTx{
+		BatchNonce:        k.incrementLastOutgoingBatchNonce(ctx),
+		Timeout:           k.getTimeoutHeight(ctx, chainID),
+		Transactions:      selected,
+		TokenContract:     contractAddress.Hex(),
+		Height:            uint64(ctx.BlockHeight()),
+		CheckpointVersion: k.checkpointVersion(ctx),
+	}
+	k.SetOutgoingTx(ctx, chainID, batch)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOutgoingERC1155Batch,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		k.bridgeContractAttribute(ctx, chainID),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		types.EthereumAddressAttribute(types.AttributeKeyTokenContract, batch.TokenContract),
+		sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(batch.BatchNonce)),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(batch.BatchNonce)),
+	))
+	types.IncrMetricCounter(types.MetricKeyBatchTxsCreated, chainID, telemetry.NewLabel(types.MetricLabelKind, types.MetricKindERC1155))
+	k.updateBlockSummary(ctx, func(summary *types.EventBlockSummary) { summary.BatchedTxs += uint64(len(batch.Transactions)) })
+	types.SetMetricGauge(types.MetricKeyBatchTxSize, chainID, float32(len(batch.Transactions)), telemetry.NewLabel(types.MetricLabelKind, types.MetricKindERC1155))
+	emitTypedEvent(ctx, &types.EventOutgoingERC1155Batch{
+		EvmChainId:        chainID,
+		TokenContract:     batch.TokenContract,
+		BatchNonce:        batch.BatchNonce,
+		Timeout:           batch.Timeout,
+		SendToEthereumIds: batch.SendToEthereumIDs(),
+	})
+
+	return batch
+}
+
+// erc1155BatchTxExecuted deletes the executed ERC1155 batch and cancels the earlier
+// batches of the token
+func (k Keeper) erc1155BatchTxExecuted(ctx sdk.Context, chainID uint64, tokenContract common.Address, nonce uint64) {
+	otx := k.GetOutgoingTx(ctx, chainID, types.MakeERC1155BatchTxKey(tokenContract, nonce))
+	if otx == nil {
+		k.Logger(ctx).Error("Failed to clean erc1155 batches",
+			"chain id", chainID,
+			"token contract", tokenContract.Hex(),
+			"nonce", nonce)
+		return
+	}
+	batchTx, _ := otx.(*types.ERC1155BatchTx)
+	k.IterateOutgoingTxsByType(ctx, chainID, types.ERC1155BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+		btx, _ := otx.(*types.ERC1155BatchTx)
+		if (btx.BatchNonce < batchTx.BatchNonce) && (btx.TokenContract == batchTx.TokenContract) {
+			k.CancelERC1155BatchTx(ctx, chainID, btx)
+		}
+		return false
+	})
+	k.DeleteOutgoingTx(ctx, chainID, batchTx.GetStoreIndex())
+
+	emitTypedEvent(ctx, &types.EventOutgoingERC1155BatchExecuted{
+		EvmChainId:    chainID,
+		TokenContract: batchTx.TokenContract,
+		BatchNonce:    batchTx.BatchNonce,
+	})
+}
+
+// CancelERC1155BatchTx returns the transfers of the batch to the pool and deletes it
+func (k Keeper) CancelERC1155BatchTx(ctx sdk.Context, chainID uint64, batch *types.ERC1155BatchTx) {
+	for _, send := range batch.Transactions {
+		k.setUnbatchedSendERC1155ToEthereum(ctx, chainID, send)
+	}
+	k.DeleteOutgoingTx(ctx, chainID, batch.GetStoreIndex())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeERC1155BatchCanceled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			k.bridgeContractAttribute(ctx, chainID),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+			types.EthereumAddressAttribute(types.AttributeKeyTokenContract, batch.TokenContract),
+			sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(batch.BatchNonce)),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(batch.BatchNonce)),
+		),
+	)
+	emitTypedEvent(ctx, &types.EventOutgoingERC1155BatchCanceled{
+		EvmChainId:    chainID,
+		TokenContract: batch.TokenContract,
+		BatchNonce:    batch.BatchNonce,
+	})
+}
+
+func (k Keeper) getLastERC1155BatchTxByTokenType(ctx sdk.Context, chainID uint64, token common.Address) *types.ERC1155BatchTx {
+	var lastBatch *types.ERC1155BatchTx
+	k.IterateOutgoingTxsByType(ctx, chainID, types.ERC1155BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+		btx, _ := otx.(*types.ERC1155BatchTx)
+		if common.HexToAddress(btx.TokenContract) == token && (lastBatch == nil || btx.BatchNonce > lastBatch.BatchNonce) {
+			lastBatch = btx
+		}
+		return false
+	})
+	return lastBatch
+}
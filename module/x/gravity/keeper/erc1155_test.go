@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestERC1155(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		recipient     = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		receiver      = AccAddrs[0]
+		swordDenom    = types.NewERC1155Token(chainID, tokenContract, sdk.NewInt(1)).Denom()
+		shieldDenom   = types.NewERC1155Token(chainID, tokenContract, sdk.NewInt(2)).Denom()
+	)
+	input.AccountKeeper.NewAccountWithAddress(ctx, receiver)
+
+	// deposits mint vouchers of each id
+	require.NoError(t, k.Handle(ctx, chainID, &types.SendERC1155ToCosmosEvent{
+		EventNonce:    1,
+		TokenContract: tokenContract.Hex(),
+		Amounts: []types.ERC1155Amount{
+			{Id: sdk.NewInt(1), Amount: sdk.NewInt(10)},
+			{Id: sdk.NewInt(2), Amount: sdk.NewInt(1)},
+		},
+		EthereumSender: EthAddrs[0].Hex(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 10,
+	}))
+	require.Equal(t, sdk.NewInt(10), input.BankKeeper.GetBalance(ctx, receiver, swordDenom).Amount)
+	require.Equal(t, sdk.NewInt(1), input.BankKeeper.GetBalance(ctx, receiver, shieldDenom).Amount)
+	token, found := k.GetERC1155Token(ctx, swordDenom)
+	require.True(t, found)
+	require.Equal(t, types.NewERC1155Token(chainID, tokenContract, sdk.NewInt(1)), token)
+
+	// sending back burns them, ids that never came from the chain can't be sent
+	send := func(amounts ...types.ERC1155Amount) error {
+		_, err := k.createSendERC1155ToEthereum(ctx, chainID, receiver, recipient.Hex(), tokenContract, amounts)
+		return err
+	}
+	require.NoError(t, send(types.ERC1155Amount{Id: sdk.NewInt(1), Amount: sdk.NewInt(4)}, types.ERC1155Amount{Id: sdk.NewInt(2), Amount: sdk.NewInt(1)}))
+	require.Error(t, send(types.ERC1155Amount{Id: sdk.NewInt(3), Amount: sdk.NewInt(1)}))
+	require.Error(t, send(types.ERC1155Amount{Id: sdk.NewInt(1), Amount: sdk.NewInt(7)}))
+	require.Equal(t, sdk.NewInt(6), input.BankKeeper.GetBalance(ctx, receiver, swordDenom).Amount)
+	require.True(t, input.BankKeeper.GetSupply(ctx, shieldDenom).Amount.IsZero())
+	require.Len(t, k.getUnbatchedSendERC1155ToEthereums(ctx, chainID), 1)
+
+	// only one batch of a token is pending at a time
+	batch := k.CreateERC1155BatchTx(ctx, chainID, tokenContract, BatchTxSize)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 1)
+	require.Empty(t, k.getUnbatchedSendERC1155ToEthereums(ctx, chainID))
+	require.NoError(t, send(types.ERC1155Amount{Id: sdk.NewInt(1), Amount: sdk.NewInt(1)}))
+	require.Nil(t, k.CreateERC1155BatchTx(ctx, chainID, tokenContract, BatchTxSize))
+
+	// a canceled batch returns its transfers to the pool
+	k.CancelERC1155BatchTx(ctx, chainID, batch)
+	require.Nil(t, k.GetOutgoingTx(ctx, chainID, batch.GetStoreIndex()))
+	require.Len(t, k.getUnbatchedSendERC1155ToEthereums(ctx, chainID), 2)
+
+	// the next batch takes both, oldest first, and is deleted once executed
+	batch = k.CreateERC1155BatchTx(ctx, chainID, tokenContract, BatchTxSize)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 2)
+	require.True(t, batch.Transactions[0].Id < batch.Transactions[1].Id)
+	require.NoError(t, k.Handle(ctx, chainID, &types.ERC1155BatchExecutedEvent{
+		TokenContract:  tokenContract.Hex(),
+		EventNonce:     2,
+		EthereumHeight: 11,
+		BatchNonce:     batch.BatchNonce,
+	}))
+	require.Nil(t, k.GetOutgoingTx(ctx, chainID, batch.GetStoreIndex()))
+	require.Empty(t, k.getUnbatchedSendERC1155ToEthereums(ctx, chainID))
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// escrowCoins moves cosmos originated coins sent to the EVM chain from the module account
+// to the chain's escrow
+func (k Keeper) escrowCoins(ctx sdk.Context, chainID uint64, coins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.EVMChainEscrowAddress(chainID), coins); err != nil {
+		return sdkerrors.Wrapf(err, "escrow coins for chain id %d", chainID)
+	}
+	return nil
+}
+
+// releaseCoins moves cosmos originated coins returning from the EVM chain from the chain's
+// escrow back to the module account
+func (k Keeper) releaseCoins(ctx sdk.Context, chainID uint64, coins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, types.EVMChainEscrowAddress(chainID), types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrapf(err, "release coins escrowed for chain id %d", chainID)
+	}
+	return nil
+}
+
+// GetEscrowedCoins returns the cosmos originated coins locked for the EVM chain
+func (k Keeper) GetEscrowedCoins(ctx sdk.Context, chainID uint64) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, types.EVMChainEscrowAddress(chainID))
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestEVMChainEscrow(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	require.NoError(t, k.AddEVMChain(ctx, testEVMChain))
+
+	var (
+		sender    = AccAddrs[0]
+		recipient = EthAddrs[0].Hex()
+		coins     = sdk.NewCoins(sdk.NewCoin("ucosmos", sdk.NewInt(1000)))
+	)
+	k.setCosmosOriginatedDenomToERC20(ctx, chainID, "ucosmos", common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"))
+	k.setCosmosOriginatedDenomToERC20(ctx, testEVMChain.ChainId, "ucosmos", common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546"))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins))
+
+	// coins sent to each chain are locked in the chain's own escrow
+	id, err := k.createSendToEthereum(ctx, chainID, sender, recipient, sdk.NewInt64Coin("ucosmos", 100), sdk.NewInt64Coin("ucosmos", 10))
+	require.NoError(t, err)
+	_, err = k.createSendToEthereum(ctx, testEVMChain.ChainId, sender, recipient, sdk.NewInt64Coin("ucosmos", 200), sdk.NewInt64Coin("ucosmos", 20))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ucosmos", 110)), k.GetEscrowedCoins(ctx, chainID))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ucosmos", 220)), k.GetEscrowedCoins(ctx, testEVMChain.ChainId))
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+
+	_, broken := EVMChainEscrowInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// and released from it when canceled
+	require.NoError(t, k.cancelSendToEthereum(ctx, chainID, id, sender.String()))
+	require.True(t, k.GetEscrowedCoins(ctx, chainID).IsZero())
+	require.Equal(t, sdk.NewInt(780), input.BankKeeper.GetBalance(ctx, sender, "ucosmos").Amount)
+
+	// an escrow holding less than its chain's pending sends breaks the invariant
+	escrow := types.EVMChainEscrowAddress(testEVMChain.ChainId)
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, escrow, sender, sdk.NewCoins(sdk.NewInt64Coin("ucosmos", 1))))
+	_, broken = EVMChainEscrowInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestForwardChannelEscrowInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	params := k.GetParams(ctx)
+	params.IbcForwardChannels = []types.IBCForwardChannel{{ChannelId: "channel-3", Bech32Prefix: "osmo", Timeout: 600}}
+	k.setParams(ctx, params)
+
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, k.Handle(ctx, chainID, &types.SendToCosmosEvent{
+		EventNonce:        1,
+		TokenContract:     tokenContract.Hex(),
+		Amount:            sdk.NewInt(100),
+		EthereumSender:    EthAddrs[0].Hex(),
+		CosmosReceiver:    AccAddrs[0].String(),
+		EthereumHeight:    10,
+		ForwardIbcChannel: "channel-3",
+	}))
+
+	// the forwarded vouchers are held by the escrow of the channel until the transfer completes
+	_, broken := ForwardChannelEscrowInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.setForwardedDeposit(ctx, types.ForwardedDeposit{
+		EvmChainId: chainID,
+		EventNonce: 2,
+		ChannelId:  "channel-3",
+		Sequence:   2,
+		Receiver:   "osmo1receiver",
+		Amount:     sdk.NewInt64Coin(types.GravityDenom(tokenContract), 50),
+	})
+	_, broken = ForwardChannelEscrowInvariant(k)(ctx)
+	require.True(t, broken)
+}
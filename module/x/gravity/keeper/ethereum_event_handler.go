@@ -1,11 +1,17 @@
 package keeper
 
 import (
+	"fmt"
 	"math/big"
+	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
@@ -21,26 +27,52 @@ func (k Keeper) DetectMaliciousSupply(ctx sdk.Context, denom string, amount sdk.
 	return nil
 }
 
-// Handle is the entry point for EthereumEvent processing
-func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
+// Handle is the entry point for EthereumEvent processing of events observed on the given EVM chain
+func (k Keeper) Handle(ctx sdk.Context, chainID uint64, eve types.EthereumEvent) (err error) {
 	switch event := eve.(type) {
 	case *types.SendToCosmosEvent:
+		k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.SendsToCosmos++ })
+
 		// Check if coin is Cosmos-originated asset and get denom
-		isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(event.TokenContract))
+		isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, chainID, common.HexToAddress(event.TokenContract))
 		addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
-		coins := sdk.Coins{sdk.NewCoin(denom, event.Amount)}
+		chain, _ := k.GetEVMChain(ctx, chainID)
+		amount := chain.DenomAmount(denom, event.Amount)
+		if !amount.IsPositive() {
+			// nothing is left of a deposit below the smallest unit of the denom
+			k.AfterSendToCosmosEvent(ctx, chainID, *event)
+			return nil
+		}
+		coins := sdk.Coins{sdk.NewCoin(denom, amount)}
 
 		if !isCosmosOriginated {
-			if err := k.DetectMaliciousSupply(ctx, denom, event.Amount); err != nil {
+			if err := k.DetectMaliciousSupply(ctx, denom, amount); err != nil {
 				return err
 			}
 
 			// if it is not cosmos originated, mint the coins (aka vouchers)
-			if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			if err := k.issueVouchers(ctx, coins); err != nil {
 				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
 			}
+		} else {
+			// if it is, release the coins escrowed for the chain
+			if err := k.releaseCoins(ctx, chainID, coins); err != nil {
+				return err
+			}
 		}
 
+		// dropped with the rest of the event's state should crediting the deposit fail
+		emitDepositReceivedEvent(ctx, chainID, event.EventNonce, event.EthereumSender, event.CosmosReceiver, event.TokenContract, coins)
+		emitTypedEvent(ctx, &types.EventDepositObserved{
+			EvmChainId:     chainID,
+			EventNonce:     event.EventNonce,
+			TokenContract:  event.TokenContract,
+			EthereumSender: event.EthereumSender,
+			CosmosReceiver: event.CosmosReceiver,
+			Denom:          denom,
+			Amount:         amount,
+		})
+
 		if recipientModule, ok := k.ReceiverModuleAccounts[event.CosmosReceiver]; ok {
 			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, coins); err != nil {
 				return err
@@ -49,39 +81,61 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
 				return err
 			}
+			if event.ForwardEvmChainId != 0 {
+				k.forwardSendToCosmos(ctx, chainID, event, addr, coins[0])
+			} else if event.ForwardIbcChannel != "" {
+				k.transferSendToCosmos(ctx, chainID, event, addr, coins[0])
+			}
 		}
-		k.AfterSendToCosmosEvent(ctx, *event)
+		k.AfterSendToCosmosEvent(ctx, chainID, *event)
 		return nil
 
 	case *types.BatchExecutedEvent:
-		k.batchTxExecuted(ctx, common.HexToAddress(event.TokenContract), event.BatchNonce)
-		k.AfterBatchExecutedEvent(ctx, *event)
+		k.batchTxExecuted(ctx, chainID, common.HexToAddress(event.TokenContract), event.BatchNonce)
+		k.AfterBatchExecutedEvent(ctx, chainID, *event)
 		return nil
 
 	case *types.ERC20DeployedEvent:
-		if err := k.verifyERC20DeployedEvent(ctx, event); err != nil {
+		if err := k.verifyERC20DeployedEvent(ctx, chainID, event); err != nil {
 			return err
 		}
 
 		// add to denom-erc20 mapping
-		k.setCosmosOriginatedDenomToERC20(ctx, event.CosmosDenom, common.HexToAddress(event.TokenContract))
-		k.AfterERC20DeployedEvent(ctx, *event)
+		k.setCosmosOriginatedDenomToERC20(ctx, chainID, event.CosmosDenom, common.HexToAddress(event.TokenContract))
+		k.AfterERC20DeployedEvent(ctx, chainID, *event)
 		return nil
 
 	case *types.ContractCallExecutedEvent:
-		k.contractCallExecuted(ctx, event.InvalidationScope.Bytes(), event.InvalidationNonce)
-		k.AfterContractCallExecutedEvent(ctx, *event)
+		k.contractCallExecuted(ctx, chainID, event.InvalidationScope.Bytes(), event.InvalidationNonce)
+		k.AfterContractCallExecutedEvent(ctx, chainID, *event)
 		return nil
 
 	case *types.SignerSetTxExecutedEvent:
 		// TODO here we should check the contents of the validator set against
 		// the store, if they differ we should take some action to indicate to the
 		// user that bridge highjacking has occurred
-		k.setLastObservedSignerSetTx(ctx, types.SignerSetTx{
+		k.setLastObservedSignerSetTx(ctx, chainID, types.SignerSetTx{
 			Nonce:   event.SignerSetTxNonce,
 			Signers: event.Members,
 		})
-		k.AfterSignerSetExecutedEvent(ctx, *event)
+		k.AfterSignerSetExecutedEvent(ctx, chainID, *event)
+		return nil
+
+	case *types.SendERC1155ToCosmosEvent:
+		return k.sendERC1155ToCosmos(ctx, chainID, event)
+
+	case *types.ERC1155BatchExecutedEvent:
+		k.erc1155BatchTxExecuted(ctx, chainID, common.HexToAddress(event.TokenContract), event.BatchNonce)
+		return nil
+
+	case *types.ContractVersionEvent:
+		k.setContractVersion(ctx, chainID, event.Version)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeContractVersion,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+			sdk.NewAttribute(types.AttributeKeyContractVersion, strconv.FormatUint(event.Version, 10)),
+		))
 		return nil
 
 	default:
@@ -89,23 +143,184 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 	}
 }
 
-func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20DeployedEvent) error {
-	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, event.CosmosDenom); exists {
+// emitDepositReceivedEvent emits the event of a deposit credited to its receiver
+func emitDepositReceivedEvent(ctx sdk.Context, chainID uint64, eventNonce uint64, sender, receiver, tokenContract string, coins sdk.Coins) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeDepositReceived,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(eventNonce)),
+		types.EthereumAddressAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyRecipient, receiver),
+		types.EthereumAddressAttribute(types.AttributeKeyTokenContract, tokenContract),
+		sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+	))
+}
+
+// forwardSendToCosmos routes a deposit credited to the receiver on to the EVM chain
+// it was addressed to, the receiver's address bytes being the recipient there. If
+// the transfer can't be created, e.g. because the chain is unknown or paused or the
+// token has no ERC20 on it, the deposit is returned to its sender on the chain it
+// came from instead. Should that fail too the coins are left with the receiver.
+// Routed transfers pay the fee floor of the chain they go to out of the deposit.
+func (k Keeper) forwardSendToCosmos(ctx sdk.Context, chainID uint64, event *types.SendToCosmosEvent, receiver sdk.AccAddress, amount sdk.Coin) {
+	forward := func(targetChainID uint64, recipient string) (uint64, error) {
+		chain, found := k.GetEVMChain(ctx, targetChainID)
+		if !found {
+			return 0, sdkerrors.Wrapf(types.ErrUnknownEVMChain, "chain id %d", targetChainID)
+		}
+		fee := sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+		if _, tokenContract, err := k.DenomToERC20Lookup(ctx, targetChainID, amount.Denom); err == nil {
+			fee.Amount = chain.MinimumDenomFee(amount.Denom, tokenContract)
+		}
+		if fee.Amount.GTE(amount.Amount) {
+			return 0, sdkerrors.Wrapf(types.ErrInsufficientFee, "deposit of %s can't pay the fee of %s on chain id %d", amount, fee, targetChainID)
+		}
+		xCtx, commit := ctx.CacheContext()
+		txID, err := k.createSendToEthereum(xCtx, targetChainID, receiver, recipient, amount.Sub(fee), fee)
+		if err != nil {
+			return 0, err
+		}
+		commit()
+		return txID, nil
+	}
+
+	recipient := common.BytesToAddress(receiver).Hex()
+	txID, err := forward(event.ForwardEvmChainId, recipient)
+	if err == nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBridgeDepositForwarded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, fmt.Sprint(event.ForwardEvmChainId)),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+		))
+		return
+	}
+
+	k.Logger(ctx).Info(
+		"returning deposit that could not be forwarded",
+		"chain id", chainID,
+		"nonce", event.EventNonce,
+		"forward chain id", event.ForwardEvmChainId,
+		"cause", err.Error(),
+	)
+	txID, err = forward(chainID, event.EthereumSender)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"deposit could not be returned, leaving it with the receiver",
+			"chain id", chainID,
+			"nonce", event.EventNonce,
+			"receiver", receiver.String(),
+			"cause", err.Error(),
+		)
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeDepositReturned,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, fmt.Sprint(chainID)),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+	))
+}
+
+// transferSendToCosmos sends a deposit credited to the receiver on over the IBC channel
+// it was addressed to, the receiver there being the receiver's address bytes under the
+// bech32 prefix of the channel's chain. The receiver is the sender of the ICS-20 transfer,
+// so the transfer module refunds it should the transfer time out or be rejected. Deposits
+// for channels that aren't forwarded on, or whose transfer can't be sent, stay with the
+// receiver. Forwarded deposits are kept until their transfer completes.
+func (k Keeper) transferSendToCosmos(ctx sdk.Context, chainID uint64, event *types.SendToCosmosEvent, receiver sdk.AccAddress, amount sdk.Coin) {
+	transfer := func() (string, error) {
+		channel, found := k.GetParams(ctx).GetIBCForwardChannel(event.ForwardIbcChannel)
+		if !found {
+			return "", sdkerrors.Wrapf(types.ErrInvalid, "deposits are not forwarded on channel %s", event.ForwardIbcChannel)
+		}
+		ibcReceiver, err := bech32.ConvertAndEncode(channel.Bech32Prefix, receiver)
+		if err != nil {
+			return "", err
+		}
+		timeout := ctx.BlockTime().Add(time.Duration(channel.Timeout) * time.Second)
+		xCtx, commit := ctx.CacheContext()
+		res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(xCtx), ibctransfertypes.NewMsgTransfer(
+			ibctransfertypes.PortID, channel.ChannelId, amount, receiver.String(),
+			ibcReceiver, clienttypes.ZeroHeight(), uint64(timeout.UnixNano()),
+		))
+		if err != nil {
+			return "", err
+		}
+		commit()
+		k.setForwardedDeposit(ctx, types.ForwardedDeposit{
+			EvmChainId:     chainID,
+			EventNonce:     event.EventNonce,
+			EthereumSender: event.EthereumSender,
+			ChannelId:      channel.ChannelId,
+			Sequence:       res.Sequence,
+			Receiver:       ibcReceiver,
+			Amount:         amount,
+		})
+		return ibcReceiver, nil
+	}
+
+	ibcReceiver, err := transfer()
+	if err != nil {
+		k.Logger(ctx).Info(
+			"deposit could not be transferred over IBC, leaving it with the receiver",
+			"chain id", chainID,
+			"nonce", event.EventNonce,
+			"channel", event.ForwardIbcChannel,
+			"receiver", receiver.String(),
+			"cause", err.Error(),
+		)
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeDepositForwarded,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.EventNonce)),
+		sdk.NewAttribute(types.AttributeKeyIBCChannel, event.ForwardIbcChannel),
+		sdk.NewAttribute(types.AttributeKeyRecipient, ibcReceiver),
+	))
+}
+
+func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, chainID uint64, event *types.ERC20DeployedEvent) error {
+	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, chainID, event.CosmosDenom); exists {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidERC20Event,
 			"ERC20 token %s already exists for denom %s", existingERC20.Hex(), event.CosmosDenom,
 		)
 	}
 
+	// a denom scaled on the chain is deployed with the decimals of the chain's tokens,
+	// the denom's own decimals are what its metadata is checked against
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	for _, decimals := range chain.TokenDecimals {
+		if decimals.Denom != event.CosmosDenom {
+			continue
+		}
+		if event.Erc20Decimals != uint64(decimals.Erc20Decimals) {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidERC20Event,
+				"ERC20 decimals %d does not match the %d decimals of denom %s on chain id %d", event.Erc20Decimals, decimals.Erc20Decimals, event.CosmosDenom, chainID,
+			)
+		}
+		scaled := *event
+		scaled.Erc20Decimals = uint64(decimals.DenomDecimals)
+		event = &scaled
+	}
+
 	// We expect that all Cosmos-based tokens have metadata defined. In the case
 	// a token does not have metadata defined, e.g. an IBC token, we successfully
 	// handle the token under the following conditions:
 	//
 	// 1. The ERC20 name is equal to the token's denomination. Otherwise, this
-	// 		means that ERC20 tokens would have an untenable UX.
+	// 		means that ERC20 tokens would have an untenable UX. IBC vouchers are
+	// 		named after their full denom trace path instead of their hash.
 	// 2. The ERC20 token has zero decimals as this is what we default to since
 	// 		we cannot know or infer the real decimal value for the Cosmos token.
-	// 3. The ERC20 symbol is empty.
+	// 3. The ERC20 symbol is empty, or the base denom of IBC vouchers on their
+	// 		origin chain.
 	//
 	// NOTE: This path is not encouraged and all supported assets should have
 	// metadata defined. If metadata cannot be defined, consider adding the token's
@@ -121,17 +336,22 @@ func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20Depl
 		)
 	}
 
-	if event.Erc20Name != event.CosmosDenom {
+	name, symbol := event.CosmosDenom, ""
+	if trace, found := k.ibcDenomTrace(ctx, event.CosmosDenom); found {
+		name, symbol = trace.GetFullDenomPath(), trace.BaseDenom
+	}
+
+	if event.Erc20Name != name {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidERC20Event,
-			"invalid ERC20 name for token without metadata; got: %s, expected: %s", event.Erc20Name, event.CosmosDenom,
+			"invalid ERC20 name for token without metadata; got: %s, expected: %s", event.Erc20Name, name,
 		)
 	}
 
-	if event.Erc20Symbol != "" {
+	if event.Erc20Symbol != symbol {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidERC20Event,
-			"expected empty ERC20 symbol for token without metadata; got: %s", event.Erc20Symbol,
+			"invalid ERC20 symbol for token without metadata; got: %s, expected: %q", event.Erc20Symbol, symbol,
 		)
 	}
 
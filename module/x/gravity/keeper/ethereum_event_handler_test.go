@@ -1,11 +1,17 @@
 package keeper
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 func TestDetectMaliciousSupply(t *testing.T) {
@@ -19,3 +25,174 @@ func TestDetectMaliciousSupply(t *testing.T) {
 	err := input.GravityKeeper.DetectMaliciousSupply(input.Context, "stake", bigCoinAmount)
 	require.Error(t, err, "didn't error out on too much added supply")
 }
+
+func TestForwardSendToCosmos(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	require.NoError(t, k.AddEVMChain(ctx, testEVMChain))
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		arbitrumERC20 = common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+		denom         = types.GravityDenom(tokenContract)
+		receiver      = AccAddrs[0]
+	)
+	// the vouchers of the mainnet token were deployed as an ERC20 on arbitrum
+	k.setCosmosOriginatedDenomToERC20(ctx, testEVMChain.ChainId, denom, arbitrumERC20)
+
+	deposit := func(nonce uint64, forwardChainID uint64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:        nonce,
+			TokenContract:     tokenContract.Hex(),
+			Amount:            sdktypes.NewInt(100),
+			EthereumSender:    EthAddrs[0].Hex(),
+			CosmosReceiver:    receiver.String(),
+			EthereumHeight:    10,
+			ForwardEvmChainId: forwardChainID,
+		}
+	}
+
+	require.NoError(t, k.Handle(ctx, chainID, deposit(1, testEVMChain.ChainId)))
+	forwarded := k.getUnbatchedSendToEthereums(ctx, testEVMChain.ChainId)
+	require.Len(t, forwarded, 1)
+	require.Equal(t, common.BytesToAddress(receiver).Hex(), forwarded[0].EthereumRecipient)
+	require.Equal(t, arbitrumERC20.Hex(), forwarded[0].Erc20Token.Contract)
+	require.Equal(t, sdktypes.NewInt(100), forwarded[0].Erc20Token.Amount)
+	require.True(t, forwarded[0].Erc20Fee.Amount.IsZero())
+	// the vouchers are locked for the arbitrum ERC20 rather than burned
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).IsZero())
+	require.Equal(t, sdktypes.NewInt(100), input.BankKeeper.GetSupply(ctx, denom).Amount)
+
+	// a deposit for an unknown chain is returned to its sender
+	require.NoError(t, k.Handle(ctx, chainID, deposit(2, 10)))
+	returned := k.getUnbatchedSendToEthereums(ctx, chainID)
+	require.Len(t, returned, 1)
+	require.Equal(t, EthAddrs[0].Hex(), returned[0].EthereumRecipient)
+	require.Equal(t, tokenContract.Hex(), returned[0].Erc20Token.Contract)
+
+	// with both chains paused the receiver keeps the coins
+	k.setEVMChainPaused(ctx, chainID, true)
+	k.setEVMChainPaused(ctx, testEVMChain.ChainId, true)
+	require.NoError(t, k.Handle(ctx, chainID, deposit(3, testEVMChain.ChainId)))
+	require.Equal(t, sdktypes.NewInt(100), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount)
+
+	// the forwarding chain is part of the event's hash
+	require.NotEqual(t, deposit(1, 0).Hash(), deposit(1, testEVMChain.ChainId).Hash())
+}
+
+func TestTransferSendToCosmos(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	params := k.GetParams(ctx)
+	params.IbcForwardChannels = []types.IBCForwardChannel{{ChannelId: "channel-3", Bech32Prefix: "osmo", Timeout: 600}}
+	k.setParams(ctx, params)
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom         = types.GravityDenom(tokenContract)
+		receiver      = AccAddrs[0]
+	)
+	deposit := func(nonce uint64, channel string) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:        nonce,
+			TokenContract:     tokenContract.Hex(),
+			Amount:            sdktypes.NewInt(100),
+			EthereumSender:    EthAddrs[0].Hex(),
+			CosmosReceiver:    receiver.String(),
+			EthereumHeight:    10,
+			ForwardIbcChannel: channel,
+		}
+	}
+
+	// the receiver's address bytes receive the deposit on the counterparty chain
+	require.NoError(t, k.Handle(ctx, chainID, deposit(1, "channel-3")))
+	require.Len(t, input.TransferKeeper.Transfers, 1)
+	transfer := input.TransferKeeper.Transfers[0]
+	osmoReceiver, _ := bech32.ConvertAndEncode("osmo", receiver)
+	require.Equal(t, "channel-3", transfer.SourceChannel)
+	require.Equal(t, ibctransfertypes.PortID, transfer.SourcePort)
+	require.Equal(t, receiver.String(), transfer.Sender)
+	require.Equal(t, osmoReceiver, transfer.Receiver)
+	require.Equal(t, sdktypes.NewInt64Coin(denom, 100), transfer.Token)
+	require.Equal(t, uint64(ctx.BlockTime().UnixNano())+600e9, transfer.TimeoutTimestamp)
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).IsZero())
+
+	// the deposit is kept until its transfer completes
+	forwarded, found := k.GetForwardedDeposit(ctx, "channel-3", 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), forwarded.EventNonce)
+	require.Equal(t, EthAddrs[0].Hex(), forwarded.EthereumSender)
+	require.Equal(t, osmoReceiver, forwarded.Receiver)
+	completed, found := k.CompleteForwardedDeposit(ctx, "channel-3", 1, "")
+	require.True(t, found)
+	require.Equal(t, forwarded, completed)
+	_, found = k.GetForwardedDeposit(ctx, "channel-3", 1)
+	require.False(t, found)
+
+	// channels that aren't forwarded on and failed transfers leave the deposit with the receiver
+	require.NoError(t, k.Handle(ctx, chainID, deposit(2, "channel-4")))
+	input.TransferKeeper.Err = errors.New("channel closed")
+	require.NoError(t, k.Handle(ctx, chainID, deposit(3, "channel-3")))
+	require.Len(t, input.TransferKeeper.Transfers, 1)
+	require.Equal(t, sdktypes.NewInt(200), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount)
+
+	// the channel is part of the event's hash
+	require.NotEqual(t, deposit(1, "").Hash(), deposit(1, "channel-3").Hash())
+}
+
+func TestIBCVoucherERC20Deployment(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	goCtx := sdktypes.WrapSDKContext(ctx)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	trace := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uosmo")
+	input.TransferKeeper.DenomTraces = []ibctransfertypes.DenomTrace{trace}
+	denom := trace.IBCDenom()
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(sdktypes.NewInt64Coin(denom, 1))))
+
+	// vouchers without metadata are deployed named after their trace instead of their hash
+	params, err := k.DenomToERC20Params(goCtx, &types.DenomToERC20ParamsRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, &types.DenomToERC20ParamsResponse{
+		BaseDenom:    denom,
+		Erc20Name:    "transfer/channel-0/uosmo",
+		Erc20Symbol:  "uosmo",
+		IbcPath:      "transfer/channel-0",
+		IbcBaseDenom: "uosmo",
+	}, params)
+
+	tokenContract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	event := &types.ERC20DeployedEvent{
+		EventNonce:    1,
+		CosmosDenom:   denom,
+		TokenContract: tokenContract.Hex(),
+		Erc20Name:     denom,
+		Erc20Symbol:   "",
+	}
+	require.Error(t, k.Handle(ctx, chainID, event))
+
+	event.Erc20Name, event.Erc20Symbol = params.Erc20Name, params.Erc20Symbol
+	require.NoError(t, k.Handle(ctx, chainID, event))
+
+	// and their registry entries carry the trace
+	toDenom, err := k.ERC20ToDenom(goCtx, &types.ERC20ToDenomRequest{Erc20: tokenContract.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, &types.ERC20ToDenomResponse{
+		Denom:            denom,
+		CosmosOriginated: true,
+		IbcPath:          "transfer/channel-0",
+		IbcBaseDenom:     "uosmo",
+	}, toDenom)
+
+	toERC20, err := k.DenomToERC20(goCtx, &types.DenomToERC20Request{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, "transfer/channel-0", toERC20.IbcPath)
+	require.Equal(t, "uosmo", toERC20.IbcBaseDenom)
+}
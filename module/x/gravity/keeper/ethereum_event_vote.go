@@ -1,19 +1,24 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keycodec"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 func (k Keeper) recordEventVote(
 	ctx sdk.Context,
+	chainID uint64,
 	event types.EthereumEvent,
 	val sdk.ValAddress,
 ) (*types.EthereumEventVoteRecord, error) {
@@ -21,10 +26,10 @@ func (k Keeper) recordEventVote(
 	// We check the event nonce in processEthereumEvent as well,
 	// but checking it here gives individual eth signers a chance to retry,
 	// and prevents validators from submitting two claims with the same nonce
-	lastEventNonce := k.getLastEventNonceByValidator(ctx, val)
+	lastEventNonce := k.getLastEventNonceByValidator(ctx, chainID, val)
 	expectedNonce := lastEventNonce + 1
 	if event.GetEventNonce() != expectedNonce {
-		return nil, sdkerrors.Wrapf(types.ErrInvalid,
+		return nil, sdkerrors.Wrapf(types.ErrStaleNonce,
 			"non contiguous event nonce expected %v observed %v for validator %v",
 			expectedNonce,
 			event.GetEventNonce(),
@@ -32,8 +37,20 @@ func (k Keeper) recordEventVote(
 		)
 	}
 
+	// the event is recorded with its addresses normalized, whatever their case in the vote
+	if normalizer, ok := event.(types.EthereumAddressNormalizer); ok {
+		normalizer.NormalizeEthereumAddresses()
+	}
+
 	// Tries to get an EthereumEventVoteRecord with the same eventNonce and event as the event that was submitted.
-	eventVoteRecord := k.GetEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash())
+	eventVoteRecord := k.GetEthereumEventVoteRecord(ctx, chainID, event.GetEventNonce(), event.Hash())
+
+	// the votes at a nonce already observed or rejected are pruned, a late vote only moves the
+	// validator to the nonce
+	if event.GetEventNonce() <= k.GetLastObservedEventNonce(ctx, chainID) {
+		k.setLastEventNonceByValidator(ctx, chainID, val, event.GetEventNonce())
+		return eventVoteRecord, nil
+	}
 
 	// If it does not exist, create a new one.
 	if eventVoteRecord == nil {
@@ -41,17 +58,29 @@ func (k Keeper) recordEventVote(
 		if err != nil {
 			return nil, err
 		}
+		// the votes are kept in a bitmap of the voters of the latest signer set
+		signerSetNonce, _ := k.latestVoterSet(ctx, chainID)
 		eventVoteRecord = &types.EthereumEventVoteRecord{
-			Accepted: false,
-			Event:    any,
+			Accepted:        false,
+			Event:           any,
+			FirstVoteHeight: uint64(ctx.BlockHeight()),
+			SignerSetNonce:  signerSetNonce,
 		}
 	}
 
 	// Add the validator's vote to this EthereumEventVoteRecord
-	eventVoteRecord.Votes = append(eventVoteRecord.Votes, val.String())
+	k.addEventVote(ctx, chainID, eventVoteRecord, val)
 
-	k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
-	k.setLastEventNonceByValidator(ctx, val, event.GetEventNonce())
+	k.setEthereumEventVoteRecord(ctx, chainID, event.GetEventNonce(), event.Hash(), eventVoteRecord)
+	k.setLastEventNonceByValidator(ctx, chainID, val, event.GetEventNonce())
+
+	types.IncrMetricCounter(types.MetricKeyEventVotesReceived, chainID)
+	emitTypedEvent(ctx, &types.EventEthereumEventVote{
+		EvmChainId:       chainID,
+		EventNonce:       event.GetEventNonce(),
+		EventHash:        event.Hash(),
+		ValidatorAddress: val.String(),
+	})
 
 	return eventVoteRecord, nil
 }
@@ -59,7 +88,7 @@ func (k Keeper) recordEventVote(
 // TryEventVoteRecord checks if an event vote record has enough votes to be applied to the consensus state
 // and has not already been marked Observed, then calls processEthereumEvent to actually apply it to the state,
 // and then marks it Observed and emits an event.
-func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.EthereumEventVoteRecord) {
+func (k Keeper) TryEventVoteRecord(ctx sdk.Context, chainID uint64, eventVoteRecord *types.EthereumEventVoteRecord) {
 	// If the event vote record has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
 	// This conditional stops the event vote record from accidentally being applied twice.
 	if !eventVoteRecord.Accepted {
@@ -68,45 +97,45 @@ func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.Ether
 			panic("unpacking packed any")
 		}
 
-		// Sum the current powers of all validators who have voted and see if it passes the current threshold
+		// Sum the powers of all validators who have voted and see if it passes the current threshold
 		// TODO: The different integer types and math here needs a careful review
 		requiredPower := types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx))
-		eventVotePower := sdk.NewInt(0)
-		for _, validator := range eventVoteRecord.Votes {
-			val, _ := sdk.ValAddressFromBech32(validator)
-
-			validatorPower := k.StakingKeeper.GetLastValidatorPower(ctx, val)
-			// Add it to the attestation power's sum
-			eventVotePower = eventVotePower.Add(sdk.NewInt(validatorPower))
-			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
-			// process the attestation, set Observed to true, and break
-			if eventVotePower.GTE(requiredPower) {
-				lastEventNonce := k.GetLastObservedEventNonce(ctx)
-				// this check is performed at the next level up so this should never panic
-				// outside of programmer error.
-				if event.GetEventNonce() != lastEventNonce+1 {
-					panic("attempting to apply events to state out of order")
-				}
-				k.setLastObservedEventNonce(ctx, event.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, event.GetEthereumHeight())
-
-				eventVoteRecord.Accepted = true
-				k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), eventVoteRecord)
-
-				k.processEthereumEvent(ctx, event)
-				ctx.EventManager().EmitEvent(sdk.NewEvent(
-					types.EventTypeObservation,
-					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-					sdk.NewAttribute(types.AttributeKeyEthereumEventType, fmt.Sprintf("%T", event)),
-					sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-					sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-					sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
-						string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
-					sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
-				))
-
-				break
+		// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
+		// process the attestation and set Observed to true
+		if k.eventVotePower(ctx, chainID, eventVoteRecord).GTE(requiredPower) {
+			lastEventNonce := k.GetLastObservedEventNonce(ctx, chainID)
+			// this check is performed at the next level up so this should never panic
+			// outside of programmer error.
+			if event.GetEventNonce() != lastEventNonce+1 {
+				panic("attempting to apply events to state out of order")
 			}
+			k.setLastObservedEventNonce(ctx, chainID, event.GetEventNonce())
+			k.SetLastObservedEthereumBlockHeight(ctx, chainID, event.GetEthereumHeight())
+
+			eventVoteRecord.Accepted = true
+			k.pruneEventVotes(ctx, chainID, event, eventVoteRecord)
+
+			k.processEthereumEvent(ctx, chainID, event)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeObservation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				types.EthereumEventTypeAttribute(event),
+				k.bridgeContractAttribute(ctx, chainID),
+				sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+				sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
+					string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
+				sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
+			))
+			types.IncrMetricCounter(types.MetricKeyEventsObserved, chainID, telemetry.NewLabel(types.MetricLabelEventType, proto.MessageName(event)))
+			k.updateBlockSummary(ctx, func(summary *types.EventBlockSummary) { summary.ObservedEvents++ })
+			k.recordAttestationLatency(ctx, chainID, eventVoteRecord)
+			emitTypedEvent(ctx, &types.EventEthereumEventObserved{
+				EvmChainId:     chainID,
+				EventNonce:     event.GetEventNonce(),
+				EventHash:      event.Hash(),
+				EventType:      proto.MessageName(event),
+				EthereumHeight: event.GetEthereumHeight(),
+			})
 		}
 	} else {
 		// We panic here because this should never happen
@@ -114,16 +143,179 @@ func (k Keeper) TryEventVoteRecord(ctx sdk.Context, eventVoteRecord *types.Ether
 	}
 }
 
+// pruneEventVotes stores the observed record without its votes, and deletes the other records
+// at its nonce, which can no longer be observed. The observed record is kept as the result of
+// the vote.
+func (k Keeper) pruneEventVotes(ctx sdk.Context, chainID uint64, event types.EthereumEvent, eventVoteRecord *types.EthereumEventVoteRecord) {
+	eventVoteRecord.Votes, eventVoteRecord.SignerSetNonce, eventVoteRecord.VoteBitmap = nil, 0, nil
+	k.setEthereumEventVoteRecord(ctx, chainID, event.GetEventNonce(), event.Hash(), eventVoteRecord)
+
+	store := prefix.NewStore(k.chainStore(ctx, chainID), types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), nil))
+	iter := store.Iterator(nil, nil)
+	var hashes [][]byte
+	for ; iter.Valid(); iter.Next() {
+		if !bytes.Equal(iter.Key(), event.Hash()) {
+			hashes = append(hashes, iter.Key())
+		}
+	}
+	iter.Close()
+	for _, hash := range hashes {
+		store.Delete(hash)
+	}
+}
+
+// RejectEthereumEventVoteRecord rejects the vote record of the event with the hash at the next
+// event nonce of the chain and skips the nonce, none of the events voted for at it being
+// applied. Only a pending record can be rejected, and only while no event at the nonce has
+// the votes to be observed, so that a stuck nonce can be moved past but an event can't be
+// censored.
+func (k Keeper) RejectEthereumEventVoteRecord(ctx sdk.Context, chainID uint64, eventNonce uint64, eventHash []byte) error {
+	lastEventNonce := k.GetLastObservedEventNonce(ctx, chainID)
+	if eventNonce != lastEventNonce+1 {
+		return sdkerrors.Wrapf(types.ErrStaleNonce, "only the event at the next nonce %d can be rejected, not %d", lastEventNonce+1, eventNonce)
+	}
+
+	eventVoteRecord := k.GetEthereumEventVoteRecord(ctx, chainID, eventNonce, eventHash)
+	if eventVoteRecord == nil {
+		return sdkerrors.Wrapf(types.ErrEventVoteRecordNotFound, "no vote record for event %X at nonce %d", eventHash, eventNonce)
+	}
+	if eventVoteRecord.Accepted || eventVoteRecord.Rejected {
+		return sdkerrors.Wrapf(types.ErrEventVoteRecordNotPending, "vote record for event %X at nonce %d is no longer pending", eventHash, eventNonce)
+	}
+
+	// none of the records at the nonce may be observable, or the rejection would censor it
+	requiredPower := types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx))
+	for _, record := range k.GetEthereumEventVoteRecordMapping(ctx, chainID)[eventNonce] {
+		if k.eventVotePower(ctx, chainID, record).GTE(requiredPower) {
+			return sdkerrors.Wrapf(types.ErrEventVoteRecordNotPending, "an event at nonce %d has the votes to be observed", eventNonce)
+		}
+	}
+
+	eventVoteRecord.Rejected = true
+	k.setEthereumEventVoteRecord(ctx, chainID, eventNonce, eventHash, eventVoteRecord)
+	k.setLastObservedEventNonce(ctx, chainID, eventNonce)
+	k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.RejectedEventVotes++ })
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEventVoteRecordRejected,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
+			string(types.MakeEthereumEventVoteRecordKey(eventNonce, eventHash))),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(eventNonce)),
+	))
+	emitTypedEvent(ctx, &types.EventEthereumEventRejected{
+		EvmChainId: chainID,
+		EventNonce: eventNonce,
+		EventHash:  eventHash,
+	})
+
+	return nil
+}
+
+// ReplayPendingEventVoteRecords revalidates the pending event vote records of all chains after
+// an upgrade against the event validation rules of the upgraded chain. Records failing them
+// are either grandfathered, kept pending with an event, or expired: deleted with an event and
+// their voters rewound to the nonce before them, their votes at later nonces removed, so that
+// they resubmit the events from it under the new rules rather than the nonce being stuck
+// half-voted. It returns the numbers of records grandfathered and expired.
+func (k Keeper) ReplayPendingEventVoteRecords(ctx sdk.Context, expire bool) (grandfathered, expired int) {
+	for _, chain := range k.GetEVMChains(ctx) {
+		chainID := chain.ChainId
+		lastEventNonce := k.GetLastObservedEventNonce(ctx, chainID)
+
+		// records are iterated by nonce, a voter is rewound before their votes at later
+		// nonces are met
+		var records []*types.EthereumEventVoteRecord
+		k.iterateEthereumEventVoteRecords(ctx, chainID, func(_ []byte, record *types.EthereumEventVoteRecord) bool {
+			records = append(records, record)
+			return false
+		})
+
+		rewound := make(map[string]uint64)
+		var voters []sdk.ValAddress
+		for _, record := range records {
+			event, err := types.UnpackEvent(record.Event)
+			if err != nil {
+				panic(err)
+			}
+			if record.Accepted || record.Rejected || event.GetEventNonce() <= lastEventNonce {
+				continue
+			}
+			key := types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash())
+
+			err = k.revalidateEvent(ctx, chainID, event)
+			if err != nil && expire {
+				k.chainStore(ctx, chainID).Delete(key)
+				for _, val := range k.eventVoters(ctx, chainID, record) {
+					if _, ok := rewound[val.String()]; !ok {
+						rewound[val.String()] = event.GetEventNonce() - 1
+						voters = append(voters, val)
+					}
+				}
+				expired++
+				k.emitEventVoteRecordReplayed(ctx, types.EventTypeEventVoteExpired, chainID, event, err)
+				continue
+			}
+			if err != nil {
+				grandfathered++
+				k.emitEventVoteRecordReplayed(ctx, types.EventTypeEventVoteGrandfathered, chainID, event, err)
+			}
+
+			removed := false
+			for _, val := range k.eventVoters(ctx, chainID, record) {
+				if nonce, ok := rewound[val.String()]; ok && event.GetEventNonce() > nonce {
+					k.removeEventVote(ctx, chainID, record, val)
+					removed = true
+				}
+			}
+			switch {
+			case len(record.Votes) == 0 && len(record.VoteBitmap) == 0:
+				k.chainStore(ctx, chainID).Delete(key)
+			case removed:
+				k.setEthereumEventVoteRecord(ctx, chainID, event.GetEventNonce(), event.Hash(), record)
+			}
+		}
+
+		for _, val := range voters {
+			k.setLastEventNonceByValidator(ctx, chainID, val, rewound[val.String()])
+		}
+	}
+	return grandfathered, expired
+}
+
+// revalidateEvent checks a pending event against the validation of submitted events that
+// doesn't depend on the ethereum height observed since
+func (k Keeper) revalidateEvent(ctx sdk.Context, chainID uint64, event types.EthereumEvent) error {
+	if err := event.Validate(); err != nil {
+		return err
+	}
+	return k.validateEventContract(ctx, chainID, event)
+}
+
+func (k Keeper) emitEventVoteRecordReplayed(ctx sdk.Context, eventType string, chainID uint64, event types.EthereumEvent, reason error) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID,
+			string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
+		sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+	))
+}
+
 // processEthereumEvent actually applies the attestation to the consensus state
-func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent) {
+func (k Keeper) processEthereumEvent(ctx sdk.Context, chainID uint64, event types.EthereumEvent) {
 	// then execute in a new Tx so that we can store state on failure
 	xCtx, commit := ctx.CacheContext()
-	if err := k.Handle(xCtx, event); err != nil { // execute with a transient storage
+	if err := k.Handle(xCtx, chainID, event); err != nil { // execute with a transient storage
 		// If the attestation fails, something has gone wrong and we can't recover it. Log and move on
 		// The attestation will still be marked "Observed", and validators can still be slashed for not
 		// having voted for it.
 		k.Logger(ctx).Error(
 			"ethereum event vote record failed",
+			"chain id", chainID,
 			"cause", err.Error(),
 			"event type", fmt.Sprintf("%T", event),
 			"id", types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()),
@@ -136,13 +328,13 @@ func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent)
 }
 
 // setEthereumEventVoteRecord sets the attestation in the store
-func (k Keeper) setEthereumEventVoteRecord(ctx sdk.Context, eventNonce uint64, claimHash []byte, eventVoteRecord *types.EthereumEventVoteRecord) {
-	ctx.KVStore(k.storeKey).Set(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash), k.cdc.MustMarshal(eventVoteRecord))
+func (k Keeper) setEthereumEventVoteRecord(ctx sdk.Context, chainID uint64, eventNonce uint64, claimHash []byte, eventVoteRecord *types.EthereumEventVoteRecord) {
+	k.chainStore(ctx, chainID).Set(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash), k.cdc.MustMarshal(eventVoteRecord))
 }
 
 // GetEthereumEventVoteRecord return a vote record given a nonce
-func (k Keeper) GetEthereumEventVoteRecord(ctx sdk.Context, eventNonce uint64, claimHash []byte) *types.EthereumEventVoteRecord {
-	if bz := ctx.KVStore(k.storeKey).Get(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash)); bz == nil {
+func (k Keeper) GetEthereumEventVoteRecord(ctx sdk.Context, chainID uint64, eventNonce uint64, claimHash []byte) *types.EthereumEventVoteRecord {
+	if bz := k.chainStore(ctx, chainID).Get(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash)); bz == nil {
 		return nil
 	} else {
 		var out types.EthereumEventVoteRecord
@@ -152,9 +344,9 @@ func (k Keeper) GetEthereumEventVoteRecord(ctx sdk.Context, eventNonce uint64, c
 }
 
 // GetEthereumEventVoteRecordMapping returns a mapping of eventnonce -> attestations at that nonce
-func (k Keeper) GetEthereumEventVoteRecordMapping(ctx sdk.Context) (out map[uint64][]*types.EthereumEventVoteRecord) {
+func (k Keeper) GetEthereumEventVoteRecordMapping(ctx sdk.Context, chainID uint64) (out map[uint64][]*types.EthereumEventVoteRecord) {
 	out = make(map[uint64][]*types.EthereumEventVoteRecord)
-	k.iterateEthereumEventVoteRecords(ctx, func(key []byte, eventVoteRecord *types.EthereumEventVoteRecord) bool {
+	k.iterateEthereumEventVoteRecords(ctx, chainID, func(key []byte, eventVoteRecord *types.EthereumEventVoteRecord) bool {
 		event, err := types.UnpackEvent(eventVoteRecord.Event)
 		if err != nil {
 			panic(err)
@@ -170,8 +362,8 @@ func (k Keeper) GetEthereumEventVoteRecordMapping(ctx sdk.Context) (out map[uint
 }
 
 // iterateEthereumEventVoteRecords iterates through all attestations
-func (k Keeper) iterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte, *types.EthereumEventVoteRecord) bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+func (k Keeper) iterateEthereumEventVoteRecords(ctx sdk.Context, chainID uint64, cb func([]byte, *types.EthereumEventVoteRecord) bool) {
+	store := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.EthereumEventVoteRecordKey})
 	iter := store.Iterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -185,8 +377,8 @@ func (k Keeper) iterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte,
 }
 
 // GetLastObservedEventNonce returns the latest observed event nonce
-func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context, chainID uint64) uint64 {
+	store := k.chainStore(ctx, chainID)
 	bytes := store.Get([]byte{types.LastObservedEventNonceKey})
 
 	if len(bytes) == 0 {
@@ -197,8 +389,8 @@ func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 
 // GetLastObservedEthereumBlockHeight height gets the block height to of the last observed attestation from
 // the store
-func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LatestEthereumBlockHeight {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context, chainID uint64) types.LatestEthereumBlockHeight {
+	store := k.chainStore(ctx, chainID)
 	bytes := store.Get([]byte{types.LastEthereumBlockHeightKey})
 
 	if len(bytes) == 0 {
@@ -213,13 +405,13 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.Latest
 }
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store.
-func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
-	k.SetLastObservedEthereumBlockHeightWithCosmos(ctx, ethereumHeight, uint64(ctx.BlockHeight()))
+func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, chainID uint64, ethereumHeight uint64) {
+	k.SetLastObservedEthereumBlockHeightWithCosmos(ctx, chainID, ethereumHeight, uint64(ctx.BlockHeight()))
 }
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store, specifying the cosmos height
-func (k Keeper) SetLastObservedEthereumBlockHeightWithCosmos(ctx sdk.Context, ethereumHeight uint64, cosmosHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) SetLastObservedEthereumBlockHeightWithCosmos(ctx sdk.Context, chainID uint64, ethereumHeight uint64, cosmosHeight uint64) {
+	store := k.chainStore(ctx, chainID)
 	height := types.LatestEthereumBlockHeight{
 		EthereumHeight: ethereumHeight,
 		CosmosHeight:   cosmosHeight,
@@ -228,14 +420,14 @@ func (k Keeper) SetLastObservedEthereumBlockHeightWithCosmos(ctx sdk.Context, et
 }
 
 // setLastObservedEventNonce sets the latest observed event nonce
-func (k Keeper) setLastObservedEventNonce(ctx sdk.Context, nonce uint64) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) setLastObservedEventNonce(ctx sdk.Context, chainID uint64, nonce uint64) {
+	store := k.chainStore(ctx, chainID)
 	store.Set([]byte{types.LastObservedEventNonceKey}, sdk.Uint64ToBigEndian(nonce))
 }
 
 // getLastEventNonceByValidator returns the latest event nonce for a given validator
-func (k Keeper) getLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64 {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) getLastEventNonceByValidator(ctx sdk.Context, chainID uint64, validator sdk.ValAddress) uint64 {
+	store := k.chainStore(ctx, chainID)
 	bytes := store.Get(types.MakeLastEventNonceByValidatorKey(validator))
 
 	if len(bytes) == 0 {
@@ -253,8 +445,8 @@ func (k Keeper) getLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 		// just the lowest observed event in the store. If no claims have been submitted in for
 		// params.SignedClaimsWindow we may have no attestations in our nonce. At which point
 		// the last observed which is a persistent and never cleaned counter will suffice.
-		lowestObserved := k.GetLastObservedEventNonce(ctx)
-		attmap := k.GetEthereumEventVoteRecordMapping(ctx)
+		lowestObserved := k.GetLastObservedEventNonce(ctx, chainID)
+		attmap := k.GetEthereumEventVoteRecordMapping(ctx, chainID)
 		// no new claims in params.SignedClaimsWindow, we can return the current value
 		// because the validator can't be slashed for an event that has already passed.
 		// so they only have to worry about the *next* event to occur
@@ -280,7 +472,18 @@ func (k Keeper) getLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 }
 
 // setLastEventNonceByValidator sets the latest event nonce for a give validator
-func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, chainID uint64, validator sdk.ValAddress, nonce uint64) {
+	store := k.chainStore(ctx, chainID)
 	store.Set(types.MakeLastEventNonceByValidatorKey(validator), sdk.Uint64ToBigEndian(nonce))
 }
+
+// iterateLastEventNonceByValidator iterates over the latest event nonces set for validators
+func (k Keeper) iterateLastEventNonceByValidator(ctx sdk.Context, chainID uint64, cb func(validator sdk.ValAddress, nonce uint64) bool) {
+	iter := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.LastEventNonceByValidatorKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(keycodec.NewReader(iter.Key()).LengthPrefixed()), binary.BigEndian.Uint64(iter.Value())) {
+			break
+		}
+	}
+}
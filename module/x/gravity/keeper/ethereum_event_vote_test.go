@@ -0,0 +1,496 @@
+package keeper
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestRejectEthereumEventVoteRecord(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	event := func(nonce uint64, amount int64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[1].Hex(),
+			CosmosReceiver: AccAddrs[1].String(),
+			EthereumHeight: 10,
+		}
+	}
+
+	correct, poisoned := event(1, 100), event(1, 999)
+	for i, val := range ValAddrs {
+		e := poisoned
+		if i == 0 {
+			e = correct
+		}
+		_, err := k.recordEventVote(ctx, chainID, e, val)
+		require.NoError(t, err)
+	}
+
+	// records can't be rejected while any event at their nonce has the votes to be observed
+	require.ErrorIs(t, k.RejectEthereumEventVoteRecord(ctx, chainID, 1, correct.Hash()), types.ErrEventVoteRecordNotPending)
+
+	// once the votes are split so that neither event can be observed they can
+	record := k.GetEthereumEventVoteRecord(ctx, chainID, 1, poisoned.Hash())
+	record.Votes = record.Votes[:2]
+	k.setEthereumEventVoteRecord(ctx, chainID, 1, poisoned.Hash(), record)
+
+	// neither can records at a nonce other than the next one
+	_, err := k.recordEventVote(ctx, chainID, event(2, 100), ValAddrs[0])
+	require.NoError(t, err)
+	require.ErrorIs(t, k.RejectEthereumEventVoteRecord(ctx, chainID, 2, event(2, 100).Hash()), types.ErrStaleNonce)
+
+	// nor records no one voted for
+	require.ErrorIs(t, k.RejectEthereumEventVoteRecord(ctx, chainID, 1, event(1, 5).Hash()), types.ErrEventVoteRecordNotFound)
+
+	proposal := types.NewEthereumEventRejectionProposal("reject", "unblock the bridge", 0, 1, poisoned.Hash().String())
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, k.HandleEthereumEventRejectionProposal(ctx, proposal))
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(ctx, chainID))
+	require.True(t, k.GetEthereumEventVoteRecord(ctx, chainID, 1, poisoned.Hash()).Rejected)
+
+	// the skipped nonce is no longer pending and its events aren't applied
+	require.ErrorIs(t, k.HandleEthereumEventRejectionProposal(ctx, proposal), types.ErrStaleNonce)
+	require.True(t, input.BankKeeper.GetBalance(ctx, AccAddrs[1], types.GravityDenom(EthAddrs[0])).IsZero())
+}
+
+func TestEventNoncesInvariant(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	event := func(nonce uint64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[1].Hex(),
+			CosmosReceiver: AccAddrs[1].String(),
+			EthereumHeight: 10,
+		}
+	}
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		for _, val := range ValAddrs {
+			record, err := k.recordEventVote(ctx, chainID, event(nonce), val)
+			require.NoError(t, err)
+			if nonce < 3 && !record.Accepted {
+				k.TryEventVoteRecord(ctx, chainID, record)
+			}
+		}
+	}
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(ctx, chainID))
+
+	// pending records above the last observed nonce keep the invariant
+	_, broken := EventNoncesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// an accepted one doesn't
+	record := k.GetEthereumEventVoteRecord(ctx, chainID, 3, event(3).Hash())
+	record.Accepted = true
+	k.setEthereumEventVoteRecord(ctx, chainID, 3, event(3).Hash(), record)
+	_, broken = EventNoncesInvariant(k)(ctx)
+	require.True(t, broken)
+
+	// nor does a nonce decided twice
+	k.setLastObservedEventNonce(ctx, chainID, 3)
+	_, broken = EventNoncesInvariant(k)(ctx)
+	require.False(t, broken)
+	other := event(3)
+	other.Amount = sdk.NewInt(5)
+	k.setEthereumEventVoteRecord(ctx, chainID, 3, other.Hash(), &types.EthereumEventVoteRecord{Event: record.Event, Rejected: true})
+	_, broken = EventNoncesInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestEventVoteAddressCase(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	// the orchestrators report the addresses of the same event in different cases
+	cases := []func(string) string{strings.ToLower, strings.ToUpper, func(s string) string { return s }}
+	for i, val := range ValAddrs {
+		toCase := cases[i%len(cases)]
+		_, err := k.recordEventVote(ctx, chainID, &types.SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  "0x" + toCase(EthAddrs[0].Hex()[2:]),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: "0x" + toCase(EthAddrs[1].Hex()[2:]),
+			CosmosReceiver: AccAddrs[1].String(),
+			EthereumHeight: 10,
+		}, val)
+		require.NoError(t, err)
+	}
+
+	var records []*types.EthereumEventVoteRecord
+	k.iterateEthereumEventVoteRecords(ctx, chainID, func(_ []byte, record *types.EthereumEventVoteRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	require.Len(t, records, 1)
+	require.Len(t, k.eventVoters(ctx, chainID, records[0]), len(ValAddrs))
+
+	event, err := types.UnpackEvent(records[0].Event)
+	require.NoError(t, err)
+	require.Equal(t, EthAddrs[0].Hex(), event.(*types.SendToCosmosEvent).TokenContract)
+	require.Equal(t, EthAddrs[1].Hex(), event.(*types.SendToCosmosEvent).EthereumSender)
+}
+
+func TestReplayPendingEventVoteRecords(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	event := func(nonce uint64, receiver string) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[1].Hex(),
+			CosmosReceiver: receiver,
+			EthereumHeight: 10,
+		}
+	}
+
+	// two validators voted at nonce 1 for an event the upgraded chain considers invalid, and
+	// went on voting at nonce 2 with the others
+	valid, invalid := event(1, AccAddrs[1].String()), event(1, "not an address")
+	for i, val := range ValAddrs[:4] {
+		e := valid
+		if i < 2 {
+			e = invalid
+		}
+		_, err := k.recordEventVote(ctx, chainID, e, val)
+		require.NoError(t, err)
+		_, err = k.recordEventVote(ctx, chainID, event(2, AccAddrs[1].String()), val)
+		require.NoError(t, err)
+	}
+
+	// grandfathering keeps the records
+	grandfathered, expired := k.ReplayPendingEventVoteRecords(ctx, false)
+	require.Equal(t, 1, grandfathered)
+	require.Equal(t, 0, expired)
+	require.NotNil(t, k.GetEthereumEventVoteRecord(ctx, chainID, 1, invalid.Hash()))
+	require.Len(t, k.GetEthereumEventVoteRecord(ctx, chainID, 2, event(2, AccAddrs[1].String()).Hash()).Votes, 4)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	grandfathered, expired = k.ReplayPendingEventVoteRecords(ctx, true)
+	require.Equal(t, 0, grandfathered)
+	require.Equal(t, 1, expired)
+	require.Nil(t, k.GetEthereumEventVoteRecord(ctx, chainID, 1, invalid.Hash()))
+	require.Len(t, k.GetEthereumEventVoteRecord(ctx, chainID, 1, valid.Hash()).Votes, 2)
+	require.Equal(t, types.EventTypeEventVoteExpired, ctx.EventManager().Events()[0].Type)
+
+	// the voters of the expired record are rewound, their later votes removed
+	require.Len(t, k.GetEthereumEventVoteRecord(ctx, chainID, 2, event(2, AccAddrs[1].String()).Hash()).Votes, 2)
+	for i, val := range ValAddrs[:4] {
+		expected := uint64(2)
+		if i < 2 {
+			expected = 0
+		}
+		require.Equal(t, expected, k.getLastEventNonceByValidator(ctx, chainID, val))
+	}
+
+	// and resubmit the events from the expired nonce, the valid one being observed
+	for _, val := range ValAddrs[:2] {
+		_, err := k.recordEventVote(ctx, chainID, valid, val)
+		require.NoError(t, err)
+	}
+	record := k.GetEthereumEventVoteRecord(ctx, chainID, 1, valid.Hash())
+	require.Len(t, record.Votes, 4)
+	k.TryEventVoteRecord(ctx, chainID, record)
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(ctx, chainID))
+}
+
+func TestEventVoteBitmap(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	// the voter set of the signer set lacks the last validator, as if it bonded since
+	signerSet := k.CreateSignerSetTx(ctx, chainID)
+	voterSet, found := k.GetVoterSet(ctx, chainID, signerSet.Nonce)
+	require.True(t, found)
+	require.Len(t, voterSet.Voters, len(ValAddrs))
+	outsider := ValAddrs[4]
+	i, ok := voterSet.Index(outsider)
+	require.True(t, ok)
+	voterSet.Voters = append(voterSet.Voters[:i], voterSet.Voters[i+1:]...)
+	k.setVoterSet(ctx, chainID, signerSet.Nonce, voterSet)
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  EthAddrs[0].Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[1].String(),
+		EthereumHeight: 10,
+	}
+	for _, val := range ValAddrs {
+		_, err := k.recordEventVote(ctx, chainID, event, val)
+		require.NoError(t, err)
+	}
+
+	// the votes of the voter set are in the bitmap, the outsider's in the list
+	record := k.GetEthereumEventVoteRecord(ctx, chainID, 1, event.Hash())
+	require.Equal(t, signerSet.Nonce, record.SignerSetNonce)
+	require.Equal(t, []byte{0x0f}, record.VoteBitmap)
+	require.Equal(t, []string{outsider.String()}, record.Votes)
+	require.ElementsMatch(t, ValAddrs, k.eventVoters(ctx, chainID, record))
+	require.Equal(t, input.StakingKeeper.GetLastTotalPower(ctx), k.eventVotePower(ctx, chainID, record))
+
+	k.removeEventVote(ctx, chainID, record, ValAddrs[0])
+	k.removeEventVote(ctx, chainID, record, outsider)
+	require.Len(t, record.VoteBitIndexes(), 3)
+	require.Empty(t, record.Votes)
+	k.setEthereumEventVoteRecord(ctx, chainID, 1, event.Hash(), record)
+
+	// the genesis has the votes as a list
+	genesis := ExportGenesis(ctx, k)
+	require.Len(t, genesis.EthereumEventVoteRecords, 1)
+	exported := genesis.EthereumEventVoteRecords[0]
+	require.Len(t, exported.Votes, 3)
+	require.Zero(t, exported.SignerSetNonce)
+	require.Empty(t, exported.VoteBitmap)
+}
+
+func TestEventVotePowerOfJailedVoters(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	k.CreateSignerSetTx(ctx, chainID)
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  EthAddrs[0].Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[1].String(),
+		EthereumHeight: 10,
+	}
+	var record *types.EthereumEventVoteRecord
+	for _, val := range ValAddrs[:4] {
+		var err error
+		record, err = k.recordEventVote(ctx, chainID, event, val)
+		require.NoError(t, err)
+	}
+	require.Len(t, record.VoteBitIndexes(), 4)
+	requiredPower := types.EventVoteRecordPowerThreshold(input.StakingKeeper.GetLastTotalPower(ctx))
+	require.True(t, k.eventVotePower(ctx, chainID, record).GTE(requiredPower))
+
+	// three of the voters are jailed between their votes and the tally, their power in the
+	// voter set no longer counts
+	for _, val := range ValAddrs[:3] {
+		validator, found := input.StakingKeeper.GetValidator(ctx, val)
+		require.True(t, found)
+		consAddr, err := validator.GetConsAddr()
+		require.NoError(t, err)
+		input.StakingKeeper.Jail(ctx, consAddr)
+	}
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	k.UpdateVoterPowers(ctx)
+
+	require.Equal(t, sdk.NewInt(input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[3])), k.eventVotePower(ctx, chainID, record))
+	k.TryEventVoteRecord(ctx, chainID, record)
+	require.False(t, record.Accepted)
+	require.Zero(t, k.GetLastObservedEventNonce(ctx, chainID))
+}
+
+func TestEventVotePowerOfSlashedVoters(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	k.CreateSignerSetTx(ctx, chainID)
+
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  EthAddrs[0].Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[1].String(),
+		EthereumHeight: 10,
+	}
+	record, err := k.recordEventVote(ctx, chainID, event, ValAddrs[0])
+	require.NoError(t, err)
+	before := k.eventVotePower(ctx, chainID, record)
+
+	validator, found := input.StakingKeeper.GetValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	power := input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0])
+	input.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, sdk.NewDecWithPrec(5, 1))
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	// the voter set keeps the former power until the flagged voters are updated
+	require.Equal(t, before, k.eventVotePower(ctx, chainID, record))
+	k.UpdateVoterPowers(ctx)
+	after := sdk.NewInt(input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0]))
+	require.True(t, after.LT(before))
+	require.Equal(t, after, k.eventVotePower(ctx, chainID, record))
+}
+
+func BenchmarkTryEventVoteRecord(b *testing.B) {
+	// the votes are in the bitmap of the record once there is a signer set, in its list before
+	for _, withSignerSet := range []bool{false, true} {
+		name := "votes=list"
+		if withSignerSet {
+			name = "votes=bitmap"
+		}
+		b.Run(name, func(b *testing.B) {
+			input, ctx := SetupFiveValChain(b)
+			k := input.GravityKeeper
+			chainID := TestingGravityParams.BridgeChainId
+			if withSignerSet {
+				k.CreateSignerSetTx(ctx, chainID)
+			}
+
+			event := &types.SendToCosmosEvent{
+				EventNonce:     1,
+				TokenContract:  EthAddrs[0].Hex(),
+				Amount:         sdk.NewInt(100),
+				EthereumSender: EthAddrs[1].Hex(),
+				CosmosReceiver: AccAddrs[1].String(),
+				EthereumHeight: 10,
+			}
+			// three of the five validators stay below the threshold, the record is only tallied
+			var record *types.EthereumEventVoteRecord
+			for _, val := range ValAddrs[:3] {
+				var err error
+				record, err = k.recordEventVote(ctx, chainID, event, val)
+				require.NoError(b, err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				k.TryEventVoteRecord(ctx, chainID, record)
+			}
+			b.StopTimer()
+			require.False(b, record.Accepted)
+		})
+	}
+}
+
+func TestPruneEventVotes(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	k.CreateSignerSetTx(ctx, chainID)
+
+	event := func(amount int64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: EthAddrs[1].Hex(),
+			CosmosReceiver: AccAddrs[1].String(),
+			EthereumHeight: 10,
+		}
+	}
+	observed, other := event(100), event(200)
+	for i, val := range ValAddrs {
+		voted := observed
+		if i == 3 {
+			voted = other
+		}
+		_, err := k.recordEventVote(ctx, chainID, voted, val)
+		require.NoError(t, err)
+	}
+
+	// the observed record is kept without its votes, the other record at the nonce is deleted
+	record := k.GetEthereumEventVoteRecord(ctx, chainID, 1, observed.Hash())
+	k.TryEventVoteRecord(ctx, chainID, record)
+	record = k.GetEthereumEventVoteRecord(ctx, chainID, 1, observed.Hash())
+	require.True(t, record.Accepted)
+	require.Empty(t, record.Votes)
+	require.Empty(t, record.VoteBitmap)
+	require.Zero(t, record.SignerSetNonce)
+	require.Nil(t, k.GetEthereumEventVoteRecord(ctx, chainID, 1, other.Hash()))
+
+	// a late vote at the nonce isn't recorded, the validator only moves to it
+	k.setLastEventNonceByValidator(ctx, chainID, ValAddrs[3], 0)
+	_, err := k.recordEventVote(ctx, chainID, other, ValAddrs[3])
+	require.NoError(t, err)
+	require.Nil(t, k.GetEthereumEventVoteRecord(ctx, chainID, 1, other.Hash()))
+	require.EqualValues(t, 1, k.getLastEventNonceByValidator(ctx, chainID, ValAddrs[3]))
+	require.Len(t, k.GetEthereumEventVoteRecordMapping(ctx, chainID)[1], 1)
+}
+
+// FuzzEventVoteOrder checks that the outcome of the votes at a nonce only depends on which
+// event each validator voted for, not on the order the votes came in, whether they are kept
+// in the bitmaps of a voter set or in the vote lists
+func FuzzEventVoteOrder(f *testing.F) {
+	f.Add(uint8(0x00), int64(1), false)
+	f.Add(uint8(0x01), int64(2), true)
+	f.Add(uint8(0x03), int64(3), false)
+	f.Add(uint8(0x0a), int64(4), true)
+	f.Add(uint8(0x1f), int64(5), true)
+	f.Fuzz(func(t *testing.T, choices uint8, seed int64, bitmap bool) {
+		input, ctx := SetupFiveValChain(t)
+		k := input.GravityKeeper
+		chainID := TestingGravityParams.BridgeChainId
+		if bitmap {
+			k.CreateSignerSetTx(ctx, chainID)
+		}
+
+		events := []*types.SendToCosmosEvent{{
+			EventNonce:     1,
+			TokenContract:  EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[1].Hex(),
+			CosmosReceiver: AccAddrs[1].String(),
+			EthereumHeight: 10,
+		}}
+		alt := *events[0]
+		alt.Amount = sdk.NewInt(999)
+		events = append(events, &alt)
+
+		// each validator votes for the event of its bit, in an order drawn from the seed
+		voters := make([][]sdk.ValAddress, len(events))
+		for i, val := range ValAddrs {
+			choice := int(choices>>i) & 1
+			voters[choice] = append(voters[choice], val)
+		}
+		order := rand.New(rand.NewSource(seed)).Perm(len(ValAddrs))
+		for _, i := range order {
+			_, err := k.recordEventVote(ctx, chainID, events[int(choices>>i)&1], ValAddrs[i])
+			require.NoError(t, err)
+		}
+		for _, record := range k.GetEthereumEventVoteRecordMapping(ctx, chainID)[1] {
+			k.TryEventVoteRecord(ctx, chainID, record)
+		}
+
+		power := input.StakingKeeper.GetLastTotalPower(ctx).QuoRaw(int64(len(ValAddrs)))
+		required := types.EventVoteRecordPowerThreshold(input.StakingKeeper.GetLastTotalPower(ctx))
+		observed := uint64(0)
+		for i, event := range events {
+			record := k.GetEthereumEventVoteRecord(ctx, chainID, 1, event.Hash())
+			votePower := power.MulRaw(int64(len(voters[i])))
+			if votePower.GTE(required) {
+				observed = 1
+				require.True(t, record.Accepted)
+				continue
+			}
+			if record == nil {
+				// the record was pruned once the other event was observed
+				continue
+			}
+			require.False(t, record.Accepted)
+			require.ElementsMatch(t, voters[i], k.eventVoters(ctx, chainID, record))
+			require.Equal(t, votePower, k.eventVotePower(ctx, chainID, record))
+		}
+		require.Equal(t, observed, k.GetLastObservedEventNonce(ctx, chainID))
+	})
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// chainStore returns the store holding the state of an EVM chain
+func (k Keeper) chainStore(ctx sdk.Context, chainID uint64) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeEVMChainStorePrefix(chainID))
+}
+
+// defaultEVMChain returns the EVM chain described by the bridge params
+func (k Keeper) defaultEVMChain(ctx sdk.Context) types.EVMChain {
+	params := k.GetParams(ctx)
+	return types.EVMChain{
+		ChainId:               k.getBridgeChainID(ctx),
+		GravityId:             params.GravityId,
+		BridgeEthereumAddress: params.BridgeEthereumAddress,
+		Finality:              params.EthereumFinality,
+		MinimumConfirmations:  params.MinimumEthereumConfirmations,
+		FeeFloors:             params.EthereumFeeFloors,
+		DepositAddressFactory: params.EthereumDepositAddressFactory,
+		TokenDecimals:         params.EthereumTokenDecimals,
+		NativeDecimals:        params.EthereumNativeDecimals,
+		RateLimits:            params.EthereumRateLimits,
+	}
+}
+
+// GetEVMChain returns the EVM chain with the given chain id, either the default
+// chain or one added by governance
+func (k Keeper) GetEVMChain(ctx sdk.Context, chainID uint64) (types.EVMChain, bool) {
+	if chainID == k.getBridgeChainID(ctx) {
+		return k.defaultEVMChain(ctx), true
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeEVMChainKey(chainID))
+	if bz == nil {
+		return types.EVMChain{}, false
+	}
+	var chain types.EVMChain
+	k.cdc.MustUnmarshal(bz, &chain)
+	return chain, true
+}
+
+// GetEVMChains returns all EVM chains, the default chain first followed by the ones
+// added by governance in chain id order
+func (k Keeper) GetEVMChains(ctx sdk.Context) []types.EVMChain {
+	chains := []types.EVMChain{k.defaultEVMChain(ctx)}
+	k.iterateEVMChains(ctx, func(chain types.EVMChain) bool {
+		chains = append(chains, chain)
+		return false
+	})
+	return chains
+}
+
+func (k Keeper) iterateEVMChains(ctx sdk.Context, cb func(types.EVMChain) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EVMChainKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var chain types.EVMChain
+		k.cdc.MustUnmarshal(iter.Value(), &chain)
+		if cb(chain) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setEVMChain(ctx sdk.Context, chain types.EVMChain) {
+	chain.NormalizeEthereumAddresses()
+	ctx.KVStore(k.storeKey).Set(types.MakeEVMChainKey(chain.ChainId), k.cdc.MustMarshal(&chain))
+}
+
+// AddEVMChain registers an additional EVM chain to bridge to. The chain id and the
+// gravity id must not be used by any other chain.
+func (k Keeper) AddEVMChain(ctx sdk.Context, chain types.EVMChain) error {
+	if err := chain.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, existing := range k.GetEVMChains(ctx) {
+		if existing.ChainId == chain.ChainId {
+			return sdkerrors.Wrapf(types.ErrEVMChainExists, "evm chain %d already exists", chain.ChainId)
+		}
+	}
+	if existing, found := k.gravityIDInUse(ctx, chain.GravityId); found {
+		return sdkerrors.Wrapf(types.ErrGravityIDInUse, "gravity id %s is used by evm chain %d", chain.GravityId, existing)
+	}
+
+	k.setEVMChain(ctx, chain)
+	return nil
+}
+
+// IsEVMChainPaused returns true while governance has paused bridging to the EVM chain
+func (k Keeper) IsEVMChainPaused(ctx sdk.Context, chainID uint64) bool {
+	return k.chainStore(ctx, chainID).Has([]byte{types.EVMChainPausedKey})
+}
+
+func (k Keeper) setEVMChainPaused(ctx sdk.Context, chainID uint64, paused bool) {
+	if paused {
+		k.chainStore(ctx, chainID).Set([]byte{types.EVMChainPausedKey}, []byte{1})
+	} else {
+		k.chainStore(ctx, chainID).Delete([]byte{types.EVMChainPausedKey})
+	}
+}
+
+// outgoingTxsPaused returns true if no batches or contract calls may be created for the
+// EVM chain, either because it is paused or because it is migrating to a new contract
+func (k Keeper) outgoingTxsPaused(ctx sdk.Context, chainID uint64) bool {
+	return k.IsEVMChainPaused(ctx, chainID) || k.isMigrating(ctx, chainID)
+}
+
+// getMinimumFee returns the fee floor of transfers of the token to the EVM chain
+func (k Keeper) getMinimumFee(ctx sdk.Context, chainID uint64, tokenContract common.Address) sdk.Int {
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	return chain.MinimumFee(tokenContract)
+}
+
+// resolveEVMChainID returns the chain id messages and queries refer to, zero selects
+// the default chain
+func (k Keeper) resolveEVMChainID(ctx sdk.Context, chainID uint64) (uint64, error) {
+	if chainID == 0 {
+		return k.getBridgeChainID(ctx), nil
+	}
+	if _, found := k.GetEVMChain(ctx, chainID); !found {
+		return 0, sdkerrors.Wrapf(types.ErrUnknownEVMChain, "chain id %d", chainID)
+	}
+	return chainID, nil
+}
+
+// validateEventFinality rejects events the EVM chain can't be trusted not to reorganize
+// away yet. The Ethereum height agreed on by the validators is compared against, the
+// confirmations reported in the event are not trusted for this. With confirmations
+// finality the height is the chain head and must be far enough past the event's block,
+// with a tag based finality it is the tagged block and must include the event's block.
+func (k Keeper) validateEventFinality(ctx sdk.Context, chain types.EVMChain, event types.EthereumEvent) error {
+	observedHeight := k.GetLastObservedEthereumBlockHeight(ctx, chain.ChainId).EthereumHeight
+
+	switch chain.Finality {
+	case types.FinalityConfirmations:
+		if chain.MinimumConfirmations > 0 && event.GetEthereumHeight()+chain.MinimumConfirmations > observedHeight {
+			return sdkerrors.Wrapf(
+				types.ErrInsufficientConfirmations,
+				"event nonce %d at ethereum height %d requires %d confirmations, the last observed ethereum height is %d",
+				event.GetEventNonce(), event.GetEthereumHeight(), chain.MinimumConfirmations, observedHeight,
+			)
+		}
+	default:
+		if event.GetEthereumHeight() > observedHeight {
+			return sdkerrors.Wrapf(
+				types.ErrInsufficientConfirmations,
+				"event nonce %d at ethereum height %d is past the last observed %s ethereum height %d",
+				event.GetEventNonce(), event.GetEthereumHeight(), chain.Finality, observedHeight,
+			)
+		}
+	}
+	return nil
+}
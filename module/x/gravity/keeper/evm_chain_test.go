@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+var testEVMChain = types.EVMChain{
+	ChainId:               42161,
+	Name:                  "arbitrum",
+	GravityId:             "arbitrum-gravity",
+	BridgeEthereumAddress: "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf",
+}
+
+func TestAddEVMChain(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	require.NoError(t, k.AddEVMChain(ctx, testEVMChain))
+
+	chains := k.GetEVMChains(ctx)
+	require.Len(t, chains, 2)
+	require.Equal(t, TestingGravityParams.BridgeChainId, chains[0].ChainId)
+	require.Equal(t, testEVMChain, chains[1])
+
+	// the chain id and gravity id must be unique
+	duplicate := testEVMChain
+	duplicate.GravityId = "other"
+	require.Error(t, k.AddEVMChain(ctx, duplicate))
+
+	duplicate = testEVMChain
+	duplicate.ChainId = 10
+	duplicate.GravityId = TestingGravityParams.GravityId
+	require.Error(t, k.AddEVMChain(ctx, duplicate))
+
+	// zero resolves to the default chain, unknown chains are rejected
+	chainID, err := k.resolveEVMChainID(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, TestingGravityParams.BridgeChainId, chainID)
+	_, err = k.resolveEVMChainID(ctx, 10)
+	require.ErrorIs(t, err, types.ErrUnknownEVMChain)
+}
+
+func TestEVMChainStateIsScoped(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	defaultChainID := TestingGravityParams.BridgeChainId
+	require.NoError(t, k.AddEVMChain(ctx, testEVMChain))
+
+	k.setLastObservedEventNonce(ctx, testEVMChain.ChainId, 5)
+	require.Equal(t, uint64(5), k.GetLastObservedEventNonce(ctx, testEVMChain.ChainId))
+	require.Equal(t, uint64(0), k.GetLastObservedEventNonce(ctx, defaultChainID))
+
+	require.Equal(t, testEVMChain.GravityId, k.getGravityID(ctx, testEVMChain.ChainId))
+	require.Equal(t, TestingGravityParams.GravityId, k.getGravityID(ctx, defaultChainID))
+
+	// vouchers of a chain can only be sent back to that chain
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	_, denom := k.ERC20ToDenomLookup(ctx, testEVMChain.ChainId, tokenContract)
+	require.Equal(t, types.EVMChainGravityDenom(testEVMChain.ChainId, tokenContract), denom)
+
+	_, erc20, err := k.DenomToERC20Lookup(ctx, testEVMChain.ChainId, denom)
+	require.NoError(t, err)
+	require.Equal(t, tokenContract, erc20)
+
+	_, _, err = k.DenomToERC20Lookup(ctx, defaultChainID, denom)
+	require.Error(t, err)
+	_, _, err = k.DenomToERC20Lookup(ctx, testEVMChain.ChainId, types.GravityDenom(tokenContract))
+	require.Error(t, err)
+}
+
+func TestDefaultEVMChainIDIsFixed(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	defaultChainID := TestingGravityParams.BridgeChainId
+
+	k.setLastObservedEventNonce(ctx, defaultChainID, 4)
+
+	// changing the param doesn't move the default chain or its state
+	params := k.GetParams(ctx)
+	params.BridgeChainId = 12
+	k.setParams(ctx, params)
+
+	require.Equal(t, defaultChainID, k.getBridgeChainID(ctx))
+	require.Equal(t, uint64(4), k.GetLastObservedEventNonce(ctx, k.getBridgeChainID(ctx)))
+	require.Equal(t, defaultChainID, ExportGenesis(ctx, k).Params.BridgeChainId)
+}
+
+func TestValidateEventFinality(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	rollup := testEVMChain
+	rollup.Finality = types.FinalityFinalized
+	rollup.MinimumConfirmations = 10
+	require.Error(t, k.AddEVMChain(ctx, rollup))
+	rollup.MinimumConfirmations = 0
+	require.NoError(t, k.AddEVMChain(ctx, rollup))
+
+	event := &types.SendToCosmosEvent{EventNonce: 1, EthereumHeight: 100}
+
+	// the observed height of a rollup is its finalized block, which must include the event
+	k.SetLastObservedEthereumBlockHeight(ctx, rollup.ChainId, 99)
+	require.ErrorIs(t, k.validateEventFinality(ctx, rollup, event), types.ErrInsufficientConfirmations)
+	k.SetLastObservedEthereumBlockHeight(ctx, rollup.ChainId, 100)
+	require.NoError(t, k.validateEventFinality(ctx, rollup, event))
+
+	// the default chain counts confirmations past the event's block
+	params := k.GetParams(ctx)
+	params.MinimumEthereumConfirmations = 6
+	k.setParams(ctx, params)
+	mainnet, _ := k.GetEVMChain(ctx, TestingGravityParams.BridgeChainId)
+	require.Equal(t, types.FinalityConfirmations, mainnet.Finality)
+	require.Equal(t, uint64(6), mainnet.MinimumConfirmations)
+
+	k.SetLastObservedEthereumBlockHeight(ctx, mainnet.ChainId, 105)
+	require.ErrorIs(t, k.validateEventFinality(ctx, mainnet, event), types.ErrInsufficientConfirmations)
+	k.SetLastObservedEthereumBlockHeight(ctx, mainnet.ChainId, 106)
+	require.NoError(t, k.validateEventFinality(ctx, mainnet, event))
+
+	params.EthereumFinality = types.FinalitySafe
+	require.Error(t, params.ValidateBasic())
+}
+
+func TestEVMChainPause(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	require.NoError(t, k.AddEVMChain(ctx, testEVMChain))
+
+	var (
+		sender, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		receiver      = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		vouchers      = sdk.NewCoins(types.NewERC20Token(99999, tokenContract).GravityCoin())
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, sender, vouchers))
+	input.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, receiver, 2, 3)
+
+	pause := types.NewEVMChainPauseProposal("pause", "pause the default chain", 0, true)
+	require.NoError(t, k.HandleEVMChainPauseProposal(ctx, pause))
+	require.Error(t, k.HandleEVMChainPauseProposal(ctx, pause))
+
+	// transfers and outgoing txs stop for the paused chain only
+	amount := types.NewERC20Token(10, tokenContract).GravityCoin()
+	_, err := k.createSendToEthereum(ctx, chainID, sender, receiver.Hex(), amount, amount)
+	require.ErrorIs(t, err, types.ErrEVMChainPaused)
+	require.Nil(t, k.CreateBatchTx(ctx, chainID, tokenContract, 10))
+	require.False(t, k.IsEVMChainPaused(ctx, testEVMChain.ChainId))
+
+	res, err := k.EVMChains(sdk.WrapSDKContext(ctx), &types.EVMChainsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Chains, 2)
+	require.Equal(t, chainID, res.Chains[0].Chain.ChainId)
+	require.True(t, res.Chains[0].Paused)
+	require.Equal(t, testEVMChain, res.Chains[1].Chain)
+	require.False(t, res.Chains[1].Paused)
+
+	// the pause is part of the exported genesis state
+	exported := ExportGenesis(ctx, k)
+	require.True(t, exported.Paused)
+
+	resume := types.NewEVMChainPauseProposal("resume", "resume the default chain", 0, false)
+	require.NoError(t, k.HandleEVMChainPauseProposal(ctx, resume))
+	require.NotNil(t, k.CreateBatchTx(ctx, chainID, tokenContract, 10))
+}
+
+func TestFeeFloor(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		sender, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		receiver      = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		vouchers      = sdk.NewCoins(types.NewERC20Token(99999, tokenContract).GravityCoin())
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, sender, vouchers))
+	input.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, receiver, 2, 3, 4)
+
+	// the floor is raised while some of the pooled transfers pay less
+	params := k.GetParams(ctx)
+	params.EthereumFeeFloors = []types.FeeFloor{{TokenContract: tokenContract.Hex(), MinimumFee: sdk.NewInt(3)}}
+	k.setParams(ctx, params)
+	require.Equal(t, sdk.NewInt(3), k.getMinimumFee(ctx, chainID, tokenContract))
+	require.True(t, k.getMinimumFee(ctx, testEVMChain.ChainId, tokenContract).IsZero())
+
+	amount := types.NewERC20Token(10, tokenContract).GravityCoin()
+	fee := types.NewERC20Token(2, tokenContract).GravityCoin()
+	_, err := k.createSendToEthereum(ctx, chainID, sender, receiver.Hex(), amount, fee)
+	require.ErrorIs(t, err, types.ErrInsufficientFee)
+
+	require.Equal(t, sdk.NewInt(7), k.GetBatchFeesByTokenType(ctx, chainID, tokenContract, 10))
+	batch := k.CreateBatchTx(ctx, chainID, tokenContract, 10)
+	require.NotNil(t, batch)
+	require.Len(t, batch.Transactions, 2)
+	for _, tx := range batch.Transactions {
+		require.Equal(t, chainID, tx.EvmChainId)
+	}
+
+	// the transfer below the floor stays in the pool
+	var unbatched []*types.SendToEthereum
+	k.IterateUnbatchedSendToEthereums(ctx, chainID, func(ste *types.SendToEthereum) bool {
+		unbatched = append(unbatched, ste)
+		return false
+	})
+	require.Len(t, unbatched, 1)
+	require.Equal(t, sdk.NewInt(2), unbatched[0].Erc20Fee.Amount)
+}
+
+func TestTokenDecimals(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		sender, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		receiver      = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom         = types.GravityDenom(tokenContract)
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, sender, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// the token has 18 decimals on the chain and 6 on cosmos
+	params := k.GetParams(ctx)
+	params.EthereumTokenDecimals = []types.TokenDecimals{{Denom: denom, DenomDecimals: 6, Erc20Decimals: 18}}
+	params.EthereumFeeFloors = []types.FeeFloor{{TokenContract: tokenContract.Hex(), MinimumFee: sdk.NewInt(1500000000000)}}
+	k.setParams(ctx, params)
+
+	_, err := k.createSendToEthereum(ctx, chainID, sender, receiver.Hex(), sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin(denom, 1))
+	require.ErrorIs(t, err, types.ErrInsufficientFee)
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	require.Equal(t, sdk.NewInt(2), chain.MinimumDenomFee(denom, tokenContract))
+
+	id, err := k.createSendToEthereum(ctx, chainID, sender, receiver.Hex(), sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin(denom, 2))
+	require.NoError(t, err)
+	unbatched := k.getUnbatchedSendToEthereums(ctx, chainID)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, sdk.NewInt(10000000000000), unbatched[0].Erc20Token.Amount)
+	require.Equal(t, sdk.NewInt(2000000000000), unbatched[0].Erc20Fee.Amount)
+	require.Equal(t, sdk.NewInt(88), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+
+	// the refund is in units of the denom again
+	require.NoError(t, k.cancelSendToEthereum(ctx, chainID, id, sender.String()))
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+
+	// deposits drop what is below the smallest unit of the denom
+	deposit := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(3999999999999),
+		EthereumSender: receiver.Hex(),
+		CosmosReceiver: sender.String(),
+		EthereumHeight: 10,
+	}
+	require.NoError(t, k.Handle(ctx, chainID, deposit))
+	require.Equal(t, sdk.NewInt(103), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+	deposit.Amount = sdk.NewInt(999999999999)
+	require.NoError(t, k.Handle(ctx, chainID, deposit))
+	require.Equal(t, sdk.NewInt(103), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+}
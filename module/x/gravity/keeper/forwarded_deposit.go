@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetForwardedDeposit returns the deposit forwarded by the transfer with the sequence on the channel
+func (k Keeper) GetForwardedDeposit(ctx sdk.Context, channelID string, sequence uint64) (types.ForwardedDeposit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeForwardedDepositKey(channelID, sequence))
+	if bz == nil {
+		return types.ForwardedDeposit{}, false
+	}
+	var deposit types.ForwardedDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+func (k Keeper) setForwardedDeposit(ctx sdk.Context, deposit types.ForwardedDeposit) {
+	ctx.KVStore(k.storeKey).Set(types.MakeForwardedDepositKey(deposit.ChannelId, deposit.Sequence), k.cdc.MustMarshal(&deposit))
+}
+
+// IterateForwardedDeposits iterates over the deposits whose transfers are in flight
+func (k Keeper) IterateForwardedDeposits(ctx sdk.Context, cb func(types.ForwardedDeposit) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ForwardedDepositKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.ForwardedDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// CompleteForwardedDeposit removes the deposit forwarded by the transfer with the sequence
+// on the channel once the transfer is acknowledged or times out, errMsg being empty if it
+// succeeded. False is returned if the transfer didn't forward a deposit.
+func (k Keeper) CompleteForwardedDeposit(ctx sdk.Context, channelID string, sequence uint64, errMsg string) (types.ForwardedDeposit, bool) {
+	deposit, found := k.GetForwardedDeposit(ctx, channelID, sequence)
+	if !found {
+		return types.ForwardedDeposit{}, false
+	}
+	ctx.KVStore(k.storeKey).Delete(types.MakeForwardedDepositKey(channelID, sequence))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBridgeDepositCompleted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.FormatUint(deposit.EvmChainId, 10)),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(deposit.EventNonce)),
+		sdk.NewAttribute(types.AttributeKeyIBCChannel, channelID),
+		sdk.NewAttribute(types.AttributeKeyPacketSequence, fmt.Sprint(sequence)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(errMsg == "")),
+		sdk.NewAttribute(types.AttributeKeyError, errMsg),
+	))
+	return deposit, true
+}
@@ -14,9 +14,131 @@ import (
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.setParams(ctx, *data.Params)
 
+	// reset delegate keys in state first, the signers of the confirmations are resolved to
+	// their validators through them
+	for _, keys := range data.DelegateKeys {
+		if err := keys.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("Invalid delegate key in Genesis: %s", err))
+		}
+
+		val, _ := sdk.ValAddressFromBech32(keys.ValidatorAddress)
+		orch, _ := sdk.AccAddressFromBech32(keys.OrchestratorAddress)
+		eth := common.HexToAddress(keys.EthereumAddress)
+
+		// set the orchestrator address
+		k.SetOrchestratorValidatorAddress(ctx, val, orch)
+		// set the ethereum address
+		k.setValidatorEthereumAddress(ctx, val, common.HexToAddress(keys.EthereumAddress))
+		k.setEthereumOrchestratorAddress(ctx, eth, orch)
+	}
+
+	// reset the state of the default evm chain
+	initEVMChainGenesis(ctx, k, k.getBridgeChainID(ctx), types.EVMChainGenesisState{
+		LastObservedEventNonce:            data.LastObservedEventNonce,
+		OutgoingTxs:                       data.OutgoingTxs,
+		Confirmations:                     data.Confirmations,
+		EthereumEventVoteRecords:          data.EthereumEventVoteRecords,
+		Erc20ToDenoms:                     data.Erc20ToDenoms,
+		UnbatchedSendToEthereumTxs:        data.UnbatchedSendToEthereumTxs,
+		BridgeContract:                    data.BridgeContract,
+		ContractMigration:                 data.ContractMigration,
+		Paused:                            data.Paused,
+		GravityIdRotation:                 data.GravityIdRotation,
+		DepositAddresses:                  data.DepositAddresses,
+		UnbatchedSendErc1155ToEthereumTxs: data.UnbatchedSendErc1155ToEthereumTxs,
+		ContractVersion:                   data.ContractVersion,
+		LastObservedEthereumHeight:        data.LastObservedEthereumHeight,
+		LatestSignerSetTxNonce:            data.LatestSignerSetTxNonce,
+		LastSlashedOutgoingTxHeight:       data.LastSlashedOutgoingTxHeight,
+		LastObservedSignerSetTx:           data.LastObservedSignerSetTx,
+		LastEventNonces:                   data.LastEventNonces,
+		EthereumHeightVotes:               data.EthereumHeightVotes,
+		RateLimitUsages:                   data.RateLimitUsages,
+		ContractBootstrap:                 data.ContractBootstrap,
+		AttestationLatency:                data.AttestationLatency,
+	})
+
+	// reset the ERC1155 token ids vouchers have been minted for
+	for _, token := range data.Erc1155Tokens {
+		k.setERC1155Token(ctx, token)
+	}
+
+	// reset the deposits forwarded over IBC
+	for _, deposit := range data.ForwardedDeposits {
+		k.setForwardedDeposit(ctx, deposit)
+	}
+
+	// reset the relayer incentives, the next one taking the id after the highest
+	for _, incentive := range data.RelayerIncentives {
+		k.setRelayerIncentive(ctx, incentive)
+		if incentive.Id > k.getLastRelayerIncentiveID(ctx) {
+			k.setLastRelayerIncentiveID(ctx, incentive.Id)
+		}
+	}
+	if data.LastRelayerIncentiveId > k.getLastRelayerIncentiveID(ctx) {
+		k.setLastRelayerIncentiveID(ctx, data.LastRelayerIncentiveId)
+	}
+
+	// reset the incident log, the next record taking the id after the highest
+	for _, record := range data.IncidentRecords {
+		k.setIncidentRecord(ctx, record)
+		if record.Id > k.getLastIncidentRecordID(ctx) {
+			k.setLastIncidentRecordID(ctx, record.Id)
+		}
+	}
+
+	if data.LastIncidentRecordId > k.getLastIncidentRecordID(ctx) {
+		k.setLastIncidentRecordID(ctx, data.LastIncidentRecordId)
+	}
+
+	// reset the bridge reports and the one of the period in progress
+	for _, report := range data.BridgeReports {
+		k.setBridgeReport(ctx, report)
+	}
+	if data.CurrentBridgeReport != nil {
+		k.setCurrentBridgeReport(ctx, *data.CurrentBridgeReport)
+	}
+
+	// reset the update of the params waiting for its height
+	if data.ScheduledParamsUpdate != nil {
+		k.setScheduledParamsUpdate(ctx, *data.ScheduledParamsUpdate)
+	}
+
+	// reset the additional evm chains and their state
+	for _, chain := range data.EvmChains {
+		if err := k.AddEVMChain(ctx, chain.Chain); err != nil {
+			panic(fmt.Sprintf("invalid evm chain in genesis: %s", err))
+		}
+		initEVMChainGenesis(ctx, k, chain.Chain.ChainId, chain)
+	}
+
+	// reset the counters shared by all chains, never below the ids of the imported sends
+	// and batches
+	if data.LastSendToEthereumId > k.getLastSendToEthereumID(ctx) {
+		k.setLastSendToEthereumID(ctx, data.LastSendToEthereumId)
+	}
+	if data.LastOutgoingBatchNonce > k.getLastOutgoingBatchNonce(ctx) {
+		k.setLastOutgoingBatchNonce(ctx, data.LastOutgoingBatchNonce)
+	}
+	k.setLastUnbondingBlockHeight(ctx, data.LastUnbondingBlockHeight)
+
+	// the issuance of the vouchers is their supply in the bank genesis, initialized before
+	k.seedVoucherIssuance(ctx)
+}
+
+func initEVMChainGenesis(ctx sdk.Context, k Keeper, chainID uint64, data types.EVMChainGenesisState) {
 	// reset pool transactions in state
 	for _, tx := range data.UnbatchedSendToEthereumTxs {
-		k.setUnbatchedSendToEthereum(ctx, tx)
+		k.setUnbatchedSendToEthereum(ctx, chainID, tx)
+		if tx.Id > k.getLastSendToEthereumID(ctx) {
+			k.setLastSendToEthereumID(ctx, tx.Id)
+		}
+	}
+	for _, send := range data.UnbatchedSendErc1155ToEthereumTxs {
+		k.setUnbatchedSendERC1155ToEthereum(ctx, chainID, send)
+		if send.Id > k.getLastSendToEthereumID(ctx) {
+			k.setLastSendToEthereumID(ctx, send.Id)
+		}
 	}
 
 	// reset ethereum event vote records in state
@@ -28,47 +150,37 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err := event.Validate(); err != nil {
 			panic(fmt.Sprintf("invalid event in genesis: %s", err))
 		}
-		k.setEthereumEventVoteRecord(ctx, event.GetEventNonce(), event.Hash(), evr)
+		k.setEthereumEventVoteRecord(ctx, chainID, event.GetEventNonce(), event.Hash(), evr)
 	}
 
 	// reset last observed event nonce
-	k.setLastObservedEventNonce(ctx, data.LastObservedEventNonce)
+	k.setLastObservedEventNonce(ctx, chainID, data.LastObservedEventNonce)
 
-	// reset attestation state of all validators
+	// reset attestation state of all validators, raised to the nonces of their votes
+	for _, lastEventNonce := range data.LastEventNonces {
+		val, _ := sdk.ValAddressFromBech32(lastEventNonce.ValidatorAddress)
+		k.setLastEventNonceByValidator(ctx, chainID, val, lastEventNonce.Nonce)
+	}
 	for _, eventVoteRecord := range data.EthereumEventVoteRecords {
 		event, _ := types.UnpackEvent(eventVoteRecord.Event)
-		for _, vote := range eventVoteRecord.Votes {
-			val, err := sdk.ValAddressFromBech32(vote)
-			if err != nil {
-				panic(err)
-			}
-			last := k.getLastEventNonceByValidator(ctx, val)
+		for _, val := range k.eventVoters(ctx, chainID, eventVoteRecord) {
+			last := k.getLastEventNonceByValidator(ctx, chainID, val)
 			if event.GetEventNonce() > last {
-				k.setLastEventNonceByValidator(ctx, val, event.GetEventNonce())
+				k.setLastEventNonceByValidator(ctx, chainID, val, event.GetEventNonce())
 			}
 		}
 	}
 
-	// reset delegate keys in state
-	for _, keys := range data.DelegateKeys {
-		if err := keys.ValidateBasic(); err != nil {
-			panic(fmt.Sprintf("Invalid delegate key in Genesis: %s", err))
-		}
-
-		val, _ := sdk.ValAddressFromBech32(keys.ValidatorAddress)
-		orch, _ := sdk.AccAddressFromBech32(keys.OrchestratorAddress)
-		eth := common.HexToAddress(keys.EthereumAddress)
-
-		// set the orchestrator address
-		k.SetOrchestratorValidatorAddress(ctx, val, orch)
-		// set the ethereum address
-		k.setValidatorEthereumAddress(ctx, val, common.HexToAddress(keys.EthereumAddress))
-		k.setEthereumOrchestratorAddress(ctx, eth, orch)
+	// reset the observed ethereum heights and the votes for them
+	k.SetLastObservedEthereumBlockHeightWithCosmos(ctx, chainID, data.LastObservedEthereumHeight.EthereumHeight, data.LastObservedEthereumHeight.CosmosHeight)
+	for _, vote := range data.EthereumHeightVotes {
+		val, _ := sdk.ValAddressFromBech32(vote.ValidatorAddress)
+		k.setEthereumHeightVote(ctx, chainID, val, vote.Height)
 	}
 
 	// populate state with cosmos originated denom-erc20 mapping
 	for _, item := range data.Erc20ToDenoms {
-		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, common.HexToAddress(item.Erc20))
+		k.setCosmosOriginatedDenomToERC20(ctx, chainID, item.Denom, common.HexToAddress(item.Erc20))
 	}
 
 	// reset outgoing txs in state
@@ -77,19 +189,109 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic(fmt.Sprintf("invalid outgoing tx any in genesis file: %s", err))
 		}
-		k.SetOutgoingTx(ctx, otx)
+		k.SetOutgoingTx(ctx, chainID, otx)
+		switch otx := otx.(type) {
+		case *types.SignerSetTx:
+			if otx.Nonce > k.GetLatestSignerSetTxNonce(ctx, chainID) {
+				k.setLatestSignerSetTxNonce(ctx, chainID, otx.Nonce)
+			}
+		case *types.BatchTx:
+			if otx.BatchNonce > k.getLastOutgoingBatchNonce(ctx) {
+				k.setLastOutgoingBatchNonce(ctx, otx.BatchNonce)
+			}
+			for _, tx := range otx.Transactions {
+				if tx.Id > k.getLastSendToEthereumID(ctx) {
+					k.setLastSendToEthereumID(ctx, tx.Id)
+				}
+			}
+		case *types.ERC1155BatchTx:
+			if otx.BatchNonce > k.getLastOutgoingBatchNonce(ctx) {
+				k.setLastOutgoingBatchNonce(ctx, otx.BatchNonce)
+			}
+		}
+	}
+	if data.LatestSignerSetTxNonce > k.GetLatestSignerSetTxNonce(ctx, chainID) {
+		k.setLatestSignerSetTxNonce(ctx, chainID, data.LatestSignerSetTxNonce)
 	}
+	if data.LastObservedSignerSetTx != nil {
+		k.setLastObservedSignerSetTx(ctx, chainID, *data.LastObservedSignerSetTx)
+	}
+	k.SetLastSlashedOutgoingTxBlockHeight(ctx, chainID, data.LastSlashedOutgoingTxHeight)
 
-	// reset signatures in state
+	// reset signatures in state, under the validators their signers are the delegate
+	// ethereum keys of
 	for _, confa := range data.Confirmations {
 		conf, err := types.UnpackConfirmation(confa)
 		if err != nil {
 			panic(fmt.Sprintf("invalid etheruem signature in genesis: %s", err))
 		}
-		// TODO: not currently an easy way to get the validator address from the
-		// etherum address here. once we implement the third index for keys
-		// this will be easy.
-		k.SetEthereumSignature(ctx, conf, sdk.ValAddress{})
+		val := k.GetOrchestratorValidatorAddress(ctx, k.GetEthereumOrchestratorAddress(ctx, conf.GetSigner()))
+		if val == nil {
+			panic(fmt.Sprintf("no delegate keys for the signer %s of an ethereum signature in genesis", conf.GetSigner()))
+		}
+		k.SetEthereumSignature(ctx, chainID, conf, val)
+	}
+
+	// reset what has been transferred of the rate limited tokens in their windows
+	for _, usage := range data.RateLimitUsages {
+		k.setRateLimitUsage(ctx, chainID, common.HexToAddress(usage.TokenContract), usage.Usage)
+	}
+
+	// reset the bridging epoch and any pending contract migration
+	if data.BridgeContract != nil {
+		k.setBridgeContract(ctx, chainID, *data.BridgeContract)
+	}
+	if data.ContractMigration != nil {
+		k.setContractMigration(ctx, chainID, *data.ContractMigration)
+	}
+	k.setEVMChainPaused(ctx, chainID, data.Paused)
+	if data.GravityIdRotation != nil {
+		k.setGravityIDRotation(ctx, chainID, *data.GravityIdRotation)
+	}
+	k.setContractVersion(ctx, chainID, data.ContractVersion)
+
+	// reset the registered deposit addresses
+	for _, depositAddress := range data.DepositAddresses {
+		recipient, _ := sdk.AccAddressFromBech32(depositAddress.Recipient)
+		k.setDepositAddress(ctx, chainID, recipient, common.HexToAddress(depositAddress.DepositAddress))
+	}
+
+	if data.AttestationLatency != nil {
+		k.setAttestationLatency(ctx, chainID, *data.AttestationLatency)
+	}
+
+	if data.ContractBootstrap != nil {
+		bootstrapContract(ctx, k, chainID, *data.ContractBootstrap)
+	}
+}
+
+// bootstrapContract takes over an already deployed contract, raising the nonces and heights of
+// the chain to those of the contract so that the events, signer sets and batches the contract
+// already processed are never expected or produced again. The vouchers of the ethereum
+// originated tokens in the bank genesis can't exceed what the contract holds of the tokens.
+func bootstrapContract(ctx sdk.Context, k Keeper, chainID uint64, bootstrap types.ContractBootstrap) {
+	if bootstrap.LastEventNonce > k.GetLastObservedEventNonce(ctx, chainID) {
+		k.setLastObservedEventNonce(ctx, chainID, bootstrap.LastEventNonce)
+	}
+	if bootstrap.EthereumHeight > k.GetLastObservedEthereumBlockHeight(ctx, chainID).EthereumHeight {
+		k.SetLastObservedEthereumBlockHeightWithCosmos(ctx, chainID, bootstrap.EthereumHeight, uint64(ctx.BlockHeight()))
+	}
+	if bootstrap.LastSignerSetNonce > k.GetLatestSignerSetTxNonce(ctx, chainID) {
+		k.setLatestSignerSetTxNonce(ctx, chainID, bootstrap.LastSignerSetNonce)
+	}
+
+	for _, token := range bootstrap.Tokens {
+		if token.LastBatchNonce > k.getLastOutgoingBatchNonce(ctx) {
+			k.setLastOutgoingBatchNonce(ctx, token.LastBatchNonce)
+		}
+
+		cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, chainID, common.HexToAddress(token.Contract))
+		if cosmosOriginated {
+			continue
+		}
+		if supply := k.bankKeeper.GetSupply(ctx, denom); supply.Amount.GT(token.Balance) {
+			panic(fmt.Sprintf("%s vouchers in genesis exceed the contract balance %s of %s", supply, token.Balance, token.Contract))
+		}
 	}
 }
 
@@ -97,35 +299,132 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 // from the current state of the chain
 func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	var (
-		p                        = k.GetParams(ctx)
+		p         = k.GetParams(ctx)
+		delegates = k.getDelegateKeys(ctx)
+		evmChains []types.EVMChainGenesisState
+	)
+
+	// export the chain id the default chain's state is stored under
+	p.BridgeChainId = k.getBridgeChainID(ctx)
+
+	// this will marshal into "dW51c2Vk" as []byte will be encoded as base64
+	for _, delegate := range delegates {
+		delegate.EthSignature = []byte("unused")
+	}
+
+	k.iterateEVMChains(ctx, func(chain types.EVMChain) bool {
+		evmChains = append(evmChains, exportEVMChainGenesis(ctx, k, chain))
+		return false
+	})
+
+	defaultChain := exportEVMChainGenesis(ctx, k, k.defaultEVMChain(ctx))
+
+	var erc1155Tokens []types.ERC1155Token
+	k.iterateERC1155Tokens(ctx, func(token types.ERC1155Token) bool {
+		erc1155Tokens = append(erc1155Tokens, token)
+		return false
+	})
+
+	var forwardedDeposits []types.ForwardedDeposit
+	k.IterateForwardedDeposits(ctx, func(deposit types.ForwardedDeposit) bool {
+		forwardedDeposits = append(forwardedDeposits, deposit)
+		return false
+	})
+
+	var relayerIncentives []types.RelayerIncentive
+	k.IterateRelayerIncentives(ctx, func(incentive types.RelayerIncentive) bool {
+		relayerIncentives = append(relayerIncentives, incentive)
+		return false
+	})
+
+	var incidentRecords []types.IncidentRecord
+	k.IterateIncidentRecords(ctx, func(record types.IncidentRecord) bool {
+		incidentRecords = append(incidentRecords, record)
+		return false
+	})
+
+	var bridgeReports []types.BridgeReport
+	k.IterateBridgeReports(ctx, func(report types.BridgeReport) bool {
+		bridgeReports = append(bridgeReports, report)
+		return false
+	})
+
+	return types.GenesisState{
+		Params:                            &p,
+		LastObservedEventNonce:            defaultChain.LastObservedEventNonce,
+		OutgoingTxs:                       defaultChain.OutgoingTxs,
+		Confirmations:                     defaultChain.Confirmations,
+		EthereumEventVoteRecords:          defaultChain.EthereumEventVoteRecords,
+		DelegateKeys:                      delegates,
+		Erc20ToDenoms:                     defaultChain.Erc20ToDenoms,
+		UnbatchedSendToEthereumTxs:        defaultChain.UnbatchedSendToEthereumTxs,
+		EvmChains:                         evmChains,
+		BridgeContract:                    defaultChain.BridgeContract,
+		ContractMigration:                 defaultChain.ContractMigration,
+		Paused:                            defaultChain.Paused,
+		GravityIdRotation:                 defaultChain.GravityIdRotation,
+		DepositAddresses:                  defaultChain.DepositAddresses,
+		Erc1155Tokens:                     erc1155Tokens,
+		UnbatchedSendErc1155ToEthereumTxs: defaultChain.UnbatchedSendErc1155ToEthereumTxs,
+		ForwardedDeposits:                 forwardedDeposits,
+		RelayerIncentives:                 relayerIncentives,
+		ContractVersion:                   defaultChain.ContractVersion,
+		IncidentRecords:                   incidentRecords,
+		ScheduledParamsUpdate:             k.GetScheduledParamsUpdate(ctx),
+		BridgeReports:                     bridgeReports,
+		LastObservedEthereumHeight:        defaultChain.LastObservedEthereumHeight,
+		LatestSignerSetTxNonce:            defaultChain.LatestSignerSetTxNonce,
+		LastSlashedOutgoingTxHeight:       defaultChain.LastSlashedOutgoingTxHeight,
+		LastObservedSignerSetTx:           defaultChain.LastObservedSignerSetTx,
+		LastEventNonces:                   defaultChain.LastEventNonces,
+		EthereumHeightVotes:               defaultChain.EthereumHeightVotes,
+		RateLimitUsages:                   defaultChain.RateLimitUsages,
+		LastSendToEthereumId:              k.getLastSendToEthereumID(ctx),
+		LastOutgoingBatchNonce:            k.getLastOutgoingBatchNonce(ctx),
+		LastUnbondingBlockHeight:          k.GetLastUnbondingBlockHeight(ctx),
+		LastRelayerIncentiveId:            k.getLastRelayerIncentiveID(ctx),
+		LastIncidentRecordId:              k.getLastIncidentRecordID(ctx),
+		CurrentBridgeReport:               k.getStartedBridgeReport(ctx),
+		AttestationLatency:                defaultChain.AttestationLatency,
+	}
+}
+
+func exportEVMChainGenesis(ctx sdk.Context, k Keeper, chain types.EVMChain) types.EVMChainGenesisState {
+	var (
+		chainID                  = chain.ChainId
 		outgoingTxs              []*cdctypes.Any
 		ethereumTxConfirmations  []*cdctypes.Any
-		attmap                   = k.GetEthereumEventVoteRecordMapping(ctx)
 		ethereumEventVoteRecords []*types.EthereumEventVoteRecord
-		delegates                = k.getDelegateKeys(ctx)
-		lastobserved             = k.GetLastObservedEventNonce(ctx)
+		lastobserved             = k.GetLastObservedEventNonce(ctx, chainID)
 		erc20ToDenoms            []*types.ERC20ToDenom
-		unbatchedTransfers       = k.getUnbatchedSendToEthereums(ctx)
+		unbatchedTransfers       = k.getUnbatchedSendToEthereums(ctx, chainID)
 	)
 
-	// export ethereumEventVoteRecords from state
-	for _, atts := range attmap {
-		// TODO: set height = 0?
-		ethereumEventVoteRecords = append(ethereumEventVoteRecords, atts...)
-	}
+	// export ethereumEventVoteRecords from state, in the order of the store so that the
+	// export is deterministic. Their votes are exported as a list, the voter sets indexing
+	// their bitmaps not being part of the genesis.
+	k.iterateEthereumEventVoteRecords(ctx, chainID, func(_ []byte, evr *types.EthereumEventVoteRecord) bool {
+		votes := make([]string, 0, len(evr.Votes))
+		for _, val := range k.eventVoters(ctx, chainID, evr) {
+			votes = append(votes, val.String())
+		}
+		evr.Votes, evr.SignerSetNonce, evr.VoteBitmap = votes, 0, nil
+		ethereumEventVoteRecords = append(ethereumEventVoteRecords, evr)
+		return false
+	})
 
 	// export erc20 to denom relations
-	k.iterateERC20ToDenom(ctx, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+	k.iterateERC20ToDenom(ctx, chainID, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
 		erc20ToDenoms = append(erc20ToDenoms, erc20ToDenom)
 		return false
 	})
 
 	// export signer set txs and sigs
-	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+	k.IterateOutgoingTxsByType(ctx, chainID, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
 		sstx, _ := otx.(*types.SignerSetTx)
-		k.iterateEthereumSignatures(ctx, sstx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
+		k.iterateEthereumSignatures(ctx, chainID, sstx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
 			siga, _ := types.PackConfirmation(&types.SignerSetTxConfirmation{sstx.Nonce, k.GetValidatorEthereumAddress(ctx, val).Hex(), sig})
 			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
 			return false
@@ -134,11 +433,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	})
 
 	// export batch txs and sigs
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+	k.IterateOutgoingTxsByType(ctx, chainID, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
 		btx, _ := otx.(*types.BatchTx)
-		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
+		k.iterateEthereumSignatures(ctx, chainID, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
 			siga, _ := types.PackConfirmation(&types.BatchTxConfirmation{btx.TokenContract, btx.BatchNonce, k.GetValidatorEthereumAddress(ctx, val).Hex(), sig})
 			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
 			return false
@@ -147,11 +446,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	})
 
 	// export contract call txs and sigs
-	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+	k.IterateOutgoingTxsByType(ctx, chainID, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
 		btx, _ := otx.(*types.ContractCallTx)
-		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
+		k.iterateEthereumSignatures(ctx, chainID, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
 			siga, _ := types.PackConfirmation(&types.ContractCallTxConfirmation{btx.InvalidationScope, btx.InvalidationNonce, k.GetValidatorEthereumAddress(ctx, val).Hex(), sig})
 			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
 			return false
@@ -159,19 +458,88 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		return false
 	})
 
-	// this will marshal into "dW51c2Vk" as []byte will be encoded as base64
-	for _, delegate := range delegates {
-		delegate.EthSignature = []byte("unused")
+	// export erc1155 batch txs and sigs
+	k.IterateOutgoingTxsByType(ctx, chainID, types.ERC1155BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		ota, _ := types.PackOutgoingTx(otx)
+		outgoingTxs = append(outgoingTxs, ota)
+		btx, _ := otx.(*types.ERC1155BatchTx)
+		k.iterateEthereumSignatures(ctx, chainID, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
+			siga, _ := types.PackConfirmation(&types.ERC1155BatchTxConfirmation{
+				TokenContract:  btx.TokenContract,
+				BatchNonce:     btx.BatchNonce,
+				EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
+				Signature:      sig,
+			})
+			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
+			return false
+		})
+		return false
+	})
+
+	// export the bridging epoch once the chain has been migrated
+	var bridgeContract *types.BridgeContract
+	if contract := k.GetBridgeContract(ctx, chainID); contract.Epoch > 0 {
+		bridgeContract = &contract
 	}
+	var contractMigration *types.ContractMigration
+	if migration, found := k.GetContractMigration(ctx, chainID); found {
+		contractMigration = &migration
+	}
+	var gravityIDRotation *types.GravityIDRotation
+	if rotation, found := k.GetGravityIDRotation(ctx, chainID); found {
+		gravityIDRotation = &rotation
+	}
+	var depositAddresses []types.DepositAddress
+	k.iterateDepositAddresses(ctx, chainID, func(recipient sdk.AccAddress, depositAddress common.Address) bool {
+		depositAddresses = append(depositAddresses, types.DepositAddress{
+			Recipient:      recipient.String(),
+			DepositAddress: depositAddress.Hex(),
+		})
+		return false
+	})
 
-	return types.GenesisState{
-		Params:                     &p,
-		LastObservedEventNonce:     lastobserved,
-		OutgoingTxs:                outgoingTxs,
-		Confirmations:              ethereumTxConfirmations,
-		EthereumEventVoteRecords:   ethereumEventVoteRecords,
-		DelegateKeys:               delegates,
-		Erc20ToDenoms:              erc20ToDenoms,
-		UnbatchedSendToEthereumTxs: unbatchedTransfers,
+	var lastEventNonces []types.ValidatorEventNonce
+	k.iterateLastEventNonceByValidator(ctx, chainID, func(val sdk.ValAddress, nonce uint64) bool {
+		lastEventNonces = append(lastEventNonces, types.ValidatorEventNonce{ValidatorAddress: val.String(), Nonce: nonce})
+		return false
+	})
+	var ethereumHeightVotes []types.EthereumHeightVote
+	k.IterateEthereumHeightVotes(ctx, chainID, func(val sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
+		ethereumHeightVotes = append(ethereumHeightVotes, types.EthereumHeightVote{ValidatorAddress: val.String(), Height: height})
+		return false
+	})
+	var rateLimitUsages []types.TokenRateLimitUsage
+	k.iterateRateLimitUsages(ctx, chainID, func(tokenContract common.Address, usage types.RateLimitUsage) bool {
+		rateLimitUsages = append(rateLimitUsages, types.TokenRateLimitUsage{TokenContract: tokenContract.Hex(), Usage: usage})
+		return false
+	})
+	var attestationLatency *types.AttestationLatency
+	if latency := k.GetAttestationLatency(ctx, chainID); len(latency.Samples) > 0 {
+		attestationLatency = &latency
+	}
+
+	return types.EVMChainGenesisState{
+		Chain:                             chain,
+		LastObservedEventNonce:            lastobserved,
+		OutgoingTxs:                       outgoingTxs,
+		Confirmations:                     ethereumTxConfirmations,
+		EthereumEventVoteRecords:          ethereumEventVoteRecords,
+		Erc20ToDenoms:                     erc20ToDenoms,
+		UnbatchedSendToEthereumTxs:        unbatchedTransfers,
+		BridgeContract:                    bridgeContract,
+		ContractMigration:                 contractMigration,
+		Paused:                            k.IsEVMChainPaused(ctx, chainID),
+		GravityIdRotation:                 gravityIDRotation,
+		DepositAddresses:                  depositAddresses,
+		UnbatchedSendErc1155ToEthereumTxs: k.getUnbatchedSendERC1155ToEthereums(ctx, chainID),
+		ContractVersion:                   k.GetContractVersion(ctx, chainID),
+		LastObservedEthereumHeight:        k.GetLastObservedEthereumBlockHeight(ctx, chainID),
+		LatestSignerSetTxNonce:            k.GetLatestSignerSetTxNonce(ctx, chainID),
+		LastSlashedOutgoingTxHeight:       k.GetLastSlashedOutgoingTxBlockHeight(ctx, chainID),
+		LastObservedSignerSetTx:           k.GetLastObservedSignerSetTx(ctx, chainID),
+		LastEventNonces:                   lastEventNonces,
+		EthereumHeightVotes:               ethereumHeightVotes,
+		RateLimitUsages:                   rateLimitUsages,
+		AttestationLatency:                attestationLatency,
 	}
 }
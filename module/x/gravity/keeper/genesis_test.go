@@ -6,6 +6,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
 // for the moment this is only testing delegate keys being set, but it would be good to make
@@ -23,6 +26,9 @@ func TestExportAndImport(t *testing.T) {
 	keeper.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
 	keeper.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
 
+	require.NoError(t, keeper.AddEVMChain(ctx, testEVMChain))
+	keeper.setLastObservedEventNonce(ctx, testEVMChain.ChainId, 3)
+
 	exportedGenesis := ExportGenesis(ctx, keeper)
 	newEnv := CreateTestEnv(t)
 	newCtx := newEnv.Context
@@ -33,4 +39,118 @@ func TestExportAndImport(t *testing.T) {
 	assert.Equal(t, newKeeper.GetValidatorEthereumAddress(newCtx, valAddr), ethAddr)
 	assert.Equal(t, newKeeper.GetEthereumOrchestratorAddress(newCtx, ethAddr), orchAddr)
 	assert.Equal(t, newKeeper.GetOrchestratorValidatorAddress(newCtx, orchAddr), valAddr)
+
+	chain, found := newKeeper.GetEVMChain(newCtx, testEVMChain.ChainId)
+	assert.True(t, found)
+	assert.Equal(t, testEVMChain, chain)
+	assert.Equal(t, uint64(3), newKeeper.GetLastObservedEventNonce(newCtx, testEVMChain.ChainId))
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	tokenContract := EthAddrs[0]
+
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(tokenContract), 10000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, AccAddrs[0], vouchers))
+
+	// in-flight withdrawals, half of them batched and signed by some validators
+	input.AddSendToEthTxsToPool(t, ctx, tokenContract, AccAddrs[0], EthAddrs[1], 1, 2, 3, 4)
+	batch := k.CreateBatchTx(ctx, chainID, tokenContract, 2)
+	require.NotNil(t, batch)
+	for _, i := range []int{0, 1} {
+		k.SetEthereumSignature(ctx, chainID, &types.BatchTxConfirmation{
+			TokenContract:  batch.TokenContract,
+			BatchNonce:     batch.BatchNonce,
+			EthereumSigner: EthAddrs[i].Hex(),
+			Signature:      []byte{byte(i + 1)},
+		}, ValAddrs[i])
+	}
+	signerSet := k.CreateSignerSetTx(ctx, chainID)
+
+	// votes, nonces and counters
+	_, err := k.recordEventVote(ctx, chainID, &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[1].String(),
+		EthereumHeight: 10,
+	}, ValAddrs[0])
+	require.NoError(t, err)
+	k.setLastEventNonceByValidator(ctx, chainID, ValAddrs[2], 7)
+	k.SetEthereumHeightVote(ctx, chainID, ValAddrs[0], 100)
+	k.SetLastObservedEthereumBlockHeight(ctx, chainID, 90)
+	k.setLastObservedSignerSetTx(ctx, chainID, *signerSet)
+	k.SetLastSlashedOutgoingTxBlockHeight(ctx, chainID, 5)
+	k.setLastUnbondingBlockHeight(ctx, 6)
+	k.setRateLimitUsage(ctx, chainID, tokenContract, types.RateLimitUsage{WindowStart: 3, Amount: sdk.NewInt(42)})
+	k.setCurrentBridgeReport(ctx, types.BridgeReport{Id: 1, StartHeight: 2, SendsToEthereum: 4})
+	k.setCosmosOriginatedDenomToERC20(ctx, chainID, "ucosmos", EthAddrs[2])
+
+	exported := ExportGenesis(ctx, k)
+	require.NoError(t, exported.ValidateBasic())
+
+	newInput := CreateTestEnv(t)
+	newCtx := newInput.Context
+	newKeeper := newInput.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exported)
+
+	// nothing is lost or changed by the round trip
+	require.Equal(t, exported, ExportGenesis(newCtx, newKeeper))
+
+	// the signatures are kept under their validators
+	signatures := newKeeper.GetEthereumSignatures(newCtx, chainID, batch.GetStoreIndex())
+	require.Equal(t, map[string][]byte{ValAddrs[0].String(): {1}, ValAddrs[1].String(): {2}}, signatures)
+
+	// the cosmos originated denoms keep their ERC20
+	erc20, found := newKeeper.getCosmosOriginatedERC20(newCtx, chainID, "ucosmos")
+	require.True(t, found)
+	require.Equal(t, EthAddrs[2], erc20)
+
+	// and the ids and nonces carry on where they stopped
+	require.Equal(t, signerSet, newKeeper.GetLatestSignerSetTx(newCtx, chainID))
+	require.Equal(t, uint64(4), newKeeper.getLastSendToEthereumID(newCtx))
+	require.Equal(t, batch.BatchNonce, newKeeper.getLastOutgoingBatchNonce(newCtx))
+}
+
+func TestGenesisContractBootstrap(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(EthAddrs[0]), 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+
+	params := TestingGravityParams
+	genesis := types.DefaultGenesisState()
+	genesis.Params = &params
+	genesis.ContractBootstrap = &types.ContractBootstrap{
+		EthereumHeight:     1000,
+		LastEventNonce:     42,
+		LastSignerSetNonce: 7,
+		Tokens: []types.ContractToken{
+			{Contract: EthAddrs[0].Hex(), Balance: sdk.NewInt(100), LastBatchNonce: 5},
+			{Contract: EthAddrs[1].Hex(), Balance: sdk.ZeroInt(), LastBatchNonce: 9},
+		},
+	}
+	require.NoError(t, genesis.ValidateBasic())
+	InitGenesis(ctx, k, *genesis)
+	chainID := k.getBridgeChainID(ctx)
+
+	// the chain carries on from the state of the contract
+	require.Equal(t, uint64(42), k.GetLastObservedEventNonce(ctx, chainID))
+	require.Equal(t, uint64(42), k.getLastEventNonceByValidator(ctx, chainID, ValAddrs[0]))
+	require.Equal(t, uint64(1000), k.GetLastObservedEthereumBlockHeight(ctx, chainID).EthereumHeight)
+	require.Equal(t, uint64(7), k.GetLatestSignerSetTxNonce(ctx, chainID))
+	require.Equal(t, uint64(9), k.getLastOutgoingBatchNonce(ctx))
+
+	// vouchers the contract doesn't hold the tokens of can't be imported
+	genesis.ContractBootstrap.Tokens[0].Balance = sdk.NewInt(99)
+	require.Panics(t, func() { InitGenesis(ctx, k, *genesis) })
+
+	genesis.ContractBootstrap.EthereumHeight = 0
+	require.Error(t, genesis.ValidateBasic())
 }
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetGravityIDRotation returns the rotation of the EVM chain's gravity id whose
+// acceptance window hasn't been pruned yet, if any
+func (k Keeper) GetGravityIDRotation(ctx sdk.Context, chainID uint64) (types.GravityIDRotation, bool) {
+	bz := k.chainStore(ctx, chainID).Get([]byte{types.GravityIDRotationKey})
+	if bz == nil {
+		return types.GravityIDRotation{}, false
+	}
+	var rotation types.GravityIDRotation
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return rotation, true
+}
+
+func (k Keeper) setGravityIDRotation(ctx sdk.Context, chainID uint64, rotation types.GravityIDRotation) {
+	k.chainStore(ctx, chainID).Set([]byte{types.GravityIDRotationKey}, k.cdc.MustMarshal(&rotation))
+}
+
+func (k Keeper) deleteGravityIDRotation(ctx sdk.Context, chainID uint64) {
+	k.chainStore(ctx, chainID).Delete([]byte{types.GravityIDRotationKey})
+}
+
+// acceptedGravityIDs returns the gravity ids confirmations of the EVM chain's outgoing
+// txs may be signed for, the current one first followed by the previous one while the
+// acceptance window of a rotation is open
+func (k Keeper) acceptedGravityIDs(ctx sdk.Context, chainID uint64) []string {
+	gravityIDs := []string{k.getGravityID(ctx, chainID)}
+	if rotation, found := k.GetGravityIDRotation(ctx, chainID); found && uint64(ctx.BlockHeight()) <= rotation.EndHeight {
+		gravityIDs = append(gravityIDs, rotation.PreviousGravityId)
+	}
+	return gravityIDs
+}
+
+// gravityIDInUse returns the EVM chain that signs for the gravity id, either as its
+// current id or as the previous one of a rotation that is still being accepted
+func (k Keeper) gravityIDInUse(ctx sdk.Context, gravityID string) (uint64, bool) {
+	for _, chain := range k.GetEVMChains(ctx) {
+		for _, accepted := range k.acceptedGravityIDs(ctx, chain.ChainId) {
+			if accepted == gravityID {
+				return chain.ChainId, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func (k Keeper) setGravityID(ctx sdk.Context, chainID uint64, gravityID string) {
+	if chainID == k.getBridgeChainID(ctx) {
+		params := k.GetParams(ctx)
+		params.GravityId = gravityID
+		k.setParams(ctx, params)
+		return
+	}
+
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	chain.GravityId = gravityID
+	k.setEVMChain(ctx, chain)
+}
+
+// rotateGravityID switches the EVM chain to a new gravity id, checkpoints are produced
+// for it from now on while confirmations for the previous id are accepted for the
+// acceptance window in blocks
+func (k Keeper) rotateGravityID(ctx sdk.Context, chainID uint64, gravityID string, acceptanceWindow uint64) error {
+	if rotation, found := k.GetGravityIDRotation(ctx, chainID); found {
+		return sdkerrors.Wrapf(types.ErrInvalid, "evm chain %d is still accepting its previous gravity id %s", chainID, rotation.PreviousGravityId)
+	}
+	if existing, found := k.gravityIDInUse(ctx, gravityID); found {
+		return sdkerrors.Wrapf(types.ErrGravityIDInUse, "gravity id %s is used by evm chain %d", gravityID, existing)
+	}
+
+	previous := k.getGravityID(ctx, chainID)
+	k.setGravityID(ctx, chainID, gravityID)
+
+	rotation := types.GravityIDRotation{
+		PreviousGravityId: previous,
+		EndHeight:         uint64(ctx.BlockHeight()) + acceptanceWindow,
+	}
+	if acceptanceWindow > 0 {
+		k.setGravityIDRotation(ctx, chainID, rotation)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGravityIDRotation,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyGravityID, gravityID),
+		sdk.NewAttribute(types.AttributeKeyPreviousGravityID, previous),
+		sdk.NewAttribute(types.AttributeKeyAcceptanceEndHeight, fmt.Sprint(rotation.EndHeight)),
+	))
+
+	return nil
+}
+
+// PruneGravityIDRotation drops the EVM chain's rotation once its acceptance window
+// has ended, the previous gravity id is then no longer accepted
+func (k Keeper) PruneGravityIDRotation(ctx sdk.Context, chainID uint64) {
+	rotation, found := k.GetGravityIDRotation(ctx, chainID)
+	if !found || uint64(ctx.BlockHeight()) <= rotation.EndHeight {
+		return
+	}
+	k.deleteGravityIDRotation(ctx, chainID)
+	k.Logger(ctx).Info("gravity id rotation completed", "chain id", chainID, "previous gravity id", rotation.PreviousGravityId)
+}
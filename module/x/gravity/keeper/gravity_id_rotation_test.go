@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestGravityIDRotation(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	previous := TestingGravityParams.GravityId
+
+	var (
+		orcAddrs = []sdk.AccAddress{AccAddrs[0], AccAddrs[1], AccAddrs[2]}
+		valAddrs = []sdk.ValAddress{sdk.ValAddress(AccAddrs[0]), sdk.ValAddress(AccAddrs[1]), sdk.ValAddress(AccAddrs[2])}
+	)
+	k.StakingKeeper = NewStakingKeeperMock(valAddrs...)
+	for i := range valAddrs {
+		k.SetOrchestratorValidatorAddress(ctx, valAddrs[i], orcAddrs[i])
+	}
+	signerSetTx := k.CreateSignerSetTx(ctx, chainID)
+
+	msgServer := NewMsgServerImpl(k)
+	confirm := func(ctx sdk.Context, i int, gravityID string) error {
+		ethPrivKey, err := ethCrypto.GenerateKey()
+		require.NoError(t, err)
+		ethAddr := ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey)
+		k.setValidatorEthereumAddress(ctx, valAddrs[i], ethAddr)
+
+		signature, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gravityID)), ethPrivKey)
+		require.NoError(t, err)
+		confirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: ethAddr.Hex(),
+			Signature:      signature,
+		})
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: confirmation,
+			Signer:       orcAddrs[i].String(),
+		})
+		return err
+	}
+
+	proposal := types.NewGravityIDRotationProposal("rotate", "rotate the gravity id", 0, "rotatedgravityid", 10)
+	require.NoError(t, k.HandleGravityIDRotationProposal(ctx, proposal))
+	require.Equal(t, "rotatedgravityid", k.GetParams(ctx).GravityId)
+	rotation, found := k.GetGravityIDRotation(ctx, chainID)
+	require.True(t, found)
+	require.Equal(t, types.GravityIDRotation{PreviousGravityId: previous, EndHeight: uint64(ctx.BlockHeight()) + 10}, rotation)
+
+	// another rotation has to wait for the acceptance window to end, and the previous
+	// id can't be taken by another chain in the meantime
+	proposal.GravityId = "othergravityid"
+	require.Error(t, k.HandleGravityIDRotationProposal(ctx, proposal))
+	reused := testEVMChain
+	reused.GravityId = previous
+	require.Error(t, k.AddEVMChain(ctx, reused))
+
+	// both ids are accepted within the window
+	require.NoError(t, confirm(ctx, 0, previous))
+	require.NoError(t, confirm(ctx, 1, "rotatedgravityid"))
+
+	// once it ends only the new id is
+	ctx = ctx.WithBlockHeight(int64(rotation.EndHeight) + 1)
+	k.PruneGravityIDRotation(ctx, chainID)
+	_, found = k.GetGravityIDRotation(ctx, chainID)
+	require.False(t, found)
+	require.Error(t, confirm(ctx, 2, previous))
+	require.NoError(t, confirm(ctx, 2, "rotatedgravityid"))
+
+	// the new id must not be in use by any chain
+	require.NoError(t, k.AddEVMChain(ctx, testEVMChain))
+	proposal.GravityId = testEVMChain.GravityId
+	require.Error(t, k.HandleGravityIDRotationProposal(ctx, proposal))
+	proposal.GravityId = "othergravityid"
+	proposal.EvmChainId = testEVMChain.ChainId
+	proposal.AcceptanceWindow = 0
+	require.NoError(t, k.HandleGravityIDRotationProposal(ctx, proposal))
+	chain, _ := k.GetEVMChain(ctx, testEVMChain.ChainId)
+	require.Equal(t, "othergravityid", chain.GravityId)
+	_, found = k.GetGravityIDRotation(ctx, testEVMChain.ChainId)
+	require.False(t, found)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// runQuery runs the query handler on its own branch of the store, see queryContext, with a gas
+// meter limited to gasLimit, the store reads of queries are otherwise unmetered. A query running
+// out of gas fails with ResourceExhausted. The queries run by a tx or a block, such as those of
+// the interchain query packets, keep the gas meter of their context so their reads are charged
+// to the tx, and running out of gas aborts the tx as any other message would.
+func runQuery(ctx context.Context, req interface{}, handler grpc.UnaryHandler, gasLimit sdk.Gas) (res interface{}, err error) {
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	if !ok {
+		return handler(ctx, req)
+	}
+	if !isQueryContext(sdkCtx) {
+		return handler(sdk.WrapSDKContext(queryContext(sdkCtx, sdkCtx.GasMeter())), req)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, status.Errorf(codes.ResourceExhausted, "query out of gas in %s, limit %d", outOfGas.Descriptor, gasLimit)
+		}
+	}()
+	return handler(sdk.WrapSDKContext(queryContext(sdkCtx, sdk.NewGasMeter(gasLimit))), req)
+}
+
+// isQueryContext returns whether ctx is that of a gRPC or ABCI query, which the base app
+// creates on the check state without tx bytes, rather than that of a tx, its simulation or a
+// block
+func isQueryContext(ctx sdk.Context) bool {
+	return ctx.IsCheckTx() && len(ctx.TxBytes()) == 0
+}
+
+// queryContext returns the context of a query, reading a cache branch of the store of ctx that
+// is never written back, so the concurrent queries served from the same state neither see nor
+// race with the writes of one another, and with the gas meter and a fresh event manager
+func queryContext(ctx sdk.Context, gasMeter sdk.GasMeter) sdk.Context {
+	return ctx.
+		WithMultiStore(ctx.MultiStore().CacheMultiStore()).
+		WithGasMeter(gasMeter).
+		WithEventManager(sdk.NewEventManager())
+}
+
+// checkPageRequest refuses the page requests over types.MaxPageLimit
+func checkPageRequest(pageReq *query.PageRequest) error {
+	if pageReq != nil && pageReq.Limit > types.MaxPageLimit {
+		return status.Errorf(codes.InvalidArgument, "page limit %d exceeds the maximum of %d", pageReq.Limit, types.MaxPageLimit)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestRunQuery(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	k := env.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		tokenContract = EthAddrs[0]
+		sender        = AccAddrs[0]
+		vouchers      = sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(tokenContract), 1000))
+	)
+	require.NoError(t, env.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	env.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, env.BankKeeper, sender, vouchers))
+	env.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, EthAddrs[1], 2, 3, 4)
+	txCtx := ctx
+	// the base app creates the contexts of the queries on its check state
+	ctx = ctx.WithIsCheckTx(true)
+
+	unbatched := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return k.UnbatchedSendToEthereums(ctx, req.(*types.UnbatchedSendToEthereumsRequest))
+	}
+	req := &types.UnbatchedSendToEthereumsRequest{EvmChainId: chainID, SenderAddress: sender.String()}
+
+	res, err := runQuery(sdk.WrapSDKContext(ctx), req, unbatched, types.QueryGasLimit)
+	require.NoError(t, err)
+	require.Len(t, res.(*types.UnbatchedSendToEthereumsResponse).SendToEthereums, 3)
+
+	// reading the pool over the limit aborts the query
+	_, err = runQuery(sdk.WrapSDKContext(ctx), req, unbatched, 1000)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the queries of a tx are charged to its gas meter, and running out of it aborts the tx
+	txCtx = txCtx.WithGasMeter(sdk.NewGasMeter(types.QueryGasLimit))
+	res, err = runQuery(sdk.WrapSDKContext(txCtx), req, unbatched, 1000)
+	require.NoError(t, err)
+	require.Len(t, res.(*types.UnbatchedSendToEthereumsResponse).SendToEthereums, 3)
+	require.Greater(t, txCtx.GasMeter().GasConsumed(), uint64(1000))
+	func() {
+		defer func() {
+			require.IsType(t, sdk.ErrorOutOfGas{}, recover())
+		}()
+		_, _ = runQuery(sdk.WrapSDKContext(txCtx.WithGasMeter(sdk.NewGasMeter(1000))), req, unbatched, types.QueryGasLimit)
+	}()
+
+	// other panics are left to the server
+	require.Panics(t, func() {
+		_, _ = runQuery(sdk.WrapSDKContext(ctx), req, func(context.Context, interface{}) (interface{}, error) {
+			panic("boom")
+		}, types.QueryGasLimit)
+	})
+
+	// pages over the maximum are refused
+	req.Pagination = &query.PageRequest{Limit: types.MaxPageLimit + 1}
+	_, err = k.UnbatchedSendToEthereums(sdk.WrapSDKContext(ctx), req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.BatchTxs(sdk.WrapSDKContext(ctx), &types.BatchTxsRequest{EvmChainId: chainID, Pagination: req.Pagination})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRunQueryBranch(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	k := env.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		tokenContract = EthAddrs[0]
+		sender        = AccAddrs[0]
+		vouchers      = sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(tokenContract), 1000))
+	)
+	require.NoError(t, env.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	env.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, env.BankKeeper, sender, vouchers))
+	env.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, EthAddrs[1], 2, 3, 4)
+	ctx = ctx.WithIsCheckTx(true)
+
+	// the writes of a query stay in its branch
+	key := []byte("query")
+	_, err := runQuery(sdk.WrapSDKContext(ctx), nil, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Set(key, []byte{1})
+		return nil, nil
+	}, types.QueryGasLimit)
+	require.NoError(t, err)
+	require.False(t, ctx.KVStore(k.storeKey).Has(key))
+
+	// concurrent queries read the same state
+	req := &types.UnbatchedSendToEthereumsRequest{EvmChainId: chainID, SenderAddress: sender.String()}
+	unbatched := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return k.UnbatchedSendToEthereums(ctx, req.(*types.UnbatchedSendToEthereumsRequest))
+	}
+	var (
+		wg     sync.WaitGroup
+		counts = make([]int, 16)
+		errs   = make([]error, len(counts))
+	)
+	for i := range counts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := runQuery(sdk.WrapSDKContext(ctx), req, unbatched, types.QueryGasLimit)
+			if errs[i] = err; err == nil {
+				counts[i] = len(res.(*types.UnbatchedSendToEthereumsResponse).SendToEthereums)
+			}
+		}(i)
+	}
+	wg.Wait()
+	for i := range counts {
+		require.NoError(t, errs[i])
+		require.Equal(t, 3, counts[i])
+	}
+}
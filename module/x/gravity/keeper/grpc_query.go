@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -18,14 +19,21 @@ import (
 var _ types.QueryServer = Keeper{}
 
 func (k Keeper) Params(c context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
-	params := k.GetParams(sdk.UnwrapSDKContext(c))
-	return &types.ParamsResponse{Params: params}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.ParamsResponse{
+		Params:          k.GetParams(ctx),
+		ScheduledUpdate: k.GetScheduledParamsUpdate(ctx),
+	}, nil
 }
 
 func (k Keeper) LatestSignerSetTx(c context.Context, req *types.LatestSignerSetTxRequest) (*types.SignerSetTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.OutgoingTxKey}, types.SignerSetTxPrefixByte))
+	store := prefix.NewStore(k.chainStore(ctx, chainID), append([]byte{types.OutgoingTxKey}, types.SignerSetTxPrefixByte))
 	iter := store.ReverseIterator(nil, nil)
 	defer iter.Close()
 
@@ -50,8 +58,12 @@ func (k Keeper) LatestSignerSetTx(c context.Context, req *types.LatestSignerSetT
 func (k Keeper) SignerSetTx(c context.Context, req *types.SignerSetTxRequest) (*types.SignerSetTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	key := types.MakeSignerSetTxKey(req.SignerSetNonce)
-	otx := k.GetOutgoingTx(ctx, key)
+	otx := k.GetOutgoingTx(ctx, chainID, key)
 	if otx == nil {
 		return &types.SignerSetTxResponse{}, nil
 	}
@@ -69,10 +81,16 @@ func (k Keeper) BatchTx(c context.Context, req *types.BatchTxRequest) (*types.Ba
 		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
 	}
 
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	res := &types.BatchTxResponse{}
 
 	key := types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
-	otx := k.GetOutgoingTx(sdk.UnwrapSDKContext(c), key)
+	otx := k.GetOutgoingTx(ctx, chainID, key)
 	if otx == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "no batch tx found for %d %s", req.BatchNonce, req.TokenContract)
 	}
@@ -86,8 +104,14 @@ func (k Keeper) BatchTx(c context.Context, req *types.BatchTxRequest) (*types.Ba
 }
 
 func (k Keeper) ContractCallTx(c context.Context, req *types.ContractCallTxRequest) (*types.ContractCallTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	key := types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce)
-	otx := k.GetOutgoingTx(sdk.UnwrapSDKContext(c), key)
+	otx := k.GetOutgoingTx(ctx, chainID, key)
 	if otx == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "no contract call found for %d %s", req.InvalidationNonce, req.InvalidationScope)
 	}
@@ -101,8 +125,14 @@ func (k Keeper) ContractCallTx(c context.Context, req *types.ContractCallTxReque
 }
 
 func (k Keeper) SignerSetTxs(c context.Context, req *types.SignerSetTxsRequest) (*types.SignerSetTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	var signers []*types.SignerSetTx
-	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
+	pageRes, err := k.PaginateOutgoingTxsByType(ctx, chainID, req.Pagination, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
 		signer, ok := otx.(*types.SignerSetTx)
 		if !ok {
 			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to signer set for %s", otx))
@@ -119,8 +149,14 @@ func (k Keeper) SignerSetTxs(c context.Context, req *types.SignerSetTxsRequest)
 }
 
 func (k Keeper) BatchTxs(c context.Context, req *types.BatchTxsRequest) (*types.BatchTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	var batches []*types.BatchTx
-	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
+	pageRes, err := k.PaginateOutgoingTxsByType(ctx, chainID, req.Pagination, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
 		batch, ok := otx.(*types.BatchTx)
 		if !ok {
 			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to batch tx for %s", otx))
@@ -136,8 +172,14 @@ func (k Keeper) BatchTxs(c context.Context, req *types.BatchTxsRequest) (*types.
 }
 
 func (k Keeper) ContractCallTxs(c context.Context, req *types.ContractCallTxsRequest) (*types.ContractCallTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	var calls []*types.ContractCallTx
-	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
+	pageRes, err := k.PaginateOutgoingTxsByType(ctx, chainID, req.Pagination, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
 		call, ok := otx.(*types.ContractCallTx)
 		if !ok {
 			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to contract call for %s", otx))
@@ -154,10 +196,14 @@ func (k Keeper) ContractCallTxs(c context.Context, req *types.ContractCallTxsReq
 
 func (k Keeper) SignerSetTxConfirmations(c context.Context, req *types.SignerSetTxConfirmationsRequest) (*types.SignerSetTxConfirmationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	key := types.MakeSignerSetTxKey(req.SignerSetNonce)
 
 	var out []*types.SignerSetTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	k.iterateEthereumSignatures(ctx, chainID, key, func(val sdk.ValAddress, sig []byte) bool {
 		out = append(out, &types.SignerSetTxConfirmation{
 			SignerSetNonce: req.SignerSetNonce,
 			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
@@ -171,10 +217,14 @@ func (k Keeper) SignerSetTxConfirmations(c context.Context, req *types.SignerSet
 
 func (k Keeper) BatchTxConfirmations(c context.Context, req *types.BatchTxConfirmationsRequest) (*types.BatchTxConfirmationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	key := types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
 
 	var out []*types.BatchTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	k.iterateEthereumSignatures(ctx, chainID, key, func(val sdk.ValAddress, sig []byte) bool {
 		out = append(out, &types.BatchTxConfirmation{
 			TokenContract:  req.TokenContract,
 			BatchNonce:     req.BatchNonce,
@@ -188,10 +238,14 @@ func (k Keeper) BatchTxConfirmations(c context.Context, req *types.BatchTxConfir
 
 func (k Keeper) ContractCallTxConfirmations(c context.Context, req *types.ContractCallTxConfirmationsRequest) (*types.ContractCallTxConfirmationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	key := types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce)
 
 	var out []*types.ContractCallTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	k.iterateEthereumSignatures(ctx, chainID, key, func(val sdk.ValAddress, sig []byte) bool {
 		out = append(out, &types.ContractCallTxConfirmation{
 			InvalidationScope: req.InvalidationScope,
 			InvalidationNonce: req.InvalidationNonce,
@@ -205,13 +259,17 @@ func (k Keeper) ContractCallTxConfirmations(c context.Context, req *types.Contra
 
 func (k Keeper) UnsignedSignerSetTxs(c context.Context, req *types.UnsignedSignerSetTxsRequest) (*types.UnsignedSignerSetTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	val, err := k.getSignerValidator(ctx, req.Address)
 	if err != nil {
 		return nil, err
 	}
 	var signerSets []*types.SignerSetTx
-	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
-		sig := k.getEthereumSignature(ctx, otx.GetStoreIndex(), val)
+	k.IterateOutgoingTxsByType(ctx, chainID, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		sig := k.getEthereumSignature(ctx, chainID, otx.GetStoreIndex(), val)
 		if len(sig) == 0 { // it's pending
 			signerSet, ok := otx.(*types.SignerSetTx)
 			if !ok {
@@ -226,14 +284,18 @@ func (k Keeper) UnsignedSignerSetTxs(c context.Context, req *types.UnsignedSigne
 
 func (k Keeper) UnsignedBatchTxs(c context.Context, req *types.UnsignedBatchTxsRequest) (*types.UnsignedBatchTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	val, err := k.getSignerValidator(ctx, req.Address)
 	if err != nil {
 		return nil, err
 	}
 	var batches []*types.BatchTx
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
-		sig := k.getEthereumSignature(ctx, otx.GetStoreIndex(), val)
-		if len(sig) == 0 { // it's pending
+	k.IterateOutgoingTxsByType(ctx, chainID, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		sig := k.getEthereumSignature(ctx, chainID, otx.GetStoreIndex(), val)
+		if len(sig) == 0 && !k.inVetoDelay(ctx, otx) { // it's pending
 			batch, ok := otx.(*types.BatchTx)
 			if !ok {
 				panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to batch tx for %s", otx))
@@ -247,14 +309,18 @@ func (k Keeper) UnsignedBatchTxs(c context.Context, req *types.UnsignedBatchTxsR
 
 func (k Keeper) UnsignedContractCallTxs(c context.Context, req *types.UnsignedContractCallTxsRequest) (*types.UnsignedContractCallTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	val, err := k.getSignerValidator(ctx, req.Address)
 	if err != nil {
 		return nil, err
 	}
 	var calls []*types.ContractCallTx
-	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
-		sig := k.getEthereumSignature(ctx, otx.GetStoreIndex(), val)
-		if len(sig) == 0 { // it's pending
+	k.IterateOutgoingTxsByType(ctx, chainID, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		sig := k.getEthereumSignature(ctx, chainID, otx.GetStoreIndex(), val)
+		if len(sig) == 0 && !k.inVetoDelay(ctx, otx) { // it's pending
 			call, ok := otx.(*types.ContractCallTx)
 			if !ok {
 				panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to contract call for %s", otx))
@@ -268,29 +334,38 @@ func (k Keeper) UnsignedContractCallTxs(c context.Context, req *types.UnsignedCo
 
 func (k Keeper) LastSubmittedEthereumEvent(c context.Context, req *types.LastSubmittedEthereumEventRequest) (*types.LastSubmittedEthereumEventResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	valAddr, err := k.getSignerValidator(ctx, req.Address)
 	if err != nil {
 		return nil, err
 	}
 
 	res := &types.LastSubmittedEthereumEventResponse{
-		EventNonce: k.getLastEventNonceByValidator(ctx, valAddr),
+		EventNonce: k.getLastEventNonceByValidator(ctx, chainID, valAddr),
 	}
 	return res, nil
 }
 
 func (k Keeper) BatchTxFees(c context.Context, req *types.BatchTxFeesRequest) (*types.BatchTxFeesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	res := &types.BatchTxFeesResponse{}
+	chain, _ := k.GetEVMChain(ctx, chainID)
 
 	// TODO: is this what we want here?
 	// Should this calculation return a
 	// map[contract_address]fees or something similar?
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
+	k.IterateOutgoingTxsByType(ctx, chainID, types.BatchTxPrefixByte, func(key []byte, otx types.OutgoingTx) bool {
 		btx, _ := otx.(*types.BatchTx)
 		for _, tx := range btx.Transactions {
-			_, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(tx.Erc20Fee.Contract))
-			res.Fees = append(res.Fees, sdk.NewCoin(denom, tx.Erc20Fee.Amount))
+			_, denom := k.ERC20ToDenomLookup(ctx, chainID, common.HexToAddress(tx.Erc20Fee.Contract))
+			res.Fees = append(res.Fees, sdk.NewCoin(denom, chain.DenomAmount(denom, tx.Erc20Fee.Amount)))
 		}
 		return false
 	})
@@ -300,23 +375,36 @@ func (k Keeper) BatchTxFees(c context.Context, req *types.BatchTxFeesRequest) (*
 
 func (k Keeper) ERC20ToDenom(c context.Context, req *types.ERC20ToDenomRequest) (*types.ERC20ToDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(req.Erc20))
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, chainID, common.HexToAddress(req.Erc20))
 	res := &types.ERC20ToDenomResponse{
 		Denom:            denom,
 		CosmosOriginated: cosmosOriginated,
 	}
+	if trace, found := k.ibcDenomTrace(ctx, denom); found {
+		res.IbcPath, res.IbcBaseDenom = trace.Path, trace.BaseDenom
+	}
 	return res, nil
 }
 
 func (k Keeper) DenomToERC20Params(c context.Context, req *types.DenomToERC20ParamsRequest) (*types.DenomToERC20ParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, req.Denom); exists {
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, chainID, req.Denom); exists {
 		return nil, sdkerrors.Wrapf(
 			types.ErrInvalidERC20Event,
 			"ERC20 token %s already exists for denom %s", existingERC20.Hex(), req.Denom,
 		)
 	}
 
+	trace, isIBCVoucher := k.ibcDenomTrace(ctx, req.Denom)
+
 	// use metadata, if we can find it
 	if md, ok := k.bankKeeper.GetDenomMetaData(ctx, req.Denom); ok && md.Base != "" {
 		var erc20Decimals uint64
@@ -332,6 +420,8 @@ func (k Keeper) DenomToERC20Params(c context.Context, req *types.DenomToERC20Par
 			Erc20Name:     md.Display,
 			Erc20Symbol:   md.Display,
 			Erc20Decimals: erc20Decimals,
+			IbcPath:       trace.Path,
+			IbcBaseDenom:  trace.BaseDenom,
 		}, nil
 	}
 
@@ -342,6 +432,19 @@ func (k Keeper) DenomToERC20Params(c context.Context, req *types.DenomToERC20Par
 		)
 	}
 
+	// no metadata on an IBC voucher, name the erc-20 after its path and base denom
+	// rather than its hash
+	if isIBCVoucher {
+		return &types.DenomToERC20ParamsResponse{
+			BaseDenom:     req.Denom,
+			Erc20Name:     trace.GetFullDenomPath(),
+			Erc20Symbol:   trace.BaseDenom,
+			Erc20Decimals: 0,
+			IbcPath:       trace.Path,
+			IbcBaseDenom:  trace.BaseDenom,
+		}, nil
+	}
+
 	// no metadata, go with a zero decimal, no symbol erc-20
 	res := &types.DenomToERC20ParamsResponse{
 		BaseDenom:     req.Denom,
@@ -355,7 +458,11 @@ func (k Keeper) DenomToERC20Params(c context.Context, req *types.DenomToERC20Par
 
 func (k Keeper) DenomToERC20(c context.Context, req *types.DenomToERC20Request) (*types.DenomToERC20Response, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, req.Denom)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, chainID, req.Denom)
 	if err != nil {
 		return nil, err
 	}
@@ -363,14 +470,21 @@ func (k Keeper) DenomToERC20(c context.Context, req *types.DenomToERC20Request)
 		Erc20:            erc20.Hex(),
 		CosmosOriginated: cosmosOriginated,
 	}
+	if trace, found := k.ibcDenomTrace(ctx, req.Denom); found {
+		res.IbcPath, res.IbcBaseDenom = trace.Path, trace.BaseDenom
+	}
 	return res, nil
 }
 
 func (k Keeper) BatchedSendToEthereums(c context.Context, req *types.BatchedSendToEthereumsRequest) (*types.BatchedSendToEthereumsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	res := &types.BatchedSendToEthereumsResponse{}
 
-	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, outgoing types.OutgoingTx) bool {
+	k.IterateOutgoingTxsByType(ctx, chainID, types.BatchTxPrefixByte, func(_ []byte, outgoing types.OutgoingTx) bool {
 		batchTx := outgoing.(*types.BatchTx)
 		for _, ste := range batchTx.Transactions {
 			if ste.Sender == req.SenderAddress {
@@ -386,9 +500,16 @@ func (k Keeper) BatchedSendToEthereums(c context.Context, req *types.BatchedSend
 
 func (k Keeper) UnbatchedSendToEthereums(c context.Context, req *types.UnbatchedSendToEthereumsRequest) (*types.UnbatchedSendToEthereumsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	res := &types.UnbatchedSendToEthereumsResponse{}
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumKey})
+	if err := checkPageRequest(req.Pagination); err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.SendToEthereumKey})
 	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var ste types.SendToEthereum
 		k.cdc.MustUnmarshal(value, &ste)
@@ -463,7 +584,11 @@ func (k Keeper) DelegateKeys(c context.Context, req *types.DelegateKeysRequest)
 
 func (k Keeper) LastObservedEthereumHeight(c context.Context, req *types.LastObservedEthereumHeightRequest) (*types.LastObservedEthereumHeightResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	lastObservedEthereumHeight := k.GetLastObservedEthereumBlockHeight(ctx)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	lastObservedEthereumHeight := k.GetLastObservedEthereumBlockHeight(ctx, chainID)
 
 	res := &types.LastObservedEthereumHeightResponse{
 		LastObservedEthereumHeight: &lastObservedEthereumHeight,
@@ -471,3 +596,293 @@ func (k Keeper) LastObservedEthereumHeight(c context.Context, req *types.LastObs
 
 	return res, nil
 }
+
+func (k Keeper) EVMChains(c context.Context, req *types.EVMChainsRequest) (*types.EVMChainsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var chains []types.EVMChainStatus
+	for _, chain := range k.GetEVMChains(ctx) {
+		status := types.EVMChainStatus{
+			Chain:                      chain,
+			LastObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx, chain.ChainId).EthereumHeight,
+			LastObservedEventNonce:     k.GetLastObservedEventNonce(ctx, chain.ChainId),
+			Paused:                     k.IsEVMChainPaused(ctx, chain.ChainId),
+			Migrating:                  k.isMigrating(ctx, chain.ChainId),
+		}
+		if rotation, found := k.GetGravityIDRotation(ctx, chain.ChainId); found {
+			status.GravityIdRotation = &rotation
+		}
+		chains = append(chains, status)
+	}
+
+	return &types.EVMChainsResponse{Chains: chains}, nil
+}
+
+func (k Keeper) BridgeContract(c context.Context, req *types.BridgeContractRequest) (*types.BridgeContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.BridgeContractResponse{
+		BridgeContract:  k.GetBridgeContract(ctx, chainID),
+		ContractVersion: k.GetContractVersion(ctx, chainID),
+	}
+	if migration, found := k.GetContractMigration(ctx, chainID); found {
+		res.PendingMigration = &migration
+	}
+
+	return res, nil
+}
+
+func (k Keeper) DepositAddress(c context.Context, req *types.DepositAddressRequest) (*types.DepositAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient %s", req.Recipient)
+	}
+
+	res := &types.DepositAddressResponse{}
+	if depositAddress, found := k.GetDepositAddress(ctx, chainID, recipient); found {
+		res.DepositAddress = depositAddress.Hex()
+	}
+	if derived, err := k.deriveDepositAddress(ctx, chainID, recipient); err == nil {
+		res.DerivedDepositAddress = derived.Hex()
+	}
+
+	return res, nil
+}
+
+func (k Keeper) ERC1155BatchTxs(c context.Context, req *types.ERC1155BatchTxsRequest) (*types.ERC1155BatchTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	var batches []*types.ERC1155BatchTx
+	pageRes, err := k.PaginateOutgoingTxsByType(ctx, chainID, req.Pagination, types.ERC1155BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
+		batch, ok := otx.(*types.ERC1155BatchTx)
+		if !ok {
+			panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to erc1155 batch tx for %s", otx))
+		}
+		batches = append(batches, batch)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ERC1155BatchTxsResponse{Batches: batches, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ERC1155BatchTxConfirmations(c context.Context, req *types.ERC1155BatchTxConfirmationsRequest) (*types.ERC1155BatchTxConfirmationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	key := types.MakeERC1155BatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
+
+	var out []*types.ERC1155BatchTxConfirmation
+	k.iterateEthereumSignatures(ctx, chainID, key, func(val sdk.ValAddress, sig []byte) bool {
+		out = append(out, &types.ERC1155BatchTxConfirmation{
+			TokenContract:  req.TokenContract,
+			BatchNonce:     req.BatchNonce,
+			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
+			Signature:      sig,
+		})
+		return false
+	})
+	return &types.ERC1155BatchTxConfirmationsResponse{Signatures: out}, nil
+}
+
+func (k Keeper) UnsignedERC1155BatchTxs(c context.Context, req *types.UnsignedERC1155BatchTxsRequest) (*types.UnsignedERC1155BatchTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	val, err := k.getSignerValidator(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	var batches []*types.ERC1155BatchTx
+	k.IterateOutgoingTxsByType(ctx, chainID, types.ERC1155BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		sig := k.getEthereumSignature(ctx, chainID, otx.GetStoreIndex(), val)
+		if len(sig) == 0 { // it's pending
+			batch, ok := otx.(*types.ERC1155BatchTx)
+			if !ok {
+				panic(sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to erc1155 batch tx for %s", otx))
+			}
+			batches = append(batches, batch)
+		}
+		return false
+	})
+	return &types.UnsignedERC1155BatchTxsResponse{Batches: batches}, nil
+}
+
+func (k Keeper) ERC1155Token(c context.Context, req *types.ERC1155TokenRequest) (*types.ERC1155TokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	token, found := k.GetERC1155Token(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no erc1155 token for denom %s", req.Denom)
+	}
+	return &types.ERC1155TokenResponse{Token: &token}, nil
+}
+
+func (k Keeper) RelayerIncentives(c context.Context, req *types.RelayerIncentivesRequest) (*types.RelayerIncentivesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.RelayerIncentivesResponse{RewardAccountBalance: k.GetRelayerRewardBalance(ctx)}
+	k.IterateRelayerIncentives(ctx, func(incentive types.RelayerIncentive) bool {
+		if incentive.EvmChainId == chainID {
+			res.Incentives = append(res.Incentives, incentive)
+		}
+		return false
+	})
+
+	return res, nil
+}
+
+func (k Keeper) SimulateParamsChange(c context.Context, req *types.SimulateParamsChangeRequest) (*types.SimulateParamsChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := req.Params.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return k.simulateParamsChange(ctx, req.Params), nil
+}
+
+func (k Keeper) IncidentRecords(c context.Context, req *types.IncidentRecordsRequest) (*types.IncidentRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.IncidentRecordsResponse{}
+	k.IterateIncidentRecords(ctx, func(record types.IncidentRecord) bool {
+		res.Records = append(res.Records, record)
+		return false
+	})
+
+	return res, nil
+}
+
+func (k Keeper) BridgeReports(c context.Context, req *types.BridgeReportsRequest) (*types.BridgeReportsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.BridgeReportsResponse{}
+	k.IterateBridgeReports(ctx, func(report types.BridgeReport) bool {
+		res.Reports = append(res.Reports, report)
+		return false
+	})
+	if k.GetParams(ctx).BridgeReportPeriod != 0 {
+		current := k.GetCurrentBridgeReport(ctx)
+		res.Current = &current
+	}
+
+	return res, nil
+}
+
+func (k Keeper) BridgeStateHash(c context.Context, req *types.BridgeStateHashRequest) (*types.BridgeStateHashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the state is read at the end of the block the hash was committed to at
+	state := k.GetBridgeState(ctx)
+	return &types.BridgeStateHashResponse{
+		Hash:  k.GetBridgeStateHash(ctx),
+		State: &state,
+	}, nil
+}
+
+func (k Keeper) TransferHistory(c context.Context, req *types.TransferHistoryRequest) (*types.TransferHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.TransferHistoryResponse{}
+
+	if err := checkPageRequest(req.Pagination); err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.EthereumEventVoteRecordKey})
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var record types.EthereumEventVoteRecord
+		k.cdc.MustUnmarshal(value, &record)
+		transfer, ok := k.transferRecord(chainID, &record)
+		if !ok {
+			return false, nil
+		}
+		if accumulate {
+			res.Records = append(res.Records, transfer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+func (k Keeper) AttestationLatency(c context.Context, req *types.AttestationLatencyRequest) (*types.AttestationLatencyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AttestationLatencyResponse{Latency: k.GetAttestationLatency(ctx, chainID)}, nil
+}
+
+func (k Keeper) RelayCalldata(c context.Context, req *types.RelayCalldataRequest) (*types.RelayCalldataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	otx := k.GetOutgoingTx(ctx, chainID, req.StoreIndex)
+	if otx == nil {
+		return nil, status.Errorf(codes.NotFound, "no outgoing tx found for store index %x", req.StoreIndex)
+	}
+	calldata, err := k.relayCalldata(ctx, chainID, otx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RelayCalldataResponse{
+		ContractAddress: k.getBridgeContractAddress(ctx, chainID),
+		Calldata:        calldata,
+	}, nil
+}
+
+func (k Keeper) TypedData(c context.Context, req *types.TypedDataRequest) (*types.TypedDataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, req.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	otx := k.GetOutgoingTx(ctx, chainID, req.StoreIndex)
+	if otx == nil {
+		return nil, status.Errorf(codes.NotFound, "no outgoing tx found for store index %x", req.StoreIndex)
+	}
+	gravityID := k.acceptedGravityIDs(ctx, chainID)[0]
+	typedData, err := json.Marshal(otx.GetTypedData([]byte(gravityID), chainID))
+	if err != nil {
+		return nil, err
+	}
+	_, accepted := k.acceptedSigningPayloads(ctx, chainID)
+	return &types.TypedDataResponse{
+		TypedData: string(typedData),
+		Digest:    k.outgoingTxTypedDataDigest(otx, gravityID, chainID),
+		Accepted:  accepted,
+	}, nil
+}
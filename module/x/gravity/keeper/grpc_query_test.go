@@ -36,7 +36,7 @@ func TestKeeper_LatestSignerSetTx(t *testing.T) {
 		ctx := env.Context
 		gk := env.GravityKeeper
 		{ // setup
-			sstx := gk.CreateSignerSetTx(env.Context)
+			sstx := gk.CreateSignerSetTx(env.Context, TestingGravityParams.BridgeChainId)
 			require.NotNil(t, sstx)
 		}
 		{ // validate
@@ -56,7 +56,7 @@ func TestKeeper_SignerSetTx(t *testing.T) {
 
 		var signerSetNonce uint64
 		{ // setup
-			sstx := gk.CreateSignerSetTx(env.Context)
+			sstx := gk.CreateSignerSetTx(env.Context, TestingGravityParams.BridgeChainId)
 			require.NotNil(t, sstx)
 			signerSetNonce = sstx.Nonce
 		}
@@ -82,7 +82,7 @@ func TestKeeper_BatchTx(t *testing.T) {
 		)
 
 		{ // setup
-			gk.SetOutgoingTx(ctx, &types.BatchTx{
+			gk.SetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, &types.BatchTx{
 				BatchNonce:    batchNonce,
 				Timeout:       1000,
 				Transactions:  nil,
@@ -116,7 +116,7 @@ func TestKeeper_ContractCallTx(t *testing.T) {
 		)
 
 		{ // setup
-			gk.SetOutgoingTx(ctx, &types.ContractCallTx{
+			gk.SetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, &types.ContractCallTx{
 				InvalidationNonce: invalidationNonce,
 				InvalidationScope: bytes.HexBytes(invalidationScope),
 			})
@@ -142,8 +142,8 @@ func TestKeeper_SignerSetTxs(t *testing.T) {
 		gk := env.GravityKeeper
 
 		{ // setup
-			require.NotNil(t, gk.CreateSignerSetTx(env.Context))
-			require.NotNil(t, gk.CreateSignerSetTx(env.Context))
+			require.NotNil(t, gk.CreateSignerSetTx(env.Context, TestingGravityParams.BridgeChainId))
+			require.NotNil(t, gk.CreateSignerSetTx(env.Context, TestingGravityParams.BridgeChainId))
 		}
 		{ // validate
 			req := &types.SignerSetTxsRequest{}
@@ -162,14 +162,14 @@ func TestKeeper_BatchTxs(t *testing.T) {
 		gk := env.GravityKeeper
 
 		{ // setup
-			gk.SetOutgoingTx(ctx, &types.BatchTx{
+			gk.SetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, &types.BatchTx{
 				BatchNonce:    1000,
 				Timeout:       1000,
 				Transactions:  nil,
 				TokenContract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4",
 				Height:        1000,
 			})
-			gk.SetOutgoingTx(ctx, &types.BatchTx{
+			gk.SetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, &types.BatchTx{
 				BatchNonce:    1001,
 				Timeout:       1000,
 				Transactions:  nil,
@@ -194,12 +194,12 @@ func TestKeeper_ContractCallTxs(t *testing.T) {
 		gk := env.GravityKeeper
 
 		{ // setup
-			gk.SetOutgoingTx(ctx, &types.ContractCallTx{
+			gk.SetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, &types.ContractCallTx{
 				InvalidationNonce: 5,
 				InvalidationScope: []byte("an-invalidation-scope"),
 				// TODO
 			})
-			gk.SetOutgoingTx(ctx, &types.ContractCallTx{
+			gk.SetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, &types.ContractCallTx{
 				InvalidationNonce: 6,
 				InvalidationScope: []byte("an-invalidation-scope"),
 			})
@@ -234,3 +234,60 @@ func TestKeeper_ContractCallTxs(t *testing.T) {
 // DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
 // DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
 // DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+
+func TestKeeper_SimulateParamsChange(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	height := uint64(ctx.BlockHeight())
+
+	batch := &types.BatchTx{
+		BatchNonce:    1,
+		Timeout:       1000,
+		TokenContract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4",
+		Height:        height + 5,
+	}
+	gk.SetOutgoingTx(ctx, chainID, batch)
+	for i, val := range ValAddrs[1:] {
+		gk.SetEthereumSignature(ctx, chainID, &types.BatchTxConfirmation{
+			TokenContract:  batch.TokenContract,
+			BatchNonce:     batch.BatchNonce,
+			EthereumSigner: EthAddrs[i+1].Hex(),
+			Signature:      []byte("dummysig"),
+		}, val)
+	}
+	ctx = ctx.WithBlockHeight(int64(height) + 20)
+	params := gk.GetParams(ctx)
+	params.SignedBatchesWindow = 20
+	gk.setParams(ctx, params)
+
+	simulate := func(params types.Params) *types.SimulateParamsChangeResponse {
+		res, err := gk.SimulateParamsChange(sdk.WrapSDKContext(ctx), &types.SimulateParamsChangeRequest{Params: params})
+		require.NoError(t, err)
+		return res
+	}
+
+	// unchanged params have no effect, the batch is still within the signing window
+	res := simulate(gk.GetParams(ctx))
+	require.Empty(t, res.InvalidatedBatches)
+	require.Empty(t, res.SlashableValidators)
+
+	// a shorter window makes the validator that didn't sign slashable
+	params.SignedBatchesWindow = 10
+	res = simulate(params)
+	require.Equal(t, []string{ValAddrs[0].String()}, res.SlashableValidators)
+
+	// a new gravity id invalidates the signatures of pending batches
+	params.GravityId = "newgravityid"
+	res = simulate(params)
+	require.Len(t, res.InvalidatedBatches, 1)
+	require.Equal(t, batch.BatchNonce, res.InvalidatedBatches[0].BatchNonce)
+
+	// nothing changed in the meantime
+	require.Equal(t, TestingGravityParams.GravityId, gk.GetParams(ctx).GravityId)
+
+	// invalid params can't be simulated
+	params.TargetEthTxTimeout = 1
+	_, err := gk.SimulateParamsChange(sdk.WrapSDKContext(ctx), &types.SimulateParamsChangeRequest{Params: params})
+	require.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// telemetryServer registers services with the telemetry interceptor applied to their methods,
+// ahead of the interceptors of the server they are served from
+type telemetryServer struct {
+	gogogrpc.Server
+}
+
+// NewTelemetryServer returns the server registering services with server, recording the latency
+// and errors of each call to their methods, see types.MeasureQuery. The calls are traced in the
+// debug logs, and each runs on its own branch of the store with its gas limited to
+// types.QueryGasLimit, see runQuery.
+func NewTelemetryServer(server gogogrpc.Server) gogogrpc.Server {
+	return telemetryServer{server}
+}
+
+func (s telemetryServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		desc.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return handler(srv, ctx, dec, chainTelemetryInterceptor(interceptor))
+			},
+		}
+	}
+	s.Server.RegisterService(&desc, ss)
+}
+
+// chainTelemetryInterceptor runs the telemetry interceptor inside the interceptor of the server,
+// which is nil for the queries routed over ABCI and sets the sdk.Context for the gRPC server
+func chainTelemetryInterceptor(interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if interceptor == nil {
+		return telemetryInterceptor
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return telemetryInterceptor(ctx, req, info, handler)
+		})
+	}
+}
+
+func telemetryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	start := time.Now()
+	res, err := runQuery(ctx, req, handler, types.QueryGasLimit)
+	types.MeasureQuery(method, start, err)
+
+	if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+		sdkCtx.Logger().With("module", "x/"+types.ModuleName).Debug("query", "method", method, "height", sdkCtx.BlockHeight(), "duration", time.Since(start), "error", err)
+	}
+	return res, err
+}
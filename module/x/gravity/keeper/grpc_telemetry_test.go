@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestTelemetryServer(t *testing.T) {
+	env := CreateTestEnv(t)
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	_, err := metrics.NewGlobal(&metrics.Config{ServiceName: "test", TimerGranularity: time.Millisecond, FilterDefault: true}, sink)
+	require.NoError(t, err)
+
+	router := baseapp.NewGRPCQueryRouter()
+	types.RegisterQueryServer(NewTelemetryServer(router), env.GravityKeeper)
+
+	query := func(method string, req interface{ Marshal() ([]byte, error) }) error {
+		data, err := req.Marshal()
+		require.NoError(t, err)
+		_, err = router.Route("/gravity.v1.Query/"+method)(env.Context, abci.RequestQuery{Data: data})
+		return err
+	}
+	require.NoError(t, query("Params", &types.ParamsRequest{}))
+	require.ErrorIs(t, query("AttestationLatency", &types.AttestationLatencyRequest{EvmChainId: 999}), types.ErrUnknownEVMChain)
+
+	intervals := sink.Data()
+	require.NotEmpty(t, intervals)
+	counters := intervals[0].Counters
+	require.Equal(t, 1, counters["test.gravity.queries;method=Params"].Count)
+	require.Equal(t, 1, counters["test.gravity.queries;method=AttestationLatency"].Count)
+	require.Equal(t, 1, counters["test.gravity.query_errors;method=AttestationLatency;code=gravity:13"].Count)
+	require.Contains(t, intervals[0].Samples, "test.gravity.query_latency;method=Params")
+}
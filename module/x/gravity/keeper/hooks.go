@@ -39,35 +39,70 @@ func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAd
 func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 }
 
+// VoterPowerHooks flag the validators whose power may change for UpdateVoterPowers, on the
+// changes of their delegations, their slashing and their bonding or unbonding, jailing
+// included. Unlike Hooks they have no other effect. They hold a pointer to the keeper so that
+// they can be set on the staking keeper before the gravity keeper is created.
+type VoterPowerHooks struct {
+	k *Keeper
+}
+
+var _ stakingtypes.StakingHooks = VoterPowerHooks{}
+
+// VoterPowerHooks returns the staking hooks keeping the powers of the voter sets up to date
+func (k *Keeper) VoterPowerHooks() VoterPowerHooks { return VoterPowerHooks{k} }
+
+func (h VoterPowerHooks) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.flagVoterPowerUpdate(ctx, valAddr)
+}
+func (h VoterPowerHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.flagVoterPowerUpdate(ctx, valAddr)
+}
+func (h VoterPowerHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, _ sdk.Dec) {
+	h.k.flagVoterPowerUpdate(ctx, valAddr)
+}
+func (h VoterPowerHooks) AfterDelegationModified(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.k.flagVoterPowerUpdate(ctx, valAddr)
+}
+func (h VoterPowerHooks) BeforeDelegationRemoved(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.k.flagVoterPowerUpdate(ctx, valAddr)
+}
+func (h VoterPowerHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress)                     {}
+func (h VoterPowerHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                   {}
+func (h VoterPowerHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
+func (h VoterPowerHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+func (h VoterPowerHooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {
+}
+
 var _ types.GravityHooks = Keeper{}
 
-func (k Keeper) AfterContractCallExecutedEvent(ctx sdk.Context, event types.ContractCallExecutedEvent) {
+func (k Keeper) AfterContractCallExecutedEvent(ctx sdk.Context, chainID uint64, event types.ContractCallExecutedEvent) {
 	if k.hooks != nil {
-		k.hooks.AfterContractCallExecutedEvent(ctx, event)
+		k.hooks.AfterContractCallExecutedEvent(ctx, chainID, event)
 	}
 }
 
-func (k Keeper) AfterERC20DeployedEvent(ctx sdk.Context, event types.ERC20DeployedEvent) {
+func (k Keeper) AfterERC20DeployedEvent(ctx sdk.Context, chainID uint64, event types.ERC20DeployedEvent) {
 	if k.hooks != nil {
-		k.hooks.AfterERC20DeployedEvent(ctx, event)
+		k.hooks.AfterERC20DeployedEvent(ctx, chainID, event)
 	}
 }
 
-func (k Keeper) AfterSignerSetExecutedEvent(ctx sdk.Context, event types.SignerSetTxExecutedEvent) {
+func (k Keeper) AfterSignerSetExecutedEvent(ctx sdk.Context, chainID uint64, event types.SignerSetTxExecutedEvent) {
 	if k.hooks != nil {
-		k.hooks.AfterSignerSetExecutedEvent(ctx, event)
+		k.hooks.AfterSignerSetExecutedEvent(ctx, chainID, event)
 	}
 }
 
-func (k Keeper) AfterBatchExecutedEvent(ctx sdk.Context, event types.BatchExecutedEvent) {
+func (k Keeper) AfterBatchExecutedEvent(ctx sdk.Context, chainID uint64, event types.BatchExecutedEvent) {
 	if k.hooks != nil {
-		k.hooks.AfterBatchExecutedEvent(ctx, event)
+		k.hooks.AfterBatchExecutedEvent(ctx, chainID, event)
 	}
 }
 
-func (k Keeper) AfterSendToCosmosEvent(ctx sdk.Context, event types.SendToCosmosEvent) {
+func (k Keeper) AfterSendToCosmosEvent(ctx sdk.Context, chainID uint64, event types.SendToCosmosEvent) {
 	if k.hooks != nil {
-		k.hooks.AfterSendToCosmosEvent(ctx, event)
+		k.hooks.AfterSendToCosmosEvent(ctx, chainID, event)
 	}
 }
 
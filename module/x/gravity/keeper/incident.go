@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetIncidentRecord returns the incident record with the id
+func (k Keeper) GetIncidentRecord(ctx sdk.Context, id uint64) (types.IncidentRecord, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeIncidentRecordKey(id))
+	if bz == nil {
+		return types.IncidentRecord{}, false
+	}
+	var record types.IncidentRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+func (k Keeper) setIncidentRecord(ctx sdk.Context, record types.IncidentRecord) {
+	ctx.KVStore(k.storeKey).Set(types.MakeIncidentRecordKey(record.Id), k.cdc.MustMarshal(&record))
+}
+
+// IterateIncidentRecords iterates over the incident log by id
+func (k Keeper) IterateIncidentRecords(ctx sdk.Context, cb func(types.IncidentRecord) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.IncidentRecordKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.IncidentRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getLastIncidentRecordID(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastIncidentRecordIDKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setLastIncidentRecordID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastIncidentRecordIDKey}, sdk.Uint64ToBigEndian(id))
+}
+
+// appendIncidentRecord adds the record of an action to the incident log under the next id.
+// Records are only ever added, never updated or removed.
+func (k Keeper) appendIncidentRecord(ctx sdk.Context, action types.IncidentAction, authority, account string, amount sdk.Coins, reason string) types.IncidentRecord {
+	id := k.getLastIncidentRecordID(ctx) + 1
+	k.setLastIncidentRecordID(ctx, id)
+
+	record := types.IncidentRecord{
+		Id:        id,
+		Action:    action,
+		Authority: authority,
+		Account:   account,
+		Amount:    amount,
+		Reason:    reason,
+		Height:    uint64(ctx.BlockHeight()),
+	}
+	k.setIncidentRecord(ctx, record)
+	k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.IncidentRecords++ })
+	return record
+}
+
+// checkRegisteredVouchers returns an error if the coins hold the vouchers of an ERC1155 token
+// id never bridged, whose denoms can't be traced back to the token
+func (k Keeper) checkRegisteredVouchers(ctx sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if !types.IsERC1155Denom(coin.Denom) {
+			continue
+		}
+		if _, found := k.GetERC1155Token(ctx, coin.Denom); !found {
+			return sdkerrors.Wrapf(types.ErrUnknownDenom, "unknown erc1155 voucher denom %s", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// mintVouchers mints bridged vouchers to the recipient and records it in the incident log
+func (k Keeper) mintVouchers(ctx sdk.Context, authority string, recipient sdk.AccAddress, amount sdk.Coins, reason string) (types.IncidentRecord, error) {
+	if err := k.checkRegisteredVouchers(ctx, amount); err != nil {
+		return types.IncidentRecord{}, err
+	}
+	if err := k.issueVouchers(ctx, amount); err != nil {
+		return types.IncidentRecord{}, sdkerrors.Wrapf(err, "mint vouchers %s", amount)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return types.IncidentRecord{}, sdkerrors.Wrap(err, "transfer minted vouchers")
+	}
+
+	record := k.appendIncidentRecord(ctx, types.IncidentActionMint, authority, recipient.String(), amount, reason)
+	k.emitIncidentEvent(ctx, types.EventTypeVouchersMinted, record)
+	return record, nil
+}
+
+// burnVouchers burns bridged vouchers held by the holder and records it in the incident log
+func (k Keeper) burnVouchers(ctx sdk.Context, authority string, holder sdk.AccAddress, amount sdk.Coins, reason string) (types.IncidentRecord, error) {
+	if err := k.checkRegisteredVouchers(ctx, amount); err != nil {
+		return types.IncidentRecord{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, amount); err != nil {
+		return types.IncidentRecord{}, sdkerrors.Wrap(err, "transfer vouchers to burn")
+	}
+	if err := k.retireVouchers(ctx, amount); err != nil {
+		return types.IncidentRecord{}, sdkerrors.Wrapf(err, "burn vouchers %s", amount)
+	}
+
+	record := k.appendIncidentRecord(ctx, types.IncidentActionBurn, authority, holder.String(), amount, reason)
+	k.emitIncidentEvent(ctx, types.EventTypeVouchersBurned, record)
+	return record, nil
+}
+
+func (k Keeper) emitIncidentEvent(ctx sdk.Context, eventType string, record types.IncidentRecord) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyIncidentID, strconv.FormatUint(record.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyAuthority, record.Authority),
+		sdk.NewAttribute(types.AttributeKeyAccount, record.Account),
+		sdk.NewAttribute(types.AttributeKeyAmount, record.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, record.Reason),
+	))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestIncidentRecovery(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	msgServer := NewMsgServerImpl(k)
+	authority, err := sdk.AccAddressFromBech32(k.GetAuthority())
+	require.NoError(t, err)
+
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(EthAddrs[0]), 100))
+	balance := func() sdk.Int {
+		return input.BankKeeper.GetBalance(ctx, AccAddrs[1], types.GravityDenom(EthAddrs[0])).Amount
+	}
+
+	// only the authority may mint or burn vouchers
+	_, err = msgServer.MintVouchers(sdk.WrapSDKContext(ctx), types.NewMsgMintVouchers(AccAddrs[0], AccAddrs[1], vouchers, "reimburse"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// and only bridged vouchers, for a reason
+	_, err = msgServer.MintVouchers(sdk.WrapSDKContext(ctx), types.NewMsgMintVouchers(authority, AccAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), "reimburse"))
+	require.ErrorIs(t, err, types.ErrInvalid)
+	_, err = msgServer.MintVouchers(sdk.WrapSDKContext(ctx), types.NewMsgMintVouchers(authority, AccAddrs[1], vouchers, " "))
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// erc1155 vouchers must be of a token id bridged before
+	unknown := types.NewERC1155Token(TestingGravityParams.BridgeChainId, EthAddrs[0], sdk.NewInt(1)).Denom()
+	_, err = msgServer.MintVouchers(sdk.WrapSDKContext(ctx), types.NewMsgMintVouchers(authority, AccAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(unknown, 1)), "reimburse"))
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	res, err := msgServer.MintVouchers(sdk.WrapSDKContext(ctx), types.NewMsgMintVouchers(authority, AccAddrs[1], vouchers, "reimburse"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.IncidentId)
+	require.Equal(t, sdk.NewInt(100), balance())
+
+	// burning more than the holder has fails without being recorded
+	_, err = msgServer.BurnVouchers(sdk.WrapSDKContext(ctx), types.NewMsgBurnVouchers(authority, AccAddrs[1], vouchers.Add(vouchers...), "claw back"))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	proposal := types.NewIncidentRecoveryProposal("burn", "claw back", types.IncidentActionBurn, AccAddrs[1].String(), sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(EthAddrs[0]), 40)), "double mint")
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, k.HandleIncidentRecoveryProposal(ctx, proposal))
+	require.Equal(t, sdk.NewInt(60), balance())
+
+	// both actions are in the incident log in order
+	records, err := k.IncidentRecords(sdk.WrapSDKContext(ctx), &types.IncidentRecordsRequest{})
+	require.NoError(t, err)
+	require.Len(t, records.Records, 2)
+	require.Equal(t, types.IncidentRecord{
+		Id:        1,
+		Action:    types.IncidentActionMint,
+		Authority: authority.String(),
+		Account:   AccAddrs[1].String(),
+		Amount:    vouchers,
+		Reason:    "reimburse",
+		Height:    uint64(ctx.BlockHeight()),
+	}, records.Records[0])
+	require.Equal(t, types.IncidentActionBurn, records.Records[1].Action)
+	require.Equal(t, "double mint", records.Records[1].Reason)
+
+	// the log is exported with the genesis state
+	genesis := ExportGenesis(ctx, k)
+	require.NoError(t, genesis.ValidateBasic())
+	require.Equal(t, records.Records, genesis.IncidentRecords)
+}
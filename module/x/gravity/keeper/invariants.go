@@ -0,0 +1,304 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keycodec"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// RegisterInvariants registers the gravity module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "evm-chain-escrow", EVMChainEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "forward-channel-escrow", ForwardChannelEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "relayer-reward-account", RelayerRewardAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-aggregates", PoolAggregatesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "voucher-supply", VoucherSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "event-nonces", EventNoncesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "batched-sends", BatchedSendsInvariant(k))
+}
+
+// EVMChainEscrowInvariant checks that the escrow of each EVM chain holds the cosmos originated
+// coins of the sends, batches and contract calls still waiting to be executed on the chain
+func EVMChainEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		for _, chain := range k.GetEVMChains(ctx) {
+			pending := k.pendingEscrowedCoins(ctx, chain)
+			escrowed := k.GetEscrowedCoins(ctx, chain.ChainId)
+			if !escrowed.IsAllGTE(pending) {
+				broken = true
+				msg += fmt.Sprintf("\tescrow of chain id %d holds %s, less than the %s pending\n", chain.ChainId, escrowed, pending)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "evm-chain-escrow",
+			fmt.Sprintf("escrows holding less than their pending outgoing coins\n%s", msg)), broken
+	}
+}
+
+// pendingEscrowedCoins returns the cosmos originated coins locked by the outgoing sends and
+// refundable contract calls of the chain
+func (k Keeper) pendingEscrowedCoins(ctx sdk.Context, chain types.EVMChain) sdk.Coins {
+	pending := sdk.NewCoins()
+	add := func(tokens ...types.ERC20Token) {
+		for _, token := range tokens {
+			if isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, chain.ChainId, common.HexToAddress(token.Contract)); isCosmosOriginated {
+				pending = pending.Add(sdk.NewCoin(denom, chain.DenomAmount(denom, token.Amount)))
+			}
+		}
+	}
+
+	k.IterateUnbatchedSendToEthereums(ctx, chain.ChainId, func(ste *types.SendToEthereum) bool {
+		add(ste.Erc20Token, ste.Erc20Fee)
+		return false
+	})
+	k.IterateOutgoingTxsByType(ctx, chain.ChainId, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		for _, ste := range otx.(*types.BatchTx).Transactions {
+			add(ste.Erc20Token, ste.Erc20Fee)
+		}
+		return false
+	})
+	k.IterateOutgoingTxsByType(ctx, chain.ChainId, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		// only contract calls made from transfers lock coins, governance calls spend tokens
+		// already on the chain
+		if cctx := otx.(*types.ContractCallTx); cctx.RefundAddress != "" {
+			add(cctx.Tokens...)
+			add(cctx.Fees...)
+		}
+		return false
+	})
+	return pending
+}
+
+// ForwardChannelEscrowInvariant checks that the transfer escrow of each channel deposits are
+// forwarded over holds the coins of the forwarded deposits still in flight. Returning IBC
+// vouchers are burned rather than escrowed, and aren't counted.
+func ForwardChannelEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		inFlight := map[string]sdk.Coins{}
+		k.IterateForwardedDeposits(ctx, func(deposit types.ForwardedDeposit) bool {
+			if !strings.HasPrefix(deposit.Amount.Denom, ibctransfertypes.DenomPrefix+"/") {
+				inFlight[deposit.ChannelId] = inFlight[deposit.ChannelId].Add(deposit.Amount)
+			}
+			return false
+		})
+
+		channels := make([]string, 0, len(inFlight))
+		for channelID := range inFlight {
+			channels = append(channels, channelID)
+		}
+		sort.Strings(channels)
+
+		var (
+			msg    string
+			broken bool
+		)
+		for _, channelID := range channels {
+			escrowed := k.bankKeeper.GetAllBalances(ctx, ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, channelID))
+			if !escrowed.IsAllGTE(inFlight[channelID]) {
+				broken = true
+				msg += fmt.Sprintf("\tescrow of channel %s holds %s, less than the %s in flight\n", channelID, escrowed, inFlight[channelID])
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "forward-channel-escrow",
+			fmt.Sprintf("channel escrows holding less than their forwarded deposits\n%s", msg)), broken
+	}
+}
+
+// RelayerRewardAccountInvariant checks that the relayer reward account holds what the relayer
+// incentives have left to disburse
+func RelayerRewardAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		remaining := sdk.NewCoins()
+		k.IterateRelayerIncentives(ctx, func(incentive types.RelayerIncentive) bool {
+			remaining = remaining.Add(incentive.Amount.Sub(incentive.Disbursed)...)
+			return false
+		})
+
+		balance := k.GetRelayerRewardBalance(ctx)
+		broken := !balance.IsAllGTE(remaining)
+		return sdk.FormatInvariant(types.ModuleName, "relayer-reward-account",
+			fmt.Sprintf("relayer reward account holds %s, the incentives have %s left to disburse\n", balance, remaining)), broken
+	}
+}
+
+// PoolAggregatesInvariant checks that the pool aggregates of each EVM chain count the
+// unbatched transfers of their token and total their fees
+func PoolAggregatesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		for _, chain := range k.GetEVMChains(ctx) {
+			expected := map[common.Address]types.PoolAggregate{}
+			k.IterateUnbatchedSendToEthereums(ctx, chain.ChainId, func(ste *types.SendToEthereum) bool {
+				contract := common.HexToAddress(ste.Erc20Fee.Contract)
+				aggregate, ok := expected[contract]
+				if !ok {
+					aggregate.TotalFees = sdk.ZeroInt()
+				}
+				aggregate.Count++
+				aggregate.TotalFees = aggregate.TotalFees.Add(ste.Erc20Fee.Amount)
+				expected[contract] = aggregate
+				return false
+			})
+			expectedERC1155 := map[common.Address]uint64{}
+			k.IterateUnbatchedSendERC1155ToEthereums(ctx, chain.ChainId, func(send *types.SendERC1155ToEthereum) bool {
+				expectedERC1155[common.HexToAddress(send.TokenContract)]++
+				return false
+			})
+
+			k.IteratePoolAggregates(ctx, chain.ChainId, func(contract common.Address, aggregate types.PoolAggregate) bool {
+				if want, ok := expected[contract]; !ok || want.Count != aggregate.Count || !want.TotalFees.Equal(aggregate.TotalFees) {
+					broken = true
+					msg += fmt.Sprintf("\taggregate of %s on chain id %d is %d transfers and %s fees, the pool holds %d and %s\n",
+						contract.Hex(), chain.ChainId, aggregate.Count, aggregate.TotalFees, want.Count, want.TotalFees)
+				}
+				delete(expected, contract)
+				return false
+			})
+			k.IterateERC1155PoolAggregates(ctx, chain.ChainId, func(contract common.Address, aggregate types.PoolAggregate) bool {
+				if want := expectedERC1155[contract]; want != aggregate.Count {
+					broken = true
+					msg += fmt.Sprintf("\tERC1155 aggregate of %s on chain id %d is %d transfers, the pool holds %d\n",
+						contract.Hex(), chain.ChainId, aggregate.Count, want)
+				}
+				delete(expectedERC1155, contract)
+				return false
+			})
+			if len(expected) > 0 || len(expectedERC1155) > 0 {
+				broken = true
+				msg += fmt.Sprintf("\t%d ERC20 and %d ERC1155 tokens pooled on chain id %d have no aggregate\n",
+					len(expected), len(expectedERC1155), chain.ChainId)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "pool-aggregates",
+			fmt.Sprintf("pool aggregates not matching the pools\n%s", msg)), broken
+	}
+}
+
+// VoucherSupplyInvariant checks that the supply of each voucher denom is no more than what
+// the bridge issued of it, the vouchers minted for deposits and refunds less those burned by
+// withdrawals. Vouchers burned outside of the bridge, by another module, leave the supply
+// below the issuance and keep the contract over-collateralized, only those minted outside of
+// it break the invariant
+func VoucherSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		issued := map[string]bool{}
+		k.IterateVoucherIssuance(ctx, func(denom string, amount sdk.Int) bool {
+			issued[denom] = true
+			if supply := k.bankKeeper.GetSupply(ctx, denom); supply.Amount.GT(amount) {
+				broken = true
+				msg += fmt.Sprintf("\tsupply of %s is %s, the bridge only issued %s\n", denom, supply.Amount, amount)
+			}
+			return false
+		})
+		k.bankKeeper.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+			if types.IsVoucherDenom(supply.Denom) && !issued[supply.Denom] {
+				broken = true
+				msg += fmt.Sprintf("\tsupply of %s is %s, the bridge issued none\n", supply.Denom, supply.Amount)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "voucher-supply",
+			fmt.Sprintf("voucher supplies above their issuance\n%s", msg)), broken
+	}
+}
+
+// EventNoncesInvariant checks that the event nonces of each EVM chain only moved forward: no
+// event above the last observed nonce was accepted or rejected, and no nonce was decided twice
+func EventNoncesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		for _, chain := range k.GetEVMChains(ctx) {
+			lastEventNonce := k.GetLastObservedEventNonce(ctx, chain.ChainId)
+			decided := map[uint64]bool{}
+			k.iterateEthereumEventVoteRecords(ctx, chain.ChainId, func(key []byte, record *types.EthereumEventVoteRecord) bool {
+				if !record.Accepted && !record.Rejected {
+					return false
+				}
+				nonce := keycodec.NewReader(key).Uint64()
+				switch {
+				case nonce > lastEventNonce:
+					broken = true
+					msg += fmt.Sprintf("\tevent at nonce %d of chain id %d is decided, above the last observed nonce %d\n", nonce, chain.ChainId, lastEventNonce)
+				case decided[nonce]:
+					broken = true
+					msg += fmt.Sprintf("\tnonce %d of chain id %d has more than one decided event\n", nonce, chain.ChainId)
+				}
+				decided[nonce] = true
+				return false
+			})
+		}
+		return sdk.FormatInvariant(types.ModuleName, "event-nonces",
+			fmt.Sprintf("event nonces not moving forward\n%s", msg)), broken
+	}
+}
+
+// BatchedSendsInvariant checks that the batches of each EVM chain only hold sends taken from
+// its pools: sends with ids already assigned, each in a single pool or batch
+func BatchedSendsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		lastID := k.getLastSendToEthereumID(ctx)
+		seen := map[uint64]bool{}
+		check := func(chainID uint64, holder string, id uint64) {
+			switch {
+			case id == 0 || id > lastID:
+				broken = true
+				msg += fmt.Sprintf("\t%s of chain id %d holds send id %d, never assigned from the pool\n", holder, chainID, id)
+			case seen[id]:
+				broken = true
+				msg += fmt.Sprintf("\t%s of chain id %d holds send id %d, held elsewhere too\n", holder, chainID, id)
+			}
+			seen[id] = true
+		}
+
+		for _, chain := range k.GetEVMChains(ctx) {
+			k.IterateUnbatchedSendToEthereums(ctx, chain.ChainId, func(ste *types.SendToEthereum) bool {
+				check(chain.ChainId, "pool", ste.Id)
+				return false
+			})
+			k.IterateUnbatchedSendERC1155ToEthereums(ctx, chain.ChainId, func(send *types.SendERC1155ToEthereum) bool {
+				check(chain.ChainId, "ERC1155 pool", send.Id)
+				return false
+			})
+			k.IterateOutgoingTxsByType(ctx, chain.ChainId, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+				batch := otx.(*types.BatchTx)
+				for _, ste := range batch.Transactions {
+					check(chain.ChainId, fmt.Sprintf("batch %d", batch.BatchNonce), ste.Id)
+				}
+				return false
+			})
+			k.IterateOutgoingTxsByType(ctx, chain.ChainId, types.ERC1155BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+				batch := otx.(*types.ERC1155BatchTx)
+				for _, send := range batch.Transactions {
+					check(chain.ChainId, fmt.Sprintf("ERC1155 batch %d", batch.BatchNonce), send.Id)
+				}
+				return false
+			})
+		}
+		return sdk.FormatInvariant(types.ModuleName, "batched-sends",
+			fmt.Sprintf("batches holding sends missing from the pools\n%s", msg)), broken
+	}
+}
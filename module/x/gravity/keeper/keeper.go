@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -17,9 +16,12 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keycodec"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
@@ -27,33 +29,46 @@ import (
 type Keeper struct {
 	StakingKeeper          types.StakingKeeper
 	storeKey               sdk.StoreKey
+	transientKey           sdk.StoreKey
 	paramSpace             paramtypes.Subspace
 	cdc                    codec.Codec
 	accountKeeper          types.AccountKeeper
 	bankKeeper             types.BankKeeper
 	SlashingKeeper         types.SlashingKeeper
 	DistributionKeeper     types.DistributionKeeper
+	transferKeeper         types.TransferKeeper
 	PowerReduction         sdk.Int
 	hooks                  types.GravityHooks
 	ReceiverModuleAccounts map[string]string
 	SenderModuleAccounts   map[string]string
+
+	// the address allowed to update the params, the governance module account unless
+	// configured otherwise
+	authority string
+
+	// the memoized checkpoints of the outgoing txs, see outgoingTxCheckpoint
+	checkpoints *lru.Cache
 }
 
 // NewKeeper returns a new instance of the gravity keeper
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey sdk.StoreKey,
+	transientKey sdk.StoreKey,
 	paramSpace paramtypes.Subspace,
 	accKeeper types.AccountKeeper,
 	stakingKeeper types.StakingKeeper,
 	bankKeeper types.BankKeeper,
 	slashingKeeper types.SlashingKeeper,
 	distributionKeeper types.DistributionKeeper,
+	transferKeeper types.TransferKeeper,
 	powerReduction sdk.Int,
 	receiverModuleAccounts map[string]string,
 	senderModuleAccounts map[string]string,
+	authority string,
 ) Keeper {
-	// set KeyTable if it has not already been set
+	// set KeyTable if it has not already been set, the params subspace is only read by
+	// the store migrations of the consensus versions that kept the params in it
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
@@ -62,14 +77,18 @@ func NewKeeper(
 		cdc:                    cdc,
 		paramSpace:             paramSpace,
 		storeKey:               storeKey,
+		transientKey:           transientKey,
 		accountKeeper:          accKeeper,
 		StakingKeeper:          stakingKeeper,
 		bankKeeper:             bankKeeper,
 		SlashingKeeper:         slashingKeeper,
 		DistributionKeeper:     distributionKeeper,
+		transferKeeper:         transferKeeper,
 		PowerReduction:         powerReduction,
 		ReceiverModuleAccounts: receiverModuleAccounts,
 		SenderModuleAccounts:   senderModuleAccounts,
+		authority:              authority,
+		checkpoints:            newCheckpointCache(),
 	}
 
 	return k
@@ -79,30 +98,41 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// emitTypedEvent emits the typed event of a state transition, see events.proto. Encoding
+// it only fails on a programming error.
+func emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+}
+
 /////////////////////////////
 //     SignerSetTxNonce    //
 /////////////////////////////
 
 // incrementLatestSignerSetTxNonce sets the latest valset nonce
-func (k Keeper) incrementLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
-	current := k.GetLatestSignerSetTxNonce(ctx)
-	next := current + 1
-	ctx.KVStore(k.storeKey).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(next))
+func (k Keeper) incrementLatestSignerSetTxNonce(ctx sdk.Context, chainID uint64) uint64 {
+	next := k.GetLatestSignerSetTxNonce(ctx, chainID) + 1
+	k.setLatestSignerSetTxNonce(ctx, chainID, next)
 	return next
 }
 
+func (k Keeper) setLatestSignerSetTxNonce(ctx sdk.Context, chainID uint64, nonce uint64) {
+	k.chainStore(ctx, chainID).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
+
 // GetLatestSignerSetTxNonce returns the latest valset nonce
-func (k Keeper) GetLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
-	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LatestSignerSetTxNonceKey}); bz != nil {
+func (k Keeper) GetLatestSignerSetTxNonce(ctx sdk.Context, chainID uint64) uint64 {
+	if bz := k.chainStore(ctx, chainID).Get([]byte{types.LatestSignerSetTxNonceKey}); bz != nil {
 		return binary.BigEndian.Uint64(bz)
 	}
 	return 0
 }
 
 // GetLatestSignerSetTx returns the latest validator set in state
-func (k Keeper) GetLatestSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
-	key := types.MakeSignerSetTxKey(k.GetLatestSignerSetTxNonce(ctx))
-	otx := k.GetOutgoingTx(ctx, key)
+func (k Keeper) GetLatestSignerSetTx(ctx sdk.Context, chainID uint64) *types.SignerSetTx {
+	key := types.MakeSignerSetTxKey(k.GetLatestSignerSetTxNonce(ctx, chainID))
+	otx := k.GetOutgoingTx(ctx, chainID, key)
 	out, _ := otx.(*types.SignerSetTx)
 	return out
 }
@@ -130,37 +160,98 @@ func (k Keeper) GetLastUnbondingBlockHeight(ctx sdk.Context) uint64 {
 ///////////////////////////////
 
 // getEthereumSignature returns a valset confirmation by a nonce and validator address
-func (k Keeper) getEthereumSignature(ctx sdk.Context, storeIndex []byte, validator sdk.ValAddress) []byte {
-	return ctx.KVStore(k.storeKey).Get(types.MakeEthereumSignatureKey(storeIndex, validator))
+func (k Keeper) getEthereumSignature(ctx sdk.Context, chainID uint64, storeIndex []byte, validator sdk.ValAddress) []byte {
+	store := k.chainStore(ctx, chainID)
+	bz := store.Get(types.MakeEthereumSignaturesKey(storeIndex))
+	if bz == nil {
+		return nil
+	}
+	voterSet, _ := k.GetVoterSet(ctx, chainID, sdk.BigEndianToUint64(bz))
+	if i, ok := voterSet.Index(validator); ok {
+		return store.Get(types.MakeVoterEthereumSignatureKey(storeIndex, i))
+	}
+	return store.Get(types.MakeValidatorEthereumSignatureKey(storeIndex, validator))
 }
 
-// SetEthereumSignature sets a valset confirmation
-func (k Keeper) SetEthereumSignature(ctx sdk.Context, sig types.EthereumTxConfirmation, val sdk.ValAddress) []byte {
-	key := types.MakeEthereumSignatureKey(sig.GetStoreIndex(), val)
-	ctx.KVStore(k.storeKey).Set(key, sig.GetSignature())
+// SetEthereumSignature sets a valset confirmation. The signatures of an outgoing tx are kept
+// under a common prefix, those of the validators of the voter set of the latest signer set at
+// the first signature keyed by their index in it, so that each signature is a write of its
+// own and the signatures are read in the order of the voter set by a single iterator.
+func (k Keeper) SetEthereumSignature(ctx sdk.Context, chainID uint64, sig types.EthereumTxConfirmation, val sdk.ValAddress) []byte {
+	store := k.chainStore(ctx, chainID)
+	storeIndex := sig.GetStoreIndex()
+
+	var signerSetNonce uint64
+	if bz := store.Get(types.MakeEthereumSignaturesKey(storeIndex)); bz != nil {
+		signerSetNonce = sdk.BigEndianToUint64(bz)
+	} else {
+		signerSetNonce, _ = k.latestVoterSet(ctx, chainID)
+		store.Set(types.MakeEthereumSignaturesKey(storeIndex), sdk.Uint64ToBigEndian(signerSetNonce))
+	}
+
+	key := types.MakeValidatorEthereumSignatureKey(storeIndex, val)
+	voterSet, _ := k.GetVoterSet(ctx, chainID, signerSetNonce)
+	if i, ok := voterSet.Index(val); ok {
+		key = types.MakeVoterEthereumSignatureKey(storeIndex, i)
+	}
+	store.Set(key, sig.GetSignature())
 	return key
 }
 
+// deleteEthereumSignatures deletes the signatures of an outgoing tx
+func (k Keeper) deleteEthereumSignatures(ctx sdk.Context, chainID uint64, storeIndex []byte) {
+	prefixStore := prefix.NewStore(k.chainStore(ctx, chainID), types.MakeEthereumSignaturesKey(storeIndex))
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
 // GetEthereumSignatures returns all etherum signatures for a given outgoing tx by store index
-func (k Keeper) GetEthereumSignatures(ctx sdk.Context, storeIndex []byte) map[string][]byte {
+func (k Keeper) GetEthereumSignatures(ctx sdk.Context, chainID uint64, storeIndex []byte) map[string][]byte {
 	var signatures = make(map[string][]byte)
-	k.iterateEthereumSignatures(ctx, storeIndex, func(val sdk.ValAddress, h []byte) bool {
+	k.iterateEthereumSignatures(ctx, chainID, storeIndex, func(val sdk.ValAddress, h []byte) bool {
 		signatures[val.String()] = h
 		return false
 	})
 	return signatures
 }
 
-// iterateEthereumSignatures iterates through all valset confirms by nonce in ASC order
-func (k Keeper) iterateEthereumSignatures(ctx sdk.Context, storeIndex []byte, cb func(sdk.ValAddress, []byte) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumSignatureKey}, storeIndex...))
-	iter := prefixStore.Iterator(nil, nil)
-	defer iter.Close()
+// iterateEthereumSignatures iterates through the signatures of an outgoing tx, those of the
+// voters in the order of their voter set first
+func (k Keeper) iterateEthereumSignatures(ctx sdk.Context, chainID uint64, storeIndex []byte, cb func(sdk.ValAddress, []byte) bool) {
+	store := k.chainStore(ctx, chainID)
+	bz := store.Get(types.MakeEthereumSignaturesKey(storeIndex))
+	if bz == nil {
+		return
+	}
+	voterSet, _ := k.GetVoterSet(ctx, chainID, sdk.BigEndianToUint64(bz))
 
+	iter := prefix.NewStore(store, types.MakeEthereumSignaturesKey(storeIndex)).Iterator(nil, nil)
+	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
+		// the empty key is the signer set nonce
+		if len(iter.Key()) == 0 {
+			continue
+		}
+		i, val := types.ParseEthereumSignatureKey(iter.Key())
+		if i >= 0 {
+			if i >= len(voterSet.Voters) {
+				continue
+			}
+			var err error
+			if val, err = sdk.ValAddressFromBech32(voterSet.Voters[i].ValidatorAddress); err != nil {
+				panic(err)
+			}
+		}
 		// cb returns true to stop early
-		if cb(iter.Key(), iter.Value()) {
-			break
+		if cb(val, iter.Value()) {
+			return
 		}
 	}
 }
@@ -254,23 +345,34 @@ func (k Keeper) ethAddressForOrchestratorExists(ctx sdk.Context, orch sdk.AccAdd
 
 // CreateSignerSetTx gets the current signer set from the staking keeper, increments the nonce,
 // creates the signer set tx object, emits an event and sets the signer set in state
-func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
-	nonce := k.incrementLatestSignerSetTxNonce(ctx)
+func (k Keeper) CreateSignerSetTx(ctx sdk.Context, chainID uint64) *types.SignerSetTx {
+	nonce := k.incrementLatestSignerSetTxNonce(ctx, chainID)
 	currSignerSet := k.CurrentSignerSet(ctx)
 	newSignerSetTx := types.NewSignerSetTx(nonce, uint64(ctx.BlockHeight()), currSignerSet)
+	newSignerSetTx.CheckpointVersion = k.checkpointVersion(ctx)
+	k.setVoterSet(ctx, chainID, nonce, k.currentVoterSet(ctx))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeMultisigUpdateRequest,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			k.bridgeContractAttribute(ctx, chainID),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
 			sdk.NewAttribute(types.AttributeKeySignerSetNonce, fmt.Sprint(nonce)),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nonce)),
 		),
 	)
-	k.SetOutgoingTx(ctx, newSignerSetTx)
+	types.IncrMetricCounter(types.MetricKeySignerSetTxsCreated, chainID)
+	emitTypedEvent(ctx, &types.EventSignerSetTx{
+		EvmChainId: chainID,
+		Nonce:      newSignerSetTx.Nonce,
+		Height:     newSignerSetTx.Height,
+		Signers:    newSignerSetTx.Signers,
+	})
+	k.SetOutgoingTx(ctx, chainID, newSignerSetTx)
 	k.Logger(ctx).Info(
 		"SignerSetTx created",
+		"chain_id", chainID,
 		"nonce", newSignerSetTx.Nonce,
 		"height", newSignerSetTx.Height,
 		"signers", len(newSignerSetTx.Signers),
@@ -313,8 +415,8 @@ func (k Keeper) CurrentSignerSet(ctx sdk.Context) types.EthereumSigners {
 }
 
 // GetSignerSetTxs returns all the signer set txs from the store
-func (k Keeper) GetSignerSetTxs(ctx sdk.Context) (out []*types.SignerSetTx) {
-	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+func (k Keeper) GetSignerSetTxs(ctx sdk.Context, chainID uint64) (out []*types.SignerSetTx) {
+	k.IterateOutgoingTxsByType(ctx, chainID, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		sstx, _ := otx.(*types.SignerSetTx)
 		out = append(out, sstx)
 		return false
@@ -328,27 +430,61 @@ func (k Keeper) GetSignerSetTxs(ctx sdk.Context) (out []*types.SignerSetTx) {
 
 // GetParams returns the parameters from the store
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
-	return
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.ParamsKey})
+	if bz == nil {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// GetAuthority returns the address allowed to update the params
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // setParams sets the parameters in the store
 func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
-	k.paramSpace.SetParamSet(ctx, &ps)
+	ctx.KVStore(k.storeKey).Set([]byte{types.ParamsKey}, k.cdc.MustMarshal(&ps))
+
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte{types.DefaultEVMChainIDKey}) {
+		store.Set([]byte{types.DefaultEVMChainIDKey}, sdk.Uint64ToBigEndian(ps.BridgeChainId))
+	}
 }
 
-// getBridgeContractAddress returns the bridge contract address on ETH
-func (k Keeper) getBridgeContractAddress(ctx sdk.Context) string {
-	var a string
-	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractAddress, &a)
-	return a
+// UpgradeParams stores the params as changed by the update if they are still valid, for
+// upgrade handlers seeding the params added by a new version
+func (k Keeper) UpgradeParams(ctx sdk.Context, update func(*types.Params)) error {
+	params := k.GetParams(ctx)
+	update(&params)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	k.setParams(ctx, params)
+	return nil
+}
+
+// getBridgeContractAddress returns the bridge contract address on the EVM chain
+func (k Keeper) getBridgeContractAddress(ctx sdk.Context, chainID uint64) string {
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	return chain.BridgeEthereumAddress
 }
 
-// getBridgeChainID returns the chain id of the ETH chain we are running against
+// bridgeContractAttribute returns the event attribute of the bridge contract of the chain
+func (k Keeper) bridgeContractAttribute(ctx sdk.Context, chainID uint64) sdk.Attribute {
+	return types.EthereumAddressAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx, chainID))
+}
+
+// getBridgeChainID returns the chain id of the default ETH chain we are running against,
+// the state of the chain is stored under this id so it is read from the store rather
+// than the bridge_chain_id param that governance could change
 func (k Keeper) getBridgeChainID(ctx sdk.Context) uint64 {
-	var a uint64
-	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractChainID, &a)
-	return a
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.DefaultEVMChainIDKey})
+	if bz == nil {
+		return k.GetParams(ctx).BridgeChainId
+	}
+	return sdk.BigEndianToUint64(bz)
 }
 
 // getGravityID returns the GravityID the GravityID is essentially a salt value
@@ -361,10 +497,12 @@ func (k Keeper) getBridgeChainID(ctx sdk.Context) uint64 {
 // is deployed the GravityID CAN NOT BE CHANGED. Meaning that it can't just be the
 // same as the chain id since the chain id may be changed many times with each
 // successive chain in charge of the same bridge
-func (k Keeper) getGravityID(ctx sdk.Context) string {
-	var a string
-	k.paramSpace.Get(ctx, types.ParamsStoreKeyGravityID, &a)
-	return a
+//
+// Each EVM chain has its own GravityID, the one of the default chain is the
+// GravityID param.
+func (k Keeper) getGravityID(ctx sdk.Context, chainID uint64) string {
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	return chain.GravityId
 }
 
 // getDelegateKeys iterates both the EthAddress and Orchestrator address indexes to produce
@@ -382,7 +520,7 @@ func (k Keeper) getDelegateKeys(ctx sdk.Context) (out []*types.MsgDelegateKeys)
 	iter := prefix.NewStore(store, []byte{types.ValidatorEthereumAddressKey}).Iterator(nil, nil)
 	for ; iter.Valid(); iter.Next() {
 		out = append(out, &types.MsgDelegateKeys{
-			ValidatorAddress: sdk.ValAddress(iter.Key()).String(),
+			ValidatorAddress: sdk.ValAddress(keycodec.NewReader(iter.Key()).LengthPrefixed()).String(),
 			EthereumAddress:  common.BytesToAddress(iter.Value()).Hex(),
 		})
 	}
@@ -411,12 +549,12 @@ func (k Keeper) GetUnbondingvalidators(unbondingVals []byte) stakingtypes.ValAdd
 }
 
 // This gets the timeout height in Ethereum blocks for expiring old batches and contract calls.
-func (k Keeper) getTimeoutHeight(ctx sdk.Context) uint64 {
+func (k Keeper) getTimeoutHeight(ctx sdk.Context, chainID uint64) uint64 {
 	params := k.GetParams(ctx)
 	currentCosmosHeight := ctx.BlockHeight()
 	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
 	// no batch can be produced if the last Ethereum block height is not first populated by a deposit event.
-	heights := k.GetLastObservedEthereumBlockHeight(ctx)
+	heights := k.GetLastObservedEthereumBlockHeight(ctx, chainID)
 	if heights.CosmosHeight == 0 || heights.EthereumHeight == 0 {
 		return 0
 	}
@@ -435,31 +573,34 @@ func (k Keeper) getTimeoutHeight(ctx sdk.Context) uint64 {
 /////////////////
 
 // GetOutgoingTx todo: outgoingTx prefix byte
-func (k Keeper) GetOutgoingTx(ctx sdk.Context, storeIndex []byte) (out types.OutgoingTx) {
-	if err := k.cdc.UnmarshalInterface(ctx.KVStore(k.storeKey).Get(types.MakeOutgoingTxKey(storeIndex)), &out); err != nil {
+func (k Keeper) GetOutgoingTx(ctx sdk.Context, chainID uint64, storeIndex []byte) (out types.OutgoingTx) {
+	if err := k.cdc.UnmarshalInterface(k.chainStore(ctx, chainID).Get(types.MakeOutgoingTxKey(storeIndex)), &out); err != nil {
 		panic(err)
 	}
 	return out
 }
 
-func (k Keeper) SetOutgoingTx(ctx sdk.Context, outgoing types.OutgoingTx) {
+func (k Keeper) SetOutgoingTx(ctx sdk.Context, chainID uint64, outgoing types.OutgoingTx) {
 	any, err := types.PackOutgoingTx(outgoing)
 	if err != nil {
 		panic(err)
 	}
-	ctx.KVStore(k.storeKey).Set(
+	k.chainStore(ctx, chainID).Set(
 		types.MakeOutgoingTxKey(outgoing.GetStoreIndex()),
 		k.cdc.MustMarshal(any),
 	)
 }
 
 // DeleteOutgoingTx deletes a given outgoingtx
-func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, storeIndex []byte) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxKey(storeIndex))
+func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, chainID uint64, storeIndex []byte) {
+	k.chainStore(ctx, chainID).Delete(types.MakeOutgoingTxKey(storeIndex))
 }
 
-func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRequest, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte}))
+func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, chainID uint64, pageReq *query.PageRequest, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
+	if err := checkPageRequest(pageReq); err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(k.chainStore(ctx, chainID), types.MakeOutgoingTxKey([]byte{prefixByte}))
 
 	return query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		if !accumulate {
@@ -481,8 +622,8 @@ func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRe
 }
 
 // IterateOutgoingTxsByType iterates over a specific type of outgoing transaction denoted by the chosen prefix byte
-func (k Keeper) IterateOutgoingTxsByType(ctx sdk.Context, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) (stop bool)) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte}))
+func (k Keeper) IterateOutgoingTxsByType(ctx sdk.Context, chainID uint64, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) (stop bool)) {
+	prefixStore := prefix.NewStore(k.chainStore(ctx, chainID), types.MakeOutgoingTxKey([]byte{prefixByte}))
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -499,8 +640,8 @@ func (k Keeper) IterateOutgoingTxsByType(ctx sdk.Context, prefixByte byte, cb fu
 }
 
 // iterateOutgoingTxs iterates over a specific type of outgoing transaction denoted by the chosen prefix byte
-func (k Keeper) iterateOutgoingTxs(ctx sdk.Context, cb func(key []byte, outgoing types.OutgoingTx) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxKey})
+func (k Keeper) iterateOutgoingTxs(ctx sdk.Context, chainID uint64, cb func(key []byte, outgoing types.OutgoingTx) bool) {
+	prefixStore := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.OutgoingTxKey})
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -517,9 +658,9 @@ func (k Keeper) iterateOutgoingTxs(ctx sdk.Context, cb func(key []byte, outgoing
 }
 
 // GetLastObservedSignerSetTx retrieves the last observed validator set from the store
-func (k Keeper) GetLastObservedSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
+func (k Keeper) GetLastObservedSignerSetTx(ctx sdk.Context, chainID uint64) *types.SignerSetTx {
 	key := []byte{types.LastObservedSignerSetKey}
-	if val := ctx.KVStore(k.storeKey).Get(key); val != nil {
+	if val := k.chainStore(ctx, chainID).Get(key); val != nil {
 		var out types.SignerSetTx
 		k.cdc.MustUnmarshal(val, &out)
 		return &out
@@ -528,14 +669,21 @@ func (k Keeper) GetLastObservedSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 }
 
 // setLastObservedSignerSetTx updates the last observed validator set in the stor e
-func (k Keeper) setLastObservedSignerSetTx(ctx sdk.Context, signerSet types.SignerSetTx) {
+func (k Keeper) setLastObservedSignerSetTx(ctx sdk.Context, chainID uint64, signerSet types.SignerSetTx) {
 	key := []byte{types.LastObservedSignerSetKey}
-	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&signerSet))
+	k.chainStore(ctx, chainID).Set(key, k.cdc.MustMarshal(&signerSet))
 }
 
 // CreateContractCallTx xxx
-func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64, invalidationScope tmbytes.HexBytes,
+// No contract call is created while the chain is paused or migrating to a new contract,
+// or while its contract is older than the minimum version for contract calls, nil is
+// returned instead.
+func (k Keeper) CreateContractCallTx(ctx sdk.Context, chainID uint64, invalidationNonce uint64, invalidationScope tmbytes.HexBytes,
 	address common.Address, payload []byte, tokens []types.ERC20Token, fees []types.ERC20Token) *types.ContractCallTx {
+	if k.outgoingTxsPaused(ctx, chainID) || !k.contractSupports(ctx, chainID, types.ContractFeatureContractCalls) {
+		return nil
+	}
+
 	params := k.GetParams(ctx)
 
 	newContractCallTx := &types.ContractCallTx{
@@ -543,10 +691,11 @@ func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64,
 		InvalidationScope: invalidationScope,
 		Address:           address.String(),
 		Payload:           payload,
-		Timeout:           k.getTimeoutHeight(ctx),
+		Timeout:           k.getTimeoutHeight(ctx, chainID),
 		Tokens:            tokens,
 		Fees:              fees,
 		Height:            uint64(ctx.BlockHeight()),
+		CheckpointVersion: k.checkpointVersion(ctx),
 	}
 
 	var tokenString []string
@@ -563,22 +712,31 @@ func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64,
 		sdk.NewEvent(
 			types.EventTypeMultisigUpdateRequest,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
+			k.bridgeContractAttribute(ctx, chainID),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
 			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationNonce, fmt.Sprint(invalidationNonce)),
 			sdk.NewAttribute(types.AttributeKeyContractCallInvalidationScope, fmt.Sprint(invalidationScope)),
-			sdk.NewAttribute(types.AttributeKeyContractCallAddress, fmt.Sprint(address.String())),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(invalidationNonce)),
+			types.EthereumAddressAttribute(types.AttributeKeyContractCallAddress, address.Hex()),
 			sdk.NewAttribute(types.AttributeKeyContractCallPayload, string(payload)),
 			sdk.NewAttribute(types.AttributeKeyContractCallTokens, strings.Join(tokenString, "|")),
 			sdk.NewAttribute(types.AttributeKeyContractCallFees, strings.Join(feeString, "|")),
 			sdk.NewAttribute(types.AttributeKeyEthTxTimeout, strconv.FormatUint(params.TargetEthTxTimeout, 10)),
 		),
 	)
-	k.SetOutgoingTx(ctx, newContractCallTx)
+	types.IncrMetricCounter(types.MetricKeyContractCallTxsCreated, chainID)
+	emitTypedEvent(ctx, &types.EventContractCallTx{
+		EvmChainId:        chainID,
+		InvalidationScope: invalidationScope,
+		InvalidationNonce: invalidationNonce,
+		Address:           address.String(),
+		Timeout:           newContractCallTx.Timeout,
+	})
+	k.SetOutgoingTx(ctx, chainID, newContractCallTx)
 	k.Logger(ctx).Info(
 		"ContractCallTx created",
-		"bridge_contract", k.getBridgeContractAddress(ctx),
-		"bridge_chain_id", strconv.Itoa(int(k.getBridgeChainID(ctx))),
+		"bridge_contract", k.getBridgeContractAddress(ctx, chainID),
+		"bridge_chain_id", strconv.Itoa(int(chainID)),
 		"invalidation_nonce", newContractCallTx.InvalidationNonce,
 		"invalidation_scope", newContractCallTx.InvalidationScope,
 		"address", address.String(),
@@ -595,8 +753,8 @@ func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64,
 //////////////////////////////////////
 
 // GetEthereumHeightVoteRecord gets the latest observed heights per validator
-func (k Keeper) GetEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress) types.LatestEthereumBlockHeight {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) GetEthereumHeightVote(ctx sdk.Context, chainID uint64, valAddress sdk.ValAddress) types.LatestEthereumBlockHeight {
+	store := k.chainStore(ctx, chainID)
 	key := types.MakeEthereumHeightVoteKey(valAddress)
 	bytes := store.Get(key)
 
@@ -613,25 +771,25 @@ func (k Keeper) GetEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress
 }
 
 // SetEthereumHeightVoteRecord sets the latest observed heights per validator
-func (k Keeper) SetEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
-	height := types.LatestEthereumBlockHeight{
+func (k Keeper) SetEthereumHeightVote(ctx sdk.Context, chainID uint64, valAddress sdk.ValAddress, ethereumHeight uint64) {
+	k.setEthereumHeightVote(ctx, chainID, valAddress, types.LatestEthereumBlockHeight{
 		EthereumHeight: ethereumHeight,
 		CosmosHeight:   uint64(ctx.BlockHeight()),
-	}
-	key := types.MakeEthereumHeightVoteKey(valAddress)
-	store.Set(key, k.cdc.MustMarshal(&height))
+	})
 }
 
-func (k Keeper) IterateEthereumHeightVotes(ctx sdk.Context, cb func(val sdk.ValAddress, height types.LatestEthereumBlockHeight) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) setEthereumHeightVote(ctx sdk.Context, chainID uint64, valAddress sdk.ValAddress, height types.LatestEthereumBlockHeight) {
+	k.chainStore(ctx, chainID).Set(types.MakeEthereumHeightVoteKey(valAddress), k.cdc.MustMarshal(&height))
+}
+
+func (k Keeper) IterateEthereumHeightVotes(ctx sdk.Context, chainID uint64, cb func(val sdk.ValAddress, height types.LatestEthereumBlockHeight) (stop bool)) {
+	store := k.chainStore(ctx, chainID)
 	iter := sdk.KVStorePrefixIterator(store, []byte{types.EthereumHeightVoteKey})
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
 		var height types.LatestEthereumBlockHeight
-		key := bytes.NewBuffer(bytes.TrimPrefix(iter.Key(), []byte{types.EthereumHeightVoteKey}))
-		val := sdk.ValAddress(key.Next(20))
+		val := sdk.ValAddress(keycodec.NewReader(iter.Key()[1:]).LengthPrefixed())
 
 		k.cdc.MustUnmarshal(iter.Value(), &height)
 		if cb(val, height) {
@@ -647,11 +805,12 @@ func (k Keeper) IterateEthereumHeightVotes(ctx sdk.Context, cb func(val sdk.ValA
 // Clean up all state associated a previous gravity contract and set a new contract. This is intended to run in the upgrade handler.
 // This implementation is partial at best. It doees not contain necessary functionality to freeze the bridge.
 // We will have yet to implement functionality to Migrate the Cosmos ERC20 tokens or any other ERC20 tokens bridged to the gravity contracts.
-// This just does keeper state cleanup if a new gravity contract has been deployed
-func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string, bridgeDeploymentHeight uint64) {
+// This just does keeper state cleanup if a new gravity contract has been deployed on the given EVM chain
+func (k Keeper) MigrateGravityContract(ctx sdk.Context, chainID uint64, newBridgeAddress string, bridgeDeploymentHeight uint64) {
 	// Delete Any Outgoing TXs.
+	store := k.chainStore(ctx, chainID)
 
-	prefixStoreOtx := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxKey})
+	prefixStoreOtx := prefix.NewStore(store, []byte{types.OutgoingTxKey})
 	iterOtx := prefixStoreOtx.ReverseIterator(nil, nil)
 	defer iterOtx.Close()
 	for ; iterOtx.Valid(); iterOtx.Next() {
@@ -663,45 +822,40 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 			panic(err)
 		}
 		// Delete any partial Eth Signatures handging around
-		prefixStoreSig := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumSignatureKey}, otx.GetStoreIndex()...))
-		iterSig := prefixStoreSig.Iterator(nil, nil)
-		defer iterSig.Close()
-
-		for ; iterSig.Valid(); iterSig.Next() {
-			prefixStoreSig.Delete(iterSig.Key())
-		}
+		k.deleteEthereumSignatures(ctx, chainID, otx.GetStoreIndex())
 
 		prefixStoreOtx.Delete(iterOtx.Key())
 	}
 
 	// Reset the last observed signer set nonce
-	store := ctx.KVStore(k.storeKey)
 	store.Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(0))
 
 	// Reset all ethereum event nonces to zero
-	k.setLastObservedEventNonce(ctx, 0)
-	k.iterateEthereumEventVoteRecords(ctx, func(_ []byte, voteRecord *types.EthereumEventVoteRecord) bool {
-		for _, vote := range voteRecord.Votes {
-			val, err := sdk.ValAddressFromBech32(vote)
-
-			if err != nil {
-				panic(err)
-			}
-
-			k.setLastEventNonceByValidator(ctx, val, 0)
+	k.setLastObservedEventNonce(ctx, chainID, 0)
+	k.iterateEthereumEventVoteRecords(ctx, chainID, func(_ []byte, voteRecord *types.EthereumEventVoteRecord) bool {
+		for _, val := range k.eventVoters(ctx, chainID, voteRecord) {
+			k.setLastEventNonceByValidator(ctx, chainID, val, 0)
 		}
 
 		return false
 	})
 
 	// Delete all Ethereum Events
-	prefixStoreEthereumEvent := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	prefixStoreEthereumEvent := prefix.NewStore(store, []byte{types.EthereumEventVoteRecordKey})
 	iterEvent := prefixStoreEthereumEvent.Iterator(nil, nil)
 	defer iterEvent.Close()
 	for ; iterEvent.Valid(); iterEvent.Next() {
 		prefixStoreEthereumEvent.Delete(iterEvent.Key())
 	}
 
+	// Delete the voter sets of the deleted signer sets, the nonces restarting from zero
+	prefixStoreVoterSet := prefix.NewStore(store, []byte{types.VoterSetKey})
+	iterVoterSet := prefixStoreVoterSet.Iterator(nil, nil)
+	defer iterVoterSet.Close()
+	for ; iterVoterSet.Valid(); iterVoterSet.Next() {
+		prefixStoreVoterSet.Delete(iterVoterSet.Key())
+	}
+
 	// Set the Last oberved Ethereum Blockheight to zero
 	height := types.LatestEthereumBlockHeight{
 		EthereumHeight: (bridgeDeploymentHeight - 1),
@@ -710,17 +864,29 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 
 	store.Set([]byte{types.LastEthereumBlockHeightKey}, k.cdc.MustMarshal(&height))
 
-	k.setLastObservedSignerSetTx(ctx, types.SignerSetTx{
+	k.setLastObservedSignerSetTx(ctx, chainID, types.SignerSetTx{
 		Nonce:   0,
 		Height:  0,
 		Signers: nil,
 	})
 
-	// Set the batch Nonce to zero
-	store.Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(0))
+	// Set the batch Nonce to zero, the nonce is shared by all EVM chains so it
+	// can only be reset while there are no others
+	if len(k.GetEVMChains(ctx)) == 1 {
+		ctx.KVStore(k.storeKey).Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(0))
+	}
+
+	// The version of the new contract is unknown until it is attested
+	k.setContractVersion(ctx, chainID, 0)
 
 	// Update the bridge contract address
-	params := k.GetParams(ctx)
-	params.BridgeEthereumAddress = newBridgeAddress
-	k.setParams(ctx, params)
+	if chainID == k.getBridgeChainID(ctx) {
+		params := k.GetParams(ctx)
+		params.BridgeEthereumAddress = newBridgeAddress
+		k.setParams(ctx, params)
+	} else {
+		chain, _ := k.GetEVMChain(ctx, chainID)
+		chain.BridgeEthereumAddress = newBridgeAddress
+		k.setEVMChain(ctx, chain)
+	}
 }
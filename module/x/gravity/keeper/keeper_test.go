@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -43,7 +44,7 @@ func TestCurrentValsetNormalization(t *testing.T) {
 				input.GravityKeeper.setValidatorEthereumAddress(ctx, cAddr, common.HexToAddress("0xf71402f886b45c134743F4c00750823Bbf5Fd045"))
 			}
 			input.GravityKeeper.StakingKeeper = NewStakingKeeperWeightedMock(operators...)
-			r := input.GravityKeeper.CreateSignerSetTx(ctx)
+			r := input.GravityKeeper.CreateSignerSetTx(ctx, TestingGravityParams.BridgeChainId)
 			assert.Equal(t, spec.expPowers, r.Signers.GetPowers())
 		})
 	}
@@ -76,11 +77,11 @@ func TestAttestationIterator(t *testing.T) {
 		EthereumSender: EthAddrs[0].String(),
 		CosmosReceiver: AccAddrs[0].String(),
 	}
-	input.GravityKeeper.setEthereumEventVoteRecord(ctx, dep1.EventNonce, dep1.Hash(), att1)
-	input.GravityKeeper.setEthereumEventVoteRecord(ctx, dep2.EventNonce, dep2.Hash(), att2)
+	input.GravityKeeper.setEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, dep1.EventNonce, dep1.Hash(), att1)
+	input.GravityKeeper.setEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, dep2.EventNonce, dep2.Hash(), att2)
 
 	var atts []*types.EthereumEventVoteRecord
-	input.GravityKeeper.iterateEthereumEventVoteRecords(ctx, func(_ []byte, att *types.EthereumEventVoteRecord) bool {
+	input.GravityKeeper.iterateEthereumEventVoteRecords(ctx, TestingGravityParams.BridgeChainId, func(_ []byte, att *types.EthereumEventVoteRecord) bool {
 		atts = append(atts, att)
 		return false
 	})
@@ -183,13 +184,13 @@ func TestStoreEventVoteRecord(t *testing.T) {
 		},
 	}
 
-	gk.setEthereumEventVoteRecord(ctx, stce.GetEventNonce(), stce.Hash(), evr)
-	gk.setEthereumEventVoteRecord(ctx, cctxe.GetEventNonce(), cctxe.Hash(), evr2)
+	gk.setEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, stce.GetEventNonce(), stce.Hash(), evr)
+	gk.setEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, cctxe.GetEventNonce(), cctxe.Hash(), evr2)
 
-	stored := gk.GetEthereumEventVoteRecord(ctx, stce.GetEventNonce(), stce.Hash())
+	stored := gk.GetEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, stce.GetEventNonce(), stce.Hash())
 	require.NotNil(t, stored)
 
-	stored1 := gk.GetEthereumEventVoteRecord(ctx, cctxe.GetEventNonce(), cctxe.Hash())
+	stored1 := gk.GetEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, cctxe.GetEventNonce(), cctxe.Hash())
 	require.NotNil(t, stored1)
 
 	// var storedEvent, storedEvent1 types.EthereumEvent
@@ -204,7 +205,7 @@ func TestStoreEventVoteRecord(t *testing.T) {
 	require.EqualValues(t, storedEvent1.GetEventNonce(), 2)
 	require.EqualValues(t, storedEvent1.Hash(), cctxe.Hash())
 
-	mapping := gk.GetEthereumEventVoteRecordMapping(ctx)
+	mapping := gk.GetEthereumEventVoteRecordMapping(ctx, TestingGravityParams.BridgeChainId)
 	require.EqualValues(t, 3, len(mapping[1][0].Votes))
 	require.EqualValues(t, 3, len(mapping[2][0].Votes))
 
@@ -226,37 +227,37 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 	i := 1
 	for ; i < 10; i++ {
 		ctx = ctx.WithBlockHeight(int64(i))
-		_ = k.CreateSignerSetTx(ctx)
+		_ = k.CreateSignerSetTx(ctx, TestingGravityParams.BridgeChainId)
 	}
 
-	latestValsetNonce := k.GetLatestSignerSetTxNonce(ctx)
+	latestValsetNonce := k.GetLatestSignerSetTxNonce(ctx, TestingGravityParams.BridgeChainId)
 	assert.Equal(t, uint64(i-1), latestValsetNonce)
 
 	//  lastSlashedValsetNonce should be zero initially.
-	lastSlashedValsetNonce := k.GetLastSlashedOutgoingTxBlockHeight(ctx)
+	lastSlashedValsetNonce := k.GetLastSlashedOutgoingTxBlockHeight(ctx, TestingGravityParams.BridgeChainId)
 	assert.Equal(t, uint64(0), lastSlashedValsetNonce)
-	unslashedValsets := k.GetUnSlashedOutgoingTxs(ctx, uint64(12))
+	unslashedValsets := k.GetUnSlashedOutgoingTxs(ctx, TestingGravityParams.BridgeChainId, uint64(12))
 	assert.Equal(t, 9, len(unslashedValsets))
 
 	// check if last Slashed Valset nonce is set properly or not
-	k.SetLastSlashedOutgoingTxBlockHeight(ctx, uint64(3))
-	lastSlashedValsetNonce = k.GetLastSlashedOutgoingTxBlockHeight(ctx)
+	k.SetLastSlashedOutgoingTxBlockHeight(ctx, TestingGravityParams.BridgeChainId, uint64(3))
+	lastSlashedValsetNonce = k.GetLastSlashedOutgoingTxBlockHeight(ctx, TestingGravityParams.BridgeChainId)
 	assert.Equal(t, uint64(3), lastSlashedValsetNonce)
 
 	// when maxHeight < lastSlashedValsetNonce, len(unslashedValsets) should be zero
-	unslashedValsets = k.GetUnSlashedOutgoingTxs(ctx, uint64(2))
+	unslashedValsets = k.GetUnSlashedOutgoingTxs(ctx, TestingGravityParams.BridgeChainId, uint64(2))
 	assert.Equal(t, 0, len(unslashedValsets))
 
 	// when maxHeight == lastSlashedValsetNonce, len(unslashedValsets) should be zero
-	unslashedValsets = k.GetUnSlashedOutgoingTxs(ctx, uint64(3))
+	unslashedValsets = k.GetUnSlashedOutgoingTxs(ctx, TestingGravityParams.BridgeChainId, uint64(3))
 	assert.Equal(t, 0, len(unslashedValsets))
 
 	// when maxHeight > lastSlashedValsetNonce && maxHeight <= latestValsetNonce
-	unslashedValsets = k.GetUnSlashedOutgoingTxs(ctx, uint64(6))
+	unslashedValsets = k.GetUnSlashedOutgoingTxs(ctx, TestingGravityParams.BridgeChainId, uint64(6))
 	assert.Equal(t, 2, len(unslashedValsets))
 
 	// when maxHeight > latestValsetNonce
-	unslashedValsets = k.GetUnSlashedOutgoingTxs(ctx, uint64(15))
+	unslashedValsets = k.GetUnSlashedOutgoingTxs(ctx, TestingGravityParams.BridgeChainId, uint64(15))
 	assert.Equal(t, 6, len(unslashedValsets))
 }
 
@@ -268,7 +269,7 @@ func TestKeeper_GetLatestSignerSetTx(t *testing.T) {
 		ctx := env.Context
 		gk := env.GravityKeeper
 
-		got := gk.GetLatestSignerSetTx(ctx)
+		got := gk.GetLatestSignerSetTx(ctx, TestingGravityParams.BridgeChainId)
 		require.Nil(t, got)
 	})
 
@@ -278,17 +279,17 @@ func TestKeeper_GetLatestSignerSetTx(t *testing.T) {
 		gk := env.GravityKeeper
 
 		{ // setup
-			gk.SetOutgoingTx(ctx, &types.SignerSetTx{
-				Nonce:   gk.incrementLatestSignerSetTxNonce(ctx),
+			gk.SetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, &types.SignerSetTx{
+				Nonce:   gk.incrementLatestSignerSetTxNonce(ctx, TestingGravityParams.BridgeChainId),
 				Height:  1,
 				Signers: nil,
 			})
 		}
 
 		{ // validate
-			got := gk.GetLatestSignerSetTx(env.Context)
+			got := gk.GetLatestSignerSetTx(env.Context, TestingGravityParams.BridgeChainId)
 			require.NotNil(t, got)
-			require.EqualValues(t, got.Height, gk.GetLatestSignerSetTxNonce(ctx))
+			require.EqualValues(t, got.Height, gk.GetLatestSignerSetTxNonce(ctx, TestingGravityParams.BridgeChainId))
 		}
 	})
 }
@@ -299,7 +300,7 @@ func TestKeeper_GetSignerSetTxs(t *testing.T) {
 		ctx := env.Context
 		gk := env.GravityKeeper
 
-		got := gk.GetSignerSetTxs(ctx)
+		got := gk.GetSignerSetTxs(ctx, TestingGravityParams.BridgeChainId)
 		require.Nil(t, got)
 	})
 
@@ -309,15 +310,15 @@ func TestKeeper_GetSignerSetTxs(t *testing.T) {
 		gk := env.GravityKeeper
 
 		{ // setup
-			gk.SetOutgoingTx(ctx, &types.SignerSetTx{
-				Nonce:   gk.incrementLatestSignerSetTxNonce(ctx),
+			gk.SetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, &types.SignerSetTx{
+				Nonce:   gk.incrementLatestSignerSetTxNonce(ctx, TestingGravityParams.BridgeChainId),
 				Height:  1,
 				Signers: nil,
 			})
 		}
 
 		{ // validate
-			got := gk.GetSignerSetTxs(ctx)
+			got := gk.GetSignerSetTxs(ctx, TestingGravityParams.BridgeChainId)
 			require.NotNil(t, got)
 			require.Len(t, got, 1)
 		}
@@ -330,7 +331,7 @@ func TestKeeper_GetLastObservedSignerSetTx(t *testing.T) {
 		ctx := env.Context
 		gk := env.GravityKeeper
 
-		got := gk.GetLastObservedSignerSetTx(ctx)
+		got := gk.GetLastObservedSignerSetTx(ctx, TestingGravityParams.BridgeChainId)
 		require.Nil(t, got)
 	})
 
@@ -340,7 +341,7 @@ func TestKeeper_GetLastObservedSignerSetTx(t *testing.T) {
 		gk := env.GravityKeeper
 
 		{ // setup
-			gk.setLastObservedSignerSetTx(ctx, types.SignerSetTx{
+			gk.setLastObservedSignerSetTx(ctx, TestingGravityParams.BridgeChainId, types.SignerSetTx{
 				Nonce:   1,
 				Height:  1,
 				Signers: nil,
@@ -348,7 +349,7 @@ func TestKeeper_GetLastObservedSignerSetTx(t *testing.T) {
 		}
 
 		{ // validate
-			got := gk.GetLastObservedSignerSetTx(ctx)
+			got := gk.GetLastObservedSignerSetTx(ctx, TestingGravityParams.BridgeChainId)
 			require.NotNil(t, got)
 		}
 	})
@@ -392,7 +393,7 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 			types.MakeContractCallTxKey(nil, 0),
 		}
 		for _, storeIndex := range storeIndexes {
-			got := gk.GetEthereumSignatures(ctx, storeIndex)
+			got := gk.GetEthereumSignatures(ctx, TestingGravityParams.BridgeChainId, storeIndex)
 			require.Empty(t, got)
 		}
 	})
@@ -414,7 +415,7 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 				EthereumSigner: ethAddr.Hex(),
 				Signature:      []byte("fake-signature"),
 			}
-			key := gk.SetEthereumSignature(ctx, signerSetTxConfirmation, valAddr)
+			key := gk.SetEthereumSignature(ctx, TestingGravityParams.BridgeChainId, signerSetTxConfirmation, valAddr)
 			require.NotEmpty(t, key)
 		}
 
@@ -422,11 +423,11 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 			storeIndex := types.MakeSignerSetTxKey(signerSetNonce)
 
 			{ // getEthereumSignature
-				got := gk.getEthereumSignature(ctx, storeIndex, valAddr)
+				got := gk.getEthereumSignature(ctx, TestingGravityParams.BridgeChainId, storeIndex, valAddr)
 				require.Equal(t, []byte("fake-signature"), got)
 			}
 			{ // GetEthereumSignatures
-				got := gk.GetEthereumSignatures(ctx, storeIndex)
+				got := gk.GetEthereumSignatures(ctx, TestingGravityParams.BridgeChainId, storeIndex)
 				require.Len(t, got, 1)
 			}
 		}
@@ -453,7 +454,7 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 				EthereumSigner: ethAddr.Hex(),
 				Signature:      []byte("fake-signature"),
 			}
-			key := gk.SetEthereumSignature(ctx, batchTxConfirmation, valAddr)
+			key := gk.SetEthereumSignature(ctx, TestingGravityParams.BridgeChainId, batchTxConfirmation, valAddr)
 			require.NotEmpty(t, key)
 		}
 
@@ -461,11 +462,11 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 			storeIndex := types.MakeBatchTxKey(common.HexToAddress(tokenContract), batchNonce)
 
 			{ // getEthereumSignature
-				got := gk.getEthereumSignature(ctx, storeIndex, valAddr)
+				got := gk.getEthereumSignature(ctx, TestingGravityParams.BridgeChainId, storeIndex, valAddr)
 				require.Equal(t, []byte("fake-signature"), got)
 			}
 			{ // GetEthereumSignatures
-				got := gk.GetEthereumSignatures(ctx, storeIndex)
+				got := gk.GetEthereumSignatures(ctx, TestingGravityParams.BridgeChainId, storeIndex)
 				require.Len(t, got, 1)
 			}
 		}
@@ -493,7 +494,7 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 				EthereumSigner:    ethAddr.Hex(),
 				Signature:         []byte("fake-signature"),
 			}
-			key := gk.SetEthereumSignature(ctx, contractCallConfirmation, valAddr)
+			key := gk.SetEthereumSignature(ctx, TestingGravityParams.BridgeChainId, contractCallConfirmation, valAddr)
 			require.NotEmpty(t, key)
 		}
 
@@ -501,17 +502,86 @@ func TestKeeper_GetEthereumSignatures(t *testing.T) {
 			storeIndex := types.MakeContractCallTxKey([]byte(invalidationScope), invalidationNonce)
 
 			{ // getEthereumSignature
-				got := gk.getEthereumSignature(ctx, storeIndex, valAddr)
+				got := gk.getEthereumSignature(ctx, TestingGravityParams.BridgeChainId, storeIndex, valAddr)
 				require.Equal(t, []byte("fake-signature"), got)
 			}
 			{ // GetEthereumSignatures
-				got := gk.GetEthereumSignatures(ctx, storeIndex)
+				got := gk.GetEthereumSignatures(ctx, TestingGravityParams.BridgeChainId, storeIndex)
 				require.Len(t, got, 1)
 			}
 		}
 	})
 }
 
+func TestKeeper_EthereumSignaturesBySignerIndex(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	// the voter set of the signer set lacks the last validator, as if it bonded since
+	signerSet := k.CreateSignerSetTx(ctx, chainID)
+	voterSet, _ := k.GetVoterSet(ctx, chainID, signerSet.Nonce)
+	outsider := ValAddrs[4]
+	i, ok := voterSet.Index(outsider)
+	require.True(t, ok)
+	voterSet.Voters = append(voterSet.Voters[:i], voterSet.Voters[i+1:]...)
+	k.setVoterSet(ctx, chainID, signerSet.Nonce, voterSet)
+
+	batch := &types.BatchTx{BatchNonce: 1, TokenContract: EthAddrs[0].Hex()}
+	for j, val := range ValAddrs {
+		k.SetEthereumSignature(ctx, chainID, &types.BatchTxConfirmation{
+			TokenContract:  batch.TokenContract,
+			BatchNonce:     batch.BatchNonce,
+			EthereumSigner: EthAddrs[j].Hex(),
+			Signature:      []byte{byte(j)},
+		}, val)
+	}
+
+	// each signature is an entry of its own, those of the voters keyed by their index in the
+	// voter set of the signer set at the first signature
+	store := ctx.KVStore(input.GravityStoreKey)
+	chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+	require.Equal(t, sdk.Uint64ToBigEndian(signerSet.Nonce), chainStore.Get(types.MakeEthereumSignaturesKey(batch.GetStoreIndex())))
+	for j, voter := range voterSet.Voters {
+		val, err := sdk.ValAddressFromBech32(voter.ValidatorAddress)
+		require.NoError(t, err)
+		require.NotNil(t, chainStore.Get(types.MakeVoterEthereumSignatureKey(batch.GetStoreIndex(), j)))
+		require.Nil(t, chainStore.Get(types.MakeValidatorEthereumSignatureKey(batch.GetStoreIndex(), val)))
+	}
+	require.Equal(t, []byte{4}, chainStore.Get(types.MakeValidatorEthereumSignatureKey(batch.GetStoreIndex(), outsider)))
+
+	var order []string
+	k.iterateEthereumSignatures(ctx, chainID, batch.GetStoreIndex(), func(val sdk.ValAddress, _ []byte) bool {
+		order = append(order, val.String())
+		return false
+	})
+	for j, voter := range voterSet.Voters {
+		require.Equal(t, voter.ValidatorAddress, order[j])
+	}
+	require.Equal(t, outsider.String(), order[len(order)-1])
+	for j, val := range ValAddrs {
+		require.Equal(t, []byte{byte(j)}, k.getEthereumSignature(ctx, chainID, batch.GetStoreIndex(), val))
+	}
+	require.Nil(t, k.getEthereumSignature(ctx, chainID, types.MakeBatchTxKey(EthAddrs[0], 2), ValAddrs[0]))
+
+	// the signatures of a contract call whose store index is the prefix of another's are its own
+	short := &types.ContractCallTx{InvalidationScope: []byte{1}, InvalidationNonce: 1}
+	long := &types.ContractCallTx{InvalidationScope: append([]byte{1}, sdk.Uint64ToBigEndian(1)...), InvalidationNonce: 1}
+	require.True(t, bytes.HasPrefix(long.GetStoreIndex(), short.GetStoreIndex()))
+	k.SetEthereumSignature(ctx, chainID, &types.ContractCallTxConfirmation{
+		InvalidationScope: long.InvalidationScope,
+		InvalidationNonce: long.InvalidationNonce,
+		Signature:         []byte{1},
+	}, ValAddrs[0])
+	require.Empty(t, k.GetEthereumSignatures(ctx, chainID, short.GetStoreIndex()))
+	require.Len(t, k.GetEthereumSignatures(ctx, chainID, long.GetStoreIndex()), 1)
+
+	k.deleteEthereumSignatures(ctx, chainID, batch.GetStoreIndex())
+	require.Empty(t, k.GetEthereumSignatures(ctx, chainID, batch.GetStoreIndex()))
+	require.Nil(t, chainStore.Get(types.MakeEthereumSignaturesKey(batch.GetStoreIndex())))
+	require.Len(t, k.GetEthereumSignatures(ctx, chainID, long.GetStoreIndex()), 1)
+}
+
 func TestKeeper_Migration(t *testing.T) {
 
 	input := CreateTestEnv(t)
@@ -583,21 +653,21 @@ func TestKeeper_Migration(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	firstBatch := input.GravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	firstBatch := input.GravityKeeper.CreateBatchTx(ctx, TestingGravityParams.BridgeChainId, myTokenContractAddr, 2)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, firstBatch.GetStoreIndex())
+	gotFirstBatch := input.GravityKeeper.GetOutgoingTx(ctx, TestingGravityParams.BridgeChainId, firstBatch.GetStoreIndex())
 	require.NotNil(t, gotFirstBatch)
 
-	gk.setEthereumEventVoteRecord(ctx, stce.GetEventNonce(), stce.Hash(), evr)
-	gk.setLastObservedEventNonce(ctx, stce.GetEventNonce())
-	gk.setEthereumEventVoteRecord(ctx, cctxe.GetEventNonce(), cctxe.Hash(), evr2)
-	gk.setLastObservedEventNonce(ctx, cctxe.GetEventNonce())
+	gk.setEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, stce.GetEventNonce(), stce.Hash(), evr)
+	gk.setLastObservedEventNonce(ctx, TestingGravityParams.BridgeChainId, stce.GetEventNonce())
+	gk.setEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, cctxe.GetEventNonce(), cctxe.Hash(), evr2)
+	gk.setLastObservedEventNonce(ctx, TestingGravityParams.BridgeChainId, cctxe.GetEventNonce())
 
-	stored := gk.GetEthereumEventVoteRecord(ctx, stce.GetEventNonce(), stce.Hash())
+	stored := gk.GetEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, stce.GetEventNonce(), stce.Hash())
 	require.NotNil(t, stored)
 
-	stored2 := gk.GetEthereumEventVoteRecord(ctx, cctxe.GetEventNonce(), cctxe.Hash())
+	stored2 := gk.GetEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, cctxe.GetEventNonce(), cctxe.Hash())
 	require.NotNil(t, stored2)
 
 	ethAddr := common.HexToAddress("0x3146D2d6Eed46Afa423969f5dDC3152DfC359b09")
@@ -612,7 +682,7 @@ func TestKeeper_Migration(t *testing.T) {
 			EthereumSigner: ethAddr.Hex(),
 			Signature:      []byte("fake-signature"),
 		}
-		key := gk.SetEthereumSignature(ctx, batchTxConfirmation, valAddr)
+		key := gk.SetEthereumSignature(ctx, TestingGravityParams.BridgeChainId, batchTxConfirmation, valAddr)
 		require.NotEmpty(t, key)
 	}
 
@@ -620,49 +690,49 @@ func TestKeeper_Migration(t *testing.T) {
 		storeIndex := gotFirstBatch.GetStoreIndex()
 
 		{ // getEthereumSignature
-			got := gk.getEthereumSignature(ctx, storeIndex, valAddr)
+			got := gk.getEthereumSignature(ctx, TestingGravityParams.BridgeChainId, storeIndex, valAddr)
 			require.Equal(t, []byte("fake-signature"), got)
 		}
 		{ // GetEthereumSignatures
-			got := gk.GetEthereumSignatures(ctx, storeIndex)
+			got := gk.GetEthereumSignatures(ctx, TestingGravityParams.BridgeChainId, storeIndex)
 			require.Len(t, got, 1)
 		}
 	}
 
-	nonce := gk.GetLastObservedEventNonce(ctx)
+	nonce := gk.GetLastObservedEventNonce(ctx, TestingGravityParams.BridgeChainId)
 	require.Equal(t, cctxe.GetEventNonce(), nonce)
 
-	gk.setLastObservedSignerSetTx(ctx, types.SignerSetTx{
+	gk.setLastObservedSignerSetTx(ctx, TestingGravityParams.BridgeChainId, types.SignerSetTx{
 		Nonce:   1,
 		Height:  1,
 		Signers: nil,
 	})
 
 	for _, val := range ValAddrs {
-		gk.setLastEventNonceByValidator(ctx, val, nonce)
+		gk.setLastEventNonceByValidator(ctx, TestingGravityParams.BridgeChainId, val, nonce)
 	}
 
-	gk.MigrateGravityContract(ctx, "0x5e175bE4d23Fa25604CE7848F60FB340894D5CDA", 1000)
+	gk.MigrateGravityContract(ctx, TestingGravityParams.BridgeChainId, "0x5e175bE4d23Fa25604CE7848F60FB340894D5CDA", 1000)
 
-	storedAfterMigrate := gk.GetEthereumEventVoteRecord(ctx, stce.GetEventNonce(), stce.Hash())
+	storedAfterMigrate := gk.GetEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, stce.GetEventNonce(), stce.Hash())
 	require.Nil(t, storedAfterMigrate)
 
-	stored2AfterMigrate := gk.GetEthereumEventVoteRecord(ctx, cctxe.GetEventNonce(), cctxe.Hash())
+	stored2AfterMigrate := gk.GetEthereumEventVoteRecord(ctx, TestingGravityParams.BridgeChainId, cctxe.GetEventNonce(), cctxe.Hash())
 	require.Nil(t, stored2AfterMigrate)
 
-	nonce2 := gk.GetLastObservedEventNonce(ctx)
+	nonce2 := gk.GetLastObservedEventNonce(ctx, TestingGravityParams.BridgeChainId)
 	require.Equal(t, uint64(0), nonce2)
 
 	for _, val := range ValAddrs {
-		require.Equal(t, uint64(0), gk.getLastEventNonceByValidator(ctx, val))
+		require.Equal(t, uint64(0), gk.getLastEventNonceByValidator(ctx, TestingGravityParams.BridgeChainId, val))
 	}
 
-	got := gk.GetLastObservedSignerSetTx(ctx)
+	got := gk.GetLastObservedSignerSetTx(ctx, TestingGravityParams.BridgeChainId)
 	require.Equal(t, got, &types.SignerSetTx{Nonce: 0x0, Height: 0x0, Signers: types.EthereumSigners(nil)})
 
 	{ // GetEthereumSignatures
 		storeIndex := gotFirstBatch.GetStoreIndex()
-		got := gk.GetEthereumSignatures(ctx, storeIndex)
+		got := gk.GetEthereumSignatures(ctx, TestingGravityParams.BridgeChainId, storeIndex)
 		require.Len(t, got, 0)
 	}
 
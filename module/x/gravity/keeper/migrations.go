@@ -1,10 +1,27 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
 	v1 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v1"
+	v2 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v2"
+	v3 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v3"
+	v4 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v4"
+	v5 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v5"
+	v6 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v6"
+	v7 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v7"
+	v8 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v8"
+	v9 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v9"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
+// ConsensusVersion is the consensus version of the module, one more than the number of
+// in-place store migrations
+const ConsensusVersion = 11
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -15,7 +32,88 @@ func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
 
+// Migrations returns the in-place store migrations in order, the one at index i migrating
+// from consensus version i+1 to i+2. A change to the store layout or params appends a
+// migration here, in a new package under x/gravity/migrations, and bumps ConsensusVersion.
+func (m Migrator) Migrations() []module.MigrationHandler {
+	return []module.MigrationHandler{
+		m.Migrate1to2,
+		m.Migrate2to3,
+		m.Migrate3to4,
+		m.Migrate4to5,
+		m.Migrate5to6,
+		m.Migrate6to7,
+		m.Migrate7to8,
+		m.Migrate8to9,
+		m.Migrate9to10,
+		m.Migrate10to11,
+	}
+}
+
+// RegisterMigrations registers all the in-place store migrations with the configurator, so
+// upgrade handlers only need to run the migrations of the module manager
+func (m Migrator) RegisterMigrations(cfg module.Configurator) error {
+	migrations := m.Migrations()
+	if len(migrations)+1 != ConsensusVersion {
+		return fmt.Errorf("%d migrations for consensus version %d", len(migrations), ConsensusVersion)
+	}
+	for i, handler := range migrations {
+		from := uint64(i + 1)
+		if err := cfg.RegisterMigration(types.ModuleName, from, handler); err != nil {
+			return fmt.Errorf("register migration from version %d to %d: %w", from, from+1, err)
+		}
+	}
+	return nil
+}
+
 // Migrate1to2 migrates from consensus version 1 to 2.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v1.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v2.MigrateParams(ctx, m.keeper.paramSpace)
+}
+
+// Migrate3to4 migrates from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramSpace, m.keeper.bankKeeper)
+}
+
+// Migrate4to5 migrates from consensus version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v4.MigrateParams(ctx, m.keeper.storeKey, m.keeper.paramSpace, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate6to7 migrates from consensus version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate7to8 migrates from consensus version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate8to9 migrates from consensus version 8 to 9.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate9to10 migrates from consensus version 9 to 10.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate10to11 migrates from consensus version 10 to 11, seeding the issuance of the
+// vouchers with their supply.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	m.keeper.seedVoucherIssuance(ctx)
+	return nil
+}
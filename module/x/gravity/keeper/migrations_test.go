@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterMigrations(t *testing.T) {
+	input := CreateTestEnv(t)
+	m := NewMigrator(input.GravityKeeper)
+	require.Len(t, m.Migrations(), ConsensusVersion-1)
+
+	cfg := module.NewConfigurator(input.Marshaler, baseapp.NewMsgServiceRouter(), baseapp.NewGRPCQueryRouter())
+	require.NoError(t, m.RegisterMigrations(cfg))
+
+	// each version can only be migrated from once
+	require.Error(t, m.RegisterMigrations(cfg))
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
@@ -94,10 +96,15 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
 			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, orchAddr.String()),
-			sdk.NewAttribute(types.AttributeKeySetEthereumAddr, ethAddr.Hex()),
+			types.EthereumAddressAttribute(types.AttributeKeySetEthereumAddr, ethAddr.Hex()),
 			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
 		),
 	)
+	emitTypedEvent(ctx, &types.EventSetDelegateKeys{
+		ValidatorAddress:    valAddr.String(),
+		OrchestratorAddress: orchAddr.String(),
+		EthereumAddress:     ethAddr.Hex(),
+	})
 
 	return &types.MsgDelegateKeysResponse{}, nil
 
@@ -106,6 +113,10 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 // SubmitEthereumTxConfirmation handles MsgSubmitEthereumTxConfirmation
 func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.MsgSubmitEthereumTxConfirmation) (*types.MsgSubmitEthereumTxConfirmationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	confirmation, err := types.UnpackConfirmation(msg.Confirmation)
 	if err != nil {
@@ -117,24 +128,30 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 		return nil, err
 	}
 
-	otx := k.GetOutgoingTx(ctx, confirmation.GetStoreIndex())
+	otx := k.GetOutgoingTx(ctx, chainID, confirmation.GetStoreIndex())
 	if otx == nil {
 		k.Logger(ctx).Error(
 			"no outgoing tx",
+			"chain id", chainID,
 			"store index", fmt.Sprintf("%x", confirmation.GetStoreIndex()),
 		)
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find outgoing tx")
+		return nil, sdkerrors.Wrapf(types.ErrOutgoingTxNotFound, "store index %x", confirmation.GetStoreIndex())
+	}
+	if k.inVetoDelay(ctx, otx) {
+		return nil, sdkerrors.Wrapf(types.ErrInVetoDelay, "outgoing tx created at height %d is in its veto delay", otx.GetCosmosHeight())
 	}
 
-	gravityID := k.getGravityID(ctx)
-	checkpoint := otx.GetCheckpoint([]byte(gravityID))
+	gravityIDs := k.acceptedGravityIDs(ctx, chainID)
+	gravityID := gravityIDs[0]
+	checkpoint := k.outgoingTxCheckpoint(otx, gravityID)
 
 	ethAddress := k.GetValidatorEthereumAddress(ctx, val)
 	if ethAddress != confirmation.GetSigner() {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "eth address does not match signer eth address")
+		return nil, sdkerrors.Wrapf(types.ErrSignerMismatch, "signer %s isn't the validator's registered eth address %s", confirmation.GetSigner().Hex(), ethAddress.Hex())
 	}
 
-	if err = types.ValidateEthereumSignature(checkpoint, confirmation.GetSignature(), ethAddress); err != nil {
+	err = k.validateConfirmationSignature(ctx, chainID, otx, confirmation.GetSignature(), ethAddress)
+	if err != nil {
 		k.Logger(ctx).Error("error validating signature",
 			"eth addr", ethAddress.String(),
 			"gravityID", gravityID,
@@ -142,22 +159,22 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 			"type url", msg.Confirmation.TypeUrl,
 			"signature", hex.EncodeToString(confirmation.GetSignature()),
 			"error", err)
-		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf(
-			"signature verification failed ethAddress %s gravityID %s checkpoint %s typeURL %s signature %s err %s",
+		// the error keeps the code of the check the signature failed
+		return nil, sdkerrors.Wrapf(err,
+			"signature verification failed ethAddress %s gravityID %s checkpoint %s typeURL %s signature %s",
 			ethAddress.Hex(),
 			gravityID,
 			hex.EncodeToString(checkpoint),
 			msg.Confirmation.TypeUrl,
 			hex.EncodeToString(confirmation.GetSignature()),
-			err,
-		))
+		)
 	}
 	// TODO: should validators be able to overwrite their signatures?
-	if k.getEthereumSignature(ctx, confirmation.GetStoreIndex(), val) != nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "signature duplicate")
+	if k.getEthereumSignature(ctx, chainID, confirmation.GetStoreIndex(), val) != nil {
+		return nil, sdkerrors.Wrap(types.ErrDuplicateConfirmation, "signature duplicate")
 	}
 
-	key := k.SetEthereumSignature(ctx, confirmation, val)
+	key := k.SetEthereumSignature(ctx, chainID, confirmation, val)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -166,18 +183,40 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 			sdk.NewAttribute(types.AttributeKeyEthereumSignatureKey, string(key)),
 		),
 	)
+	types.IncrMetricCounter(types.MetricKeyConfirmationsReceived, chainID, telemetry.NewLabel(types.MetricLabelKind, proto.MessageName(confirmation)))
+	k.updateBlockSummary(ctx, func(summary *types.EventBlockSummary) { summary.Confirmations++ })
+	emitTypedEvent(ctx, &types.EventEthereumTxConfirmation{
+		EvmChainId:       chainID,
+		StoreIndex:       confirmation.GetStoreIndex(),
+		ValidatorAddress: val.String(),
+		EthereumSigner:   ethAddress.Hex(),
+	})
+	k.emitOutgoingTxRelayable(ctx, chainID, otx, ethAddress)
 	return &types.MsgSubmitEthereumTxConfirmationResponse{}, nil
 }
 
 // SubmitEthereumEvent handles MsgSubmitEthereumEvent
 func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEthereumEvent) (*types.MsgSubmitEthereumEventResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	event, err := types.UnpackEvent(msg.Event)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := k.validateEventContract(ctx, chainID, event); err != nil {
+		return nil, err
+	}
+
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	if err := k.validateEventFinality(ctx, chain, event); err != nil {
+		return nil, err
+	}
+
 	// return an error if the validator isn't in the active set
 	val, err := k.getSignerValidator(ctx, msg.Signer)
 	if err != nil {
@@ -185,7 +224,7 @@ func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEt
 	}
 
 	// Add the claim to the store
-	_, err = k.recordEventVote(ctx, event, val)
+	_, err = k.recordEventVote(ctx, chainID, event, val)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "create event vote record")
 	}
@@ -197,6 +236,9 @@ func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEt
 			sdk.NewAttribute(sdk.AttributeKeyModule, fmt.Sprintf("%T", event)),
 			// TODO: maybe return something better here? is this the right string representation?
 			sdk.NewAttribute(types.AttributeKeyEthereumEventVoteRecordID, string(types.MakeEthereumEventVoteRecordKey(event.GetEventNonce(), event.Hash()))),
+			types.EthereumEventTypeAttribute(event),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(event.GetEventNonce())),
 		),
 	)
 
@@ -211,78 +253,373 @@ func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereu
 		return nil, err
 	}
 
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	// ensure the denoms provided in the message will map correctly if they are gravity denoms
 	types.NormalizeCoinDenom(&msg.Amount)
 	types.NormalizeCoinDenom(&msg.BridgeFee)
 
-	txID, err := k.createSendToEthereum(ctx, sender, msg.EthereumRecipient, msg.Amount, msg.BridgeFee)
+	txID, err := k.createSendToEthereum(ctx, chainID, sender, msg.EthereumRecipient, msg.Amount, msg.BridgeFee)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvents([]sdk.Event{
-		sdk.NewEvent(
-			types.EventTypeBridgeWithdrawalReceived,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(txID))),
-			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(txID)),
-		),
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
 			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
 		),
-	})
+	)
 
 	return &types.MsgSendToEthereumResponse{Id: txID}, nil
 }
 
 func (k msgServer) CancelSendToEthereum(c context.Context, msg *types.MsgCancelSendToEthereum) (*types.MsgCancelSendToEthereumResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	err := k.Keeper.cancelSendToEthereum(ctx, msg.Id, msg.Sender)
+	err = k.Keeper.cancelSendToEthereum(ctx, chainID, msg.Id, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvents([]sdk.Event{
-		sdk.NewEvent(
-			types.EventTypeBridgeWithdrawCanceled,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.getBridgeChainID(ctx)))),
-		),
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
 			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(msg.Id)),
 		),
-	})
+	)
 
 	return &types.MsgCancelSendToEthereumResponse{}, nil
 }
 
 func (k msgServer) SubmitEthereumHeightVote(c context.Context, msg *types.MsgEthereumHeightVote) (*types.MsgEthereumHeightVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	// a height read with another finality would let the observed height run ahead of
+	// what the chain considers final
+	if chain, _ := k.GetEVMChain(ctx, chainID); msg.Finality != chain.Finality {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalid,
+			"ethereum height vote with %s finality, evm chain %d uses %s finality",
+			msg.Finality, chainID, chain.Finality,
+		)
+	}
 
 	val, err := k.getSignerValidator(ctx, msg.Signer)
 	if err != nil {
 		return nil, err
 	}
 
-	k.Keeper.SetEthereumHeightVote(ctx, val, msg.EthereumHeight)
+	k.Keeper.SetEthereumHeightVote(ctx, chainID, val, msg.EthereumHeight)
 
 	return &types.MsgEthereumHeightVoteResponse{}, nil
 }
 
+func (k msgServer) RequestDepositAddress(c context.Context, msg *types.MsgRequestDepositAddress) (*types.MsgRequestDepositAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	depositAddress, err := k.registerDepositAddress(ctx, chainID, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestDepositAddressResponse{DepositAddress: depositAddress.Hex()}, nil
+}
+
+func (k msgServer) SendERC1155ToEthereum(c context.Context, msg *types.MsgSendERC1155ToEthereum) (*types.MsgSendERC1155ToEthereumResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := k.createSendERC1155ToEthereum(ctx, chainID, sender, msg.EthereumRecipient, common.HexToAddress(msg.TokenContract), msg.Amounts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+		),
+	)
+
+	return &types.MsgSendERC1155ToEthereumResponse{Id: txID}, nil
+}
+
+func (k msgServer) UpdateParams(c context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if msg.EffectiveHeight != 0 {
+		if err := k.scheduleParamsUpdate(ctx, msg.Params, msg.EffectiveHeight); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeParamsUpdateScheduled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyEffectiveHeight, strconv.FormatUint(msg.EffectiveHeight, 10)),
+		))
+
+		return &types.MsgUpdateParamsResponse{}, nil
+	}
+
+	// the scheduled update was built on the params replaced here, it is cancelled
+	k.setParams(ctx, msg.Params)
+	k.deleteScheduledParamsUpdate(ctx)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeParamsUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+	))
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) BridgeAdminPause(c context.Context, msg *types.MsgBridgeAdminPause) (*types.MsgBridgeAdminPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := k.checkBridgeAdmin(ctx, msg.Admin, types.BridgeAdminPermissionPause); err != nil {
+		return nil, err
+	}
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.changeEVMChainPause(ctx, chainID, msg.Paused); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBridgeAdminPauseResponse{}, nil
+}
+
+func (k msgServer) BridgeAdminSetRateLimits(c context.Context, msg *types.MsgBridgeAdminSetRateLimits) (*types.MsgBridgeAdminSetRateLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := k.checkBridgeAdmin(ctx, msg.Admin, types.BridgeAdminPermissionRateLimits); err != nil {
+		return nil, err
+	}
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	k.setEVMChainRateLimits(ctx, chainID, msg.RateLimits)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRateLimitsUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyAuthority, msg.Admin),
+	))
+
+	return &types.MsgBridgeAdminSetRateLimitsResponse{}, nil
+}
+
+func (k msgServer) BridgeAdminSetFeeFloors(c context.Context, msg *types.MsgBridgeAdminSetFeeFloors) (*types.MsgBridgeAdminSetFeeFloorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := k.checkBridgeAdmin(ctx, msg.Admin, types.BridgeAdminPermissionFeeFloors); err != nil {
+		return nil, err
+	}
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	k.setEVMChainFeeFloors(ctx, chainID, msg.FeeFloors)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeFloorsUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyAuthority, msg.Admin),
+	))
+
+	return &types.MsgBridgeAdminSetFeeFloorsResponse{}, nil
+}
+
+func (k msgServer) MintVouchers(c context.Context, msg *types.MsgMintVouchers) (*types.MsgMintVouchersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.mintVouchers(ctx, msg.Authority, recipient, msg.Amount, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintVouchersResponse{IncidentId: record.Id}, nil
+}
+
+func (k msgServer) BurnVouchers(c context.Context, msg *types.MsgBurnVouchers) (*types.MsgBurnVouchersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.burnVouchers(ctx, msg.Authority, holder, msg.Amount, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnVouchersResponse{IncidentId: record.Id}, nil
+}
+
+func (k msgServer) VetoBatchTx(c context.Context, msg *types.MsgVetoBatchTx) (*types.MsgVetoBatchTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := k.checkVetoCouncil(ctx, msg.Council); err != nil {
+		return nil, err
+	}
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	otx, err := k.getVetoableOutgoingTx(ctx, chainID, types.MakeBatchTxKey(common.HexToAddress(msg.TokenContract), msg.BatchNonce))
+	if err != nil {
+		return nil, err
+	}
+	batch, ok := otx.(*types.BatchTx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to batch tx for %d %s", msg.BatchNonce, msg.TokenContract)
+	}
+	if err := k.vetoBatchTx(ctx, chainID, batch); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOutgoingTxVetoed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		types.EthereumAddressAttribute(types.AttributeKeyTokenContract, batch.TokenContract),
+		sdk.NewAttribute(types.AttributeKeyBatchNonce, fmt.Sprint(batch.BatchNonce)),
+		sdk.NewAttribute(types.AttributeKeyVetoCouncil, msg.Council),
+	))
+
+	return &types.MsgVetoBatchTxResponse{}, nil
+}
+
+func (k msgServer) VetoContractCallTx(c context.Context, msg *types.MsgVetoContractCallTx) (*types.MsgVetoContractCallTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := k.checkVetoCouncil(ctx, msg.Council); err != nil {
+		return nil, err
+	}
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	otx, err := k.getVetoableOutgoingTx(ctx, chainID, types.MakeContractCallTxKey(msg.InvalidationScope, msg.InvalidationNonce))
+	if err != nil {
+		return nil, err
+	}
+	call, ok := otx.(*types.ContractCallTx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "couldn't cast to contract call for %x %d", msg.InvalidationScope, msg.InvalidationNonce)
+	}
+	k.CancelContractCallTx(ctx, chainID, call)
+	k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.VetoedOutgoingTxs++ })
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOutgoingTxVetoed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyContractCallInvalidationScope, fmt.Sprint(call.InvalidationScope)),
+		sdk.NewAttribute(types.AttributeKeyContractCallInvalidationNonce, fmt.Sprint(call.InvalidationNonce)),
+		sdk.NewAttribute(types.AttributeKeyVetoCouncil, msg.Council),
+	))
+
+	return &types.MsgVetoContractCallTxResponse{}, nil
+}
+
+// InjectEthereumEvent observes an event without the votes of orchestrators, it is rejected
+// unless the binary is built with the devnet build tag
+func (k msgServer) InjectEthereumEvent(c context.Context, msg *types.MsgInjectEthereumEvent) (*types.MsgInjectEthereumEventResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !devnetBuild {
+		return nil, sdkerrors.Wrap(types.ErrDevnetOnly, "injected ethereum events")
+	}
+	chainID, err := k.resolveEVMChainID(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+	event, err := types.UnpackEvent(msg.Event)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validateEventContract(ctx, chainID, event); err != nil {
+		return nil, err
+	}
+
+	if err := k.injectEthereumEvent(ctx, chainID, event); err != nil {
+		return nil, sdkerrors.Wrap(err, "inject ethereum event")
+	}
+
+	k.Logger(ctx).Info("injected ethereum event", "chain id", chainID, "nonce", event.GetEventNonce(), "signer", msg.Signer)
+	return &types.MsgInjectEthereumEventResponse{}, nil
+}
+
 // getSignerValidator takes an sdk.AccAddress that represents either a validator or orchestrator address and returns
 // the assoicated validator address
 func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.ValAddress, error) {
 	signer, err := sdk.AccAddressFromBech32(signerString)
 	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "signer address")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer address")
 	}
 	var validatorI stakingtypes.ValidatorI
 	if validator := k.GetOrchestratorValidatorAddress(ctx, signer); validator == nil {
@@ -292,9 +629,9 @@ func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.Va
 	}
 
 	if validatorI == nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "not orchestrator or validator")
+		return nil, sdkerrors.Wrap(types.ErrUnknownSigner, signerString)
 	} else if !validatorI.IsBonded() {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("validator is not bonded: %s", validatorI.GetOperator()))
+		return nil, sdkerrors.Wrap(types.ErrValidatorNotBonded, validatorI.GetOperator().String())
 	}
 
 	return validatorI.GetOperator(), nil
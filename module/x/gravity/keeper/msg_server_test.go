@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 	"fmt"
+	"math/big"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -47,10 +50,10 @@ func TestMsgServer_SubmitEthereumSignature(t *testing.T) {
 	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
 
 	// setup for GetOutgoingTx
-	signerSetTx := gk.CreateSignerSetTx(ctx)
+	signerSetTx := gk.CreateSignerSetTx(ctx, TestingGravityParams.BridgeChainId)
 
 	// setup for ValidateEthereumSignature
-	gravityId := gk.getGravityID(ctx)
+	gravityId := gk.getGravityID(ctx, TestingGravityParams.BridgeChainId)
 	checkpoint := signerSetTx.GetCheckpoint([]byte(gravityId))
 	signature, err := types.NewEthereumSignature(checkpoint, ethPrivKey)
 	require.NoError(t, err)
@@ -75,6 +78,60 @@ func TestMsgServer_SubmitEthereumSignature(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_SubmitEthereumSignatureErrors(t *testing.T) {
+	var (
+		env     = CreateTestEnv(t)
+		ctx     = env.Context
+		gk      = env.GravityKeeper
+		orcAddr = AccAddrs[0]
+		valAddr = sdk.ValAddress(AccAddrs[0])
+	)
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	otherPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := crypto.PubkeyToAddress(ethPrivKey.PublicKey)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr, orcAddr)
+	gk.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
+	signerSetTx := gk.CreateSignerSetTx(ctx, TestingGravityParams.BridgeChainId)
+	checkpoint := signerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx, TestingGravityParams.BridgeChainId)))
+	msgServer := NewMsgServerImpl(gk)
+
+	submit := func(signer common.Address, signature []byte) error {
+		confirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: signer.Hex(),
+			Signature:      signature,
+		})
+		require.NoError(t, err)
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: confirmation,
+			Signer:       orcAddr.String(),
+		})
+		return err
+	}
+
+	signature, err := types.NewEthereumSignature(checkpoint, ethPrivKey)
+	require.NoError(t, err)
+	otherSignature, err := types.NewEthereumSignature(checkpoint, otherPrivKey)
+	require.NoError(t, err)
+
+	malleated := append([]byte(nil), signature...)
+	new(big.Int).Sub(ethCrypto.S256().Params().N, new(big.Int).SetBytes(signature[32:64])).FillBytes(malleated[32:64])
+	malleated[64] ^= 1
+	badRecoveryID := append([]byte(nil), signature...)
+	badRecoveryID[64] = 4
+
+	require.ErrorIs(t, submit(ethAddr, malleated), types.ErrMalleableSignature)
+	require.ErrorIs(t, submit(ethAddr, badRecoveryID), types.ErrInvalidRecoveryID)
+	require.ErrorIs(t, submit(ethAddr, otherSignature), types.ErrSignerMismatch)
+	require.ErrorIs(t, submit(crypto.PubkeyToAddress(otherPrivKey.PublicKey), otherSignature), types.ErrSignerMismatch)
+	require.ErrorIs(t, submit(ethAddr, signature[:64]), types.ErrInvalidSignature)
+	require.NoError(t, submit(ethAddr, signature))
+}
+
 func TestMsgServer_SendToEthereum(t *testing.T) {
 	ethPrivKey, err := ethCrypto.GenerateKey()
 	require.NoError(t, err)
@@ -122,7 +179,7 @@ func TestMsgServer_SendToEthereum(t *testing.T) {
 	}
 
 	// create denom in keeper
-	gk.setCosmosOriginatedDenomToERC20(ctx, testDenom, testContract)
+	gk.setCosmosOriginatedDenomToERC20(ctx, TestingGravityParams.BridgeChainId, testDenom, testContract)
 
 	// setup for GetValidatorEthereumAddress
 	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
@@ -136,8 +193,25 @@ func TestMsgServer_SendToEthereum(t *testing.T) {
 		BridgeFee:         fee,
 	}
 
-	_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
+	response, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), msg)
 	require.NoError(t, err)
+
+	// the transfer can be found by tx_search on its sender, recipient and token in the
+	// formats of all events, bech32 and lowercase hex
+	var withdrawal sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBridgeWithdrawalReceived {
+			withdrawal = event
+		}
+	}
+	attributes := make(map[string]string)
+	for _, attribute := range withdrawal.Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+	require.Equal(t, orcAddr1.String(), attributes[types.AttributeKeySender])
+	require.Equal(t, strings.ToLower(ethAddr1.Hex()), attributes[types.AttributeKeyRecipient])
+	require.Equal(t, strings.ToLower(testContract.Hex()), attributes[types.AttributeKeyTokenContract])
+	require.Equal(t, fmt.Sprint(response.Id), attributes[types.AttributeKeyNonce])
 }
 
 func TestMsgServer_CancelSendToEthereum(t *testing.T) {
@@ -187,7 +261,7 @@ func TestMsgServer_CancelSendToEthereum(t *testing.T) {
 	}
 
 	// create denom in keeper
-	gk.setCosmosOriginatedDenomToERC20(ctx, testDenom, testContract)
+	gk.setCosmosOriginatedDenomToERC20(ctx, TestingGravityParams.BridgeChainId, testDenom, testContract)
 
 	// setup for GetValidatorEthereumAddress
 	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
@@ -211,6 +285,31 @@ func TestMsgServer_CancelSendToEthereum(t *testing.T) {
 
 	_, err = msgServer.CancelSendToEthereum(sdk.WrapSDKContext(ctx), cancelMsg)
 	require.NoError(t, err)
+
+	// both transitions are emitted as typed events
+	var sent *types.EventSendToEthereum
+	var cancelled *types.EventSendToEthereumCancelled
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		typed, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		switch typed := typed.(type) {
+		case *types.EventSendToEthereum:
+			sent = typed
+		case *types.EventSendToEthereumCancelled:
+			cancelled = typed
+		}
+	}
+	require.NotNil(t, sent)
+	require.Equal(t, response.Id, sent.Id)
+	require.Equal(t, ethAddr1.String(), sent.EthereumRecipient)
+	require.Equal(t, testContract.Hex(), sent.Erc20Token.Contract)
+	require.Equal(t, &types.EventSendToEthereumCancelled{
+		EvmChainId: TestingGravityParams.BridgeChainId,
+		Id:         response.Id,
+		Sender:     orcAddr1.String(),
+	}, cancelled)
 }
 
 func TestMsgServer_SubmitEthereumEvent(t *testing.T) {
@@ -266,6 +365,27 @@ func TestMsgServer_SubmitEthereumEvent(t *testing.T) {
 
 	_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), msg)
 	require.NoError(t, err)
+
+	// events less than the required confirmations behind the observed ethereum height are
+	// rejected, whatever confirmations they report
+	params := gk.GetParams(ctx)
+	params.MinimumEthereumConfirmations = 6
+	gk.setParams(ctx, params)
+	gk.SetLastObservedEthereumBlockHeight(ctx, TestingGravityParams.BridgeChainId, 205)
+
+	sendToCosmosEvent.EventNonce = 2
+	sendToCosmosEvent.EthereumConfirmations = 100
+	event, err = types.PackEvent(sendToCosmosEvent)
+	require.NoError(t, err)
+	msg.Event = event
+
+	_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrInsufficientConfirmations)
+
+	gk.SetLastObservedEthereumBlockHeight(ctx, TestingGravityParams.BridgeChainId, 206)
+
+	_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
 }
 
 func TestMsgServer_SetDelegateKeys(t *testing.T) {
@@ -346,7 +466,238 @@ func TestMsgServer_SubmitEthereumHeightVote(t *testing.T) {
 	_, err := msgServer.SubmitEthereumHeightVote(sdk.WrapSDKContext(ctx), msg)
 
 	require.NoError(t, err)
-	require.Equal(t, gk.GetEthereumHeightVote(ctx, valAddr1).EthereumHeight, uint64(5))
+	require.Equal(t, gk.GetEthereumHeightVote(ctx, TestingGravityParams.BridgeChainId, valAddr1).EthereumHeight, uint64(5))
+
+	// the default chain counts confirmations, heights of a tagged block are rejected
+	msg.EthereumHeight = 6
+	msg.Finality = types.FinalityFinalized
+	_, err = msgServer.SubmitEthereumHeightVote(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrInvalid)
+	require.Equal(t, gk.GetEthereumHeightVote(ctx, TestingGravityParams.BridgeChainId, valAddr1).EthereumHeight, uint64(5))
+}
+
+func TestMsgServer_RequestDepositAddress(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		recipient, _ = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		factory      = "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf"
+	)
+
+	msgServer := NewMsgServerImpl(gk)
+	msg := types.NewMsgRequestDepositAddress(recipient)
+
+	// the default chain has no factory to derive the address with
+	_, err := msgServer.RequestDepositAddress(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrNoDepositAddressFactory)
+
+	params := gk.GetParams(ctx)
+	params.EthereumDepositAddressFactory = factory
+	gk.setParams(ctx, params)
+
+	res, err := msgServer.RequestDepositAddress(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, "0x1946fCD6b3B5A4a817Ee7Fbf921A685981e179A3", res.DepositAddress)
+	depositAddress, found := gk.GetDepositAddress(ctx, TestingGravityParams.BridgeChainId, recipient)
+	require.True(t, found)
+	require.Equal(t, res.DepositAddress, depositAddress.Hex())
+
+	// the registered address is kept when the factory changes
+	params.EthereumDepositAddressFactory = "0x5e175bE4d23Fa25604CE7848F60FB340894D5CDA"
+	gk.setParams(ctx, params)
+	again, err := msgServer.RequestDepositAddress(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, res.DepositAddress, again.DepositAddress)
+
+	query, err := gk.DepositAddress(sdk.WrapSDKContext(ctx), &types.DepositAddressRequest{Recipient: recipient.String()})
+	require.NoError(t, err)
+	require.Equal(t, res.DepositAddress, query.DepositAddress)
+	require.NotEqual(t, res.DepositAddress, query.DerivedDepositAddress)
+
+	// the address is exported with the chain's state
+	exported := ExportGenesis(ctx, gk)
+	require.Equal(t, []types.DepositAddress{{Recipient: recipient.String(), DepositAddress: res.DepositAddress}}, exported.DepositAddresses)
+}
+
+func TestMsgServer_UpdateParams(t *testing.T) {
+	var (
+		env       = CreateTestEnv(t)
+		ctx       = env.Context
+		gk        = env.GravityKeeper
+		msgServer = NewMsgServerImpl(gk)
+	)
+
+	params := gk.GetParams(ctx)
+	params.SignedBatchesWindow = 42
+
+	// only the authority may update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(AccAddrs[0], params, 0))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	authority, err := sdk.AccAddressFromBech32(gk.GetAuthority())
+	require.NoError(t, err)
+	invalid := params
+	invalid.TargetEthTxTimeout = 1
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, invalid, 0))
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, params, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), gk.GetParams(ctx).SignedBatchesWindow)
+
+	// as may governance through an update params proposal
+	params.SignedBatchesWindow = 43
+	require.NoError(t, gk.HandleUpdateParamsProposal(ctx, types.NewUpdateParamsProposal("title", "description", params, 0)))
+	require.Equal(t, uint64(43), gk.GetParams(ctx).SignedBatchesWindow)
+}
+
+func TestMsgServer_ScheduledParamsUpdate(t *testing.T) {
+	var (
+		env       = CreateTestEnv(t)
+		ctx       = env.Context
+		gk        = env.GravityKeeper
+		msgServer = NewMsgServerImpl(gk)
+	)
+	authority, err := sdk.AccAddressFromBech32(gk.GetAuthority())
+	require.NoError(t, err)
+
+	params := gk.GetParams(ctx)
+	params.SignedBatchesWindow = 42
+	height := uint64(ctx.BlockHeight())
+
+	// updates can't be scheduled at a height already reached
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, params, height))
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, params, height+10))
+	require.NoError(t, err)
+	require.Equal(t, TestingGravityParams.SignedBatchesWindow, gk.GetParams(ctx).SignedBatchesWindow)
+
+	res, err := gk.Params(sdk.WrapSDKContext(ctx), &types.ParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.ScheduledParamsUpdate{Params: params, Height: height + 10}, res.ScheduledUpdate)
+
+	// the update waits for its height
+	gk.ApplyScheduledParamsUpdate(ctx.WithBlockHeight(int64(height) + 9))
+	require.Equal(t, TestingGravityParams.SignedBatchesWindow, gk.GetParams(ctx).SignedBatchesWindow)
+
+	ctx = ctx.WithBlockHeight(int64(height) + 10)
+	gk.ApplyScheduledParamsUpdate(ctx)
+	require.Equal(t, uint64(42), gk.GetParams(ctx).SignedBatchesWindow)
+	require.Nil(t, gk.GetScheduledParamsUpdate(ctx))
+
+	// a proposal updating the params right away cancels the scheduled update
+	params.SignedBatchesWindow = 43
+	require.NoError(t, gk.HandleUpdateParamsProposal(ctx, types.NewUpdateParamsProposal("title", "description", params, height+20)))
+	require.NotNil(t, gk.GetScheduledParamsUpdate(ctx))
+	params.SignedBatchesWindow = 44
+	require.NoError(t, gk.HandleUpdateParamsProposal(ctx, types.NewUpdateParamsProposal("title", "description", params, 0)))
+	require.Nil(t, gk.GetScheduledParamsUpdate(ctx))
+	require.Equal(t, uint64(44), gk.GetParams(ctx).SignedBatchesWindow)
+}
+
+func TestMsgServer_BridgeAdmin(t *testing.T) {
+	var (
+		env       = CreateTestEnv(t)
+		ctx       = env.Context
+		gk        = env.GravityKeeper
+		msgServer = NewMsgServerImpl(gk)
+		admin     = AccAddrs[0]
+		chainID   = TestingGravityParams.BridgeChainId
+	)
+	require.NoError(t, gk.AddEVMChain(ctx, testEVMChain))
+
+	params := gk.GetParams(ctx)
+	params.BridgeAdmin = types.BridgeAdmin{
+		Address:     admin.String(),
+		Permissions: []types.BridgeAdminPermission{types.BridgeAdminPermissionPause, types.BridgeAdminPermissionRateLimits},
+	}
+	require.NoError(t, params.ValidateBasic())
+	gk.setParams(ctx, params)
+
+	// only the admin may act, and only with the permissions it holds
+	_, err := msgServer.BridgeAdminPause(sdk.WrapSDKContext(ctx), types.NewMsgBridgeAdminPause(AccAddrs[1], 0, true))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	floors := []types.FeeFloor{{TokenContract: EthAddrs[0].Hex(), MinimumFee: sdk.NewInt(10)}}
+	_, err = msgServer.BridgeAdminSetFeeFloors(sdk.WrapSDKContext(ctx), types.NewMsgBridgeAdminSetFeeFloors(admin, 0, floors))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.BridgeAdminPause(sdk.WrapSDKContext(ctx), types.NewMsgBridgeAdminPause(admin, testEVMChain.ChainId, true))
+	require.NoError(t, err)
+	require.True(t, gk.IsEVMChainPaused(ctx, testEVMChain.ChainId))
+	require.False(t, gk.IsEVMChainPaused(ctx, chainID))
+
+	// rate limits are replaced on the default chain's params as well as on added chains
+	limits := []types.RateLimit{{TokenContract: EthAddrs[0].Hex(), Limit: sdk.NewInt(1000), Window: 100}}
+	for _, id := range []uint64{0, testEVMChain.ChainId} {
+		_, err = msgServer.BridgeAdminSetRateLimits(sdk.WrapSDKContext(ctx), types.NewMsgBridgeAdminSetRateLimits(admin, id, limits))
+		require.NoError(t, err)
+	}
+	require.Equal(t, limits, gk.GetParams(ctx).EthereumRateLimits)
+	chain, _ := gk.GetEVMChain(ctx, testEVMChain.ChainId)
+	require.Equal(t, limits, chain.RateLimits)
+
+	// governance grants the permissions, and an admin without an address can't hold any
+	params = gk.GetParams(ctx)
+	params.BridgeAdmin.Permissions = append(params.BridgeAdmin.Permissions, types.BridgeAdminPermissionFeeFloors)
+	require.NoError(t, gk.HandleUpdateParamsProposal(ctx, types.NewUpdateParamsProposal("title", "description", params, 0)))
+	_, err = msgServer.BridgeAdminSetFeeFloors(sdk.WrapSDKContext(ctx), types.NewMsgBridgeAdminSetFeeFloors(admin, 0, floors))
+	require.NoError(t, err)
+	require.Equal(t, floors, gk.GetParams(ctx).EthereumFeeFloors)
+
+	params.BridgeAdmin.Address = ""
+	require.Error(t, params.ValidateBasic())
+}
+
+func TestMsgServer_VetoCouncil(t *testing.T) {
+	var (
+		env           = CreateTestEnv(t)
+		ctx           = env.Context
+		gk            = env.GravityKeeper
+		msgServer     = NewMsgServerImpl(gk)
+		council       = AccAddrs[0]
+		sender        = AccAddrs[1]
+		tokenContract = EthAddrs[0]
+		chainID       = TestingGravityParams.BridgeChainId
+		vouchers      = sdk.NewCoins(types.NewERC20Token(1000, tokenContract).GravityCoin())
+	)
+	require.NoError(t, env.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	env.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, env.BankKeeper, sender, vouchers))
+
+	params := gk.GetParams(ctx)
+	params.VetoCouncil = types.VetoCouncil{Address: council.String(), VetoDelay: 5}
+	require.NoError(t, params.ValidateBasic())
+	gk.setParams(ctx, params)
+
+	env.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, EthAddrs[1], 2, 3)
+	batch := gk.CreateBatchTx(ctx, chainID, tokenContract, 10)
+	require.NotNil(t, batch)
+	require.True(t, gk.inVetoDelay(ctx, batch))
+
+	// only the council may veto, and only during the veto delay
+	_, err := msgServer.VetoBatchTx(sdk.WrapSDKContext(ctx), types.NewMsgVetoBatchTx(sender, 0, tokenContract, batch.BatchNonce))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	late := ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	require.False(t, gk.inVetoDelay(late, batch))
+	_, err = msgServer.VetoBatchTx(sdk.WrapSDKContext(late), types.NewMsgVetoBatchTx(council, 0, tokenContract, batch.BatchNonce))
+	require.ErrorIs(t, err, types.ErrVetoDelayPassed)
+
+	// the vetoed batch is deleted and its sends refunded rather than returned to the pool
+	_, err = msgServer.VetoBatchTx(sdk.WrapSDKContext(ctx), types.NewMsgVetoBatchTx(council, 0, tokenContract, batch.BatchNonce))
+	require.NoError(t, err)
+	require.Nil(t, gk.GetOutgoingTx(ctx, chainID, batch.GetStoreIndex()))
+	require.Equal(t, vouchers, env.BankKeeper.GetAllBalances(ctx, sender))
+	gk.IterateUnbatchedSendToEthereums(ctx, chainID, func(*types.SendToEthereum) bool {
+		t.Fatal("vetoed sends returned to the pool")
+		return true
+	})
+
+	// a council needs a delay shorter than the signed batches window
+	params.VetoCouncil.VetoDelay = params.SignedBatchesWindow
+	require.Error(t, params.ValidateBasic())
 }
 
 func TestEthVerify(t *testing.T) {
@@ -403,3 +754,147 @@ func TestEthVerify(t *testing.T) {
 	gorcSig := "0xbda7037e448ca07ac91f5f386b72df37b6bbacf102b2c8f5acb58b5e053d68d96875ce9e442433bea55ac083230f492670ca2c07a8303c332dca06b1c0758c661b"
 	require.Equal(t, hexutil.Encode(sig), gorcSig)
 }
+
+// FuzzMsgServer_SendToEthereum checks that no send to Ethereum passing ValidateBasic panics
+// the msg server, whatever its recipient, denoms and amounts, and that an accepted send
+// takes its amount and fee from the sender
+func FuzzMsgServer_SendToEthereum(f *testing.F) {
+	voucher := types.GravityDenom(common.HexToAddress(TokenContractAddrs[1]))
+	f.Add(EthAddrs[1].Hex(), "stake", "1000", "stake", "10")
+	f.Add(EthAddrs[1].Hex(), voucher, "1000", voucher, "0")
+	f.Add(strings.ToLower(EthAddrs[1].Hex()), strings.ToLower(voucher), "1", strings.ToLower(voucher), "1")
+	f.Add("0xzz", "stake", "1", "stake", "1")
+	f.Add(EthAddrs[1].Hex(), voucher, "115792089237316195423570985008687907853269984665640564039457584007913129639935", voucher, "115792089237316195423570985008687907853269984665640564039457584007913129639935")
+	f.Fuzz(func(t *testing.T, recipient, denom, amount, feeDenom, fee string) {
+		amountInt, ok := sdk.NewIntFromString(amount)
+		if !ok {
+			return
+		}
+		feeInt, ok := sdk.NewIntFromString(fee)
+		if !ok {
+			return
+		}
+		msg := types.NewMsgSendToEthereum(AccAddrs[0], recipient, sdk.Coin{Denom: denom, Amount: amountInt}, sdk.Coin{Denom: feeDenom, Amount: feeInt})
+		if err := msg.ValidateBasic(); err != nil {
+			return
+		}
+
+		env := CreateTestEnv(t)
+		ctx := env.Context
+		gk := env.GravityKeeper
+		chainID := TestingGravityParams.BridgeChainId
+		gk.setCosmosOriginatedDenomToERC20(ctx, chainID, "stake", common.HexToAddress(TokenContractAddrs[0]))
+		balance := sdk.NewCoins(sdk.NewInt64Coin("stake", 100000), sdk.NewInt64Coin(voucher, 100000))
+		require.NoError(t, env.AddBalanceToBank(ctx, AccAddrs[0], balance))
+
+		if _, err := NewMsgServerImpl(gk).SendToEthereum(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return
+		}
+		paid := balance.Sub(env.BankKeeper.GetAllBalances(ctx, AccAddrs[0]))
+		require.Equal(t, sdk.NewCoins(sdk.NewCoin(types.NormalizeDenom(denom), amountInt.Add(feeInt))), paid)
+	})
+}
+
+// FuzzMsgServer_SubmitEthereumTxConfirmation checks that no confirmation passing
+// ValidateBasic panics the msg server, whatever its kind, nonces, addresses and signature,
+// and that only confirmations of an outgoing tx are stored
+func FuzzMsgServer_SubmitEthereumTxConfirmation(f *testing.F) {
+	f.Add(uint8(0), uint64(1), TokenContractAddrs[0], EthAddrs[0].Hex(), []byte{1}, bytes.Repeat([]byte{1}, 65))
+	f.Add(uint8(1), uint64(1), TokenContractAddrs[0], EthAddrs[0].Hex(), []byte{}, bytes.Repeat([]byte{0xff}, 65))
+	f.Add(uint8(2), uint64(1), "0xzz", strings.ToLower(EthAddrs[0].Hex()), []byte{1}, []byte{})
+	f.Add(uint8(3), uint64(18446744073709551615), TokenContractAddrs[0], EthAddrs[0].Hex(), []byte{}, []byte{27})
+	f.Fuzz(func(t *testing.T, kind uint8, nonce uint64, contract, signer string, scope, signature []byte) {
+		var confirmation types.EthereumTxConfirmation
+		switch kind % 4 {
+		case 0:
+			confirmation = &types.SignerSetTxConfirmation{SignerSetNonce: nonce, EthereumSigner: signer, Signature: signature}
+		case 1:
+			confirmation = &types.BatchTxConfirmation{TokenContract: contract, BatchNonce: nonce, EthereumSigner: signer, Signature: signature}
+		case 2:
+			confirmation = &types.ContractCallTxConfirmation{InvalidationScope: scope, InvalidationNonce: nonce, EthereumSigner: signer, Signature: signature}
+		case 3:
+			confirmation = &types.ERC1155BatchTxConfirmation{TokenContract: contract, BatchNonce: nonce, EthereumSigner: signer, Signature: signature}
+		}
+		packed, err := types.PackConfirmation(confirmation)
+		require.NoError(t, err)
+		msg := &types.MsgSubmitEthereumTxConfirmation{Confirmation: packed, Signer: AccAddrs[0].String()}
+		if err := msg.ValidateBasic(); err != nil {
+			return
+		}
+
+		input, ctx := SetupFiveValChain(t)
+		gk := input.GravityKeeper
+		chainID := TestingGravityParams.BridgeChainId
+		gk.CreateSignerSetTx(ctx, chainID)
+
+		if _, err := NewMsgServerImpl(gk).SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return
+		}
+		require.NotNil(t, gk.GetOutgoingTx(ctx, chainID, confirmation.GetStoreIndex()))
+		require.Equal(t, confirmation.GetSignature(), gk.getEthereumSignature(ctx, chainID, confirmation.GetStoreIndex(), ValAddrs[0]))
+	})
+}
+
+// FuzzMsgServer_SubmitEthereumEvent checks that no event passing ValidateBasic panics the
+// msg server, or its handler once every validator voted for it, whatever its kind,
+// addresses, amounts and denom
+func FuzzMsgServer_SubmitEthereumEvent(f *testing.F) {
+	maxUint256 := "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	for kind := uint8(0); kind < 8; kind++ {
+		f.Add(kind, TokenContractAddrs[0], EthAddrs[1].Hex(), AccAddrs[1].String(), "100", "stake")
+	}
+	f.Add(uint8(0), TokenContractAddrs[1], EthAddrs[1].Hex(), AccAddrs[1].String(), maxUint256, "stake")
+	f.Add(uint8(0), strings.ToLower(TokenContractAddrs[0]), "0xzz", "cosmos1", "-1", "stake")
+	f.Add(uint8(3), TokenContractAddrs[2], EthAddrs[1].Hex(), AccAddrs[1].String(), "0", "ibc/ABCD")
+	f.Add(uint8(5), TokenContractAddrs[1], EthAddrs[1].Hex(), AccAddrs[1].String(), maxUint256, "stake")
+	f.Fuzz(func(t *testing.T, kind uint8, contract, sender, receiver, amount, denom string) {
+		amountInt, ok := sdk.NewIntFromString(amount)
+		if !ok {
+			return
+		}
+		// the nonces, decimals, powers and versions of the events take the low bits of the amount
+		low := amountInt.BigInt().Uint64()
+		var event types.EthereumEvent
+		switch kind % 8 {
+		case 0:
+			event = &types.SendToCosmosEvent{EventNonce: 1, TokenContract: contract, Amount: amountInt, EthereumSender: sender, CosmosReceiver: receiver, EthereumHeight: 10}
+		case 1:
+			event = &types.BatchExecutedEvent{TokenContract: contract, EventNonce: 1, EthereumHeight: 10, BatchNonce: low}
+		case 2:
+			event = &types.ContractCallExecutedEvent{EventNonce: 1, InvalidationScope: []byte(sender), InvalidationNonce: low, EthereumHeight: 10}
+		case 3:
+			event = &types.ERC20DeployedEvent{EventNonce: 1, CosmosDenom: denom, TokenContract: contract, Erc20Name: denom, Erc20Symbol: denom, Erc20Decimals: low, EthereumHeight: 10}
+		case 4:
+			event = &types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: low, EthereumHeight: 10, Members: types.EthereumSigners{{Power: low, EthereumAddress: sender}}}
+		case 5:
+			event = &types.SendERC1155ToCosmosEvent{EventNonce: 1, TokenContract: contract, Amounts: []types.ERC1155Amount{{Id: amountInt, Amount: amountInt}}, EthereumSender: sender, CosmosReceiver: receiver, EthereumHeight: 10}
+		case 6:
+			event = &types.ERC1155BatchExecutedEvent{TokenContract: contract, EventNonce: 1, EthereumHeight: 10, BatchNonce: low}
+		case 7:
+			event = &types.ContractVersionEvent{EventNonce: 1, Version: low, EthereumHeight: 10}
+		}
+		packed, err := types.PackEvent(event)
+		require.NoError(t, err)
+		if err := (&types.MsgSubmitEthereumEvent{Event: packed, Signer: AccAddrs[0].String()}).ValidateBasic(); err != nil {
+			return
+		}
+
+		input, ctx := SetupFiveValChain(t)
+		gk := input.GravityKeeper
+		chainID := TestingGravityParams.BridgeChainId
+		gk.SetLastObservedEthereumBlockHeight(ctx, chainID, 1000)
+		gk.setCosmosOriginatedDenomToERC20(ctx, chainID, "stake", common.HexToAddress(TokenContractAddrs[0]))
+
+		msgServer := NewMsgServerImpl(gk)
+		for _, orchestrator := range AccAddrs {
+			msg := &types.MsgSubmitEthereumEvent{Event: packed, Signer: orchestrator.String()}
+			_, err := msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), msg)
+			require.NoError(t, err)
+		}
+		// as the end blocker does, which applies the event through its handler
+		for _, record := range gk.GetEthereumEventVoteRecordMapping(ctx, chainID)[1] {
+			gk.TryEventVoteRecord(ctx, chainID, record)
+		}
+		require.Equal(t, uint64(1), gk.GetLastObservedEventNonce(ctx, chainID))
+	})
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// simulateParamsChange reports the effects of replacing the current params with the
+// candidate ones without changing any state
+func (k Keeper) simulateParamsChange(ctx sdk.Context, params types.Params) *types.SimulateParamsChangeResponse {
+	res := &types.SimulateParamsChangeResponse{}
+	current := k.GetParams(ctx)
+	chainID := k.getBridgeChainID(ctx)
+
+	// the checkpoints of pending batches are signed for the gravity id and verified by
+	// the contract of the default chain, they can't be submitted once either changes
+	if params.GravityId != current.GravityId || params.BridgeEthereumAddress != current.BridgeEthereumAddress {
+		k.IterateOutgoingTxsByType(ctx, chainID, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+			res.InvalidatedBatches = append(res.InvalidatedBatches, otx.(*types.BatchTx))
+			return false
+		})
+		k.IterateOutgoingTxsByType(ctx, chainID, types.ERC1155BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+			res.InvalidatedErc1155Batches = append(res.InvalidatedErc1155Batches, otx.(*types.ERC1155BatchTx))
+			return false
+		})
+	}
+
+	seen := make(map[string]bool)
+	for _, chain := range k.GetEVMChains(ctx) {
+		for _, val := range k.missingSignatureValidators(ctx, chain.ChainId, params.SignedBatchesWindow) {
+			if !seen[val.String()] {
+				seen[val.String()] = true
+				res.SlashableValidators = append(res.SlashableValidators, val.String())
+			}
+		}
+	}
+
+	return res
+}
+
+// missingSignatureValidators returns the bonded validators the outgoing tx slashing of
+// the end blocker would slash for the EVM chain with the signed batches window, the ones
+// missing a signature of an outgoing tx created after they started signing blocks
+func (k Keeper) missingSignatureValidators(ctx sdk.Context, chainID uint64, signedBatchesWindow uint64) (out []sdk.ValAddress) {
+	if uint64(ctx.BlockHeight()) <= signedBatchesWindow {
+		return nil
+	}
+	otxs := k.GetUnSlashedOutgoingTxs(ctx, chainID, uint64(ctx.BlockHeight())-signedBatchesWindow)
+	if len(otxs) == 0 {
+		return nil
+	}
+
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		if val.IsJailed() {
+			continue
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			continue
+		}
+		sigs, found := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		if !found {
+			continue
+		}
+		for _, otx := range otxs {
+			if sigs.StartHeight >= int64(otx.GetCosmosHeight()) {
+				continue
+			}
+			if _, signed := k.GetEthereumSignatures(ctx, chainID, otx.GetStoreIndex())[val.GetOperator().String()]; !signed {
+				out = append(out, val.GetOperator())
+				break
+			}
+		}
+	}
+	return out
+}
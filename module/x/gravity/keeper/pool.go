@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -15,21 +16,46 @@ import (
 
 // createSendToEthereum
 // - checks a counterpart denominator exists for the given voucher type
-// - burns the voucher for transfer amount and fees
+// - burns the voucher for transfer amount and fees, or escrows it for the chain if cosmos originated
 // - persists an OutgoingTx
 // - adds the TX to the `available` TX pool via a second index
-func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+func (k Keeper) createSendToEthereum(ctx sdk.Context, chainID uint64, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	if k.IsEVMChainPaused(ctx, chainID) {
+		return 0, sdkerrors.Wrapf(types.ErrEVMChainPaused, "chain id %d", chainID)
+	}
+
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
 
 	// If the coin is a gravity voucher, burn the coins. If not, check if there is a deployed ERC20 contract representing it.
-	// If there is, lock the coins.
+	// If there is, lock the coins in the escrow of the chain.
 
-	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, totalAmount.Denom)
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, chainID, totalAmount.Denom)
+	if err != nil {
+		return 0, err
+	}
+
+	// amounts go out in the decimals of the token on the chain
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	erc20Amount, err := chain.ERC20Amount(amount.Denom, amount.Amount)
+	if err != nil {
+		return 0, err
+	}
+	erc20Fee, err := chain.ERC20Amount(fee.Denom, fee.Amount)
 	if err != nil {
 		return 0, err
 	}
 
+	if minimumFee := chain.MinimumFee(tokenContract); erc20Fee.LT(minimumFee) {
+		return 0, sdkerrors.Wrapf(types.ErrInsufficientFee, "fee %s is below the minimum of %s on chain id %d", erc20Fee, minimumFee, chainID)
+	}
+	if err := k.checkPoolLimits(ctx, chainID, tokenContract, erc20Fee); err != nil {
+		return 0, err
+	}
+	if err := k.consumeRateLimit(ctx, chainID, tokenContract, erc20Amount.Add(erc20Fee)); err != nil {
+		return 0, err
+	}
+
 	if senderModule, ok := k.SenderModuleAccounts[sender.String()]; ok {
 		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, totalInVouchers); err != nil {
 			return 0, err
@@ -42,25 +68,40 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 
 	// If it is no a cosmos-originated asset we burn
 	if !isCosmosOriginated {
-		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, totalInVouchers); err != nil {
+		if err := k.retireVouchers(ctx, totalInVouchers); err != nil {
 			panic(err)
 		}
+	} else if err := k.escrowCoins(ctx, chainID, totalInVouchers); err != nil {
+		return 0, err
 	}
 
 	// get next tx id from keeper
 	nextID := k.incrementLastSendToEthereumIDKey(ctx)
+	k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.SendsToEthereum++ })
+	k.updateBlockSummary(ctx, func(summary *types.EventBlockSummary) { summary.NewSends++ })
 
 	// construct the unbatched tx, as part of this process we represent
 	// the token as an ERC20 token since it is preparing to go to ETH
 	// rather than the denom that is the input to this function.
 
 	// set the unbatched transaction in the pool index
-	k.setUnbatchedSendToEthereum(ctx, &types.SendToEthereum{
+	send := &types.SendToEthereum{
 		Id:                nextID,
 		Sender:            sender.String(),
-		EthereumRecipient: counterpartReceiver,
-		Erc20Token:        types.NewSDKIntERC20Token(amount.Amount, tokenContract),
-		Erc20Fee:          types.NewSDKIntERC20Token(fee.Amount, tokenContract),
+		EthereumRecipient: types.NormalizeEthereumAddress(counterpartReceiver),
+		Erc20Token:        types.NewSDKIntERC20Token(erc20Amount, tokenContract),
+		Erc20Fee:          types.NewSDKIntERC20Token(erc20Fee, tokenContract),
+		EvmChainId:        chainID,
+	}
+	k.setUnbatchedSendToEthereum(ctx, chainID, send)
+	k.emitSendToEthereumEvent(ctx, types.EventTypeBridgeWithdrawalReceived, chainID, send.Id, send.Sender, send.EthereumRecipient, send.Erc20Token.Contract)
+	emitTypedEvent(ctx, &types.EventSendToEthereum{
+		EvmChainId:        chainID,
+		Id:                send.Id,
+		Sender:            send.Sender,
+		EthereumRecipient: send.EthereumRecipient,
+		Erc20Token:        send.Erc20Token,
+		Erc20Fee:          send.Erc20Fee,
 	})
 
 	return nextID, nil
@@ -70,53 +111,92 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 // - checks that the provided tx actually exists
 // - deletes the unbatched tx from the pool
 // - issues the tokens back to the sender
-func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error {
+func (k Keeper) cancelSendToEthereum(ctx sdk.Context, chainID uint64, id uint64, s string) error {
 	sender, _ := sdk.AccAddressFromBech32(s)
 
 	var send *types.SendToEthereum
-	for _, ste := range k.getUnbatchedSendToEthereums(ctx) {
+	for _, ste := range k.getUnbatchedSendToEthereums(ctx, chainID) {
 		if ste.Id == id {
 			send = ste
 		}
 	}
 	if send == nil {
 		// NOTE: this case will also be hit if the transaction is in a batch
-		return sdkerrors.Wrap(types.ErrInvalid, "id not found in send to ethereum pool")
+		return sdkerrors.Wrapf(types.ErrPoolEntryNotFound, "id %d not found in send to ethereum pool", id)
 	}
 
 	if sender.String() != send.Sender {
-		return fmt.Errorf("can't cancel a message you didn't send")
+		return sdkerrors.Wrapf(types.ErrNotSender, "%s didn't send %d", sender, id)
 	}
 
-	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(send.Erc20Token.Contract))
-	amountToRefund := send.Erc20Token.Amount.Add(send.Erc20Fee.Amount)
-	coinsToRefund := sdk.NewCoins(sdk.NewCoin(denom, amountToRefund))
-
-	// If it is not cosmos-originated the coins are minted
-	if !isCosmosOriginated {
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coinsToRefund); err != nil {
-			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coinsToRefund)
-		}
+	refunds := newRefunds(chainID)
+	k.refundSendToEthereum(ctx, refunds, send)
+	if err := k.issueRefunds(ctx, refunds); err != nil {
+		return err
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coinsToRefund); err != nil {
-		return sdkerrors.Wrap(err, "sending coins from module account")
-	}
-
-	k.deleteUnbatchedSendToEthereum(ctx, send.Id, send.Erc20Fee)
+	k.deleteUnbatchedSendToEthereum(ctx, chainID, send.Id, send.Erc20Fee)
+	k.emitSendToEthereumEvent(ctx, types.EventTypeBridgeWithdrawCanceled, chainID, send.Id, send.Sender, send.EthereumRecipient, send.Erc20Token.Contract)
+	emitTypedEvent(ctx, &types.EventSendToEthereumCancelled{
+		EvmChainId: chainID,
+		Id:         send.Id,
+		Sender:     send.Sender,
+	})
 	return nil
 }
 
-func (k Keeper) setUnbatchedSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
-	ctx.KVStore(k.storeKey).Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
+// refundSendToEthereum adds the amount and fee of the send to the refunds of its sender
+func (k Keeper) refundSendToEthereum(ctx sdk.Context, r *refunds, send *types.SendToEthereum) {
+	sender, _ := sdk.AccAddressFromBech32(send.Sender)
+	k.addTokenRefund(ctx, r, sender, types.NewSDKIntERC20Token(send.Erc20Token.Amount.Add(send.Erc20Fee.Amount), common.HexToAddress(send.Erc20Token.Contract)))
 }
 
-func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, id uint64, fee types.ERC20Token) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeSendToEthereumKey(id, fee))
+// emitSendToEthereumEvent emits the event of a transition of a transfer to an EVM chain
+func (k Keeper) emitSendToEthereumEvent(ctx sdk.Context, eventType string, chainID uint64, id uint64, sender, recipient, tokenContract string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		k.bridgeContractAttribute(ctx, chainID),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(chainID))),
+		sdk.NewAttribute(types.AttributeKeyOutgoingTXID, strconv.Itoa(int(id))),
+		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(id)),
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		types.EthereumAddressAttribute(types.AttributeKeyRecipient, recipient),
+		types.EthereumAddressAttribute(types.AttributeKeyTokenContract, tokenContract),
+	))
 }
 
-func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, contract common.Address, cb func(*types.SendToEthereum) bool) {
-	iter := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.SendToEthereumKey}, contract.Bytes()...)).ReverseIterator(nil, nil)
+// setUnbatchedSendToEthereum puts the send in the pool, counting it in the aggregate of its
+// token
+func (k Keeper) setUnbatchedSendToEthereum(ctx sdk.Context, chainID uint64, ste *types.SendToEthereum) {
+	key := types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee)
+	store := k.chainStore(ctx, chainID)
+	if !store.Has(key) {
+		k.updatePoolAggregate(ctx, chainID, types.MakePoolAggregateKey(common.HexToAddress(ste.Erc20Fee.Contract)), func(aggregate *types.PoolAggregate) {
+			aggregate.Count++
+			aggregate.TotalFees = aggregate.TotalFees.Add(ste.Erc20Fee.Amount)
+		})
+	}
+	store.Set(key, k.cdc.MustMarshal(ste))
+}
+
+// deleteUnbatchedSendToEthereum removes the send from the pool and from the aggregate of its
+// token
+func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, chainID uint64, id uint64, fee types.ERC20Token) {
+	key := types.MakeSendToEthereumKey(id, fee)
+	store := k.chainStore(ctx, chainID)
+	if !store.Has(key) {
+		return
+	}
+	k.updatePoolAggregate(ctx, chainID, types.MakePoolAggregateKey(common.HexToAddress(fee.Contract)), func(aggregate *types.PoolAggregate) {
+		aggregate.Count--
+		aggregate.TotalFees = aggregate.TotalFees.Sub(fee.Amount)
+	})
+	store.Delete(key)
+}
+
+func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, chainID uint64, contract common.Address, cb func(*types.SendToEthereum) bool) {
+	iter := prefix.NewStore(k.chainStore(ctx, chainID), append([]byte{types.SendToEthereumKey}, contract.Bytes()...)).ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		var ste types.SendToEthereum
@@ -127,8 +207,8 @@ func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, contr
 	}
 }
 
-func (k Keeper) IterateUnbatchedSendToEthereums(ctx sdk.Context, cb func(*types.SendToEthereum) bool) {
-	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumKey}).ReverseIterator(nil, nil)
+func (k Keeper) IterateUnbatchedSendToEthereums(ctx sdk.Context, chainID uint64, cb func(*types.SendToEthereum) bool) {
+	iter := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.SendToEthereumKey}).ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		var ste types.SendToEthereum
@@ -139,9 +219,9 @@ func (k Keeper) IterateUnbatchedSendToEthereums(ctx sdk.Context, cb func(*types.
 	}
 }
 
-func (k Keeper) getUnbatchedSendToEthereums(ctx sdk.Context) []*types.SendToEthereum {
+func (k Keeper) getUnbatchedSendToEthereums(ctx sdk.Context, chainID uint64) []*types.SendToEthereum {
 	var out []*types.SendToEthereum
-	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+	k.IterateUnbatchedSendToEthereums(ctx, chainID, func(ste *types.SendToEthereum) bool {
 		out = append(out, ste)
 		return false
 	})
@@ -149,14 +229,19 @@ func (k Keeper) getUnbatchedSendToEthereums(ctx sdk.Context) []*types.SendToEthe
 }
 
 func (k Keeper) incrementLastSendToEthereumIDKey(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastSendToEthereumIDKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
-	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastSendToEthereumIDKey}, bz)
+	newId := k.getLastSendToEthereumID(ctx) + 1
+	k.setLastSendToEthereumID(ctx, newId)
 	return newId
 }
+
+// getLastSendToEthereumID returns the id of the last send to ethereum, shared by all EVM chains
+func (k Keeper) getLastSendToEthereumID(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastSendToEthereumIDKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setLastSendToEthereumID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastSendToEthereumIDKey}, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetPoolAggregate returns the aggregate of the unbatched transfers of the ERC20 token to the
+// chain
+func (k Keeper) GetPoolAggregate(ctx sdk.Context, chainID uint64, contract common.Address) types.PoolAggregate {
+	return k.getPoolAggregate(ctx, chainID, types.MakePoolAggregateKey(contract))
+}
+
+// GetERC1155PoolAggregate returns the aggregate of the unbatched transfers of the ERC1155
+// token to the chain
+func (k Keeper) GetERC1155PoolAggregate(ctx sdk.Context, chainID uint64, contract common.Address) types.PoolAggregate {
+	return k.getPoolAggregate(ctx, chainID, types.MakeERC1155PoolAggregateKey(contract))
+}
+
+func (k Keeper) getPoolAggregate(ctx sdk.Context, chainID uint64, key []byte) types.PoolAggregate {
+	aggregate := types.PoolAggregate{TotalFees: sdk.ZeroInt()}
+	if bz := k.chainStore(ctx, chainID).Get(key); bz != nil {
+		k.cdc.MustUnmarshal(bz, &aggregate)
+	}
+	return aggregate
+}
+
+// updatePoolAggregate applies the update to the aggregate at the key, deleting it once the
+// pool of its token is empty
+func (k Keeper) updatePoolAggregate(ctx sdk.Context, chainID uint64, key []byte, update func(*types.PoolAggregate)) {
+	aggregate := k.getPoolAggregate(ctx, chainID, key)
+	update(&aggregate)
+	if aggregate.Count == 0 {
+		k.chainStore(ctx, chainID).Delete(key)
+		return
+	}
+	k.chainStore(ctx, chainID).Set(key, k.cdc.MustMarshal(&aggregate))
+}
+
+// IteratePoolAggregates iterates the aggregates of the ERC20 tokens with unbatched transfers
+// to the chain, by token contract
+func (k Keeper) IteratePoolAggregates(ctx sdk.Context, chainID uint64, cb func(common.Address, types.PoolAggregate) bool) {
+	k.iteratePoolAggregates(ctx, chainID, types.PoolAggregateKey, cb)
+}
+
+// IterateERC1155PoolAggregates iterates the aggregates of the ERC1155 tokens with unbatched
+// transfers to the chain, by token contract
+func (k Keeper) IterateERC1155PoolAggregates(ctx sdk.Context, chainID uint64, cb func(common.Address, types.PoolAggregate) bool) {
+	k.iteratePoolAggregates(ctx, chainID, types.ERC1155PoolAggregateKey, cb)
+}
+
+func (k Keeper) iteratePoolAggregates(ctx sdk.Context, chainID uint64, prefixKey byte, cb func(common.Address, types.PoolAggregate) bool) {
+	iter := prefix.NewStore(k.chainStore(ctx, chainID), []byte{prefixKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var aggregate types.PoolAggregate
+		k.cdc.MustUnmarshal(iter.Value(), &aggregate)
+		if cb(common.BytesToAddress(iter.Key()), aggregate) {
+			break
+		}
+	}
+}
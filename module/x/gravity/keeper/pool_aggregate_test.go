@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestPoolAggregates(t *testing.T) {
+	var (
+		env           = CreateTestEnv(t)
+		ctx           = env.Context
+		k             = env.GravityKeeper
+		chainID       = TestingGravityParams.BridgeChainId
+		sender        = AccAddrs[1]
+		tokenContract = EthAddrs[0]
+		vouchers      = sdk.NewCoins(sdk.NewInt64Coin(types.GravityDenom(tokenContract), 10000))
+	)
+	require.NoError(t, env.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	env.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, env.BankKeeper, sender, vouchers))
+	checkInvariant := func() {
+		msg, broken := PoolAggregatesInvariant(k)(ctx)
+		require.False(t, broken, msg)
+	}
+
+	// sends to the pool are counted with their fees
+	env.AddSendToEthTxsToPool(t, ctx, tokenContract, sender, EthAddrs[1], 2, 3, 4)
+	require.Equal(t, types.PoolAggregate{Count: 3, TotalFees: sdk.NewInt(9)}, k.GetPoolAggregate(ctx, chainID, tokenContract))
+	checkInvariant()
+
+	// and leave them when cancelled or batched
+	require.NoError(t, k.cancelSendToEthereum(ctx, chainID, 1, sender.String()))
+	require.Equal(t, types.PoolAggregate{Count: 2, TotalFees: sdk.NewInt(7)}, k.GetPoolAggregate(ctx, chainID, tokenContract))
+	batch := k.CreateBatchTx(ctx, chainID, tokenContract, 1)
+	require.NotNil(t, batch)
+	require.Equal(t, types.PoolAggregate{Count: 1, TotalFees: sdk.NewInt(3)}, k.GetPoolAggregate(ctx, chainID, tokenContract))
+	checkInvariant()
+
+	// the sends of a cancelled batch are back in the pool
+	k.CancelBatchTx(ctx, chainID, batch)
+	require.Equal(t, types.PoolAggregate{Count: 2, TotalFees: sdk.NewInt(7)}, k.GetPoolAggregate(ctx, chainID, tokenContract))
+	checkInvariant()
+
+	// the aggregate of an emptied pool is removed
+	require.NotNil(t, k.CreateBatchTx(ctx, chainID, tokenContract, 2))
+	k.IteratePoolAggregates(ctx, chainID, func(_ common.Address, _ types.PoolAggregate) bool {
+		require.Fail(t, "aggregate of an empty pool")
+		return false
+	})
+	checkInvariant()
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// checkPoolLimits returns an error if a transfer of the token with the fee can't join the
+// pool of the chain, which is the case once the pool is at one of the caps of the pool
+// limits unless the limits accept transfers above the median fee of the token's pool
+func (k Keeper) checkPoolLimits(ctx sdk.Context, chainID uint64, contract common.Address, fee sdk.Int) error {
+	limits := k.GetParams(ctx).PoolLimits
+	if !limits.IsCapped() {
+		return nil
+	}
+
+	aggregate := k.GetPoolAggregate(ctx, chainID, contract)
+	var err error
+	if limits.MaxTokenEntries != 0 && aggregate.Count >= limits.MaxTokenEntries {
+		err = sdkerrors.Wrapf(types.ErrPoolFull, "%d unbatched transfers of %s to chain id %d, the max is %d", aggregate.Count, contract.Hex(), chainID, limits.MaxTokenEntries)
+	} else if count := k.poolCount(ctx, chainID); limits.MaxEntries != 0 && count >= limits.MaxEntries {
+		err = sdkerrors.Wrapf(types.ErrPoolFull, "%d unbatched transfers to chain id %d, the max is %d", count, chainID, limits.MaxEntries)
+	}
+	if err == nil || !limits.AboveMedianFee {
+		return err
+	}
+
+	if median := k.poolMedianFee(ctx, chainID, contract, aggregate.Count); !fee.GT(median) {
+		return sdkerrors.Wrapf(err, "fee %s isn't above the median fee %s", fee, median)
+	}
+	return nil
+}
+
+// poolCount returns the number of unbatched transfers to the chain, all tokens included
+func (k Keeper) poolCount(ctx sdk.Context, chainID uint64) uint64 {
+	var count uint64
+	k.IteratePoolAggregates(ctx, chainID, func(_ common.Address, aggregate types.PoolAggregate) bool {
+		count += aggregate.Count
+		return false
+	})
+	return count
+}
+
+// poolMedianFee returns the median fee of the count unbatched transfers of the token to the
+// chain, the lower of the two middle fees for an even count and zero for an empty pool
+func (k Keeper) poolMedianFee(ctx sdk.Context, chainID uint64, contract common.Address, count uint64) sdk.Int {
+	median := sdk.ZeroInt()
+	// the transfers are iterated from the highest fee
+	i := uint64(0)
+	k.iterateUnbatchedSendToEthereumsByContract(ctx, chainID, contract, func(send *types.SendToEthereum) bool {
+		if i == count/2 {
+			median = send.Erc20Fee.Amount
+			return true
+		}
+		i++
+		return false
+	})
+	return median
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestPoolLimits(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		sender    = AccAddrs[0]
+		recipient = EthAddrs[0].Hex()
+		tokenA    = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		tokenB    = common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+		denomA    = types.NewERC20Token(0, tokenA).GravityCoin().Denom
+		denomB    = types.NewERC20Token(0, tokenB).GravityCoin().Denom
+	)
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(denomA, 1000), sdk.NewInt64Coin(denomB, 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, vouchers))
+	send := func(denom string, fee int64) error {
+		_, err := k.createSendToEthereum(ctx, chainID, sender, recipient, sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin(denom, fee))
+		return err
+	}
+
+	for _, fee := range []int64{2, 3, 2, 1} {
+		require.NoError(t, send(denomA, fee))
+	}
+
+	// a full token pool rejects the transfers of its token
+	params := k.GetParams(ctx)
+	params.PoolLimits = types.PoolLimits{MaxEntries: 5, MaxTokenEntries: 4}
+	require.NoError(t, params.ValidateBasic())
+	k.setParams(ctx, params)
+	require.ErrorIs(t, send(denomA, 10), types.ErrPoolFull)
+	require.NoError(t, send(denomB, 1))
+
+	// as does a full chain pool those of all tokens
+	require.ErrorIs(t, send(denomB, 10), types.ErrPoolFull)
+	require.Equal(t, uint64(5), k.poolCount(ctx, chainID))
+
+	// unless they pay more than the median fee of their token's pool
+	params.PoolLimits.AboveMedianFee = true
+	k.setParams(ctx, params)
+	require.Equal(t, sdk.NewInt(2), k.poolMedianFee(ctx, chainID, tokenA, 4))
+	require.ErrorIs(t, send(denomA, 2), types.ErrPoolFull)
+	require.NoError(t, send(denomA, 3))
+	require.Equal(t, sdk.NewInt(1), k.poolMedianFee(ctx, chainID, tokenB, 1))
+	require.ErrorIs(t, send(denomB, 1), types.ErrPoolFull)
+	require.NoError(t, send(denomB, 2))
+
+	// the limits only apply with a cap
+	params.PoolLimits = types.PoolLimits{AboveMedianFee: true}
+	require.Error(t, params.ValidateBasic())
+	params.PoolLimits = types.PoolLimits{MaxEntries: 1, MaxTokenEntries: 2}
+	require.Error(t, params.ValidateBasic())
+}
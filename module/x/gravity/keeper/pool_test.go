@@ -32,16 +32,16 @@ func TestAddToOutgoingPool(t *testing.T) {
 
 	// then
 	var got []*types.SendToEthereum
-	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(tx *types.SendToEthereum) bool {
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, TestingGravityParams.BridgeChainId, func(tx *types.SendToEthereum) bool {
 		got = append(got, tx)
 		return false
 	})
 
 	exp := []*types.SendToEthereum{
-		types.NewSendToEthereumTx(2, myTokenContractAddr, mySender, myReceiver, 101, 3),
-		types.NewSendToEthereumTx(3, myTokenContractAddr, mySender, myReceiver, 102, 2),
-		types.NewSendToEthereumTx(1, myTokenContractAddr, mySender, myReceiver, 100, 2),
-		types.NewSendToEthereumTx(4, myTokenContractAddr, mySender, myReceiver, 103, 1),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 2, myTokenContractAddr, mySender, myReceiver, 101, 3),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 3, myTokenContractAddr, mySender, myReceiver, 102, 2),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 1, myTokenContractAddr, mySender, myReceiver, 100, 2),
+		types.NewSendToEthereumTx(TestingGravityParams.BridgeChainId, 4, myTokenContractAddr, mySender, myReceiver, 103, 1),
 	}
 
 	require.Equal(t, exp, got)
@@ -51,3 +51,27 @@ func TestAddToOutgoingPool(t *testing.T) {
 	require.EqualValues(t, exp[3], got[3])
 	require.Len(t, got, 4)
 }
+
+func BenchmarkCreateSendToEthereum(b *testing.B) {
+	input := CreateTestEnv(b)
+	ctx := input.Context
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		amount              = types.NewERC20Token(100, myTokenContractAddr).GravityCoin()
+		fee                 = types.NewERC20Token(1, myTokenContractAddr).GravityCoin()
+	)
+	allVouchers := sdk.Coins{types.NewERC20Token(uint64(b.N)*101, myTokenContractAddr).GravityCoin()}
+	require.NoError(b, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(b, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := input.GravityKeeper.createSendToEthereum(ctx, TestingGravityParams.BridgeChainId, mySender, myReceiver.Hex(), amount, fee); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
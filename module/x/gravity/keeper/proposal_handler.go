@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
 )
 
@@ -22,7 +26,7 @@ func (k Keeper) HandleCommunityPoolEthereumSpendProposal(ctx sdk.Context, p *typ
 	feePool.CommunityPool = newPool
 	sender := authtypes.NewModuleAddress(distributiontypes.ModuleName)
 
-	txID, err := k.createSendToEthereum(ctx, sender, p.Recipient, p.Amount, p.BridgeFee)
+	txID, err := k.createSendToEthereum(ctx, k.getBridgeChainID(ctx), sender, p.Recipient, p.Amount, p.BridgeFee)
 	if err != nil {
 		return err
 	}
@@ -32,3 +36,142 @@ func (k Keeper) HandleCommunityPoolEthereumSpendProposal(ctx sdk.Context, p *typ
 
 	return nil
 }
+
+func (k Keeper) HandleAddEVMChainProposal(ctx sdk.Context, p *types.AddEVMChainProposal) error {
+	if err := k.AddEVMChain(ctx, p.Chain); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("evm chain added", "chain id", p.Chain.ChainId, "name", p.Chain.Name, "bridge contract", p.Chain.BridgeEthereumAddress)
+
+	return nil
+}
+
+func (k Keeper) HandleEVMChainPauseProposal(ctx sdk.Context, p *types.EVMChainPauseProposal) error {
+	chainID, err := k.resolveEVMChainID(ctx, p.EvmChainId)
+	if err != nil {
+		return err
+	}
+
+	return k.changeEVMChainPause(ctx, chainID, p.Paused)
+}
+
+func (k Keeper) HandleGravityIDRotationProposal(ctx sdk.Context, p *types.GravityIDRotationProposal) error {
+	chainID, err := k.resolveEVMChainID(ctx, p.EvmChainId)
+	if err != nil {
+		return err
+	}
+
+	previous := k.getGravityID(ctx, chainID)
+	if err := k.rotateGravityID(ctx, chainID, p.GravityId, p.AcceptanceWindow); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("gravity id rotated", "chain id", chainID, "gravity id", p.GravityId, "previous gravity id", previous, "acceptance window", p.AcceptanceWindow)
+
+	return nil
+}
+
+func (k Keeper) HandleContractMigrationProposal(ctx sdk.Context, p *types.ContractMigrationProposal) error {
+	chainID, err := k.resolveEVMChainID(ctx, p.EvmChainId)
+	if err != nil {
+		return err
+	}
+
+	if err := k.startContractMigration(ctx, chainID, p.BridgeEthereumAddress, p.EthereumHeight); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("contract migration started", "chain id", chainID, "bridge contract", k.getBridgeContractAddress(ctx, chainID), "new bridge contract", p.BridgeEthereumAddress)
+
+	return nil
+}
+
+func (k Keeper) HandleUpdateParamsProposal(ctx sdk.Context, p *types.UpdateParamsProposal) error {
+	msg := types.NewMsgUpdateParams(authtypes.NewModuleAddress(govtypes.ModuleName), p.Params, p.EffectiveHeight)
+	if _, err := NewMsgServerImpl(k).UpdateParams(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("params updated")
+
+	return nil
+}
+
+func (k Keeper) HandleRelayerIncentiveProposal(ctx sdk.Context, p *types.RelayerIncentiveProposal) error {
+	chainID, err := k.resolveEVMChainID(ctx, p.EvmChainId)
+	if err != nil {
+		return err
+	}
+
+	// like for community pool Ethereum spends the community pool is reduced
+	// separately from moving its coins out of the distribution module account
+	feePool := k.DistributionKeeper.GetFeePool(ctx)
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(p.Amount...))
+	if negative {
+		return distributiontypes.ErrBadDistribution
+	}
+	feePool.CommunityPool = newPool
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, distributiontypes.ModuleName, types.RelayerRewardAddress(), p.Amount); err != nil {
+		return err
+	}
+	k.DistributionKeeper.SetFeePool(ctx, feePool)
+
+	incentive := k.createRelayerIncentive(ctx, chainID, p.Amount, p.DisbursementPeriod, p.Disbursements)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRelayerIncentive,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRelayerIncentiveID, strconv.FormatUint(incentive.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.FormatUint(chainID, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmount, p.Amount.String()),
+	))
+	k.Logger(ctx).Info("relayer incentive funded from the community pool", "id", incentive.Id, "chain id", chainID, "amount", p.Amount.String(), "disbursements", p.Disbursements)
+
+	return nil
+}
+
+func (k Keeper) HandleEthereumEventRejectionProposal(ctx sdk.Context, p *types.EthereumEventRejectionProposal) error {
+	chainID, err := k.resolveEVMChainID(ctx, p.EvmChainId)
+	if err != nil {
+		return err
+	}
+
+	eventHash, err := p.GetEventHash()
+	if err != nil {
+		return err
+	}
+	if err := k.RejectEthereumEventVoteRecord(ctx, chainID, p.EventNonce, eventHash); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("ethereum event vote record rejected", "chain id", chainID, "nonce", p.EventNonce, "event hash", eventHash.String())
+
+	return nil
+}
+
+func (k Keeper) HandleIncidentRecoveryProposal(ctx sdk.Context, p *types.IncidentRecoveryProposal) error {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	account, err := sdk.AccAddressFromBech32(p.Account)
+	if err != nil {
+		return err
+	}
+
+	msgServer := NewMsgServerImpl(k)
+	switch p.Action {
+	case types.IncidentActionMint:
+		_, err = msgServer.MintVouchers(sdk.WrapSDKContext(ctx), types.NewMsgMintVouchers(authority, account, p.Amount, p.Reason))
+	case types.IncidentActionBurn:
+		_, err = msgServer.BurnVouchers(sdk.WrapSDKContext(ctx), types.NewMsgBurnVouchers(authority, account, p.Amount, p.Reason))
+	default:
+		err = sdkerrors.Wrapf(types.ErrInvalid, "incident action %s", p.Action)
+	}
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("incident recovery executed", "action", p.Action.String(), "account", p.Account, "amount", p.Amount.String())
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetRateLimitUsage returns what has been transferred of the token to the EVM chain
+// since the start of the window it was last transferred in
+func (k Keeper) GetRateLimitUsage(ctx sdk.Context, chainID uint64, tokenContract common.Address) (types.RateLimitUsage, bool) {
+	bz := k.chainStore(ctx, chainID).Get(types.MakeRateLimitUsageKey(tokenContract))
+	if bz == nil {
+		return types.RateLimitUsage{}, false
+	}
+	var usage types.RateLimitUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage, true
+}
+
+func (k Keeper) setRateLimitUsage(ctx sdk.Context, chainID uint64, tokenContract common.Address, usage types.RateLimitUsage) {
+	k.chainStore(ctx, chainID).Set(types.MakeRateLimitUsageKey(tokenContract), k.cdc.MustMarshal(&usage))
+}
+
+func (k Keeper) iterateRateLimitUsages(ctx sdk.Context, chainID uint64, cb func(tokenContract common.Address, usage types.RateLimitUsage) bool) {
+	iter := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.RateLimitUsageKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var usage types.RateLimitUsage
+		k.cdc.MustUnmarshal(iter.Value(), &usage)
+		if cb(common.BytesToAddress(iter.Key()), usage) {
+			break
+		}
+	}
+}
+
+// consumeRateLimit adds the amount to what has been transferred of the token to the EVM
+// chain in the current window, erroring if that would exceed the token's rate limit.
+// Tokens without a rate limit aren't tracked.
+func (k Keeper) consumeRateLimit(ctx sdk.Context, chainID uint64, tokenContract common.Address, amount sdk.Int) error {
+	chain, _ := k.GetEVMChain(ctx, chainID)
+	limit, found := chain.GetRateLimit(tokenContract)
+	if !found {
+		return nil
+	}
+
+	height := uint64(ctx.BlockHeight())
+	usage, found := k.GetRateLimitUsage(ctx, chainID, tokenContract)
+	if !found || height >= usage.WindowStart+limit.Window {
+		usage = types.RateLimitUsage{WindowStart: height, Amount: sdk.ZeroInt()}
+	}
+
+	total := usage.Amount.Add(amount)
+	if total.GT(limit.Limit) {
+		return sdkerrors.Wrapf(
+			types.ErrRateLimited,
+			"%s of token %s would be transferred to chain id %d in the window starting at height %d, the limit is %s",
+			total, tokenContract.Hex(), chainID, usage.WindowStart, limit.Limit,
+		)
+	}
+
+	usage.Amount = total
+	k.setRateLimitUsage(ctx, chainID, tokenContract, usage)
+	return nil
+}
+
+// ConsumeVoucherRateLimit adds an amount of vouchers leaving this chain other than to
+// their EVM chain, e.g. over IBC, to what has been transferred of their ERC20 to the
+// chain, so that they are capped by the same rate limit. Denoms other than vouchers of
+// an ERC20 aren't limited.
+func (k Keeper) ConsumeVoucherRateLimit(ctx sdk.Context, denom string, amount sdk.Int) error {
+	var chainID uint64
+	tokenContract, err := types.GravityDenomToERC20(denom)
+	if err == nil {
+		chainID = k.getBridgeChainID(ctx)
+	} else if chainID, tokenContract, err = types.EVMChainGravityDenomToERC20(denom); err != nil {
+		return nil
+	}
+	return k.consumeRateLimit(ctx, chainID, common.HexToAddress(tokenContract), amount)
+}
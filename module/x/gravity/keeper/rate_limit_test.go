@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestRateLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		sender, _     = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		receiver      = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		otherContract = common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+		vouchers      = sdk.NewCoins(
+			types.NewERC20Token(99999, tokenContract).GravityCoin(),
+			types.NewERC20Token(99999, otherContract).GravityCoin(),
+		)
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, sender, vouchers))
+
+	params := k.GetParams(ctx)
+	params.EthereumRateLimits = []types.RateLimit{{TokenContract: tokenContract.Hex(), Limit: sdk.NewInt(100), Window: 10}}
+	k.setParams(ctx, params)
+
+	send := func(ctx sdk.Context, tokenContract common.Address, amount uint64) error {
+		_, err := k.createSendToEthereum(ctx, chainID, sender, receiver.Hex(),
+			types.NewERC20Token(amount, tokenContract).GravityCoin(), types.NewERC20Token(1, tokenContract).GravityCoin())
+		return err
+	}
+
+	// fees count towards the limit
+	require.NoError(t, send(ctx, tokenContract, 59))
+	require.ErrorIs(t, send(ctx, tokenContract, 40), types.ErrRateLimited)
+	require.NoError(t, send(ctx, tokenContract, 39))
+	usage, found := k.GetRateLimitUsage(ctx, chainID, tokenContract)
+	require.True(t, found)
+	require.Equal(t, types.RateLimitUsage{WindowStart: uint64(ctx.BlockHeight()), Amount: sdk.NewInt(100)}, usage)
+
+	// tokens without a limit aren't tracked
+	require.NoError(t, send(ctx, otherContract, 1000))
+	_, found = k.GetRateLimitUsage(ctx, chainID, otherContract)
+	require.False(t, found)
+
+	// each chain has limits and usage of its own
+	limited := testEVMChain
+	limited.RateLimits = []types.RateLimit{{TokenContract: tokenContract.Hex(), Limit: sdk.NewInt(5), Window: 10}}
+	require.NoError(t, k.AddEVMChain(ctx, limited))
+	require.ErrorIs(t, k.consumeRateLimit(ctx, limited.ChainId, tokenContract, sdk.NewInt(6)), types.ErrRateLimited)
+	require.NoError(t, k.consumeRateLimit(ctx, limited.ChainId, tokenContract, sdk.NewInt(5)))
+	usage, _ = k.GetRateLimitUsage(ctx, chainID, tokenContract)
+	require.Equal(t, sdk.NewInt(100), usage.Amount)
+
+	// the usage starts over with the next window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 9)
+	require.ErrorIs(t, send(ctx, tokenContract, 1), types.ErrRateLimited)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, send(ctx, tokenContract, 99))
+	require.ErrorIs(t, send(ctx, tokenContract, 1), types.ErrRateLimited)
+}
+
+func TestConsumeVoucherRateLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	params := k.GetParams(ctx)
+	params.EthereumRateLimits = []types.RateLimit{{TokenContract: tokenContract.Hex(), Limit: sdk.NewInt(100), Window: 10}}
+	k.setParams(ctx, params)
+	limited := testEVMChain
+	limited.RateLimits = []types.RateLimit{{TokenContract: tokenContract.Hex(), Limit: sdk.NewInt(5), Window: 10}}
+	require.NoError(t, k.AddEVMChain(ctx, limited))
+
+	// vouchers share the limit of their ERC20 on their chain
+	require.NoError(t, k.ConsumeVoucherRateLimit(ctx, types.GravityDenom(tokenContract), sdk.NewInt(60)))
+	require.NoError(t, k.consumeRateLimit(ctx, chainID, tokenContract, sdk.NewInt(40)))
+	require.ErrorIs(t, k.ConsumeVoucherRateLimit(ctx, types.GravityDenom(tokenContract), sdk.NewInt(1)), types.ErrRateLimited)
+	require.ErrorIs(t, k.ConsumeVoucherRateLimit(ctx, types.EVMChainGravityDenom(limited.ChainId, tokenContract), sdk.NewInt(6)), types.ErrRateLimited)
+	require.NoError(t, k.ConsumeVoucherRateLimit(ctx, types.EVMChainGravityDenom(limited.ChainId, tokenContract), sdk.NewInt(5)))
+
+	// other denoms aren't limited
+	require.NoError(t, k.ConsumeVoucherRateLimit(ctx, "uatom", sdk.NewInt(1000)))
+	require.NoError(t, k.ConsumeVoucherRateLimit(ctx, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.NewInt(1000)))
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// refunds accumulates the coins issued back to accounts from the tokens of a chain, so that a
+// flow refunding many entries mints and releases them at once and sends them with a single
+// bank transfer per recipient rather than one per entry
+type refunds struct {
+	chainID    uint64
+	minted     sdk.Coins
+	released   sdk.Coins
+	recipients []string
+	coins      map[string]sdk.Coins
+}
+
+func newRefunds(chainID uint64) *refunds {
+	return &refunds{chainID: chainID, coins: make(map[string]sdk.Coins)}
+}
+
+// addTokenRefund adds the refund of the ERC20 token of the chain to the recipient, as the coins of
+// its denom
+func (k Keeper) addTokenRefund(ctx sdk.Context, r *refunds, recipient sdk.AccAddress, token types.ERC20Token) {
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, r.chainID, common.HexToAddress(token.Contract))
+	chain, _ := k.GetEVMChain(ctx, r.chainID)
+	coins := sdk.NewCoins(sdk.NewCoin(denom, chain.DenomAmount(denom, token.Amount)))
+	if coins.IsZero() {
+		return
+	}
+
+	// If it is not cosmos-originated the coins are minted, otherwise released from the chain's escrow
+	if isCosmosOriginated {
+		r.released = r.released.Add(coins...)
+	} else {
+		r.minted = r.minted.Add(coins...)
+	}
+	address := recipient.String()
+	if _, ok := r.coins[address]; !ok {
+		r.recipients = append(r.recipients, address)
+	}
+	r.coins[address] = r.coins[address].Add(coins...)
+}
+
+// issueRefunds mints and releases the accumulated coins, then sends them to their recipients
+// in the order they were first added
+func (k Keeper) issueRefunds(ctx sdk.Context, r *refunds) error {
+	if !r.minted.IsZero() {
+		if err := k.issueVouchers(ctx, r.minted); err != nil {
+			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", r.minted)
+		}
+	}
+	if !r.released.IsZero() {
+		if err := k.releaseCoins(ctx, r.chainID, r.released); err != nil {
+			return err
+		}
+	}
+	for _, address := range r.recipients {
+		recipient, _ := sdk.AccAddressFromBech32(address)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, r.coins[address]); err != nil {
+			return sdkerrors.Wrap(err, "sending coins from module account")
+		}
+	}
+	return nil
+}
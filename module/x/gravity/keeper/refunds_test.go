@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestIssueRefunds(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		ucosmosContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		tokenContract   = common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+		_, voucherDenom = k.ERC20ToDenomLookup(ctx, chainID, tokenContract)
+	)
+	k.setCosmosOriginatedDenomToERC20(ctx, chainID, "ucosmos", ucosmosContract)
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin("ucosmos", 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, escrowed))
+	require.NoError(t, k.escrowCoins(ctx, chainID, escrowed))
+
+	// many refunds to the same accounts are issued with a send per account
+	refunds := newRefunds(chainID)
+	for i := 0; i < 10; i++ {
+		k.addTokenRefund(ctx, refunds, AccAddrs[i%2], types.NewSDKIntERC20Token(sdk.NewInt(10), tokenContract))
+		k.addTokenRefund(ctx, refunds, AccAddrs[i%2], types.NewSDKIntERC20Token(sdk.NewInt(20), ucosmosContract))
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.issueRefunds(ctx, refunds))
+
+	sends := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != banktypes.EventTypeTransfer {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == banktypes.AttributeKeyRecipient && (string(attr.Value) == AccAddrs[0].String() || string(attr.Value) == AccAddrs[1].String()) {
+				sends++
+			}
+		}
+	}
+	require.Equal(t, 2, sends)
+
+	for _, addr := range AccAddrs[:2] {
+		require.Equal(t, sdk.NewInt(50), input.BankKeeper.GetBalance(ctx, addr, voucherDenom).Amount)
+		require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, addr, "ucosmos").Amount)
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ucosmos", 800)), k.GetEscrowedCoins(ctx, chainID))
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
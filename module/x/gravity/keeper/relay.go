@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// relaySignatures returns the signatures of the outgoing tx at the store index by the
+// Ethereum address of the validator that signed it
+func (k Keeper) relaySignatures(ctx sdk.Context, chainID uint64, storeIndex []byte) map[common.Address][]byte {
+	signatures := make(map[common.Address][]byte)
+	k.iterateEthereumSignatures(ctx, chainID, storeIndex, func(val sdk.ValAddress, sig []byte) bool {
+		signatures[k.GetValidatorEthereumAddress(ctx, val)] = sig
+		return false
+	})
+	return signatures
+}
+
+// relayCalldata returns the calldata of the Gravity contract call relaying the outgoing tx,
+// signed by the last observed signer set of the contract
+func (k Keeper) relayCalldata(ctx sdk.Context, chainID uint64, otx types.OutgoingTx) ([]byte, error) {
+	current := k.GetLastObservedSignerSetTx(ctx, chainID)
+	if current == nil || len(current.Signers) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrNotRelayable, "no signer set of the contract observed")
+	}
+	return k.signatureScheme(ctx).RelayCalldata(otx, *current, k.relaySignatures(ctx, chainID, otx.GetStoreIndex()))
+}
+
+// emitOutgoingTxRelayable emits the relay calldata of the outgoing tx if the signature of the
+// signer was the one making its signed power pass the threshold of the contract
+func (k Keeper) emitOutgoingTxRelayable(ctx sdk.Context, chainID uint64, otx types.OutgoingTx, signer common.Address) {
+	current := k.GetLastObservedSignerSetTx(ctx, chainID)
+	if current == nil {
+		return
+	}
+	signers := types.EthereumSigners(current.Signers)
+	signatures := k.relaySignatures(ctx, chainID, otx.GetStoreIndex())
+	power := signers.SignedPower(signatures)
+	signerPower := signers.SignedPower(map[common.Address][]byte{signer: nil})
+	if power <= types.ContractPowerThreshold || power-signerPower > types.ContractPowerThreshold {
+		return
+	}
+
+	calldata, err := k.signatureScheme(ctx).RelayCalldata(otx, *current, signatures)
+	if err != nil {
+		k.Logger(ctx).Error("failed to encode the relay calldata", "chain id", chainID, "error", err)
+		return
+	}
+	emitTypedEvent(ctx, &types.EventOutgoingTxRelayable{
+		EvmChainId:      chainID,
+		StoreIndex:      otx.GetStoreIndex(),
+		ContractAddress: k.getBridgeContractAddress(ctx, chainID),
+		Calldata:        calldata,
+	})
+}
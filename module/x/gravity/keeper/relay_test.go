@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"crypto/ecdsa"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestRelayCalldata(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	var (
+		orcAddrs = []sdk.AccAddress{AccAddrs[0], AccAddrs[1], AccAddrs[2]}
+		valAddrs = []sdk.ValAddress{sdk.ValAddress(AccAddrs[0]), sdk.ValAddress(AccAddrs[1]), sdk.ValAddress(AccAddrs[2])}
+		ethKeys  = make([]*ecdsa.PrivateKey, len(valAddrs))
+	)
+	k.StakingKeeper = NewStakingKeeperMock(valAddrs...)
+	for i := range valAddrs {
+		var err error
+		ethKeys[i], err = ethCrypto.GenerateKey()
+		require.NoError(t, err)
+		k.SetOrchestratorValidatorAddress(ctx, valAddrs[i], orcAddrs[i])
+		k.setValidatorEthereumAddress(ctx, valAddrs[i], ethCrypto.PubkeyToAddress(ethKeys[i].PublicKey))
+	}
+
+	// the contract runs the first signer set, the second is relayed to it
+	current := k.CreateSignerSetTx(ctx, chainID)
+	k.setLastObservedSignerSetTx(ctx, chainID, *current)
+	signerSetTx := k.CreateSignerSetTx(ctx, chainID)
+
+	msgServer := NewMsgServerImpl(k)
+	confirm := func(i int) sdk.Events {
+		signature, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(TestingGravityParams.GravityId)), ethKeys[i])
+		require.NoError(t, err)
+		confirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: ethCrypto.PubkeyToAddress(ethKeys[i].PublicKey).Hex(),
+			Signature:      signature,
+		})
+		require.NoError(t, err)
+
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: confirmation,
+			Signer:       orcAddrs[i].String(),
+		})
+		require.NoError(t, err)
+		return ctx.EventManager().Events()
+	}
+	relayable := func(events sdk.Events) (out []*types.EventOutgoingTxRelayable) {
+		for _, event := range events.ToABCIEvents() {
+			if parsed, err := sdk.ParseTypedEvent(event); err == nil {
+				if relayable, ok := parsed.(*types.EventOutgoingTxRelayable); ok {
+					out = append(out, relayable)
+				}
+			}
+		}
+		return out
+	}
+	query := func() (*types.RelayCalldataResponse, error) {
+		return k.RelayCalldata(sdk.WrapSDKContext(ctx), &types.RelayCalldataRequest{StoreIndex: signerSetTx.GetStoreIndex()})
+	}
+
+	// a third of the power doesn't pass the threshold of the contract
+	require.Empty(t, relayable(confirm(0)))
+	_, err := query()
+	require.ErrorIs(t, err, types.ErrNotRelayable)
+
+	// the second signature does, the calldata is emitted once and can be queried
+	events := relayable(confirm(1))
+	require.Len(t, events, 1)
+	require.Equal(t, signerSetTx.GetStoreIndex(), events[0].StoreIndex)
+	res, err := query()
+	require.NoError(t, err)
+	require.Equal(t, events[0].Calldata, res.Calldata)
+	require.Empty(t, relayable(confirm(2)))
+
+	relayABI, err := abi.JSON(strings.NewReader(types.GravityRelayABIJSON))
+	require.NoError(t, err)
+	method, err := relayABI.MethodById(events[0].Calldata[:4])
+	require.NoError(t, err)
+	require.Equal(t, "updateValset", method.Name)
+	values, err := method.Inputs.Unpack(events[0].Calldata[4:])
+	require.NoError(t, err)
+
+	newValset := *abi.ConvertType(values[0], new(types.ABIEncodedValsetArgs)).(*types.ABIEncodedValsetArgs)
+	require.Equal(t, signerSetTx.Nonce, newValset.Nonce.Uint64())
+	currentValset := *abi.ConvertType(values[1], new(types.ABIEncodedValsetArgs)).(*types.ABIEncodedValsetArgs)
+	require.Equal(t, current.Nonce, currentValset.Nonce.Uint64())
+	require.Equal(t, current.ABIEncodedValsetArgs().Validators, currentValset.Validators)
+
+	// the signatures at the time of the event are ordered as the signers of the contract
+	sigs := *abi.ConvertType(values[2], new([]types.ABIEncodedValSignature)).(*[]types.ABIEncodedValSignature)
+	require.Len(t, sigs, len(currentValset.Validators))
+	for i, signer := range currentValset.Validators {
+		if signer == ethCrypto.PubkeyToAddress(ethKeys[2].PublicKey) {
+			require.Zero(t, sigs[i].V)
+			continue
+		}
+		signature := append(append(sigs[i].R[:], sigs[i].S[:]...), sigs[i].V)
+		require.NoError(t, types.ValidateEthereumSignature(signerSetTx.GetCheckpoint([]byte(TestingGravityParams.GravityId)), signature, signer))
+	}
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetRelayerIncentive returns the relayer incentive with the id
+func (k Keeper) GetRelayerIncentive(ctx sdk.Context, id uint64) (types.RelayerIncentive, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeRelayerIncentiveKey(id))
+	if bz == nil {
+		return types.RelayerIncentive{}, false
+	}
+	var incentive types.RelayerIncentive
+	k.cdc.MustUnmarshal(bz, &incentive)
+	return incentive, true
+}
+
+func (k Keeper) setRelayerIncentive(ctx sdk.Context, incentive types.RelayerIncentive) {
+	ctx.KVStore(k.storeKey).Set(types.MakeRelayerIncentiveKey(incentive.Id), k.cdc.MustMarshal(&incentive))
+}
+
+// IterateRelayerIncentives iterates over the relayer incentives of all EVM chains by id
+func (k Keeper) IterateRelayerIncentives(ctx sdk.Context, cb func(types.RelayerIncentive) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.RelayerIncentiveKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var incentive types.RelayerIncentive
+		k.cdc.MustUnmarshal(iter.Value(), &incentive)
+		if cb(incentive) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getLastRelayerIncentiveID(ctx sdk.Context) uint64 {
+	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastRelayerIncentiveIDKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+func (k Keeper) setLastRelayerIncentiveID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastRelayerIncentiveIDKey}, sdk.Uint64ToBigEndian(id))
+}
+
+// createRelayerIncentive schedules the disbursement of coins already moved to the relayer
+// reward account, the first disbursement being made a period from now
+func (k Keeper) createRelayerIncentive(ctx sdk.Context, chainID uint64, amount sdk.Coins, disbursementPeriod, disbursements uint64) types.RelayerIncentive {
+	id := k.getLastRelayerIncentiveID(ctx) + 1
+	k.setLastRelayerIncentiveID(ctx, id)
+
+	incentive := types.RelayerIncentive{
+		Id:                     id,
+		EvmChainId:             chainID,
+		Amount:                 amount,
+		Disbursed:              sdk.NewCoins(),
+		DisbursementPeriod:     disbursementPeriod,
+		Disbursements:          disbursements,
+		NextDisbursementHeight: uint64(ctx.BlockHeight()) + disbursementPeriod,
+	}
+	k.setRelayerIncentive(ctx, incentive)
+	return incentive
+}
+
+// DisburseRelayerIncentives makes the disbursements of the relayer incentives due at this
+// height. A disbursement is split between the orchestrators of the latest signer set of the
+// incentive's chain in proportion to their power, the remainder of the split going to the
+// first of them. Disbursements of chains that are paused or have no signer set to pay are
+// postponed by a period.
+func (k Keeper) DisburseRelayerIncentives(ctx sdk.Context) {
+	var due []types.RelayerIncentive
+	k.IterateRelayerIncentives(ctx, func(incentive types.RelayerIncentive) bool {
+		if !incentive.Completed() && incentive.NextDisbursementHeight <= uint64(ctx.BlockHeight()) {
+			due = append(due, incentive)
+		}
+		return false
+	})
+
+	for _, incentive := range due {
+		incentive.NextDisbursementHeight += incentive.DisbursementPeriod
+
+		recipients, powers := k.relayerIncentiveRecipients(ctx, incentive.EvmChainId)
+		if k.IsEVMChainPaused(ctx, incentive.EvmChainId) || len(recipients) == 0 {
+			k.setRelayerIncentive(ctx, incentive)
+			k.Logger(ctx).Info("relayer incentive disbursement postponed", "id", incentive.Id, "chain id", incentive.EvmChainId)
+			continue
+		}
+
+		part := incentive.NextDisbursement()
+		xCtx, commit := ctx.CacheContext()
+		if err := k.payRelayerIncentive(xCtx, part, recipients, powers); err != nil {
+			// the reward account is checked by an invariant to hold what remains to be
+			// disbursed, a failure here is postponed rather than halting the chain
+			k.setRelayerIncentive(ctx, incentive)
+			k.Logger(ctx).Error("relayer incentive disbursement failed", "id", incentive.Id, "chain id", incentive.EvmChainId, "error", err)
+			continue
+		}
+		commit()
+		incentive.Disbursed = incentive.Disbursed.Add(part...)
+		incentive.DisbursementsMade++
+		k.setRelayerIncentive(ctx, incentive)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeIncentiveDisbursed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRelayerIncentiveID, strconv.FormatUint(incentive.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.FormatUint(incentive.EvmChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, part.String()),
+		))
+	}
+}
+
+// relayerIncentiveRecipients returns the orchestrators of the latest signer set of the chain
+// with their power, leaving out signers without an orchestrator
+func (k Keeper) relayerIncentiveRecipients(ctx sdk.Context, chainID uint64) ([]sdk.AccAddress, []uint64) {
+	signerSet := k.GetLatestSignerSetTx(ctx, chainID)
+	if signerSet == nil {
+		return nil, nil
+	}
+
+	var (
+		recipients []sdk.AccAddress
+		powers     []uint64
+	)
+	for _, signer := range signerSet.Signers {
+		orchestrator := k.GetEthereumOrchestratorAddress(ctx, common.HexToAddress(signer.EthereumAddress))
+		if orchestrator.Empty() || signer.Power == 0 {
+			continue
+		}
+		recipients = append(recipients, orchestrator)
+		powers = append(powers, signer.Power)
+	}
+	return recipients, powers
+}
+
+// payRelayerIncentive splits the coins out of the relayer reward account between the
+// recipients in proportion to their power
+func (k Keeper) payRelayerIncentive(ctx sdk.Context, coins sdk.Coins, recipients []sdk.AccAddress, powers []uint64) error {
+	var totalPower uint64
+	for _, power := range powers {
+		totalPower += power
+	}
+
+	shares := make([]sdk.Coins, len(recipients))
+	remainder := coins
+	for i := range recipients {
+		share := make([]sdk.Coin, 0, len(coins))
+		for _, coin := range coins {
+			share = append(share, sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewIntFromUint64(powers[i])).Quo(sdk.NewIntFromUint64(totalPower))))
+		}
+		shares[i] = sdk.NewCoins(share...)
+		remainder = remainder.Sub(shares[i])
+	}
+	shares[0] = shares[0].Add(remainder...)
+
+	for i, recipient := range recipients {
+		if shares[i].IsZero() {
+			continue
+		}
+		if err := k.bankKeeper.SendCoins(ctx, types.RelayerRewardAddress(), recipient, shares[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRelayerRewardBalance returns the coins held by the relayer reward account
+func (k Keeper) GetRelayerRewardBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, types.RelayerRewardAddress())
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestRelayerIncentive(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	k.CreateSignerSetTx(ctx, chainID)
+
+	// fund the community pool
+	pool := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, pool))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, pool))
+	feePool := input.DistKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(pool...)
+	input.DistKeeper.SetFeePool(ctx, feePool)
+
+	// incentives can't spend more than the community pool holds
+	proposal := types.NewRelayerIncentiveProposal("incentive", "subsidize relaying", 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)), 10, 3)
+	require.ErrorIs(t, k.HandleRelayerIncentiveProposal(ctx, proposal), distrtypes.ErrBadDistribution)
+
+	proposal.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, k.HandleRelayerIncentiveProposal(ctx, proposal))
+	require.True(t, input.DistKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+	require.Equal(t, proposal.Amount, k.GetRelayerRewardBalance(ctx))
+
+	incentive, found := k.GetRelayerIncentive(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, chainID, incentive.EvmChainId)
+	require.Equal(t, uint64(ctx.BlockHeight())+10, incentive.NextDisbursementHeight)
+
+	balances := func() []sdk.Int {
+		var out []sdk.Int
+		for _, addr := range AccAddrs {
+			out = append(out, input.BankKeeper.GetBalance(ctx, addr, "stake").Amount)
+		}
+		return out
+	}
+	before := balances()
+
+	// nothing is paid out before the first disbursement is due
+	k.DisburseRelayerIncentives(ctx)
+	require.Equal(t, before, balances())
+
+	// each disbursement is split between the orchestrators by power, the last one paying out
+	// what the rounding down left
+	for i, disbursed := range []int64{333, 666, 1000} {
+		ctx = ctx.WithBlockHeight(int64(incentive.NextDisbursementHeight) + int64(i)*10)
+		k.DisburseRelayerIncentives(ctx)
+		incentive, _ = k.GetRelayerIncentive(ctx, 1)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", disbursed)), incentive.Disbursed)
+
+		_, broken := RelayerRewardAccountInvariant(k)(ctx)
+		require.False(t, broken)
+	}
+	require.True(t, incentive.Completed())
+	require.True(t, k.GetRelayerRewardBalance(ctx).IsZero())
+
+	paid := sdk.ZeroInt()
+	for i, balance := range balances() {
+		paid = paid.Add(balance.Sub(before[i]))
+		require.True(t, balance.Sub(before[i]).GTE(sdk.NewInt(198)))
+	}
+	require.Equal(t, sdk.NewInt(1000), paid)
+
+	res, err := k.RelayerIncentives(sdk.WrapSDKContext(ctx), &types.RelayerIncentivesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RelayerIncentive{incentive}, res.Incentives)
+}
+
+func TestRelayerIncentivePostponed(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.RelayerRewardAddress(), coins))
+	incentive := k.createRelayerIncentive(ctx, chainID, coins, 5, 1)
+
+	// without a signer set there is no one to pay
+	ctx = ctx.WithBlockHeight(int64(incentive.NextDisbursementHeight))
+	k.DisburseRelayerIncentives(ctx)
+	postponed, _ := k.GetRelayerIncentive(ctx, incentive.Id)
+	require.Zero(t, postponed.DisbursementsMade)
+	require.Equal(t, incentive.NextDisbursementHeight+5, postponed.NextDisbursementHeight)
+
+	// an account holding less than what is left to disburse breaks the invariant
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, types.RelayerRewardAddress(), AccAddrs[0], coins))
+	_, broken := RelayerRewardAccountInvariant(k)(ctx)
+	require.True(t, broken)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// GetScheduledParamsUpdate returns the update of the params waiting for its height, nil if
+// there is none
+func (k Keeper) GetScheduledParamsUpdate(ctx sdk.Context) *types.ScheduledParamsUpdate {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.ScheduledParamsUpdateKey})
+	if bz == nil {
+		return nil
+	}
+	var update types.ScheduledParamsUpdate
+	k.cdc.MustUnmarshal(bz, &update)
+	return &update
+}
+
+func (k Keeper) setScheduledParamsUpdate(ctx sdk.Context, update types.ScheduledParamsUpdate) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.ScheduledParamsUpdateKey}, k.cdc.MustMarshal(&update))
+}
+
+func (k Keeper) deleteScheduledParamsUpdate(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete([]byte{types.ScheduledParamsUpdateKey})
+}
+
+// scheduleParamsUpdate schedules the params to replace the current ones at the height,
+// replacing any update scheduled before
+func (k Keeper) scheduleParamsUpdate(ctx sdk.Context, params types.Params, height uint64) error {
+	if height <= uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "effective height %d isn't after the current height %d", height, ctx.BlockHeight())
+	}
+	k.setScheduledParamsUpdate(ctx, types.ScheduledParamsUpdate{Params: params, Height: height})
+	return nil
+}
+
+// ApplyScheduledParamsUpdate replaces the params with the scheduled ones once their height
+// is reached
+func (k Keeper) ApplyScheduledParamsUpdate(ctx sdk.Context) {
+	update := k.GetScheduledParamsUpdate(ctx)
+	if update == nil || update.Height > uint64(ctx.BlockHeight()) {
+		return
+	}
+
+	k.setParams(ctx, update.Params)
+	k.deleteScheduledParamsUpdate(ctx)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeParamsUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyEffectiveHeight, strconv.FormatUint(update.Height, 10)),
+	))
+	k.Logger(ctx).Info("scheduled params update applied", "height", update.Height)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// signatureScheme returns the scheme of the signature_scheme param, which the validation of
+// the params restricts to the registered ones
+func (k Keeper) signatureScheme(ctx sdk.Context) types.SignatureScheme {
+	scheme, err := types.GetSignatureScheme(k.GetParams(ctx).SignatureScheme)
+	if err != nil {
+		panic(err)
+	}
+	return scheme
+}
+
+// signingPayloads returns the payloads the confirmations of the outgoing tx may sign, for each
+// of the accepted gravity ids of the EVM chain, the current one first
+func (k Keeper) signingPayloads(ctx sdk.Context, chainID uint64, otx types.OutgoingTx) []types.SigningPayload {
+	checkpoints, typedData := k.acceptedSigningPayloads(ctx, chainID)
+	var payloads []types.SigningPayload
+	for _, gravityID := range k.acceptedGravityIDs(ctx, chainID) {
+		if checkpoints {
+			payloads = append(payloads, types.SigningPayload{Kind: types.SigningPayloadCheckpoint, Hash: k.outgoingTxCheckpoint(otx, gravityID)})
+		}
+		if typedData {
+			payloads = append(payloads, types.SigningPayload{Kind: types.SigningPayloadTypedData, Hash: k.outgoingTxTypedDataDigest(otx, gravityID, chainID)})
+		}
+	}
+	return payloads
+}
+
+// validateConfirmationSignature returns an error unless the signature is the Ethereum
+// address' over one of the signing payloads of the outgoing tx under the signature scheme.
+// Orchestrators still signing for the previous gravity id of a rotation are accepted until
+// its window ends.
+func (k Keeper) validateConfirmationSignature(ctx sdk.Context, chainID uint64, otx types.OutgoingTx, signature []byte, ethAddress common.Address) error {
+	scheme := k.signatureScheme(ctx)
+	err := sdkerrors.Wrap(types.ErrInvalidSignature, "no signing payload accepted")
+	for _, payload := range k.signingPayloads(ctx, chainID, otx) {
+		if err = scheme.ValidateSignature(payload, signature, ethAddress); err == nil {
+			return nil
+		}
+	}
+	return err
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"context"
 	"testing"
 	"time"
 
@@ -48,8 +49,10 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -212,23 +215,31 @@ type TestInput struct {
 	Marshaler       codec.Codec
 	LegacyAmino     *codec.LegacyAmino
 	GravityStoreKey *sdk.KVStoreKey
+	TransferKeeper  *TransferKeeperMock
+	// the params subspace gravity kept its params in before consensus version 5
+	GravityParamSpace paramstypes.Subspace
 }
 
-func (input TestInput) AddSendToEthTxsToPool(t *testing.T, ctx sdk.Context, tokenContract gethcommon.Address, sender sdk.AccAddress, receiver gethcommon.Address, ids ...uint64) {
+func (input TestInput) AddSendToEthTxsToPool(t testing.TB, ctx sdk.Context, tokenContract gethcommon.Address, sender sdk.AccAddress, receiver gethcommon.Address, ids ...uint64) {
 	for i, id := range ids {
 		amount := types.NewERC20Token(uint64(i+100), tokenContract).GravityCoin()
 		fee := types.NewERC20Token(id, tokenContract).GravityCoin()
-		_, err := input.GravityKeeper.createSendToEthereum(ctx, sender, receiver.Hex(), amount, fee)
+		_, err := input.GravityKeeper.createSendToEthereum(ctx, TestingGravityParams.BridgeChainId, sender, receiver.Hex(), amount, fee)
 		require.NoError(t, err)
 	}
 }
 
+// SetParams sets the params of the gravity keeper, for tests outside the keeper package
+func (input TestInput) SetParams(ctx sdk.Context, params types.Params) {
+	input.GravityKeeper.setParams(ctx, params)
+}
+
 func (input TestInput) AddBalanceToBank(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
 	return fundAccount(ctx, input.BankKeeper, addr, balances)
 }
 
 // SetupFiveValChain does all the initialization for a 5 Validator chain using the keys here
-func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
+func SetupFiveValChain(t testing.TB) (TestInput, sdk.Context) {
 	t.Helper()
 	input := CreateTestEnv(t)
 
@@ -277,11 +288,12 @@ func SetupFiveValChain(t *testing.T) (TestInput, sdk.Context) {
 }
 
 // CreateTestEnv creates the keeper testing environment for gravity
-func CreateTestEnv(t *testing.T) TestInput {
+func CreateTestEnv(t testing.TB) TestInput {
 	t.Helper()
 
 	// Initialize store keys
 	gravityKey := sdk.NewKVStoreKey(types.StoreKey)
+	tkeyGravity := sdk.NewTransientStoreKey(types.TStoreKey)
 	keyAcc := sdk.NewKVStoreKey(authtypes.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyBank := sdk.NewKVStoreKey(banktypes.StoreKey)
@@ -295,6 +307,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(gravityKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyGravity, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
@@ -419,18 +432,23 @@ func CreateTestEnv(t *testing.T) TestInput {
 		getSubspace(paramsKeeper, slashingtypes.ModuleName).WithKeyTable(slashingtypes.ParamKeyTable()),
 	)
 
+	transferKeeper := &TransferKeeperMock{bankKeeper: bankKeeper}
+
 	k := NewKeeper(
 		marshaler,
 		gravityKey,
+		tkeyGravity,
 		getSubspace(paramsKeeper, types.DefaultParamspace),
 		accountKeeper,
 		stakingKeeper,
 		bankKeeper,
 		slashingKeeper,
 		distKeeper,
+		transferKeeper,
 		sdk.DefaultPowerReduction,
 		receiverModuleAccounts,
 		senderModuleAccounts,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	stakingKeeper = *stakingKeeper.SetHooks(
@@ -438,6 +456,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 			distKeeper.Hooks(),
 			slashingKeeper.Hooks(),
 			k.Hooks(),
+			k.VoterPowerHooks(),
 		),
 	)
 
@@ -455,6 +474,9 @@ func CreateTestEnv(t *testing.T) TestInput {
 		Marshaler:       marshaler,
 		LegacyAmino:     cdc,
 		GravityStoreKey: gravityKey,
+		TransferKeeper:  transferKeeper,
+
+		GravityParamSpace: k.paramSpace,
 	}
 }
 
@@ -491,7 +513,7 @@ func MakeTestMarshaler() codec.Codec {
 func MintVouchersFromAir(t *testing.T, ctx sdk.Context, k Keeper, dest sdk.AccAddress, amount types.ERC20Token) sdk.Coin {
 	coin := amount.GravityCoin()
 	vouchers := sdk.Coins{coin}
-	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers)
+	err := k.issueVouchers(ctx, vouchers)
 	require.NoError(t, err)
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, vouchers)
 	require.NoError(t, err)
@@ -701,6 +723,43 @@ func (s AlwaysPanicStakingMock) Jail(sdk.Context, sdk.ConsAddress) {
 	panic("unexpected call")
 }
 
+// TransferKeeperMock escrows the coins of ICS-20 transfers like the transfer module does
+// and records the transfers, failing them with Err if it is set. The sequence of a transfer
+// is its position in Transfers, counting from one. The denom traces it knows
+// are those in DenomTraces.
+type TransferKeeperMock struct {
+	bankKeeper  bankkeeper.Keeper
+	Transfers   []ibctransfertypes.MsgTransfer
+	Err         error
+	DenomTraces []ibctransfertypes.DenomTrace
+}
+
+func (m *TransferKeeperMock) Transfer(goCtx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	escrow := ibctransfertypes.GetEscrowAddress(msg.SourcePort, msg.SourceChannel)
+	if err := m.bankKeeper.SendCoins(ctx, sender, escrow, sdk.Coins{msg.Token}); err != nil {
+		return nil, err
+	}
+	m.Transfers = append(m.Transfers, *msg)
+	return &ibctransfertypes.MsgTransferResponse{Sequence: uint64(len(m.Transfers))}, nil
+}
+
+func (m *TransferKeeperMock) GetDenomTrace(_ sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+	for _, trace := range m.DenomTraces {
+		if bytes.Equal(trace.Hash(), denomTraceHash) {
+			return trace, true
+		}
+	}
+	return ibctransfertypes.DenomTrace{}, false
+}
+
 func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey ccrypto.PubKey, amt sdk.Int) *stakingtypes.MsgCreateValidator {
 	commission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 	out, err := stakingtypes.NewMsgCreateValidator(
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// transferRecord returns the transfer record of an event vote record, false if its event
+// wasn't observed or isn't a transfer
+func (k Keeper) transferRecord(chainID uint64, record *types.EthereumEventVoteRecord) (types.TransferRecord, bool) {
+	if !record.Accepted {
+		return types.TransferRecord{}, false
+	}
+	event, err := types.UnpackEvent(record.Event)
+	if err != nil {
+		panic(err)
+	}
+	return types.NewTransferRecord(chainID, event)
+}
+
+// IterateTransferHistory iterates over the completed transfers of the chain kept in state, by
+// event nonce
+func (k Keeper) IterateTransferHistory(ctx sdk.Context, chainID uint64, cb func(types.TransferRecord) bool) {
+	k.iterateEthereumEventVoteRecords(ctx, chainID, func(_ []byte, record *types.EthereumEventVoteRecord) bool {
+		if transfer, ok := k.transferRecord(chainID, record); ok {
+			return cb(transfer)
+		}
+		return false
+	})
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestTransferHistory(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	chainID := k.getBridgeChainID(ctx)
+
+	setRecord := func(event types.EthereumEvent, accepted bool) {
+		packed, err := types.PackEvent(event)
+		require.NoError(t, err)
+		k.setEthereumEventVoteRecord(ctx, chainID, event.GetEventNonce(), event.Hash(), &types.EthereumEventVoteRecord{
+			Event:    packed,
+			Votes:    []string{ValAddrs[0].String()},
+			Accepted: accepted,
+		})
+	}
+	deposit := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  EthAddrs[0].Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[1].String(),
+		EthereumHeight: 10,
+	}
+	setRecord(deposit, true)
+	setRecord(&types.ContractVersionEvent{EventNonce: 2, Version: 2, EthereumHeight: 11}, true)
+	setRecord(&types.BatchExecutedEvent{EventNonce: 3, TokenContract: EthAddrs[0].Hex(), BatchNonce: 4, EthereumHeight: 12}, true)
+	// events not observed yet aren't in the history
+	setRecord(&types.BatchExecutedEvent{EventNonce: 4, TokenContract: EthAddrs[0].Hex(), BatchNonce: 5, EthereumHeight: 13}, false)
+
+	// only the observed transfers are returned, by event nonce and page by page
+	res, err := k.TransferHistory(sdk.WrapSDKContext(ctx), &types.TransferHistoryRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.TransferRecord{{
+		EvmChainId:     chainID,
+		EventNonce:     1,
+		EthereumHeight: 10,
+		Kind:           types.TransferKindSendToCosmos,
+		TokenContract:  EthAddrs[0].Hex(),
+		Amount:         "100",
+		EthereumSender: EthAddrs[1].Hex(),
+		CosmosReceiver: AccAddrs[1].String(),
+	}}, res.Records)
+
+	res, err = k.TransferHistory(sdk.WrapSDKContext(ctx), &types.TransferHistoryRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, types.TransferKindBatchExecuted, res.Records[0].Kind)
+	require.Equal(t, uint64(4), res.Records[0].BatchNonce)
+
+	// the history is written as csv with a header row
+	var buf bytes.Buffer
+	writer, err := types.NewTransferHistoryWriter(&buf, types.TransferHistoryFormatCSV)
+	require.NoError(t, err)
+	k.IterateTransferHistory(ctx, chainID, func(record types.TransferRecord) bool {
+		require.NoError(t, writer.Write(record))
+		return false
+	})
+	require.NoError(t, writer.Flush())
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	require.True(t, strings.HasPrefix(lines[1], "11,1,10,send_to_cosmos,"))
+
+	_, err = types.NewTransferHistoryWriter(&buf, "xml")
+	require.Error(t, err)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestTypedDataConfirmations(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	gravityID := TestingGravityParams.GravityId
+
+	var (
+		orcAddrs = []sdk.AccAddress{AccAddrs[0], AccAddrs[1], AccAddrs[2], AccAddrs[3]}
+		valAddrs = []sdk.ValAddress{sdk.ValAddress(AccAddrs[0]), sdk.ValAddress(AccAddrs[1]), sdk.ValAddress(AccAddrs[2]), sdk.ValAddress(AccAddrs[3])}
+	)
+	k.StakingKeeper = NewStakingKeeperMock(valAddrs...)
+	for i := range valAddrs {
+		k.SetOrchestratorValidatorAddress(ctx, valAddrs[i], orcAddrs[i])
+	}
+	signerSetTx := k.CreateSignerSetTx(ctx, chainID)
+
+	msgServer := NewMsgServerImpl(k)
+	confirm := func(i int, typedData bool) error {
+		ethPrivKey, err := ethCrypto.GenerateKey()
+		require.NoError(t, err)
+		ethAddr := ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey)
+		k.setValidatorEthereumAddress(ctx, valAddrs[i], ethAddr)
+
+		var signature []byte
+		if typedData {
+			signature, err = types.NewTypedDataSignature(types.TypedDataDigest(signerSetTx.GetTypedData([]byte(gravityID), chainID)), ethPrivKey)
+		} else {
+			signature, err = types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gravityID)), ethPrivKey)
+		}
+		require.NoError(t, err)
+		confirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: ethAddr.Hex(),
+			Signature:      signature,
+		})
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: confirmation,
+			Signer:       orcAddrs[i].String(),
+		})
+		return err
+	}
+
+	// contracts older than the typed data version only verify checkpoint signatures
+	k.setContractVersion(ctx, chainID, types.TypedDataContractVersion-1)
+	require.Error(t, confirm(0, true))
+	require.NoError(t, confirm(0, false))
+
+	// both are accepted once the contract verifies typed data
+	k.setContractVersion(ctx, chainID, types.TypedDataContractVersion)
+	require.NoError(t, confirm(1, true))
+	require.NoError(t, confirm(2, false))
+
+	// until the param retires the checkpoint signatures
+	params := k.GetParams(ctx)
+	params.TypedDataSignaturesOnly = true
+	k.setParams(ctx, params)
+	require.Error(t, confirm(3, false))
+	require.NoError(t, confirm(3, true))
+}
+
+func TestTypedDataQuery(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+
+	k.StakingKeeper = NewStakingKeeperMock(sdk.ValAddress(AccAddrs[0]))
+	k.setValidatorEthereumAddress(ctx, sdk.ValAddress(AccAddrs[0]), EthAddrs[0])
+	signerSetTx := k.CreateSignerSetTx(ctx, chainID)
+
+	_, err := k.TypedData(sdk.WrapSDKContext(ctx), &types.TypedDataRequest{StoreIndex: []byte("missing")})
+	require.Error(t, err)
+
+	res, err := k.TypedData(sdk.WrapSDKContext(ctx), &types.TypedDataRequest{StoreIndex: signerSetTx.GetStoreIndex()})
+	require.NoError(t, err)
+	require.False(t, res.Accepted)
+
+	// the digest is the one of the typed data as a signer would receive it
+	var typedData apitypes.TypedData
+	require.NoError(t, json.Unmarshal([]byte(res.TypedData), &typedData))
+	require.Equal(t, "Valset", typedData.PrimaryType)
+	require.Equal(t, types.TypedDataDigest(typedData), res.Digest)
+
+	k.setContractVersion(ctx, chainID, types.TypedDataContractVersion)
+	res, err = k.TypedData(sdk.WrapSDKContext(ctx), &types.TypedDataRequest{StoreIndex: signerSetTx.GetStoreIndex()})
+	require.NoError(t, err)
+	require.True(t, res.Accepted)
+}
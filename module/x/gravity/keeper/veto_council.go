@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// checkVetoCouncil returns an error unless the address is the veto council
+func (k Keeper) checkVetoCouncil(ctx sdk.Context, address string) error {
+	council := k.GetParams(ctx).VetoCouncil
+	if council.Address == "" || council.Address != address {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the veto council", address)
+	}
+	return nil
+}
+
+// inVetoDelay returns true while the outgoing tx waits out the veto delay, during which its
+// signatures aren't collected
+func (k Keeper) inVetoDelay(ctx sdk.Context, otx types.OutgoingTx) bool {
+	return k.GetParams(ctx).VetoCouncil.InVetoDelay(otx, uint64(ctx.BlockHeight()))
+}
+
+// getVetoableOutgoingTx returns the outgoing tx at the store index if it can still be vetoed
+func (k Keeper) getVetoableOutgoingTx(ctx sdk.Context, chainID uint64, storeIndex []byte) (types.OutgoingTx, error) {
+	otx := k.GetOutgoingTx(ctx, chainID, storeIndex)
+	if otx == nil {
+		return nil, sdkerrors.Wrapf(types.ErrOutgoingTxNotFound, "store index %x", storeIndex)
+	}
+	if !k.inVetoDelay(ctx, otx) {
+		return nil, sdkerrors.Wrapf(types.ErrVetoDelayPassed, "veto delay of the outgoing tx created at height %d has passed", otx.GetCosmosHeight())
+	}
+	return otx, nil
+}
+
+// vetoBatchTx deletes the batch, refunding its sends to their senders rather than returning
+// them to the pool where they would be batched again
+func (k Keeper) vetoBatchTx(ctx sdk.Context, chainID uint64, batch *types.BatchTx) error {
+	refunds := newRefunds(chainID)
+	for _, send := range batch.Transactions {
+		k.refundSendToEthereum(ctx, refunds, send)
+	}
+	if err := k.issueRefunds(ctx, refunds); err != nil {
+		return err
+	}
+	k.DeleteOutgoingTx(ctx, chainID, batch.GetStoreIndex())
+	k.UpdateBridgeReport(ctx, func(report *types.BridgeReport) { report.VetoedOutgoingTxs++ })
+	return nil
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keycodec"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// currentVoterSet returns the validators of CurrentSignerSet, in its order, with their power
+func (k Keeper) currentVoterSet(ctx sdk.Context) types.VoterSet {
+	var voterSet types.VoterSet
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		val := validator.GetOperator()
+		if ethAddr := k.GetValidatorEthereumAddress(ctx, val); ethAddr.Hex() != "0x0000000000000000000000000000000000000000" {
+			voterSet.Voters = append(voterSet.Voters, &types.Voter{
+				ValidatorAddress: val.String(),
+				Power:            k.StakingKeeper.GetLastValidatorPower(ctx, val),
+			})
+		}
+	}
+	return voterSet
+}
+
+// GetVoterSet returns the voter set of the signer set of the chain at the nonce
+func (k Keeper) GetVoterSet(ctx sdk.Context, chainID uint64, nonce uint64) (types.VoterSet, bool) {
+	var voterSet types.VoterSet
+	bz := k.chainStore(ctx, chainID).Get(types.MakeVoterSetKey(nonce))
+	if bz == nil {
+		return voterSet, false
+	}
+	k.cdc.MustUnmarshal(bz, &voterSet)
+	return voterSet, true
+}
+
+func (k Keeper) setVoterSet(ctx sdk.Context, chainID uint64, nonce uint64, voterSet types.VoterSet) {
+	k.chainStore(ctx, chainID).Set(types.MakeVoterSetKey(nonce), k.cdc.MustMarshal(&voterSet))
+}
+
+// latestVoterSet returns the nonce of the latest signer set of the chain and its voter set,
+// taken now if the signer set predates the voter sets. The nonce is zero if the chain has no
+// signer set yet.
+func (k Keeper) latestVoterSet(ctx sdk.Context, chainID uint64) (uint64, types.VoterSet) {
+	nonce := k.GetLatestSignerSetTxNonce(ctx, chainID)
+	if nonce == 0 {
+		return 0, types.VoterSet{}
+	}
+	voterSet, found := k.GetVoterSet(ctx, chainID, nonce)
+	if !found {
+		voterSet = k.currentVoterSet(ctx)
+		k.setVoterSet(ctx, chainID, nonce, voterSet)
+	}
+	return nonce, voterSet
+}
+
+// recordVoterSet returns the voter set indexing the vote bitmap of the record
+func (k Keeper) recordVoterSet(ctx sdk.Context, chainID uint64, record *types.EthereumEventVoteRecord) types.VoterSet {
+	if record.SignerSetNonce == 0 {
+		return types.VoterSet{}
+	}
+	voterSet, _ := k.GetVoterSet(ctx, chainID, record.SignerSetNonce)
+	return voterSet
+}
+
+// addEventVote records the vote of the validator for the record, in its bitmap if the
+// validator is in its voter set and in its votes list otherwise
+func (k Keeper) addEventVote(ctx sdk.Context, chainID uint64, record *types.EthereumEventVoteRecord, val sdk.ValAddress) {
+	if i, ok := k.recordVoterSet(ctx, chainID, record).Index(val); ok {
+		record.SetVoteBit(i)
+		return
+	}
+	record.Votes = append(record.Votes, val.String())
+}
+
+// removeEventVote removes the vote of the validator for the record
+func (k Keeper) removeEventVote(ctx sdk.Context, chainID uint64, record *types.EthereumEventVoteRecord, val sdk.ValAddress) {
+	if i, ok := k.recordVoterSet(ctx, chainID, record).Index(val); ok {
+		record.ClearVoteBit(i)
+	}
+	votes := make([]string, 0, len(record.Votes))
+	for _, voter := range record.Votes {
+		if voter != val.String() {
+			votes = append(votes, voter)
+		}
+	}
+	record.Votes = votes
+}
+
+// eventVoters returns the validators that voted for the record, those of its bitmap first
+func (k Keeper) eventVoters(ctx sdk.Context, chainID uint64, record *types.EthereumEventVoteRecord) []sdk.ValAddress {
+	voterSet := k.recordVoterSet(ctx, chainID, record)
+	voters := make([]sdk.ValAddress, 0, len(record.Votes))
+	for _, i := range record.VoteBitIndexes() {
+		if i < len(voterSet.Voters) {
+			val, err := sdk.ValAddressFromBech32(voterSet.Voters[i].ValidatorAddress)
+			if err != nil {
+				panic(err)
+			}
+			voters = append(voters, val)
+		}
+	}
+	for _, voter := range record.Votes {
+		val, err := sdk.ValAddressFromBech32(voter)
+		if err != nil {
+			panic(err)
+		}
+		voters = append(voters, val)
+	}
+	return voters
+}
+
+// eventVotePower returns the power of the votes for the record, the voters of its bitmap
+// counting with their power in its voter set, kept at their last power by UpdateVoterPowers,
+// and the others with their last power
+func (k Keeper) eventVotePower(ctx sdk.Context, chainID uint64, record *types.EthereumEventVoteRecord) sdk.Int {
+	power := sdk.ZeroInt()
+	voterSet := k.recordVoterSet(ctx, chainID, record)
+	for _, i := range record.VoteBitIndexes() {
+		if i < len(voterSet.Voters) {
+			power = power.Add(sdk.NewInt(voterSet.Voters[i].Power))
+		}
+	}
+	for _, voter := range record.Votes {
+		val, _ := sdk.ValAddressFromBech32(voter)
+		power = power.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+	}
+	return power
+}
+
+// flagVoterPowerUpdate flags the validator for UpdateVoterPowers, its power may change at the
+// next staking end blocker
+func (k Keeper) flagVoterPowerUpdate(ctx sdk.Context, val sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Set(types.MakeVoterPowerUpdateKey(val), []byte{1})
+}
+
+// UpdateVoterPowers sets the power of the validators flagged by VoterPowerHooks to their last
+// power in the voter sets the tallies read, that of the latest signer set and those of the
+// event vote records not observed yet of each chain, and clears the flags. It runs after the
+// staking end blocker has set the last powers of the block, the validators flagged later being
+// updated in the next block.
+func (k Keeper) UpdateVoterPowers(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VoterPowerUpdateKey})
+	var keys [][]byte
+	powers := make(map[string]int64)
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		val := sdk.ValAddress(keycodec.NewReader(iter.Key()).LengthPrefixed())
+		powers[val.String()] = k.StakingKeeper.GetLastValidatorPower(ctx, val)
+	}
+	iter.Close()
+	if len(keys) == 0 {
+		return
+	}
+
+	for _, chain := range k.GetEVMChains(ctx) {
+		for _, nonce := range k.tallyVoterSetNonces(ctx, chain.ChainId) {
+			voterSet, found := k.GetVoterSet(ctx, chain.ChainId, nonce)
+			if !found {
+				continue
+			}
+			updated := false
+			for _, voter := range voterSet.Voters {
+				if power, ok := powers[voter.ValidatorAddress]; ok && power != voter.Power {
+					voter.Power = power
+					updated = true
+				}
+			}
+			if updated {
+				k.setVoterSet(ctx, chain.ChainId, nonce, voterSet)
+			}
+		}
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// tallyVoterSetNonces returns in order the nonces of the signer sets whose voter sets the
+// tallies of the chain read: the latest one, which the new records use, and those of the
+// records not observed yet
+func (k Keeper) tallyVoterSetNonces(ctx sdk.Context, chainID uint64) []uint64 {
+	nonces := make(map[uint64]bool)
+	if nonce := k.GetLatestSignerSetTxNonce(ctx, chainID); nonce != 0 {
+		nonces[nonce] = true
+	}
+
+	store := prefix.NewStore(k.chainStore(ctx, chainID), []byte{types.EthereumEventVoteRecordKey})
+	iter := store.Iterator(keycodec.Uint64(k.GetLastObservedEventNonce(ctx, chainID)+1), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.EthereumEventVoteRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if record.SignerSetNonce != 0 {
+			nonces[record.SignerSetNonce] = true
+		}
+	}
+
+	sorted := make([]uint64, 0, len(nonces))
+	for nonce := range nonces {
+		sorted = append(sorted, nonce)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keycodec"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// issueVouchers mints the vouchers of observed deposits or refunds and adds them to their
+// issuance, the amounts of the vouchers the bridge is accountable for
+func (k Keeper) issueVouchers(ctx sdk.Context, vouchers sdk.Coins) error {
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return err
+	}
+	for _, coin := range vouchers {
+		k.setVoucherIssuance(ctx, coin.Denom, k.GetVoucherIssuance(ctx, coin.Denom).Add(coin.Amount))
+	}
+	return nil
+}
+
+// retireVouchers burns the vouchers of withdrawals and subtracts them from their issuance
+func (k Keeper) retireVouchers(ctx sdk.Context, vouchers sdk.Coins) error {
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return err
+	}
+	for _, coin := range vouchers {
+		k.setVoucherIssuance(ctx, coin.Denom, k.GetVoucherIssuance(ctx, coin.Denom).Sub(coin.Amount))
+	}
+	return nil
+}
+
+// GetVoucherIssuance returns the amount of the vouchers of the denom minted by the bridge
+// less the amount it burned
+func (k Keeper) GetVoucherIssuance(ctx sdk.Context, denom string) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeVoucherIssuanceKey(denom))
+	if len(bz) == 0 {
+		return sdk.ZeroInt()
+	}
+	var issuance sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &issuance)
+	return issuance.Int
+}
+
+func (k Keeper) setVoucherIssuance(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.MakeVoucherIssuanceKey(denom))
+		return
+	}
+	store.Set(types.MakeVoucherIssuanceKey(denom), k.cdc.MustMarshal(&sdk.IntProto{Int: amount}))
+}
+
+// IterateVoucherIssuance iterates over the issuance of the voucher denoms, in denom order
+func (k Keeper) IterateVoucherIssuance(ctx sdk.Context, cb func(denom string, amount sdk.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VoucherIssuanceKey})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var issuance sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &issuance)
+		if cb(string(keycodec.NewReader(iter.Key()).LengthPrefixed()), issuance.Int) {
+			return
+		}
+	}
+}
+
+// seedVoucherIssuance sets the issuance of the voucher denoms to their supply, for a genesis
+// or a store migrated from before the issuance was tracked
+func (k Keeper) seedVoucherIssuance(ctx sdk.Context) {
+	k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		if types.IsVoucherDenom(coin.Denom) {
+			k.setVoucherIssuance(ctx, coin.Denom, coin.Amount)
+		}
+		return false
+	})
+}
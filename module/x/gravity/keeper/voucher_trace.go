@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// ResolveVoucherTrace resolves a denom to the EVM chain and ERC20 of the gravity vouchers
+// it traces back to. Besides the vouchers themselves these are IBC denoms of vouchers that
+// returned over another path than they left on, looked up in the denom traces of the
+// transfer module, and full trace paths such as transfer/channel-0/gravity0x....
+func (k Keeper) ResolveVoucherTrace(ctx sdk.Context, denom string) (uint64, common.Address, bool) {
+	baseDenom := denom
+	if strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+		trace, found := k.ibcDenomTrace(ctx, denom)
+		if !found {
+			return 0, common.Address{}, false
+		}
+		baseDenom = trace.BaseDenom
+	} else if strings.Contains(denom, "/") {
+		baseDenom = ibctransfertypes.ParseDenomTrace(denom).BaseDenom
+	}
+
+	if tokenContract, err := types.GravityDenomToERC20(baseDenom); err == nil {
+		return k.getBridgeChainID(ctx), common.HexToAddress(tokenContract), true
+	}
+	if chainID, tokenContract, err := types.EVMChainGravityDenomToERC20(baseDenom); err == nil {
+		return chainID, common.HexToAddress(tokenContract), true
+	}
+	return 0, common.Address{}, false
+}
+
+// ibcDenomTrace returns the denom trace of an IBC voucher denom, the path it took to this
+// chain and its base denom on its origin chain
+func (k Keeper) ibcDenomTrace(ctx sdk.Context, denom string) (ibctransfertypes.DenomTrace, bool) {
+	if !strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+		return ibctransfertypes.DenomTrace{}, false
+	}
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return ibctransfertypes.DenomTrace{}, false
+	}
+	return k.transferKeeper.GetDenomTrace(ctx, hash)
+}
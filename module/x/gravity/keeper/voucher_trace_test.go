@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestResolveVoucherTrace(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	chainID := TestingGravityParams.BridgeChainId
+	tokenContract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	// vouchers that returned over another path than they left on
+	returned := ibctransfertypes.ParseDenomTrace("transfer/channel-1/transfer/channel-7/" + types.GravityDenom(tokenContract))
+	otherChain := ibctransfertypes.ParseDenomTrace("transfer/channel-1/" + types.EVMChainGravityDenom(testEVMChain.ChainId, tokenContract))
+	unrelated := ibctransfertypes.ParseDenomTrace("transfer/channel-1/uosmo")
+	input.TransferKeeper.DenomTraces = []ibctransfertypes.DenomTrace{returned, otherChain, unrelated}
+
+	specs := map[string]struct {
+		denom   string
+		chainID uint64
+		found   bool
+	}{
+		"voucher":                    {denom: types.GravityDenom(tokenContract), chainID: chainID, found: true},
+		"voucher of another chain":   {denom: types.EVMChainGravityDenom(testEVMChain.ChainId, tokenContract), chainID: testEVMChain.ChainId, found: true},
+		"ibc denom":                  {denom: returned.IBCDenom(), chainID: chainID, found: true},
+		"ibc denom of another chain": {denom: otherChain.IBCDenom(), chainID: testEVMChain.ChainId, found: true},
+		"trace path":                 {denom: returned.GetFullDenomPath(), chainID: chainID, found: true},
+		"unknown ibc denom":          {denom: ibctransfertypes.ParseDenomTrace("transfer/channel-2/" + types.GravityDenom(tokenContract)).IBCDenom()},
+		"ibc denom of another token": {denom: unrelated.IBCDenom()},
+		"native denom":               {denom: "stake"},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			resolvedChainID, resolvedContract, found := k.ResolveVoucherTrace(ctx, spec.denom)
+			require.Equal(t, spec.found, found)
+			if spec.found {
+				require.Equal(t, spec.chainID, resolvedChainID)
+				require.Equal(t, tokenContract, resolvedContract)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Package keycodec encodes and decodes the composite keys of the gravity store. A key is a
+// prefix byte followed by its components, the variable length components, such as Cosmos
+// addresses, denoms and channel ids, being prefixed by their length and the others, such as
+// nonces and Ethereum addresses, having a fixed length. The components of a key can so be read
+// back from it, and the keys sharing the prefix of a component value are exactly those with
+// that value, so that the key ranges iterated by component are correct.
+package keycodec
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxComponentLen is the maximum length of a variable length component, whose length is
+// encoded in a byte
+const MaxComponentLen = 255
+
+// Key returns the key with the prefix and the encoded components
+func Key(prefix byte, components ...[]byte) []byte {
+	n := 1
+	for _, component := range components {
+		n += len(component)
+	}
+	key := make([]byte, 0, n)
+	key = append(key, prefix)
+	for _, component := range components {
+		key = append(key, component...)
+	}
+	return key
+}
+
+// LengthPrefixed encodes a variable length component, prefixed by its length. It panics if
+// the component is longer than MaxComponentLen.
+func LengthPrefixed(bz []byte) []byte {
+	if len(bz) > MaxComponentLen {
+		panic(fmt.Sprintf("key component of %d bytes exceeds the maximum of %d", len(bz), MaxComponentLen))
+	}
+	return append([]byte{byte(len(bz))}, bz...)
+}
+
+// String encodes a string component, prefixed by its length
+func String(s string) []byte {
+	return LengthPrefixed([]byte(s))
+}
+
+// Uint64 encodes a uint64 component in big endian, so that the keys are ordered by it
+func Uint64(n uint64) []byte {
+	return sdk.Uint64ToBigEndian(n)
+}
+
+// Reader decodes the components of a key, in order. A malformed key is a programming error
+// for the keys of the store and the reads panic on it.
+type Reader struct {
+	key []byte
+}
+
+// NewReader returns the reader of the components of the key, without its prefix byte
+func NewReader(key []byte) *Reader {
+	return &Reader{key: key}
+}
+
+// LengthPrefixed reads a variable length component
+func (r *Reader) LengthPrefixed() []byte {
+	if len(r.key) == 0 {
+		panic("key too short for the length of a component")
+	}
+	return r.Fixed(int(r.key[0]) + 1)[1:]
+}
+
+// Uint64 reads a uint64 component
+func (r *Reader) Uint64() uint64 {
+	return sdk.BigEndianToUint64(r.Fixed(8))
+}
+
+// Fixed reads a component of n bytes
+func (r *Reader) Fixed(n int) []byte {
+	if len(r.key) < n {
+		panic(fmt.Sprintf("key too short for a component of %d bytes, %d left", n, len(r.key)))
+	}
+	component := r.key[:n]
+	r.key = r.key[n:]
+	return component
+}
+
+// Done returns true once all the components of the key are read
+func (r *Reader) Done() bool {
+	return len(r.key) == 0
+}
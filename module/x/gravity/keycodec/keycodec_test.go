@@ -0,0 +1,38 @@
+package keycodec
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyRoundTrip(t *testing.T) {
+	key := Key(0x20, String("channel-3"), Uint64(7), []byte{0xaa, 0xbb})
+	require.Equal(t, append([]byte{0x20, 9}, append([]byte("channel-3"), 0, 0, 0, 0, 0, 0, 0, 7, 0xaa, 0xbb)...), key)
+
+	r := NewReader(key[1:])
+	require.Equal(t, "channel-3", string(r.LengthPrefixed()))
+	require.Equal(t, uint64(7), r.Uint64())
+	require.Equal(t, []byte{0xaa, 0xbb}, r.Fixed(2))
+	require.True(t, r.Done())
+}
+
+func TestKeyPrefixes(t *testing.T) {
+	// without the lengths, the keys of the sequences of channel-1 would start those of channel-10
+	channel1 := Key(0x20, String("channel-1"))
+	channel10 := Key(0x20, String("channel-10"), Uint64(1))
+	require.False(t, bytes.HasPrefix(channel10, channel1))
+
+	// nor could a 20 byte address be told from the start of a 32 byte one
+	short, long := make([]byte, 20), make([]byte, 32)
+	require.False(t, bytes.HasPrefix(Key(0x1, LengthPrefixed(long)), Key(0x1, LengthPrefixed(short))))
+}
+
+func TestKeyMalformed(t *testing.T) {
+	require.Panics(t, func() { LengthPrefixed([]byte(strings.Repeat("a", MaxComponentLen+1))) })
+	require.Panics(t, func() { NewReader([]byte{5, 'a'}).LengthPrefixed() })
+	require.Panics(t, func() { NewReader([]byte{0, 1}).Uint64() })
+	require.Panics(t, func() { NewReader(nil).LengthPrefixed() })
+}
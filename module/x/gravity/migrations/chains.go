@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// ChainIDs returns the ids of the default EVM chain and of the chains added by governance
+func ChainIDs(store storetypes.KVStore, cdc codec.BinaryCodec) []uint64 {
+	var ids []uint64
+	if bz := store.Get([]byte{types.DefaultEVMChainIDKey}); bz != nil {
+		ids = append(ids, binary.BigEndian.Uint64(bz))
+	}
+
+	iter := prefix.NewStore(store, []byte{types.EVMChainKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var chain types.EVMChain
+		cdc.MustUnmarshal(iter.Value(), &chain)
+		ids = append(ids, chain.ChainId)
+	}
+	return ids
+}
@@ -0,0 +1,66 @@
+// Package migrations holds the store operations shared by the versioned in-place store
+// migrations of the gravity module, each in the package named after the consensus version
+// it migrates from.
+package migrations
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// MoveKeys moves all the keys under the prefix from one store to the other, keeping the
+// keys unchanged. The stores are usually the root store and a prefix store within it.
+func MoveKeys(from, to storetypes.KVStore, keyPrefix []byte) {
+	keys, values := collect(from, keyPrefix)
+	for i, key := range keys {
+		from.Delete(key)
+		to.Set(key, values[i])
+	}
+}
+
+// DeleteKeys deletes all the keys under the prefix
+func DeleteKeys(store storetypes.KVStore, keyPrefix []byte) {
+	keys, _ := collect(store, keyPrefix)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ReindexKeys rewrites each key under the prefix to the one returned by rekey, which is
+// given the key without the prefix and its value and returns the new key without the
+// prefix. Keys rekey returns unchanged are left in place, so that reindexing is idempotent
+// when rekey computes the key from the value. Rewritten keys must not collide with the
+// keys left in place. It returns the number of keys rewritten.
+func ReindexKeys(store storetypes.KVStore, keyPrefix []byte, rekey func(key, value []byte) []byte) int {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	keys, values := collect(prefixStore, nil)
+
+	// all the rewritten keys are deleted before any is set, a new key may be the old key of
+	// another entry
+	var newKeys, newValues [][]byte
+	for i, key := range keys {
+		newKey := rekey(key, values[i])
+		if string(newKey) == string(key) {
+			continue
+		}
+		prefixStore.Delete(key)
+		newKeys = append(newKeys, newKey)
+		newValues = append(newValues, values[i])
+	}
+	for i, key := range newKeys {
+		prefixStore.Set(key, newValues[i])
+	}
+	return len(newKeys)
+}
+
+// collect returns the keys and values under the prefix, the store can't be written while
+// it is iterated
+func collect(store storetypes.KVStore, keyPrefix []byte) (keys, values [][]byte) {
+	iter := prefix.NewStore(store, keyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append(append([]byte{}, keyPrefix...), iter.Key()...))
+		values = append(values, iter.Value())
+	}
+	return keys, values
+}
@@ -0,0 +1,51 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations"
+)
+
+func TestMoveKeys(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	chainStore := prefix.NewStore(store, []byte{0xff, 1})
+
+	store.Set([]byte{1, 'a'}, []byte("a"))
+	store.Set([]byte{1, 'b'}, []byte("b"))
+	store.Set([]byte{2, 'a'}, []byte("other"))
+
+	migrations.MoveKeys(store, chainStore, []byte{1})
+
+	require.Nil(t, store.Get([]byte{1, 'a'}))
+	require.Nil(t, store.Get([]byte{1, 'b'}))
+	require.Equal(t, []byte("a"), chainStore.Get([]byte{1, 'a'}))
+	require.Equal(t, []byte("b"), chainStore.Get([]byte{1, 'b'}))
+	require.Equal(t, []byte("other"), store.Get([]byte{2, 'a'}))
+}
+
+func TestReindexKeys(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	store.Set([]byte{1, 'a'}, []byte("a"))
+	store.Set([]byte{1, 'B'}, []byte("b"))
+	store.Set([]byte{2, 'a'}, []byte("other"))
+
+	// upper case keys are rewritten to lower case, those already lower case are kept
+	rewritten := migrations.ReindexKeys(store, []byte{1}, func(key, _ []byte) []byte {
+		if key[0] >= 'A' && key[0] <= 'Z' {
+			return []byte{key[0] + 'a' - 'A'}
+		}
+		return key
+	})
+
+	require.Equal(t, 1, rewritten)
+	require.Nil(t, store.Get([]byte{1, 'B'}))
+	require.Equal(t, []byte("a"), store.Get([]byte{1, 'a'}))
+	require.Equal(t, []byte("b"), store.Get([]byte{1, 'b'}))
+	require.Equal(t, []byte("other"), store.Get([]byte{2, 'a'}))
+}
@@ -7,6 +7,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v1/types"
 )
 
@@ -24,17 +25,9 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 }
 
 func migrateCosmosOriginatedERC20ToDenom(store storetypes.KVStore) error {
-	prefixStore := prefix.NewStore(store, []byte{types.ERC20ToDenomKey})
-	iter := prefixStore.Iterator(nil, nil)
-	defer iter.Close()
-
-	for ; iter.Valid(); iter.Next() {
-		oldKey := iter.Key()
-		newKey := common.HexToAddress(string(oldKey)).Bytes()
-
-		prefixStore.Delete(oldKey)
-		prefixStore.Set(newKey, iter.Value())
-	}
+	migrations.ReindexKeys(store, []byte{types.ERC20ToDenomKey}, func(key, _ []byte) []byte {
+		return common.HexToAddress(string(key)).Bytes()
+	})
 
 	return nil
 }
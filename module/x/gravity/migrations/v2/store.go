@@ -0,0 +1,19 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// MigrateParams sets the params added in consensus version 3 to their defaults
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	ctx.Logger().Info("Gravity v2 to v3: Beginning params migration")
+
+	defaults := types.DefaultParams()
+	paramSpace.Set(ctx, types.ParamsStoreKeyMinimumEthereumConfirmations, defaults.MinimumEthereumConfirmations)
+
+	ctx.Logger().Info("Gravity v2 to v3: Params migration complete")
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	v2 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v2"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramSpace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), keyParams, tkeyParams, types.DefaultParamspace).
+		WithKeyTable(types.ParamKeyTable())
+
+	// a version 2 store has all params but the minimum confirmations
+	params := *types.DefaultParams()
+	params.MinimumEthereumConfirmations = 10
+	paramSpace.SetParamSet(ctx, &params)
+	ctx.KVStore(keyParams).Delete(append([]byte(types.DefaultParamspace+"/"), types.ParamsStoreKeyMinimumEthereumConfirmations...))
+	require.False(t, paramSpace.Has(ctx, types.ParamsStoreKeyMinimumEthereumConfirmations))
+
+	require.NoError(t, v2.MigrateParams(ctx, paramSpace))
+
+	var migrated types.Params
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.Equal(t, types.DefaultParams().MinimumEthereumConfirmations, migrated.MinimumEthereumConfirmations)
+	require.Equal(t, params.SignedBatchesWindow, migrated.SignedBatchesWindow)
+}
@@ -0,0 +1,92 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// chainScopedKeys are the prefixes of the state moved into the store of the default EVM
+// chain, delegate keys, the batch nonce, the send to ethereum id and the unbonding height
+// stay shared by all chains
+var chainScopedKeys = []byte{
+	types.EthereumSignatureKey,
+	types.EthereumEventVoteRecordKey,
+	types.OutgoingTxKey,
+	types.SendToEthereumKey,
+	types.LastEventNonceByValidatorKey,
+	types.LastObservedEventNonceKey,
+	types.LatestSignerSetTxNonceKey,
+	types.LastSlashedOutgoingTxBlockKey,
+	types.LastSlashedSignerSetTxNonceKey,
+	types.LastEthereumBlockHeightKey,
+	types.DenomToERC20Key,
+	types.ERC20ToDenomKey,
+	types.LastObservedSignerSetKey,
+	types.EthereumHeightVoteKey,
+}
+
+// BankKeeper is the part of the bank keeper the migration escrows the locked coins with
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// MigrateStore moves the bridge state into the store of the EVM chain configured by the
+// bridge chain id param, and the cosmos originated coins locked in the module account to the
+// escrow of that chain
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, bankKeeper BankKeeper) error {
+	ctx.Logger().Info("Gravity v3 to v4: Beginning store migration")
+
+	var chainID uint64
+	paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractChainID, &chainID)
+
+	store := ctx.KVStore(storeKey)
+	chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+
+	for _, key := range chainScopedKeys {
+		migrations.MoveKeys(store, chainStore, []byte{key})
+	}
+
+	// fix the default chain id so changes to the param can't orphan the moved state
+	store.Set([]byte{types.DefaultEVMChainIDKey}, sdk.Uint64ToBigEndian(chainID))
+
+	if err := migrateEscrow(ctx, chainStore, bankKeeper, chainID); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("Gravity v3 to v4: Store migration complete", "chain id", chainID)
+
+	return nil
+}
+
+// migrateEscrow moves the cosmos originated coins locked in the module account, all sent to
+// the default chain before version 4, to the escrow of the default chain. The ERC20s of the
+// cosmos originated denoms are read in the layout of version 4, keyed by their address with
+// the denom as value.
+func migrateEscrow(ctx sdk.Context, chainStore storetypes.KVStore, bankKeeper BankKeeper, chainID uint64) error {
+	balances := bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+
+	locked := sdk.NewCoins()
+	iter := prefix.NewStore(chainStore, []byte{types.ERC20ToDenomKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Value())
+		if amount := balances.AmountOf(denom); amount.IsPositive() {
+			locked = locked.Add(sdk.NewCoin(denom, amount))
+		}
+	}
+	if locked.Empty() {
+		return nil
+	}
+
+	if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.EVMChainEscrowAddress(chainID), locked); err != nil {
+		return sdkerrors.Wrapf(err, "escrow coins for chain id %d", chainID)
+	}
+	return nil
+}
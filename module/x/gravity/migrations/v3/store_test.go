@@ -0,0 +1,67 @@
+package v3_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigrateStoreMovesStateToDefaultChain(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	params := keeper.TestingGravityParams
+	input.GravityParamSpace.SetParamSet(ctx, &params)
+
+	tokenContract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	store.Set([]byte{types.LastObservedEventNonceKey}, sdk.Uint64ToBigEndian(7))
+	store.Set(types.MakeERC20ToDenomKey(tokenContract), []byte("ucosmos"))
+	store.Set(types.MakeDenomToERC20Key("ucosmos"), tokenContract.Bytes())
+	store.Set([]byte{types.LastSendToEthereumIDKey}, sdk.Uint64ToBigEndian(3))
+
+	require.NoError(t, keeper.NewMigrator(input.GravityKeeper).Migrate3to4(ctx))
+
+	require.Nil(t, store.Get([]byte{types.LastObservedEventNonceKey}))
+	require.Nil(t, store.Get(types.MakeERC20ToDenomKey(tokenContract)))
+	require.Equal(t, uint64(7), input.GravityKeeper.GetLastObservedEventNonce(ctx, chainID))
+
+	cosmosOriginated, denom := input.GravityKeeper.ERC20ToDenomLookup(ctx, chainID, tokenContract)
+	require.True(t, cosmosOriginated)
+	require.Equal(t, "ucosmos", denom)
+
+	require.Equal(t, sdk.Uint64ToBigEndian(chainID), store.Get([]byte{types.DefaultEVMChainIDKey}))
+
+	// the send to ethereum id counter is shared by all chains and stays in place
+	require.Equal(t, sdk.Uint64ToBigEndian(3), store.Get([]byte{types.LastSendToEthereumIDKey}))
+}
+
+func TestMigrateStoreEscrowsLockedCoins(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+	chainID := keeper.TestingGravityParams.BridgeChainId
+
+	params := keeper.TestingGravityParams
+	input.GravityParamSpace.SetParamSet(ctx, &params)
+
+	tokenContract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	store.Set(types.MakeERC20ToDenomKey(tokenContract), []byte("ucosmos"))
+	store.Set(types.MakeDenomToERC20Key("ucosmos"), tokenContract.Bytes())
+	locked := sdk.NewCoins(sdk.NewInt64Coin("ucosmos", 500), sdk.NewInt64Coin("uother", 7))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, locked))
+
+	require.NoError(t, keeper.NewMigrator(input.GravityKeeper).Migrate3to4(ctx))
+
+	// only the coins of cosmos originated ERC20s move to the escrow of the default chain
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ucosmos", 500)), input.GravityKeeper.GetEscrowedCoins(ctx, chainID))
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("uother", 7)),
+		input.BankKeeper.GetAllBalances(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName)),
+	)
+}
@@ -0,0 +1,40 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// MigrateParams moves the params out of the params subspace into the gravity store, from
+// consensus version 5 on they are updated with MsgUpdateParams instead of param change
+// proposals. The params added after version 4 are missing from the subspace, they are set to
+// their defaults, which enables the bridge reports.
+func MigrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v4 to v5: Beginning params migration")
+
+	defaults := types.DefaultParams()
+	params := types.Params{
+		EthereumFinality:              defaults.EthereumFinality,
+		EthereumFeeFloors:             defaults.EthereumFeeFloors,
+		EthereumDepositAddressFactory: defaults.EthereumDepositAddressFactory,
+		EthereumTokenDecimals:         defaults.EthereumTokenDecimals,
+		EthereumNativeDecimals:        defaults.EthereumNativeDecimals,
+		EthereumRateLimits:            defaults.EthereumRateLimits,
+		IbcForwardChannels:            defaults.IbcForwardChannels,
+		LogicCallTemplates:            defaults.LogicCallTemplates,
+		BridgeReportPeriod:            defaults.BridgeReportPeriod,
+	}
+	paramSpace.GetParamSetIfExists(ctx, &params)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	ctx.KVStore(storeKey).Set([]byte{types.ParamsKey}, cdc.MustMarshal(&params))
+
+	ctx.Logger().Info("Gravity v4 to v5: Params migration complete")
+
+	return nil
+}
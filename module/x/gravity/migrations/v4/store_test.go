@@ -0,0 +1,70 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	v4 "github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations/v4"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+
+	// a version 4 store keeps its params in the params subspace
+	params := keeper.TestingGravityParams
+	params.SignedBatchesWindow = 42
+	input.GravityParamSpace.SetParamSet(ctx, &params)
+	store.Delete([]byte{types.ParamsKey})
+
+	require.NoError(t, v4.MigrateParams(ctx, input.GravityStoreKey, input.GravityParamSpace, input.Marshaler))
+	// the bridge report period isn't a param of the subspace
+	params.BridgeReportPeriod = types.DefaultParams().BridgeReportPeriod
+	require.Equal(t, params, input.GravityKeeper.GetParams(ctx))
+}
+
+func TestMigrateParamsSetsLaterParamsToDefaults(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+
+	// the params added after version 4 are missing from the subspace of a version 4 store
+	added := map[string]bool{
+		string(types.ParamsStoreKeyEthereumFinality):              true,
+		string(types.ParamsStoreKeyEthereumFeeFloors):             true,
+		string(types.ParamsStoreKeyEthereumDepositAddressFactory): true,
+		string(types.ParamsStoreKeyEthereumTokenDecimals):         true,
+		string(types.ParamsStoreKeyEthereumNativeDecimals):        true,
+		string(types.ParamsStoreKeyEthereumRateLimits):            true,
+		string(types.ParamsStoreKeyIBCForwardChannels):            true,
+		string(types.ParamsStoreKeyLogicCallTemplates):            true,
+	}
+	params := keeper.TestingGravityParams
+	params.EthereumFinality = types.FinalitySafe
+	params.EthereumDepositAddressFactory = keeper.EthAddrs[0].Hex()
+	params.BridgeReportPeriod = 0
+	for _, pair := range params.ParamSetPairs() {
+		if !added[string(pair.Key)] {
+			input.GravityParamSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	store.Delete([]byte{types.ParamsKey})
+
+	require.NoError(t, v4.MigrateParams(ctx, input.GravityStoreKey, input.GravityParamSpace, input.Marshaler))
+
+	want := params
+	want.EthereumFinality = types.DefaultParams().EthereumFinality
+	want.EthereumFeeFloors = types.DefaultParams().EthereumFeeFloors
+	want.EthereumDepositAddressFactory = types.DefaultParams().EthereumDepositAddressFactory
+	want.EthereumTokenDecimals = types.DefaultParams().EthereumTokenDecimals
+	want.EthereumNativeDecimals = types.DefaultParams().EthereumNativeDecimals
+	want.EthereumRateLimits = types.DefaultParams().EthereumRateLimits
+	want.IbcForwardChannels = types.DefaultParams().IbcForwardChannels
+	want.LogicCallTemplates = types.DefaultParams().LogicCallTemplates
+	want.BridgeReportPeriod = types.DefaultParams().BridgeReportPeriod
+	require.True(t, want.Equal(input.GravityKeeper.GetParams(ctx)))
+}
@@ -0,0 +1,42 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// MigrateStore rewrites the keys of the send to ethereum pools of all chains to the contract
+// prefixed layout, fee ordered for ERC20 sends, computed from the sends they hold. Keys
+// already in that layout are left in place, so the migration can run again without effect.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v5 to v6: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+	for _, chainID := range migrations.ChainIDs(store, cdc) {
+		chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+
+		sends := migrations.ReindexKeys(chainStore, []byte{types.SendToEthereumKey}, func(_, value []byte) []byte {
+			var send types.SendToEthereum
+			cdc.MustUnmarshal(value, &send)
+			return types.MakeSendToEthereumKey(send.Id, send.Erc20Fee)[1:]
+		})
+		erc1155Sends := migrations.ReindexKeys(chainStore, []byte{types.SendERC1155ToEthereumKey}, func(_, value []byte) []byte {
+			var send types.SendERC1155ToEthereum
+			cdc.MustUnmarshal(value, &send)
+			return types.MakeSendERC1155ToEthereumKey(common.HexToAddress(send.TokenContract), send.Id)[1:]
+		})
+
+		ctx.Logger().Info("Gravity v5 to v6: Reindexed send to ethereum pool",
+			"chain id", chainID, "sends", sends, "erc1155 sends", erc1155Sends)
+	}
+
+	ctx.Logger().Info("Gravity v5 to v6: Store migration complete")
+
+	return nil
+}
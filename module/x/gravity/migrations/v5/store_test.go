@@ -0,0 +1,61 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigrateStoreReindexesSendToEthereumPool(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	store.Set([]byte{types.DefaultEVMChainIDKey}, sdk.Uint64ToBigEndian(chainID))
+	chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+
+	tokenContract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	send := types.SendToEthereum{
+		Id:                7,
+		Sender:            "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+		EthereumRecipient: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Erc20Token:        types.NewERC20Token(100, tokenContract),
+		Erc20Fee:          types.NewERC20Token(3, tokenContract),
+	}
+	erc1155Send := types.SendERC1155ToEthereum{
+		Id:                8,
+		Sender:            send.Sender,
+		EthereumRecipient: send.EthereumRecipient,
+		TokenContract:     tokenContract.Hex(),
+	}
+
+	// the sends are indexed by id only, as in a legacy layout
+	legacyKey := append([]byte{types.SendToEthereumKey}, sdk.Uint64ToBigEndian(send.Id)...)
+	chainStore.Set(legacyKey, input.Marshaler.MustMarshal(&send))
+	legacyERC1155Key := append([]byte{types.SendERC1155ToEthereumKey}, sdk.Uint64ToBigEndian(erc1155Send.Id)...)
+	chainStore.Set(legacyERC1155Key, input.Marshaler.MustMarshal(&erc1155Send))
+
+	migrator := keeper.NewMigrator(input.GravityKeeper)
+	require.NoError(t, migrator.Migrate5to6(ctx))
+
+	require.Nil(t, chainStore.Get(legacyKey))
+	require.Nil(t, chainStore.Get(legacyERC1155Key))
+	require.NotNil(t, chainStore.Get(types.MakeSendToEthereumKey(send.Id, send.Erc20Fee)))
+	require.NotNil(t, chainStore.Get(types.MakeSendERC1155ToEthereumKey(tokenContract, erc1155Send.Id)))
+
+	// running it again changes nothing
+	require.NoError(t, migrator.Migrate5to6(ctx))
+
+	var sends []uint64
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, chainID, func(ste *types.SendToEthereum) bool {
+		sends = append(sends, ste.Id)
+		return false
+	})
+	require.Equal(t, []uint64{send.Id}, sends)
+}
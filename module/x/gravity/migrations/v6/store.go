@@ -0,0 +1,76 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// MigrateStore computes the pool aggregates of all chains from the transfers in their send to
+// ethereum pools. Existing aggregates are replaced, so the migration can run again without
+// effect.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v6 to v7: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+	for _, chainID := range migrations.ChainIDs(store, cdc) {
+		chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+
+		aggregates := map[common.Address]*types.PoolAggregate{}
+		var contracts []common.Address
+		iterate(chainStore, types.SendToEthereumKey, func(value []byte) {
+			var send types.SendToEthereum
+			cdc.MustUnmarshal(value, &send)
+			contract := common.HexToAddress(send.Erc20Fee.Contract)
+			if _, ok := aggregates[contract]; !ok {
+				aggregates[contract] = &types.PoolAggregate{TotalFees: sdk.ZeroInt()}
+				contracts = append(contracts, contract)
+			}
+			aggregates[contract].Count++
+			aggregates[contract].TotalFees = aggregates[contract].TotalFees.Add(send.Erc20Fee.Amount)
+		})
+
+		erc1155Aggregates := map[common.Address]*types.PoolAggregate{}
+		var erc1155Contracts []common.Address
+		iterate(chainStore, types.SendERC1155ToEthereumKey, func(value []byte) {
+			var send types.SendERC1155ToEthereum
+			cdc.MustUnmarshal(value, &send)
+			contract := common.HexToAddress(send.TokenContract)
+			if _, ok := erc1155Aggregates[contract]; !ok {
+				erc1155Aggregates[contract] = &types.PoolAggregate{TotalFees: sdk.ZeroInt()}
+				erc1155Contracts = append(erc1155Contracts, contract)
+			}
+			erc1155Aggregates[contract].Count++
+		})
+
+		migrations.DeleteKeys(chainStore, []byte{types.PoolAggregateKey})
+		for _, contract := range contracts {
+			chainStore.Set(types.MakePoolAggregateKey(contract), cdc.MustMarshal(aggregates[contract]))
+		}
+		migrations.DeleteKeys(chainStore, []byte{types.ERC1155PoolAggregateKey})
+		for _, contract := range erc1155Contracts {
+			chainStore.Set(types.MakeERC1155PoolAggregateKey(contract), cdc.MustMarshal(erc1155Aggregates[contract]))
+		}
+
+		ctx.Logger().Info("Gravity v6 to v7: Computed pool aggregates",
+			"chain id", chainID, "tokens", len(contracts), "erc1155 tokens", len(erc1155Contracts))
+	}
+
+	ctx.Logger().Info("Gravity v6 to v7: Store migration complete")
+
+	return nil
+}
+
+// iterate calls cb with the values under the prefix key
+func iterate(store storetypes.KVStore, prefixKey byte, cb func(value []byte)) {
+	iter := prefix.NewStore(store, []byte{prefixKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		cb(iter.Value())
+	}
+}
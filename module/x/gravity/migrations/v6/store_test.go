@@ -0,0 +1,55 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigrateStoreComputesPoolAggregates(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	store.Set([]byte{types.DefaultEVMChainIDKey}, sdk.Uint64ToBigEndian(chainID))
+	chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+
+	tokenContract := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	erc1155Contract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	for id, fee := range map[uint64]uint64{7: 3, 8: 5} {
+		send := types.SendToEthereum{
+			Id:                id,
+			Sender:            "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+			EthereumRecipient: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Erc20Token:        types.NewERC20Token(100, tokenContract),
+			Erc20Fee:          types.NewERC20Token(fee, tokenContract),
+		}
+		chainStore.Set(types.MakeSendToEthereumKey(send.Id, send.Erc20Fee), input.Marshaler.MustMarshal(&send))
+	}
+	erc1155Send := types.SendERC1155ToEthereum{
+		Id:                9,
+		Sender:            "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+		EthereumRecipient: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		TokenContract:     erc1155Contract.Hex(),
+	}
+	chainStore.Set(types.MakeSendERC1155ToEthereumKey(erc1155Contract, erc1155Send.Id), input.Marshaler.MustMarshal(&erc1155Send))
+
+	// the pools were written without aggregates, as before version 7
+	k := input.GravityKeeper
+	migrator := keeper.NewMigrator(k)
+	require.NoError(t, migrator.Migrate6to7(ctx))
+	require.Equal(t, types.PoolAggregate{Count: 2, TotalFees: sdk.NewInt(8)}, k.GetPoolAggregate(ctx, chainID, tokenContract))
+	require.Equal(t, types.PoolAggregate{Count: 1, TotalFees: sdk.ZeroInt()}, k.GetERC1155PoolAggregate(ctx, chainID, erc1155Contract))
+
+	// running it again changes nothing
+	require.NoError(t, migrator.Migrate6to7(ctx))
+	require.Equal(t, types.PoolAggregate{Count: 2, TotalFees: sdk.NewInt(8)}, k.GetPoolAggregate(ctx, chainID, tokenContract))
+	msg, broken := keeper.PoolAggregatesInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
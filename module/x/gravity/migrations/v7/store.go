@@ -0,0 +1,58 @@
+package v7
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// validatorAddressLen is the length of the validator addresses ending the keys of the
+// signatures before version 8
+const validatorAddressLen = 20
+
+// MigrateStore moves the signatures of the outgoing txs of all chains from keys ending with
+// the validator address to entries under the prefix of their outgoing tx, all keyed by
+// validator with a zero signer set nonce as none was recorded with them. The nonce of an
+// outgoing tx already under the prefix is kept, so the migration can run again without effect.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v7 to v8: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+	for _, chainID := range migrations.ChainIDs(store, cdc) {
+		chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+
+		var keys, values [][]byte
+		iter := prefix.NewStore(chainStore, []byte{types.EthereumSignatureKey}).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			if len(iter.Key()) <= validatorAddressLen {
+				continue
+			}
+			keys = append(keys, iter.Key())
+			values = append(values, iter.Value())
+		}
+		iter.Close()
+
+		migrations.DeleteKeys(chainStore, []byte{types.EthereumSignatureKey})
+		storeIndexes := map[string]bool{}
+		for i, key := range keys {
+			storeIndex := key[:len(key)-validatorAddressLen]
+			if !chainStore.Has(types.MakeEthereumSignaturesKey(storeIndex)) {
+				chainStore.Set(types.MakeEthereumSignaturesKey(storeIndex), sdk.Uint64ToBigEndian(0))
+			}
+			val := sdk.ValAddress(key[len(key)-validatorAddressLen:])
+			chainStore.Set(types.MakeValidatorEthereumSignatureKey(storeIndex, val), values[i])
+			storeIndexes[string(storeIndex)] = true
+		}
+
+		ctx.Logger().Info("Gravity v7 to v8: Moved the ethereum signatures",
+			"chain id", chainID, "outgoing txs", len(storeIndexes))
+	}
+
+	ctx.Logger().Info("Gravity v7 to v8: Store migration complete")
+
+	return nil
+}
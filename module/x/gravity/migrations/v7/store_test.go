@@ -0,0 +1,51 @@
+package v7_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigrateStoreMovesEthereumSignatures(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	store.Set([]byte{types.DefaultEVMChainIDKey}, sdk.Uint64ToBigEndian(chainID))
+	chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+
+	// the signatures were written by validator, as before version 8
+	signerSetIndex := types.MakeSignerSetTxKey(3)
+	batchIndex := types.MakeBatchTxKey(keeper.EthAddrs[0], 2)
+	oldKey := func(storeIndex []byte, val sdk.ValAddress) []byte {
+		return bytes.Join([][]byte{{types.EthereumSignatureKey}, storeIndex, val}, nil)
+	}
+	chainStore.Set(oldKey(signerSetIndex, keeper.ValAddrs[0]), []byte("sig0"))
+	chainStore.Set(oldKey(signerSetIndex, keeper.ValAddrs[1]), []byte("sig1"))
+	chainStore.Set(oldKey(batchIndex, keeper.ValAddrs[2]), []byte("sig2"))
+
+	k := input.GravityKeeper
+	migrator := keeper.NewMigrator(k)
+	require.NoError(t, migrator.Migrate7to8(ctx))
+	expected := map[string][]byte{
+		keeper.ValAddrs[0].String(): []byte("sig0"),
+		keeper.ValAddrs[1].String(): []byte("sig1"),
+	}
+	require.Equal(t, expected, k.GetEthereumSignatures(ctx, chainID, signerSetIndex))
+	require.Equal(t, map[string][]byte{keeper.ValAddrs[2].String(): []byte("sig2")}, k.GetEthereumSignatures(ctx, chainID, batchIndex))
+	require.Equal(t, []byte("sig2"), chainStore.Get(types.MakeValidatorEthereumSignatureKey(batchIndex, keeper.ValAddrs[2])))
+	require.Equal(t, sdk.Uint64ToBigEndian(0), chainStore.Get(types.MakeEthereumSignaturesKey(batchIndex)))
+	iter := prefix.NewStore(chainStore, []byte{types.EthereumSignatureKey}).Iterator(nil, nil)
+	require.False(t, iter.Valid())
+	iter.Close()
+
+	// running it again changes nothing
+	require.NoError(t, migrator.Migrate7to8(ctx))
+	require.Equal(t, expected, k.GetEthereumSignatures(ctx, chainID, signerSetIndex))
+}
@@ -0,0 +1,64 @@
+package v8
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// MigrateStore prunes the event vote records of all chains observed before version 9: the
+// observed records are kept without their votes and the other records at their nonces are
+// deleted, as they are once an event is observed since.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v8 to v9: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+	for _, chainID := range migrations.ChainIDs(store, cdc) {
+		records := prefix.NewStore(prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID)), []byte{types.EthereumEventVoteRecordKey})
+
+		// the keys of the records are their event nonce followed by their event hash
+		observedNonces := map[string]bool{}
+		var (
+			observed [][]byte
+			pruned   []*types.EthereumEventVoteRecord
+			pending  [][]byte
+		)
+		iter := records.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			var record types.EthereumEventVoteRecord
+			cdc.MustUnmarshal(iter.Value(), &record)
+			switch {
+			case record.Accepted:
+				observedNonces[string(iter.Key()[:8])] = true
+				record.Votes, record.SignerSetNonce, record.VoteBitmap = nil, 0, nil
+				observed = append(observed, iter.Key())
+				pruned = append(pruned, &record)
+			case !record.Rejected:
+				pending = append(pending, iter.Key())
+			}
+		}
+		iter.Close()
+
+		for i, key := range observed {
+			records.Set(key, cdc.MustMarshal(pruned[i]))
+		}
+		deleted := 0
+		for _, key := range pending {
+			if observedNonces[string(key[:8])] {
+				records.Delete(key)
+				deleted++
+			}
+		}
+
+		ctx.Logger().Info("Gravity v8 to v9: Pruned the observed event vote records",
+			"chain id", chainID, "observed", len(pruned), "deleted", deleted)
+	}
+
+	ctx.Logger().Info("Gravity v8 to v9: Store migration complete")
+
+	return nil
+}
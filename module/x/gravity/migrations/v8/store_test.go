@@ -0,0 +1,59 @@
+package v8_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigrateStorePrunesEventVoteRecords(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	store.Set([]byte{types.DefaultEVMChainIDKey}, sdk.Uint64ToBigEndian(chainID))
+	chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+
+	// the records kept their votes once observed before version 9
+	event := func(nonce uint64, amount int64) *types.SendToCosmosEvent {
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  keeper.EthAddrs[0].Hex(),
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: keeper.EthAddrs[1].Hex(),
+			CosmosReceiver: keeper.AccAddrs[1].String(),
+			EthereumHeight: 10,
+		}
+	}
+	setRecord := func(event *types.SendToCosmosEvent, accepted bool, voters ...sdk.ValAddress) {
+		any, err := types.PackEvent(event)
+		require.NoError(t, err)
+		record := &types.EthereumEventVoteRecord{Event: any, Accepted: accepted}
+		for _, val := range voters {
+			record.Votes = append(record.Votes, val.String())
+		}
+		chainStore.Set(types.MakeEthereumEventVoteRecordKey(event.EventNonce, event.Hash()), input.Marshaler.MustMarshal(record))
+	}
+	observed, other, pending := event(1, 100), event(1, 200), event(2, 100)
+	setRecord(observed, true, keeper.ValAddrs[:4]...)
+	setRecord(other, false, keeper.ValAddrs[4])
+	setRecord(pending, false, keeper.ValAddrs[0])
+
+	k := input.GravityKeeper
+	migrator := keeper.NewMigrator(k)
+	require.NoError(t, migrator.Migrate8to9(ctx))
+	record := k.GetEthereumEventVoteRecord(ctx, chainID, 1, observed.Hash())
+	require.True(t, record.Accepted)
+	require.Empty(t, record.Votes)
+	require.Nil(t, k.GetEthereumEventVoteRecord(ctx, chainID, 1, other.Hash()))
+	require.Len(t, k.GetEthereumEventVoteRecord(ctx, chainID, 2, pending.Hash()).Votes, 1)
+
+	// running it again changes nothing
+	require.NoError(t, migrator.Migrate8to9(ctx))
+	require.Len(t, k.GetEthereumEventVoteRecordMapping(ctx, chainID), 2)
+}
@@ -0,0 +1,57 @@
+package v9
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keycodec"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/migrations"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// MigrateStore prefixes the variable length components of the keys of the gravity store, the
+// Cosmos addresses, denoms and channel ids, by their length, see keycodec. Before version 10
+// they were the rest of their keys or followed by a sequence, so that a key could be read as
+// one with other components. The migration runs once, the keys of the addresses and denoms
+// being rewritten from the keys themselves.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v9 to v10: Beginning store migration")
+
+	store := ctx.KVStore(storeKey)
+	lengthPrefixed := func(key, _ []byte) []byte {
+		return keycodec.LengthPrefixed(key)
+	}
+	rekeyed := migrations.ReindexKeys(store, []byte{types.ValidatorEthereumAddressKey}, lengthPrefixed)
+	rekeyed += migrations.ReindexKeys(store, []byte{types.OrchestratorValidatorAddressKey}, lengthPrefixed)
+	rekeyed += migrations.ReindexKeys(store, []byte{types.ERC1155TokenKey}, func(_, value []byte) []byte {
+		var token types.ERC1155Token
+		cdc.MustUnmarshal(value, &token)
+		return keycodec.String(token.Denom())
+	})
+	rekeyed += migrations.ReindexKeys(store, []byte{types.ForwardedDepositKey}, func(_, value []byte) []byte {
+		var deposit types.ForwardedDeposit
+		cdc.MustUnmarshal(value, &deposit)
+		return types.MakeForwardedDepositKey(deposit.ChannelId, deposit.Sequence)[1:]
+	})
+	ctx.Logger().Info("Gravity v9 to v10: Rewrote the keys of the module", "keys", rekeyed)
+
+	for _, chainID := range migrations.ChainIDs(store, cdc) {
+		chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+		rekeyed := 0
+		for _, keyPrefix := range []byte{
+			types.LastEventNonceByValidatorKey,
+			types.EthereumHeightVoteKey,
+			types.DenomToERC20Key,
+			types.DepositAddressKey,
+		} {
+			rekeyed += migrations.ReindexKeys(chainStore, []byte{keyPrefix}, lengthPrefixed)
+		}
+		ctx.Logger().Info("Gravity v9 to v10: Rewrote the keys of the chain", "chain id", chainID, "keys", rekeyed)
+	}
+
+	ctx.Logger().Info("Gravity v9 to v10: Store migration complete")
+
+	return nil
+}
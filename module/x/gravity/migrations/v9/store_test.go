@@ -0,0 +1,68 @@
+package v9_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestMigrateStoreLengthPrefixesKeys(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+	chainID := keeper.TestingGravityParams.BridgeChainId
+	store.Set([]byte{types.DefaultEVMChainIDKey}, sdk.Uint64ToBigEndian(chainID))
+	chainStore := prefix.NewStore(store, types.MakeEVMChainStorePrefix(chainID))
+
+	// the addresses and denoms were the rest of their keys before version 10, and a 32 byte
+	// address is kept whole
+	oldKey := func(keyPrefix byte, components ...[]byte) []byte {
+		key := []byte{keyPrefix}
+		for _, component := range components {
+			key = append(key, component...)
+		}
+		return key
+	}
+	val := keeper.ValAddrs[0]
+	orchestrator := sdk.AccAddress(make([]byte, 32))
+	orchestrator[0] = 1
+	store.Set(oldKey(types.ValidatorEthereumAddressKey, val), keeper.EthAddrs[0].Bytes())
+	store.Set(oldKey(types.OrchestratorValidatorAddressKey, orchestrator), val)
+	token := types.ERC1155Token{EvmChainId: chainID, Contract: keeper.EthAddrs[1].Hex(), Id: sdk.NewInt(7)}
+	store.Set(oldKey(types.ERC1155TokenKey, []byte(token.Denom())), input.Marshaler.MustMarshal(&token))
+	deposit := types.ForwardedDeposit{EvmChainId: chainID, ChannelId: "channel-1", Sequence: 2, Amount: sdk.NewInt64Coin("stake", 1)}
+	store.Set(oldKey(types.ForwardedDepositKey, []byte(deposit.ChannelId), sdk.Uint64ToBigEndian(deposit.Sequence)), input.Marshaler.MustMarshal(&deposit))
+	chainStore.Set(oldKey(types.LastEventNonceByValidatorKey, val), sdk.Uint64ToBigEndian(3))
+	chainStore.Set(oldKey(types.EthereumHeightVoteKey, val), input.Marshaler.MustMarshal(&types.LatestEthereumBlockHeight{EthereumHeight: 10, CosmosHeight: 20}))
+	chainStore.Set(oldKey(types.DenomToERC20Key, []byte("ucosmos")), keeper.EthAddrs[2].Bytes())
+	chainStore.Set(oldKey(types.DepositAddressKey, orchestrator), keeper.EthAddrs[3].Bytes())
+
+	k := input.GravityKeeper
+	require.NoError(t, keeper.NewMigrator(k).Migrate9to10(ctx))
+
+	require.Equal(t, keeper.EthAddrs[0], k.GetValidatorEthereumAddress(ctx, val))
+	require.Equal(t, val, k.GetOrchestratorValidatorAddress(ctx, orchestrator))
+	got, found := k.GetERC1155Token(ctx, token.Denom())
+	require.True(t, found)
+	require.Equal(t, token.Denom(), got.Denom())
+	_, found = k.GetForwardedDeposit(ctx, deposit.ChannelId, deposit.Sequence)
+	require.True(t, found)
+	require.Equal(t, types.LatestEthereumBlockHeight{EthereumHeight: 10, CosmosHeight: 20}, k.GetEthereumHeightVote(ctx, chainID, val))
+	depositAddress, found := k.GetDepositAddress(ctx, chainID, orchestrator)
+	require.True(t, found)
+	require.Equal(t, keeper.EthAddrs[3], depositAddress)
+	_, erc20, err := k.DenomToERC20Lookup(ctx, chainID, "ucosmos")
+	require.NoError(t, err)
+	require.Equal(t, keeper.EthAddrs[2], erc20)
+
+	// the keys are read back whole by the iterations
+	genesis := keeper.ExportGenesis(ctx, k)
+	require.Len(t, genesis.DelegateKeys, 1)
+	require.Equal(t, val.String(), genesis.DelegateKeys[0].ValidatorAddress)
+	require.Equal(t, []types.ValidatorEventNonce{{ValidatorAddress: val.String(), Nonce: 3}}, genesis.LastEventNonces)
+}
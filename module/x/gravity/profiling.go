@@ -0,0 +1,49 @@
+package gravity
+
+import (
+	"context"
+	"runtime/pprof"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// ProfileLabelSection is the pprof label naming the section of the gravity blockers the
+// samples of a CPU profile of the node were taken in, the sections run for an EVM chain
+// being labeled with its id as well
+const ProfileLabelSection = "gravity_section"
+
+// The sections of the gravity blockers in profiles
+const (
+	profileSectionScheduledParams   = "scheduled_params"
+	profileSectionTimeouts          = "timeouts"
+	profileSectionSignerSetTxs      = "signer_set_txs"
+	profileSectionBatchCreation     = "batch_creation"
+	profileSectionSignerSetPruning  = "signer_set_pruning"
+	profileSectionSlashing          = "slashing"
+	profileSectionVoterPowers       = "voter_powers"
+	profileSectionEventVoteTally    = "event_vote_tally"
+	profileSectionObservedHeight    = "observed_height"
+	profileSectionContractMigration = "contract_migration"
+	profileSectionGravityIDRotation = "gravity_id_rotation"
+	profileSectionRelayerIncentives = "relayer_incentives"
+	profileSectionBridgeReport      = "bridge_report"
+	profileSectionBlockSummary      = "block_summary"
+	profileSectionBridgeState       = "bridge_state"
+)
+
+// profileSection runs the section of a blocker with the pprof labels naming it, so that
+// operators profiling a slow node can attribute the time of the blockers to the bridge
+// subsystems
+func profileSection(ctx sdk.Context, section string, f func()) {
+	pprof.Do(ctx.Context(), pprof.Labels(ProfileLabelSection, section), func(context.Context) { f() })
+}
+
+// profileChainSection runs the section of a blocker for the chain with the pprof labels
+// naming it and the chain
+func profileChainSection(ctx sdk.Context, section string, chainID uint64, f func()) {
+	labels := pprof.Labels(ProfileLabelSection, section, types.MetricLabelEVMChainID, strconv.FormatUint(chainID, 10))
+	pprof.Do(ctx.Context(), labels, func(context.Context) { f() })
+}
@@ -0,0 +1,108 @@
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// protoValues returns an empty value of the protobuf type stored under each key prefix
+var protoValues = map[byte]func() codec.ProtoMarshaler{
+	types.EthereumEventVoteRecordKey: func() codec.ProtoMarshaler { return &types.EthereumEventVoteRecord{} },
+	types.SendToEthereumKey:          func() codec.ProtoMarshaler { return &types.SendToEthereum{} },
+	types.LastEthereumBlockHeightKey: func() codec.ProtoMarshaler { return &types.LatestEthereumBlockHeight{} },
+	types.LastObservedSignerSetKey:   func() codec.ProtoMarshaler { return &types.SignerSetTx{} },
+	types.EthereumHeightVoteKey:      func() codec.ProtoMarshaler { return &types.LatestEthereumBlockHeight{} },
+	types.EVMChainKey:                func() codec.ProtoMarshaler { return &types.EVMChain{} },
+	types.BridgeContractKey:          func() codec.ProtoMarshaler { return &types.BridgeContract{} },
+	types.ContractMigrationKey:       func() codec.ProtoMarshaler { return &types.ContractMigration{} },
+	types.GravityIDRotationKey:       func() codec.ProtoMarshaler { return &types.GravityIDRotation{} },
+	types.RateLimitUsageKey:          func() codec.ProtoMarshaler { return &types.RateLimitUsage{} },
+	types.ERC1155TokenKey:            func() codec.ProtoMarshaler { return &types.ERC1155Token{} },
+	types.SendERC1155ToEthereumKey:   func() codec.ProtoMarshaler { return &types.SendERC1155ToEthereum{} },
+	types.ForwardedDepositKey:        func() codec.ProtoMarshaler { return &types.ForwardedDeposit{} },
+	types.ParamsKey:                  func() codec.ProtoMarshaler { return &types.Params{} },
+	types.RelayerIncentiveKey:        func() codec.ProtoMarshaler { return &types.RelayerIncentive{} },
+	types.IncidentRecordKey:          func() codec.ProtoMarshaler { return &types.IncidentRecord{} },
+	types.ScheduledParamsUpdateKey:   func() codec.ProtoMarshaler { return &types.ScheduledParamsUpdate{} },
+	types.BridgeReportKey:            func() codec.ProtoMarshaler { return &types.BridgeReport{} },
+	types.CurrentBridgeReportKey:     func() codec.ProtoMarshaler { return &types.BridgeReport{} },
+	types.BridgeStateHashKey:         func() codec.ProtoMarshaler { return &types.BridgeStateHash{} },
+	types.AttestationLatencyKey:      func() codec.ProtoMarshaler { return &types.AttestationLatency{} },
+	types.PoolAggregateKey:           func() codec.ProtoMarshaler { return &types.PoolAggregate{} },
+	types.ERC1155PoolAggregateKey:    func() codec.ProtoMarshaler { return &types.PoolAggregate{} },
+	types.VoterSetKey:                func() codec.ProtoMarshaler { return &types.VoterSet{} },
+	types.VoucherIssuanceKey:         func() codec.ProtoMarshaler { return &sdk.IntProto{} },
+}
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding gravity type. The keys of the state of an EVM chain are
+// decoded by the prefix following the chain id.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		return fmt.Sprintf("%v\n%v", decodePair(cdc, kvA), decodePair(cdc, kvB))
+	}
+}
+
+func decodePair(cdc codec.Codec, pair kv.Pair) string {
+	if len(pair.Key) == 0 {
+		return ""
+	}
+	prefix := pair.Key[0]
+	if prefix == types.EVMChainStoreKey && len(pair.Key) > 9 {
+		prefix = pair.Key[9]
+	}
+	return decodeValue(cdc, prefix, pair.Value)
+}
+
+func decodeValue(cdc codec.Codec, prefix byte, value []byte) string {
+	if newValue, ok := protoValues[prefix]; ok {
+		v := newValue()
+		cdc.MustUnmarshal(value, v)
+		return string(cdc.MustMarshalJSON(v))
+	}
+
+	switch prefix {
+	case types.LastEventNonceByValidatorKey, types.LastObservedEventNonceKey, types.LatestSignerSetTxNonceKey,
+		types.LastSlashedOutgoingTxBlockKey, types.LastSlashedSignerSetTxNonceKey, types.LastOutgoingBatchNonceKey,
+		types.LastSendToEthereumIDKey, types.LastUnBondingBlockHeightKey, types.DefaultEVMChainIDKey,
+		types.LastRelayerIncentiveIDKey, types.ContractVersionKey, types.LastIncidentRecordIDKey:
+		return fmt.Sprint(sdk.BigEndianToUint64(value))
+
+	case types.OutgoingTxKey:
+		var otx types.OutgoingTx
+		if err := cdc.UnmarshalInterface(value, &otx); err != nil {
+			panic(err)
+		}
+		return string(cdc.MustMarshalJSON(otx.(codec.ProtoMarshaler)))
+
+	case types.ValidatorEthereumAddressKey, types.DenomToERC20Key, types.DepositAddressKey:
+		return common.BytesToAddress(value).Hex()
+
+	case types.OrchestratorValidatorAddressKey:
+		return sdk.ValAddress(value).String()
+
+	case types.EthereumOrchestratorAddressKey:
+		return sdk.AccAddress(value).String()
+
+	case types.ERC20ToDenomKey:
+		return string(value)
+
+	case types.EthereumSignaturesKey:
+		return fmt.Sprintf("%X", value)
+
+	case types.EVMChainPausedKey:
+		return fmt.Sprint(len(value) > 0 && value[0] == 1)
+
+	case types.VoterPowerUpdateKey:
+		return ""
+
+	default:
+		panic(fmt.Sprintf("invalid gravity key prefix %X", prefix))
+	}
+}
@@ -0,0 +1,61 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/simulation"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	dec := simulation.NewDecodeStore(cdc)
+
+	chainID := uint64(5)
+	chainKey := func(key []byte) []byte {
+		return append(append([]byte{types.EVMChainStoreKey}, sdk.Uint64ToBigEndian(chainID)...), key...)
+	}
+
+	send := types.SendToEthereum{
+		Id:                1,
+		Sender:            "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn",
+		EthereumRecipient: "0x2a24af0501a534fca004ee1bd667b783f205a546",
+		Erc20Token:        types.NewSDKIntERC20Token(sdk.NewInt(10), common.HexToAddress("0x1")),
+		Erc20Fee:          types.NewSDKIntERC20Token(sdk.NewInt(1), common.HexToAddress("0x1")),
+	}
+	batch := &types.BatchTx{BatchNonce: 2, TokenContract: common.HexToAddress("0x1").Hex()}
+	batchBytes, err := cdc.MarshalInterface(batch)
+	require.NoError(t, err)
+	ethAddr := common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	valAddr := sdk.ValAddress([]byte("validator"))
+
+	tests := []struct {
+		name     string
+		pair     kv.Pair
+		expected string
+	}{
+		{"SendToEthereum", kv.Pair{Key: chainKey(types.MakeSendToEthereumKey(send.Id, send.Erc20Fee)), Value: cdc.MustMarshal(&send)}, string(cdc.MustMarshalJSON(&send))},
+		{"OutgoingTx", kv.Pair{Key: chainKey(types.MakeOutgoingTxKey(batch.GetStoreIndex())), Value: batchBytes}, string(cdc.MustMarshalJSON(batch))},
+		{"LastObservedEventNonce", kv.Pair{Key: chainKey([]byte{types.LastObservedEventNonceKey}), Value: sdk.Uint64ToBigEndian(7)}, "7"},
+		{"ValidatorEthereumAddress", kv.Pair{Key: types.MakeValidatorEthereumAddressKey(valAddr), Value: ethAddr.Bytes()}, ethAddr.Hex()},
+		{"OrchestratorValidatorAddress", kv.Pair{Key: []byte{types.OrchestratorValidatorAddressKey}, Value: valAddr}, valAddr.String()},
+		{"VoucherIssuance", kv.Pair{Key: types.MakeVoucherIssuanceKey("gravity0x1"), Value: cdc.MustMarshal(&sdk.IntProto{Int: sdk.NewInt(3)})}, `{"int":"3"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, fmt.Sprintf("%v\n%v", tt.expected, tt.expected), dec(tt.pair, tt.pair))
+		})
+	}
+
+	require.Panics(t, func() { dec(kv.Pair{Key: []byte{0xff}}, kv.Pair{Key: []byte{0xff}}) })
+}
@@ -0,0 +1,119 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// Simulation parameter constants
+const (
+	bridgeChainID            = "bridge_chain_id"
+	signedSignerSetTxsWindow = "signed_signer_set_txs_window"
+	signedBatchesWindow      = "signed_batches_window"
+	targetEthTxTimeout       = "target_eth_tx_timeout"
+	slashFractionSignerSetTx = "slash_fraction_signer_set_tx"
+	slashFractionBatch       = "slash_fraction_batch"
+)
+
+var (
+	// simERC20Contract is the ERC20 the simulated deposits are made of, its vouchers are
+	// minted on cosmos
+	simERC20Contract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	// simBondDenomContract is the ERC20 representing the bond denom, cosmos originated
+	simBondDenomContract = common.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+)
+
+// ethereumKey returns the Ethereum key of the simulation account, derived from its
+// secp256k1 private key, which the account's validator delegates to sign for the bridge
+func ethereumKey(acc simtypes.Account) *ecdsa.PrivateKey {
+	key, err := ethcrypto.ToECDSA(acc.PrivKey.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// delegateKeysSignature returns the signature of the delegation of the validator of the
+// simulation account to its Ethereum key, for the account sequence
+func delegateKeysSignature(acc simtypes.Account, sequence uint64) []byte {
+	signMsg := types.DelegateKeysSignMsg{
+		ValidatorAddress: sdk.ValAddress(acc.Address).String(),
+		Nonce:            sequence,
+	}
+	bz, err := signMsg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	signature, err := types.NewEthereumSignature(ethcrypto.Keccak256(bz), ethereumKey(acc))
+	if err != nil {
+		panic(err)
+	}
+	return signature
+}
+
+// RandomizedGenState generates a random GenesisState for gravity. The validators bonded at
+// genesis, the first accounts, have their keys delegated to themselves and their Ethereum
+// keys, and the bond denom is mapped to an ERC20 of the default chain.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, bridgeChainID, &params.BridgeChainId, simState.Rand,
+		func(r *rand.Rand) { params.BridgeChainId = uint64(simtypes.RandIntBetween(r, 1, 1000)) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, signedSignerSetTxsWindow, &params.SignedSignerSetTxsWindow, simState.Rand,
+		func(r *rand.Rand) {
+			params.SignedSignerSetTxsWindow = uint64(simtypes.RandIntBetween(r, 1000, 20000))
+		},
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, signedBatchesWindow, &params.SignedBatchesWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedBatchesWindow = uint64(simtypes.RandIntBetween(r, 1000, 20000)) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, targetEthTxTimeout, &params.TargetEthTxTimeout, simState.Rand,
+		func(r *rand.Rand) { params.TargetEthTxTimeout = uint64(simtypes.RandIntBetween(r, 60000, 43200000)) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashFractionSignerSetTx, &params.SlashFractionSignerSetTx, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionSignerSetTx = sdk.NewDecWithPrec(int64(r.Intn(10)), 3) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slashFractionBatch, &params.SlashFractionBatch, simState.Rand,
+		func(r *rand.Rand) { params.SlashFractionBatch = sdk.NewDecWithPrec(int64(r.Intn(10)), 3) },
+	)
+
+	gravityGenesis := types.GenesisState{
+		Params: params,
+		Erc20ToDenoms: []*types.ERC20ToDenom{{
+			Erc20: simBondDenomContract.Hex(),
+			Denom: sdk.DefaultBondDenom,
+		}},
+	}
+	for _, acc := range simState.Accounts[:simState.NumBonded] {
+		gravityGenesis.DelegateKeys = append(gravityGenesis.DelegateKeys, types.NewMsgDelegateKeys(
+			sdk.ValAddress(acc.Address),
+			acc.Address,
+			ethcrypto.PubkeyToAddress(ethereumKey(acc).PublicKey).Hex(),
+			delegateKeysSignature(acc, 0),
+		))
+	}
+
+	paramsBytes, err := json.MarshalIndent(params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated gravity parameters:\n%s\n", paramsBytes)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&gravityGenesis)
+}
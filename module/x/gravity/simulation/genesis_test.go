@@ -0,0 +1,47 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/simulation"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	r := rand.New(rand.NewSource(1))
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 5),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+	simulation.RandomizedGenState(&simState)
+
+	var genState types.GenesisState
+	cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genState)
+	require.NoError(t, genState.ValidateBasic())
+
+	// the bonded validators delegate to their accounts
+	require.Len(t, genState.DelegateKeys, 3)
+	for i, delegation := range genState.DelegateKeys {
+		require.Equal(t, sdk.ValAddress(simState.Accounts[i].Address).String(), delegation.ValidatorAddress)
+		require.Equal(t, simState.Accounts[i].Address.String(), delegation.OrchestratorAddress)
+	}
+	require.Equal(t, sdk.DefaultBondDenom, genState.Erc20ToDenoms[0].Denom)
+}
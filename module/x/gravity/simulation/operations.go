@@ -0,0 +1,343 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	appparams "github.com/peggyjv/gravity-bridge/module/v3/app/params"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendToEthereum               = "op_weight_msg_send_to_ethereum"
+	OpWeightMsgCancelSendToEthereum         = "op_weight_msg_cancel_send_to_ethereum"
+	OpWeightMsgSubmitEthereumTxConfirmation = "op_weight_msg_submit_ethereum_tx_confirmation"
+	OpWeightMsgSubmitEthereumEvent          = "op_weight_msg_submit_ethereum_event"
+	OpWeightMsgDelegateKeys                 = "op_weight_msg_delegate_keys"
+)
+
+// simEthereumSender is the Ethereum sender of the simulated deposits
+var simEthereumSender = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgSendToEthereum               int
+		weightMsgCancelSendToEthereum         int
+		weightMsgSubmitEthereumTxConfirmation int
+		weightMsgSubmitEthereumEvent          int
+		weightMsgDelegateKeys                 int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendToEthereum, &weightMsgSendToEthereum, nil,
+		func(_ *rand.Rand) { weightMsgSendToEthereum = appparams.DefaultWeightMsgSendToEthereum },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelSendToEthereum, &weightMsgCancelSendToEthereum, nil,
+		func(_ *rand.Rand) { weightMsgCancelSendToEthereum = appparams.DefaultWeightMsgCancelSendToEthereum },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitEthereumTxConfirmation, &weightMsgSubmitEthereumTxConfirmation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitEthereumTxConfirmation = appparams.DefaultWeightMsgSubmitEthereumTxConfirmation
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitEthereumEvent, &weightMsgSubmitEthereumEvent, nil,
+		func(_ *rand.Rand) { weightMsgSubmitEthereumEvent = appparams.DefaultWeightMsgSubmitEthereumEvent },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateKeys, &weightMsgDelegateKeys, nil,
+		func(_ *rand.Rand) { weightMsgDelegateKeys = appparams.DefaultWeightMsgDelegateKeys },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSendToEthereum, SimulateMsgSendToEthereum(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgCancelSendToEthereum, SimulateMsgCancelSendToEthereum(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSubmitEthereumTxConfirmation, SimulateMsgSubmitEthereumTxConfirmation(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSubmitEthereumEvent, SimulateMsgSubmitEthereumEvent(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgDelegateKeys, SimulateMsgDelegateKeys(ak, bk, k)),
+	}
+}
+
+// SimulateMsgSendToEthereum generates a MsgSendToEthereum of the bond denom or of the
+// vouchers of the simulated deposits from a random account to a random Ethereum recipient
+func SimulateMsgSendToEthereum(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSendToEthereum{}).Type()
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		denoms := []string{sdk.DefaultBondDenom, types.GravityDenom(simERC20Contract)}
+		denom := denoms[r.Intn(len(denoms))]
+		// keep some of the balance for the fees of the tx
+		balance := spendable.AmountOf(denom).QuoRaw(2)
+		if !balance.GT(sdk.OneInt()) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient balance"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+		fee := simtypes.RandomAmount(r, balance.Sub(amount))
+
+		var recipient common.Address
+		r.Read(recipient[:])
+		msg := types.NewMsgSendToEthereum(simAccount.Address, recipient.Hex(), sdk.NewCoin(denom, amount), sdk.NewCoin(denom, fee))
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, sdk.NewCoins(msg.Amount.Add(msg.BridgeFee)))
+	}
+}
+
+// SimulateMsgCancelSendToEthereum generates a MsgCancelSendToEthereum of a random
+// unbatched send of a simulation account
+func SimulateMsgCancelSendToEthereum(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgCancelSendToEthereum{}).Type()
+
+		var sends []*types.SendToEthereum
+		k.IterateUnbatchedSendToEthereums(ctx, 0, func(ste *types.SendToEthereum) bool {
+			sends = append(sends, ste)
+			return false
+		})
+		if len(sends) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched sends"), nil, nil
+		}
+		send := sends[r.Intn(len(sends))]
+
+		sender, err := sdk.AccAddressFromBech32(send.Sender)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid sender"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sender)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender is not a simulation account"), nil, nil
+		}
+		msg := types.NewMsgCancelSendToEthereum(send.Id, simAccount.Address)
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgSubmitEthereumTxConfirmation generates a MsgSubmitEthereumTxConfirmation of
+// a random signer set, batch or contract call the validator of a random simulation
+// account hasn't signed yet, signed with its delegated Ethereum key
+func SimulateMsgSubmitEthereumTxConfirmation(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSubmitEthereumTxConfirmation{}).Type()
+		simAccount, ok := randomOrchestrator(r, ctx, accs, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator with delegated keys"), nil, nil
+		}
+
+		wctx := sdk.WrapSDKContext(ctx)
+		var otxs []types.OutgoingTx
+		signerSets, err := k.UnsignedSignerSetTxs(wctx, &types.UnsignedSignerSetTxsRequest{Address: simAccount.Address.String()})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to query signer sets"), nil, err
+		}
+		for _, signerSet := range signerSets.SignerSets {
+			otxs = append(otxs, signerSet)
+		}
+		batches, err := k.UnsignedBatchTxs(wctx, &types.UnsignedBatchTxsRequest{Address: simAccount.Address.String()})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to query batches"), nil, err
+		}
+		for _, batch := range batches.Batches {
+			otxs = append(otxs, batch)
+		}
+		calls, err := k.UnsignedContractCallTxs(wctx, &types.UnsignedContractCallTxsRequest{Address: simAccount.Address.String()})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to query contract calls"), nil, err
+		}
+		for _, call := range calls.Calls {
+			otxs = append(otxs, call)
+		}
+		if len(otxs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unsigned outgoing txs"), nil, nil
+		}
+		otx := otxs[r.Intn(len(otxs))]
+
+		key := ethereumKey(simAccount)
+		signature, err := types.NewEthereumSignature(otx.GetCheckpoint([]byte(k.GetParams(ctx).GravityId)), key)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign outgoing tx"), nil, err
+		}
+		signer := ethcrypto.PubkeyToAddress(key.PublicKey).Hex()
+
+		var confirmation types.EthereumTxConfirmation
+		switch otx := otx.(type) {
+		case *types.SignerSetTx:
+			confirmation = &types.SignerSetTxConfirmation{
+				SignerSetNonce: otx.Nonce,
+				EthereumSigner: signer,
+				Signature:      signature,
+			}
+		case *types.BatchTx:
+			confirmation = &types.BatchTxConfirmation{
+				TokenContract:  otx.TokenContract,
+				BatchNonce:     otx.BatchNonce,
+				EthereumSigner: signer,
+				Signature:      signature,
+			}
+		case *types.ContractCallTx:
+			confirmation = &types.ContractCallTxConfirmation{
+				InvalidationScope: otx.InvalidationScope,
+				InvalidationNonce: otx.InvalidationNonce,
+				EthereumSigner:    signer,
+				Signature:         signature,
+			}
+		}
+		packed, err := types.PackConfirmation(confirmation)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack confirmation"), nil, err
+		}
+		msg := &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: packed,
+			Signer:       simAccount.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgSubmitEthereumEvent generates a MsgSubmitEthereumEvent of the deposit at the
+// next event nonce of the validator of a random simulation account. The deposit at each
+// nonce is the same for all validators, so that their votes observe it.
+func SimulateMsgSubmitEthereumEvent(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSubmitEthereumEvent{}).Type()
+		simAccount, ok := randomOrchestrator(r, ctx, accs, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator with delegated keys"), nil, nil
+		}
+
+		res, err := k.LastSubmittedEthereumEvent(sdk.WrapSDKContext(ctx), &types.LastSubmittedEthereumEventRequest{
+			Address: simAccount.Address.String(),
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to query last submitted event"), nil, err
+		}
+		nonce := res.EventNonce + 1
+
+		packed, err := types.PackEvent(&types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  simERC20Contract.Hex(),
+			Amount:         sdk.NewIntFromUint64(nonce).MulRaw(1_000_000),
+			EthereumSender: simEthereumSender.Hex(),
+			CosmosReceiver: accs[nonce%uint64(len(accs))].Address.String(),
+			EthereumHeight: nonce,
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack event"), nil, err
+		}
+		msg := &types.MsgSubmitEthereumEvent{
+			Event:  packed,
+			Signer: simAccount.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// SimulateMsgDelegateKeys generates a MsgDelegateKeys of a validator created during the
+// simulation to its own account and the Ethereum key of the account
+func SimulateMsgDelegateKeys(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgDelegateKeys{}).Type()
+
+		var candidates []simtypes.Account
+		for _, acc := range accs {
+			valAddr := sdk.ValAddress(acc.Address)
+			if k.StakingKeeper.Validator(ctx, valAddr) == nil {
+				continue
+			}
+			ethAddr := ethcrypto.PubkeyToAddress(ethereumKey(acc).PublicKey)
+			if k.GetValidatorEthereumAddress(ctx, valAddr) != (common.Address{}) ||
+				k.GetEthereumOrchestratorAddress(ctx, ethAddr) != nil ||
+				k.GetOrchestratorValidatorAddress(ctx, acc.Address) != nil {
+				continue
+			}
+			candidates = append(candidates, acc)
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no validator without delegated keys"), nil, nil
+		}
+		simAccount := candidates[r.Intn(len(candidates))]
+
+		sequence, err := ak.GetSequence(ctx, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get account sequence"), nil, err
+		}
+		msg := types.NewMsgDelegateKeys(
+			sdk.ValAddress(simAccount.Address),
+			simAccount.Address,
+			ethcrypto.PubkeyToAddress(ethereumKey(simAccount).PublicKey).Hex(),
+			delegateKeysSignature(simAccount, sequence),
+		)
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType, nil)
+	}
+}
+
+// randomOrchestrator returns a random simulation account orchestrating for its own bonded
+// validator with its Ethereum key
+func randomOrchestrator(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k keeper.Keeper) (simtypes.Account, bool) {
+	var orchestrators []simtypes.Account
+	for _, acc := range accs {
+		valAddr := sdk.ValAddress(acc.Address)
+		validator := k.StakingKeeper.Validator(ctx, valAddr)
+		if validator == nil || !validator.IsBonded() {
+			continue
+		}
+		if !valAddr.Equals(k.GetOrchestratorValidatorAddress(ctx, acc.Address)) ||
+			k.GetValidatorEthereumAddress(ctx, valAddr) != ethcrypto.PubkeyToAddress(ethereumKey(acc).PublicKey) {
+			continue
+		}
+		orchestrators = append(orchestrators, acc)
+	}
+	if len(orchestrators) == 0 {
+		return simtypes.Account{}, false
+	}
+	return orchestrators[r.Intn(len(orchestrators))], true
+}
+
+// deliver signs the msg with the simulation account and delivers it in a tx with random fees
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+	simAccount simtypes.Account, msg sdk.Msg, msgType string, coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: coinsSpentInMsg,
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
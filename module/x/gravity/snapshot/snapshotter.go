@@ -0,0 +1,133 @@
+package snapshot
+
+import (
+	"bytes"
+	"io"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+const (
+	// SnapshotFormat is the format of the payloads written by the snapshotter, a kv.Pairs of
+	// the store entries in key order
+	SnapshotFormat uint32 = 1
+
+	// ChunkSize is the size in bytes of the keys and values from which the entries written
+	// so far are flushed to a payload
+	ChunkSize = 64 * 1024
+)
+
+var _ snapshottypes.ExtensionSnapshotter = &Snapshotter{}
+
+// Snapshotter is a state sync snapshot extension streaming the bridge state, the event vote
+// records, pools and outgoing txs of all chains, in chunks after the multistore. The module
+// store is part of the multistore snapshot already, so on restore the extension checks the
+// restored store holds exactly the streamed state and fails the restore otherwise, rather
+// than letting a node join with bridge state the snapshot didn't carry.
+type Snapshotter struct {
+	cms      storetypes.MultiStore
+	storeKey storetypes.StoreKey
+}
+
+// NewSnapshotter returns the snapshotter of the gravity store of the multistore
+func NewSnapshotter(cms storetypes.MultiStore, storeKey storetypes.StoreKey) *Snapshotter {
+	return &Snapshotter{cms: cms, storeKey: storeKey}
+}
+
+// SnapshotName implements ExtensionSnapshotter
+func (s *Snapshotter) SnapshotName() string {
+	return types.ModuleName
+}
+
+// SnapshotFormat implements ExtensionSnapshotter
+func (s *Snapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements ExtensionSnapshotter
+func (s *Snapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// Snapshot implements Snapshotter, writing the entries of the gravity store at the height
+func (s *Snapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	cms, err := s.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return sdkerrors.Wrapf(err, "load gravity store at height %d", height)
+	}
+
+	iter := cms.GetKVStore(s.storeKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	var chunk kv.Pairs
+	size := 0
+	flush := func() error {
+		if len(chunk.Pairs) == 0 {
+			return nil
+		}
+		bz, err := chunk.Marshal()
+		if err != nil {
+			return err
+		}
+		chunk, size = kv.Pairs{}, 0
+		return snapshottypes.WriteExtensionItem(protoWriter, bz)
+	}
+
+	for ; iter.Valid(); iter.Next() {
+		chunk.Pairs = append(chunk.Pairs, kv.Pair{Key: iter.Key(), Value: iter.Value()})
+		size += len(iter.Key()) + len(iter.Value())
+		if size >= ChunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// Restore implements Snapshotter, checking the payloads against the gravity store restored
+// from the multistore snapshot. It returns the first item that isn't one of its payloads.
+func (s *Snapshotter) Restore(height uint64, format uint32, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	if format != SnapshotFormat {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %d", format)
+	}
+
+	iter := s.cms.CacheMultiStore().GetKVStore(s.storeKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	var item snapshottypes.SnapshotItem
+	for {
+		item = snapshottypes.SnapshotItem{}
+		if err := protoReader.ReadMsg(&item); err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			break
+		}
+
+		var chunk kv.Pairs
+		if err := chunk.Unmarshal(payload.Payload); err != nil {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid gravity snapshot payload")
+		}
+		for _, pair := range chunk.Pairs {
+			if !iter.Valid() || !bytes.Equal(iter.Key(), pair.Key) || !bytes.Equal(iter.Value(), pair.Value) {
+				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(types.ErrInvalid, "restored gravity store differs from the snapshot at key %X", pair.Key)
+			}
+			iter.Next()
+		}
+	}
+
+	if iter.Valid() {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(types.ErrInvalid, "restored gravity store has key %X not in the snapshot", iter.Key())
+	}
+	return item, nil
+}
@@ -0,0 +1,66 @@
+package snapshot
+
+import (
+	"bytes"
+	"testing"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+func TestSnapshotter(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	store := cms.GetKVStore(storeKey)
+	value := bytes.Repeat([]byte{1}, 1024)
+	for i := uint64(0); i < 200; i++ {
+		store.Set(sdk.Uint64ToBigEndian(i), value)
+	}
+	height := uint64(cms.Commit().Version)
+
+	snapshotter := NewSnapshotter(cms, storeKey)
+	var buf bytes.Buffer
+	require.NoError(t, snapshotter.Snapshot(height, protoio.NewDelimitedWriter(&buf)))
+
+	// the entries are split in chunks
+	payloads := 0
+	reader := protoio.NewDelimitedReader(bytes.NewReader(buf.Bytes()), 1<<20)
+	for {
+		var item snapshottypes.SnapshotItem
+		if err := reader.ReadMsg(&item); err != nil {
+			break
+		}
+		payloads++
+	}
+	require.Equal(t, 4, payloads)
+
+	restore := func() error {
+		_, err := snapshotter.Restore(height, SnapshotFormat, protoio.NewDelimitedReader(bytes.NewReader(buf.Bytes()), 1<<20))
+		return err
+	}
+	require.NoError(t, restore())
+
+	_, err := snapshotter.Restore(height, SnapshotFormat+1, protoio.NewDelimitedReader(bytes.NewReader(buf.Bytes()), 1<<20))
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+
+	// a restored store with other state fails the restore
+	store.Set(sdk.Uint64ToBigEndian(5), []byte{2})
+	cms.Commit()
+	require.ErrorIs(t, restore(), types.ErrInvalid)
+
+	store.Set(sdk.Uint64ToBigEndian(5), value)
+	store.Set(sdk.Uint64ToBigEndian(500), value)
+	cms.Commit()
+	require.ErrorIs(t, restore(), types.ErrInvalid)
+}
@@ -0,0 +1,135 @@
+package streaming
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// BlockMessage is published for every block, with the writes of the block to the gravity store
+// and the typed gravity events it emitted. Blocks are published at least once and in height
+// order, consumers skip the heights they already processed.
+type BlockMessage struct {
+	Height  int64         `json:"height"`
+	Time    time.Time     `json:"time"`
+	Changes []StoreChange `json:"changes"`
+	Events  []Event       `json:"events"`
+}
+
+// StoreChange is the final write of a block to a key of the gravity store. The value is the
+// protobuf encoding of the state under the key, the kind names the key prefix and, for the
+// state of an EVM chain, its chain id is given.
+type StoreChange struct {
+	Kind       string `json:"kind"`
+	EVMChainID uint64 `json:"evm_chain_id,omitempty"`
+	Key        []byte `json:"key"`
+	Value      []byte `json:"value,omitempty"`
+	Delete     bool   `json:"delete,omitempty"`
+}
+
+// Event is a typed gravity event, its attributes are the JSON encoded fields of the event
+type Event struct {
+	Type       string                     `json:"type"`
+	Attributes map[string]json.RawMessage `json:"attributes"`
+}
+
+// NewStoreChange returns the change of a write to the gravity store
+func NewStoreChange(key, value []byte, delete bool) StoreChange {
+	change := StoreChange{
+		Key:    append([]byte{}, key...),
+		Value:  append([]byte{}, value...),
+		Delete: delete,
+	}
+	prefix := key
+	if len(key) > 9 && key[0] == types.EVMChainStoreKey {
+		change.EVMChainID = sdk.BigEndianToUint64(key[1:9])
+		prefix = key[9:]
+	}
+	change.Kind = keyKinds[prefix[0]]
+	if change.Kind == "" {
+		change.Kind = "unknown"
+	}
+	return change
+}
+
+// typedEvents returns the typed gravity events among the events, the legacy events carry the
+// same transitions
+func typedEvents(events []abci.Event) []Event {
+	var typed []Event
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, "gravity.v1.") {
+			continue
+		}
+		attributes := make(map[string]json.RawMessage, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			value := json.RawMessage(attribute.Value)
+			if !json.Valid(value) {
+				value, _ = json.Marshal(string(attribute.Value))
+			}
+			attributes[string(attribute.Key)] = value
+		}
+		typed = append(typed, Event{Type: event.Type, Attributes: attributes})
+	}
+	return typed
+}
+
+// keyKinds names the prefixes of the gravity store keys, including those of the state of each
+// EVM chain
+var keyKinds = map[byte]string{
+	types.ValidatorEthereumAddressKey:     "validator_ethereum_address",
+	types.OrchestratorValidatorAddressKey: "orchestrator_validator_address",
+	types.EthereumOrchestratorAddressKey:  "ethereum_orchestrator_address",
+	types.EthereumSignatureKey:            "ethereum_signature",
+	types.EthereumEventVoteRecordKey:      "ethereum_event_vote_record",
+	types.OutgoingTxKey:                   "outgoing_tx",
+	types.SendToEthereumKey:               "send_to_ethereum",
+	types.LastEventNonceByValidatorKey:    "last_event_nonce_by_validator",
+	types.LastObservedEventNonceKey:       "last_observed_event_nonce",
+	types.LatestSignerSetTxNonceKey:       "latest_signer_set_tx_nonce",
+	types.LastSlashedOutgoingTxBlockKey:   "last_slashed_outgoing_tx_block",
+	types.LastSlashedSignerSetTxNonceKey:  "last_slashed_signer_set_tx_nonce",
+	types.LastOutgoingBatchNonceKey:       "last_outgoing_batch_nonce",
+	types.LastSendToEthereumIDKey:         "last_send_to_ethereum_id",
+	types.LastEthereumBlockHeightKey:      "last_ethereum_block_height",
+	types.DenomToERC20Key:                 "denom_to_erc20",
+	types.ERC20ToDenomKey:                 "erc20_to_denom",
+	types.LastUnBondingBlockHeightKey:     "last_unbonding_block_height",
+	types.LastObservedSignerSetKey:        "last_observed_signer_set",
+	types.EthereumHeightVoteKey:           "ethereum_height_vote",
+	types.EVMChainKey:                     "evm_chain",
+	types.EVMChainStoreKey:                "evm_chain_store",
+	types.DefaultEVMChainIDKey:            "default_evm_chain_id",
+	types.BridgeContractKey:               "bridge_contract",
+	types.ContractMigrationKey:            "contract_migration",
+	types.EVMChainPausedKey:               "evm_chain_paused",
+	types.GravityIDRotationKey:            "gravity_id_rotation",
+	types.DepositAddressKey:               "deposit_address",
+	types.RateLimitUsageKey:               "rate_limit_usage",
+	types.ERC1155TokenKey:                 "erc1155_token",
+	types.SendERC1155ToEthereumKey:        "send_erc1155_to_ethereum",
+	types.ForwardedDepositKey:             "forwarded_deposit",
+	types.ParamsKey:                       "params",
+	types.RelayerIncentiveKey:             "relayer_incentive",
+	types.LastRelayerIncentiveIDKey:       "last_relayer_incentive_id",
+	types.ContractVersionKey:              "contract_version",
+	types.IncidentRecordKey:               "incident_record",
+	types.LastIncidentRecordIDKey:         "last_incident_record_id",
+	types.ScheduledParamsUpdateKey:        "scheduled_params_update",
+	types.BridgeReportKey:                 "bridge_report",
+	types.CurrentBridgeReportKey:          "current_bridge_report",
+	types.BridgeStateHashKey:              "bridge_state_hash",
+	types.BlockSummaryKey:                 "block_summary",
+	types.AttestationLatencyKey:           "attestation_latency",
+	types.PoolAggregateKey:                "pool_aggregate",
+	types.ERC1155PoolAggregateKey:         "erc1155_pool_aggregate",
+	types.VoterSetKey:                     "voter_set",
+	types.EthereumSignaturesKey:           "ethereum_signatures",
+	types.VoucherIssuanceKey:              "voucher_issuance",
+	types.VoterPowerUpdateKey:             "voter_power_update",
+}
@@ -0,0 +1,65 @@
+package streaming
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const outboxSuffix = ".json"
+
+// outbox holds the block messages not yet published, one file per block named after its
+// height so that the files sort in publishing order. Messages are synced to disk when their
+// block is committed, so that they survive restarts of the node and outages of the bus.
+type outbox struct {
+	dir string
+}
+
+func newOutbox(dir string) (*outbox, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create outbox %s: %w", dir, err)
+	}
+	return &outbox{dir: dir}, nil
+}
+
+// put writes the message of the block at the height, replacing it if the block was executed
+// again after a restart
+func (o *outbox) put(height int64, msg []byte) error {
+	path := filepath.Join(o.dir, fmt.Sprintf("%020d%s", height, outboxSuffix))
+	tmp := path + ".tmp"
+
+	file, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(msg); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// pending returns the paths of the messages not yet published, oldest first
+func (o *outbox) pending() ([]string, error) {
+	entries, err := os.ReadDir(o.dir)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), outboxSuffix) {
+			paths = append(paths, filepath.Join(o.dir, entry.Name()))
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
@@ -0,0 +1,256 @@
+package streaming
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+// The message buses block messages can be published to
+const (
+	PublisherNATS  = "nats"
+	PublisherKafka = "kafka"
+)
+
+// publishTimeout bounds the time the bus has to accept a message
+const publishTimeout = 10 * time.Second
+
+// Publisher publishes block messages to a message bus, returning once the bus accepted them
+type Publisher interface {
+	Publish(height int64, msg []byte) error
+	Close() error
+}
+
+// NewPublisher returns the publisher of the kind to the bus at the endpoint, publishing to
+// the subject, which is the topic on Kafka
+func NewPublisher(kind, endpoint, subject string) (Publisher, error) {
+	switch kind {
+	case PublisherNATS:
+		return NewNATSPublisher(endpoint, subject), nil
+	case PublisherKafka:
+		return NewKafkaRESTPublisher(endpoint, subject), nil
+	default:
+		return nil, fmt.Errorf("unknown publisher %s", kind)
+	}
+}
+
+// NATSPublisher publishes to a NATS server over its client protocol. The server is pinged
+// after each message, so that a message is only acknowledged once the server processed it.
+type NATSPublisher struct {
+	url     string
+	subject string
+
+	conn       net.Conn
+	reader     *bufio.Reader
+	maxPayload int
+}
+
+// NewNATSPublisher returns a publisher to the subject of the NATS server at the endpoint, in
+// the nats://[user:password@]host:port form
+func NewNATSPublisher(endpoint, subject string) *NATSPublisher {
+	return &NATSPublisher{url: endpoint, subject: subject}
+}
+
+// Publish publishes the message, connecting to the server first if needed
+func (p *NATSPublisher) Publish(height int64, msg []byte) error {
+	if p.conn == nil {
+		if err := p.connect(); err != nil {
+			return fmt.Errorf("connect to %s: %w", p.url, err)
+		}
+	}
+	if p.maxPayload > 0 && len(msg) > p.maxPayload {
+		return fmt.Errorf("message of height %d is %d bytes, over the max payload of %d bytes of the server", height, len(msg), p.maxPayload)
+	}
+
+	if err := p.publish(msg); err != nil {
+		p.Close()
+		return err
+	}
+	return nil
+}
+
+func (p *NATSPublisher) publish(msg []byte) error {
+	if err := p.conn.SetDeadline(time.Now().Add(publishTimeout)); err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "PUB %s %d\r\n", p.subject, len(msg))
+	buf.Write(msg)
+	buf.WriteString("\r\nPING\r\n")
+	if _, err := p.conn.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return p.awaitPong()
+}
+
+func (p *NATSPublisher) connect() error {
+	u, err := url.Parse(p.url)
+	if err != nil {
+		return err
+	}
+	conn, err := net.DialTimeout("tcp", u.Host, publishTimeout)
+	if err != nil {
+		return err
+	}
+	p.conn = conn
+	p.reader = bufio.NewReader(conn)
+
+	if err := conn.SetDeadline(time.Now().Add(publishTimeout)); err != nil {
+		p.Close()
+		return err
+	}
+	line, err := p.readLine()
+	if err != nil {
+		p.Close()
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		p.Close()
+		return fmt.Errorf("unexpected greeting %q", line)
+	}
+	var info struct {
+		MaxPayload int `json:"max_payload"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "INFO ")), &info); err != nil {
+		p.Close()
+		return fmt.Errorf("invalid server info: %w", err)
+	}
+	p.maxPayload = info.MaxPayload
+
+	options := map[string]interface{}{"verbose": false, "pedantic": false, "name": types.ModuleName}
+	if u.User != nil {
+		options["user"] = u.User.Username()
+		if password, ok := u.User.Password(); ok {
+			options["pass"] = password
+		}
+	}
+	connect, err := json.Marshal(options)
+	if err != nil {
+		p.Close()
+		return err
+	}
+	if _, err := fmt.Fprintf(conn, "CONNECT %s\r\nPING\r\n", connect); err != nil {
+		p.Close()
+		return err
+	}
+	if err := p.awaitPong(); err != nil {
+		p.Close()
+		return err
+	}
+	return nil
+}
+
+// awaitPong reads until the server answers the last ping, failing on the errors it sends
+func (p *NATSPublisher) awaitPong() error {
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return err
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := io.WriteString(p.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (p *NATSPublisher) readLine() (string, error) {
+	line, err := p.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// Close closes the connection to the server, the next publish reconnects
+func (p *NATSPublisher) Close() error {
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Close()
+	p.conn = nil
+	p.reader = nil
+	return err
+}
+
+// KafkaRESTPublisher publishes to a Kafka topic through a Confluent compatible REST proxy. All
+// messages are produced with the same key, so that they land on one partition in order.
+type KafkaRESTPublisher struct {
+	url    string
+	topic  string
+	client *http.Client
+}
+
+// NewKafkaRESTPublisher returns a publisher to the topic through the REST proxy at the
+// endpoint
+func NewKafkaRESTPublisher(endpoint, topic string) *KafkaRESTPublisher {
+	return &KafkaRESTPublisher{
+		url:    strings.TrimSuffix(endpoint, "/"),
+		topic:  topic,
+		client: &http.Client{Timeout: publishTimeout},
+	}
+}
+
+// Publish produces the message, returning once the proxy reports its offset
+func (p *KafkaRESTPublisher) Publish(height int64, msg []byte) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"records": []map[string]interface{}{{
+			"key":   types.ModuleName,
+			"value": json.RawMessage(msg),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := p.client.Post(
+		fmt.Sprintf("%s/topics/%s", p.url, url.PathEscape(p.topic)),
+		"application/vnd.kafka.json.v2+json",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		text, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("produce message of height %d: %s: %s", height, res.Status, strings.TrimSpace(string(text)))
+	}
+
+	var produced struct {
+		Offsets []struct {
+			ErrorCode *int   `json:"error_code"`
+			Error     string `json:"error"`
+		} `json:"offsets"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&produced); err != nil {
+		return fmt.Errorf("produce message of height %d: invalid response: %w", height, err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("produce message of height %d: error %d: %s", height, *offset.ErrorCode, offset.Error)
+		}
+	}
+	return nil
+}
+
+// Close releases the idle connections to the proxy
+func (p *KafkaRESTPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,93 @@
+package streaming
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// serveNATS accepts a connection and answers it like a NATS server, sending the payloads
+// published to it on the channel
+func serveNATS(t *testing.T, listener net.Listener, payloads chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	fmt.Fprintf(conn, "INFO {\"max_payload\":1024}\r\n")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.HasPrefix(line, "PING"):
+			fmt.Fprintf(conn, "PONG\r\n")
+		case strings.HasPrefix(line, "PUB "):
+			var subject string
+			var size int
+			_, err := fmt.Sscanf(line, "PUB %s %d", &subject, &size)
+			require.NoError(t, err)
+			payload := make([]byte, size+2)
+			_, err = io.ReadFull(reader, payload)
+			require.NoError(t, err)
+			payloads <- subject + " " + string(payload[:size])
+		}
+	}
+}
+
+func TestNATSPublisher(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	payloads := make(chan string, 1)
+	go serveNATS(t, listener, payloads)
+
+	publisher := NewNATSPublisher("nats://"+listener.Addr().String(), DefaultSubject)
+	defer publisher.Close()
+	require.NoError(t, publisher.Publish(1, []byte(`{"height":1}`)))
+	require.Equal(t, DefaultSubject+` {"height":1}`, <-payloads)
+
+	// messages over the max payload of the server are refused
+	require.Error(t, publisher.Publish(2, []byte(strings.Repeat("a", 2048))))
+}
+
+func TestKafkaRESTPublisher(t *testing.T) {
+	var errorCode int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/topics/"+DefaultSubject, r.URL.Path)
+		require.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		var body struct {
+			Records []struct {
+				Key   string          `json:"key"`
+				Value json.RawMessage `json:"value"`
+			} `json:"records"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Len(t, body.Records, 1)
+		require.JSONEq(t, `{"height":1}`, string(body.Records[0].Value))
+
+		if errorCode != 0 {
+			fmt.Fprintf(w, `{"offsets":[{"partition":null,"offset":null,"error_code":%d,"error":"unavailable"}]}`, errorCode)
+			return
+		}
+		fmt.Fprint(w, `{"offsets":[{"partition":0,"offset":3,"error_code":null,"error":null}]}`)
+	}))
+	defer server.Close()
+
+	publisher := NewKafkaRESTPublisher(server.URL+"/", DefaultSubject)
+	require.NoError(t, publisher.Publish(1, []byte(`{"height":1}`)))
+
+	errorCode = 50003
+	require.Error(t, publisher.Publish(1, []byte(`{"height":1}`)))
+}
@@ -0,0 +1,249 @@
+// Package streaming streams the changes to the bridge state to a message bus, for the exchange
+// and custodian integrations that must not miss a deposit. It is enabled in the
+// streamers.gravity section of app.toml:
+//
+//	[streamers.gravity]
+//	# nats, or kafka through a REST proxy
+//	publisher = "nats"
+//	url = "nats://localhost:4222"
+//	# the subject, or topic on Kafka, block messages are published to
+//	subject = "gravity.bridge"
+//	# where the messages of committed blocks wait to be published, data/gravity-stream in
+//	# the node home by default
+//	outbox_dir = ""
+//
+// Every block is published as a BlockMessage with the writes of the block to the gravity store
+// and the typed gravity events it emitted. The message of a block is synced to the outbox when
+// the block is committed and only removed from it once the bus accepted it, so that blocks are
+// published at least once, in height order, across restarts and outages of the bus.
+package streaming
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spf13/cast"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The app.toml options of the streaming service
+const (
+	FlagPublisher = "streamers.gravity.publisher"
+	FlagURL       = "streamers.gravity.url"
+	FlagSubject   = "streamers.gravity.subject"
+	FlagOutboxDir = "streamers.gravity.outbox_dir"
+)
+
+// DefaultSubject is the subject block messages are published to when none is configured
+const DefaultSubject = "gravity.bridge"
+
+// retryInterval is the time waited before publishing again once the bus failed
+const retryInterval = 5 * time.Second
+
+var (
+	_ baseapp.StreamingService = &StreamingService{}
+	_ storetypes.WriteListener = &StreamingService{}
+)
+
+// StreamingService listens to the writes to the gravity store and the events of each block,
+// and publishes the block once committed
+type StreamingService struct {
+	storeKey  storetypes.StoreKey
+	publisher Publisher
+	outbox    *outbox
+	logger    log.Logger
+
+	mtx   sync.Mutex
+	block BlockMessage
+
+	notify chan struct{}
+	quit   chan struct{}
+	done   chan struct{}
+}
+
+// NewStreamingService returns the service publishing the blocks to the publisher, keeping
+// them in the outbox directory until they are
+func NewStreamingService(storeKey storetypes.StoreKey, publisher Publisher, outboxDir string, logger log.Logger) (*StreamingService, error) {
+	outbox, err := newOutbox(outboxDir)
+	if err != nil {
+		return nil, err
+	}
+	return &StreamingService{
+		storeKey:  storeKey,
+		publisher: publisher,
+		outbox:    outbox,
+		logger:    logger.With("module", "gravity-streaming"),
+		notify:    make(chan struct{}, 1),
+		quit:      make(chan struct{}),
+	}, nil
+}
+
+// NewStreamingServiceFromOptions returns the service configured in app.toml, nil if no
+// publisher is configured
+func NewStreamingServiceFromOptions(appOpts servertypes.AppOptions, homePath string, storeKey storetypes.StoreKey, logger log.Logger) (*StreamingService, error) {
+	kind := cast.ToString(appOpts.Get(FlagPublisher))
+	if kind == "" {
+		return nil, nil
+	}
+	subject := cast.ToString(appOpts.Get(FlagSubject))
+	if subject == "" {
+		subject = DefaultSubject
+	}
+	publisher, err := NewPublisher(kind, cast.ToString(appOpts.Get(FlagURL)), subject)
+	if err != nil {
+		return nil, err
+	}
+	outboxDir := cast.ToString(appOpts.Get(FlagOutboxDir))
+	if outboxDir == "" {
+		outboxDir = filepath.Join(homePath, "data", "gravity-stream")
+	}
+	return NewStreamingService(storeKey, publisher, outboxDir, logger)
+}
+
+// Listeners returns the service as the listener of the gravity store
+func (s *StreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{s.storeKey: {s}}
+}
+
+// OnWrite records a write to the gravity store. The writes of a block reach the listeners
+// when the block is committed.
+func (s *StreamingService) OnWrite(_ storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.block.Changes = append(s.block.Changes, NewStoreChange(key, value, delete))
+	return nil
+}
+
+// ListenBeginBlock starts the message of the block
+func (s *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.block.Height = req.Header.Height
+	s.block.Time = req.Header.Time
+	s.block.Events = append(s.block.Events, typedEvents(res.Events)...)
+	return nil
+}
+
+// ListenDeliverTx records the events of the tx, those of failed txs were reverted
+func (s *StreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !res.IsOK() {
+		return nil
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.block.Events = append(s.block.Events, typedEvents(res.Events)...)
+	return nil
+}
+
+// ListenEndBlock records the events of the end blockers
+func (s *StreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.block.Events = append(s.block.Events, typedEvents(res.Events)...)
+	return nil
+}
+
+// Commit puts the message of the committed block in the outbox for publishing, it is called
+// once the block is committed and its writes were recorded
+func (s *StreamingService) Commit() error {
+	s.mtx.Lock()
+	block := s.block
+	s.block = BlockMessage{}
+	s.mtx.Unlock()
+
+	msg, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+	if err := s.outbox.put(block.Height, msg); err != nil {
+		return fmt.Errorf("put block %d in the outbox: %w", block.Height, err)
+	}
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Stream publishes the messages in the outbox as they are put in it, starting with those left
+// from before a restart
+func (s *StreamingService) Stream(wg *sync.WaitGroup) error {
+	s.done = make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(s.done)
+		defer s.publisher.Close()
+
+		for {
+			wait := s.notify
+			var retry <-chan time.Time
+			if !s.publishPending() {
+				wait = nil
+				retry = time.After(retryInterval)
+			}
+
+			select {
+			case <-s.quit:
+				return
+			case <-wait:
+			case <-retry:
+			}
+		}
+	}()
+	return nil
+}
+
+// publishPending publishes the messages in the outbox in height order, removing each once
+// published. It returns false if one failed to be published, which is then retried.
+func (s *StreamingService) publishPending() bool {
+	paths, err := s.outbox.pending()
+	if err != nil {
+		s.logger.Error("failed to list the outbox", "error", err)
+		return false
+	}
+	for _, path := range paths {
+		msg, err := os.ReadFile(path)
+		if err != nil {
+			s.logger.Error("failed to read block message", "path", path, "error", err)
+			return false
+		}
+		var block struct {
+			Height int64 `json:"height"`
+		}
+		if err := json.Unmarshal(msg, &block); err != nil {
+			s.logger.Error("failed to decode block message", "path", path, "error", err)
+			return false
+		}
+		if err := s.publisher.Publish(block.Height, msg); err != nil {
+			s.logger.Error("failed to publish block message, retrying", "height", block.Height, "error", err)
+			return false
+		}
+		if err := os.Remove(path); err != nil {
+			s.logger.Error("failed to remove published block message", "path", path, "error", err)
+			return false
+		}
+	}
+	return true
+}
+
+// Close stops publishing, the messages left in the outbox are published once restarted
+func (s *StreamingService) Close() error {
+	close(s.quit)
+	if s.done == nil {
+		return s.publisher.Close()
+	}
+	<-s.done
+	return nil
+}
@@ -0,0 +1,134 @@
+package streaming
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
+)
+
+type testPublisher struct {
+	mtx      sync.Mutex
+	failures int
+	messages [][]byte
+}
+
+func (p *testPublisher) Publish(_ int64, msg []byte) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("bus unavailable")
+	}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func (p *testPublisher) Close() error { return nil }
+
+func (p *testPublisher) published() [][]byte {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return append([][]byte{}, p.messages...)
+}
+
+func TestStreamingService(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	publisher := &testPublisher{failures: 1}
+	service, err := NewStreamingService(storeKey, publisher, t.TempDir(), log.NewNopLogger())
+	require.NoError(t, err)
+	cms.AddListeners(storeKey, service.Listeners()[storeKey])
+
+	blockTime := time.Unix(1600000000, 0).UTC()
+	typedEvent := abci.Event{
+		Type:       "gravity.v1.EventSendToEthereum",
+		Attributes: []abci.EventAttribute{{Key: []byte("id"), Value: []byte(`"1"`)}},
+	}
+	legacyEvent := abci.Event{Type: types.EventTypeBridgeWithdrawalReceived}
+
+	ctx := sdk.Context{}
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 5, Time: blockTime}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{Events: []abci.Event{typedEvent, legacyEvent}}))
+	// the events of failed txs are reverted
+	require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{Code: 1, Events: []abci.Event{typedEvent}}))
+	require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 5}, abci.ResponseEndBlock{}))
+
+	// the writes of the block reach the service as it is committed
+	cache := cms.CacheMultiStore()
+	sendKey := append(types.MakeEVMChainStorePrefix(1), types.SendToEthereumKey, 7)
+	cache.GetKVStore(storeKey).Set(sendKey, []byte{1})
+	cache.GetKVStore(storeKey).Set([]byte{types.ParamsKey}, []byte{2})
+	cache.Write()
+	require.NoError(t, service.Commit())
+
+	// a failed publish leaves the block in the outbox
+	require.False(t, service.publishPending())
+	pending, err := service.outbox.pending()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	require.True(t, service.publishPending())
+	pending, err = service.outbox.pending()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	messages := publisher.published()
+	require.Len(t, messages, 1)
+	var block BlockMessage
+	require.NoError(t, json.Unmarshal(messages[0], &block))
+	require.Equal(t, int64(5), block.Height)
+	require.Equal(t, blockTime, block.Time)
+	require.Equal(t, []StoreChange{
+		{Kind: "send_to_ethereum", EVMChainID: 1, Key: sendKey, Value: []byte{1}},
+		{Kind: "params", Key: []byte{types.ParamsKey}, Value: []byte{2}},
+	}, block.Changes)
+	require.Len(t, block.Events, 1)
+	require.Equal(t, typedEvent.Type, block.Events[0].Type)
+	require.Equal(t, json.RawMessage(`"1"`), block.Events[0].Attributes["id"])
+}
+
+func TestStreamingServiceStream(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	dir := t.TempDir()
+	publisher := &testPublisher{}
+
+	// blocks left in the outbox before a restart are published first
+	service, err := NewStreamingService(storeKey, publisher, dir, log.NewNopLogger())
+	require.NoError(t, err)
+	require.NoError(t, service.ListenBeginBlock(sdk.Context{}, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1, Time: time.Now()}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, service.Commit())
+
+	service, err = NewStreamingService(storeKey, publisher, dir, log.NewNopLogger())
+	require.NoError(t, err)
+	require.NoError(t, service.Stream(new(sync.WaitGroup)))
+	require.Eventually(t, func() bool { return len(publisher.published()) == 1 }, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, service.ListenBeginBlock(sdk.Context{}, abci.RequestBeginBlock{Header: tmproto.Header{Height: 2, Time: time.Now()}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, service.Commit())
+	require.Eventually(t, func() bool { return len(publisher.published()) == 2 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, service.Close())
+
+	var heights []int64
+	for _, msg := range publisher.published() {
+		var block BlockMessage
+		require.NoError(t, json.Unmarshal(msg, &block))
+		heights = append(heights, block.Height)
+	}
+	require.Equal(t, []int64{1, 2}, heights)
+}
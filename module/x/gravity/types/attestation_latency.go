@@ -0,0 +1,44 @@
+package types
+
+import (
+	"sort"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxAttestationLatencySamples is the number of latencies of observed events kept for each
+// chain, older ones are dropped
+const MaxAttestationLatencySamples = 1000
+
+// Record adds the latency of an observed event, dropping the oldest one past
+// MaxAttestationLatencySamples, and updates the percentiles
+func (l *AttestationLatency) Record(blocks uint64) {
+	l.Samples = append(l.Samples, blocks)
+	if len(l.Samples) > MaxAttestationLatencySamples {
+		l.Samples = l.Samples[len(l.Samples)-MaxAttestationLatencySamples:]
+	}
+
+	sorted := append([]uint64{}, l.Samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	l.P50 = percentile(sorted, 50)
+	l.P90 = percentile(sorted, 90)
+	l.P99 = percentile(sorted, 99)
+	l.Max = sorted[len(sorted)-1]
+}
+
+// percentile returns the nearest rank percentile of the sorted samples
+func percentile(sorted []uint64, p int) uint64 {
+	rank := (p*len(sorted) + 99) / 100
+	if rank == 0 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// ValidateBasic performs stateless checks on the attestation latency of a chain
+func (l AttestationLatency) ValidateBasic() error {
+	if len(l.Samples) > MaxAttestationLatencySamples {
+		return sdkerrors.Wrapf(ErrInvalid, "%d attestation latency samples, over the maximum of %d", len(l.Samples), MaxAttestationLatencySamples)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic checks that the admin is either unset or a valid address holding known,
+// distinct permissions
+func (a BridgeAdmin) ValidateBasic() error {
+	if a.Address == "" {
+		if len(a.Permissions) > 0 {
+			return sdkerrors.Wrap(ErrInvalid, "permissions without a bridge admin")
+		}
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, a.Address)
+	}
+	seen := make(map[BridgeAdminPermission]bool, len(a.Permissions))
+	for _, permission := range a.Permissions {
+		if _, known := BridgeAdminPermission_name[int32(permission)]; !known || permission == BridgeAdminPermissionUnspecified {
+			return sdkerrors.Wrapf(ErrInvalid, "bridge admin permission %d", permission)
+		}
+		if seen[permission] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate bridge admin permission %s", permission)
+		}
+		seen[permission] = true
+	}
+	return nil
+}
+
+// IsPermitted returns true if the address is the admin and holds the permission
+func (a BridgeAdmin) IsPermitted(address string, permission BridgeAdminPermission) bool {
+	if a.Address == "" || a.Address != address {
+		return false
+	}
+	for _, p := range a.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxBridgeReports is the number of closed bridge reports kept in state, older ones are pruned
+const MaxBridgeReports = 90
+
+// ValidateBasic performs stateless checks on a closed bridge report
+func (r BridgeReport) ValidateBasic() error {
+	if r.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "bridge report id cannot be zero")
+	}
+	if r.StartHeight == 0 || r.EndHeight < r.StartHeight {
+		return sdkerrors.Wrapf(ErrInvalid, "bridge report heights %d to %d", r.StartHeight, r.EndHeight)
+	}
+	if !r.FeesCollected.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalid, "bridge report fees collected %s", r.FeesCollected)
+	}
+	return nil
+}
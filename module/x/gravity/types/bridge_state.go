@@ -0,0 +1,16 @@
+package types
+
+import (
+	"crypto/sha256"
+)
+
+// Hash returns the sha256 of the protobuf encoding of the bridge state, what the bridge state
+// hash commits to
+func (s BridgeState) Hash() []byte {
+	bz, err := s.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
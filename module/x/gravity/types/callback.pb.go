@@ -0,0 +1,472 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gravity/v1/callback.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DepositCompletionPacketData is the packet the outcome of a deposit forwarded
+// over IBC is sent to the callback contract of the forward channel with, once
+// the deposit's transfer is acknowledged or times out
+type DepositCompletionPacketData struct {
+	Contract string           `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Deposit  ForwardedDeposit `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit"`
+	Success  bool             `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// why the transfer failed, if it did
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *DepositCompletionPacketData) Reset()         { *m = DepositCompletionPacketData{} }
+func (m *DepositCompletionPacketData) String() string { return proto.CompactTextString(m) }
+func (*DepositCompletionPacketData) ProtoMessage()    {}
+func (*DepositCompletionPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e367803e65792e8b, []int{0}
+}
+func (m *DepositCompletionPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositCompletionPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositCompletionPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositCompletionPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositCompletionPacketData.Merge(m, src)
+}
+func (m *DepositCompletionPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositCompletionPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositCompletionPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositCompletionPacketData proto.InternalMessageInfo
+
+func (m *DepositCompletionPacketData) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *DepositCompletionPacketData) GetDeposit() ForwardedDeposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return ForwardedDeposit{}
+}
+
+func (m *DepositCompletionPacketData) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *DepositCompletionPacketData) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DepositCompletionPacketData)(nil), "gravity.v1.DepositCompletionPacketData")
+}
+
+func init() { proto.RegisterFile("gravity/v1/callback.proto", fileDescriptor_e367803e65792e8b) }
+
+var fileDescriptor_e367803e65792e8b = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x3f, 0x4e, 0xc3, 0x30,
+	0x18, 0xc5, 0x63, 0x28, 0xb4, 0x98, 0x2d, 0xea, 0x60, 0x02, 0x32, 0x11, 0x53, 0x16, 0x62, 0x95,
+	0x8e, 0x30, 0x95, 0x8a, 0x19, 0x45, 0x62, 0x61, 0x73, 0x1c, 0xcb, 0x84, 0x26, 0xfd, 0x22, 0xc7,
+	0x09, 0xe4, 0x16, 0xdc, 0x82, 0xab, 0x74, 0xec, 0xc8, 0x84, 0x50, 0x72, 0x11, 0x44, 0x52, 0x03,
+	0x9b, 0x9f, 0xdf, 0xfb, 0x7d, 0xff, 0xf0, 0x89, 0xd2, 0xbc, 0x4e, 0x4d, 0xc3, 0xea, 0x19, 0x13,
+	0x3c, 0xcb, 0x62, 0x2e, 0x56, 0x61, 0xa1, 0xc1, 0x80, 0x8b, 0x77, 0x56, 0x58, 0xcf, 0xbc, 0xa9,
+	0x02, 0x05, 0xfd, 0x37, 0xfb, 0x79, 0x0d, 0x09, 0x8f, 0xfc, 0x83, 0x6d, 0xb8, 0x77, 0x2e, 0xde,
+	0x11, 0x3e, 0x5d, 0xca, 0x02, 0xca, 0xd4, 0xdc, 0x42, 0x5e, 0x64, 0xd2, 0xa4, 0xb0, 0xbe, 0xe7,
+	0x62, 0x25, 0xcd, 0x92, 0x1b, 0xee, 0x7a, 0x78, 0x22, 0x60, 0x6d, 0x34, 0x17, 0x86, 0x20, 0x1f,
+	0x05, 0x47, 0xd1, 0xaf, 0x76, 0x6f, 0xf0, 0x38, 0x19, 0x50, 0xb2, 0xe7, 0xa3, 0xe0, 0xf8, 0xea,
+	0x2c, 0xfc, 0x9b, 0x24, 0xbc, 0x03, 0xfd, 0xc2, 0x75, 0x22, 0x93, 0x5d, 0xf9, 0xc5, 0x68, 0xf3,
+	0x79, 0xee, 0x44, 0x16, 0x71, 0x09, 0x1e, 0x97, 0x95, 0x10, 0xb2, 0x2c, 0xc9, 0xbe, 0x8f, 0x82,
+	0x49, 0x64, 0xa5, 0x3b, 0xc5, 0x07, 0x52, 0x6b, 0xd0, 0x64, 0xd4, 0x37, 0x1c, 0xc4, 0xe2, 0x61,
+	0xd3, 0x52, 0xb4, 0x6d, 0x29, 0xfa, 0x6a, 0x29, 0x7a, 0xeb, 0xa8, 0xb3, 0xed, 0xa8, 0xf3, 0xd1,
+	0x51, 0xe7, 0xf1, 0x5a, 0xa5, 0xe6, 0xa9, 0x8a, 0x43, 0x01, 0x39, 0x2b, 0xa4, 0x52, 0xcd, 0x73,
+	0x6d, 0xb7, 0xbc, 0x8c, 0x75, 0x9a, 0x28, 0xc9, 0x72, 0x48, 0xaa, 0x4c, 0xb2, 0x7a, 0xce, 0x5e,
+	0xad, 0xc5, 0x4c, 0x53, 0xc8, 0x32, 0x3e, 0xec, 0xef, 0x30, 0xff, 0x1e, 0x00, 0xb4, 0x2d, 0x24,
+	0x52, 0x60, 0x01, 0x00, 0x00,
+}
+
+func (m *DepositCompletionPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositCompletionPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositCompletionPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCallback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCallback(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCallback(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCallback(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DepositCompletionPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovCallback(uint64(l))
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCallback(uint64(l))
+	}
+	return n
+}
+
+func sovCallback(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCallback(x uint64) (n int) {
+	return sovCallback(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DepositCompletionPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositCompletionPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositCompletionPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCallback(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCallback
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCallback
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCallback
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCallback
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCallback        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCallback          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCallback = fmt.Errorf("proto: unexpected end of group")
+)
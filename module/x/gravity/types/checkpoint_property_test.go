@@ -0,0 +1,605 @@
+package types
+
+import (
+	"encoding/json"
+	"flag"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// The checkpoints signed by the orchestrators must be bit for bit the hashes Gravity.sol
+// computes. The tests below check GetCheckpoint against a second encoding, written in Go
+// from the argument lists of the contract, which only catches the module drifting from
+// that reference. Agreement with the contract rests on the vectors they write, which the
+// hardhat test solidity/test/checkpointVectors.ts replays in the Solidity CI job on
+// CheckpointHashingTest.sol: its checkpoints copy the abi.encode argument lists of
+// Gravity.sol, its typed data digests are those of the TypedData library.
+
+var updateCheckpointVectors = flag.Bool("update-checkpoint-vectors", false, "rewrite testdata/checkpoint_vectors.json")
+
+const (
+	checkpointVectorsFile = "testdata/checkpoint_vectors.json"
+	checkpointVectorsSeed = 1
+	checkpointVectorsN    = 8
+	// the chain id of the hardhat network the vectors are replayed on
+	checkpointVectorsChainID = 31337
+)
+
+////////////////////////////
+// reference ABI encoding //
+////////////////////////////
+
+// abiValue is a single argument of abi.encode, either a static word or the encoding of
+// a dynamic value placed in the tail
+type abiValue struct {
+	dynamic bool
+	data    []byte
+}
+
+func abiUint(v *big.Int) abiValue {
+	return abiValue{data: gethcommon.LeftPadBytes(v.Bytes(), 32)}
+}
+
+func abiAddress(a gethcommon.Address) abiValue {
+	return abiValue{data: gethcommon.LeftPadBytes(a.Bytes(), 32)}
+}
+
+func abiBytes32(b [32]byte) abiValue {
+	return abiValue{data: b[:]}
+}
+
+func abiBytes(b []byte) abiValue {
+	padded := make([]byte, (len(b)+31)/32*32)
+	copy(padded, b)
+	return abiValue{dynamic: true, data: append(abiUint(big.NewInt(int64(len(b)))).data, padded...)}
+}
+
+func abiUintArray(vs []*big.Int) abiValue {
+	data := abiUint(big.NewInt(int64(len(vs)))).data
+	for _, v := range vs {
+		data = append(data, abiUint(v).data...)
+	}
+	return abiValue{dynamic: true, data: data}
+}
+
+func abiAddressArray(as []gethcommon.Address) abiValue {
+	data := abiUint(big.NewInt(int64(len(as)))).data
+	for _, a := range as {
+		data = append(data, abiAddress(a).data...)
+	}
+	return abiValue{dynamic: true, data: data}
+}
+
+// abiEncode mirrors solidity's abi.encode, the heads of the values followed by the
+// tails of the dynamic ones, each head of a dynamic value being the offset of its tail
+func abiEncode(values ...abiValue) []byte {
+	var head, tail []byte
+	for _, v := range values {
+		if !v.dynamic {
+			head = append(head, v.data...)
+			continue
+		}
+		head = append(head, abiUint(big.NewInt(int64(32*len(values)+len(tail)))).data...)
+		tail = append(tail, v.data...)
+	}
+	return append(head, tail...)
+}
+
+func methodName(name string) (out [32]byte) {
+	copy(out[:], name)
+	return out
+}
+
+/////////////////////////////////////
+// reference EIP-712 typed hashing //
+/////////////////////////////////////
+
+// typedArray is the EIP-712 encoding of an array of static values, the hash of its
+// elements as solidity's keccak256(abi.encodePacked(array))
+func typedArray(v abiValue) abiValue {
+	return abiBytes32(crypto.Keccak256Hash(v.data[32:]))
+}
+
+func typedBytes(b []byte) abiValue {
+	return abiBytes32(crypto.Keccak256Hash(b))
+}
+
+func typeHash(encodedType string) abiValue {
+	return abiBytes32(crypto.Keccak256Hash([]byte(encodedType)))
+}
+
+// typedDigest mirrors the TypedData library of Gravity.sol, the digest of a struct hash
+// under the domain of the gravity id and chain
+func typedDigest(gravityID [32]byte, chainID uint64, structHash []byte) []byte {
+	domainSeparator := crypto.Keccak256(abiEncode(
+		typeHash("EIP712Domain(string name,string version,uint256 chainId,bytes32 salt)"),
+		typedBytes([]byte("Gravity Bridge")),
+		typedBytes([]byte("1")),
+		abiUint(uint64Big(chainID)),
+		abiBytes32(gravityID),
+	))
+	return crypto.Keccak256([]byte("\x19\x01"), domainSeparator, structHash)
+}
+
+////////////////////////////////
+// contract arguments vectors //
+////////////////////////////////
+
+type checkpointVectors struct {
+	GravityID      hexutil.Bytes        `json:"gravity_id"`
+	ChainID        uint64               `json:"chain_id"`
+	Valsets        []valsetVector       `json:"valsets"`
+	Batches        []batchVector        `json:"batches"`
+	ERC1155Batches []erc1155BatchVector `json:"erc1155_batches"`
+	LogicCalls     []logicCallVector    `json:"logic_calls"`
+}
+
+type valsetVector struct {
+	Validators      []gethcommon.Address `json:"validators"`
+	Powers          []*hexutil.Big       `json:"powers"`
+	ValsetNonce     *hexutil.Big         `json:"valset_nonce"`
+	RewardAmount    *hexutil.Big         `json:"reward_amount"`
+	RewardToken     gethcommon.Address   `json:"reward_token"`
+	Checkpoint      hexutil.Bytes        `json:"checkpoint"`
+	TypedDataDigest hexutil.Bytes        `json:"typed_data_digest"`
+}
+
+type batchVector struct {
+	Amounts         []*hexutil.Big       `json:"amounts"`
+	Destinations    []gethcommon.Address `json:"destinations"`
+	Fees            []*hexutil.Big       `json:"fees"`
+	BatchNonce      *hexutil.Big         `json:"batch_nonce"`
+	TokenContract   gethcommon.Address   `json:"token_contract"`
+	BatchTimeout    *hexutil.Big         `json:"batch_timeout"`
+	Checkpoint      hexutil.Bytes        `json:"checkpoint"`
+	TypedDataDigest hexutil.Bytes        `json:"typed_data_digest"`
+}
+
+type erc1155BatchVector struct {
+	Destinations    []gethcommon.Address `json:"destinations"`
+	IDs             []*hexutil.Big       `json:"ids"`
+	Amounts         []*hexutil.Big       `json:"amounts"`
+	BatchNonce      *hexutil.Big         `json:"batch_nonce"`
+	TokenContract   gethcommon.Address   `json:"token_contract"`
+	BatchTimeout    *hexutil.Big         `json:"batch_timeout"`
+	Checkpoint      hexutil.Bytes        `json:"checkpoint"`
+	TypedDataDigest hexutil.Bytes        `json:"typed_data_digest"`
+}
+
+type logicCallVector struct {
+	TransferAmounts        []*hexutil.Big       `json:"transfer_amounts"`
+	TransferTokenContracts []gethcommon.Address `json:"transfer_token_contracts"`
+	FeeAmounts             []*hexutil.Big       `json:"fee_amounts"`
+	FeeTokenContracts      []gethcommon.Address `json:"fee_token_contracts"`
+	LogicContractAddress   gethcommon.Address   `json:"logic_contract_address"`
+	Payload                hexutil.Bytes        `json:"payload"`
+	TimeOut                *hexutil.Big         `json:"time_out"`
+	InvalidationID         hexutil.Bytes        `json:"invalidation_id"`
+	InvalidationNonce      *hexutil.Big         `json:"invalidation_nonce"`
+	Checkpoint             hexutil.Bytes        `json:"checkpoint"`
+	TypedDataDigest        hexutil.Bytes        `json:"typed_data_digest"`
+}
+
+func hexBig(v *big.Int) *hexutil.Big {
+	return (*hexutil.Big)(v)
+}
+
+func bigs(vs []*hexutil.Big) []*big.Int {
+	out := make([]*big.Int, len(vs))
+	for i, v := range vs {
+		out[i] = v.ToInt()
+	}
+	return out
+}
+
+func uint64Big(v uint64) *big.Int {
+	return new(big.Int).SetUint64(v)
+}
+
+// newValsetVector returns the arguments the contract is given for the signer set, its
+// signers ordered by decreasing power as the relayers submit them
+func newValsetVector(u SignerSetTx, gravityID []byte, chainID uint64) valsetVector {
+	signers := make(EthereumSigners, len(u.Signers))
+	copy(signers, u.Signers)
+	signers.Sort()
+	v := valsetVector{
+		ValsetNonce:     hexBig(uint64Big(u.Nonce)),
+		RewardAmount:    hexBig(big.NewInt(0)),
+		Validators:      []gethcommon.Address{},
+		Powers:          []*hexutil.Big{},
+		Checkpoint:      u.GetCheckpoint(gravityID),
+		TypedDataDigest: TypedDataDigest(u.GetTypedData(gravityID, chainID)),
+	}
+	for _, s := range signers {
+		v.Validators = append(v.Validators, gethcommon.HexToAddress(s.EthereumAddress))
+		v.Powers = append(v.Powers, hexBig(uint64Big(s.Power)))
+	}
+	return v
+}
+
+func (v valsetVector) reference(gravityID [32]byte) []byte {
+	return crypto.Keccak256(abiEncode(
+		abiBytes32(gravityID),
+		abiBytes32(methodName("checkpoint")),
+		abiUint(v.ValsetNonce.ToInt()),
+		abiAddressArray(v.Validators),
+		abiUintArray(bigs(v.Powers)),
+		abiUint(v.RewardAmount.ToInt()),
+		abiAddress(v.RewardToken),
+	))
+}
+
+func (v valsetVector) typedReference(gravityID [32]byte, chainID uint64) []byte {
+	return typedDigest(gravityID, chainID, crypto.Keccak256(abiEncode(
+		typeHash("Valset(uint256 valsetNonce,address[] validators,uint256[] powers,uint256 rewardAmount,address rewardToken)"),
+		abiUint(v.ValsetNonce.ToInt()),
+		typedArray(abiAddressArray(v.Validators)),
+		typedArray(abiUintArray(bigs(v.Powers))),
+		abiUint(v.RewardAmount.ToInt()),
+		abiAddress(v.RewardToken),
+	)))
+}
+
+func newBatchVector(b BatchTx, gravityID []byte, chainID uint64) batchVector {
+	v := batchVector{
+		Amounts:         []*hexutil.Big{},
+		Destinations:    []gethcommon.Address{},
+		Fees:            []*hexutil.Big{},
+		BatchNonce:      hexBig(uint64Big(b.BatchNonce)),
+		TokenContract:   gethcommon.HexToAddress(b.TokenContract),
+		BatchTimeout:    hexBig(uint64Big(b.Timeout)),
+		Checkpoint:      b.GetCheckpoint(gravityID),
+		TypedDataDigest: TypedDataDigest(b.GetTypedData(gravityID, chainID)),
+	}
+	for _, tx := range b.Transactions {
+		v.Amounts = append(v.Amounts, hexBig(tx.Erc20Token.Amount.BigInt()))
+		v.Destinations = append(v.Destinations, gethcommon.HexToAddress(tx.EthereumRecipient))
+		v.Fees = append(v.Fees, hexBig(tx.Erc20Fee.Amount.BigInt()))
+	}
+	return v
+}
+
+func (v batchVector) reference(gravityID [32]byte) []byte {
+	return crypto.Keccak256(abiEncode(
+		abiBytes32(gravityID),
+		abiBytes32(methodName("transactionBatch")),
+		abiUintArray(bigs(v.Amounts)),
+		abiAddressArray(v.Destinations),
+		abiUintArray(bigs(v.Fees)),
+		abiUint(v.BatchNonce.ToInt()),
+		abiAddress(v.TokenContract),
+		abiUint(v.BatchTimeout.ToInt()),
+	))
+}
+
+func (v batchVector) typedReference(gravityID [32]byte, chainID uint64) []byte {
+	return typedDigest(gravityID, chainID, crypto.Keccak256(abiEncode(
+		typeHash("TransactionBatch(uint256[] amounts,address[] destinations,uint256[] fees,uint256 batchNonce,address tokenContract,uint256 batchTimeout)"),
+		typedArray(abiUintArray(bigs(v.Amounts))),
+		typedArray(abiAddressArray(v.Destinations)),
+		typedArray(abiUintArray(bigs(v.Fees))),
+		abiUint(v.BatchNonce.ToInt()),
+		abiAddress(v.TokenContract),
+		abiUint(v.BatchTimeout.ToInt()),
+	)))
+}
+
+// newERC1155BatchVector returns the arguments the contract is given for the batch, one
+// entry per id moved to a recipient
+func newERC1155BatchVector(b ERC1155BatchTx, gravityID []byte, chainID uint64) erc1155BatchVector {
+	v := erc1155BatchVector{
+		Destinations:    []gethcommon.Address{},
+		IDs:             []*hexutil.Big{},
+		Amounts:         []*hexutil.Big{},
+		BatchNonce:      hexBig(uint64Big(b.BatchNonce)),
+		TokenContract:   gethcommon.HexToAddress(b.TokenContract),
+		BatchTimeout:    hexBig(uint64Big(b.Timeout)),
+		Checkpoint:      b.GetCheckpoint(gravityID),
+		TypedDataDigest: TypedDataDigest(b.GetTypedData(gravityID, chainID)),
+	}
+	for _, tx := range b.Transactions {
+		for _, amount := range tx.Amounts {
+			v.Destinations = append(v.Destinations, gethcommon.HexToAddress(tx.EthereumRecipient))
+			v.IDs = append(v.IDs, hexBig(amount.Id.BigInt()))
+			v.Amounts = append(v.Amounts, hexBig(amount.Amount.BigInt()))
+		}
+	}
+	return v
+}
+
+func (v erc1155BatchVector) reference(gravityID [32]byte) []byte {
+	return crypto.Keccak256(abiEncode(
+		abiBytes32(gravityID),
+		abiBytes32(methodName("erc1155Batch")),
+		abiAddressArray(v.Destinations),
+		abiUintArray(bigs(v.IDs)),
+		abiUintArray(bigs(v.Amounts)),
+		abiUint(v.BatchNonce.ToInt()),
+		abiAddress(v.TokenContract),
+		abiUint(v.BatchTimeout.ToInt()),
+	))
+}
+
+func (v erc1155BatchVector) typedReference(gravityID [32]byte, chainID uint64) []byte {
+	return typedDigest(gravityID, chainID, crypto.Keccak256(abiEncode(
+		typeHash("ERC1155Batch(address[] destinations,uint256[] ids,uint256[] amounts,uint256 batchNonce,address tokenContract,uint256 batchTimeout)"),
+		typedArray(abiAddressArray(v.Destinations)),
+		typedArray(abiUintArray(bigs(v.IDs))),
+		typedArray(abiUintArray(bigs(v.Amounts))),
+		abiUint(v.BatchNonce.ToInt()),
+		abiAddress(v.TokenContract),
+		abiUint(v.BatchTimeout.ToInt()),
+	)))
+}
+
+func newLogicCallVector(c ContractCallTx, gravityID []byte, chainID uint64) logicCallVector {
+	var invalidationID [32]byte
+	copy(invalidationID[:], c.InvalidationScope)
+	v := logicCallVector{
+		TransferAmounts:        []*hexutil.Big{},
+		TransferTokenContracts: []gethcommon.Address{},
+		FeeAmounts:             []*hexutil.Big{},
+		FeeTokenContracts:      []gethcommon.Address{},
+		LogicContractAddress:   gethcommon.HexToAddress(c.Address),
+		Payload:                append([]byte{}, c.Payload...),
+		TimeOut:                hexBig(uint64Big(c.Timeout)),
+		InvalidationID:         invalidationID[:],
+		InvalidationNonce:      hexBig(uint64Big(c.InvalidationNonce)),
+		Checkpoint:             c.GetCheckpoint(gravityID),
+		TypedDataDigest:        TypedDataDigest(c.GetTypedData(gravityID, chainID)),
+	}
+	for _, coin := range c.Tokens {
+		v.TransferAmounts = append(v.TransferAmounts, hexBig(coin.Amount.BigInt()))
+		v.TransferTokenContracts = append(v.TransferTokenContracts, gethcommon.HexToAddress(coin.Contract))
+	}
+	for _, coin := range c.Fees {
+		v.FeeAmounts = append(v.FeeAmounts, hexBig(coin.Amount.BigInt()))
+		v.FeeTokenContracts = append(v.FeeTokenContracts, gethcommon.HexToAddress(coin.Contract))
+	}
+	return v
+}
+
+func (v logicCallVector) reference(gravityID [32]byte) []byte {
+	var invalidationID [32]byte
+	copy(invalidationID[:], v.InvalidationID)
+	return crypto.Keccak256(abiEncode(
+		abiBytes32(gravityID),
+		abiBytes32(methodName("logicCall")),
+		abiUintArray(bigs(v.TransferAmounts)),
+		abiAddressArray(v.TransferTokenContracts),
+		abiUintArray(bigs(v.FeeAmounts)),
+		abiAddressArray(v.FeeTokenContracts),
+		abiAddress(v.LogicContractAddress),
+		abiBytes(v.Payload),
+		abiUint(v.TimeOut.ToInt()),
+		abiBytes32(invalidationID),
+		abiUint(v.InvalidationNonce.ToInt()),
+	))
+}
+
+func (v logicCallVector) typedReference(gravityID [32]byte, chainID uint64) []byte {
+	var invalidationID [32]byte
+	copy(invalidationID[:], v.InvalidationID)
+	return typedDigest(gravityID, chainID, crypto.Keccak256(abiEncode(
+		typeHash("LogicCall(uint256[] transferAmounts,address[] transferTokenContracts,uint256[] feeAmounts,address[] feeTokenContracts,address logicContractAddress,bytes payload,uint256 timeOut,bytes32 invalidationId,uint256 invalidationNonce)"),
+		typedArray(abiUintArray(bigs(v.TransferAmounts))),
+		typedArray(abiAddressArray(v.TransferTokenContracts)),
+		typedArray(abiUintArray(bigs(v.FeeAmounts))),
+		typedArray(abiAddressArray(v.FeeTokenContracts)),
+		abiAddress(v.LogicContractAddress),
+		typedBytes(v.Payload),
+		abiUint(v.TimeOut.ToInt()),
+		abiBytes32(invalidationID),
+		abiUint(v.InvalidationNonce.ToInt()),
+	)))
+}
+
+/////////////////////////
+// random transactions //
+/////////////////////////
+
+func randAddress(r *rand.Rand) string {
+	var a gethcommon.Address
+	r.Read(a[:])
+	return a.Hex()
+}
+
+// randAmount returns an amount of up to 255 bits, the widest sdk.Int allows, favouring
+// the boundaries of the words
+func randAmount(r *rand.Rand) sdk.Int {
+	switch r.Intn(4) {
+	case 0:
+		return sdk.ZeroInt()
+	case 1:
+		return sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1)))
+	default:
+		return sdk.NewIntFromBigInt(new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(r.Intn(255)+1))))
+	}
+}
+
+// randNonce returns a nonce or timeout, which GetCheckpoint converts through int64
+func randNonce(r *rand.Rand) uint64 {
+	return uint64(r.Int63())
+}
+
+func randGravityID(r *rand.Rand) []byte {
+	id := make([]byte, r.Intn(32)+1)
+	for i := range id {
+		id[i] = byte('a' + r.Intn(26))
+	}
+	return id
+}
+
+func randSignerSetTx(r *rand.Rand) SignerSetTx {
+	u := SignerSetTx{Nonce: randNonce(r), Height: r.Uint64()}
+	for i := r.Intn(12); i > 0; i-- {
+		u.Signers = append(u.Signers, &EthereumSigner{
+			// a small range of powers so that the ties are ordered by address
+			Power:           uint64(r.Intn(8)) << 29,
+			EthereumAddress: randAddress(r),
+		})
+	}
+	return u
+}
+
+func randBatchTx(r *rand.Rand) BatchTx {
+	contract := gethcommon.HexToAddress(randAddress(r))
+	b := BatchTx{BatchNonce: randNonce(r), Timeout: randNonce(r), TokenContract: contract.Hex()}
+	for i := r.Intn(12); i > 0; i-- {
+		b.Transactions = append(b.Transactions, &SendToEthereum{
+			Id:                r.Uint64(),
+			EthereumRecipient: randAddress(r),
+			Erc20Token:        NewSDKIntERC20Token(randAmount(r), contract),
+			Erc20Fee:          NewSDKIntERC20Token(randAmount(r), contract),
+		})
+	}
+	return b
+}
+
+func randERC1155BatchTx(r *rand.Rand) ERC1155BatchTx {
+	contract := randAddress(r)
+	b := ERC1155BatchTx{BatchNonce: randNonce(r), Timeout: randNonce(r), TokenContract: contract}
+	for i := r.Intn(6); i > 0; i-- {
+		tx := &SendERC1155ToEthereum{Id: r.Uint64(), EthereumRecipient: randAddress(r), TokenContract: contract}
+		for j := r.Intn(4) + 1; j > 0; j-- {
+			tx.Amounts = append(tx.Amounts, ERC1155Amount{Id: randAmount(r), Amount: randAmount(r)})
+		}
+		b.Transactions = append(b.Transactions, tx)
+	}
+	return b
+}
+
+func randContractCallTx(r *rand.Rand) ContractCallTx {
+	c := ContractCallTx{
+		InvalidationNonce: randNonce(r),
+		InvalidationScope: make([]byte, r.Intn(33)),
+		Address:           randAddress(r),
+		Payload:           make([]byte, r.Intn(100)),
+		Timeout:           randNonce(r),
+	}
+	r.Read(c.InvalidationScope)
+	r.Read(c.Payload)
+	for i := r.Intn(4); i > 0; i-- {
+		c.Tokens = append(c.Tokens, NewSDKIntERC20Token(randAmount(r), gethcommon.HexToAddress(randAddress(r))))
+	}
+	for i := r.Intn(4); i > 0; i-- {
+		c.Fees = append(c.Fees, NewSDKIntERC20Token(randAmount(r), gethcommon.HexToAddress(randAddress(r))))
+	}
+	return c
+}
+
+func randCheckpointVectors(r *rand.Rand, n int, chainID uint64) checkpointVectors {
+	gravityID := randGravityID(r)
+	vectors := checkpointVectors{GravityID: gethcommon.RightPadBytes(gravityID, 32), ChainID: chainID}
+	for i := 0; i < n; i++ {
+		vectors.Valsets = append(vectors.Valsets, newValsetVector(randSignerSetTx(r), gravityID, chainID))
+		vectors.Batches = append(vectors.Batches, newBatchVector(randBatchTx(r), gravityID, chainID))
+		vectors.ERC1155Batches = append(vectors.ERC1155Batches, newERC1155BatchVector(randERC1155BatchTx(r), gravityID, chainID))
+		vectors.LogicCalls = append(vectors.LogicCalls, newLogicCallVector(randContractCallTx(r), gravityID, chainID))
+	}
+	return vectors
+}
+
+func requireReferenceCheckpoints(t *testing.T, vectors checkpointVectors) {
+	var gravityID [32]byte
+	copy(gravityID[:], vectors.GravityID)
+	for i, v := range vectors.Valsets {
+		require.Equal(t, hexutil.Bytes(v.reference(gravityID)), v.Checkpoint, "valset %d", i)
+		require.Equal(t, hexutil.Bytes(v.typedReference(gravityID, vectors.ChainID)), v.TypedDataDigest, "valset %d", i)
+	}
+	for i, v := range vectors.Batches {
+		require.Equal(t, hexutil.Bytes(v.reference(gravityID)), v.Checkpoint, "batch %d", i)
+		require.Equal(t, hexutil.Bytes(v.typedReference(gravityID, vectors.ChainID)), v.TypedDataDigest, "batch %d", i)
+	}
+	for i, v := range vectors.ERC1155Batches {
+		require.Equal(t, hexutil.Bytes(v.reference(gravityID)), v.Checkpoint, "erc1155 batch %d", i)
+		require.Equal(t, hexutil.Bytes(v.typedReference(gravityID, vectors.ChainID)), v.TypedDataDigest, "erc1155 batch %d", i)
+	}
+	for i, v := range vectors.LogicCalls {
+		require.Equal(t, hexutil.Bytes(v.reference(gravityID)), v.Checkpoint, "logic call %d", i)
+		require.Equal(t, hexutil.Bytes(v.typedReference(gravityID, vectors.ChainID)), v.TypedDataDigest, "logic call %d", i)
+	}
+}
+
+func TestCheckpointsMatchReferenceEncoding(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		requireReferenceCheckpoints(t, randCheckpointVectors(r, 10, r.Uint64()))
+	}
+}
+
+// TestCheckpointReferenceEncoding anchors the reference encoding to the hashes computed
+// by the bridge contract in checkpoint_test.go
+func TestCheckpointReferenceEncoding(t *testing.T) {
+	gravityID := methodName("foo")
+	erc20Addr := gethcommon.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+	valset := valsetVector{
+		Validators:   []gethcommon.Address{gethcommon.HexToAddress("0xc783df8a850f42e7F7e57013759C285caa701eB6")},
+		Powers:       []*hexutil.Big{hexBig(big.NewInt(6667))},
+		ValsetNonce:  hexBig(big.NewInt(0)),
+		RewardAmount: hexBig(big.NewInt(0)),
+	}
+	require.Equal(t,
+		"0x89731c26bab12cf0cb5363ef9abab6f9bd5496cf758a2309311c7946d54bca85",
+		hexutil.Encode(valset.reference(gravityID)),
+	)
+
+	batch := batchVector{
+		Amounts:       []*hexutil.Big{hexBig(big.NewInt(1))},
+		Destinations:  []gethcommon.Address{gethcommon.HexToAddress("0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39")},
+		Fees:          []*hexutil.Big{hexBig(big.NewInt(1))},
+		BatchNonce:    hexBig(big.NewInt(1)),
+		TokenContract: erc20Addr,
+		BatchTimeout:  hexBig(big.NewInt(2111)),
+	}
+	require.Equal(t,
+		"0xa3a7ee0a363b8ad2514e7ee8f110d7449c0d88f3b0913c28c1751e6e0079a9b2",
+		hexutil.Encode(batch.reference(gravityID)),
+	)
+
+	tokenAddr := gethcommon.HexToAddress("0xC26eFfa98B8A2632141562Ae7E34953Cfe5B4888")
+	call := logicCallVector{
+		TransferAmounts:        []*hexutil.Big{hexBig(big.NewInt(1))},
+		TransferTokenContracts: []gethcommon.Address{tokenAddr},
+		FeeAmounts:             []*hexutil.Big{hexBig(big.NewInt(1))},
+		FeeTokenContracts:      []gethcommon.Address{tokenAddr},
+		LogicContractAddress:   gethcommon.HexToAddress("0x17c1736CcF692F653c433d7aa2aB45148C016F68"),
+		Payload:                hexutil.MustDecode("0x74657374696e675061796c6f6164000000000000000000000000000000000000"),
+		TimeOut:                hexBig(big.NewInt(4766922941000)),
+		InvalidationID:         hexutil.MustDecode("0x696e76616c69646174696f6e4964000000000000000000000000000000000000"),
+		InvalidationNonce:      hexBig(big.NewInt(1)),
+	}
+	require.Equal(t,
+		"0x1de95c9ace999f8ec70c6dc8d045942da2612950567c4861aca959c0650194da",
+		hexutil.Encode(call.reference(gravityID)),
+	)
+}
+
+// TestCheckpointVectors checks the vectors replayed on the contract are the checkpoints
+// of the module, run it with -update-checkpoint-vectors to rewrite them
+func TestCheckpointVectors(t *testing.T) {
+	vectors := randCheckpointVectors(rand.New(rand.NewSource(checkpointVectorsSeed)), checkpointVectorsN, checkpointVectorsChainID)
+	requireReferenceCheckpoints(t, vectors)
+
+	bz, err := json.MarshalIndent(vectors, "", "  ")
+	require.NoError(t, err)
+	bz = append(bz, '\n')
+
+	if *updateCheckpointVectors {
+		require.NoError(t, os.MkdirAll(filepath.Dir(checkpointVectorsFile), 0o755))
+		require.NoError(t, os.WriteFile(checkpointVectorsFile, bz, 0o644))
+	}
+
+	expected, err := os.ReadFile(checkpointVectorsFile)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(bz))
+}
@@ -2,11 +2,14 @@ package types
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -44,6 +47,46 @@ func TestBatchTxCheckpoint(t *testing.T) {
 	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
 }
 
+func TestERC1155BatchTxCheckpoint(t *testing.T) {
+	senderAddr, err := sdk.AccAddressFromHex("527FBEE652609AB150F0AEE9D61A2F76CFC4A73E")
+	require.NoError(t, err)
+	var (
+		erc1155Addr = gethcommon.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+	)
+
+	src := ERC1155BatchTx{
+		BatchNonce: 1,
+		Timeout:    2111,
+		Transactions: []*SendERC1155ToEthereum{
+			{
+				Id:                0x1,
+				Sender:            senderAddr.String(),
+				EthereumRecipient: "0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39",
+				TokenContract:     erc1155Addr.Hex(),
+				Amounts: []ERC1155Amount{
+					{Id: sdk.NewInt(1), Amount: sdk.NewInt(10)},
+					{Id: sdk.NewInt(7), Amount: sdk.NewInt(1)},
+				},
+			},
+			{
+				Id:                0x2,
+				Sender:            senderAddr.String(),
+				EthereumRecipient: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+				TokenContract:     erc1155Addr.Hex(),
+				Amounts:           []ERC1155Amount{{Id: sdk.NewInt(1), Amount: sdk.NewInt(5)}},
+			},
+		},
+		TokenContract: erc1155Addr.Hex(),
+	}
+
+	ourHash := src.GetCheckpoint([]byte("foo"))
+
+	// keccak256 of abi.encode(gravityId, "erc1155Batch", destinations, ids, amounts,
+	// batchNonce, tokenContract, timeout) as in submitERC1155Batch, one entry per id
+	goldHash := "0xf5b87c24dea4a63573a121e68621b8753151a62d91a984d30af76afe8bc56d4e"[2:]
+	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
+}
+
 func TestContractCallTxCheckpoint(t *testing.T) {
 	payload, err := hex.DecodeString("0x74657374696e675061796c6f6164000000000000000000000000000000000000"[2:])
 	require.NoError(t, err)
@@ -84,3 +127,120 @@ func TestValsetCheckpoint(t *testing.T) {
 	goldHash := "0x89731c26bab12cf0cb5363ef9abab6f9bd5496cf758a2309311c7946d54bca85"[2:]
 	assert.Equal(t, goldHash, hex.EncodeToString(ourHash))
 }
+
+// testCheckpointEncoder is a checkpoint version hashing the gravity id alone
+type testCheckpointEncoder struct{}
+
+func (testCheckpointEncoder) Checkpoint(_ OutgoingTx, gravityID []byte) []byte {
+	return crypto.Keccak256(gravityID)
+}
+
+func (testCheckpointEncoder) TypedData(otx OutgoingTx, gravityID []byte, chainID uint64) apitypes.TypedData {
+	return checkpointEncoderV1{}.TypedData(otx, gravityID, chainID)
+}
+
+func TestCheckpointVersions(t *testing.T) {
+	checkpointEncoders[2] = testCheckpointEncoder{}
+	defer delete(checkpointEncoders, 2)
+
+	src := NewSignerSetTx(1, 1, EthereumSigners{{
+		Power:           6667,
+		EthereumAddress: "0xc783df8a850f42e7F7e57013759C285caa701eB6",
+	}})
+	v1 := src.GetCheckpoint([]byte("foo"))
+
+	// the unversioned txs are encoded with the first version
+	src.CheckpointVersion = CheckpointVersion1
+	require.Equal(t, v1, src.GetCheckpoint([]byte("foo")))
+
+	src.CheckpointVersion = 2
+	require.Equal(t, crypto.Keccak256([]byte("foo")), src.GetCheckpoint([]byte("foo")))
+
+	src.CheckpointVersion = 3
+	require.Panics(t, func() { src.GetCheckpoint([]byte("foo")) })
+
+	activations := []CheckpointVersionActivation{{Version: 2, Height: 100}}
+	require.NoError(t, ValidateCheckpointVersionActivations(activations))
+	require.Equal(t, CheckpointVersion1, ActiveCheckpointVersion(activations, 99))
+	require.Equal(t, uint32(2), ActiveCheckpointVersion(activations, 100))
+	require.Equal(t, uint32(2), ActiveCheckpointVersion(activations, 101))
+
+	for _, invalid := range [][]CheckpointVersionActivation{
+		{{Version: 0, Height: 100}},
+		{{Version: 3, Height: 100}},
+		{{Version: 1, Height: 100}, {Version: 2, Height: 100}},
+		{{Version: 2, Height: 100}, {Version: 1, Height: 200}},
+	} {
+		require.Error(t, ValidateCheckpointVersionActivations(invalid), "%v", invalid)
+	}
+}
+
+func BenchmarkBatchTxCheckpoint(b *testing.B) {
+	erc20Addr := gethcommon.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+	batch := BatchTx{BatchNonce: 1, Timeout: 2111, TokenContract: erc20Addr.Hex()}
+	for i := 0; i < 100; i++ {
+		batch.Transactions = append(batch.Transactions, &SendToEthereum{
+			Id:                uint64(i),
+			EthereumRecipient: "0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39",
+			Erc20Token:        NewERC20Token(uint64(i+100), erc20Addr),
+			Erc20Fee:          NewERC20Token(uint64(i), erc20Addr),
+		})
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch.GetCheckpoint([]byte("foo"))
+	}
+}
+
+func BenchmarkERC1155BatchTxCheckpoint(b *testing.B) {
+	erc1155Addr := gethcommon.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+	batch := ERC1155BatchTx{BatchNonce: 1, Timeout: 2111, TokenContract: erc1155Addr.Hex()}
+	for i := 0; i < 100; i++ {
+		batch.Transactions = append(batch.Transactions, &SendERC1155ToEthereum{
+			Id:                uint64(i),
+			EthereumRecipient: "0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39",
+			TokenContract:     erc1155Addr.Hex(),
+			Amounts:           []ERC1155Amount{{Id: sdk.NewInt(int64(i)), Amount: sdk.NewInt(10)}},
+		})
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		batch.GetCheckpoint([]byte("foo"))
+	}
+}
+
+func BenchmarkSignerSetTxCheckpoint(b *testing.B) {
+	var signers EthereumSigners
+	for i := 0; i < 100; i++ {
+		signers = append(signers, &EthereumSigner{
+			Power:           uint64(i + 1),
+			EthereumAddress: gethcommon.BigToAddress(big.NewInt(int64(i + 1))).Hex(),
+		})
+	}
+	signerSet := NewSignerSetTx(1, 1, signers)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		signerSet.GetCheckpoint([]byte("foo"))
+	}
+}
+
+func BenchmarkContractCallTxCheckpoint(b *testing.B) {
+	token := []ERC20Token{NewERC20Token(1, gethcommon.HexToAddress("0xC26eFfa98B8A2632141562Ae7E34953Cfe5B4888"))}
+	call := ContractCallTx{
+		Tokens:            token,
+		Fees:              token,
+		Address:           "0x17c1736CcF692F653c433d7aa2aB45148C016F68",
+		Payload:           make([]byte, 256),
+		Timeout:           4766922941000,
+		InvalidationScope: make([]byte, 32),
+		InvalidationNonce: 1,
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		call.GetCheckpoint([]byte("foo"))
+	}
+}
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Each outgoing tx records the version of the encoding of its checkpoint, chosen when the tx
+// is created from the checkpoint_version_activations param. A change to the encoding, say
+// new fields or batches of several tokens, registers an encoder under a new version which
+// governance activates at a height once the contracts and orchestrators support it. The txs
+// outstanding at the activation height keep the version they were signed under, so that
+// nodes don't have to switch encodings in lockstep with a binary upgrade.
+
+// CheckpointVersion1 is the ABI encoding of the checkpoints the Gravity contracts have
+// verified from the start, which the txs created before checkpoints were versioned are
+// encoded with
+const CheckpointVersion1 uint32 = 1
+
+// CheckpointEncoder encodes the checkpoints and EIP-712 typed data of the outgoing txs of a
+// checkpoint version
+type CheckpointEncoder interface {
+	// Checkpoint returns the checkpoint of the tx under the gravity id
+	Checkpoint(otx OutgoingTx, gravityID []byte) []byte
+	// TypedData returns the typed data of the tx under the gravity id and EVM chain id
+	TypedData(otx OutgoingTx, gravityID []byte, chainID uint64) apitypes.TypedData
+}
+
+var checkpointEncoders = map[uint32]CheckpointEncoder{
+	CheckpointVersion1: checkpointEncoderV1{},
+}
+
+// GetCheckpointEncoder returns the encoder registered under the checkpoint version, the
+// first version's for the unversioned txs
+func GetCheckpointEncoder(version uint32) (CheckpointEncoder, error) {
+	if version == 0 {
+		version = CheckpointVersion1
+	}
+	encoder, ok := checkpointEncoders[version]
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "no checkpoint encoder of version %d", version)
+	}
+	return encoder, nil
+}
+
+// mustCheckpointEncoder returns the encoder of the version of a stored tx, which the params
+// only let the keeper create with a registered version
+func mustCheckpointEncoder(version uint32) CheckpointEncoder {
+	encoder, err := GetCheckpointEncoder(version)
+	if err != nil {
+		panic(err)
+	}
+	return encoder
+}
+
+// checkpointEncoderV1 is the encoder of CheckpointVersion1
+type checkpointEncoderV1 struct{}
+
+func (checkpointEncoderV1) Checkpoint(otx OutgoingTx, gravityID []byte) []byte {
+	switch tx := otx.(type) {
+	case *SignerSetTx:
+		return tx.checkpointV1(gravityID)
+	case *BatchTx:
+		return tx.checkpointV1(gravityID)
+	case *ContractCallTx:
+		return tx.checkpointV1(gravityID)
+	case *ERC1155BatchTx:
+		return tx.checkpointV1(gravityID)
+	default:
+		panic(fmt.Sprintf("no checkpoint of outgoing tx %T", otx))
+	}
+}
+
+func (checkpointEncoderV1) TypedData(otx OutgoingTx, gravityID []byte, chainID uint64) apitypes.TypedData {
+	switch tx := otx.(type) {
+	case *SignerSetTx:
+		return tx.typedDataV1(gravityID, chainID)
+	case *BatchTx:
+		return tx.typedDataV1(gravityID, chainID)
+	case *ContractCallTx:
+		return tx.typedDataV1(gravityID, chainID)
+	case *ERC1155BatchTx:
+		return tx.typedDataV1(gravityID, chainID)
+	default:
+		panic(fmt.Sprintf("no typed data of outgoing tx %T", otx))
+	}
+}
+
+// ValidateCheckpointVersionActivations returns an error unless the activations are of
+// registered versions, in increasing order of both version and height
+func ValidateCheckpointVersionActivations(activations []CheckpointVersionActivation) error {
+	for i, activation := range activations {
+		if _, ok := checkpointEncoders[activation.Version]; !ok {
+			return fmt.Errorf("unknown checkpoint version %d", activation.Version)
+		}
+		if i == 0 {
+			continue
+		}
+		if previous := activations[i-1]; activation.Version <= previous.Version || activation.Height <= previous.Height {
+			return fmt.Errorf("checkpoint version %d at height %d doesn't follow version %d at height %d", activation.Version, activation.Height, previous.Version, previous.Height)
+		}
+	}
+	return nil
+}
+
+// ActiveCheckpointVersion returns the checkpoint version of the txs created at the height,
+// the first version's until another activates
+func ActiveCheckpointVersion(activations []CheckpointVersionActivation, height uint64) uint32 {
+	version := CheckpointVersion1
+	for _, activation := range activations {
+		if activation.Height > height {
+			break
+		}
+		version = activation.Version
+	}
+	return version
+}
+
+// GetCheckpoint returns the checkpoint of the signer set under its checkpoint version
+func (u SignerSetTx) GetCheckpoint(gravityID []byte) []byte {
+	return mustCheckpointEncoder(u.CheckpointVersion).Checkpoint(&u, gravityID)
+}
+
+// GetCheckpoint returns the checkpoint of the batch under its checkpoint version
+func (b BatchTx) GetCheckpoint(gravityID []byte) []byte {
+	return mustCheckpointEncoder(b.CheckpointVersion).Checkpoint(&b, gravityID)
+}
+
+// GetCheckpoint returns the checkpoint of the logic call under its checkpoint version
+func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
+	return mustCheckpointEncoder(c.CheckpointVersion).Checkpoint(&c, gravityID)
+}
+
+// GetCheckpoint returns the checkpoint of the ERC1155 batch under its checkpoint version
+func (b ERC1155BatchTx) GetCheckpoint(gravityID []byte) []byte {
+	return mustCheckpointEncoder(b.CheckpointVersion).Checkpoint(&b, gravityID)
+}
+
+// GetTypedData returns the EIP-712 typed data of the signer set under its checkpoint version
+func (u SignerSetTx) GetTypedData(gravityID []byte, chainID uint64) apitypes.TypedData {
+	return mustCheckpointEncoder(u.CheckpointVersion).TypedData(&u, gravityID, chainID)
+}
+
+// GetTypedData returns the EIP-712 typed data of the batch under its checkpoint version
+func (b BatchTx) GetTypedData(gravityID []byte, chainID uint64) apitypes.TypedData {
+	return mustCheckpointEncoder(b.CheckpointVersion).TypedData(&b, gravityID, chainID)
+}
+
+// GetTypedData returns the EIP-712 typed data of the logic call under its checkpoint version
+func (c ContractCallTx) GetTypedData(gravityID []byte, chainID uint64) apitypes.TypedData {
+	return mustCheckpointEncoder(c.CheckpointVersion).TypedData(&c, gravityID, chainID)
+}
+
+// GetTypedData returns the EIP-712 typed data of the ERC1155 batch under its checkpoint
+// version
+func (b ERC1155BatchTx) GetTypedData(gravityID []byte, chainID uint64) apitypes.TypedData {
+	return mustCheckpointEncoder(b.CheckpointVersion).TypedData(&b, gravityID, chainID)
+}
@@ -18,6 +18,39 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegateKeys{}, "gravity-bridge/MsgDelegateKeys", nil)
 	cdc.RegisterConcrete(&MsgSendToEthereum{}, "gravity-bridge/MsgSendToEthereum", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEthereum{}, "gravity-bridge/MsgCancelSendToEthereum", nil)
+	cdc.RegisterConcrete(&MsgSendERC1155ToEthereum{}, "gravity-bridge/MsgSendERC1155ToEthereum", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "gravity-bridge/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgBridgeAdminPause{}, "gravity-bridge/MsgBridgeAdminPause", nil)
+	cdc.RegisterConcrete(&MsgBridgeAdminSetRateLimits{}, "gravity-bridge/MsgBridgeAdminSetRateLimits", nil)
+	cdc.RegisterConcrete(&MsgBridgeAdminSetFeeFloors{}, "gravity-bridge/MsgBridgeAdminSetFeeFloors", nil)
+	cdc.RegisterConcrete(&MsgMintVouchers{}, "gravity-bridge/MsgMintVouchers", nil)
+	cdc.RegisterConcrete(&MsgBurnVouchers{}, "gravity-bridge/MsgBurnVouchers", nil)
+	cdc.RegisterConcrete(&MsgVetoBatchTx{}, "gravity-bridge/MsgVetoBatchTx", nil)
+	cdc.RegisterConcrete(&MsgVetoContractCallTx{}, "gravity-bridge/MsgVetoContractCallTx", nil)
+	cdc.RegisterConcrete(&MsgInjectEthereumEvent{}, "gravity-bridge/MsgInjectEthereumEvent", nil)
+
+	// orchestrator messages are registered so that they can be signed in the
+	// legacy amino JSON sign mode, the only one supported by Ledger devices
+	cdc.RegisterConcrete(&MsgSubmitEthereumEvent{}, "gravity-bridge/MsgSubmitEthereumEvent", nil)
+	cdc.RegisterConcrete(&MsgSubmitEthereumTxConfirmation{}, "gravity-bridge/MsgSubmitEthereumTxConfirmation", nil)
+	cdc.RegisterConcrete(&MsgEthereumHeightVote{}, "gravity-bridge/MsgEthereumHeightVote", nil)
+	cdc.RegisterConcrete(&MsgRequestDepositAddress{}, "gravity-bridge/MsgRequestDepositAddress", nil)
+
+	cdc.RegisterInterface((*EthereumEvent)(nil), nil)
+	cdc.RegisterConcrete(&SendToCosmosEvent{}, "gravity-bridge/SendToCosmosEvent", nil)
+	cdc.RegisterConcrete(&BatchExecutedEvent{}, "gravity-bridge/BatchExecutedEvent", nil)
+	cdc.RegisterConcrete(&ERC20DeployedEvent{}, "gravity-bridge/ERC20DeployedEvent", nil)
+	cdc.RegisterConcrete(&ContractCallExecutedEvent{}, "gravity-bridge/ContractCallExecutedEvent", nil)
+	cdc.RegisterConcrete(&SignerSetTxExecutedEvent{}, "gravity-bridge/SignerSetTxExecutedEvent", nil)
+	cdc.RegisterConcrete(&SendERC1155ToCosmosEvent{}, "gravity-bridge/SendERC1155ToCosmosEvent", nil)
+	cdc.RegisterConcrete(&ERC1155BatchExecutedEvent{}, "gravity-bridge/ERC1155BatchExecutedEvent", nil)
+	cdc.RegisterConcrete(&ContractVersionEvent{}, "gravity-bridge/ContractVersionEvent", nil)
+
+	cdc.RegisterInterface((*EthereumTxConfirmation)(nil), nil)
+	cdc.RegisterConcrete(&BatchTxConfirmation{}, "gravity-bridge/BatchTxConfirmation", nil)
+	cdc.RegisterConcrete(&ContractCallTxConfirmation{}, "gravity-bridge/ContractCallTxConfirmation", nil)
+	cdc.RegisterConcrete(&SignerSetTxConfirmation{}, "gravity-bridge/SignerSetTxConfirmation", nil)
+	cdc.RegisterConcrete(&ERC1155BatchTxConfirmation{}, "gravity-bridge/ERC1155BatchTxConfirmation", nil)
 }
 
 var (
@@ -47,6 +80,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSubmitEthereumTxConfirmation{},
 		&MsgDelegateKeys{},
 		&MsgEthereumHeightVote{},
+		&MsgRequestDepositAddress{},
+		&MsgSendERC1155ToEthereum{},
+		&MsgUpdateParams{},
+		&MsgBridgeAdminPause{},
+		&MsgBridgeAdminSetRateLimits{},
+		&MsgBridgeAdminSetFeeFloors{},
+		&MsgMintVouchers{},
+		&MsgBurnVouchers{},
+		&MsgVetoBatchTx{},
+		&MsgVetoContractCallTx{},
+		&MsgInjectEthereumEvent{},
 	)
 
 	registry.RegisterInterface(
@@ -57,6 +101,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ERC20DeployedEvent{},
 		&ContractCallExecutedEvent{},
 		&SignerSetTxExecutedEvent{},
+		&SendERC1155ToCosmosEvent{},
+		&ERC1155BatchExecutedEvent{},
+		&ContractVersionEvent{},
 	)
 
 	registry.RegisterInterface(
@@ -65,6 +112,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BatchTxConfirmation{},
 		&ContractCallTxConfirmation{},
 		&SignerSetTxConfirmation{},
+		&ERC1155BatchTxConfirmation{},
 	)
 
 	registry.RegisterInterface(
@@ -73,15 +121,58 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&SignerSetTx{},
 		&BatchTx{},
 		&ContractCallTx{},
+		&ERC1155BatchTx{},
 	)
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&CommunityPoolEthereumSpendProposal{},
+		&AddEVMChainProposal{},
+		&ContractMigrationProposal{},
+		&EVMChainPauseProposal{},
+		&GravityIDRotationProposal{},
+		&UpdateParamsProposal{},
+		&RelayerIncentiveProposal{},
+		&EthereumEventRejectionProposal{},
+		&IncidentRecoveryProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
+// InterchainAccountMsgs returns the type URLs of the messages an interchain account of a
+// remote chain may submit, for the allowlist of the interchain accounts host. These are
+// the messages of bridge users, the messages of validators' orchestrators are left out.
+func InterchainAccountMsgs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgSendToEthereum{}),
+		sdk.MsgTypeURL(&MsgCancelSendToEthereum{}),
+		sdk.MsgTypeURL(&MsgRequestDepositAddress{}),
+		sdk.MsgTypeURL(&MsgSendERC1155ToEthereum{}),
+	}
+}
+
+// InterchainQueryPaths returns the query paths the interchain query host answers, for
+// remote chains to verify the bridge's solvency and the status of pending withdrawals:
+// the ERC20 mappings, the vouchers in circulation and held by the module, and the
+// batches and sends waiting to be relayed.
+func InterchainQueryPaths() []string {
+	return []string{
+		"/gravity.v1.Query/Params",
+		"/gravity.v1.Query/EVMChains",
+		"/gravity.v1.Query/BridgeContract",
+		"/gravity.v1.Query/LastObservedEthereumHeight",
+		"/gravity.v1.Query/ERC20ToDenom",
+		"/gravity.v1.Query/DenomToERC20",
+		"/gravity.v1.Query/BatchTx",
+		"/gravity.v1.Query/BatchTxs",
+		"/gravity.v1.Query/BatchTxFees",
+		"/gravity.v1.Query/BatchedSendToEthereums",
+		"/gravity.v1.Query/UnbatchedSendToEthereums",
+		"/cosmos.bank.v1beta1.Query/Balance",
+		"/cosmos.bank.v1beta1.Query/SupplyOf",
+	}
+}
+
 func PackEvent(event EthereumEvent) (*types.Any, error) {
 	msg, ok := event.(proto.Message)
 	if !ok {
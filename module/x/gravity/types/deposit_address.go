@@ -0,0 +1,56 @@
+package types
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// the EIP-1167 minimal proxy init code the factory clones its forwarder with, the
+// forwarder's address goes in between
+var (
+	cloneInitCodePrefix = common.FromHex("3d602d80600a3d3981f3363d3d373d3d3d363d73")
+	cloneInitCodeSuffix = common.FromHex("5af43d82803e903d91602b57fd5bf3")
+)
+
+// DepositDestination returns the sendToCosmos destination deposits to the recipient are
+// forwarded with, the recipient's address left padded to 32 bytes
+func DepositDestination(recipient sdk.AccAddress) common.Hash {
+	return common.BytesToHash(recipient)
+}
+
+// DeriveDepositAddress returns the address the deposit address factory clones its
+// forwarder to for the recipient. The factory creates the forwarder in its constructor,
+// so the forwarder is at the factory's first contract creation address.
+func DeriveDepositAddress(factory common.Address, recipient sdk.AccAddress) common.Address {
+	forwarder := crypto.CreateAddress(factory, 1)
+	initCode := bytes.Join([][]byte{cloneInitCodePrefix, forwarder.Bytes(), cloneInitCodeSuffix}, nil)
+	return crypto.CreateAddress2(factory, DepositDestination(recipient), crypto.Keccak256(initCode))
+}
+
+// validateDepositRecipient rejects recipients that don't fit in the last 20 bytes of a
+// sendToCosmos destination
+func validateDepositRecipient(recipient string) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, recipient)
+	}
+	if len(addr) != common.AddressLength {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "deposit recipient %s must be %d bytes", recipient, common.AddressLength)
+	}
+	return addr, nil
+}
+
+// ValidateBasic performs stateless checks
+func (d DepositAddress) ValidateBasic() error {
+	if _, err := validateDepositRecipient(d.Recipient); err != nil {
+		return err
+	}
+	if ValidateEthereumAddress(d.DepositAddress) != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid deposit address %s", d.DepositAddress)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// The outgoing txs may also be confirmed by signing their EIP-712 typed data rather than
+// their checkpoint, so that signers can review the transfers they approve. The typed data
+// carries the arguments of the contract call relaying the tx, under a domain whose chain id
+// is the EVM chain's and whose salt is the gravity id, mirroring the TypedData library of
+// Gravity.sol.
+
+const (
+	// TypedDataContractVersion is the first Gravity contract version verifying typed data
+	// signatures
+	TypedDataContractVersion uint64 = 3
+
+	typedDataDomainName    = "Gravity Bridge"
+	typedDataDomainVersion = "1"
+)
+
+var typedDataTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "salt", Type: "bytes32"},
+	},
+	"Valset": {
+		{Name: "valsetNonce", Type: "uint256"},
+		{Name: "validators", Type: "address[]"},
+		{Name: "powers", Type: "uint256[]"},
+		{Name: "rewardAmount", Type: "uint256"},
+		{Name: "rewardToken", Type: "address"},
+	},
+	"TransactionBatch": {
+		{Name: "amounts", Type: "uint256[]"},
+		{Name: "destinations", Type: "address[]"},
+		{Name: "fees", Type: "uint256[]"},
+		{Name: "batchNonce", Type: "uint256"},
+		{Name: "tokenContract", Type: "address"},
+		{Name: "batchTimeout", Type: "uint256"},
+	},
+	"ERC1155Batch": {
+		{Name: "destinations", Type: "address[]"},
+		{Name: "ids", Type: "uint256[]"},
+		{Name: "amounts", Type: "uint256[]"},
+		{Name: "batchNonce", Type: "uint256"},
+		{Name: "tokenContract", Type: "address"},
+		{Name: "batchTimeout", Type: "uint256"},
+	},
+	"LogicCall": {
+		{Name: "transferAmounts", Type: "uint256[]"},
+		{Name: "transferTokenContracts", Type: "address[]"},
+		{Name: "feeAmounts", Type: "uint256[]"},
+		{Name: "feeTokenContracts", Type: "address[]"},
+		{Name: "logicContractAddress", Type: "address"},
+		{Name: "payload", Type: "bytes"},
+		{Name: "timeOut", Type: "uint256"},
+		{Name: "invalidationId", Type: "bytes32"},
+		{Name: "invalidationNonce", Type: "uint256"},
+	},
+}
+
+// newTypedData returns the typed data of the message under the domain of the gravity id and
+// EVM chain, with the types of the primary type only
+func newTypedData(gravityID []byte, chainID uint64, primaryType string, message apitypes.TypedDataMessage) apitypes.TypedData {
+	gravityIDFixed, err := byteArrayToFixByteArray(gravityID)
+	if err != nil {
+		panic(err)
+	}
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": typedDataTypes["EIP712Domain"],
+			primaryType:    typedDataTypes[primaryType],
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:    typedDataDomainName,
+			Version: typedDataDomainVersion,
+			ChainId: (*math.HexOrDecimal256)(new(big.Int).SetUint64(chainID)),
+			Salt:    hexutil.Encode(gravityIDFixed[:]),
+		},
+		Message: message,
+	}
+}
+
+// the values of the messages are given as the JSON of eth_signTypedData would have them,
+// so that the typed data hashes the same once marshalled for a signer
+
+func typedUint(v *big.Int) string {
+	return v.String()
+}
+
+func typedUint64(v uint64) string {
+	return new(big.Int).SetUint64(v).String()
+}
+
+func typedUints(vs []*big.Int) []interface{} {
+	out := make([]interface{}, len(vs))
+	for i, v := range vs {
+		out[i] = typedUint(v)
+	}
+	return out
+}
+
+func typedAddresses(as []gethcommon.Address) []interface{} {
+	out := make([]interface{}, len(as))
+	for i, a := range as {
+		out[i] = a.Hex()
+	}
+	return out
+}
+
+// typedDataV1 returns the EIP-712 typed data of the signer set in CheckpointVersion1
+func (u SignerSetTx) typedDataV1(gravityID []byte, chainID uint64) apitypes.TypedData {
+	args := u.ABIEncodedValsetArgs()
+	return newTypedData(gravityID, chainID, "Valset", apitypes.TypedDataMessage{
+		"valsetNonce":  typedUint(args.Nonce),
+		"validators":   typedAddresses(args.Validators),
+		"powers":       typedUints(args.Powers),
+		"rewardAmount": typedUint(args.RewardAmount),
+		"rewardToken":  args.RewardToken.Hex(),
+	})
+}
+
+// typedDataV1 returns the EIP-712 typed data of the batch in CheckpointVersion1
+func (b BatchTx) typedDataV1(gravityID []byte, chainID uint64) apitypes.TypedData {
+	amounts := make([]interface{}, len(b.Transactions))
+	destinations := make([]interface{}, len(b.Transactions))
+	fees := make([]interface{}, len(b.Transactions))
+	for i, tx := range b.Transactions {
+		amounts[i] = typedUint(tx.Erc20Token.Amount.BigInt())
+		destinations[i] = gethcommon.HexToAddress(tx.EthereumRecipient).Hex()
+		fees[i] = typedUint(tx.Erc20Fee.Amount.BigInt())
+	}
+	return newTypedData(gravityID, chainID, "TransactionBatch", apitypes.TypedDataMessage{
+		"amounts":       amounts,
+		"destinations":  destinations,
+		"fees":          fees,
+		"batchNonce":    typedUint64(b.BatchNonce),
+		"tokenContract": gethcommon.HexToAddress(b.TokenContract).Hex(),
+		"batchTimeout":  typedUint64(b.Timeout),
+	})
+}
+
+// typedDataV1 returns the EIP-712 typed data of the ERC1155 batch in CheckpointVersion1,
+// its transfers flattened as in its checkpoint
+func (b ERC1155BatchTx) typedDataV1(gravityID []byte, chainID uint64) apitypes.TypedData {
+	destinations := []interface{}{}
+	ids := []interface{}{}
+	amounts := []interface{}{}
+	for _, tx := range b.Transactions {
+		for _, amount := range tx.Amounts {
+			destinations = append(destinations, gethcommon.HexToAddress(tx.EthereumRecipient).Hex())
+			ids = append(ids, typedUint(amount.Id.BigInt()))
+			amounts = append(amounts, typedUint(amount.Amount.BigInt()))
+		}
+	}
+	return newTypedData(gravityID, chainID, "ERC1155Batch", apitypes.TypedDataMessage{
+		"destinations":  destinations,
+		"ids":           ids,
+		"amounts":       amounts,
+		"batchNonce":    typedUint64(b.BatchNonce),
+		"tokenContract": gethcommon.HexToAddress(b.TokenContract).Hex(),
+		"batchTimeout":  typedUint64(b.Timeout),
+	})
+}
+
+// typedDataV1 returns the EIP-712 typed data of the logic call in CheckpointVersion1
+func (c ContractCallTx) typedDataV1(gravityID []byte, chainID uint64) apitypes.TypedData {
+	transferAmounts := make([]interface{}, len(c.Tokens))
+	transferTokenContracts := make([]interface{}, len(c.Tokens))
+	feeAmounts := make([]interface{}, len(c.Fees))
+	feeTokenContracts := make([]interface{}, len(c.Fees))
+	for i, coin := range c.Tokens {
+		transferAmounts[i] = typedUint(coin.Amount.BigInt())
+		transferTokenContracts[i] = gethcommon.HexToAddress(coin.Contract).Hex()
+	}
+	for i, coin := range c.Fees {
+		feeAmounts[i] = typedUint(coin.Amount.BigInt())
+		feeTokenContracts[i] = gethcommon.HexToAddress(coin.Contract).Hex()
+	}
+	var invalidationID [32]byte
+	copy(invalidationID[:], c.InvalidationScope)
+	return newTypedData(gravityID, chainID, "LogicCall", apitypes.TypedDataMessage{
+		"transferAmounts":        transferAmounts,
+		"transferTokenContracts": transferTokenContracts,
+		"feeAmounts":             feeAmounts,
+		"feeTokenContracts":      feeTokenContracts,
+		"logicContractAddress":   gethcommon.HexToAddress(c.Address).Hex(),
+		"payload":                hexutil.Encode(c.Payload),
+		"timeOut":                typedUint64(c.Timeout),
+		"invalidationId":         hexutil.Encode(invalidationID[:]),
+		"invalidationNonce":      typedUint64(c.InvalidationNonce),
+	})
+}
+
+// TypedDataDigest returns the digest of the typed data signed by the orchestrators, it panics
+// on typed data the outgoing txs don't produce
+func TypedDataDigest(typedData apitypes.TypedData) []byte {
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "hashing typed data"))
+	}
+	return digest
+}
+
+// NewTypedDataSignature signs the digest of typed data, which unlike a checkpoint is signed
+// without the Ethereum signed message prefix
+func NewTypedDataSignature(digest []byte, privateKey *ecdsa.PrivateKey) ([]byte, error) {
+	if privateKey == nil {
+		return nil, sdkerrors.Wrap(ErrInvalid, "did not pass in private key")
+	}
+	return crypto.Sign(digest, privateKey)
+}
+
+// ValidateTypedDataSignature returns an error if the signature of the typed data digest isn't
+// the Ethereum address'
+func ValidateTypedDataSignature(digest []byte, signature []byte, ethAddress gethcommon.Address) error {
+	return validateEthereumSigner(digest, signature, ethAddress)
+}
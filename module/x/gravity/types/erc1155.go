@@ -0,0 +1,105 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// ERC1155DenomPrefix prefixes the denoms of vouchers of ERC1155 token ids
+const ERC1155DenomPrefix = GravityDenomPrefix + "1155" + EVMChainDenomSeparator
+
+// NewERC1155Token returns the ERC1155 token id of the contract on the EVM chain
+func NewERC1155Token(chainID uint64, contract common.Address, id sdk.Int) ERC1155Token {
+	return ERC1155Token{EvmChainId: chainID, Contract: contract.Hex(), Id: id}
+}
+
+// Denom returns the denom of the vouchers of the token id, the hex SHA256 hash of
+// <evm chain id>/<contract>/<id> following the gravity1155/ prefix
+func (t ERC1155Token) Denom() string {
+	path := strings.Join([]string{
+		strconv.FormatUint(t.EvmChainId, 10),
+		common.HexToAddress(t.Contract).Hex(),
+		t.Id.String(),
+	}, EVMChainDenomSeparator)
+	hash := sha256.Sum256([]byte(path))
+	return ERC1155DenomPrefix + tmbytes.HexBytes(hash[:]).String()
+}
+
+// ValidateBasic performs stateless checks
+func (t ERC1155Token) ValidateBasic() error {
+	if t.EvmChainId == 0 {
+		return fmt.Errorf("evm chain id cannot be 0")
+	}
+	if ValidateEthereumAddress(t.Contract) != nil {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
+	}
+	return validateERC1155ID(t.Id)
+}
+
+// IsERC1155Denom returns whether the denom is the one of vouchers of an ERC1155 token id
+func IsERC1155Denom(denom string) bool {
+	return strings.HasPrefix(denom, ERC1155DenomPrefix)
+}
+
+// ValidateBasic performs stateless checks
+func (a ERC1155Amount) ValidateBasic() error {
+	if err := validateERC1155ID(a.Id); err != nil {
+		return err
+	}
+	if a.Amount.IsNil() || !a.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount of id %s must be positive", a.Id)
+	}
+	if a.Amount.BigInt().BitLen() > 256 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount of id %s doesn't fit in a uint256", a.Id)
+	}
+	return nil
+}
+
+func validateERC1155ID(id sdk.Int) error {
+	if id.IsNil() || id.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "erc1155 id must not be negative")
+	}
+	if id.BigInt().BitLen() > 256 {
+		return sdkerrors.Wrapf(ErrInvalid, "erc1155 id %s doesn't fit in a uint256", id)
+	}
+	return nil
+}
+
+// validateERC1155Amounts checks the amounts of a transfer, each id may only appear once
+func validateERC1155Amounts(amounts []ERC1155Amount) error {
+	if len(amounts) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "no erc1155 amounts")
+	}
+	seen := make(map[string]bool, len(amounts))
+	for _, amount := range amounts {
+		if err := amount.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[amount.Id.String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "duplicate erc1155 id %s", amount.Id)
+		}
+		seen[amount.Id.String()] = true
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks
+func (s SendERC1155ToEthereum) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, s.Sender)
+	}
+	if ValidateEthereumAddress(s.EthereumRecipient) != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
+	}
+	if ValidateEthereumAddress(s.TokenContract) != nil {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
+	}
+	return validateERC1155Amounts(s.Amounts)
+}
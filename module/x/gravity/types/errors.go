@@ -4,6 +4,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// The errors of the module, registered under the gravity codespace with a distinct code for
+// each failure mode so that clients can branch on the code of a failed tx. Codes are never
+// reused.
 var (
 	ErrInvalid                          = sdkerrors.Register(ModuleName, 3, "invalid")
 	ErrSupplyOverflow                   = sdkerrors.Register(ModuleName, 4, "malicious ERC20 with invalid supply sent over bridge")
@@ -14,4 +17,34 @@ var (
 	ErrInvalidEthereumProposalAmount    = sdkerrors.Register(ModuleName, 9, "invalid community pool Ethereum spend proposal amount")
 	ErrInvalidEthereumProposalBridgeFee = sdkerrors.Register(ModuleName, 10, "invalid community pool Ethereum spend proposal bridge fee")
 	ErrEthereumProposalDenomMismatch    = sdkerrors.Register(ModuleName, 11, "community pool Ethereum spend proposal amount and bridge fee denom mismatch")
+	ErrInsufficientConfirmations        = sdkerrors.Register(ModuleName, 12, "ethereum event submitted with too few confirmations")
+	ErrUnknownEVMChain                  = sdkerrors.Register(ModuleName, 13, "unknown EVM chain")
+	ErrContractMigration                = sdkerrors.Register(ModuleName, 14, "bridge contract migration in progress")
+	ErrEVMChainPaused                   = sdkerrors.Register(ModuleName, 15, "EVM chain is paused")
+	ErrInsufficientFee                  = sdkerrors.Register(ModuleName, 16, "bridge fee below the fee floor of the EVM chain")
+	ErrNoDepositAddressFactory          = sdkerrors.Register(ModuleName, 17, "EVM chain has no deposit address factory")
+	ErrRateLimited                      = sdkerrors.Register(ModuleName, 18, "transfer exceeds the rate limit of the EVM chain")
+	ErrInvalidRecipient                 = sdkerrors.Register(ModuleName, 19, "invalid recipient")
+	ErrUnknownDenom                     = sdkerrors.Register(ModuleName, 20, "denom not bridged to the EVM chain")
+	ErrPoolEntryNotFound                = sdkerrors.Register(ModuleName, 21, "transfer not found in the pool of the EVM chain")
+	ErrNotSender                        = sdkerrors.Register(ModuleName, 22, "only the sender of a transfer can cancel it")
+	ErrStaleNonce                       = sdkerrors.Register(ModuleName, 23, "nonce is not the next one expected")
+	ErrOutgoingTxNotFound               = sdkerrors.Register(ModuleName, 24, "outgoing tx not found")
+	ErrDuplicateConfirmation            = sdkerrors.Register(ModuleName, 25, "outgoing tx already signed by the validator")
+	ErrInvalidSignature                 = sdkerrors.Register(ModuleName, 26, "invalid Ethereum signature")
+	ErrUnknownSigner                    = sdkerrors.Register(ModuleName, 27, "signer is neither a validator nor an orchestrator")
+	ErrValidatorNotBonded               = sdkerrors.Register(ModuleName, 28, "validator is not bonded")
+	ErrInVetoDelay                      = sdkerrors.Register(ModuleName, 29, "outgoing tx is in its veto delay")
+	ErrVetoDelayPassed                  = sdkerrors.Register(ModuleName, 30, "veto delay of the outgoing tx has passed")
+	ErrEVMChainExists                   = sdkerrors.Register(ModuleName, 31, "EVM chain already exists")
+	ErrGravityIDInUse                   = sdkerrors.Register(ModuleName, 32, "gravity id used by another EVM chain")
+	ErrEventVoteRecordNotFound          = sdkerrors.Register(ModuleName, 33, "event vote record not found")
+	ErrEventVoteRecordNotPending        = sdkerrors.Register(ModuleName, 34, "event vote record no longer pending")
+	ErrUnknownLogicCallTemplate         = sdkerrors.Register(ModuleName, 35, "unknown logic call template")
+	ErrNotRelayable                     = sdkerrors.Register(ModuleName, 36, "outgoing tx is not relayable")
+	ErrPoolFull                         = sdkerrors.Register(ModuleName, 37, "pool of unbatched transfers is full")
+	ErrDevnetOnly                       = sdkerrors.Register(ModuleName, 38, "only accepted by devnet builds")
+	ErrMalleableSignature               = sdkerrors.Register(ModuleName, 39, "Ethereum signature with an s in the upper half of the curve order")
+	ErrInvalidRecoveryID                = sdkerrors.Register(ModuleName, 40, "invalid Ethereum signature recovery id")
+	ErrSignerMismatch                   = sdkerrors.Register(ModuleName, 41, "Ethereum signature not of the validator's registered key")
 )
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// EVMChainEscrowAddress returns the address of the sub-account of the gravity module holding
+// the cosmos originated coins locked for the EVM chain
+func EVMChainEscrowAddress(chainID uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(fmt.Sprintf("escrow/%d", chainID)))
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,10 @@ const (
 	// GravityDenomSeparator is the separator for gravity denoms
 	GravityDenomSeparator = ""
 
+	// EVMChainDenomSeparator separates the chain id from the contract address in the
+	// denoms of assets originating on EVM chains other than the default chain
+	EVMChainDenomSeparator = "/"
+
 	// EthereumContractAddressLen is the length of contract address strings
 	EthereumContractAddressLen = 42
 
@@ -28,19 +33,32 @@ func EthereumAddrLessThan(e, o string) bool {
 	return bytes.Compare(common.HexToAddress(e).Bytes(), common.HexToAddress(o).Bytes()) == -1
 }
 
-// ValidateEthereumAddress validates the ethereum address strings
-// func ValidateEthereumAddress(a string) error {
-// 	if a == "" {
-// 		return fmt.Errorf("empty")
-// 	}
-// 	if !regexp.MustCompile("^0x[0-9a-fA-F]{40}$").MatchString(a) {
-// 		return fmt.Errorf("address(%s) doesn't pass regex", a)
-// 	}
-// 	if len(a) != EthereumContractAddressLen {
-// 		return fmt.Errorf("address(%s) of the wrong length exp(%d) actual(%d)", a, len(a), EthereumContractAddressLen)
-// 	}
-// 	return nil
-// }
+// ValidateEthereumAddress returns an error unless the string is a hex Ethereum address whose
+// letters, when of mixed case, are those of its EIP-55 checksum. Addresses all in lower or
+// upper case carry no checksum and are accepted as they are.
+func ValidateEthereumAddress(address string) error {
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("invalid ethereum address %s", address)
+	}
+	digits := address[len(address)-2*common.AddressLength:]
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) &&
+		digits != common.HexToAddress(address).Hex()[2:] {
+		return fmt.Errorf("invalid checksum of ethereum address %s", address)
+	}
+	return nil
+}
+
+// NormalizeEthereumAddress returns the EIP-55 checksummed form of the Ethereum address, the
+// one addresses are stored in so that inputs differing only in case are the same address
+func NormalizeEthereumAddress(address string) string {
+	return common.HexToAddress(address).Hex()
+}
+
+// EthereumAddressNormalizer is implemented by the events and records holding Ethereum
+// addresses, which rewrite them in their normalized form before they are stored
+type EthereumAddressNormalizer interface {
+	NormalizeEthereumAddresses()
+}
 
 /////////////////////////
 //     ERC20Token      //
@@ -77,7 +95,7 @@ func GravityDenomToERC20(denom string) (string, error) {
 	}
 	contract := strings.TrimPrefix(denom, fullPrefix)
 	switch {
-	case !common.IsHexAddress(contract):
+	case ValidateEthereumAddress(contract) != nil:
 		return "", fmt.Errorf("error validating ethereum contract address")
 	case len(denom) != GravityDenomLen:
 		return "", fmt.Errorf("len(denom)(%d) not equal to GravityDenomLen(%d)", len(denom), GravityDenomLen)
@@ -86,6 +104,44 @@ func GravityDenomToERC20(denom string) (string, error) {
 	}
 }
 
+// EVMChainGravityDenom returns the denom of the vouchers for an ERC20 of an EVM chain
+// other than the default chain, e.g. gravity42161/0x...
+func EVMChainGravityDenom(chainID uint64, contract common.Address) string {
+	return GravityDenomPrefix + strconv.FormatUint(chainID, 10) + EVMChainDenomSeparator + contract.Hex()
+}
+
+// EVMChainGravityDenomToERC20 parses denoms created by EVMChainGravityDenom into the
+// chain id and ERC20 contract address
+func EVMChainGravityDenomToERC20(denom string) (uint64, string, error) {
+	if !strings.HasPrefix(denom, GravityDenomPrefix) {
+		return 0, "", fmt.Errorf("denom prefix(%s) not equal to expected(%s)", denom, GravityDenomPrefix)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(denom, GravityDenomPrefix), EVMChainDenomSeparator, 2)
+	if len(parts) != 2 {
+		return 0, "", fmt.Errorf("denom(%s) has no chain id", denom)
+	}
+	chainID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || chainID == 0 {
+		return 0, "", fmt.Errorf("invalid chain id in denom(%s)", denom)
+	}
+	if ValidateEthereumAddress(parts[1]) != nil || len(parts[1]) != EthereumContractAddressLen {
+		return 0, "", fmt.Errorf("error validating ethereum contract address")
+	}
+	return chainID, common.HexToAddress(parts[1]).Hex(), nil
+}
+
+// IsVoucherDenom returns whether the denom is the one of vouchers the bridge mints for the
+// tokens of an EVM chain
+func IsVoucherDenom(denom string) bool {
+	if _, err := GravityDenomToERC20(denom); err == nil {
+		return true
+	}
+	if _, _, err := EVMChainGravityDenomToERC20(denom); err == nil {
+		return true
+	}
+	return IsERC1155Denom(denom)
+}
+
 func NormalizeCoinDenom(coin *sdk.Coin) {
 	coin.Denom = NormalizeDenom(coin.Denom)
 }
@@ -94,17 +150,21 @@ func NormalizeDenom(denom string) string {
 	if contract, err := GravityDenomToERC20(denom); err == nil {
 		return GravityDenom(common.HexToAddress(contract))
 	}
+	if chainID, contract, err := EVMChainGravityDenomToERC20(denom); err == nil {
+		return EVMChainGravityDenom(chainID, common.HexToAddress(contract))
+	}
 
 	return denom
 }
 
-func NewSendToEthereumTx(id uint64, tokenContract common.Address, sender sdk.AccAddress, recipient common.Address, amount, feeAmount uint64) *SendToEthereum {
+func NewSendToEthereumTx(chainID uint64, id uint64, tokenContract common.Address, sender sdk.AccAddress, recipient common.Address, amount, feeAmount uint64) *SendToEthereum {
 	return &SendToEthereum{
 		Id:                id,
 		Erc20Fee:          NewERC20Token(feeAmount, tokenContract),
 		Sender:            sender.String(),
 		EthereumRecipient: recipient.Hex(),
 		Erc20Token:        NewERC20Token(amount, tokenContract),
+		EvmChainId:        chainID,
 	}
 }
 
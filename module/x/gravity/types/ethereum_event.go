@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/ethereum/go-ethereum/common"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
@@ -18,6 +19,18 @@ var (
 	_ EthereumEvent = &ContractCallExecutedEvent{}
 	_ EthereumEvent = &ERC20DeployedEvent{}
 	_ EthereumEvent = &SignerSetTxExecutedEvent{}
+	_ EthereumEvent = &SendERC1155ToCosmosEvent{}
+	_ EthereumEvent = &ERC1155BatchExecutedEvent{}
+	_ EthereumEvent = &ContractVersionEvent{}
+)
+
+var (
+	_ EthereumAddressNormalizer = &SendToCosmosEvent{}
+	_ EthereumAddressNormalizer = &BatchExecutedEvent{}
+	_ EthereumAddressNormalizer = &ERC20DeployedEvent{}
+	_ EthereumAddressNormalizer = &SignerSetTxExecutedEvent{}
+	_ EthereumAddressNormalizer = &SendERC1155ToCosmosEvent{}
+	_ EthereumAddressNormalizer = &ERC1155BatchExecutedEvent{}
 )
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -32,17 +45,23 @@ func (m *EthereumEventVoteRecord) UnpackInterfaces(unpacker types.AnyUnpacker) e
 
 func (stce *SendToCosmosEvent) Hash() tmbytes.HexBytes {
 	rcv, _ := sdk.AccAddressFromBech32(stce.CosmosReceiver)
-	path := bytes.Join(
-		[][]byte{
-			sdk.Uint64ToBigEndian(stce.EventNonce),
-			common.HexToAddress(stce.TokenContract).Bytes(),
-			stce.Amount.BigInt().Bytes(),
-			common.Hex2Bytes(stce.EthereumSender),
-			rcv.Bytes(),
-			sdk.Uint64ToBigEndian(stce.EthereumHeight),
-		},
-		[]byte{},
-	)
+	fields := [][]byte{
+		sdk.Uint64ToBigEndian(stce.EventNonce),
+		common.HexToAddress(stce.TokenContract).Bytes(),
+		stce.Amount.BigInt().Bytes(),
+		common.Hex2Bytes(stce.EthereumSender),
+		rcv.Bytes(),
+		sdk.Uint64ToBigEndian(stce.EthereumHeight),
+	}
+	// only routed deposits commit to the forwarding chain so that the hashes of
+	// plain deposits are unchanged
+	if stce.ForwardEvmChainId != 0 {
+		fields = append(fields, sdk.Uint64ToBigEndian(stce.ForwardEvmChainId))
+	}
+	if stce.ForwardIbcChannel != "" {
+		fields = append(fields, []byte(stce.ForwardIbcChannel))
+	}
+	path := bytes.Join(fields, []byte{})
 	hash := sha256.Sum256([]byte(path))
 	return hash[:]
 }
@@ -106,6 +125,54 @@ func (sse *SignerSetTxExecutedEvent) Hash() tmbytes.HexBytes {
 	return hash[:]
 }
 
+func (esce *SendERC1155ToCosmosEvent) Hash() tmbytes.HexBytes {
+	rcv, _ := sdk.AccAddressFromBech32(esce.CosmosReceiver)
+	fields := [][]byte{
+		sdk.Uint64ToBigEndian(esce.EventNonce),
+		common.HexToAddress(esce.TokenContract).Bytes(),
+	}
+	// ids and amounts are padded to their uint256 width so the entries can't run into
+	// each other
+	for _, amount := range esce.Amounts {
+		fields = append(fields, amount.Id.BigInt().FillBytes(make([]byte, 32)), amount.Amount.BigInt().FillBytes(make([]byte, 32)))
+	}
+	fields = append(fields,
+		common.HexToAddress(esce.EthereumSender).Bytes(),
+		rcv.Bytes(),
+		sdk.Uint64ToBigEndian(esce.EthereumHeight),
+	)
+	path := bytes.Join(fields, []byte{})
+	hash := sha256.Sum256([]byte(path))
+	return hash[:]
+}
+
+func (ebee *ERC1155BatchExecutedEvent) Hash() tmbytes.HexBytes {
+	path := bytes.Join(
+		[][]byte{
+			common.HexToAddress(ebee.TokenContract).Bytes(),
+			sdk.Uint64ToBigEndian(ebee.EventNonce),
+			sdk.Uint64ToBigEndian(ebee.BatchNonce),
+			sdk.Uint64ToBigEndian(ebee.EthereumHeight),
+		},
+		[]byte{},
+	)
+	hash := sha256.Sum256([]byte(path))
+	return hash[:]
+}
+
+func (cve *ContractVersionEvent) Hash() tmbytes.HexBytes {
+	path := bytes.Join(
+		[][]byte{
+			sdk.Uint64ToBigEndian(cve.EventNonce),
+			sdk.Uint64ToBigEndian(cve.Version),
+			sdk.Uint64ToBigEndian(cve.EthereumHeight),
+		},
+		[]byte{},
+	)
+	hash := sha256.Sum256([]byte(path))
+	return hash[:]
+}
+
 //////////////
 // Validate //
 //////////////
@@ -114,18 +181,27 @@ func (stce *SendToCosmosEvent) Validate() error {
 	if stce.EventNonce == 0 {
 		return fmt.Errorf("event nonce cannot be 0")
 	}
-	if !common.IsHexAddress(stce.TokenContract) {
+	if ValidateEthereumAddress(stce.TokenContract) != nil {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
 	}
-	if stce.Amount.IsNegative() {
+	if stce.Amount.IsNil() || stce.Amount.IsNegative() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
 	}
-	if !common.IsHexAddress(stce.EthereumSender) {
+	if ValidateEthereumAddress(stce.EthereumSender) != nil {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum sender")
 	}
-	if _, err := sdk.AccAddressFromBech32(stce.CosmosReceiver); err != nil {
+	rcv, err := sdk.AccAddressFromBech32(stce.CosmosReceiver)
+	if err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, stce.CosmosReceiver)
 	}
+	if stce.ForwardEvmChainId != 0 && len(rcv) != common.AddressLength {
+		return sdkerrors.Wrapf(ErrInvalidRecipient, "receiver %s of a forwarded deposit is not an ethereum address", stce.CosmosReceiver)
+	}
+	if stce.ForwardIbcChannel != "" {
+		if err := host.ChannelIdentifierValidator(stce.ForwardIbcChannel); err != nil {
+			return sdkerrors.Wrap(ErrInvalid, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -133,7 +209,7 @@ func (bee *BatchExecutedEvent) Validate() error {
 	if bee.EventNonce == 0 {
 		return fmt.Errorf("event nonce cannot be 0")
 	}
-	if !common.IsHexAddress(bee.TokenContract) {
+	if ValidateEthereumAddress(bee.TokenContract) != nil {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
 	}
 	return nil
@@ -150,7 +226,7 @@ func (e20de *ERC20DeployedEvent) Validate() error {
 	if e20de.EventNonce == 0 {
 		return fmt.Errorf("event nonce cannot be 0")
 	}
-	if !common.IsHexAddress(e20de.TokenContract) {
+	if ValidateEthereumAddress(e20de.TokenContract) != nil {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
 	}
 	if err := sdk.ValidateDenom(e20de.CosmosDenom); err != nil {
@@ -173,3 +249,74 @@ func (sse *SignerSetTxExecutedEvent) Validate() error {
 	}
 	return nil
 }
+
+func (esce *SendERC1155ToCosmosEvent) Validate() error {
+	if esce.EventNonce == 0 {
+		return fmt.Errorf("event nonce cannot be 0")
+	}
+	if ValidateEthereumAddress(esce.TokenContract) != nil {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
+	}
+	if err := validateERC1155Amounts(esce.Amounts); err != nil {
+		return err
+	}
+	if ValidateEthereumAddress(esce.EthereumSender) != nil {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(esce.CosmosReceiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, esce.CosmosReceiver)
+	}
+	return nil
+}
+
+func (ebee *ERC1155BatchExecutedEvent) Validate() error {
+	if ebee.EventNonce == 0 {
+		return fmt.Errorf("event nonce cannot be 0")
+	}
+	if ValidateEthereumAddress(ebee.TokenContract) != nil {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
+	}
+	return nil
+}
+
+func (cve *ContractVersionEvent) Validate() error {
+	if cve.EventNonce == 0 {
+		return fmt.Errorf("event nonce cannot be 0")
+	}
+	if cve.Version == 0 {
+		return fmt.Errorf("contract version cannot be 0")
+	}
+	return nil
+}
+
+///////////////
+// Normalize //
+///////////////
+
+func (stce *SendToCosmosEvent) NormalizeEthereumAddresses() {
+	stce.TokenContract = NormalizeEthereumAddress(stce.TokenContract)
+	stce.EthereumSender = NormalizeEthereumAddress(stce.EthereumSender)
+}
+
+func (bee *BatchExecutedEvent) NormalizeEthereumAddresses() {
+	bee.TokenContract = NormalizeEthereumAddress(bee.TokenContract)
+}
+
+func (e20de *ERC20DeployedEvent) NormalizeEthereumAddresses() {
+	e20de.TokenContract = NormalizeEthereumAddress(e20de.TokenContract)
+}
+
+func (sse *SignerSetTxExecutedEvent) NormalizeEthereumAddresses() {
+	for _, member := range sse.Members {
+		member.EthereumAddress = NormalizeEthereumAddress(member.EthereumAddress)
+	}
+}
+
+func (esce *SendERC1155ToCosmosEvent) NormalizeEthereumAddresses() {
+	esce.TokenContract = NormalizeEthereumAddress(esce.TokenContract)
+	esce.EthereumSender = NormalizeEthereumAddress(esce.EthereumSender)
+}
+
+func (ebee *ERC1155BatchExecutedEvent) NormalizeEthereumAddresses() {
+	ebee.TokenContract = NormalizeEthereumAddress(ebee.TokenContract)
+}
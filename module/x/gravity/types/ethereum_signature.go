@@ -11,6 +11,7 @@ var (
 	_ EthereumTxConfirmation = &SignerSetTxConfirmation{}
 	_ EthereumTxConfirmation = &ContractCallTxConfirmation{}
 	_ EthereumTxConfirmation = &BatchTxConfirmation{}
+	_ EthereumTxConfirmation = &ERC1155BatchTxConfirmation{}
 )
 
 ///////////////
@@ -29,6 +30,10 @@ func (u *BatchTxConfirmation) GetSigner() common.Address {
 	return common.HexToAddress(u.EthereumSigner)
 }
 
+func (u *ERC1155BatchTxConfirmation) GetSigner() common.Address {
+	return common.HexToAddress(u.EthereumSigner)
+}
+
 ///////////////////
 // GetStoreIndex //
 ///////////////////
@@ -45,6 +50,10 @@ func (cctx *ContractCallTxConfirmation) GetStoreIndex() []byte {
 	return MakeContractCallTxKey(cctx.InvalidationScope, cctx.InvalidationNonce)
 }
 
+func (ebtx *ERC1155BatchTxConfirmation) GetStoreIndex() []byte {
+	return MakeERC1155BatchTxKey(common.HexToAddress(ebtx.TokenContract), ebtx.BatchNonce)
+}
+
 //////////////
 // Validate //
 //////////////
@@ -53,7 +62,7 @@ func (u *SignerSetTxConfirmation) Validate() error {
 	if u.SignerSetNonce == 0 {
 		return fmt.Errorf("nonce must be set")
 	}
-	if !common.IsHexAddress(u.EthereumSigner) {
+	if ValidateEthereumAddress(u.EthereumSigner) != nil {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum signer must be address")
 	}
 	if u.Signature == nil {
@@ -69,7 +78,7 @@ func (u *ContractCallTxConfirmation) Validate() error {
 	if u.InvalidationScope == nil {
 		return fmt.Errorf("invalidation scope must be set")
 	}
-	if !common.IsHexAddress(u.EthereumSigner) {
+	if ValidateEthereumAddress(u.EthereumSigner) != nil {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum signer must be address")
 	}
 	if u.Signature == nil {
@@ -82,10 +91,26 @@ func (u *BatchTxConfirmation) Validate() error {
 	if u.BatchNonce == 0 {
 		return fmt.Errorf("nonce must be set")
 	}
-	if !common.IsHexAddress(u.TokenContract) {
+	if ValidateEthereumAddress(u.TokenContract) != nil {
+		return fmt.Errorf("token contract address must be valid ethereum address")
+	}
+	if ValidateEthereumAddress(u.EthereumSigner) != nil {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum signer must be address")
+	}
+	if u.Signature == nil {
+		return fmt.Errorf("signature must be set")
+	}
+	return nil
+}
+
+func (u *ERC1155BatchTxConfirmation) Validate() error {
+	if u.BatchNonce == 0 {
+		return fmt.Errorf("nonce must be set")
+	}
+	if ValidateEthereumAddress(u.TokenContract) != nil {
 		return fmt.Errorf("token contract address must be valid ethereum address")
 	}
-	if !common.IsHexAddress(u.EthereumSigner) {
+	if ValidateEthereumAddress(u.EthereumSigner) != nil {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum signer must be address")
 	}
 	if u.Signature == nil {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"crypto/ecdsa"
+	"math/big"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
@@ -21,47 +22,50 @@ func NewEthereumSignature(hash []byte, privateKey *ecdsa.PrivateKey) ([]byte, er
 	return crypto.Sign(protectedHash.Bytes(), privateKey)
 }
 
+// secp256k1HalfN is half the order of the secp256k1 curve, the highest s of a signature in
+// its canonical form
+var secp256k1HalfN = new(big.Int).Rsh(crypto.S256().Params().N, 1)
+
 // ValidateEthereumSignature takes a message, an associated signature and public key and
 // returns an error if the signature isn't valid
 func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.Address) error {
+	protectedHash := crypto.Keccak256Hash(append([]byte(signaturePrefix), hash...))
+	return validateEthereumSigner(protectedHash.Bytes(), signature, ethAddress)
+}
 
-	/// signature to public key: invalid signature length: invalid
-	/// signature not matching: invalid: invalid
-	if len(signature) < 65 {
-		return sdkerrors.Wrapf(ErrInvalid, "signature too short signature %x", signature)
+// validateEthereumSigner returns an error unless the signature of the digest is the Ethereum
+// address', with an error code for each way it can fail: a signature that isn't 65 bytes or
+// whose r or s are out of range, a recovery id other than 0 or 1 or their Ethereum encoding
+// as 27 or 28, an s in the upper half of the curve order and a signature of another key.
+// Recovering the signer of a signature with a high s gives the same address as its canonical
+// form, so accepting both would let anyone submit a second valid signature of a signer.
+func validateEthereumSigner(digest []byte, signature []byte, ethAddress common.Address) error {
+	if len(signature) != crypto.SignatureLength {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "signature of %d bytes %x", len(signature), signature)
 	}
 
-	// Copy to avoid mutating signature slice by accident
-	var sigCopy = make([]byte, len(signature))
-	copy(sigCopy, signature)
-
-	// To verify signature
-	// - use crypto.SigToPub to get the public key
-	// - use crypto.PubkeyToAddress to get the address
-	// - compare this to the address given.
-
-	// for backwards compatibility reasons  the V value of an Ethereum sig is presented
-	// as 27 or 28, internally though it should be a 0-3 value due to changed formats.
-	// It seems that go-ethereum expects this to be done before sigs actually reach it's
-	// internal validation functions. In order to comply with this requirement we check
-	// the sig an dif it's in standard format we correct it. If it's in go-ethereum's expected
-	// format already we make no changes.
-	//
-	// We could attempt to break or otherwise exit early on obviously invalid values for this
-	// byte, but that's a task best left to go-ethereum
-	if sigCopy[64] == 27 || sigCopy[64] == 28 {
-		sigCopy[64] -= 27
+	v := signature[crypto.RecoveryIDOffset]
+	if v == 27 || v == 28 {
+		v -= 27
+	}
+	if v > 1 {
+		return sdkerrors.Wrapf(ErrInvalidRecoveryID, "recovery id %d of signature %x", signature[crypto.RecoveryIDOffset], signature)
+	}
+	r, sv := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:64])
+	if sv.Cmp(secp256k1HalfN) > 0 {
+		return sdkerrors.Wrapf(ErrMalleableSignature, "signature %x", signature)
+	}
+	if !crypto.ValidateSignatureValues(v, r, sv, true) {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "r or s out of range in signature %x", signature)
 	}
 
-	hash = append([]uint8(signaturePrefix), hash...)
-
-	pubkey, err := crypto.SigToPub(crypto.Keccak256Hash(hash).Bytes(), sigCopy)
+	// the copy has the recovery id go-ethereum expects, leaving the signature unchanged
+	pubkey, err := crypto.SigToPub(digest, append(signature[:64:64], v))
 	if err != nil {
-		return sdkerrors.Wrapf(err, "signature to public key sig %x hash %x", sigCopy, hash)
+		return sdkerrors.Wrapf(ErrInvalidSignature, "signature to public key sig %x digest %x: %s", signature, digest, err)
 	}
-
 	if addr := crypto.PubkeyToAddress(*pubkey); addr != ethAddress {
-		return sdkerrors.Wrapf(ErrInvalid, "signature not matching addr %x sig %x hash %x", addr, signature, hash)
+		return sdkerrors.Wrapf(ErrSignerMismatch, "signature of %s, not %s, sig %x digest %x", addr.Hex(), ethAddress.Hex(), signature, digest)
 	}
 
 	return nil
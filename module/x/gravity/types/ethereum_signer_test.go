@@ -2,9 +2,11 @@ package types
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -89,3 +91,47 @@ func TestSignerSetConfirmSig(t *testing.T) {
 		})
 	}
 }
+
+func TestEthereumSignatureErrorCodes(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	hash := crypto.Keccak256([]byte("checkpoint"))
+
+	signature, err := NewEthereumSignature(hash, key)
+	require.NoError(t, err)
+	require.NoError(t, ValidateEthereumSignature(hash, signature, address))
+
+	// the Ethereum encoding of the recovery id is accepted too
+	ethereumV := append([]byte(nil), signature...)
+	ethereumV[64] += 27
+	require.NoError(t, ValidateEthereumSignature(hash, ethereumV, address))
+
+	// the malleated copy, (r, n - s) with the other recovery id, recovers the same key
+	n := crypto.S256().Params().N
+	malleated := append([]byte(nil), signature...)
+	new(big.Int).Sub(n, new(big.Int).SetBytes(signature[32:64])).FillBytes(malleated[32:64])
+	malleated[64] ^= 1
+	require.ErrorIs(t, ValidateEthereumSignature(hash, malleated, address), ErrMalleableSignature)
+
+	badV := append([]byte(nil), signature...)
+	badV[64] = 29
+	require.ErrorIs(t, ValidateEthereumSignature(hash, badV, address), ErrInvalidRecoveryID)
+
+	require.ErrorIs(t, ValidateEthereumSignature(hash, append(signature, 0), address), ErrInvalidSignature)
+	require.ErrorIs(t, ValidateEthereumSignature(hash, make([]byte, 65), address), ErrInvalidSignature)
+
+	otherSignature, err := NewEthereumSignature(hash, otherKey)
+	require.NoError(t, err)
+	require.ErrorIs(t, ValidateEthereumSignature(hash, otherSignature, address), ErrSignerMismatch)
+
+	// typed data signatures are held to the same checks
+	typedSignature, err := NewTypedDataSignature(hash, key)
+	require.NoError(t, err)
+	require.NoError(t, ValidateTypedDataSignature(hash, typedSignature, address))
+	new(big.Int).Sub(n, new(big.Int).SetBytes(typedSignature[32:64])).FillBytes(typedSignature[32:64])
+	typedSignature[64] ^= 1
+	require.ErrorIs(t, ValidateTypedDataSignature(hash, typedSignature, address), ErrMalleableSignature)
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
 const (
 	EventTypeObservation              = "observation"
 	EventTypeOutgoingBatch            = "outgoing_batch"
@@ -8,7 +15,33 @@ const (
 	EventTypeContractCallTxCanceled   = "outgoing_logic_call_canceled"
 	EventTypeBridgeWithdrawalReceived = "withdrawal_received"
 	EventTypeBridgeDepositReceived    = "deposit_received"
+	EventTypeBridgeDepositForwarded   = "deposit_forwarded"
+	EventTypeBridgeDepositCompleted   = "deposit_completed"
+	EventTypeBridgeDepositReturned    = "deposit_returned"
 	EventTypeBridgeWithdrawCanceled   = "withdraw_canceled"
+	EventTypeContractMigration        = "contract_migration"
+	EventTypeBridgingEpoch            = "bridging_epoch"
+	EventTypeEVMChainPause            = "evm_chain_pause"
+	EventTypeGravityIDRotation        = "gravity_id_rotation"
+	EventTypeDepositAddress           = "deposit_address"
+	EventTypeOutgoingERC1155Batch     = "outgoing_erc1155_batch"
+	EventTypeERC1155BatchCanceled     = "outgoing_erc1155_batch_canceled"
+	EventTypeVoucherTrace             = "voucher_trace"
+	EventTypeParamsUpdated            = "params_updated"
+	EventTypeRelayerIncentive         = "relayer_incentive"
+	EventTypeIncentiveDisbursed       = "relayer_incentive_disbursed"
+	EventTypeEventVoteRecordRejected  = "ethereum_event_vote_record_rejected"
+	EventTypeRateLimitsUpdated        = "rate_limits_updated"
+	EventTypeFeeFloorsUpdated         = "fee_floors_updated"
+	EventTypeContractVersion          = "contract_version"
+	EventTypeVouchersMinted           = "vouchers_minted"
+	EventTypeVouchersBurned           = "vouchers_burned"
+	EventTypeParamsUpdateScheduled    = "params_update_scheduled"
+	EventTypeOutgoingTxVetoed         = "outgoing_tx_vetoed"
+	EventTypeBridgeReport             = "bridge_report"
+	EventTypeBridgeStateHash          = "bridge_state_hash"
+	EventTypeEventVoteGrandfathered   = "ethereum_event_vote_record_grandfathered"
+	EventTypeEventVoteExpired         = "ethereum_event_vote_record_expired"
 
 	AttributeKeyEthereumEventVoteRecordID     = "ethereum_event_vote_record_id"
 	AttributeKeyBatchConfirmKey               = "batch_confirm_key"
@@ -32,4 +65,49 @@ const (
 	AttributeKeyContractCallAddress           = "contract_call_address"
 	AttributeKeyEthTxTimeout                  = "eth_tx_timeout"
 	AttributeMissingBridgeBatchSig            = "missing_bridge_batch_signature"
+	AttributeKeyNewContract                   = "new_bridge_contract"
+	AttributeKeyPreviousContract              = "previous_bridge_contract"
+	AttributeKeyBridgingEpoch                 = "bridging_epoch"
+	AttributeKeyEthereumHeight                = "ethereum_height"
+	AttributeKeyPaused                        = "paused"
+	AttributeKeyGravityID                     = "gravity_id"
+	AttributeKeyPreviousGravityID             = "previous_gravity_id"
+	AttributeKeyAcceptanceEndHeight           = "acceptance_end_height"
+	AttributeKeyRecipient                     = "recipient"
+	AttributeKeyDepositAddress                = "deposit_address"
+	AttributeKeyIBCChannel                    = "ibc_channel"
+	AttributeKeyDenom                         = "denom"
+	AttributeKeyDenomTrace                    = "denom_trace"
+	AttributeKeyTokenContract                 = "token_contract"
+	AttributeKeyPacketSequence                = "packet_sequence"
+	AttributeKeySuccess                       = "success"
+	AttributeKeyError                         = "error"
+	AttributeKeyAuthority                     = "authority"
+	AttributeKeyRelayerIncentiveID            = "relayer_incentive_id"
+	AttributeKeyAmount                        = "amount"
+	AttributeKeyContractVersion               = "contract_version"
+	AttributeKeyIncidentID                    = "incident_id"
+	AttributeKeyAccount                       = "account"
+	AttributeKeyReason                        = "reason"
+	AttributeKeyEffectiveHeight               = "effective_height"
+	AttributeKeyVetoCouncil                   = "veto_council"
+	AttributeKeyBridgeReportID                = "bridge_report_id"
+	AttributeKeyStartHeight                   = "start_height"
+	AttributeKeyEndHeight                     = "end_height"
+	AttributeKeyHeight                        = "height"
+	AttributeKeyBridgeStateHash               = "hash"
+	AttributeKeySender                        = "sender"
 )
+
+// EthereumAddressAttribute returns the attribute of an Ethereum address, which all events
+// give in lowercase hex so that tx_search queries match them whatever the casing of the
+// address they were given. Cosmos addresses are given in bech32.
+func EthereumAddressAttribute(key, address string) sdk.Attribute {
+	return sdk.NewAttribute(key, strings.ToLower(address))
+}
+
+// EthereumEventTypeAttribute returns the attribute of the type of an Ethereum event, its
+// protobuf message name
+func EthereumEventTypeAttribute(event EthereumEvent) sdk.Attribute {
+	return sdk.NewAttribute(AttributeKeyEthereumEventType, proto.MessageName(event))
+}
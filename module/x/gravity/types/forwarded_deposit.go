@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// ValidateBasic performs stateless checks
+func (d ForwardedDeposit) ValidateBasic() error {
+	if err := host.ChannelIdentifierValidator(d.ChannelId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid channel %s", d.ChannelId)
+	}
+	if d.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "zero packet sequence")
+	}
+	if !d.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s", d.Amount)
+	}
+	return nil
+}
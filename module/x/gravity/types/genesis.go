@@ -7,8 +7,10 @@ import (
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -65,6 +67,33 @@ var (
 	//  ParamStoreUnbondSlashingSignerSetTxsWindow stores unbond slashing valset window
 	ParamStoreUnbondSlashingSignerSetTxsWindow = []byte("UnbondSlashingSignerSetTxsWindow")
 
+	// ParamsStoreKeyMinimumEthereumConfirmations stores the minimum confirmations for ethereum events
+	ParamsStoreKeyMinimumEthereumConfirmations = []byte("MinimumEthereumConfirmations")
+
+	// ParamsStoreKeyEthereumFinality stores when events of the default chain are final
+	ParamsStoreKeyEthereumFinality = []byte("EthereumFinality")
+
+	// ParamsStoreKeyEthereumFeeFloors stores the minimum bridge fees of the default chain
+	ParamsStoreKeyEthereumFeeFloors = []byte("EthereumFeeFloors")
+
+	// ParamsStoreKeyEthereumDepositAddressFactory stores the deposit address factory of the default chain
+	ParamsStoreKeyEthereumDepositAddressFactory = []byte("EthereumDepositAddressFactory")
+
+	// ParamsStoreKeyEthereumTokenDecimals stores the scaling of denoms bridged to the default chain
+	ParamsStoreKeyEthereumTokenDecimals = []byte("EthereumTokenDecimals")
+
+	// ParamsStoreKeyEthereumNativeDecimals stores the decimals of the default chain's gas token
+	ParamsStoreKeyEthereumNativeDecimals = []byte("EthereumNativeDecimals")
+
+	// ParamsStoreKeyEthereumRateLimits stores the rate limits of the default chain
+	ParamsStoreKeyEthereumRateLimits = []byte("EthereumRateLimits")
+
+	// ParamsStoreKeyIBCForwardChannels stores the IBC channels deposits may be forwarded on
+	ParamsStoreKeyIBCForwardChannels = []byte("IBCForwardChannels")
+
+	// ParamsStoreKeyLogicCallTemplates stores the logic calls remote chains may make over IBC
+	ParamsStoreKeyLogicCallTemplates = []byte("LogicCallTemplates")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -87,6 +116,32 @@ func (gs *GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, chain := range gs.EvmChains {
+		if err := chain.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cs *EVMChainGenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, otx := range cs.OutgoingTxs {
+		var outgoing OutgoingTx
+		if err := unpacker.UnpackAny(otx, &outgoing); err != nil {
+			return err
+		}
+	}
+	for _, sig := range cs.Confirmations {
+		var signature EthereumTxConfirmation
+		if err := unpacker.UnpackAny(sig, &signature); err != nil {
+			return err
+		}
+	}
+	for _, evr := range cs.EthereumEventVoteRecords {
+		if err := evr.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -107,6 +162,181 @@ func (s GenesisState) ValidateBasic() error {
 			}
 		}
 	}
+	for _, depositAddress := range s.DepositAddresses {
+		if err := depositAddress.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "deposit addresses")
+		}
+	}
+	if err := validateChainCounters(s.LastEventNonces, s.EthereumHeightVotes, s.RateLimitUsages); err != nil {
+		return err
+	}
+	if err := validateCheckpointVersions(s.OutgoingTxs); err != nil {
+		return sdkerrors.Wrap(err, "outgoing txs")
+	}
+	if bootstrap := s.ContractBootstrap; bootstrap != nil {
+		if err := bootstrap.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "contract bootstrap")
+		}
+	}
+	if latency := s.AttestationLatency; latency != nil {
+		if err := latency.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "attestation latency")
+		}
+	}
+	if report := s.CurrentBridgeReport; report != nil && report.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "current bridge report id cannot be zero")
+	}
+	for _, token := range s.Erc1155Tokens {
+		if err := token.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "erc1155 tokens")
+		}
+	}
+	for _, send := range s.UnbatchedSendErc1155ToEthereumTxs {
+		if err := send.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "unbatched erc1155 transfers")
+		}
+	}
+	for _, deposit := range s.ForwardedDeposits {
+		if err := deposit.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "forwarded deposits")
+		}
+	}
+	seenIncentiveIDs := map[uint64]bool{}
+	for _, incentive := range s.RelayerIncentives {
+		if err := incentive.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "relayer incentives")
+		}
+		if seenIncentiveIDs[incentive.Id] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate relayer incentive id %d", incentive.Id)
+		}
+		seenIncentiveIDs[incentive.Id] = true
+	}
+	seenIncidentIDs := map[uint64]bool{}
+	for _, record := range s.IncidentRecords {
+		if err := record.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "incident records")
+		}
+		if seenIncidentIDs[record.Id] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate incident record id %d", record.Id)
+		}
+		seenIncidentIDs[record.Id] = true
+	}
+	seenReportIDs := map[uint64]bool{}
+	for _, report := range s.BridgeReports {
+		if err := report.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "bridge reports")
+		}
+		if seenReportIDs[report.Id] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate bridge report id %d", report.Id)
+		}
+		seenReportIDs[report.Id] = true
+	}
+	if update := s.ScheduledParamsUpdate; update != nil {
+		if update.Height == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "scheduled params update height cannot be zero")
+		}
+		if err := update.Params.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "scheduled params update")
+		}
+	}
+	seenChainIDs := map[uint64]bool{s.Params.BridgeChainId: true}
+	for _, chain := range s.EvmChains {
+		if err := chain.Chain.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "evm chains")
+		}
+		for _, depositAddress := range chain.DepositAddresses {
+			if err := depositAddress.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(err, "evm chain deposit addresses")
+			}
+		}
+		if err := validateChainCounters(chain.LastEventNonces, chain.EthereumHeightVotes, chain.RateLimitUsages); err != nil {
+			return sdkerrors.Wrapf(err, "evm chain %d", chain.Chain.ChainId)
+		}
+		if err := validateCheckpointVersions(chain.OutgoingTxs); err != nil {
+			return sdkerrors.Wrapf(err, "evm chain %d outgoing txs", chain.Chain.ChainId)
+		}
+		if bootstrap := chain.ContractBootstrap; bootstrap != nil {
+			if err := bootstrap.ValidateBasic(); err != nil {
+				return sdkerrors.Wrapf(err, "evm chain %d contract bootstrap", chain.Chain.ChainId)
+			}
+		}
+		if latency := chain.AttestationLatency; latency != nil {
+			if err := latency.ValidateBasic(); err != nil {
+				return sdkerrors.Wrapf(err, "evm chain %d attestation latency", chain.Chain.ChainId)
+			}
+		}
+		for _, send := range chain.UnbatchedSendErc1155ToEthereumTxs {
+			if err := send.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(err, "evm chain unbatched erc1155 transfers")
+			}
+		}
+		if seenChainIDs[chain.Chain.ChainId] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate evm chain id %d", chain.Chain.ChainId)
+		}
+		seenChainIDs[chain.Chain.ChainId] = true
+	}
+	return nil
+}
+
+// validateChainCounters checks the validators and tokens the per validator nonces and heights
+// and the rate limit usages of an EVM chain are kept for
+func validateChainCounters(lastEventNonces []ValidatorEventNonce, heightVotes []EthereumHeightVote, usages []TokenRateLimitUsage) error {
+	for _, lastEventNonce := range lastEventNonces {
+		if _, err := sdk.ValAddressFromBech32(lastEventNonce.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(err, "last event nonces")
+		}
+	}
+	for _, vote := range heightVotes {
+		if _, err := sdk.ValAddressFromBech32(vote.ValidatorAddress); err != nil {
+			return sdkerrors.Wrap(err, "ethereum height votes")
+		}
+	}
+	for _, usage := range usages {
+		if ValidateEthereumAddress(usage.TokenContract) != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "rate limit usage token contract %s", usage.TokenContract)
+		}
+		if usage.Usage.Amount.IsNil() || usage.Usage.Amount.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalid, "rate limit usage amount of %s", usage.TokenContract)
+		}
+	}
+	return nil
+}
+
+// validateCheckpointVersions checks that the outgoing txs are encoded with a registered
+// checkpoint version, or unversioned for those created before checkpoints were versioned, so
+// that a genesis can't import txs whose checkpoints would panic the keeper
+func validateCheckpointVersions(otxs []*cdctypes.Any) error {
+	for _, packed := range otxs {
+		otx, ok := packed.GetCachedValue().(OutgoingTx)
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "outgoing tx %s not unpacked", packed.TypeUrl)
+		}
+		if _, err := GetCheckpointEncoder(otx.GetCheckpointVersion()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks on the state of the contract to take over
+func (b ContractBootstrap) ValidateBasic() error {
+	if b.EthereumHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum height cannot be zero")
+	}
+	seen := map[common.Address]bool{}
+	for _, token := range b.Tokens {
+		if ValidateEthereumAddress(token.Contract) != nil {
+			return sdkerrors.Wrapf(ErrInvalid, "token contract %s", token.Contract)
+		}
+		if token.Balance.IsNil() || token.Balance.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalid, "balance of %s", token.Contract)
+		}
+		contract := common.HexToAddress(token.Contract)
+		if seen[contract] {
+			return sdkerrors.Wrapf(ErrInvalid, "duplicate token contract %s", token.Contract)
+		}
+		seen[contract] = true
+	}
 	return nil
 }
 
@@ -134,6 +364,22 @@ func DefaultParams() *Params {
 		SlashFractionEthereumSignature:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		SlashFractionConflictingEthereumSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		UnbondSlashingSignerSetTxsWindow:          10000,
+		MinimumEthereumConfirmations:              0,
+		EthereumFinality:                          FinalityConfirmations,
+		EthereumFeeFloors:                         []FeeFloor{},
+		EthereumDepositAddressFactory:             "",
+		EthereumTokenDecimals:                     []TokenDecimals{},
+		EthereumNativeDecimals:                    0,
+		EthereumRateLimits:                        []RateLimit{},
+		IbcForwardChannels:                        []IBCForwardChannel{},
+		LogicCallTemplates:                        []LogicCallTemplate{},
+		BridgeAdmin:                               BridgeAdmin{},
+		MinimumContractVersions:                   []MinimumContractVersion{},
+		VetoCouncil:                               VetoCouncil{},
+		BridgeReportPeriod:                        17280,
+		PoolLimits:                                PoolLimits{},
+		SignatureScheme:                           SignatureSchemeECDSA,
+		CheckpointVersionActivations:              []CheckpointVersionActivation{},
 	}
 }
 
@@ -184,6 +430,59 @@ func (p Params) ValidateBasic() error {
 	if err := validateUnbondSlashingSignerSetTxsWindow(p.UnbondSlashingSignerSetTxsWindow); err != nil {
 		return sdkerrors.Wrap(err, "unbond slashing signersettx window")
 	}
+	if err := validateMinimumEthereumConfirmations(p.MinimumEthereumConfirmations); err != nil {
+		return sdkerrors.Wrap(err, "minimum ethereum confirmations")
+	}
+	if err := validateEthereumFinality(p.EthereumFinality); err != nil {
+		return sdkerrors.Wrap(err, "ethereum finality")
+	}
+	if err := validateFinality(p.EthereumFinality, p.MinimumEthereumConfirmations); err != nil {
+		return sdkerrors.Wrap(err, "ethereum finality")
+	}
+	if err := validateEthereumFeeFloors(p.EthereumFeeFloors); err != nil {
+		return sdkerrors.Wrap(err, "ethereum fee floors")
+	}
+	if err := validateDepositAddressFactory(p.EthereumDepositAddressFactory); err != nil {
+		return sdkerrors.Wrap(err, "ethereum deposit address factory")
+	}
+	if err := validateEthereumTokenDecimals(p.EthereumTokenDecimals); err != nil {
+		return sdkerrors.Wrap(err, "ethereum token decimals")
+	}
+	if err := validateEthereumNativeDecimals(p.EthereumNativeDecimals); err != nil {
+		return sdkerrors.Wrap(err, "ethereum native decimals")
+	}
+	if err := validateEthereumRateLimits(p.EthereumRateLimits); err != nil {
+		return sdkerrors.Wrap(err, "ethereum rate limits")
+	}
+	if err := validateIBCForwardChannels(p.IbcForwardChannels); err != nil {
+		return sdkerrors.Wrap(err, "ibc forward channels")
+	}
+	if err := validateLogicCallTemplates(p.LogicCallTemplates); err != nil {
+		return sdkerrors.Wrap(err, "logic call templates")
+	}
+	if err := p.BridgeAdmin.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "bridge admin")
+	}
+	if err := validateMinimumContractVersions(p.MinimumContractVersions); err != nil {
+		return sdkerrors.Wrap(err, "minimum contract versions")
+	}
+	if err := p.VetoCouncil.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "veto council")
+	}
+	// validators are slashed for outgoing txs left unsigned for the window, which must
+	// leave them time to sign once the veto delay has passed
+	if p.VetoCouncil.Address != "" && p.VetoCouncil.VetoDelay >= p.SignedBatchesWindow {
+		return sdkerrors.Wrapf(ErrInvalid, "veto delay %d isn't less than the signed batches window %d", p.VetoCouncil.VetoDelay, p.SignedBatchesWindow)
+	}
+	if err := p.PoolLimits.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "pool limits")
+	}
+	if _, err := GetSignatureScheme(p.SignatureScheme); err != nil {
+		return sdkerrors.Wrap(err, "signature scheme")
+	}
+	if err := ValidateCheckpointVersionActivations(p.CheckpointVersionActivations); err != nil {
+		return sdkerrors.Wrap(err, "checkpoint version activations")
+	}
 
 	return nil
 }
@@ -212,6 +511,15 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionEthereumSignature, &p.SlashFractionEthereumSignature, validateSlashFractionEthereumSignature),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingEthereumSignature, &p.SlashFractionConflictingEthereumSignature, validateSlashFractionConflictingEthereumSignature),
 		paramtypes.NewParamSetPair(ParamStoreUnbondSlashingSignerSetTxsWindow, &p.UnbondSlashingSignerSetTxsWindow, validateUnbondSlashingSignerSetTxsWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMinimumEthereumConfirmations, &p.MinimumEthereumConfirmations, validateMinimumEthereumConfirmations),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEthereumFinality, &p.EthereumFinality, validateEthereumFinality),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEthereumFeeFloors, &p.EthereumFeeFloors, validateEthereumFeeFloors),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEthereumDepositAddressFactory, &p.EthereumDepositAddressFactory, validateDepositAddressFactory),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEthereumTokenDecimals, &p.EthereumTokenDecimals, validateEthereumTokenDecimals),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEthereumNativeDecimals, &p.EthereumNativeDecimals, validateEthereumNativeDecimals),
+		paramtypes.NewParamSetPair(ParamsStoreKeyEthereumRateLimits, &p.EthereumRateLimits, validateEthereumRateLimits),
+		paramtypes.NewParamSetPair(ParamsStoreKeyIBCForwardChannels, &p.IbcForwardChannels, validateIBCForwardChannels),
+		paramtypes.NewParamSetPair(ParamsStoreKeyLogicCallTemplates, &p.LogicCallTemplates, validateLogicCallTemplates),
 	}
 }
 
@@ -228,6 +536,26 @@ func (p Params) Equal(p2 Params) bool {
 	return bytes.Equal(pb, p2b)
 }
 
+// GetIBCForwardChannel returns the IBC channel with the id if deposits may be forwarded on it
+func (p Params) GetIBCForwardChannel(channelID string) (IBCForwardChannel, bool) {
+	for _, channel := range p.IbcForwardChannels {
+		if channel.ChannelId == channelID {
+			return channel, true
+		}
+	}
+	return IBCForwardChannel{}, false
+}
+
+// GetLogicCallTemplate returns the logic call template with the name
+func (p Params) GetLogicCallTemplate(name string) (LogicCallTemplate, bool) {
+	for _, template := range p.LogicCallTemplates {
+		if template.Name == name {
+			return template, true
+		}
+	}
+	return LogicCallTemplate{}, false
+}
+
 func validateGravityID(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -290,7 +618,7 @@ func validateBridgeContractAddress(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if !common.IsHexAddress(v) {
+	if ValidateEthereumAddress(v) != nil {
 		return fmt.Errorf("not an ethereum address: %s", v)
 	}
 	return nil
@@ -312,6 +640,141 @@ func validateUnbondSlashingSignerSetTxsWindow(i interface{}) error {
 	return nil
 }
 
+func validateMinimumEthereumConfirmations(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEthereumFinality(i interface{}) error {
+	v, ok := i.(Finality)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, known := Finality_name[int32(v)]; !known {
+		return fmt.Errorf("unknown finality %d", v)
+	}
+	return nil
+}
+
+func validateEthereumFeeFloors(i interface{}) error {
+	v, ok := i.([]FeeFloor)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateFeeFloors(v)
+}
+
+func validateEthereumTokenDecimals(i interface{}) error {
+	v, ok := i.([]TokenDecimals)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateTokenDecimals(v)
+}
+
+func validateEthereumNativeDecimals(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateNativeDecimals(v)
+}
+
+func validateEthereumRateLimits(i interface{}) error {
+	v, ok := i.([]RateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateRateLimits(v)
+}
+
+func validateIBCForwardChannels(i interface{}) error {
+	v, ok := i.([]IBCForwardChannel)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, channel := range v {
+		if err := host.ChannelIdentifierValidator(channel.ChannelId); err != nil {
+			return err
+		}
+		if seen[channel.ChannelId] {
+			return fmt.Errorf("duplicate ibc forward channel %s", channel.ChannelId)
+		}
+		seen[channel.ChannelId] = true
+		if channel.Bech32Prefix == "" {
+			return fmt.Errorf("empty bech32 prefix of ibc forward channel %s", channel.ChannelId)
+		}
+		if _, err := bech32.ConvertAndEncode(channel.Bech32Prefix, make([]byte, common.AddressLength)); err != nil {
+			return fmt.Errorf("invalid bech32 prefix %q of ibc forward channel %s", channel.Bech32Prefix, channel.ChannelId)
+		}
+		if channel.Timeout == 0 {
+			return fmt.Errorf("invalid timeout of ibc forward channel %s", channel.ChannelId)
+		}
+		if channel.CallbackChannelId != "" {
+			if err := host.ChannelIdentifierValidator(channel.CallbackChannelId); err != nil {
+				return fmt.Errorf("invalid callback channel of ibc forward channel %s: %w", channel.ChannelId, err)
+			}
+		} else if channel.CallbackContract != "" {
+			return fmt.Errorf("callback contract without callback channel of ibc forward channel %s", channel.ChannelId)
+		}
+	}
+	return nil
+}
+
+func validateLogicCallTemplates(i interface{}) error {
+	v, ok := i.([]LogicCallTemplate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, template := range v {
+		if template.Name == "" {
+			return fmt.Errorf("empty logic call template name")
+		}
+		if seen[template.Name] {
+			return fmt.Errorf("duplicate logic call template %s", template.Name)
+		}
+		seen[template.Name] = true
+		if ValidateEthereumAddress(template.LogicContract) != nil {
+			return fmt.Errorf("invalid logic contract %s of logic call template %s", template.LogicContract, template.Name)
+		}
+	}
+	return nil
+}
+
+func validateMinimumContractVersions(minimums []MinimumContractVersion) error {
+	seen := make(map[ContractFeature]bool, len(minimums))
+	for _, minimum := range minimums {
+		if _, known := ContractFeature_name[int32(minimum.Feature)]; !known || minimum.Feature == ContractFeatureUnspecified {
+			return fmt.Errorf("unknown contract feature %d", minimum.Feature)
+		}
+		if seen[minimum.Feature] {
+			return fmt.Errorf("duplicate minimum contract version for %s", minimum.Feature)
+		}
+		seen[minimum.Feature] = true
+		if minimum.Version == 0 {
+			return fmt.Errorf("invalid minimum contract version for %s", minimum.Feature)
+		}
+	}
+	return nil
+}
+
+// validateDepositAddressFactory allows the factory to be unset, deposit addresses can't be
+// requested for the chain then
+func validateDepositAddressFactory(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v != "" && ValidateEthereumAddress(v) != nil {
+		return fmt.Errorf("not an ethereum address: %s", v)
+	}
+	return nil
+}
+
 func validateSlashFractionSignerSetTx(i interface{}) error {
 	// TODO: do we want to set some bounds on this value?
 	if _, ok := i.(sdk.Dec); !ok {
@@ -25,98 +25,73 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Params represent the Gravity genesis and store parameters
-// gravity_id:
-// a random 32 byte value to prevent signature reuse, for example if the
-// cosmos validators decided to use the same Ethereum keys for another chain
-// also running Gravity we would not want it to be possible to play a deposit
-// from chain A back on chain B's Gravity. This value IS USED ON ETHEREUM so
-// it must be set in your genesis.json before launch and not changed after
-// deploying Gravity
-//
-// contract_hash:
-// the code hash of a known good version of the Gravity contract
-// solidity code. This can be used to verify the correct version
-// of the contract has been deployed. This is a reference value for
-// goernance action only it is never read by any Gravity code
-//
-// bridge_ethereum_address:
-// is address of the bridge contract on the Ethereum side, this is a
-// reference value for governance only and is not actually used by any
-// Gravity code
-//
-// bridge_chain_id:
-// the unique identifier of the Ethereum chain, this is a reference value
-// only and is not actually used by any Gravity code
-//
-// These reference values may be used by future Gravity client implemetnations
-// to allow for saftey features or convenience features like the Gravity address
-// in your relayer. A relayer would require a configured Gravity address if
-// governance had not set the address on the chain it was relaying for.
-//
-// signed_signer_set_txs_window
-// signed_batches_window
-// signed_ethereum_signatures_window
-//
-// These values represent the time in blocks that a validator has to submit
-// a signature for a batch or valset, or to submit a ethereum_signature for a
-// particular attestation nonce. In the case of attestations this clock starts
-// when the attestation is created, but only allows for slashing once the event
-// has passed
-//
-// target_eth_tx_timeout:
-//
-// This is the 'target' value for when ethereum transactions time out, this is a
-// target because Ethereum is a probabilistic chain and you can't say for sure
-// what the block frequency is ahead of time.
-//
-// average_block_time
-// average_ethereum_block_time
-//
-// These values are the average Cosmos block time and Ethereum block time
-// respectively and they are used to compute what the target batch timeout is.
-// It is important that governance updates these in case of any major, prolonged
-// change in the time it takes to produce a block
-//
-// slash_fraction_signer_set_tx
-// slash_fraction_batch
-// slash_fraction_ethereum_signature
-// slash_fraction_conflicting_ethereum_signature
-//
-// The slashing fractions for the various gravity related slashing conditions.
-// The first three refer to not submitting a particular message, the third for
-// submitting a different ethereum_signature for the same Ethereum event
-type Params struct {
-	GravityId                string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
-	ContractSourceHash       string `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress    string `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId            uint64 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedSignerSetTxsWindow uint64 `protobuf:"varint,6,opt,name=signed_signer_set_txs_window,json=signedSignerSetTxsWindow,proto3" json:"signed_signer_set_txs_window,omitempty"`
-	SignedBatchesWindow      uint64 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	EthereumSignaturesWindow uint64 `protobuf:"varint,8,opt,name=ethereum_signatures_window,json=ethereumSignaturesWindow,proto3" json:"ethereum_signatures_window,omitempty"`
-	TargetEthTxTimeout       uint64 `protobuf:"varint,10,opt,name=target_eth_tx_timeout,json=targetEthTxTimeout,proto3" json:"target_eth_tx_timeout,omitempty"`
-	AverageBlockTime         uint64 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime uint64 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	// TODO: slash fraction for contract call txs too
-	SlashFractionSignerSetTx                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_signer_set_tx,json=slashFractionSignerSetTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_signer_set_tx"`
-	SlashFractionBatch                        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
-	SlashFractionEthereumSignature            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_ethereum_signature,json=slashFractionEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_ethereum_signature"`
-	SlashFractionConflictingEthereumSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_ethereum_signature,json=slashFractionConflictingEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_ethereum_signature"`
-	UnbondSlashingSignerSetTxsWindow          uint64                                 `protobuf:"varint,17,opt,name=unbond_slashing_signer_set_txs_window,json=unbondSlashingSignerSetTxsWindow,proto3" json:"unbond_slashing_signer_set_txs_window,omitempty"`
-}
-
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
+// GenesisState struct
+// TODO: this need to be audited and potentially simplified using the new
+// interfaces
+type GenesisState struct {
+	Params                     *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedEventNonce     uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	OutgoingTxs                []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
+	Confirmations              []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	EthereumEventVoteRecords   []*EthereumEventVoteRecord `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
+	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	// the state of the EVM chains added by governance, the fields above hold
+	// the state of the default chain
+	EvmChains         []EVMChainGenesisState `protobuf:"bytes,13,rep,name=evm_chains,json=evmChains,proto3" json:"evm_chains"`
+	BridgeContract    *BridgeContract        `protobuf:"bytes,14,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
+	ContractMigration *ContractMigration     `protobuf:"bytes,15,opt,name=contract_migration,json=contractMigration,proto3" json:"contract_migration,omitempty"`
+	Paused            bool                   `protobuf:"varint,16,opt,name=paused,proto3" json:"paused,omitempty"`
+	GravityIdRotation *GravityIDRotation     `protobuf:"bytes,17,opt,name=gravity_id_rotation,json=gravityIdRotation,proto3" json:"gravity_id_rotation,omitempty"`
+	DepositAddresses  []DepositAddress       `protobuf:"bytes,18,rep,name=deposit_addresses,json=depositAddresses,proto3" json:"deposit_addresses"`
+	// the ERC1155 tokens vouchers have been minted for, on any EVM chain
+	Erc1155Tokens                     []ERC1155Token           `protobuf:"bytes,19,rep,name=erc1155_tokens,json=erc1155Tokens,proto3" json:"erc1155_tokens"`
+	UnbatchedSendErc1155ToEthereumTxs []*SendERC1155ToEthereum `protobuf:"bytes,20,rep,name=unbatched_send_erc1155_to_ethereum_txs,json=unbatchedSendErc1155ToEthereumTxs,proto3" json:"unbatched_send_erc1155_to_ethereum_txs,omitempty"`
+	// the deposits forwarded over IBC whose transfers are in flight
+	ForwardedDeposits []ForwardedDeposit `protobuf:"bytes,21,rep,name=forwarded_deposits,json=forwardedDeposits,proto3" json:"forwarded_deposits"`
+	// the relayer incentives of all EVM chains
+	RelayerIncentives []RelayerIncentive `protobuf:"bytes,22,rep,name=relayer_incentives,json=relayerIncentives,proto3" json:"relayer_incentives"`
+	ContractVersion   uint64             `protobuf:"varint,23,opt,name=contract_version,json=contractVersion,proto3" json:"contract_version,omitempty"`
+	// the incident log of manual voucher mints and burns
+	IncidentRecords       []IncidentRecord       `protobuf:"bytes,24,rep,name=incident_records,json=incidentRecords,proto3" json:"incident_records"`
+	ScheduledParamsUpdate *ScheduledParamsUpdate `protobuf:"bytes,25,opt,name=scheduled_params_update,json=scheduledParamsUpdate,proto3" json:"scheduled_params_update,omitempty"`
+	// the bridge reports of the last closed periods
+	BridgeReports               []BridgeReport            `protobuf:"bytes,26,rep,name=bridge_reports,json=bridgeReports,proto3" json:"bridge_reports"`
+	LastObservedEthereumHeight  LatestEthereumBlockHeight `protobuf:"bytes,27,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height"`
+	LatestSignerSetTxNonce      uint64                    `protobuf:"varint,28,opt,name=latest_signer_set_tx_nonce,json=latestSignerSetTxNonce,proto3" json:"latest_signer_set_tx_nonce,omitempty"`
+	LastSlashedOutgoingTxHeight uint64                    `protobuf:"varint,29,opt,name=last_slashed_outgoing_tx_height,json=lastSlashedOutgoingTxHeight,proto3" json:"last_slashed_outgoing_tx_height,omitempty"`
+	LastObservedSignerSetTx     *SignerSetTx              `protobuf:"bytes,30,opt,name=last_observed_signer_set_tx,json=lastObservedSignerSetTx,proto3" json:"last_observed_signer_set_tx,omitempty"`
+	LastEventNonces             []ValidatorEventNonce     `protobuf:"bytes,31,rep,name=last_event_nonces,json=lastEventNonces,proto3" json:"last_event_nonces"`
+	EthereumHeightVotes         []EthereumHeightVote      `protobuf:"bytes,32,rep,name=ethereum_height_votes,json=ethereumHeightVotes,proto3" json:"ethereum_height_votes"`
+	RateLimitUsages             []TokenRateLimitUsage     `protobuf:"bytes,33,rep,name=rate_limit_usages,json=rateLimitUsages,proto3" json:"rate_limit_usages"`
+	// the counters shared by all EVM chains
+	LastSendToEthereumId     uint64 `protobuf:"varint,34,opt,name=last_send_to_ethereum_id,json=lastSendToEthereumId,proto3" json:"last_send_to_ethereum_id,omitempty"`
+	LastOutgoingBatchNonce   uint64 `protobuf:"varint,35,opt,name=last_outgoing_batch_nonce,json=lastOutgoingBatchNonce,proto3" json:"last_outgoing_batch_nonce,omitempty"`
+	LastUnbondingBlockHeight uint64 `protobuf:"varint,36,opt,name=last_unbonding_block_height,json=lastUnbondingBlockHeight,proto3" json:"last_unbonding_block_height,omitempty"`
+	LastRelayerIncentiveId   uint64 `protobuf:"varint,37,opt,name=last_relayer_incentive_id,json=lastRelayerIncentiveId,proto3" json:"last_relayer_incentive_id,omitempty"`
+	LastIncidentRecordId     uint64 `protobuf:"varint,38,opt,name=last_incident_record_id,json=lastIncidentRecordId,proto3" json:"last_incident_record_id,omitempty"`
+	// the bridge report of the period in progress
+	CurrentBridgeReport *BridgeReport `protobuf:"bytes,39,opt,name=current_bridge_report,json=currentBridgeReport,proto3" json:"current_bridge_report,omitempty"`
+	// the state of an already deployed contract the default chain takes over,
+	// imported in place of replaying its history, never exported as the state it
+	// sets is
+	ContractBootstrap  *ContractBootstrap  `protobuf:"bytes,40,opt,name=contract_bootstrap,json=contractBootstrap,proto3" json:"contract_bootstrap,omitempty"`
+	AttestationLatency *AttestationLatency `protobuf:"bytes,41,opt,name=attestation_latency,json=attestationLatency,proto3" json:"attestation_latency,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
 	return fileDescriptor_387b0aba880adb60, []int{0}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -126,217 +101,316 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *GenesisState) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
-func (m *Params) GetGravityId() string {
+func (m *GenesisState) GetParams() *Params {
 	if m != nil {
-		return m.GravityId
+		return m.Params
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetContractSourceHash() string {
+func (m *GenesisState) GetLastObservedEventNonce() uint64 {
 	if m != nil {
-		return m.ContractSourceHash
+		return m.LastObservedEventNonce
 	}
-	return ""
+	return 0
 }
 
-func (m *Params) GetBridgeEthereumAddress() string {
+func (m *GenesisState) GetOutgoingTxs() []*types.Any {
 	if m != nil {
-		return m.BridgeEthereumAddress
+		return m.OutgoingTxs
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetBridgeChainId() uint64 {
+func (m *GenesisState) GetConfirmations() []*types.Any {
 	if m != nil {
-		return m.BridgeChainId
+		return m.Confirmations
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetSignedSignerSetTxsWindow() uint64 {
+func (m *GenesisState) GetEthereumEventVoteRecords() []*EthereumEventVoteRecord {
 	if m != nil {
-		return m.SignedSignerSetTxsWindow
+		return m.EthereumEventVoteRecords
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetSignedBatchesWindow() uint64 {
+func (m *GenesisState) GetDelegateKeys() []*MsgDelegateKeys {
 	if m != nil {
-		return m.SignedBatchesWindow
+		return m.DelegateKeys
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetEthereumSignaturesWindow() uint64 {
+func (m *GenesisState) GetErc20ToDenoms() []*ERC20ToDenom {
 	if m != nil {
-		return m.EthereumSignaturesWindow
+		return m.Erc20ToDenoms
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetTargetEthTxTimeout() uint64 {
+func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
 	if m != nil {
-		return m.TargetEthTxTimeout
+		return m.UnbatchedSendToEthereumTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetAverageBlockTime() uint64 {
+func (m *GenesisState) GetEvmChains() []EVMChainGenesisState {
 	if m != nil {
-		return m.AverageBlockTime
+		return m.EvmChains
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetAverageEthereumBlockTime() uint64 {
+func (m *GenesisState) GetBridgeContract() *BridgeContract {
 	if m != nil {
-		return m.AverageEthereumBlockTime
+		return m.BridgeContract
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetUnbondSlashingSignerSetTxsWindow() uint64 {
+func (m *GenesisState) GetContractMigration() *ContractMigration {
 	if m != nil {
-		return m.UnbondSlashingSignerSetTxsWindow
+		return m.ContractMigration
 	}
-	return 0
+	return nil
 }
 
-// GenesisState struct
-// TODO: this need to be audited and potentially simplified using the new
-// interfaces
-type GenesisState struct {
-	Params                     *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedEventNonce     uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
-	OutgoingTxs                []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
-	Confirmations              []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
-	EthereumEventVoteRecords   []*EthereumEventVoteRecord `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
-	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+func (m *GenesisState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
+func (m *GenesisState) GetGravityIdRotation() *GravityIDRotation {
+	if m != nil {
+		return m.GravityIdRotation
+	}
+	return nil
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *GenesisState) GetDepositAddresses() []DepositAddress {
+	if m != nil {
+		return m.DepositAddresses
+	}
+	return nil
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *GenesisState) GetErc1155Tokens() []ERC1155Token {
+	if m != nil {
+		return m.Erc1155Tokens
 	}
+	return nil
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+
+func (m *GenesisState) GetUnbatchedSendErc1155ToEthereumTxs() []*SendERC1155ToEthereum {
+	if m != nil {
+		return m.UnbatchedSendErc1155ToEthereumTxs
+	}
+	return nil
 }
-func (m *GenesisState) XXX_Size() int {
-	return m.Size()
+
+func (m *GenesisState) GetForwardedDeposits() []ForwardedDeposit {
+	if m != nil {
+		return m.ForwardedDeposits
+	}
+	return nil
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+
+func (m *GenesisState) GetRelayerIncentives() []RelayerIncentive {
+	if m != nil {
+		return m.RelayerIncentives
+	}
+	return nil
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+func (m *GenesisState) GetContractVersion() uint64 {
+	if m != nil {
+		return m.ContractVersion
+	}
+	return 0
+}
 
-func (m *GenesisState) GetParams() *Params {
+func (m *GenesisState) GetIncidentRecords() []IncidentRecord {
 	if m != nil {
-		return m.Params
+		return m.IncidentRecords
 	}
 	return nil
 }
 
-func (m *GenesisState) GetLastObservedEventNonce() uint64 {
+func (m *GenesisState) GetScheduledParamsUpdate() *ScheduledParamsUpdate {
 	if m != nil {
-		return m.LastObservedEventNonce
+		return m.ScheduledParamsUpdate
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeReports() []BridgeReport {
+	if m != nil {
+		return m.BridgeReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastObservedEthereumHeight() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
+	}
+	return LatestEthereumBlockHeight{}
+}
+
+func (m *GenesisState) GetLatestSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.LatestSignerSetTxNonce
 	}
 	return 0
 }
 
-func (m *GenesisState) GetOutgoingTxs() []*types.Any {
+func (m *GenesisState) GetLastSlashedOutgoingTxHeight() uint64 {
 	if m != nil {
-		return m.OutgoingTxs
+		return m.LastSlashedOutgoingTxHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastObservedSignerSetTx() *SignerSetTx {
+	if m != nil {
+		return m.LastObservedSignerSetTx
 	}
 	return nil
 }
 
-func (m *GenesisState) GetConfirmations() []*types.Any {
+func (m *GenesisState) GetLastEventNonces() []ValidatorEventNonce {
 	if m != nil {
-		return m.Confirmations
+		return m.LastEventNonces
 	}
 	return nil
 }
 
-func (m *GenesisState) GetEthereumEventVoteRecords() []*EthereumEventVoteRecord {
+func (m *GenesisState) GetEthereumHeightVotes() []EthereumHeightVote {
 	if m != nil {
-		return m.EthereumEventVoteRecords
+		return m.EthereumHeightVotes
 	}
 	return nil
 }
 
-func (m *GenesisState) GetDelegateKeys() []*MsgDelegateKeys {
+func (m *GenesisState) GetRateLimitUsages() []TokenRateLimitUsage {
 	if m != nil {
-		return m.DelegateKeys
+		return m.RateLimitUsages
 	}
 	return nil
 }
 
-func (m *GenesisState) GetErc20ToDenoms() []*ERC20ToDenom {
+func (m *GenesisState) GetLastSendToEthereumId() uint64 {
 	if m != nil {
-		return m.Erc20ToDenoms
+		return m.LastSendToEthereumId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastOutgoingBatchNonce() uint64 {
+	if m != nil {
+		return m.LastOutgoingBatchNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastUnbondingBlockHeight() uint64 {
+	if m != nil {
+		return m.LastUnbondingBlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastRelayerIncentiveId() uint64 {
+	if m != nil {
+		return m.LastRelayerIncentiveId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastIncidentRecordId() uint64 {
+	if m != nil {
+		return m.LastIncidentRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCurrentBridgeReport() *BridgeReport {
+	if m != nil {
+		return m.CurrentBridgeReport
 	}
 	return nil
 }
 
-func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
+func (m *GenesisState) GetContractBootstrap() *ContractBootstrap {
 	if m != nil {
-		return m.UnbatchedSendToEthereumTxs
+		return m.ContractBootstrap
 	}
 	return nil
 }
 
-// This records the relationship between an ERC20 token and the denom
-// of the corresponding Cosmos originated asset
-type ERC20ToDenom struct {
-	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+func (m *GenesisState) GetAttestationLatency() *AttestationLatency {
+	if m != nil {
+		return m.AttestationLatency
+	}
+	return nil
 }
 
-func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
-func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
-func (*ERC20ToDenom) ProtoMessage()    {}
-func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
+// EVMChainGenesisState is the genesis state of an additional EVM chain
+type EVMChainGenesisState struct {
+	Chain                             EVMChain                   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"`
+	LastObservedEventNonce            uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	OutgoingTxs                       []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
+	Confirmations                     []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	EthereumEventVoteRecords          []*EthereumEventVoteRecord `protobuf:"bytes,5,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
+	Erc20ToDenoms                     []*ERC20ToDenom            `protobuf:"bytes,6,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedSendToEthereumTxs        []*SendToEthereum          `protobuf:"bytes,7,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	BridgeContract                    *BridgeContract            `protobuf:"bytes,8,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
+	ContractMigration                 *ContractMigration         `protobuf:"bytes,9,opt,name=contract_migration,json=contractMigration,proto3" json:"contract_migration,omitempty"`
+	Paused                            bool                       `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
+	GravityIdRotation                 *GravityIDRotation         `protobuf:"bytes,11,opt,name=gravity_id_rotation,json=gravityIdRotation,proto3" json:"gravity_id_rotation,omitempty"`
+	DepositAddresses                  []DepositAddress           `protobuf:"bytes,12,rep,name=deposit_addresses,json=depositAddresses,proto3" json:"deposit_addresses"`
+	UnbatchedSendErc1155ToEthereumTxs []*SendERC1155ToEthereum   `protobuf:"bytes,13,rep,name=unbatched_send_erc1155_to_ethereum_txs,json=unbatchedSendErc1155ToEthereumTxs,proto3" json:"unbatched_send_erc1155_to_ethereum_txs,omitempty"`
+	ContractVersion                   uint64                     `protobuf:"varint,14,opt,name=contract_version,json=contractVersion,proto3" json:"contract_version,omitempty"`
+	LastObservedEthereumHeight        LatestEthereumBlockHeight  `protobuf:"bytes,15,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height"`
+	LatestSignerSetTxNonce            uint64                     `protobuf:"varint,16,opt,name=latest_signer_set_tx_nonce,json=latestSignerSetTxNonce,proto3" json:"latest_signer_set_tx_nonce,omitempty"`
+	LastSlashedOutgoingTxHeight       uint64                     `protobuf:"varint,17,opt,name=last_slashed_outgoing_tx_height,json=lastSlashedOutgoingTxHeight,proto3" json:"last_slashed_outgoing_tx_height,omitempty"`
+	LastObservedSignerSetTx           *SignerSetTx               `protobuf:"bytes,18,opt,name=last_observed_signer_set_tx,json=lastObservedSignerSetTx,proto3" json:"last_observed_signer_set_tx,omitempty"`
+	LastEventNonces                   []ValidatorEventNonce      `protobuf:"bytes,19,rep,name=last_event_nonces,json=lastEventNonces,proto3" json:"last_event_nonces"`
+	EthereumHeightVotes               []EthereumHeightVote       `protobuf:"bytes,20,rep,name=ethereum_height_votes,json=ethereumHeightVotes,proto3" json:"ethereum_height_votes"`
+	RateLimitUsages                   []TokenRateLimitUsage      `protobuf:"bytes,21,rep,name=rate_limit_usages,json=rateLimitUsages,proto3" json:"rate_limit_usages"`
+	ContractBootstrap                 *ContractBootstrap         `protobuf:"bytes,22,opt,name=contract_bootstrap,json=contractBootstrap,proto3" json:"contract_bootstrap,omitempty"`
+	AttestationLatency                *AttestationLatency        `protobuf:"bytes,23,opt,name=attestation_latency,json=attestationLatency,proto3" json:"attestation_latency,omitempty"`
 }
-func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
+
+func (m *EVMChainGenesisState) Reset()         { *m = EVMChainGenesisState{} }
+func (m *EVMChainGenesisState) String() string { return proto.CompactTextString(m) }
+func (*EVMChainGenesisState) ProtoMessage()    {}
+func (*EVMChainGenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+func (m *EVMChainGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EVMChainGenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EVMChainGenesisState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -346,563 +420,3789 @@ func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+func (m *EVMChainGenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMChainGenesisState.Merge(m, src)
 }
-func (m *ERC20ToDenom) XXX_Size() int {
+func (m *EVMChainGenesisState) XXX_Size() int {
 	return m.Size()
 }
-func (m *ERC20ToDenom) XXX_DiscardUnknown() {
-	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+func (m *EVMChainGenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMChainGenesisState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+var xxx_messageInfo_EVMChainGenesisState proto.InternalMessageInfo
 
-func (m *ERC20ToDenom) GetErc20() string {
+func (m *EVMChainGenesisState) GetChain() EVMChain {
 	if m != nil {
-		return m.Erc20
+		return m.Chain
 	}
-	return ""
+	return EVMChain{}
 }
 
-func (m *ERC20ToDenom) GetDenom() string {
+func (m *EVMChainGenesisState) GetLastObservedEventNonce() uint64 {
 	if m != nil {
-		return m.Denom
+		return m.LastObservedEventNonce
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+func (m *EVMChainGenesisState) GetOutgoingTxs() []*types.Any {
+	if m != nil {
+		return m.OutgoingTxs
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+func (m *EVMChainGenesisState) GetConfirmations() []*types.Any {
+	if m != nil {
+		return m.Confirmations
+	}
+	return nil
+}
 
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 910 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xb6, 0xa9, 0x1b, 0xda, 0xb1, 0x4d, 0xca, 0xe0, 0xc0, 0xd6, 0x29, 0xae, 0x09, 0xa2, 0x0a,
-	0x88, 0xec, 0x26, 0xae, 0x04, 0x22, 0xfc, 0xa8, 0xcd, 0x0f, 0x50, 0x21, 0x28, 0x5a, 0x1b, 0x90,
-	0xb8, 0x60, 0x18, 0xef, 0x9c, 0x8c, 0x97, 0xd8, 0x33, 0xd1, 0xce, 0xac, 0x6b, 0xdf, 0xf1, 0x08,
-	0x7d, 0x16, 0x1e, 0x81, 0xab, 0x5e, 0xf6, 0x12, 0x21, 0x54, 0xa1, 0xe4, 0x45, 0xd0, 0xfc, 0xac,
-	0xff, 0x52, 0x6e, 0x72, 0xb5, 0x9e, 0xf9, 0xbe, 0xef, 0x9c, 0x6f, 0xce, 0xf1, 0x9c, 0x41, 0x01,
-	0xcf, 0xe8, 0x38, 0xd5, 0xd3, 0x68, 0xbc, 0x17, 0x71, 0x10, 0xa0, 0x52, 0x15, 0x9e, 0x65, 0x52,
-	0x4b, 0x8c, 0x3c, 0x12, 0x8e, 0xf7, 0x9a, 0x0d, 0x2e, 0xb9, 0xb4, 0xdb, 0x91, 0xf9, 0xe5, 0x18,
-	0xcd, 0x25, 0xad, 0x27, 0x3b, 0x64, 0x63, 0x01, 0x19, 0x29, 0xee, 0x43, 0x36, 0x6f, 0x73, 0x29,
-	0xf9, 0x10, 0x22, 0xbb, 0xea, 0xe7, 0x27, 0x11, 0x15, 0x5e, 0xb1, 0xf5, 0xe7, 0x0d, 0xb4, 0xf6,
-	0x3d, 0xcd, 0xe8, 0x48, 0xe1, 0xb7, 0x51, 0x91, 0x9a, 0xa4, 0x2c, 0x28, 0xb7, 0xcb, 0xdb, 0x37,
-	0xe3, 0x9b, 0x7e, 0xe7, 0x11, 0xc3, 0xbb, 0xa8, 0x91, 0x48, 0xa1, 0x33, 0x9a, 0x68, 0xa2, 0x64,
-	0x9e, 0x25, 0x40, 0x06, 0x54, 0x0d, 0x82, 0x57, 0x2c, 0x11, 0x17, 0x58, 0xd7, 0x42, 0x5f, 0x53,
-	0x35, 0xc0, 0x1f, 0xa1, 0xb7, 0xfa, 0x59, 0xca, 0x38, 0x10, 0xd0, 0x03, 0xc8, 0x20, 0x1f, 0x11,
-	0xca, 0x58, 0x06, 0x4a, 0x05, 0x15, 0x2b, 0xda, 0x70, 0xf0, 0xb1, 0x47, 0x1f, 0x3a, 0x10, 0xdf,
-	0x43, 0xeb, 0x5e, 0x97, 0x0c, 0x68, 0x2a, 0x8c, 0x9b, 0xeb, 0xed, 0xf2, 0x76, 0x25, 0xae, 0xbb,
-	0xed, 0x43, 0xb3, 0xfb, 0x88, 0xe1, 0x2f, 0xd0, 0x1d, 0x95, 0x72, 0x01, 0x8c, 0xd8, 0x4f, 0x46,
-	0x14, 0x68, 0xa2, 0x27, 0x8a, 0x3c, 0x49, 0x05, 0x93, 0x4f, 0x82, 0x35, 0x2b, 0x0a, 0x1c, 0xa7,
-	0x6b, 0x29, 0x5d, 0xd0, 0xbd, 0x89, 0xfa, 0xc9, 0xe2, 0xb8, 0x83, 0x36, 0xbc, 0xbe, 0x4f, 0x75,
-	0x32, 0x80, 0x99, 0xf0, 0x55, 0x2b, 0x7c, 0xc3, 0x81, 0x07, 0x0e, 0xf3, 0x9a, 0xcf, 0x50, 0x73,
-	0x76, 0x18, 0x83, 0x53, 0x9d, 0x67, 0x73, 0xe1, 0x0d, 0x97, 0xb1, 0x60, 0x74, 0x67, 0x04, 0xaf,
-	0xde, 0x43, 0x1b, 0x9a, 0x66, 0x1c, 0xb4, 0xa9, 0x08, 0xd1, 0x13, 0xa2, 0xd3, 0x11, 0xc8, 0x5c,
-	0x07, 0xc8, 0x0a, 0xb1, 0x03, 0x8f, 0xf5, 0xa0, 0x37, 0xe9, 0x39, 0x04, 0x7f, 0x88, 0x30, 0x1d,
-	0x43, 0x46, 0x39, 0x90, 0xfe, 0x50, 0x26, 0xa7, 0x56, 0x12, 0x54, 0x2d, 0xff, 0x96, 0x47, 0x0e,
-	0x0c, 0x60, 0x04, 0xf8, 0x73, 0xb4, 0x59, 0xb0, 0x67, 0x36, 0x17, 0x64, 0x35, 0xe7, 0xcf, 0x53,
-	0x8a, 0xba, 0xcf, 0xe5, 0x02, 0xdd, 0x51, 0x43, 0xaa, 0x06, 0xe4, 0xc4, 0xb4, 0x32, 0x95, 0x62,
-	0xb9, 0xb2, 0x41, 0xbd, 0x5d, 0xde, 0xae, 0x1d, 0x84, 0xcf, 0x5e, 0xdc, 0x2d, 0xfd, 0xfd, 0xe2,
-	0xee, 0x3d, 0x9e, 0xea, 0x41, 0xde, 0x0f, 0x13, 0x39, 0x8a, 0x12, 0xa9, 0x46, 0x52, 0xf9, 0xcf,
-	0x8e, 0x62, 0xa7, 0x91, 0x9e, 0x9e, 0x81, 0x0a, 0x8f, 0x20, 0x89, 0x03, 0x1b, 0xf3, 0x4b, 0x1f,
-	0x72, 0xa1, 0x11, 0xf8, 0x57, 0xd4, 0x58, 0xc9, 0x67, 0x3b, 0x11, 0xbc, 0x76, 0xa5, 0x3c, 0x78,
-	0x29, 0x8f, 0xed, 0x1b, 0x9e, 0xa2, 0x77, 0x56, 0x32, 0x5c, 0x6e, 0x5f, 0xb0, 0x7e, 0xa5, 0x74,
-	0xad, 0xa5, 0x74, 0xc7, 0xab, 0x3d, 0xc7, 0x4f, 0xcb, 0x68, 0x67, 0x25, 0x77, 0x22, 0xc5, 0xc9,
-	0x30, 0x4d, 0x74, 0x2a, 0xf8, 0xcb, 0x7c, 0xdc, 0xba, 0x92, 0x8f, 0xf7, 0x97, 0x7c, 0x1c, 0xce,
-	0x53, 0x5c, 0xb6, 0xf4, 0x18, 0xbd, 0x97, 0x8b, 0xbe, 0x14, 0x8c, 0x58, 0x8d, 0xb1, 0xf1, 0xf2,
-	0xab, 0xf3, 0xba, 0xfd, 0xa3, 0xb4, 0x1d, 0xb9, 0xeb, 0xb9, 0x97, 0xaf, 0xd0, 0x7e, 0xe5, 0xf7,
-	0x7f, 0xda, 0xa5, 0xad, 0x3f, 0x2a, 0xa8, 0xf6, 0x95, 0x1b, 0x62, 0x5d, 0x4d, 0x35, 0xe0, 0x0f,
-	0xd0, 0xda, 0x99, 0x1d, 0x2a, 0x76, 0x8c, 0x54, 0x3b, 0x38, 0x9c, 0x0f, 0xb5, 0xd0, 0x8d, 0x9b,
-	0xd8, 0x33, 0xf0, 0x27, 0xe8, 0xf6, 0x90, 0x2a, 0x4d, 0x64, 0x5f, 0x41, 0x36, 0x06, 0x46, 0x60,
-	0x0c, 0x42, 0x13, 0x21, 0x45, 0x02, 0x76, 0xb8, 0x54, 0xe2, 0x37, 0x0d, 0xe1, 0xb1, 0xc7, 0x8f,
-	0x0d, 0xfc, 0x9d, 0x41, 0xf1, 0xc7, 0xa8, 0x26, 0x73, 0xcd, 0xa5, 0x39, 0x87, 0x9e, 0xa8, 0xe0,
-	0x5a, 0xfb, 0xda, 0x76, 0xb5, 0xd3, 0x08, 0xdd, 0xb8, 0x0b, 0x8b, 0x71, 0x17, 0x3e, 0x14, 0xd3,
-	0xb8, 0x5a, 0x30, 0x7b, 0x13, 0x85, 0xf7, 0x51, 0xdd, 0xb4, 0x22, 0xcd, 0x46, 0xd4, 0xd4, 0xcc,
-	0xcc, 0xa3, 0xff, 0x57, 0x2e, 0x53, 0x71, 0x1f, 0x6d, 0xce, 0x5a, 0xe7, 0xac, 0x8e, 0xa5, 0x06,
-	0x92, 0x41, 0x22, 0x33, 0xa6, 0x82, 0x9b, 0x36, 0xd2, 0xbb, 0x8b, 0x07, 0x2e, 0xfa, 0x60, 0x9d,
-	0xff, 0x28, 0x35, 0xc4, 0x96, 0x3b, 0x9f, 0x13, 0x2b, 0x80, 0xc2, 0x0f, 0x50, 0x9d, 0xc1, 0x10,
-	0x38, 0xd5, 0x40, 0x4e, 0x61, 0xaa, 0x02, 0x64, 0xa3, 0x6e, 0x2e, 0x46, 0xfd, 0x56, 0xf1, 0x23,
-	0xcf, 0xf9, 0x06, 0xa6, 0x2a, 0xae, 0xb1, 0x85, 0x15, 0x7e, 0x80, 0xd6, 0x21, 0x4b, 0x3a, 0xbb,
-	0x44, 0x4b, 0xc2, 0x40, 0xc8, 0x91, 0x0a, 0xaa, 0x36, 0x46, 0xb0, 0xe4, 0x2c, 0x3e, 0xec, 0xec,
-	0xf6, 0xe4, 0x91, 0x21, 0xc4, 0x75, 0x2b, 0xf0, 0x2b, 0x85, 0x7f, 0x41, 0xad, 0x5c, 0xb8, 0xc1,
-	0xc8, 0x88, 0x02, 0xc1, 0x4c, 0xa8, 0xd9, 0xc9, 0x4d, 0xb9, 0x6b, 0x36, 0x60, 0x73, 0x31, 0x60,
-	0x17, 0x04, 0xeb, 0xc9, 0xe2, 0xc0, 0x71, 0x73, 0x16, 0x61, 0x19, 0xe8, 0x4d, 0xd4, 0xd6, 0x3e,
-	0xaa, 0x2d, 0xa6, 0xc7, 0x0d, 0x74, 0xdd, 0x1a, 0xf0, 0x2f, 0x8f, 0x5b, 0x98, 0x5d, 0x6b, 0xdf,
-	0x3f, 0x33, 0x6e, 0x71, 0xf0, 0xc3, 0xb3, 0xf3, 0x56, 0xf9, 0xf9, 0x79, 0xab, 0xfc, 0xef, 0x79,
-	0xab, 0xfc, 0xf4, 0xa2, 0x55, 0x7a, 0x7e, 0xd1, 0x2a, 0xfd, 0x75, 0xd1, 0x2a, 0xfd, 0xfc, 0xe9,
-	0xc2, 0xa5, 0x39, 0x03, 0xce, 0xa7, 0xbf, 0x8d, 0x8b, 0x37, 0x72, 0xc7, 0xbd, 0x1e, 0xd1, 0x48,
-	0xb2, 0x7c, 0x08, 0xd1, 0xf8, 0x7e, 0x34, 0x29, 0x20, 0x77, 0x9b, 0xfa, 0x6b, 0xb6, 0xef, 0xf7,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x74, 0xfe, 0x63, 0x78, 0x9d, 0x07, 0x00, 0x00,
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EVMChainGenesisState) GetEthereumEventVoteRecords() []*EthereumEventVoteRecord {
+	if m != nil {
+		return m.EthereumEventVoteRecords
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EVMChainGenesisState) GetErc20ToDenoms() []*ERC20ToDenom {
+	if m != nil {
+		return m.Erc20ToDenoms
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondSlashingSignerSetTxsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	{
-		size := m.SlashFractionConflictingEthereumSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionConflictingEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.SlashFractionEthereumSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionSignerSetTx.Size()
-		i -= size
-		if _, err := m.SlashFractionSignerSetTx.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
-		i--
-		dAtA[i] = 0x60
+func (m *EVMChainGenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
+	if m != nil {
+		return m.UnbatchedSendToEthereumTxs
 	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x58
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetBridgeContract() *BridgeContract {
+	if m != nil {
+		return m.BridgeContract
 	}
-	if m.TargetEthTxTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetEthTxTimeout))
-		i--
-		dAtA[i] = 0x50
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetContractMigration() *ContractMigration {
+	if m != nil {
+		return m.ContractMigration
 	}
-	if m.EthereumSignaturesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumSignaturesWindow))
-		i--
-		dAtA[i] = 0x40
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x38
+	return false
+}
+
+func (m *EVMChainGenesisState) GetGravityIdRotation() *GravityIDRotation {
+	if m != nil {
+		return m.GravityIdRotation
 	}
-	if m.SignedSignerSetTxsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedSignerSetTxsWindow))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetDepositAddresses() []DepositAddress {
+	if m != nil {
+		return m.DepositAddresses
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetUnbatchedSendErc1155ToEthereumTxs() []*SendERC1155ToEthereum {
+	if m != nil {
+		return m.UnbatchedSendErc1155ToEthereumTxs
 	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetContractVersion() uint64 {
+	if m != nil {
+		return m.ContractVersion
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EVMChainGenesisState) GetLastObservedEthereumHeight() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
 	}
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
-		i--
-		dAtA[i] = 0xa
+	return LatestEthereumBlockHeight{}
+}
+
+func (m *EVMChainGenesisState) GetLatestSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.LatestSignerSetTxNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EVMChainGenesisState) GetLastSlashedOutgoingTxHeight() uint64 {
+	if m != nil {
+		return m.LastSlashedOutgoingTxHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EVMChainGenesisState) GetLastObservedSignerSetTx() *SignerSetTx {
+	if m != nil {
+		return m.LastObservedSignerSetTx
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+func (m *EVMChainGenesisState) GetLastEventNonces() []ValidatorEventNonce {
+	if m != nil {
+		return m.LastEventNonces
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetEthereumHeightVotes() []EthereumHeightVote {
+	if m != nil {
+		return m.EthereumHeightVotes
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetRateLimitUsages() []TokenRateLimitUsage {
+	if m != nil {
+		return m.RateLimitUsages
 	}
-	if len(m.EthereumEventVoteRecords) > 0 {
-		for iNdEx := len(m.EthereumEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EthereumEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetContractBootstrap() *ContractBootstrap {
+	if m != nil {
+		return m.ContractBootstrap
 	}
-	if len(m.Confirmations) > 0 {
-		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return nil
+}
+
+func (m *EVMChainGenesisState) GetAttestationLatency() *AttestationLatency {
+	if m != nil {
+		return m.AttestationLatency
 	}
-	if len(m.OutgoingTxs) > 0 {
-		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	return nil
+}
+
+// ValidatorEventNonce is the nonce of the last event a validator voted for
+type ValidatorEventNonce struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Nonce            uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *ValidatorEventNonce) Reset()         { *m = ValidatorEventNonce{} }
+func (m *ValidatorEventNonce) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEventNonce) ProtoMessage()    {}
+func (*ValidatorEventNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *ValidatorEventNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEventNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEventNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.LastObservedEventNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidatorEventNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEventNonce.Merge(m, src)
+}
+func (m *ValidatorEventNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEventNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEventNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEventNonce proto.InternalMessageInfo
+
+func (m *ValidatorEventNonce) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorEventNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EthereumHeightVote is the last Ethereum height a validator voted for
+type EthereumHeightVote struct {
+	ValidatorAddress string                    `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           LatestEthereumBlockHeight `protobuf:"bytes,2,opt,name=height,proto3" json:"height"`
 }
 
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+func (m *EthereumHeightVote) Reset()         { *m = EthereumHeightVote{} }
+func (m *EthereumHeightVote) String() string { return proto.CompactTextString(m) }
+func (*EthereumHeightVote) ProtoMessage()    {}
+func (*EthereumHeightVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *EthereumHeightVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumHeightVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumHeightVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EthereumHeightVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumHeightVote.Merge(m, src)
+}
+func (m *EthereumHeightVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumHeightVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumHeightVote.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EthereumHeightVote proto.InternalMessageInfo
+
+func (m *EthereumHeightVote) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.ContractSourceHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
-	}
-	if m.SignedSignerSetTxsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedSignerSetTxsWindow))
-	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
-	}
-	if m.EthereumSignaturesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.EthereumSignaturesWindow))
-	}
-	if m.TargetEthTxTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.TargetEthTxTimeout))
-	}
-	if m.AverageBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
-	}
-	if m.AverageEthereumBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
-	}
-	l = m.SlashFractionSignerSetTx.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionEthereumSignature.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionConflictingEthereumSignature.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if m.UnbondSlashingSignerSetTxsWindow != 0 {
-		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
+
+func (m *EthereumHeightVote) GetHeight() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return LatestEthereumBlockHeight{}
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+// TokenRateLimitUsage is what has been transferred of a rate limited token in
+// its current window
+type TokenRateLimitUsage struct {
+	TokenContract string         `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Usage         RateLimitUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *TokenRateLimitUsage) Reset()         { *m = TokenRateLimitUsage{} }
+func (m *TokenRateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*TokenRateLimitUsage) ProtoMessage()    {}
+func (*TokenRateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *TokenRateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenRateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenRateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *TokenRateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRateLimitUsage.Merge(m, src)
+}
+func (m *TokenRateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenRateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenRateLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenRateLimitUsage proto.InternalMessageInfo
+
+func (m *TokenRateLimitUsage) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.LastObservedEventNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	return ""
+}
+
+func (m *TokenRateLimitUsage) GetUsage() RateLimitUsage {
+	if m != nil {
+		return m.Usage
 	}
-	if len(m.OutgoingTxs) > 0 {
-		for _, e := range m.OutgoingTxs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return RateLimitUsage{}
+}
+
+// ContractBootstrap is the state of an already deployed gravity contract read at
+// an Ethereum height, imported so that a new chain can take the contract over
+// without replaying its events. The signer set of the contract must be one the
+// delegate ethereum keys of the new chain can update.
+type ContractBootstrap struct {
+	EthereumHeight uint64 `protobuf:"varint,1,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// state_lastEventNonce of the contract
+	LastEventNonce uint64 `protobuf:"varint,2,opt,name=last_event_nonce,json=lastEventNonce,proto3" json:"last_event_nonce,omitempty"`
+	// state_lastValsetNonce of the contract
+	LastSignerSetNonce uint64          `protobuf:"varint,3,opt,name=last_signer_set_nonce,json=lastSignerSetNonce,proto3" json:"last_signer_set_nonce,omitempty"`
+	Tokens             []ContractToken `protobuf:"bytes,4,rep,name=tokens,proto3" json:"tokens"`
+}
+
+func (m *ContractBootstrap) Reset()         { *m = ContractBootstrap{} }
+func (m *ContractBootstrap) String() string { return proto.CompactTextString(m) }
+func (*ContractBootstrap) ProtoMessage()    {}
+func (*ContractBootstrap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{5}
+}
+func (m *ContractBootstrap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractBootstrap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractBootstrap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Confirmations) > 0 {
-		for _, e := range m.Confirmations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+}
+func (m *ContractBootstrap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractBootstrap.Merge(m, src)
+}
+func (m *ContractBootstrap) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractBootstrap) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractBootstrap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractBootstrap proto.InternalMessageInfo
+
+func (m *ContractBootstrap) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	if len(m.EthereumEventVoteRecords) > 0 {
-		for _, e := range m.EthereumEventVoteRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return 0
+}
+
+func (m *ContractBootstrap) GetLastEventNonce() uint64 {
+	if m != nil {
+		return m.LastEventNonce
 	}
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return 0
+}
+
+func (m *ContractBootstrap) GetLastSignerSetNonce() uint64 {
+	if m != nil {
+		return m.LastSignerSetNonce
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for _, e := range m.Erc20ToDenoms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return 0
+}
+
+func (m *ContractBootstrap) GetTokens() []ContractToken {
+	if m != nil {
+		return m.Tokens
 	}
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for _, e := range m.UnbatchedSendToEthereumTxs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+// ContractToken is the balance the contract holds of an ERC20 token and the
+// nonce of the last batch of the token it executed
+type ContractToken struct {
+	Contract       string                                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Balance        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	LastBatchNonce uint64                                 `protobuf:"varint,3,opt,name=last_batch_nonce,json=lastBatchNonce,proto3" json:"last_batch_nonce,omitempty"`
+}
+
+func (m *ContractToken) Reset()         { *m = ContractToken{} }
+func (m *ContractToken) String() string { return proto.CompactTextString(m) }
+func (*ContractToken) ProtoMessage()    {}
+func (*ContractToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{6}
+}
+func (m *ContractToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ContractToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractToken.Merge(m, src)
+}
+func (m *ContractToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractToken.DiscardUnknown(m)
 }
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+var xxx_messageInfo_ContractToken proto.InternalMessageInfo
+
+func (m *ContractToken) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *ContractToken) GetLastBatchNonce() uint64 {
+	if m != nil {
+		return m.LastBatchNonce
 	}
-	return n
+	return 0
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// This records the relationship between an ERC20 token and the denom
+// of the corresponding Cosmos originated asset
+type ERC20ToDenom struct {
+	Erc20 string `protobuf:"bytes,1,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
+func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
+func (*ERC20ToDenom) ProtoMessage()    {}
+func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{7}
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20ToDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20ToDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20ToDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20ToDenom.Merge(m, src)
+}
+func (m *ERC20ToDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20ToDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20ToDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20ToDenom proto.InternalMessageInfo
+
+func (m *ERC20ToDenom) GetErc20() string {
+	if m != nil {
+		return m.Erc20
+	}
+	return ""
+}
+
+func (m *ERC20ToDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*EVMChainGenesisState)(nil), "gravity.v1.EVMChainGenesisState")
+	proto.RegisterType((*ValidatorEventNonce)(nil), "gravity.v1.ValidatorEventNonce")
+	proto.RegisterType((*EthereumHeightVote)(nil), "gravity.v1.EthereumHeightVote")
+	proto.RegisterType((*TokenRateLimitUsage)(nil), "gravity.v1.TokenRateLimitUsage")
+	proto.RegisterType((*ContractBootstrap)(nil), "gravity.v1.ContractBootstrap")
+	proto.RegisterType((*ContractToken)(nil), "gravity.v1.ContractToken")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 1624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x73, 0x13, 0xc7,
+	0x16, 0xf6, 0x60, 0x6c, 0x70, 0xdb, 0xb2, 0xad, 0x96, 0x64, 0xb7, 0x65, 0x90, 0x85, 0xb8, 0x80,
+	0xb9, 0xb7, 0x90, 0xb0, 0x29, 0xb8, 0x75, 0xb9, 0x75, 0xab, 0xc0, 0x8f, 0x0b, 0x2a, 0xec, 0x10,
+	0xc6, 0x8f, 0x22, 0x59, 0x64, 0x6a, 0x34, 0xd3, 0x1e, 0x4f, 0x2c, 0x4d, 0xab, 0xba, 0x5b, 0x8a,
+	0xf5, 0x07, 0xb2, 0xce, 0x36, 0xab, 0xfc, 0x1d, 0x96, 0x54, 0x56, 0xa9, 0x2c, 0xa8, 0x14, 0xfc,
+	0x91, 0x54, 0xbf, 0xa4, 0x99, 0x91, 0x42, 0x00, 0x9b, 0x2c, 0xb2, 0xb2, 0xfa, 0x9c, 0xaf, 0x4f,
+	0x9f, 0xee, 0xf3, 0xfa, 0xc6, 0x00, 0x05, 0xd4, 0xed, 0x86, 0xbc, 0x57, 0xeb, 0xae, 0xd5, 0x02,
+	0x1c, 0x61, 0x16, 0xb2, 0x6a, 0x9b, 0x12, 0x4e, 0x20, 0xd0, 0x9a, 0x6a, 0x77, 0xad, 0x98, 0x0f,
+	0x48, 0x40, 0xa4, 0xb8, 0x26, 0x7e, 0x29, 0x44, 0x31, 0xb1, 0x57, 0x83, 0x95, 0xa6, 0x10, 0xd3,
+	0xb4, 0x58, 0xa0, 0x4d, 0x16, 0x17, 0x63, 0xe2, 0xb6, 0x4b, 0xdd, 0x96, 0x51, 0x2c, 0x05, 0x84,
+	0x04, 0x4d, 0x5c, 0x93, 0xab, 0x46, 0xe7, 0xa8, 0xe6, 0x46, 0xda, 0x54, 0xe5, 0xc7, 0x02, 0x98,
+	0x79, 0xa2, 0x1c, 0xdb, 0xe3, 0x2e, 0xc7, 0xf0, 0x9f, 0x60, 0x52, 0xed, 0x45, 0x56, 0xd9, 0x5a,
+	0x9d, 0x5e, 0x87, 0xd5, 0x81, 0xa3, 0xd5, 0x2f, 0xa5, 0xc6, 0xd6, 0x08, 0xf8, 0x1f, 0xb0, 0xd4,
+	0x74, 0x19, 0x77, 0x48, 0x83, 0x61, 0xda, 0xc5, 0xbe, 0x83, 0xbb, 0x38, 0xe2, 0x4e, 0x44, 0x22,
+	0x0f, 0xa3, 0x0b, 0x65, 0x6b, 0xf5, 0xa2, 0xbd, 0x20, 0x00, 0xcf, 0xb5, 0x7e, 0x5b, 0xa8, 0xbf,
+	0x10, 0x5a, 0xf8, 0x6f, 0x30, 0x43, 0x3a, 0x3c, 0x20, 0x61, 0x14, 0x38, 0xfc, 0x94, 0xa1, 0xf1,
+	0xf2, 0xf8, 0xea, 0xf4, 0x7a, 0xbe, 0xaa, 0x3c, 0xad, 0x1a, 0x4f, 0xab, 0x8f, 0xa3, 0x9e, 0x3d,
+	0x6d, 0x90, 0xfb, 0xa7, 0x0c, 0x3e, 0x04, 0x19, 0x8f, 0x44, 0x47, 0x21, 0x6d, 0xb9, 0x3c, 0x24,
+	0x11, 0x43, 0x17, 0xdf, 0xb3, 0x33, 0x09, 0x85, 0x0d, 0xb0, 0x8c, 0xf9, 0x31, 0xa6, 0xb8, 0xd3,
+	0xd2, 0xae, 0x76, 0x09, 0xc7, 0x0e, 0xc5, 0x1e, 0xa1, 0x3e, 0x43, 0x53, 0xd2, 0xd2, 0xf5, 0xf8,
+	0x85, 0xb7, 0x35, 0x5c, 0x7a, 0x7e, 0x48, 0x38, 0xb6, 0x25, 0xd6, 0x46, 0x78, 0xb4, 0x82, 0xc1,
+	0x47, 0x20, 0xe3, 0xe3, 0x26, 0x0e, 0x5c, 0x8e, 0x9d, 0x13, 0xdc, 0x63, 0x08, 0x48, 0xab, 0xcb,
+	0x71, 0xab, 0xbb, 0x2c, 0xd8, 0xd2, 0x98, 0x67, 0xb8, 0xc7, 0xec, 0x19, 0x3f, 0xb6, 0x82, 0x8f,
+	0xc0, 0x1c, 0xa6, 0xde, 0xfa, 0x5d, 0x87, 0x13, 0xc7, 0xc7, 0x11, 0x69, 0x31, 0x34, 0x2d, 0x6d,
+	0xa0, 0x84, 0x67, 0xf6, 0xe6, 0xfa, 0xdd, 0x7d, 0xb2, 0x25, 0x00, 0x76, 0x46, 0x6e, 0xd0, 0x2b,
+	0x06, 0xbf, 0x01, 0xa5, 0x4e, 0xd4, 0x70, 0xb9, 0x77, 0x8c, 0x7d, 0x87, 0xe1, 0xc8, 0x17, 0xa6,
+	0xfa, 0x37, 0x17, 0xcf, 0x3d, 0x23, 0x0d, 0x16, 0xe3, 0x06, 0xf7, 0x70, 0xe4, 0xef, 0x13, 0x73,
+	0x61, 0xbb, 0xd8, 0xb7, 0x90, 0x54, 0x88, 0x18, 0x6c, 0x03, 0x80, 0xbb, 0x2d, 0xc7, 0x3b, 0x76,
+	0xc3, 0x88, 0xa1, 0x8c, 0xb4, 0x55, 0x4e, 0x38, 0x77, 0xb8, 0xbb, 0x29, 0x94, 0xf1, 0xcc, 0xda,
+	0xb8, 0xf8, 0xea, 0xcd, 0xca, 0x98, 0x3d, 0x85, 0xbb, 0x2d, 0xa9, 0x63, 0x70, 0x13, 0xcc, 0x35,
+	0x68, 0xe8, 0x07, 0xd8, 0xf1, 0x48, 0xc4, 0xa9, 0xeb, 0x71, 0x34, 0x5b, 0xb6, 0xd2, 0x7e, 0x6d,
+	0x48, 0xc8, 0xa6, 0x46, 0xd8, 0xb3, 0x8d, 0xc4, 0x1a, 0xee, 0x00, 0x68, 0x76, 0x3b, 0xad, 0x30,
+	0xa0, 0x32, 0xd4, 0x68, 0x4e, 0xda, 0xb9, 0x1a, 0xb7, 0x63, 0x76, 0xec, 0x1a, 0x90, 0x9d, 0xf5,
+	0xd2, 0x22, 0xb8, 0x20, 0xb2, 0xbf, 0xc3, 0xb0, 0x8f, 0xe6, 0xcb, 0xd6, 0xea, 0x65, 0x5b, 0xaf,
+	0xe0, 0x2e, 0xc8, 0x69, 0x53, 0x4e, 0xe8, 0x3b, 0x94, 0x70, 0x75, 0x4c, 0x76, 0xf8, 0x98, 0x27,
+	0xea, 0x67, 0x7d, 0xcb, 0xd6, 0x20, 0x3b, 0xab, 0xb5, 0x75, 0xdf, 0x88, 0xe0, 0x2e, 0xc8, 0xfa,
+	0xb8, 0x4d, 0x58, 0xc8, 0x1d, 0xd7, 0xf7, 0x29, 0x66, 0x0c, 0x33, 0x04, 0x87, 0x63, 0xb2, 0xa5,
+	0x40, 0x8f, 0x15, 0x46, 0xbf, 0xe0, 0xbc, 0x9f, 0x90, 0x62, 0x11, 0x8f, 0x59, 0x4c, 0xbd, 0xb5,
+	0xb5, 0xfb, 0xf7, 0x1d, 0x4e, 0x4e, 0x70, 0xc4, 0x50, 0x6e, 0x64, 0xc2, 0x08, 0xc4, 0xbe, 0x00,
+	0x68, 0x4b, 0x19, 0xbd, 0x4b, 0xca, 0x18, 0xe4, 0xe0, 0x66, 0x2a, 0x6d, 0x06, 0x56, 0x93, 0xe9,
+	0x93, 0x97, 0xe6, 0xaf, 0xa5, 0xd3, 0xa7, 0x7f, 0x44, 0x3f, 0x8b, 0xae, 0x25, 0xb2, 0x68, 0x9b,
+	0x7a, 0x49, 0xbd, 0x48, 0xa6, 0x17, 0x00, 0x1e, 0x11, 0xfa, 0x9d, 0x4b, 0x7d, 0xec, 0x3b, 0xfa,
+	0x6a, 0x0c, 0x15, 0xe4, 0x09, 0x57, 0xe2, 0x27, 0xfc, 0xdf, 0xa0, 0xf4, 0xab, 0xe8, 0x4b, 0x64,
+	0x8f, 0x52, 0x72, 0x69, 0x92, 0xe2, 0xa6, 0xdb, 0xc3, 0xd4, 0x09, 0x23, 0x0f, 0x47, 0x3c, 0xec,
+	0x62, 0x86, 0x16, 0x86, 0x4d, 0xda, 0x0a, 0x55, 0x37, 0x20, 0x63, 0x92, 0xa6, 0xe4, 0x0c, 0xde,
+	0x06, 0xf3, 0xfd, 0x34, 0xeb, 0x62, 0xca, 0x44, 0xf4, 0x17, 0x65, 0x87, 0x9b, 0x33, 0xf2, 0x43,
+	0x25, 0x86, 0xcf, 0xc0, 0x7c, 0x18, 0x79, 0xa1, 0x2f, 0xfa, 0x8b, 0x69, 0x2d, 0x68, 0x38, 0xb6,
+	0x75, 0x8d, 0x51, 0x8d, 0x43, 0x9f, 0x3c, 0x17, 0x26, 0xa4, 0x0c, 0x7e, 0x05, 0x16, 0x99, 0x78,
+	0xbe, 0x4e, 0x13, 0xfb, 0x8e, 0x6a, 0xbb, 0x4e, 0xa7, 0xed, 0xbb, 0x1c, 0xa3, 0xa5, 0xb2, 0x35,
+	0x14, 0x04, 0x03, 0x55, 0x8d, 0xfa, 0x40, 0x02, 0xed, 0x02, 0x1b, 0x25, 0x16, 0x59, 0xa3, 0xcb,
+	0x8f, 0xe2, 0x36, 0xa1, 0x9c, 0xa1, 0xe2, 0x70, 0xd6, 0xa8, 0xea, 0xb3, 0x25, 0xc0, 0x64, 0x4d,
+	0x23, 0x26, 0x63, 0x30, 0x02, 0x57, 0x53, 0x43, 0xc0, 0x64, 0xca, 0x31, 0x0e, 0x83, 0x63, 0x8e,
+	0x96, 0xa5, 0x9f, 0x37, 0xe2, 0x56, 0x77, 0x5c, 0x8e, 0x19, 0x37, 0x59, 0xb0, 0xd1, 0x24, 0xde,
+	0xc9, 0x53, 0x09, 0xd6, 0x47, 0x14, 0x13, 0x53, 0x43, 0xc3, 0x14, 0x02, 0x3e, 0x04, 0xc5, 0xa6,
+	0xdc, 0xee, 0xb0, 0x30, 0x88, 0x30, 0x75, 0x18, 0xe6, 0x0e, 0x3f, 0xd5, 0x53, 0xe7, 0x8a, 0x99,
+	0x3a, 0x02, 0xb1, 0x27, 0x01, 0x7b, 0x98, 0xef, 0x9f, 0xaa, 0xa9, 0xb3, 0x05, 0x56, 0xa4, 0xaf,
+	0xac, 0xe9, 0x32, 0x91, 0xe4, 0xb1, 0x11, 0x64, 0xbc, 0xbd, 0x2a, 0x0d, 0x2c, 0x0b, 0xd8, 0x9e,
+	0x42, 0x3d, 0xef, 0x4f, 0x1f, 0xed, 0xc1, 0x01, 0x58, 0x4e, 0xde, 0x38, 0xe1, 0x08, 0x2a, 0xc9,
+	0xfb, 0x2e, 0x26, 0xe2, 0x32, 0x70, 0xc4, 0x5e, 0x8c, 0xdf, 0x2d, 0xa6, 0x80, 0x2f, 0x40, 0x56,
+	0x9a, 0x8d, 0x0d, 0x51, 0x86, 0x56, 0x64, 0x48, 0x56, 0xe2, 0xc6, 0x0e, 0xdd, 0x66, 0xe8, 0xbb,
+	0x9c, 0xd0, 0xc1, 0x38, 0x35, 0xd9, 0x23, 0xf6, 0x0f, 0xa4, 0x0c, 0xbe, 0x04, 0x85, 0x54, 0x34,
+	0xe4, 0xc4, 0x63, 0xa8, 0x2c, 0xcd, 0x96, 0x46, 0x8d, 0x3a, 0x75, 0x49, 0x31, 0xd2, 0xb4, 0xd5,
+	0x1c, 0x1e, 0xd2, 0x88, 0x12, 0xcb, 0x52, 0x31, 0xe2, 0x9a, 0x61, 0x2b, 0xe4, 0x4e, 0x87, 0xb9,
+	0x01, 0x66, 0xe8, 0xda, 0xb0, 0xb3, 0xb2, 0xb5, 0xd8, 0x2e, 0xc7, 0x3b, 0x02, 0x78, 0x20, 0x70,
+	0xc6, 0x59, 0x9a, 0x90, 0x32, 0xf8, 0x00, 0x20, 0x15, 0x9c, 0xf4, 0xc0, 0x0a, 0x7d, 0x54, 0x91,
+	0x51, 0xc9, 0xcb, 0xa8, 0x24, 0xc6, 0x51, 0xdd, 0x1f, 0xb0, 0x10, 0x13, 0x4c, 0xd9, 0x71, 0x74,
+	0x3e, 0x5c, 0x8f, 0xb1, 0x10, 0xad, 0xdf, 0x10, 0x6a, 0x95, 0x0f, 0xff, 0xd3, 0x91, 0xec, 0x44,
+	0x0d, 0x12, 0xf9, 0x72, 0xaf, 0xc8, 0x45, 0x93, 0x0b, 0xff, 0x90, 0x9b, 0xa5, 0x57, 0x07, 0x06,
+	0x11, 0x4b, 0xd6, 0xfe, 0xc9, 0x43, 0xcd, 0x46, 0xb8, 0x7c, 0x63, 0x70, 0x72, 0xba, 0xcd, 0xd4,
+	0x7d, 0x78, 0x1f, 0xc8, 0x3c, 0x70, 0x52, 0x9d, 0x42, 0x6c, 0xbc, 0x39, 0xb8, 0x6b, 0xb2, 0x47,
+	0xd4, 0x7d, 0xb8, 0x03, 0x0a, 0x5e, 0x87, 0x52, 0xb1, 0x21, 0x51, 0xbb, 0xe8, 0x56, 0xd9, 0x7a,
+	0x5f, 0xe9, 0xda, 0x39, 0xbd, 0x2d, 0x2e, 0x4c, 0xcc, 0xce, 0x06, 0x21, 0x9c, 0x71, 0xea, 0xb6,
+	0xd1, 0xea, 0x1f, 0xcf, 0xce, 0x0d, 0x03, 0x1a, 0xcc, 0xce, 0xbe, 0x08, 0x3e, 0x07, 0x39, 0x97,
+	0x8b, 0xb2, 0x93, 0x33, 0xce, 0x11, 0x25, 0x18, 0x79, 0x3d, 0x74, 0xbb, 0x6c, 0xa5, 0x53, 0xed,
+	0xf1, 0x00, 0xb6, 0xa3, 0x50, 0x36, 0x74, 0x87, 0x64, 0x95, 0xd7, 0x19, 0x90, 0x1f, 0xc5, 0x24,
+	0xe0, 0x5d, 0x30, 0x21, 0xb9, 0x87, 0xa6, 0xa8, 0xf9, 0x51, 0xd4, 0x43, 0x67, 0x99, 0x02, 0xfe,
+	0xdd, 0x98, 0xea, 0xc4, 0xf9, 0x30, 0xd5, 0x21, 0x9e, 0x39, 0x79, 0xde, 0x3c, 0xf3, 0xd2, 0x99,
+	0x78, 0xe6, 0x08, 0x82, 0x78, 0xf9, 0x9c, 0x08, 0xe2, 0xd4, 0x99, 0x09, 0x22, 0xf8, 0x10, 0x82,
+	0x38, 0x7d, 0x9e, 0x04, 0x71, 0xe6, 0x93, 0x09, 0xe2, 0x87, 0x33, 0xbb, 0xcc, 0x39, 0x32, 0xbb,
+	0x51, 0x9c, 0x69, 0x76, 0x34, 0x67, 0xfa, 0x53, 0x12, 0x31, 0xf7, 0x57, 0x92, 0x88, 0xf9, 0xb3,
+	0x92, 0x88, 0xec, 0x99, 0x49, 0x04, 0x3c, 0x4f, 0x12, 0x91, 0xfb, 0x3c, 0x24, 0x22, 0xff, 0x59,
+	0x48, 0x44, 0xe1, 0x4c, 0x24, 0x62, 0xf4, 0x48, 0x5b, 0x38, 0xdf, 0x91, 0xb6, 0xf8, 0xc9, 0x23,
+	0xed, 0x25, 0xc8, 0x8d, 0x78, 0x79, 0xf8, 0x2f, 0x90, 0xed, 0x1a, 0xb1, 0x29, 0x78, 0x39, 0xdc,
+	0xa6, 0xec, 0xf9, 0xbe, 0x42, 0x97, 0x33, 0xcc, 0x83, 0x89, 0xf8, 0xdc, 0x52, 0x8b, 0xca, 0xf7,
+	0x16, 0x80, 0xc3, 0xaf, 0xff, 0x71, 0x96, 0x37, 0xc1, 0xa4, 0x4e, 0xe0, 0x0b, 0x1f, 0x5f, 0x6e,
+	0x7a, 0x6b, 0x85, 0x83, 0xdc, 0x88, 0x78, 0xc1, 0x1b, 0x60, 0x56, 0x7e, 0x9b, 0x0e, 0x5a, 0xb9,
+	0xf2, 0x22, 0x23, 0xa5, 0xfd, 0x6e, 0xfd, 0x00, 0x4c, 0xc8, 0x3c, 0xd0, 0x1e, 0x24, 0x9a, 0xdd,
+	0xc8, 0x0c, 0x50, 0xf0, 0xca, 0xcf, 0x16, 0xc8, 0x0e, 0x85, 0x14, 0xde, 0x02, 0x73, 0xe9, 0x46,
+	0x62, 0xc9, 0x47, 0x9b, 0x4d, 0xa6, 0x23, 0x5c, 0x05, 0xf3, 0xe9, 0xb2, 0xd1, 0xcf, 0x3b, 0x9b,
+	0x2c, 0x07, 0xb8, 0x06, 0x0a, 0xaa, 0xfa, 0x07, 0xe5, 0xaa, 0xe0, 0xe3, 0x12, 0x0e, 0x65, 0xcd,
+	0x9b, 0x82, 0x34, 0x0c, 0x62, 0x52, 0x7f, 0x96, 0x2b, 0x06, 0xb0, 0x34, 0x2a, 0x0f, 0xe3, 0xdf,
+	0xe5, 0x1a, 0x5e, 0xf9, 0xc9, 0x02, 0x99, 0x84, 0x1e, 0x16, 0xc1, 0xe5, 0xd4, 0xfb, 0xf5, 0xd7,
+	0xf0, 0x29, 0xb8, 0xd4, 0x70, 0x9b, 0xae, 0x71, 0x7d, 0x6a, 0xa3, 0x2a, 0x8c, 0xfd, 0xfa, 0x66,
+	0xe5, 0x66, 0x10, 0xf2, 0xe3, 0x4e, 0xa3, 0xea, 0x91, 0x56, 0xcd, 0x23, 0xac, 0x45, 0x98, 0xfe,
+	0x73, 0x87, 0xf9, 0x27, 0x35, 0xde, 0x6b, 0x63, 0x56, 0xad, 0x47, 0xdc, 0x36, 0xdb, 0xfb, 0xaf,
+	0x11, 0x27, 0xd2, 0xe3, 0x83, 0xd7, 0x18, 0x10, 0xe8, 0xca, 0x43, 0x30, 0x13, 0x27, 0x08, 0x22,
+	0x37, 0x25, 0x45, 0xd0, 0xce, 0xa9, 0x85, 0x90, 0x4a, 0x82, 0xa1, 0xfc, 0xb2, 0xd5, 0x62, 0xe3,
+	0xe0, 0xd5, 0xdb, 0x92, 0xf5, 0xfa, 0x6d, 0xc9, 0xfa, 0xed, 0x6d, 0xc9, 0xfa, 0xe1, 0x5d, 0x69,
+	0xec, 0xf5, 0xbb, 0xd2, 0xd8, 0x2f, 0xef, 0x4a, 0x63, 0x5f, 0xff, 0x37, 0xe6, 0x70, 0x1b, 0x07,
+	0x41, 0xef, 0xdb, 0xae, 0xf9, 0x0f, 0xe8, 0x1d, 0x35, 0xdd, 0x6b, 0x2d, 0x22, 0x3e, 0x68, 0x6b,
+	0xdd, 0x7b, 0xb5, 0x53, 0xa3, 0x52, 0x37, 0x69, 0x4c, 0x4a, 0x5e, 0x75, 0xef, 0xf7, 0x01, 0x00,
+	0x46, 0x93, 0x13, 0x66, 0x7b, 0x15, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestationLatency != nil {
+		{
+			size, err := m.AttestationLatency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.ContractBootstrap != nil {
+		{
+			size, err := m.ContractBootstrap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.CurrentBridgeReport != nil {
+		{
+			size, err := m.CurrentBridgeReport.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.LastIncidentRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastIncidentRecordId))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.LastRelayerIncentiveId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRelayerIncentiveId))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingBlockHeight))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.LastOutgoingBatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutgoingBatchNonce))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.LastSendToEthereumId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSendToEthereumId))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for iNdEx := len(m.RateLimitUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.EthereumHeightVotes) > 0 {
+		for iNdEx := len(m.EthereumHeightVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumHeightVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.LastEventNonces) > 0 {
+		for iNdEx := len(m.LastEventNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if m.LastObservedSignerSetTx != nil {
+		{
+			size, err := m.LastObservedSignerSetTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.LastSlashedOutgoingTxHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedOutgoingTxHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestSignerSetTxNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	{
+		size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if len(m.BridgeReports) > 0 {
+		for iNdEx := len(m.BridgeReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if m.ScheduledParamsUpdate != nil {
+		{
+			size, err := m.ScheduledParamsUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.IncidentRecords) > 0 {
+		for iNdEx := len(m.IncidentRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncidentRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.ContractVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.RelayerIncentives) > 0 {
+		for iNdEx := len(m.RelayerIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ForwardedDeposits) > 0 {
+		for iNdEx := len(m.ForwardedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.UnbatchedSendErc1155ToEthereumTxs) > 0 {
+		for iNdEx := len(m.UnbatchedSendErc1155ToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedSendErc1155ToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Erc1155Tokens) > 0 {
+		for iNdEx := len(m.Erc1155Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc1155Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.DepositAddresses) > 0 {
+		for iNdEx := len(m.DepositAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.GravityIdRotation != nil {
+		{
+			size, err := m.GravityIdRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ContractMigration != nil {
+		{
+			size, err := m.ContractMigration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.BridgeContract != nil {
+		{
+			size, err := m.BridgeContract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.EvmChains) > 0 {
+		for iNdEx := len(m.EvmChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.EthereumEventVoteRecords) > 0 {
+		for iNdEx := len(m.EthereumEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMChainGenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMChainGenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMChainGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestationLatency != nil {
+		{
+			size, err := m.AttestationLatency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.ContractBootstrap != nil {
+		{
+			size, err := m.ContractBootstrap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for iNdEx := len(m.RateLimitUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.EthereumHeightVotes) > 0 {
+		for iNdEx := len(m.EthereumHeightVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumHeightVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.LastEventNonces) > 0 {
+		for iNdEx := len(m.LastEventNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.LastObservedSignerSetTx != nil {
+		{
+			size, err := m.LastObservedSignerSetTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.LastSlashedOutgoingTxHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedOutgoingTxHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestSignerSetTxNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.ContractVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractVersion))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.UnbatchedSendErc1155ToEthereumTxs) > 0 {
+		for iNdEx := len(m.UnbatchedSendErc1155ToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedSendErc1155ToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DepositAddresses) > 0 {
+		for iNdEx := len(m.DepositAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.GravityIdRotation != nil {
+		{
+			size, err := m.GravityIdRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ContractMigration != nil {
+		{
+			size, err := m.ContractMigration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BridgeContract != nil {
+		{
+			size, err := m.BridgeContract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EthereumEventVoteRecords) > 0 {
+		for iNdEx := len(m.EthereumEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEventNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEventNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEventNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumHeightVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumHeightVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumHeightVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenRateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenRateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenRateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractBootstrap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractBootstrap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractBootstrap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LastSignerSetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSignerSetNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for _, e := range m.OutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumEventVoteRecords) > 0 {
+		for _, e := range m.EthereumEventVoteRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for _, e := range m.UnbatchedSendToEthereumTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EvmChains) > 0 {
+		for _, e := range m.EvmChains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgeContract != nil {
+		l = m.BridgeContract.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ContractMigration != nil {
+		l = m.ContractMigration.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Paused {
+		n += 3
+	}
+	if m.GravityIdRotation != nil {
+		l = m.GravityIdRotation.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DepositAddresses) > 0 {
+		for _, e := range m.DepositAddresses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc1155Tokens) > 0 {
+		for _, e := range m.Erc1155Tokens {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedSendErc1155ToEthereumTxs) > 0 {
+		for _, e := range m.UnbatchedSendErc1155ToEthereumTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForwardedDeposits) > 0 {
+		for _, e := range m.ForwardedDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerIncentives) > 0 {
+		for _, e := range m.RelayerIncentives {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ContractVersion != 0 {
+		n += 2 + sovGenesis(uint64(m.ContractVersion))
+	}
+	if len(m.IncidentRecords) > 0 {
+		for _, e := range m.IncidentRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ScheduledParamsUpdate != nil {
+		l = m.ScheduledParamsUpdate.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BridgeReports) > 0 {
+		for _, e := range m.BridgeReports {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LastObservedEthereumHeight.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.LatestSignerSetTxNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LatestSignerSetTxNonce))
+	}
+	if m.LastSlashedOutgoingTxHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedOutgoingTxHeight))
+	}
+	if m.LastObservedSignerSetTx != nil {
+		l = m.LastObservedSignerSetTx.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.LastEventNonces) > 0 {
+		for _, e := range m.LastEventNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumHeightVotes) > 0 {
+		for _, e := range m.EthereumHeightVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for _, e := range m.RateLimitUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSendToEthereumId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSendToEthereumId))
+	}
+	if m.LastOutgoingBatchNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastOutgoingBatchNonce))
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastUnbondingBlockHeight))
+	}
+	if m.LastRelayerIncentiveId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastRelayerIncentiveId))
+	}
+	if m.LastIncidentRecordId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastIncidentRecordId))
+	}
+	if m.CurrentBridgeReport != nil {
+		l = m.CurrentBridgeReport.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.ContractBootstrap != nil {
+		l = m.ContractBootstrap.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.AttestationLatency != nil {
+		l = m.AttestationLatency.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *EVMChainGenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for _, e := range m.OutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumEventVoteRecords) > 0 {
+		for _, e := range m.EthereumEventVoteRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for _, e := range m.UnbatchedSendToEthereumTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgeContract != nil {
+		l = m.BridgeContract.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ContractMigration != nil {
+		l = m.ContractMigration.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.GravityIdRotation != nil {
+		l = m.GravityIdRotation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DepositAddresses) > 0 {
+		for _, e := range m.DepositAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedSendErc1155ToEthereumTxs) > 0 {
+		for _, e := range m.UnbatchedSendErc1155ToEthereumTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ContractVersion != 0 {
+		n += 1 + sovGenesis(uint64(m.ContractVersion))
+	}
+	l = m.LastObservedEthereumHeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LatestSignerSetTxNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LatestSignerSetTxNonce))
+	}
+	if m.LastSlashedOutgoingTxHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedOutgoingTxHeight))
+	}
+	if m.LastObservedSignerSetTx != nil {
+		l = m.LastObservedSignerSetTx.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.LastEventNonces) > 0 {
+		for _, e := range m.LastEventNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumHeightVotes) > 0 {
+		for _, e := range m.EthereumHeightVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for _, e := range m.RateLimitUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ContractBootstrap != nil {
+		l = m.ContractBootstrap.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.AttestationLatency != nil {
+		l = m.AttestationLatency.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorEventNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *EthereumHeightVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TokenRateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ContractBootstrap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EthereumHeight))
+	}
+	if m.LastEventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastEventNonce))
+	}
+	if m.LastSignerSetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSignerSetNonce))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LastBatchNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBatchNonce))
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, &types.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
+			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChains = append(m.EvmChains, EVMChainGenesisState{})
+			if err := m.EvmChains[len(m.EvmChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BridgeContract == nil {
+				m.BridgeContract = &BridgeContract{}
+			}
+			if err := m.BridgeContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMigration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractMigration == nil {
+				m.ContractMigration = &ContractMigration{}
+			}
+			if err := m.ContractMigration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityIdRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GravityIdRotation == nil {
+				m.GravityIdRotation = &GravityIDRotation{}
+			}
+			if err := m.GravityIdRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAddresses = append(m.DepositAddresses, DepositAddress{})
+			if err := m.DepositAddresses[len(m.DepositAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc1155Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc1155Tokens = append(m.Erc1155Tokens, ERC1155Token{})
+			if err := m.Erc1155Tokens[len(m.Erc1155Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendErc1155ToEthereumTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedSendErc1155ToEthereumTxs = append(m.UnbatchedSendErc1155ToEthereumTxs, &SendERC1155ToEthereum{})
+			if err := m.UnbatchedSendErc1155ToEthereumTxs[len(m.UnbatchedSendErc1155ToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedDeposits = append(m.ForwardedDeposits, ForwardedDeposit{})
+			if err := m.ForwardedDeposits[len(m.ForwardedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerIncentives = append(m.RelayerIncentives, RelayerIncentive{})
+			if err := m.RelayerIncentives[len(m.RelayerIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractVersion", wireType)
+			}
+			m.ContractVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncidentRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncidentRecords = append(m.IncidentRecords, IncidentRecord{})
+			if err := m.IncidentRecords[len(m.IncidentRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParamsUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledParamsUpdate == nil {
+				m.ScheduledParamsUpdate = &ScheduledParamsUpdate{}
+			}
+			if err := m.ScheduledParamsUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeReports = append(m.BridgeReports, BridgeReport{})
+			if err := m.BridgeReports[len(m.BridgeReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetTxNonce", wireType)
+			}
+			m.LatestSignerSetTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedOutgoingTxHeight", wireType)
+			}
+			m.LastSlashedOutgoingTxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedOutgoingTxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedSignerSetTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedSignerSetTx == nil {
+				m.LastObservedSignerSetTx = &SignerSetTx{}
+			}
+			if err := m.LastObservedSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventNonces = append(m.LastEventNonces, ValidatorEventNonce{})
+			if err := m.LastEventNonces[len(m.LastEventNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumHeightVotes = append(m.EthereumHeightVotes, EthereumHeightVote{})
+			if err := m.EthereumHeightVotes[len(m.EthereumHeightVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitUsages = append(m.RateLimitUsages, TokenRateLimitUsage{})
+			if err := m.RateLimitUsages[len(m.RateLimitUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSendToEthereumId", wireType)
+			}
+			m.LastSendToEthereumId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSendToEthereumId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutgoingBatchNonce", wireType)
+			}
+			m.LastOutgoingBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOutgoingBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingBlockHeight", wireType)
+			}
+			m.LastUnbondingBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnbondingBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRelayerIncentiveId", wireType)
+			}
+			m.LastRelayerIncentiveId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRelayerIncentiveId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIncidentRecordId", wireType)
+			}
+			m.LastIncidentRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastIncidentRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBridgeReport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentBridgeReport == nil {
+				m.CurrentBridgeReport = &BridgeReport{}
+			}
+			if err := m.CurrentBridgeReport.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractBootstrap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractBootstrap == nil {
+				m.ContractBootstrap = &ContractBootstrap{}
+			}
+			if err := m.ContractBootstrap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttestationLatency == nil {
+				m.AttestationLatency = &AttestationLatency{}
+			}
+			if err := m.AttestationLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMChainGenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMChainGenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EVMChainGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, &types.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
+			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BridgeContract == nil {
+				m.BridgeContract = &BridgeContract{}
+			}
+			if err := m.BridgeContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMigration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractMigration == nil {
+				m.ContractMigration = &ContractMigration{}
+			}
+			if err := m.ContractMigration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityIdRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GravityIdRotation == nil {
+				m.GravityIdRotation = &GravityIDRotation{}
+			}
+			if err := m.GravityIdRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAddresses = append(m.DepositAddresses, DepositAddress{})
+			if err := m.DepositAddresses[len(m.DepositAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendErc1155ToEthereumTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedSendErc1155ToEthereumTxs = append(m.UnbatchedSendErc1155ToEthereumTxs, &SendERC1155ToEthereum{})
+			if err := m.UnbatchedSendErc1155ToEthereumTxs[len(m.UnbatchedSendErc1155ToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractVersion", wireType)
+			}
+			m.ContractVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetTxNonce", wireType)
+			}
+			m.LatestSignerSetTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedOutgoingTxHeight", wireType)
+			}
+			m.LastSlashedOutgoingTxHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -912,29 +4212,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastSlashedOutgoingTxHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedSignerSetTx", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -944,29 +4231,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			if m.LastObservedSignerSetTx == nil {
+				m.LastObservedSignerSetTx = &SignerSetTx{}
+			}
+			if err := m.LastObservedSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonces", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -976,29 +4267,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.LastEventNonces = append(m.LastEventNonces, ValidatorEventNonce{})
+			if err := m.LastEventNonces[len(m.LastEventNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightVotes", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1008,35 +4301,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedSignerSetTxsWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumHeightVotes = append(m.EthereumHeightVotes, EthereumHeightVote{})
+			if err := m.EthereumHeightVotes[len(m.EthereumHeightVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsages", wireType)
 			}
-			m.SignedBatchesWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1046,16 +4335,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitUsages = append(m.RateLimitUsages, TokenRateLimitUsage{})
+			if err := m.RateLimitUsages[len(m.RateLimitUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractBootstrap", wireType)
 			}
-			m.EthereumSignaturesWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1065,16 +4369,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractBootstrap == nil {
+				m.ContractBootstrap = &ContractBootstrap{}
+			}
+			if err := m.ContractBootstrap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TargetEthTxTimeout = 0
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationLatency", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1084,16 +4405,83 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttestationLatency == nil {
+				m.AttestationLatency = &AttestationLatency{}
+			}
+			if err := m.AttestationLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEventNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEventNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEventNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.AverageBlockTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1103,16 +4491,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.AverageEthereumBlockTime = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1122,16 +4523,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumHeightVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumHeightVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumHeightVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1141,30 +4592,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1174,30 +4624,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenRateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenRateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenRateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1207,30 +4707,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1240,44 +4739,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
-			}
-			m.UnbondSlashingSignerSetTxsWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1299,7 +4779,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ContractBootstrap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1322,17 +4802,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractBootstrap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractBootstrap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-			var msglen int
+			m.EthereumHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1342,33 +4822,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EthereumHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonce", wireType)
 			}
-			m.LastObservedEventNonce = 0
+			m.LastEventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1378,16 +4841,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				m.LastEventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSignerSetNonce", wireType)
 			}
-			var msglen int
+			m.LastSignerSetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1397,29 +4860,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastSignerSetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
-			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1446,50 +4894,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirmations = append(m.Confirmations, &types.Any{})
-			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tokens = append(m.Tokens, ContractToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
-			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1499,31 +4963,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1533,31 +4995,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchNonce", wireType)
 			}
-			var msglen int
+			m.LastBatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1567,26 +5029,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastBatchNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
-			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,31 @@ package types
 import (
 	"testing"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisStateValidate(t *testing.T) {
 	var nilByteSlice []byte
+	ibcForwardParams := func(channels ...IBCForwardChannel) *Params {
+		params := DefaultParams()
+		params.IbcForwardChannels = channels
+		return params
+	}
+	logicCallParams := func(templates ...LogicCallTemplate) *Params {
+		params := DefaultParams()
+		params.LogicCallTemplates = templates
+		return params
+	}
+	outgoingTxs := func(versions ...uint32) []*cdctypes.Any {
+		var otxs []*cdctypes.Any
+		for i, version := range versions {
+			otx, err := PackOutgoingTx(&BatchTx{BatchNonce: uint64(i + 1), TokenContract: "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf", CheckpointVersion: version})
+			require.NoError(t, err)
+			otxs = append(otxs, otx)
+		}
+		return otxs
+	}
 	specs := map[string]struct {
 		src    *GenesisState
 		expErr bool
@@ -88,6 +108,84 @@ func TestGenesisStateValidate(t *testing.T) {
 				},
 			},
 		}, expErr: true},
+		"valid evm chain": {src: &GenesisState{
+			Params: DefaultParams(),
+			EvmChains: []EVMChainGenesisState{
+				{Chain: EVMChain{ChainId: 42161, GravityId: "arbitrum", BridgeEthereumAddress: "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf"}},
+			},
+		}, expErr: false},
+		"evm chain with the default chain id": {src: &GenesisState{
+			Params: DefaultParams(),
+			EvmChains: []EVMChainGenesisState{
+				{Chain: EVMChain{ChainId: DefaultParams().BridgeChainId, GravityId: "arbitrum", BridgeEthereumAddress: "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf"}},
+			},
+		}, expErr: true},
+		"evm chain with bad bridge address": {src: &GenesisState{
+			Params: DefaultParams(),
+			EvmChains: []EVMChainGenesisState{
+				{Chain: EVMChain{ChainId: 42161, GravityId: "arbitrum", BridgeEthereumAddress: "0xdeadbeef"}},
+			},
+		}, expErr: true},
+		"valid ibc forward channel": {src: &GenesisState{
+			Params: ibcForwardParams(IBCForwardChannel{ChannelId: "channel-0", Bech32Prefix: "osmo", Timeout: 600}),
+		}, expErr: false},
+		"duplicate ibc forward channel": {src: &GenesisState{
+			Params: ibcForwardParams(
+				IBCForwardChannel{ChannelId: "channel-0", Bech32Prefix: "osmo", Timeout: 600},
+				IBCForwardChannel{ChannelId: "channel-0", Bech32Prefix: "juno", Timeout: 600},
+			),
+		}, expErr: true},
+		"ibc forward channel without prefix": {src: &GenesisState{
+			Params: ibcForwardParams(IBCForwardChannel{ChannelId: "channel-0", Timeout: 600}),
+		}, expErr: true},
+		"ibc forward channel without timeout": {src: &GenesisState{
+			Params: ibcForwardParams(IBCForwardChannel{ChannelId: "channel-0", Bech32Prefix: "osmo"}),
+		}, expErr: true},
+		"valid logic call template": {src: &GenesisState{
+			Params: logicCallParams(LogicCallTemplate{Name: "swap", LogicContract: "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf", Payload: []byte{1}}),
+		}, expErr: false},
+		"duplicate logic call template": {src: &GenesisState{
+			Params: logicCallParams(
+				LogicCallTemplate{Name: "swap", LogicContract: "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf"},
+				LogicCallTemplate{Name: "swap", LogicContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"},
+			),
+		}, expErr: true},
+		"logic call template with bad contract": {src: &GenesisState{
+			Params: logicCallParams(LogicCallTemplate{Name: "swap", LogicContract: "0xdeadbeef"}),
+		}, expErr: true},
+		"unregistered signature scheme": {src: &GenesisState{
+			Params: func() *Params {
+				params := DefaultParams()
+				params.SignatureScheme = SignatureSchemeType(len(SignatureSchemeType_name))
+				return params
+			}(),
+		}, expErr: true},
+		"unregistered checkpoint version": {src: &GenesisState{
+			Params: func() *Params {
+				params := DefaultParams()
+				params.CheckpointVersionActivations = []CheckpointVersionActivation{{Version: CheckpointVersion1 + 1, Height: 100}}
+				return params
+			}(),
+		}, expErr: true},
+		"unversioned and registered checkpoint versions of outgoing txs": {src: &GenesisState{
+			Params:      DefaultParams(),
+			OutgoingTxs: outgoingTxs(0, CheckpointVersion1),
+		}, expErr: false},
+		"outgoing tx of an unregistered checkpoint version": {src: &GenesisState{
+			Params:      DefaultParams(),
+			OutgoingTxs: outgoingTxs(0, CheckpointVersion1+1),
+		}, expErr: true},
+		"evm chain outgoing tx of an unregistered checkpoint version": {src: &GenesisState{
+			Params: DefaultParams(),
+			EvmChains: []EVMChainGenesisState{{
+				Chain:       EVMChain{ChainId: 42161, GravityId: "arbitrum", BridgeEthereumAddress: "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf"},
+				OutgoingTxs: outgoingTxs(CheckpointVersion1 + 1),
+			}},
+		}, expErr: true},
+		"outgoing tx not unpacked": {src: &GenesisState{
+			Params:      DefaultParams(),
+			OutgoingTxs: []*cdctypes.Any{{TypeUrl: "/gravity.v1.BatchTx"}},
+		}, expErr: true},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
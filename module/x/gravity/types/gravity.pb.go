@@ -27,6 +27,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Finality selects when an EVM chain's blocks are considered final enough for
+// their events to be accepted.
+type Finality int32
+
+const (
+	// a fixed number of blocks past the event's block, for chains with
+	// probabilistic finality like Ethereum mainnet
+	FinalityConfirmations Finality = 0
+	// the block tagged safe by the chain's node
+	FinalitySafe Finality = 1
+	// the block tagged finalized by the chain's node, for optimistic rollups
+	// this is the block whose batch is final on the settlement layer
+	FinalityFinalized Finality = 2
+)
+
+var Finality_name = map[int32]string{
+	0: "FINALITY_CONFIRMATIONS",
+	1: "FINALITY_SAFE",
+	2: "FINALITY_FINALIZED",
+}
+
+var Finality_value = map[string]int32{
+	"FINALITY_CONFIRMATIONS": 0,
+	"FINALITY_SAFE":          1,
+	"FINALITY_FINALIZED":     2,
+}
+
+func (x Finality) String() string {
+	return proto.EnumName(Finality_name, int32(x))
+}
+
+func (Finality) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{0}
+}
+
+// IncidentAction is a manual adjustment of the supply of bridged vouchers
+type IncidentAction int32
+
+const (
+	IncidentActionUnspecified IncidentAction = 0
+	// vouchers minted to an account
+	IncidentActionMint IncidentAction = 1
+	// vouchers burned from an account
+	IncidentActionBurn IncidentAction = 2
+)
+
+var IncidentAction_name = map[int32]string{
+	0: "INCIDENT_ACTION_UNSPECIFIED",
+	1: "INCIDENT_ACTION_MINT",
+	2: "INCIDENT_ACTION_BURN",
+}
+
+var IncidentAction_value = map[string]int32{
+	"INCIDENT_ACTION_UNSPECIFIED": 0,
+	"INCIDENT_ACTION_MINT":        1,
+	"INCIDENT_ACTION_BURN":        2,
+}
+
+func (x IncidentAction) String() string {
+	return proto.EnumName(IncidentAction_name, int32(x))
+}
+
+func (IncidentAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{1}
+}
+
 // EthereumEventVoteRecord is an event that is pending of confirmation by 2/3 of
 // the signer set. The event is then attested and executed in the state machine
 // once the required threshold is met.
@@ -34,6 +100,17 @@ type EthereumEventVoteRecord struct {
 	Event    *types.Any `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
 	Accepted bool       `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// set when governance rejected the record to unblock the chain's events
+	Rejected bool `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// the Cosmos height of the first vote, zero for the records created before
+	// it was recorded
+	FirstVoteHeight uint64 `protobuf:"varint,5,opt,name=first_vote_height,json=firstVoteHeight,proto3" json:"first_vote_height,omitempty"`
+	// the nonce of the signer set whose voter set indexes the vote bitmap, zero
+	// when all the votes are in the votes list
+	SignerSetNonce uint64 `protobuf:"varint,6,opt,name=signer_set_nonce,json=signerSetNonce,proto3" json:"signer_set_nonce,omitempty"`
+	// the votes of the validators of the voter set, bit i set for the voter at
+	// index i, the votes of other validators being in the votes list
+	VoteBitmap []byte `protobuf:"bytes,7,opt,name=vote_bitmap,json=voteBitmap,proto3" json:"vote_bitmap,omitempty"`
 }
 
 func (m *EthereumEventVoteRecord) Reset()         { *m = EthereumEventVoteRecord{} }
@@ -90,6 +167,34 @@ func (m *EthereumEventVoteRecord) GetAccepted() bool {
 	return false
 }
 
+func (m *EthereumEventVoteRecord) GetRejected() bool {
+	if m != nil {
+		return m.Rejected
+	}
+	return false
+}
+
+func (m *EthereumEventVoteRecord) GetFirstVoteHeight() uint64 {
+	if m != nil {
+		return m.FirstVoteHeight
+	}
+	return 0
+}
+
+func (m *EthereumEventVoteRecord) GetSignerSetNonce() uint64 {
+	if m != nil {
+		return m.SignerSetNonce
+	}
+	return 0
+}
+
+func (m *EthereumEventVoteRecord) GetVoteBitmap() []byte {
+	if m != nil {
+		return m.VoteBitmap
+	}
+	return nil
+}
+
 // LatestEthereumBlockHeight defines the latest observed ethereum block height
 // and the corresponding timestamp value in nanoseconds.
 type LatestEthereumBlockHeight struct {
@@ -206,6 +311,9 @@ type SignerSetTx struct {
 	Nonce   uint64          `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	Height  uint64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	Signers EthereumSigners `protobuf:"bytes,3,rep,name=signers,proto3,castrepeated=EthereumSigners" json:"signers,omitempty"`
+	// the version of the encoding of the checkpoint, zero for the txs created
+	// before checkpoints were versioned, which are encoded with the first version
+	CheckpointVersion uint32 `protobuf:"varint,4,opt,name=checkpoint_version,json=checkpointVersion,proto3" json:"checkpoint_version,omitempty"`
 }
 
 func (m *SignerSetTx) Reset()         { *m = SignerSetTx{} }
@@ -262,6 +370,13 @@ func (m *SignerSetTx) GetSigners() EthereumSigners {
 	return nil
 }
 
+func (m *SignerSetTx) GetCheckpointVersion() uint32 {
+	if m != nil {
+		return m.CheckpointVersion
+	}
+	return 0
+}
+
 // BatchTx represents a batch of transactions going from Cosmos to Ethereum.
 // Batch txs are are identified by a unique hash and the token contract that is
 // shared by all the SendToEthereum
@@ -271,6 +386,9 @@ type BatchTx struct {
 	Transactions  []*SendToEthereum `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
 	TokenContract string            `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	Height        uint64            `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// the version of the encoding of the checkpoint, zero for the txs created
+	// before checkpoints were versioned, which are encoded with the first version
+	CheckpointVersion uint32 `protobuf:"varint,6,opt,name=checkpoint_version,json=checkpointVersion,proto3" json:"checkpoint_version,omitempty"`
 }
 
 func (m *BatchTx) Reset()         { *m = BatchTx{} }
@@ -341,6 +459,13 @@ func (m *BatchTx) GetHeight() uint64 {
 	return 0
 }
 
+func (m *BatchTx) GetCheckpointVersion() uint32 {
+	if m != nil {
+		return m.CheckpointVersion
+	}
+	return 0
+}
+
 // SendToEthereum represents an individual SendToEthereum from Cosmos to
 // Ethereum
 type SendToEthereum struct {
@@ -349,6 +474,9 @@ type SendToEthereum struct {
 	EthereumRecipient string     `protobuf:"bytes,3,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
 	Erc20Token        ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token"`
 	Erc20Fee          ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee"`
+	// the EVM chain the transfer goes to, zero for transfers to the default
+	// chain queued before transfers were tagged
+	EvmChainId uint64 `protobuf:"varint,6,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
 }
 
 func (m *SendToEthereum) Reset()         { *m = SendToEthereum{} }
@@ -419,6 +547,277 @@ func (m *SendToEthereum) GetErc20Fee() ERC20Token {
 	return ERC20Token{}
 }
 
+func (m *SendToEthereum) GetEvmChainId() uint64 {
+	if m != nil {
+		return m.EvmChainId
+	}
+	return 0
+}
+
+// ERC1155BatchTx is a batch of transfers of an ERC1155 token from Cosmos to
+// Ethereum. Its checkpoint covers the ids and amounts of all its transfers,
+// flattened into one entry per id, so any number of ids of the token move in a
+// single batch without an ERC20 wrapper per id.
+type ERC1155BatchTx struct {
+	BatchNonce    uint64                   `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	Timeout       uint64                   `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Transactions  []*SendERC1155ToEthereum `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	TokenContract string                   `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Height        uint64                   `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// the version of the encoding of the checkpoint, zero for the txs created
+	// before checkpoints were versioned, which are encoded with the first version
+	CheckpointVersion uint32 `protobuf:"varint,6,opt,name=checkpoint_version,json=checkpointVersion,proto3" json:"checkpoint_version,omitempty"`
+}
+
+func (m *ERC1155BatchTx) Reset()         { *m = ERC1155BatchTx{} }
+func (m *ERC1155BatchTx) String() string { return proto.CompactTextString(m) }
+func (*ERC1155BatchTx) ProtoMessage()    {}
+func (*ERC1155BatchTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{6}
+}
+func (m *ERC1155BatchTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC1155BatchTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC1155BatchTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC1155BatchTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC1155BatchTx.Merge(m, src)
+}
+func (m *ERC1155BatchTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC1155BatchTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC1155BatchTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC1155BatchTx proto.InternalMessageInfo
+
+func (m *ERC1155BatchTx) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *ERC1155BatchTx) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *ERC1155BatchTx) GetTransactions() []*SendERC1155ToEthereum {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *ERC1155BatchTx) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *ERC1155BatchTx) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ERC1155BatchTx) GetCheckpointVersion() uint32 {
+	if m != nil {
+		return m.CheckpointVersion
+	}
+	return 0
+}
+
+// SendERC1155ToEthereum is a transfer of ids of an ERC1155 token from Cosmos
+// to Ethereum. ERC1155 transfers pay no bridge fee, a batch of them is created
+// once the previous batch of the token has been executed or timed out.
+type SendERC1155ToEthereum struct {
+	Id                uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender            string          `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	EthereumRecipient string          `protobuf:"bytes,3,opt,name=ethereum_recipient,json=ethereumRecipient,proto3" json:"ethereum_recipient,omitempty"`
+	TokenContract     string          `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amounts           []ERC1155Amount `protobuf:"bytes,5,rep,name=amounts,proto3" json:"amounts"`
+}
+
+func (m *SendERC1155ToEthereum) Reset()         { *m = SendERC1155ToEthereum{} }
+func (m *SendERC1155ToEthereum) String() string { return proto.CompactTextString(m) }
+func (*SendERC1155ToEthereum) ProtoMessage()    {}
+func (*SendERC1155ToEthereum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{7}
+}
+func (m *SendERC1155ToEthereum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendERC1155ToEthereum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendERC1155ToEthereum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendERC1155ToEthereum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendERC1155ToEthereum.Merge(m, src)
+}
+func (m *SendERC1155ToEthereum) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendERC1155ToEthereum) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendERC1155ToEthereum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendERC1155ToEthereum proto.InternalMessageInfo
+
+func (m *SendERC1155ToEthereum) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SendERC1155ToEthereum) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SendERC1155ToEthereum) GetEthereumRecipient() string {
+	if m != nil {
+		return m.EthereumRecipient
+	}
+	return ""
+}
+
+func (m *SendERC1155ToEthereum) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *SendERC1155ToEthereum) GetAmounts() []ERC1155Amount {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
+// ERC1155Amount is an amount of one id of an ERC1155 token
+type ERC1155Amount struct {
+	Id     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=id,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"id"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *ERC1155Amount) Reset()         { *m = ERC1155Amount{} }
+func (m *ERC1155Amount) String() string { return proto.CompactTextString(m) }
+func (*ERC1155Amount) ProtoMessage()    {}
+func (*ERC1155Amount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{8}
+}
+func (m *ERC1155Amount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC1155Amount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC1155Amount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC1155Amount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC1155Amount.Merge(m, src)
+}
+func (m *ERC1155Amount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC1155Amount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC1155Amount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC1155Amount proto.InternalMessageInfo
+
+// ERC1155Token is the id of an ERC1155 token vouchers are minted for. Their
+// denom is gravity1155/ followed by the hex SHA256 hash of
+// <evm chain id>/<contract>/<id>, ids being too long to fit in a denom.
+type ERC1155Token struct {
+	EvmChainId uint64                                 `protobuf:"varint,1,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	Contract   string                                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Id         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=id,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"id"`
+}
+
+func (m *ERC1155Token) Reset()         { *m = ERC1155Token{} }
+func (m *ERC1155Token) String() string { return proto.CompactTextString(m) }
+func (*ERC1155Token) ProtoMessage()    {}
+func (*ERC1155Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{9}
+}
+func (m *ERC1155Token) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC1155Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC1155Token.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC1155Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC1155Token.Merge(m, src)
+}
+func (m *ERC1155Token) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC1155Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC1155Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC1155Token proto.InternalMessageInfo
+
+func (m *ERC1155Token) GetEvmChainId() uint64 {
+	if m != nil {
+		return m.EvmChainId
+	}
+	return 0
+}
+
+func (m *ERC1155Token) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
 // ContractCallTx represents an individual arbitrary logic call transaction
 // from Cosmos to Ethereum.
 type ContractCallTx struct {
@@ -430,13 +829,19 @@ type ContractCallTx struct {
 	Tokens            []ERC20Token `protobuf:"bytes,6,rep,name=tokens,proto3" json:"tokens"`
 	Fees              []ERC20Token `protobuf:"bytes,7,rep,name=fees,proto3" json:"fees"`
 	Height            uint64       `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// the account the tokens and fees are refunded to if the call times out,
+	// empty if they aren't refunded
+	RefundAddress string `protobuf:"bytes,9,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// the version of the encoding of the checkpoint, zero for the txs created
+	// before checkpoints were versioned, which are encoded with the first version
+	CheckpointVersion uint32 `protobuf:"varint,10,opt,name=checkpoint_version,json=checkpointVersion,proto3" json:"checkpoint_version,omitempty"`
 }
 
 func (m *ContractCallTx) Reset()         { *m = ContractCallTx{} }
 func (m *ContractCallTx) String() string { return proto.CompactTextString(m) }
 func (*ContractCallTx) ProtoMessage()    {}
 func (*ContractCallTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{6}
+	return fileDescriptor_1715a041eadeb531, []int{10}
 }
 func (m *ContractCallTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +926,20 @@ func (m *ContractCallTx) GetHeight() uint64 {
 	return 0
 }
 
+func (m *ContractCallTx) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *ContractCallTx) GetCheckpointVersion() uint32 {
+	if m != nil {
+		return m.CheckpointVersion
+	}
+	return 0
+}
+
 type ERC20Token struct {
 	Contract string                                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 	Amount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
@@ -530,7 +949,7 @@ func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
 func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20Token) ProtoMessage()    {}
 func (*ERC20Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{7}
+	return fileDescriptor_1715a041eadeb531, []int{11}
 }
 func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +993,7 @@ func (m *IDSet) Reset()         { *m = IDSet{} }
 func (m *IDSet) String() string { return proto.CompactTextString(m) }
 func (*IDSet) ProtoMessage()    {}
 func (*IDSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{8}
+	return fileDescriptor_1715a041eadeb531, []int{12}
 }
 func (m *IDSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +1040,7 @@ type CommunityPoolEthereumSpendProposal struct {
 func (m *CommunityPoolEthereumSpendProposal) Reset()      { *m = CommunityPoolEthereumSpendProposal{} }
 func (*CommunityPoolEthereumSpendProposal) ProtoMessage() {}
 func (*CommunityPoolEthereumSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{9}
+	return fileDescriptor_1715a041eadeb531, []int{13}
 }
 func (m *CommunityPoolEthereumSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,31 +1069,46 @@ func (m *CommunityPoolEthereumSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolEthereumSpendProposal proto.InternalMessageInfo
 
-// This format of the community spend Ethereum proposal is specifically for
-// the CLI to allow simple text serialization.
-type CommunityPoolEthereumSpendProposalForCLI struct {
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	Recipient   string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
-	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty" yaml:"amount"`
-	BridgeFee   string `protobuf:"bytes,5,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee,omitempty" yaml:"bridge_fee"`
-	Deposit     string `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+// EVMChain describes an EVM chain bridged to by its own Gravity contract. The
+// default chain is described by the gravity_id, bridge_ethereum_address and
+// bridge_chain_id params, additional chains are added by governance.
+type EVMChain struct {
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// like the gravity_id param this salts the signatures for the chain's
+	// Gravity contract and must not be changed once the contract is deployed
+	GravityId             string   `protobuf:"bytes,3,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	BridgeEthereumAddress string   `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	Finality              Finality `protobuf:"varint,5,opt,name=finality,proto3,enum=gravity.v1.Finality" json:"finality,omitempty"`
+	// only used with FINALITY_CONFIRMATIONS
+	MinimumConfirmations uint64 `protobuf:"varint,6,opt,name=minimum_confirmations,json=minimumConfirmations,proto3" json:"minimum_confirmations,omitempty"`
+	// the minimum bridge fees transfers to the chain must pay, priced in the
+	// chain's gas economics
+	FeeFloors []FeeFloor `protobuf:"bytes,7,rep,name=fee_floors,json=feeFloors,proto3" json:"fee_floors"`
+	// the DepositAddressFactory deposit addresses of the chain are cloned by,
+	// empty if the chain has none
+	DepositAddressFactory string `protobuf:"bytes,8,opt,name=deposit_address_factory,json=depositAddressFactory,proto3" json:"deposit_address_factory,omitempty"`
+	// the denoms whose ERC20s on the chain use other decimals than the denom
+	TokenDecimals []TokenDecimals `protobuf:"bytes,9,rep,name=token_decimals,json=tokenDecimals,proto3" json:"token_decimals"`
+	// the decimals of the chain's native gas token, zero for the usual 18
+	NativeDecimals uint32 `protobuf:"varint,10,opt,name=native_decimals,json=nativeDecimals,proto3" json:"native_decimals,omitempty"`
+	// caps on the amounts of tokens transferred to the chain, so an incident on
+	// it can only drain so much before governance pauses it
+	RateLimits []RateLimit `protobuf:"bytes,11,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) Reset() {
-	*m = CommunityPoolEthereumSpendProposalForCLI{}
+func (m *EVMChain) Reset()         { *m = EVMChain{} }
+func (m *EVMChain) String() string { return proto.CompactTextString(m) }
+func (*EVMChain) ProtoMessage()    {}
+func (*EVMChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{14}
 }
-func (m *CommunityPoolEthereumSpendProposalForCLI) String() string { return proto.CompactTextString(m) }
-func (*CommunityPoolEthereumSpendProposalForCLI) ProtoMessage()    {}
-func (*CommunityPoolEthereumSpendProposalForCLI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1715a041eadeb531, []int{10}
-}
-func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Unmarshal(b []byte) error {
+func (m *EVMChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EVMChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EVMChain.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -684,966 +1118,14710 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI.Merge(m, src)
+func (m *EVMChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMChain.Merge(m, src)
 }
-func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Size() int {
+func (m *EVMChain) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI.DiscardUnknown(m)
+func (m *EVMChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMChain.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI proto.InternalMessageInfo
+var xxx_messageInfo_EVMChain proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*EthereumEventVoteRecord)(nil), "gravity.v1.EthereumEventVoteRecord")
-	proto.RegisterType((*LatestEthereumBlockHeight)(nil), "gravity.v1.LatestEthereumBlockHeight")
-	proto.RegisterType((*EthereumSigner)(nil), "gravity.v1.EthereumSigner")
-	proto.RegisterType((*SignerSetTx)(nil), "gravity.v1.SignerSetTx")
-	proto.RegisterType((*BatchTx)(nil), "gravity.v1.BatchTx")
-	proto.RegisterType((*SendToEthereum)(nil), "gravity.v1.SendToEthereum")
-	proto.RegisterType((*ContractCallTx)(nil), "gravity.v1.ContractCallTx")
-	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
-	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
-	proto.RegisterType((*CommunityPoolEthereumSpendProposal)(nil), "gravity.v1.CommunityPoolEthereumSpendProposal")
-	proto.RegisterType((*CommunityPoolEthereumSpendProposalForCLI)(nil), "gravity.v1.CommunityPoolEthereumSpendProposalForCLI")
+func (m *EVMChain) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
-
-var fileDescriptor_1715a041eadeb531 = []byte{
-	// 1046 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x36, 0x6d, 0x26, 0x69, 0xb6, 0x1d, 0xca, 0xe2, 0x56, 0x28, 0x8e, 0x8c, 0x58,
-	0xb2, 0x12, 0xb5, 0xdb, 0xec, 0x4a, 0x40, 0xd1, 0xae, 0xb4, 0x0e, 0x5b, 0x51, 0x69, 0x85, 0x16,
-	0xb7, 0x70, 0xe0, 0x52, 0x39, 0xf6, 0xab, 0x63, 0xea, 0x78, 0x2c, 0x7b, 0x12, 0x9a, 0x23, 0x17,
-	0x84, 0x38, 0x71, 0xe4, 0xd8, 0x33, 0x67, 0x8e, 0xdc, 0xb8, 0xac, 0x38, 0xed, 0x11, 0x38, 0x04,
-	0x68, 0x2f, 0x9c, 0xf3, 0x17, 0x20, 0xcf, 0x0f, 0xd7, 0xde, 0x5d, 0x51, 0x4e, 0x9d, 0xf7, 0xde,
-	0xf7, 0xbd, 0x79, 0xf3, 0xcd, 0x57, 0x4f, 0x90, 0xea, 0x27, 0xce, 0x34, 0xa0, 0x33, 0x73, 0xba,
-	0x67, 0x8a, 0xa5, 0x11, 0x27, 0x84, 0x12, 0x8c, 0x64, 0x38, 0xdd, 0xdb, 0xee, 0xb8, 0x24, 0x1d,
-	0x93, 0xd4, 0x1c, 0x3a, 0x29, 0x98, 0xd3, 0xbd, 0x21, 0x50, 0x67, 0xcf, 0x74, 0x49, 0x10, 0x71,
-	0xec, 0xf6, 0x16, 0xaf, 0x9f, 0xb0, 0xc8, 0xe4, 0x81, 0x28, 0x6d, 0xfa, 0xc4, 0x27, 0x3c, 0x9f,
-	0xad, 0x24, 0xc1, 0x27, 0xc4, 0x0f, 0xc1, 0x64, 0xd1, 0x70, 0x72, 0x6a, 0x3a, 0x91, 0xd8, 0x57,
-	0xff, 0x4e, 0x41, 0x6f, 0x3c, 0xa6, 0x23, 0x48, 0x60, 0x32, 0x7e, 0x3c, 0x85, 0x88, 0x7e, 0x4e,
-	0x28, 0xd8, 0xe0, 0x92, 0xc4, 0xc3, 0x0f, 0xd0, 0x32, 0x64, 0x29, 0x55, 0xe9, 0x2a, 0xbd, 0x66,
-	0x7f, 0xd3, 0xe0, 0x6d, 0x0c, 0xd9, 0xc6, 0x78, 0x14, 0xcd, 0xac, 0x8d, 0x5f, 0x7f, 0xda, 0x59,
-	0x2b, 0x75, 0xb0, 0x39, 0x0b, 0x6f, 0xa2, 0xe5, 0x29, 0xa1, 0x90, 0xaa, 0xd5, 0x6e, 0xad, 0xd7,
-	0xb0, 0x79, 0x80, 0xb7, 0xd1, 0xaa, 0xe3, 0xba, 0x10, 0x53, 0xf0, 0xd4, 0x5a, 0x57, 0xe9, 0xad,
-	0xda, 0x79, 0xac, 0x07, 0x68, 0xeb, 0x89, 0x43, 0x21, 0xa5, 0xb2, 0x9f, 0x15, 0x12, 0xf7, 0xec,
-	0x63, 0x08, 0xfc, 0x11, 0xc5, 0xef, 0xa0, 0x5b, 0x20, 0xd2, 0x27, 0x23, 0x96, 0x62, 0x73, 0x2d,
-	0xd9, 0x6d, 0x99, 0x16, 0xc0, 0xb7, 0xd0, 0x9a, 0x10, 0x48, 0xc0, 0xaa, 0x0c, 0xd6, 0xe2, 0x49,
-	0x0e, 0xd2, 0x3f, 0x45, 0x6d, 0xb9, 0xc9, 0x51, 0xe0, 0x47, 0x90, 0x64, 0xe3, 0xc6, 0xe4, 0x2b,
-	0x48, 0x44, 0x57, 0x1e, 0xe0, 0xbb, 0x68, 0x3d, 0xdf, 0xd5, 0xf1, 0xbc, 0x04, 0xd2, 0x94, 0xf5,
-	0x6b, 0xd8, 0xf9, 0x34, 0x8f, 0x78, 0x5a, 0xff, 0x46, 0x41, 0x4d, 0xde, 0xeb, 0x08, 0xe8, 0xf1,
-	0x79, 0xd6, 0x30, 0x22, 0x91, 0x0b, 0xb2, 0x21, 0x0b, 0xf0, 0x6d, 0x54, 0x2f, 0x8d, 0x25, 0x22,
-	0x7c, 0x88, 0x56, 0x52, 0x46, 0x4e, 0xd5, 0x5a, 0xb7, 0xd6, 0x6b, 0xf6, 0xb7, 0x8d, 0x6b, 0x4b,
-	0x18, 0xe5, 0x59, 0xad, 0xd7, 0x7e, 0xfc, 0x53, 0xbb, 0x55, 0xce, 0xa5, 0xb6, 0xe4, 0xeb, 0xbf,
-	0x28, 0x68, 0xc5, 0x72, 0xa8, 0x3b, 0x3a, 0x3e, 0xc7, 0x1a, 0x6a, 0x0e, 0xb3, 0xe5, 0x49, 0x71,
-	0x14, 0xc4, 0x52, 0x9f, 0xb0, 0x79, 0x54, 0xb4, 0x42, 0x83, 0x31, 0x90, 0x89, 0x1c, 0x48, 0x86,
-	0xf8, 0x21, 0x6a, 0xd1, 0xc4, 0x89, 0x52, 0xc7, 0xa5, 0x01, 0x89, 0x5e, 0x39, 0xd6, 0x11, 0x44,
-	0xde, 0x31, 0x91, 0x83, 0xd8, 0x25, 0x3c, 0x7e, 0x1b, 0xb5, 0x29, 0x39, 0x83, 0xe8, 0xc4, 0x25,
-	0x11, 0x4d, 0x1c, 0x97, 0xaa, 0x4b, 0x4c, 0xb8, 0x35, 0x96, 0x1d, 0x88, 0x64, 0x41, 0x90, 0xe5,
-	0xa2, 0x20, 0xfa, 0xdf, 0x0a, 0x6a, 0x97, 0xfb, 0xe3, 0x36, 0xaa, 0x06, 0x9e, 0x38, 0x43, 0x35,
-	0xf0, 0x32, 0x6a, 0x0a, 0x91, 0x07, 0x89, 0xb8, 0x12, 0x11, 0xe1, 0x1d, 0x84, 0xf3, 0x4b, 0x4b,
-	0xc0, 0x0d, 0xe2, 0x20, 0x73, 0x71, 0x8d, 0x61, 0x36, 0x64, 0xc5, 0x96, 0x05, 0xfc, 0x00, 0x35,
-	0x21, 0x71, 0xfb, 0xbb, 0x27, 0x6c, 0x30, 0x36, 0x65, 0xb3, 0x7f, 0xbb, 0x24, 0xbf, 0x3d, 0xe8,
-	0xef, 0x1e, 0x67, 0x55, 0x6b, 0xe9, 0xd9, 0x5c, 0xab, 0xd8, 0x88, 0x11, 0x58, 0x06, 0x7f, 0x80,
-	0x1a, 0x9c, 0x7e, 0x0a, 0xc0, 0xce, 0x70, 0x13, 0x79, 0x95, 0xc1, 0x0f, 0x00, 0xf4, 0x9f, 0xab,
-	0xa8, 0x2d, 0x85, 0x18, 0x38, 0x61, 0x78, 0x7c, 0x9e, 0xcd, 0x1e, 0x44, 0x53, 0x27, 0x0c, 0x3c,
-	0x27, 0x93, 0xb1, 0x74, 0x6f, 0x1b, 0xc5, 0x0a, 0xbf, 0xbe, 0x17, 0xe1, 0xa9, 0x4b, 0x62, 0x60,
-	0x72, 0xb4, 0xca, 0xf0, 0xa3, 0xac, 0x90, 0xdd, 0xb6, 0x74, 0x31, 0x97, 0x43, 0x86, 0x59, 0x25,
-	0x76, 0x66, 0x21, 0x71, 0x3c, 0x26, 0x40, 0xcb, 0x96, 0x61, 0xd1, 0x21, 0xcb, 0x65, 0x87, 0xdc,
-	0x47, 0x75, 0x26, 0x59, 0xaa, 0xd6, 0x99, 0x37, 0xfe, 0xfb, 0xd8, 0x02, 0x8b, 0x77, 0xd1, 0xd2,
-	0x29, 0x40, 0xaa, 0xae, 0xfc, 0x0f, 0x0e, 0x43, 0x16, 0x2c, 0xb2, 0x5a, 0xb2, 0x48, 0x8c, 0xd0,
-	0x35, 0x23, 0xfb, 0xb2, 0xe4, 0x4e, 0x53, 0xd8, 0xe1, 0xf2, 0x18, 0x1f, 0xa0, 0xba, 0x33, 0x26,
-	0x93, 0x88, 0x9b, 0xbc, 0x61, 0x19, 0x59, 0xf7, 0x3f, 0xe6, 0xda, 0x1d, 0x3f, 0xa0, 0xa3, 0xc9,
-	0xd0, 0x70, 0xc9, 0x58, 0x7c, 0x48, 0xc5, 0x9f, 0x9d, 0xd4, 0x3b, 0x33, 0xe9, 0x2c, 0x86, 0xd4,
-	0x38, 0x8c, 0xa8, 0x2d, 0xd8, 0xfa, 0x16, 0x5a, 0x3e, 0xfc, 0xe8, 0x08, 0x28, 0x5e, 0x47, 0xb5,
-	0xc0, 0x4b, 0x55, 0xa5, 0x5b, 0xeb, 0x2d, 0xd9, 0xd9, 0x52, 0xff, 0xba, 0x8a, 0xf4, 0x01, 0x19,
-	0x8f, 0x27, 0x51, 0x40, 0x67, 0x4f, 0x09, 0x09, 0xf3, 0xff, 0xcf, 0x18, 0x22, 0xef, 0x69, 0x42,
-	0x62, 0x92, 0x3a, 0x61, 0xf6, 0x55, 0xa0, 0x01, 0x0d, 0x41, 0x8c, 0xc8, 0x03, 0xdc, 0x45, 0x4d,
-	0x0f, 0x52, 0x37, 0x09, 0xe2, 0xec, 0xae, 0x84, 0x9d, 0x8b, 0x29, 0xfc, 0x26, 0x6a, 0xbc, 0x68,
-	0xe5, 0xeb, 0x04, 0x7e, 0x2f, 0x3f, 0x1f, 0x77, 0xef, 0x96, 0x21, 0x9e, 0x85, 0xec, 0x0d, 0x31,
-	0xc4, 0x1b, 0x62, 0x0c, 0x48, 0x90, 0x5f, 0x06, 0x87, 0xe3, 0x87, 0x08, 0x0d, 0x93, 0xc0, 0xf3,
-	0xa1, 0xe0, 0xde, 0x1b, 0xc9, 0x0d, 0x4e, 0x39, 0x00, 0xd8, 0x6f, 0x7d, 0x7b, 0xa1, 0x55, 0x7e,
-	0xb8, 0xd0, 0x2a, 0xff, 0x5c, 0x68, 0x15, 0xfd, 0xf7, 0x2a, 0xea, 0xdd, 0xac, 0xc1, 0x01, 0x49,
-	0x06, 0x4f, 0x0e, 0xf1, 0x9d, 0x92, 0x12, 0xd6, 0xfa, 0x62, 0xae, 0xb5, 0x66, 0xce, 0x38, 0xdc,
-	0xd7, 0x59, 0x5a, 0x97, 0xda, 0xbc, 0xff, 0x0a, 0x6d, 0xac, 0xdb, 0x8b, 0xb9, 0x86, 0x39, 0xba,
-	0x50, 0xd4, 0xcb, 0x9a, 0xf5, 0x5f, 0xd2, 0xcc, 0xda, 0x5c, 0xcc, 0xb5, 0x75, 0xce, 0xcb, 0x4b,
-	0x7a, 0x51, 0xc9, 0xbb, 0x25, 0x25, 0x1b, 0xd6, 0xc6, 0x62, 0xae, 0xad, 0x71, 0x82, 0xf0, 0x40,
-	0xae, 0xdd, 0xfd, 0x97, 0xb4, 0x6b, 0x58, 0xaf, 0x2f, 0xe6, 0xda, 0x06, 0x87, 0x5f, 0xd7, 0xf4,
-	0x82, 0x62, 0xf8, 0x5d, 0xb4, 0xe2, 0x41, 0x4c, 0xd2, 0x80, 0xaa, 0x75, 0x46, 0xc1, 0x8b, 0xb9,
-	0xd6, 0x96, 0x47, 0x61, 0x05, 0xdd, 0x96, 0x90, 0xfd, 0x55, 0xa1, 0xaf, 0x62, 0x7d, 0xf6, 0xec,
-	0xb2, 0xa3, 0x3c, 0xbf, 0xec, 0x28, 0x7f, 0x5d, 0x76, 0x94, 0xef, 0xaf, 0x3a, 0x95, 0xe7, 0x57,
-	0x9d, 0xca, 0x6f, 0x57, 0x9d, 0xca, 0x17, 0x1f, 0x16, 0x4c, 0x1c, 0x83, 0xef, 0xcf, 0xbe, 0x9c,
-	0xca, 0x5f, 0x17, 0x3b, 0x7c, 0x5f, 0x73, 0x4c, 0xbc, 0x49, 0x08, 0xe6, 0xf4, 0x9e, 0x79, 0x2e,
-	0x4b, 0xdc, 0xdd, 0xc3, 0x3a, 0x7b, 0xcd, 0xef, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x80,
-	0xb2, 0x8e, 0x9b, 0x08, 0x00, 0x00,
+func (m *EVMChain) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EVMChain) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EthereumEventVoteRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EVMChain) GetBridgeEthereumAddress() string {
+	if m != nil {
+		return m.BridgeEthereumAddress
+	}
+	return ""
 }
 
-func (m *EthereumEventVoteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Accepted {
-		i--
-		if m.Accepted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Votes[iNdEx])
-			copy(dAtA[i:], m.Votes[iNdEx])
-			i = encodeVarintGravity(dAtA, i, uint64(len(m.Votes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *EVMChain) GetFinality() Finality {
+	if m != nil {
+		return m.Finality
 	}
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGravity(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return FinalityConfirmations
+}
+
+func (m *EVMChain) GetMinimumConfirmations() uint64 {
+	if m != nil {
+		return m.MinimumConfirmations
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LatestEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EVMChain) GetFeeFloors() []FeeFloor {
+	if m != nil {
+		return m.FeeFloors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LatestEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EVMChain) GetDepositAddressFactory() string {
+	if m != nil {
+		return m.DepositAddressFactory
+	}
+	return ""
 }
 
-func (m *LatestEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CosmosHeight != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.CosmosHeight))
-		i--
-		dAtA[i] = 0x10
+func (m *EVMChain) GetTokenDecimals() []TokenDecimals {
+	if m != nil {
+		return m.TokenDecimals
 	}
-	if m.EthereumHeight != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *EVMChain) GetNativeDecimals() uint32 {
+	if m != nil {
+		return m.NativeDecimals
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EthereumSigner) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EVMChain) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EthereumSigner) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RateLimit caps the amount of an ERC20, fees included, transferred to an EVM
+// chain within a window of Cosmos blocks. Transfers that would take the total
+// of the current window past the limit are rejected until the next window.
+type RateLimit struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Limit         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"limit"`
+	// the length of a window in blocks
+	Window uint64 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (m *EthereumSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{15}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Power != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SignerSetTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SignerSetTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RateLimitUsage is the amount of a token transferred to an EVM chain since the
+// start of the current window of its rate limit
+type RateLimitUsage struct {
+	WindowStart uint64                                 `protobuf:"varint,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *SignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *RateLimitUsage) Reset()         { *m = RateLimitUsage{} }
+func (m *RateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUsage) ProtoMessage()    {}
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{16}
+}
+func (m *RateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *RateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUsage.Merge(m, src)
+}
+func (m *RateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUsage.DiscardUnknown(m)
 }
 
-func (m *BatchTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitUsage proto.InternalMessageInfo
+
+func (m *RateLimitUsage) GetWindowStart() uint64 {
+	if m != nil {
+		return m.WindowStart
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// IBCForwardChannel is an IBC channel deposits can be transferred on to the
+// counterparty chain in the same step. The 20 address bytes of the deposit's
+// destination are the receiver there, bech32 encoded with the chain's prefix.
+// Vouchers of transfers that time out or fail are refunded to the receiver's
+// account on this chain.
+type IBCForwardChannel struct {
+	ChannelId    string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Bech32Prefix string `protobuf:"bytes,2,opt,name=bech32_prefix,json=bech32Prefix,proto3" json:"bech32_prefix,omitempty"`
+	// how long the counterparty has to receive a transfer, in seconds
+	Timeout uint64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// the channel of the gravitycallback port the outcome of forwarded deposits
+	// is sent on, empty if it isn't sent
+	CallbackChannelId string `protobuf:"bytes,4,opt,name=callback_channel_id,json=callbackChannelId,proto3" json:"callback_channel_id,omitempty"`
+	// the contract on the callback channel's chain the outcome is addressed to
+	CallbackContract string `protobuf:"bytes,5,opt,name=callback_contract,json=callbackContract,proto3" json:"callback_contract,omitempty"`
 }
 
-func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
+func (m *IBCForwardChannel) Reset()         { *m = IBCForwardChannel{} }
+func (m *IBCForwardChannel) String() string { return proto.CompactTextString(m) }
+func (*IBCForwardChannel) ProtoMessage()    {}
+func (*IBCForwardChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{17}
+}
+func (m *IBCForwardChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCForwardChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCForwardChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *IBCForwardChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCForwardChannel.Merge(m, src)
+}
+func (m *IBCForwardChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCForwardChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCForwardChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCForwardChannel proto.InternalMessageInfo
+
+func (m *IBCForwardChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	if len(m.Transactions) > 0 {
-		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return ""
+}
+
+func (m *IBCForwardChannel) GetBech32Prefix() string {
+	if m != nil {
+		return m.Bech32Prefix
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *IBCForwardChannel) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
 	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *IBCForwardChannel) GetCallbackChannelId() string {
+	if m != nil {
+		return m.CallbackChannelId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SendToEthereum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IBCForwardChannel) GetCallbackContract() string {
+	if m != nil {
+		return m.CallbackContract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SendToEthereum) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ForwardedDeposit is a deposit transferred on over IBC whose transfer hasn't
+// been acknowledged or timed out yet
+type ForwardedDeposit struct {
+	EvmChainId     uint64 `protobuf:"varint,1,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	EventNonce     uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumSender string `protobuf:"bytes,3,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	ChannelId      string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the transfer's packet
+	Sequence uint64      `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Receiver string      `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount   types1.Coin `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *SendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
+func (m *ForwardedDeposit) Reset()         { *m = ForwardedDeposit{} }
+func (m *ForwardedDeposit) String() string { return proto.CompactTextString(m) }
+func (*ForwardedDeposit) ProtoMessage()    {}
+func (*ForwardedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{18}
+}
+func (m *ForwardedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.EthereumRecipient) > 0 {
-		i -= len(m.EthereumRecipient)
-		copy(dAtA[i:], m.EthereumRecipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumRecipient)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *ForwardedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedDeposit.Merge(m, src)
+}
+func (m *ForwardedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardedDeposit proto.InternalMessageInfo
+
+func (m *ForwardedDeposit) GetEvmChainId() uint64 {
+	if m != nil {
+		return m.EvmChainId
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *ForwardedDeposit) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	if m.Id != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ForwardedDeposit) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ContractCallTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ForwardedDeposit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ContractCallTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ForwardedDeposit) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
+func (m *ForwardedDeposit) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	return ""
+}
+
+func (m *ForwardedDeposit) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGravity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	return types1.Coin{}
+}
+
+// LogicCallTemplate is a logic call governance allows remote chains to make
+// over IBC, with the tokens of an ICS-20 transfer whose memo names the
+// template. The transferred tokens, less the fee, are sent to the logic
+// contract along with the payload, and are refunded to the transfer's
+// receiver if the call times out.
+type LogicCallTemplate struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the EVM chain the call is made on, zero being the default chain
+	EvmChainId    uint64 `protobuf:"varint,2,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	LogicContract string `protobuf:"bytes,3,opt,name=logic_contract,json=logicContract,proto3" json:"logic_contract,omitempty"`
+	Payload       []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *LogicCallTemplate) Reset()         { *m = LogicCallTemplate{} }
+func (m *LogicCallTemplate) String() string { return proto.CompactTextString(m) }
+func (*LogicCallTemplate) ProtoMessage()    {}
+func (*LogicCallTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{19}
+}
+func (m *LogicCallTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogicCallTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogicCallTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *LogicCallTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicCallTemplate.Merge(m, src)
+}
+func (m *LogicCallTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogicCallTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicCallTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogicCallTemplate proto.InternalMessageInfo
+
+func (m *LogicCallTemplate) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.InvalidationScope) > 0 {
-		i -= len(m.InvalidationScope)
-		copy(dAtA[i:], m.InvalidationScope)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *LogicCallTemplate) GetEvmChainId() uint64 {
+	if m != nil {
+		return m.EvmChainId
 	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *LogicCallTemplate) GetLogicContract() string {
+	if m != nil {
+		return m.LogicContract
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LogicCallTemplate) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TokenDecimals scales the amounts of a denom bridged to an EVM chain whose
+// ERC20 of it uses other decimals than the denom, e.g. a chain whose stablecoins
+// have 18 decimals bridging a 6 decimal denom. ERC20 amounts, including those of
+// outgoing txs and so their checkpoints, are the denom's amounts times
+// 10^(erc20_decimals - denom_decimals). Deposits drop what is below the
+// smallest unit of the denom. Changing the decimals of a denom with transfers
+// pending refunds them at the new scale.
+type TokenDecimals struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	DenomDecimals uint32 `protobuf:"varint,2,opt,name=denom_decimals,json=denomDecimals,proto3" json:"denom_decimals,omitempty"`
+	Erc20Decimals uint32 `protobuf:"varint,3,opt,name=erc20_decimals,json=erc20Decimals,proto3" json:"erc20_decimals,omitempty"`
 }
 
-func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TokenDecimals) Reset()         { *m = TokenDecimals{} }
+func (m *TokenDecimals) String() string { return proto.CompactTextString(m) }
+func (*TokenDecimals) ProtoMessage()    {}
+func (*TokenDecimals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{20}
+}
+func (m *TokenDecimals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenDecimals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenDecimals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGravity(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TokenDecimals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenDecimals.Merge(m, src)
+}
+func (m *TokenDecimals) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenDecimals) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenDecimals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenDecimals proto.InternalMessageInfo
+
+func (m *TokenDecimals) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *IDSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TokenDecimals) GetDenomDecimals() uint32 {
+	if m != nil {
+		return m.DenomDecimals
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TokenDecimals) GetErc20Decimals() uint32 {
+	if m != nil {
+		return m.Erc20Decimals
+	}
+	return 0
 }
 
-func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA5 := make([]byte, len(m.Ids)*10)
-		var j4 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+// FeeFloor is the minimum bridge fee of transfers of an ERC20 to an EVM chain.
+// Fees are paid in the transferred token, so the tokens with a floor are the
+// fee tokens of the chain. Transfers of tokens without a floor may pay any fee.
+type FeeFloor struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	MinimumFee    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minimum_fee,json=minimumFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minimum_fee"`
+}
+
+func (m *FeeFloor) Reset()         { *m = FeeFloor{} }
+func (m *FeeFloor) String() string { return proto.CompactTextString(m) }
+func (*FeeFloor) ProtoMessage()    {}
+func (*FeeFloor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{21}
+}
+func (m *FeeFloor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeFloor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeFloor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintGravity(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FeeFloor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeFloor.Merge(m, src)
+}
+func (m *FeeFloor) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeFloor) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeFloor.DiscardUnknown(m)
 }
 
-func (m *CommunityPoolEthereumSpendProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FeeFloor proto.InternalMessageInfo
+
+func (m *FeeFloor) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CommunityPoolEthereumSpendProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AddEVMChainProposal adds an EVM chain to bridge to, once passed the chain
+// gets its own signer set txs, batches and event nonces.
+type AddEVMChainProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Chain       EVMChain `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain"`
 }
 
-func (m *CommunityPoolEthereumSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *AddEVMChainProposal) Reset()      { *m = AddEVMChainProposal{} }
+func (*AddEVMChainProposal) ProtoMessage() {}
+func (*AddEVMChainProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{22}
+}
+func (m *AddEVMChainProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddEVMChainProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddEVMChainProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *AddEVMChainProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEVMChainProposal.Merge(m, src)
+}
+func (m *AddEVMChainProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddEVMChainProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEVMChainProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddEVMChainProposal proto.InternalMessageInfo
+
+// ContractMigrationProposal moves the bridge of an EVM chain to a newly deployed
+// Gravity contract. Once passed no new batches or contract calls are created for
+// the chain, and when the outstanding ones have executed or timed out the chain
+// cuts over to the new contract, starting a new bridging epoch. From then on only
+// events of the new contract, at or after its deployment height, are accepted.
+// Funds held by the old contract must be moved to the new one out of band.
+type ContractMigrationProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// zero selects the default chain
+	EvmChainId            uint64 `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	BridgeEthereumAddress string `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	// the height the new contract was deployed at
+	EthereumHeight uint64 `protobuf:"varint,5,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+}
+
+func (m *ContractMigrationProposal) Reset()      { *m = ContractMigrationProposal{} }
+func (*ContractMigrationProposal) ProtoMessage() {}
+func (*ContractMigrationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{23}
+}
+func (m *ContractMigrationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMigrationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMigrationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGravity(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *ContractMigrationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMigrationProposal.Merge(m, src)
+}
+func (m *ContractMigrationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMigrationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMigrationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMigrationProposal proto.InternalMessageInfo
+
+// ContractMigration is a migration to a new Gravity contract waiting for the
+// outgoing txs of the current contract to drain.
+type ContractMigration struct {
+	BridgeEthereumAddress string `protobuf:"bytes,1,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	EthereumHeight        uint64 `protobuf:"varint,2,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// the Cosmos height the migration was approved at
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ContractMigration) Reset()         { *m = ContractMigration{} }
+func (m *ContractMigration) String() string { return proto.CompactTextString(m) }
+func (*ContractMigration) ProtoMessage()    {}
+func (*ContractMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{24}
+}
+func (m *ContractMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ContractMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMigration.Merge(m, src)
+}
+func (m *ContractMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMigration proto.InternalMessageInfo
+
+func (m *ContractMigration) GetBridgeEthereumAddress() string {
+	if m != nil {
+		return m.BridgeEthereumAddress
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ContractMigration) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractMigration) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BridgeContract is the Gravity contract an EVM chain is bridged through. The
+// epoch is incremented by each completed contract migration, before the first
+// migration the contract is the one described by the chain's EVMChain entry.
+type BridgeContract struct {
+	BridgeEthereumAddress string `protobuf:"bytes,1,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	Epoch                 uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// events below this height belong to a previous contract
+	EthereumHeight uint64 `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
 }
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.BridgeFee) > 0 {
-		i -= len(m.BridgeFee)
-		copy(dAtA[i:], m.BridgeFee)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeFee)))
-		i--
-		dAtA[i] = 0x2a
+func (m *BridgeContract) Reset()         { *m = BridgeContract{} }
+func (m *BridgeContract) String() string { return proto.CompactTextString(m) }
+func (*BridgeContract) ProtoMessage()    {}
+func (*BridgeContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{25}
+}
+func (m *BridgeContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *BridgeContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeContract.Merge(m, src)
+}
+func (m *BridgeContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeContract proto.InternalMessageInfo
+
+func (m *BridgeContract) GetBridgeEthereumAddress() string {
+	if m != nil {
+		return m.BridgeEthereumAddress
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGravity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BridgeContract) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *EthereumEventVoteRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovGravity(uint64(l))
+
+func (m *BridgeContract) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
 	}
-	if len(m.Votes) > 0 {
-		for _, s := range m.Votes {
-			l = len(s)
-			n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+// EVMChainPauseProposal pauses or resumes bridging to an EVM chain. While the
+// chain is paused no transfers to it are accepted and no batches or contract
+// calls are created for it. Its events are still accepted, so deposits made
+// before the pause took effect are not stranded.
+type EVMChainPauseProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// zero selects the default chain
+	EvmChainId uint64 `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	// false resumes the chain
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *EVMChainPauseProposal) Reset()      { *m = EVMChainPauseProposal{} }
+func (*EVMChainPauseProposal) ProtoMessage() {}
+func (*EVMChainPauseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{26}
+}
+func (m *EVMChainPauseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMChainPauseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMChainPauseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Accepted {
-		n += 2
-	}
-	return n
+}
+func (m *EVMChainPauseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMChainPauseProposal.Merge(m, src)
+}
+func (m *EVMChainPauseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMChainPauseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMChainPauseProposal.DiscardUnknown(m)
 }
 
-func (m *LatestEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EthereumHeight != 0 {
-		n += 1 + sovGravity(uint64(m.EthereumHeight))
-	}
-	if m.CosmosHeight != 0 {
-		n += 1 + sovGravity(uint64(m.CosmosHeight))
-	}
-	return n
+var xxx_messageInfo_EVMChainPauseProposal proto.InternalMessageInfo
+
+// GravityIDRotationProposal rotates the gravity id of an EVM chain, e.g. for a
+// redeploy of its Gravity contract. Once passed the checkpoints of all outgoing
+// txs are produced for the new id, while for the acceptance window confirmations
+// signed for the previous id are still accepted so that orchestrators can move
+// over to the new contract without stalling the bridge.
+type GravityIDRotationProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// zero selects the default chain
+	EvmChainId uint64 `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	GravityId  string `protobuf:"bytes,4,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	// the number of blocks the previous gravity id is still accepted for
+	AcceptanceWindow uint64 `protobuf:"varint,5,opt,name=acceptance_window,json=acceptanceWindow,proto3" json:"acceptance_window,omitempty"`
 }
 
-func (m *EthereumSigner) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Power != 0 {
-		n += 1 + sovGravity(uint64(m.Power))
-	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+func (m *GravityIDRotationProposal) Reset()      { *m = GravityIDRotationProposal{} }
+func (*GravityIDRotationProposal) ProtoMessage() {}
+func (*GravityIDRotationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{27}
+}
+func (m *GravityIDRotationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GravityIDRotationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GravityIDRotationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *GravityIDRotationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GravityIDRotationProposal.Merge(m, src)
+}
+func (m *GravityIDRotationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *GravityIDRotationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GravityIDRotationProposal.DiscardUnknown(m)
 }
 
-func (m *SignerSetTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovGravity(uint64(m.Nonce))
-	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
-	}
-	if len(m.Signers) > 0 {
-		for _, e := range m.Signers {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+var xxx_messageInfo_GravityIDRotationProposal proto.InternalMessageInfo
+
+// GravityIDRotation is a rotation of an EVM chain's gravity id whose acceptance
+// window hasn't ended yet.
+type GravityIDRotation struct {
+	PreviousGravityId string `protobuf:"bytes,1,opt,name=previous_gravity_id,json=previousGravityId,proto3" json:"previous_gravity_id,omitempty"`
+	// the last Cosmos height confirmations for the previous id are accepted at
+	EndHeight uint64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *GravityIDRotation) Reset()         { *m = GravityIDRotation{} }
+func (m *GravityIDRotation) String() string { return proto.CompactTextString(m) }
+func (*GravityIDRotation) ProtoMessage()    {}
+func (*GravityIDRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{28}
+}
+func (m *GravityIDRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GravityIDRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GravityIDRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *GravityIDRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GravityIDRotation.Merge(m, src)
+}
+func (m *GravityIDRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GravityIDRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GravityIDRotation.DiscardUnknown(m)
 }
 
-func (m *BatchTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BatchNonce != 0 {
-		n += 1 + sovGravity(uint64(m.BatchNonce))
+var xxx_messageInfo_GravityIDRotation proto.InternalMessageInfo
+
+func (m *GravityIDRotation) GetPreviousGravityId() string {
+	if m != nil {
+		return m.PreviousGravityId
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovGravity(uint64(m.Timeout))
+	return ""
+}
+
+func (m *GravityIDRotation) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	if len(m.Transactions) > 0 {
-		for _, e := range m.Transactions {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+// RelayerIncentiveProposal moves coins out of the community pool to the relayer
+// reward account to subsidize relaying to an EVM chain. The amount is disbursed
+// in equal parts every disbursement period, to the orchestrators of the chain's
+// latest signer set in proportion to their power, until all of it is paid out.
+type RelayerIncentiveProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// zero selects the default chain
+	EvmChainId uint64                                   `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// the number of blocks between disbursements
+	DisbursementPeriod uint64 `protobuf:"varint,5,opt,name=disbursement_period,json=disbursementPeriod,proto3" json:"disbursement_period,omitempty"`
+	// the number of disbursements the amount is split into
+	Disbursements uint64 `protobuf:"varint,6,opt,name=disbursements,proto3" json:"disbursements,omitempty"`
+}
+
+func (m *RelayerIncentiveProposal) Reset()      { *m = RelayerIncentiveProposal{} }
+func (*RelayerIncentiveProposal) ProtoMessage() {}
+func (*RelayerIncentiveProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{29}
+}
+func (m *RelayerIncentiveProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerIncentiveProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerIncentiveProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+}
+func (m *RelayerIncentiveProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerIncentiveProposal.Merge(m, src)
+}
+func (m *RelayerIncentiveProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerIncentiveProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerIncentiveProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerIncentiveProposal proto.InternalMessageInfo
+
+// RelayerIncentive is the disbursement schedule of the coins a relayer incentive
+// proposal funded. It is kept once paid out, as the record of the incentive.
+type RelayerIncentive struct {
+	Id         uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EvmChainId uint64                                   `protobuf:"varint,2,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// what has been paid out to orchestrators so far
+	Disbursed          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=disbursed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"disbursed"`
+	DisbursementPeriod uint64                                   `protobuf:"varint,5,opt,name=disbursement_period,json=disbursementPeriod,proto3" json:"disbursement_period,omitempty"`
+	Disbursements      uint64                                   `protobuf:"varint,6,opt,name=disbursements,proto3" json:"disbursements,omitempty"`
+	DisbursementsMade  uint64                                   `protobuf:"varint,7,opt,name=disbursements_made,json=disbursementsMade,proto3" json:"disbursements_made,omitempty"`
+	// the Cosmos height of the next disbursement
+	NextDisbursementHeight uint64 `protobuf:"varint,8,opt,name=next_disbursement_height,json=nextDisbursementHeight,proto3" json:"next_disbursement_height,omitempty"`
+}
+
+func (m *RelayerIncentive) Reset()         { *m = RelayerIncentive{} }
+func (m *RelayerIncentive) String() string { return proto.CompactTextString(m) }
+func (*RelayerIncentive) ProtoMessage()    {}
+func (*RelayerIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{30}
+}
+func (m *RelayerIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
+}
+func (m *RelayerIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerIncentive.Merge(m, src)
+}
+func (m *RelayerIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerIncentive proto.InternalMessageInfo
+
+func (m *RelayerIncentive) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *SendToEthereum) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayerIncentive) GetEvmChainId() uint64 {
+	if m != nil {
+		return m.EvmChainId
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGravity(uint64(m.Id))
+	return 0
+}
+
+func (m *RelayerIncentive) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return nil
+}
+
+func (m *RelayerIncentive) GetDisbursed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Disbursed
 	}
-	l = len(m.EthereumRecipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return nil
+}
+
+func (m *RelayerIncentive) GetDisbursementPeriod() uint64 {
+	if m != nil {
+		return m.DisbursementPeriod
 	}
-	l = m.Erc20Token.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	l = m.Erc20Fee.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	return 0
 }
 
-func (m *ContractCallTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayerIncentive) GetDisbursements() uint64 {
+	if m != nil {
+		return m.Disbursements
 	}
-	var l int
-	_ = l
-	if m.InvalidationNonce != 0 {
-		n += 1 + sovGravity(uint64(m.InvalidationNonce))
-	}
-	l = len(m.InvalidationScope)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+func (m *RelayerIncentive) GetDisbursementsMade() uint64 {
+	if m != nil {
+		return m.DisbursementsMade
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+func (m *RelayerIncentive) GetNextDisbursementHeight() uint64 {
+	if m != nil {
+		return m.NextDisbursementHeight
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovGravity(uint64(m.Timeout))
+	return 0
+}
+
+// EthereumEventRejectionProposal rejects the vote record of an event stuck at the
+// next event nonce of an EVM chain, e.g. after a faulty orchestrator release split
+// the votes so that no record can be observed. The nonce is then skipped without
+// any of its events being applied, and the events after it are tallied again.
+type EthereumEventRejectionProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// zero selects the default chain
+	EvmChainId uint64 `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	EventNonce uint64 `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	// the hex encoded hash of the event whose vote record is rejected
+	EventHash string `protobuf:"bytes,5,opt,name=event_hash,json=eventHash,proto3" json:"event_hash,omitempty"`
+}
+
+func (m *EthereumEventRejectionProposal) Reset()      { *m = EthereumEventRejectionProposal{} }
+func (*EthereumEventRejectionProposal) ProtoMessage() {}
+func (*EthereumEventRejectionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{31}
+}
+func (m *EthereumEventRejectionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumEventRejectionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumEventRejectionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+}
+func (m *EthereumEventRejectionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumEventRejectionProposal.Merge(m, src)
+}
+func (m *EthereumEventRejectionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumEventRejectionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumEventRejectionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumEventRejectionProposal proto.InternalMessageInfo
+
+// IncidentRecoveryProposal mints bridged vouchers to an account or burns them
+// from it, e.g. to reimburse users after an incident. It is the governance route
+// to MsgMintVouchers and MsgBurnVouchers for as long as governance can't execute
+// messages.
+type IncidentRecoveryProposal struct {
+	Title       string                                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Action      IncidentAction                           `protobuf:"varint,3,opt,name=action,proto3,enum=gravity.v1.IncidentAction" json:"action,omitempty"`
+	Account     string                                   `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// kept in the incident record of the action
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *IncidentRecoveryProposal) Reset()      { *m = IncidentRecoveryProposal{} }
+func (*IncidentRecoveryProposal) ProtoMessage() {}
+func (*IncidentRecoveryProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{32}
+}
+func (m *IncidentRecoveryProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncidentRecoveryProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncidentRecoveryProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovGravity(uint64(l))
+}
+func (m *IncidentRecoveryProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncidentRecoveryProposal.Merge(m, src)
+}
+func (m *IncidentRecoveryProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncidentRecoveryProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncidentRecoveryProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncidentRecoveryProposal proto.InternalMessageInfo
+
+// IncidentRecord is the entry of the incident log for a mint or burn of bridged
+// vouchers by the authority of the module. The log is append only, records are
+// never updated or removed.
+type IncidentRecord struct {
+	Id        uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action    IncidentAction                           `protobuf:"varint,2,opt,name=action,proto3,enum=gravity.v1.IncidentAction" json:"action,omitempty"`
+	Authority string                                   `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	Account   string                                   `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Reason    string                                   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the Cosmos height the action was taken at
+	Height uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *IncidentRecord) Reset()         { *m = IncidentRecord{} }
+func (m *IncidentRecord) String() string { return proto.CompactTextString(m) }
+func (*IncidentRecord) ProtoMessage()    {}
+func (*IncidentRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{33}
+}
+func (m *IncidentRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncidentRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncidentRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovGravity(uint64(m.Height))
+}
+func (m *IncidentRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncidentRecord.Merge(m, src)
+}
+func (m *IncidentRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncidentRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncidentRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncidentRecord proto.InternalMessageInfo
+
+func (m *IncidentRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *ERC20Token) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IncidentRecord) GetAction() IncidentAction {
+	if m != nil {
+		return m.Action
 	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return IncidentActionUnspecified
+}
+
+func (m *IncidentRecord) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
+	return ""
 }
 
-func (m *IDSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IncidentRecord) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovGravity(uint64(e))
+	return ""
+}
+
+func (m *IncidentRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *IncidentRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *IncidentRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// BridgeReport summarizes the activity of the bridge over all EVM chains during
+// a period of Cosmos blocks, from its start height to its end height included.
+// The report of the period in progress is updated as the activity happens.
+type BridgeReport struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// the sends to Ethereum created and the deposits to Cosmos observed
+	SendsToEthereum       uint64 `protobuf:"varint,4,opt,name=sends_to_ethereum,json=sendsToEthereum,proto3" json:"sends_to_ethereum,omitempty"`
+	SendsToCosmos         uint64 `protobuf:"varint,5,opt,name=sends_to_cosmos,json=sendsToCosmos,proto3" json:"sends_to_cosmos,omitempty"`
+	BatchesExecuted       uint64 `protobuf:"varint,6,opt,name=batches_executed,json=batchesExecuted,proto3" json:"batches_executed,omitempty"`
+	ContractCallsExecuted uint64 `protobuf:"varint,7,opt,name=contract_calls_executed,json=contractCallsExecuted,proto3" json:"contract_calls_executed,omitempty"`
+	// the fees of the sends of the executed batches, in Cosmos denoms
+	FeesCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=fees_collected,json=feesCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_collected"`
+	// the validators slashed for missing outgoing tx signatures
+	ValidatorsSlashed uint64 `protobuf:"varint,9,opt,name=validators_slashed,json=validatorsSlashed,proto3" json:"validators_slashed,omitempty"`
+	// anomalies governance may want to look into
+	RejectedEventVotes  uint64 `protobuf:"varint,10,opt,name=rejected_event_votes,json=rejectedEventVotes,proto3" json:"rejected_event_votes,omitempty"`
+	VetoedOutgoingTxs   uint64 `protobuf:"varint,11,opt,name=vetoed_outgoing_txs,json=vetoedOutgoingTxs,proto3" json:"vetoed_outgoing_txs,omitempty"`
+	TimedOutOutgoingTxs uint64 `protobuf:"varint,12,opt,name=timed_out_outgoing_txs,json=timedOutOutgoingTxs,proto3" json:"timed_out_outgoing_txs,omitempty"`
+	IncidentRecords     uint64 `protobuf:"varint,13,opt,name=incident_records,json=incidentRecords,proto3" json:"incident_records,omitempty"`
+}
+
+func (m *BridgeReport) Reset()         { *m = BridgeReport{} }
+func (m *BridgeReport) String() string { return proto.CompactTextString(m) }
+func (*BridgeReport) ProtoMessage()    {}
+func (*BridgeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{34}
+}
+func (m *BridgeReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovGravity(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BridgeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeReport.Merge(m, src)
+}
+func (m *BridgeReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeReport.DiscardUnknown(m)
 }
 
-func (m *CommunityPoolEthereumSpendProposal) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BridgeReport proto.InternalMessageInfo
+
+func (m *BridgeReport) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+func (m *BridgeReport) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+func (m *BridgeReport) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	return 0
+}
+
+func (m *BridgeReport) GetSendsToEthereum() uint64 {
+	if m != nil {
+		return m.SendsToEthereum
+	}
+	return 0
+}
+
+func (m *BridgeReport) GetSendsToCosmos() uint64 {
+	if m != nil {
+		return m.SendsToCosmos
+	}
+	return 0
+}
+
+func (m *BridgeReport) GetBatchesExecuted() uint64 {
+	if m != nil {
+		return m.BatchesExecuted
+	}
+	return 0
+}
+
+func (m *BridgeReport) GetContractCallsExecuted() uint64 {
+	if m != nil {
+		return m.ContractCallsExecuted
+	}
+	return 0
+}
+
+func (m *BridgeReport) GetFeesCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesCollected
+	}
+	return nil
+}
+
+func (m *BridgeReport) GetValidatorsSlashed() uint64 {
+	if m != nil {
+		return m.ValidatorsSlashed
+	}
+	return 0
+}
+
+func (m *BridgeReport) GetRejectedEventVotes() uint64 {
+	if m != nil {
+		return m.RejectedEventVotes
+	}
+	return 0
+}
+
+func (m *BridgeReport) GetVetoedOutgoingTxs() uint64 {
+	if m != nil {
+		return m.VetoedOutgoingTxs
+	}
+	return 0
+}
+
+func (m *BridgeReport) GetTimedOutOutgoingTxs() uint64 {
+	if m != nil {
+		return m.TimedOutOutgoingTxs
+	}
+	return 0
+}
+
+func (m *BridgeReport) GetIncidentRecords() uint64 {
+	if m != nil {
+		return m.IncidentRecords
+	}
+	return 0
+}
+
+// TransferRecord is a completed transfer of the bridge history, a deposit to
+// Cosmos or the execution of a batch on Ethereum, as observed by the validators
+type TransferRecord struct {
+	EvmChainId     uint64 `protobuf:"varint,1,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	EventNonce     uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumHeight uint64 `protobuf:"varint,3,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty"`
+	// send_to_cosmos, send_erc1155_to_cosmos, batch_executed or
+	// erc1155_batch_executed
+	Kind          string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	TokenContract string `protobuf:"bytes,5,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// the amount of a deposit, as id:amount pairs for ERC1155 tokens
+	Amount         string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	EthereumSender string `protobuf:"bytes,7,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string `protobuf:"bytes,8,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	// the nonce of an executed batch
+	BatchNonce uint64 `protobuf:"varint,9,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+}
+
+func (m *TransferRecord) Reset()         { *m = TransferRecord{} }
+func (m *TransferRecord) String() string { return proto.CompactTextString(m) }
+func (*TransferRecord) ProtoMessage()    {}
+func (*TransferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{35}
+}
+func (m *TransferRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecord.Merge(m, src)
+}
+func (m *TransferRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRecord proto.InternalMessageInfo
+
+func (m *TransferRecord) GetEvmChainId() uint64 {
+	if m != nil {
+		return m.EvmChainId
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetEthereumHeight() uint64 {
+	if m != nil {
+		return m.EthereumHeight
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+// PoolAggregate is the count and the fee total of the unbatched transfers of a
+// token to a chain, kept up to date as transfers enter and leave the pool so the
+// batch creation doesn't have to go through the pool
+type PoolAggregate struct {
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// the fees of the transfers, always zero for ERC1155 tokens
+	TotalFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fees"`
+}
+
+func (m *PoolAggregate) Reset()         { *m = PoolAggregate{} }
+func (m *PoolAggregate) String() string { return proto.CompactTextString(m) }
+func (*PoolAggregate) ProtoMessage()    {}
+func (*PoolAggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{36}
+}
+func (m *PoolAggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAggregate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolAggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAggregate.Merge(m, src)
+}
+func (m *PoolAggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolAggregate proto.InternalMessageInfo
+
+func (m *PoolAggregate) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// VoterSet is the validators of a signer set with their power at its creation,
+// in the order of the signer set, that the vote bitmaps of the event vote
+// records index
+type VoterSet struct {
+	Voters []*Voter `protobuf:"bytes,1,rep,name=voters,proto3" json:"voters,omitempty"`
+}
+
+func (m *VoterSet) Reset()         { *m = VoterSet{} }
+func (m *VoterSet) String() string { return proto.CompactTextString(m) }
+func (*VoterSet) ProtoMessage()    {}
+func (*VoterSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{37}
+}
+func (m *VoterSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoterSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoterSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoterSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoterSet.Merge(m, src)
+}
+func (m *VoterSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoterSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoterSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoterSet proto.InternalMessageInfo
+
+func (m *VoterSet) GetVoters() []*Voter {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+type Voter struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Power            int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *Voter) Reset()         { *m = Voter{} }
+func (m *Voter) String() string { return proto.CompactTextString(m) }
+func (*Voter) ProtoMessage()    {}
+func (*Voter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{38}
+}
+func (m *Voter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Voter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Voter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Voter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Voter.Merge(m, src)
+}
+func (m *Voter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Voter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Voter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Voter proto.InternalMessageInfo
+
+func (m *Voter) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *Voter) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// AttestationLatency tracks the Cosmos blocks between the first vote for the
+// events of a chain and their observation, over the last observed events
+type AttestationLatency struct {
+	// the latencies of the last observed events, oldest first
+	Samples []uint64 `protobuf:"varint,1,rep,packed,name=samples,proto3" json:"samples,omitempty"`
+	// the percentiles of the samples, by nearest rank
+	P50 uint64 `protobuf:"varint,2,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90 uint64 `protobuf:"varint,3,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99 uint64 `protobuf:"varint,4,opt,name=p99,proto3" json:"p99,omitempty"`
+	Max uint64 `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *AttestationLatency) Reset()         { *m = AttestationLatency{} }
+func (m *AttestationLatency) String() string { return proto.CompactTextString(m) }
+func (*AttestationLatency) ProtoMessage()    {}
+func (*AttestationLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{39}
+}
+func (m *AttestationLatency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationLatency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationLatency.Merge(m, src)
+}
+func (m *AttestationLatency) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationLatency proto.InternalMessageInfo
+
+func (m *AttestationLatency) GetSamples() []uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+func (m *AttestationLatency) GetP50() uint64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
+}
+
+func (m *AttestationLatency) GetP90() uint64 {
+	if m != nil {
+		return m.P90
+	}
+	return 0
+}
+
+func (m *AttestationLatency) GetP99() uint64 {
+	if m != nil {
+		return m.P99
+	}
+	return 0
+}
+
+func (m *AttestationLatency) GetMax() uint64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+// BridgeState is the bridge critical state committed to at the end of each
+// block, the bridge state hash being the sha256 of its protobuf encoding
+type BridgeState struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the state of each EVM chain, by chain id
+	Chains                 []EVMChainBridgeState `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains"`
+	LastOutgoingBatchNonce uint64                `protobuf:"varint,3,opt,name=last_outgoing_batch_nonce,json=lastOutgoingBatchNonce,proto3" json:"last_outgoing_batch_nonce,omitempty"`
+	LastSendToEthereumId   uint64                `protobuf:"varint,4,opt,name=last_send_to_ethereum_id,json=lastSendToEthereumId,proto3" json:"last_send_to_ethereum_id,omitempty"`
+	// the total supply of the vouchers of the tokens bridged from all chains
+	VoucherSupply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=voucher_supply,json=voucherSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"voucher_supply"`
+}
+
+func (m *BridgeState) Reset()         { *m = BridgeState{} }
+func (m *BridgeState) String() string { return proto.CompactTextString(m) }
+func (*BridgeState) ProtoMessage()    {}
+func (*BridgeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{40}
+}
+func (m *BridgeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeState.Merge(m, src)
+}
+func (m *BridgeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeState proto.InternalMessageInfo
+
+func (m *BridgeState) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BridgeState) GetChains() []EVMChainBridgeState {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func (m *BridgeState) GetLastOutgoingBatchNonce() uint64 {
+	if m != nil {
+		return m.LastOutgoingBatchNonce
+	}
+	return 0
+}
+
+func (m *BridgeState) GetLastSendToEthereumId() uint64 {
+	if m != nil {
+		return m.LastSendToEthereumId
+	}
+	return 0
+}
+
+func (m *BridgeState) GetVoucherSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.VoucherSupply
+	}
+	return nil
+}
+
+// EVMChainBridgeState is the bridge critical state of an EVM chain, what the
+// contract on the chain is expected to agree with
+type EVMChainBridgeState struct {
+	ChainId                uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	LastObservedEventNonce uint64 `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	LatestSignerSetTxNonce uint64 `protobuf:"varint,3,opt,name=latest_signer_set_tx_nonce,json=latestSignerSetTxNonce,proto3" json:"latest_signer_set_tx_nonce,omitempty"`
+	// the checkpoint of the last signer set observed on the contract
+	LastObservedCheckpoint []byte `protobuf:"bytes,4,opt,name=last_observed_checkpoint,json=lastObservedCheckpoint,proto3" json:"last_observed_checkpoint,omitempty"`
+	// the cosmos originated coins locked for the chain
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+}
+
+func (m *EVMChainBridgeState) Reset()         { *m = EVMChainBridgeState{} }
+func (m *EVMChainBridgeState) String() string { return proto.CompactTextString(m) }
+func (*EVMChainBridgeState) ProtoMessage()    {}
+func (*EVMChainBridgeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{41}
+}
+func (m *EVMChainBridgeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMChainBridgeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMChainBridgeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMChainBridgeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMChainBridgeState.Merge(m, src)
+}
+func (m *EVMChainBridgeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMChainBridgeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMChainBridgeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMChainBridgeState proto.InternalMessageInfo
+
+func (m *EVMChainBridgeState) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EVMChainBridgeState) GetLastObservedEventNonce() uint64 {
+	if m != nil {
+		return m.LastObservedEventNonce
+	}
+	return 0
+}
+
+func (m *EVMChainBridgeState) GetLatestSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.LatestSignerSetTxNonce
+	}
+	return 0
+}
+
+func (m *EVMChainBridgeState) GetLastObservedCheckpoint() []byte {
+	if m != nil {
+		return m.LastObservedCheckpoint
+	}
+	return nil
+}
+
+func (m *EVMChainBridgeState) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+// BridgeStateHash is the hash of the bridge state at the end of a block
+type BridgeStateHash struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash   []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *BridgeStateHash) Reset()         { *m = BridgeStateHash{} }
+func (m *BridgeStateHash) String() string { return proto.CompactTextString(m) }
+func (*BridgeStateHash) ProtoMessage()    {}
+func (*BridgeStateHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{42}
+}
+func (m *BridgeStateHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeStateHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeStateHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeStateHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeStateHash.Merge(m, src)
+}
+func (m *BridgeStateHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeStateHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeStateHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeStateHash proto.InternalMessageInfo
+
+func (m *BridgeStateHash) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BridgeStateHash) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// This format of the community spend Ethereum proposal is specifically for
+// the CLI to allow simple text serialization.
+type CommunityPoolEthereumSpendProposalForCLI struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Recipient   string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty" yaml:"amount"`
+	BridgeFee   string `protobuf:"bytes,5,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee,omitempty" yaml:"bridge_fee"`
+	Deposit     string `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) Reset() {
+	*m = CommunityPoolEthereumSpendProposalForCLI{}
+}
+func (m *CommunityPoolEthereumSpendProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolEthereumSpendProposalForCLI) ProtoMessage()    {}
+func (*CommunityPoolEthereumSpendProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{43}
+}
+func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI.Merge(m, src)
+}
+func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolEthereumSpendProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolEthereumSpendProposalForCLI proto.InternalMessageInfo
+
+// This format of the add EVM chain proposal is specifically for the CLI to
+// allow simple text serialization.
+type AddEVMChainProposalForCLI struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Chain       EVMChain `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain" yaml:"chain"`
+	Deposit     string   `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *AddEVMChainProposalForCLI) Reset()         { *m = AddEVMChainProposalForCLI{} }
+func (m *AddEVMChainProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*AddEVMChainProposalForCLI) ProtoMessage()    {}
+func (*AddEVMChainProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{44}
+}
+func (m *AddEVMChainProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddEVMChainProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddEVMChainProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddEVMChainProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEVMChainProposalForCLI.Merge(m, src)
+}
+func (m *AddEVMChainProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddEVMChainProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEVMChainProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddEVMChainProposalForCLI proto.InternalMessageInfo
+
+// This format of the contract migration proposal is specifically for the CLI
+// to allow simple text serialization.
+type ContractMigrationProposalForCLI struct {
+	Title                 string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description           string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	EvmChainId            uint64 `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty" yaml:"evm_chain_id"`
+	BridgeEthereumAddress string `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty" yaml:"bridge_ethereum_address"`
+	EthereumHeight        uint64 `protobuf:"varint,5,opt,name=ethereum_height,json=ethereumHeight,proto3" json:"ethereum_height,omitempty" yaml:"ethereum_height"`
+	Deposit               string `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *ContractMigrationProposalForCLI) Reset()         { *m = ContractMigrationProposalForCLI{} }
+func (m *ContractMigrationProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*ContractMigrationProposalForCLI) ProtoMessage()    {}
+func (*ContractMigrationProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{45}
+}
+func (m *ContractMigrationProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMigrationProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMigrationProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractMigrationProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMigrationProposalForCLI.Merge(m, src)
+}
+func (m *ContractMigrationProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMigrationProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMigrationProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMigrationProposalForCLI proto.InternalMessageInfo
+
+// This format of the EVM chain pause proposal is specifically for the CLI to
+// allow simple text serialization.
+type EVMChainPauseProposalForCLI struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	EvmChainId  uint64 `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty" yaml:"evm_chain_id"`
+	Paused      bool   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	Deposit     string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *EVMChainPauseProposalForCLI) Reset()         { *m = EVMChainPauseProposalForCLI{} }
+func (m *EVMChainPauseProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*EVMChainPauseProposalForCLI) ProtoMessage()    {}
+func (*EVMChainPauseProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{46}
+}
+func (m *EVMChainPauseProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMChainPauseProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMChainPauseProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMChainPauseProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMChainPauseProposalForCLI.Merge(m, src)
+}
+func (m *EVMChainPauseProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMChainPauseProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMChainPauseProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMChainPauseProposalForCLI proto.InternalMessageInfo
+
+// This format of the gravity id rotation proposal is specifically for the CLI
+// to allow simple text serialization.
+type GravityIDRotationProposalForCLI struct {
+	Title            string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description      string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	EvmChainId       uint64 `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty" yaml:"evm_chain_id"`
+	GravityId        string `protobuf:"bytes,4,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty" yaml:"gravity_id"`
+	AcceptanceWindow uint64 `protobuf:"varint,5,opt,name=acceptance_window,json=acceptanceWindow,proto3" json:"acceptance_window,omitempty" yaml:"acceptance_window"`
+	Deposit          string `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *GravityIDRotationProposalForCLI) Reset()         { *m = GravityIDRotationProposalForCLI{} }
+func (m *GravityIDRotationProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*GravityIDRotationProposalForCLI) ProtoMessage()    {}
+func (*GravityIDRotationProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{47}
+}
+func (m *GravityIDRotationProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GravityIDRotationProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GravityIDRotationProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GravityIDRotationProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GravityIDRotationProposalForCLI.Merge(m, src)
+}
+func (m *GravityIDRotationProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *GravityIDRotationProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_GravityIDRotationProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GravityIDRotationProposalForCLI proto.InternalMessageInfo
+
+// DepositAddress is the forwarding address deposits to a Cosmos recipient can
+// be sent to on an EVM chain, for senders such as exchanges that can't set the
+// destination of sendToCosmos. The address is a clone of the chain's
+// DepositAddressFactory forwarder created with the recipient's destination as
+// salt, anyone can have the factory deploy it and forward what it holds to the
+// Gravity contract. Once registered the address is kept even if the factory of
+// the chain changes.
+type DepositAddress struct {
+	Recipient      string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	DepositAddress string `protobuf:"bytes,2,opt,name=deposit_address,json=depositAddress,proto3" json:"deposit_address,omitempty"`
+}
+
+func (m *DepositAddress) Reset()         { *m = DepositAddress{} }
+func (m *DepositAddress) String() string { return proto.CompactTextString(m) }
+func (*DepositAddress) ProtoMessage()    {}
+func (*DepositAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{48}
+}
+func (m *DepositAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositAddress.Merge(m, src)
+}
+func (m *DepositAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositAddress proto.InternalMessageInfo
+
+func (m *DepositAddress) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *DepositAddress) GetDepositAddress() string {
+	if m != nil {
+		return m.DepositAddress
+	}
+	return ""
+}
+
+// This format of the relayer incentive proposal is specifically for the CLI to
+// allow simple text serialization.
+type RelayerIncentiveProposalForCLI struct {
+	Title              string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description        string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	EvmChainId         uint64 `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty" yaml:"evm_chain_id"`
+	Amount             string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty" yaml:"amount"`
+	DisbursementPeriod uint64 `protobuf:"varint,5,opt,name=disbursement_period,json=disbursementPeriod,proto3" json:"disbursement_period,omitempty" yaml:"disbursement_period"`
+	Disbursements      uint64 `protobuf:"varint,6,opt,name=disbursements,proto3" json:"disbursements,omitempty" yaml:"disbursements"`
+	Deposit            string `protobuf:"bytes,7,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *RelayerIncentiveProposalForCLI) Reset()         { *m = RelayerIncentiveProposalForCLI{} }
+func (m *RelayerIncentiveProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*RelayerIncentiveProposalForCLI) ProtoMessage()    {}
+func (*RelayerIncentiveProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{49}
+}
+func (m *RelayerIncentiveProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerIncentiveProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerIncentiveProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerIncentiveProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerIncentiveProposalForCLI.Merge(m, src)
+}
+func (m *RelayerIncentiveProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerIncentiveProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerIncentiveProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerIncentiveProposalForCLI proto.InternalMessageInfo
+
+// This format of the Ethereum event rejection proposal is specifically for the
+// CLI to allow simple text serialization.
+type EthereumEventRejectionProposalForCLI struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	EvmChainId  uint64 `protobuf:"varint,3,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty" yaml:"evm_chain_id"`
+	EventNonce  uint64 `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty" yaml:"event_nonce"`
+	EventHash   string `protobuf:"bytes,5,opt,name=event_hash,json=eventHash,proto3" json:"event_hash,omitempty" yaml:"event_hash"`
+	Deposit     string `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *EthereumEventRejectionProposalForCLI) Reset()         { *m = EthereumEventRejectionProposalForCLI{} }
+func (m *EthereumEventRejectionProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*EthereumEventRejectionProposalForCLI) ProtoMessage()    {}
+func (*EthereumEventRejectionProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{50}
+}
+func (m *EthereumEventRejectionProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumEventRejectionProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumEventRejectionProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumEventRejectionProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumEventRejectionProposalForCLI.Merge(m, src)
+}
+func (m *EthereumEventRejectionProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumEventRejectionProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumEventRejectionProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumEventRejectionProposalForCLI proto.InternalMessageInfo
+
+// This format of the incident recovery proposal is specifically for the CLI to
+// allow simple text serialization.
+type IncidentRecoveryProposalForCLI struct {
+	Title       string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Action      IncidentAction `protobuf:"varint,3,opt,name=action,proto3,enum=gravity.v1.IncidentAction" json:"action,omitempty" yaml:"action"`
+	Account     string         `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty" yaml:"account"`
+	Amount      string         `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty" yaml:"amount"`
+	Reason      string         `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	Deposit     string         `protobuf:"bytes,7,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *IncidentRecoveryProposalForCLI) Reset()         { *m = IncidentRecoveryProposalForCLI{} }
+func (m *IncidentRecoveryProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*IncidentRecoveryProposalForCLI) ProtoMessage()    {}
+func (*IncidentRecoveryProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1715a041eadeb531, []int{51}
+}
+func (m *IncidentRecoveryProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncidentRecoveryProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncidentRecoveryProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncidentRecoveryProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncidentRecoveryProposalForCLI.Merge(m, src)
+}
+func (m *IncidentRecoveryProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncidentRecoveryProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncidentRecoveryProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncidentRecoveryProposalForCLI proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("gravity.v1.Finality", Finality_name, Finality_value)
+	proto.RegisterEnum("gravity.v1.IncidentAction", IncidentAction_name, IncidentAction_value)
+	proto.RegisterType((*EthereumEventVoteRecord)(nil), "gravity.v1.EthereumEventVoteRecord")
+	proto.RegisterType((*LatestEthereumBlockHeight)(nil), "gravity.v1.LatestEthereumBlockHeight")
+	proto.RegisterType((*EthereumSigner)(nil), "gravity.v1.EthereumSigner")
+	proto.RegisterType((*SignerSetTx)(nil), "gravity.v1.SignerSetTx")
+	proto.RegisterType((*BatchTx)(nil), "gravity.v1.BatchTx")
+	proto.RegisterType((*SendToEthereum)(nil), "gravity.v1.SendToEthereum")
+	proto.RegisterType((*ERC1155BatchTx)(nil), "gravity.v1.ERC1155BatchTx")
+	proto.RegisterType((*SendERC1155ToEthereum)(nil), "gravity.v1.SendERC1155ToEthereum")
+	proto.RegisterType((*ERC1155Amount)(nil), "gravity.v1.ERC1155Amount")
+	proto.RegisterType((*ERC1155Token)(nil), "gravity.v1.ERC1155Token")
+	proto.RegisterType((*ContractCallTx)(nil), "gravity.v1.ContractCallTx")
+	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
+	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
+	proto.RegisterType((*CommunityPoolEthereumSpendProposal)(nil), "gravity.v1.CommunityPoolEthereumSpendProposal")
+	proto.RegisterType((*EVMChain)(nil), "gravity.v1.EVMChain")
+	proto.RegisterType((*RateLimit)(nil), "gravity.v1.RateLimit")
+	proto.RegisterType((*RateLimitUsage)(nil), "gravity.v1.RateLimitUsage")
+	proto.RegisterType((*IBCForwardChannel)(nil), "gravity.v1.IBCForwardChannel")
+	proto.RegisterType((*ForwardedDeposit)(nil), "gravity.v1.ForwardedDeposit")
+	proto.RegisterType((*LogicCallTemplate)(nil), "gravity.v1.LogicCallTemplate")
+	proto.RegisterType((*TokenDecimals)(nil), "gravity.v1.TokenDecimals")
+	proto.RegisterType((*FeeFloor)(nil), "gravity.v1.FeeFloor")
+	proto.RegisterType((*AddEVMChainProposal)(nil), "gravity.v1.AddEVMChainProposal")
+	proto.RegisterType((*ContractMigrationProposal)(nil), "gravity.v1.ContractMigrationProposal")
+	proto.RegisterType((*ContractMigration)(nil), "gravity.v1.ContractMigration")
+	proto.RegisterType((*BridgeContract)(nil), "gravity.v1.BridgeContract")
+	proto.RegisterType((*EVMChainPauseProposal)(nil), "gravity.v1.EVMChainPauseProposal")
+	proto.RegisterType((*GravityIDRotationProposal)(nil), "gravity.v1.GravityIDRotationProposal")
+	proto.RegisterType((*GravityIDRotation)(nil), "gravity.v1.GravityIDRotation")
+	proto.RegisterType((*RelayerIncentiveProposal)(nil), "gravity.v1.RelayerIncentiveProposal")
+	proto.RegisterType((*RelayerIncentive)(nil), "gravity.v1.RelayerIncentive")
+	proto.RegisterType((*EthereumEventRejectionProposal)(nil), "gravity.v1.EthereumEventRejectionProposal")
+	proto.RegisterType((*IncidentRecoveryProposal)(nil), "gravity.v1.IncidentRecoveryProposal")
+	proto.RegisterType((*IncidentRecord)(nil), "gravity.v1.IncidentRecord")
+	proto.RegisterType((*BridgeReport)(nil), "gravity.v1.BridgeReport")
+	proto.RegisterType((*TransferRecord)(nil), "gravity.v1.TransferRecord")
+	proto.RegisterType((*PoolAggregate)(nil), "gravity.v1.PoolAggregate")
+	proto.RegisterType((*VoterSet)(nil), "gravity.v1.VoterSet")
+	proto.RegisterType((*Voter)(nil), "gravity.v1.Voter")
+	proto.RegisterType((*AttestationLatency)(nil), "gravity.v1.AttestationLatency")
+	proto.RegisterType((*BridgeState)(nil), "gravity.v1.BridgeState")
+	proto.RegisterType((*EVMChainBridgeState)(nil), "gravity.v1.EVMChainBridgeState")
+	proto.RegisterType((*BridgeStateHash)(nil), "gravity.v1.BridgeStateHash")
+	proto.RegisterType((*CommunityPoolEthereumSpendProposalForCLI)(nil), "gravity.v1.CommunityPoolEthereumSpendProposalForCLI")
+	proto.RegisterType((*AddEVMChainProposalForCLI)(nil), "gravity.v1.AddEVMChainProposalForCLI")
+	proto.RegisterType((*ContractMigrationProposalForCLI)(nil), "gravity.v1.ContractMigrationProposalForCLI")
+	proto.RegisterType((*EVMChainPauseProposalForCLI)(nil), "gravity.v1.EVMChainPauseProposalForCLI")
+	proto.RegisterType((*GravityIDRotationProposalForCLI)(nil), "gravity.v1.GravityIDRotationProposalForCLI")
+	proto.RegisterType((*DepositAddress)(nil), "gravity.v1.DepositAddress")
+	proto.RegisterType((*RelayerIncentiveProposalForCLI)(nil), "gravity.v1.RelayerIncentiveProposalForCLI")
+	proto.RegisterType((*EthereumEventRejectionProposalForCLI)(nil), "gravity.v1.EthereumEventRejectionProposalForCLI")
+	proto.RegisterType((*IncidentRecoveryProposalForCLI)(nil), "gravity.v1.IncidentRecoveryProposalForCLI")
+}
+
+func init() { proto.RegisterFile("gravity/v1/gravity.proto", fileDescriptor_1715a041eadeb531) }
+
+var fileDescriptor_1715a041eadeb531 = []byte{
+	// 3561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4b, 0x6c, 0x1b, 0xd7,
+	0xb5, 0x1a, 0x7e, 0x24, 0xf1, 0x50, 0xa4, 0xc8, 0xb1, 0xa4, 0x50, 0x4a, 0x2c, 0x32, 0x93, 0x38,
+	0x91, 0x93, 0x67, 0x49, 0x96, 0xed, 0x24, 0xf6, 0x7b, 0x36, 0x9e, 0x48, 0x89, 0x09, 0x1f, 0x6c,
+	0xd9, 0x6f, 0x28, 0x27, 0x68, 0x36, 0x83, 0xd1, 0xcc, 0x25, 0x39, 0x31, 0x39, 0x97, 0x9d, 0x19,
+	0xd2, 0x52, 0xbb, 0xe9, 0x07, 0x45, 0x03, 0x23, 0x2d, 0x82, 0x6e, 0xd2, 0xa2, 0x30, 0x90, 0xa2,
+	0xbb, 0x74, 0x55, 0xa0, 0xdd, 0x74, 0xd1, 0x45, 0x57, 0x41, 0x0a, 0xb4, 0x59, 0x74, 0xd1, 0x76,
+	0xa1, 0x14, 0x49, 0x17, 0x45, 0x97, 0xda, 0x74, 0xd3, 0x45, 0x71, 0x7f, 0xc3, 0x99, 0x21, 0x65,
+	0xcb, 0x8a, 0x6d, 0xc4, 0x2b, 0xcd, 0x3d, 0xe7, 0xdc, 0xcf, 0x39, 0xf7, 0xfc, 0xee, 0x39, 0x14,
+	0x14, 0x9a, 0x8e, 0xde, 0xb7, 0xbc, 0xbd, 0x95, 0xfe, 0xd9, 0x15, 0xfe, 0xb9, 0xdc, 0x75, 0xb0,
+	0x87, 0x65, 0x10, 0xc3, 0xfe, 0xd9, 0x85, 0x45, 0x03, 0xbb, 0x1d, 0xec, 0xae, 0xec, 0xe8, 0x2e,
+	0x5a, 0xe9, 0x9f, 0xdd, 0x41, 0x9e, 0x7e, 0x76, 0xc5, 0xc0, 0x96, 0xcd, 0x68, 0x17, 0xe6, 0x19,
+	0x5e, 0xa3, 0xa3, 0x15, 0x36, 0xe0, 0xa8, 0x99, 0x26, 0x6e, 0x62, 0x06, 0x27, 0x5f, 0x62, 0x42,
+	0x13, 0xe3, 0x66, 0x1b, 0xad, 0xd0, 0xd1, 0x4e, 0xaf, 0xb1, 0xa2, 0xdb, 0x7c, 0x5f, 0xe5, 0x6e,
+	0x0c, 0x9e, 0xda, 0xf4, 0x5a, 0xc8, 0x41, 0xbd, 0xce, 0x66, 0x1f, 0xd9, 0xde, 0x9b, 0xd8, 0x43,
+	0x2a, 0x32, 0xb0, 0x63, 0xca, 0x97, 0x21, 0x89, 0x08, 0xa8, 0x20, 0x95, 0xa4, 0xa5, 0xf4, 0xda,
+	0xcc, 0x32, 0x5b, 0x66, 0x59, 0x2c, 0xb3, 0xbc, 0x6e, 0xef, 0x95, 0xf3, 0x9f, 0xfc, 0xea, 0x4c,
+	0x26, 0xb4, 0x82, 0xca, 0x66, 0xc9, 0x33, 0x90, 0xec, 0x63, 0x0f, 0xb9, 0x85, 0x58, 0x29, 0xbe,
+	0x94, 0x52, 0xd9, 0x40, 0x5e, 0x80, 0x49, 0xdd, 0x30, 0x50, 0xd7, 0x43, 0x66, 0x21, 0x5e, 0x92,
+	0x96, 0x26, 0x55, 0x7f, 0x4c, 0x70, 0x0e, 0x7a, 0x07, 0x19, 0x04, 0x97, 0x60, 0x38, 0x31, 0x96,
+	0x5f, 0x82, 0x7c, 0xc3, 0x72, 0x5c, 0x4f, 0x23, 0xcb, 0x68, 0x2d, 0x64, 0x35, 0x5b, 0x5e, 0x21,
+	0x59, 0x92, 0x96, 0x12, 0xea, 0x34, 0x45, 0x90, 0x83, 0xbf, 0x41, 0xc1, 0xf2, 0x12, 0xe4, 0x5c,
+	0xab, 0x69, 0x23, 0x47, 0x73, 0x91, 0xa7, 0xd9, 0xd8, 0x36, 0x50, 0x61, 0x9c, 0x92, 0x66, 0x19,
+	0xbc, 0x8e, 0xbc, 0x2d, 0x02, 0x95, 0x8b, 0x90, 0xa6, 0xeb, 0xed, 0x58, 0x5e, 0x47, 0xef, 0x16,
+	0x26, 0x4a, 0xd2, 0xd2, 0x94, 0x0a, 0x04, 0x54, 0xa6, 0x10, 0xc5, 0x82, 0xf9, 0xab, 0xba, 0x87,
+	0x5c, 0x4f, 0xb0, 0x58, 0x6e, 0x63, 0xe3, 0x16, 0xdf, 0xe7, 0x45, 0x98, 0x46, 0x1c, 0x2c, 0x4e,
+	0x24, 0xb1, 0x6d, 0x04, 0x98, 0x13, 0x3e, 0x07, 0x19, 0x7e, 0x67, 0x9c, 0x2c, 0x46, 0xc9, 0xa6,
+	0x18, 0x90, 0x11, 0x29, 0xff, 0x0f, 0x59, 0xb1, 0x49, 0x9d, 0x9e, 0x92, 0x48, 0xb0, 0x8b, 0x6f,
+	0x23, 0x87, 0xaf, 0xca, 0x06, 0xf2, 0x69, 0xc8, 0xf9, 0xbb, 0xea, 0xa6, 0xe9, 0x20, 0xd7, 0xa5,
+	0xeb, 0xa5, 0x54, 0xff, 0x34, 0xeb, 0x0c, 0xac, 0xfc, 0x5a, 0x82, 0x74, 0x5d, 0x70, 0xbc, 0xbd,
+	0x4b, 0x16, 0x64, 0xd2, 0xe0, 0x0b, 0xd2, 0x81, 0x3c, 0x07, 0xe3, 0xa1, 0x63, 0xf1, 0x91, 0x5c,
+	0x83, 0x09, 0x26, 0x2e, 0xb7, 0x10, 0x2f, 0xc5, 0x97, 0xd2, 0x6b, 0x0b, 0xcb, 0x03, 0x2d, 0x5d,
+	0x0e, 0x9f, 0xb5, 0x7c, 0xe2, 0xa3, 0xcf, 0x8a, 0xd3, 0x61, 0x98, 0xab, 0x8a, 0xf9, 0xf2, 0x19,
+	0x90, 0x8d, 0x16, 0x32, 0x6e, 0x75, 0xb1, 0x65, 0x7b, 0x5a, 0x1f, 0x39, 0xae, 0x85, 0x6d, 0x7a,
+	0xc7, 0x19, 0x35, 0x3f, 0xc0, 0xbc, 0xc9, 0x10, 0xca, 0x81, 0x04, 0x13, 0x65, 0xdd, 0x33, 0x5a,
+	0xdb, 0xbb, 0xe4, 0x8a, 0x76, 0xc8, 0xa7, 0x16, 0x3c, 0x39, 0x50, 0x10, 0xbb, 0xc3, 0x02, 0x4c,
+	0x78, 0x56, 0x07, 0xe1, 0x9e, 0x38, 0xbf, 0x18, 0xca, 0x57, 0x60, 0xca, 0x73, 0x74, 0xdb, 0xd5,
+	0x0d, 0xcf, 0xc2, 0xf6, 0x48, 0x2e, 0xea, 0xc8, 0x36, 0xb7, 0xb1, 0x38, 0xb7, 0x1a, 0xa2, 0x97,
+	0x4f, 0x41, 0xd6, 0xc3, 0xb7, 0x90, 0xad, 0x19, 0xd8, 0xf6, 0x1c, 0xdd, 0xf0, 0xe8, 0x89, 0x53,
+	0x6a, 0x86, 0x42, 0x2b, 0x1c, 0x18, 0x90, 0x5f, 0x32, 0x24, 0xbf, 0xd1, 0x4c, 0x8f, 0x1f, 0xc6,
+	0xf4, 0x77, 0x63, 0x90, 0x0d, 0x1f, 0x47, 0xce, 0x42, 0xcc, 0x32, 0x39, 0xcb, 0x31, 0xcb, 0x24,
+	0x3b, 0xb9, 0xc8, 0x36, 0x91, 0xc3, 0x2f, 0x9c, 0x8f, 0xc8, 0x4e, 0xbe, 0x4a, 0x38, 0xc8, 0xb0,
+	0xba, 0x16, 0x31, 0xdb, 0x38, 0xa5, 0xc9, 0x0b, 0x8c, 0x2a, 0x10, 0xf2, 0x65, 0x48, 0x23, 0xc7,
+	0x58, 0x5b, 0xd5, 0x28, 0x1f, 0x94, 0xa9, 0xf4, 0xda, 0x5c, 0xe8, 0x72, 0xd5, 0xca, 0xda, 0xea,
+	0x36, 0xc1, 0x96, 0x13, 0x1f, 0xef, 0x17, 0xc7, 0x54, 0xa0, 0x13, 0x28, 0x44, 0xbe, 0x08, 0x29,
+	0x36, 0xbd, 0x81, 0x50, 0x21, 0x79, 0x84, 0xc9, 0x93, 0x94, 0xbc, 0x8a, 0x90, 0x5c, 0x82, 0x29,
+	0xd4, 0xef, 0x68, 0x46, 0x4b, 0xb7, 0x6c, 0xcd, 0x32, 0xb9, 0x55, 0x02, 0xea, 0x77, 0x2a, 0x04,
+	0x54, 0x33, 0x95, 0x6f, 0xc5, 0x20, 0xbb, 0xa9, 0x56, 0xce, 0x9e, 0xbd, 0x70, 0xe1, 0x21, 0x68,
+	0xc0, 0xe6, 0x48, 0x0d, 0x78, 0x36, 0xaa, 0x01, 0x7c, 0xc3, 0xaf, 0x88, 0x22, 0x7c, 0x2a, 0xc1,
+	0xec, 0xc8, 0x53, 0x3d, 0x2a, 0x7d, 0x38, 0x22, 0x7b, 0x17, 0x61, 0x42, 0xef, 0xe0, 0x9e, 0xed,
+	0xb9, 0x85, 0x24, 0x95, 0xe3, 0x7c, 0xe4, 0xd6, 0xc9, 0x69, 0xd7, 0x29, 0x05, 0xbf, 0x78, 0x41,
+	0xaf, 0x7c, 0x20, 0x41, 0x26, 0x44, 0x20, 0x5f, 0xf1, 0x59, 0x49, 0x95, 0x97, 0x09, 0xf1, 0x5f,
+	0xf7, 0x8b, 0x2f, 0x34, 0x2d, 0xaf, 0xd5, 0xdb, 0x59, 0x36, 0x70, 0x87, 0x87, 0x35, 0xfe, 0xe7,
+	0x8c, 0x6b, 0xde, 0x5a, 0xf1, 0xf6, 0xba, 0xc8, 0x5d, 0xae, 0xd9, 0x1e, 0x65, 0xbd, 0x0a, 0xe3,
+	0x6c, 0xf1, 0x42, 0xec, 0x58, 0x6b, 0xf0, 0xd9, 0xca, 0x7b, 0x12, 0x4c, 0xf9, 0x82, 0x26, 0xda,
+	0x1d, 0x55, 0x51, 0x29, 0xaa, 0xa2, 0x24, 0x4c, 0xf9, 0x82, 0x62, 0x72, 0xf7, 0xc7, 0x9c, 0xad,
+	0xf8, 0x71, 0xd9, 0x52, 0x7e, 0x14, 0x87, 0xac, 0x10, 0x78, 0x45, 0x6f, 0xb7, 0xb7, 0x77, 0xc9,
+	0x65, 0x5a, 0x76, 0x5f, 0x6f, 0x5b, 0xa6, 0x4e, 0xb4, 0x31, 0x64, 0x05, 0xf9, 0x20, 0x86, 0x19,
+	0x43, 0x94, 0xdc, 0x35, 0x70, 0x17, 0xd1, 0x73, 0x4e, 0x85, 0xc9, 0xeb, 0x04, 0x41, 0x6c, 0x47,
+	0x04, 0x11, 0xa6, 0x1f, 0x62, 0x48, 0x30, 0x5d, 0x7d, 0xaf, 0x8d, 0x75, 0x16, 0x8c, 0xa7, 0x54,
+	0x31, 0x0c, 0xda, 0x5b, 0x32, 0x6c, 0x6f, 0xe7, 0x61, 0x9c, 0xea, 0x8c, 0x5b, 0x18, 0x2f, 0xc5,
+	0xef, 0xeb, 0x17, 0x38, 0xad, 0xbc, 0x0a, 0x89, 0x06, 0x42, 0x6e, 0x61, 0xe2, 0x08, 0x73, 0x28,
+	0x65, 0xc0, 0xd2, 0x26, 0x43, 0x96, 0x76, 0x0a, 0xb2, 0x0e, 0x6a, 0xf4, 0x6c, 0xd3, 0x8f, 0x8c,
+	0x29, 0xa6, 0xc9, 0x0c, 0xca, 0xe3, 0xe2, 0x21, 0x06, 0x09, 0x87, 0x19, 0x64, 0x17, 0x60, 0x70,
+	0x8e, 0xd0, 0xf5, 0x4b, 0x91, 0xeb, 0x7f, 0x58, 0x5a, 0x39, 0x0f, 0xc9, 0xda, 0x46, 0x1d, 0x79,
+	0x72, 0x0e, 0xe2, 0x96, 0xe9, 0x16, 0xa4, 0x52, 0x7c, 0x29, 0xa1, 0x92, 0x4f, 0xe5, 0xdb, 0x31,
+	0x50, 0x2a, 0xb8, 0xd3, 0xe9, 0xd9, 0x96, 0xb7, 0x77, 0x03, 0xe3, 0xb6, 0x1f, 0x74, 0xbb, 0xc8,
+	0x36, 0x6f, 0x38, 0xb8, 0x8b, 0x5d, 0xbd, 0x4d, 0x42, 0xbd, 0x67, 0x79, 0x6d, 0xc4, 0x8f, 0xc8,
+	0x06, 0x72, 0x09, 0xd2, 0x26, 0x72, 0x0d, 0xc7, 0xea, 0x12, 0x0d, 0xe0, 0xda, 0x1b, 0x04, 0xc9,
+	0xcf, 0x40, 0x2a, 0xea, 0x31, 0x06, 0x00, 0xf9, 0x55, 0x9f, 0x3f, 0x16, 0x34, 0xe6, 0x97, 0x79,
+	0xfa, 0x49, 0x72, 0xd5, 0x65, 0x9e, 0xab, 0x2e, 0x57, 0xb0, 0xe5, 0x5f, 0xb1, 0x2e, 0xcc, 0x1d,
+	0x76, 0x1c, 0xcb, 0x6c, 0xa2, 0x40, 0xd0, 0xb8, 0xef, 0xe4, 0x14, 0x9b, 0x52, 0x45, 0xe8, 0xd2,
+	0xd4, 0xbb, 0x1f, 0x16, 0xc7, 0x7e, 0xfc, 0x61, 0x71, 0xec, 0x1f, 0x1f, 0x16, 0xc7, 0x94, 0x9f,
+	0x24, 0x60, 0x72, 0xf3, 0xcd, 0x6b, 0xd4, 0x20, 0xe5, 0x79, 0x98, 0x8c, 0x18, 0xeb, 0x84, 0xc1,
+	0x2d, 0x55, 0x86, 0x84, 0xad, 0x77, 0x10, 0xe7, 0x93, 0x7e, 0xcb, 0x27, 0x41, 0xe4, 0xda, 0x9a,
+	0xb0, 0x54, 0x35, 0xc5, 0x21, 0x35, 0x53, 0x7e, 0x05, 0x9e, 0xe2, 0x07, 0x1d, 0x4a, 0xb2, 0x98,
+	0x53, 0x9c, 0x65, 0xe8, 0xcd, 0x70, 0xaa, 0x25, 0xaf, 0xc2, 0x64, 0xc3, 0xb2, 0xf5, 0xb6, 0xe5,
+	0xed, 0x51, 0xf6, 0xb2, 0x24, 0x5f, 0x1e, 0xe8, 0x71, 0x95, 0xe3, 0x54, 0x9f, 0x4a, 0x3e, 0x07,
+	0xb3, 0x1d, 0xcb, 0xb6, 0x3a, 0xbd, 0x0e, 0xf1, 0xbb, 0x0d, 0xcb, 0xe9, 0xe8, 0x2c, 0x48, 0xb1,
+	0xa0, 0x38, 0xc3, 0x91, 0x95, 0x20, 0x4e, 0xbe, 0x08, 0xd0, 0x40, 0x48, 0x6b, 0xb4, 0x31, 0x76,
+	0x84, 0xc1, 0x84, 0x37, 0x42, 0xa8, 0x4a, 0x90, 0x42, 0x84, 0x0d, 0x3e, 0x76, 0x09, 0x67, 0x26,
+	0xea, 0x62, 0xd7, 0xf2, 0x04, 0x47, 0x5a, 0x43, 0x37, 0x3c, 0xec, 0xec, 0x51, 0x23, 0x4a, 0xa9,
+	0xb3, 0x1c, 0xcd, 0x59, 0xaa, 0x32, 0xa4, 0x5c, 0x15, 0xd1, 0xc1, 0x44, 0x86, 0xd5, 0xd1, 0xdb,
+	0xc4, 0xa6, 0x86, 0xbc, 0x3f, 0x35, 0x8d, 0x0d, 0x4e, 0xc0, 0xf7, 0xce, 0x78, 0x41, 0x20, 0xc9,
+	0x96, 0x6d, 0xdd, 0xb3, 0xfa, 0x68, 0xb0, 0x10, 0xb3, 0xb8, 0x2c, 0x03, 0xfb, 0x84, 0xff, 0x03,
+	0x69, 0x47, 0xf7, 0x90, 0xd6, 0xb6, 0x3a, 0x96, 0xe7, 0x16, 0xd2, 0x74, 0xb7, 0xd9, 0xe0, 0x6e,
+	0xaa, 0xee, 0xa1, 0xab, 0x04, 0xcb, 0x77, 0x02, 0x47, 0x00, 0x5c, 0xe5, 0x7d, 0x09, 0x52, 0x3e,
+	0x7e, 0x44, 0x68, 0x93, 0x46, 0x85, 0xb6, 0x0d, 0x48, 0xd2, 0xdd, 0x8e, 0x69, 0xb6, 0x6c, 0x32,
+	0xf1, 0x4a, 0xb7, 0x2d, 0xdb, 0xc4, 0xb7, 0xa9, 0x5a, 0x25, 0x54, 0x3e, 0x52, 0xbe, 0x09, 0x59,
+	0xff, 0x44, 0x37, 0x5d, 0xbd, 0x89, 0xe4, 0x67, 0x61, 0x8a, 0xe1, 0x34, 0xd7, 0xd3, 0x1d, 0xf1,
+	0x6c, 0x48, 0x33, 0x58, 0x9d, 0x80, 0x1e, 0x9a, 0x2b, 0xf9, 0x83, 0x04, 0xf9, 0x5a, 0xb9, 0x52,
+	0xc5, 0xce, 0x6d, 0xdd, 0x31, 0x2b, 0x2d, 0xdd, 0xb6, 0x51, 0x9b, 0x58, 0x81, 0xc1, 0x3e, 0x85,
+	0xd9, 0xa4, 0xd4, 0x14, 0x87, 0xd4, 0x4c, 0xf2, 0x60, 0xd9, 0x41, 0x46, 0xeb, 0xdc, 0x9a, 0xd6,
+	0x75, 0x50, 0xc3, 0xda, 0xe5, 0x16, 0x34, 0xc5, 0x80, 0x37, 0x28, 0x2c, 0x18, 0x06, 0xe2, 0xe1,
+	0x30, 0xb0, 0x0c, 0x27, 0x0c, 0xbd, 0xdd, 0xde, 0xd1, 0x8d, 0x5b, 0x5a, 0x60, 0x1b, 0x66, 0x40,
+	0x79, 0x81, 0xaa, 0xf8, 0xdb, 0xbd, 0x0c, 0xf9, 0x01, 0xbd, 0xb8, 0xa8, 0x24, 0xa5, 0xce, 0xf9,
+	0xd4, 0x1c, 0xae, 0xfc, 0x30, 0x06, 0x39, 0xce, 0x0d, 0x32, 0x37, 0x98, 0xca, 0x1e, 0x21, 0x6a,
+	0x17, 0x21, 0x4d, 0xdf, 0xa5, 0x3c, 0x7e, 0xc6, 0x04, 0x01, 0xb2, 0xf9, 0x5b, 0x30, 0xf8, 0x9a,
+	0xe3, 0x59, 0x15, 0xf3, 0x0e, 0xfe, 0x6b, 0xae, 0x4e, 0xa1, 0x11, 0xd9, 0x25, 0xa2, 0xb2, 0x5b,
+	0x80, 0x49, 0x17, 0x7d, 0xbd, 0x87, 0xc8, 0x2e, 0x2c, 0x3c, 0xfa, 0x63, 0xf6, 0xc2, 0x35, 0x90,
+	0xd5, 0x47, 0x0e, 0x35, 0xf3, 0x94, 0xea, 0x8f, 0x03, 0xbe, 0x75, 0xe2, 0x81, 0x7c, 0xab, 0x72,
+	0x47, 0x82, 0xfc, 0x55, 0xdc, 0xb4, 0x0c, 0x9a, 0x30, 0xa0, 0x4e, 0xb7, 0xad, 0x7b, 0xc8, 0xf7,
+	0x7d, 0x52, 0xc0, 0xf7, 0x45, 0xa5, 0x14, 0x1b, 0x92, 0xd2, 0x29, 0xc8, 0xb6, 0xc9, 0x52, 0x83,
+	0x6b, 0x60, 0x32, 0xc8, 0x50, 0xa8, 0x6f, 0x2f, 0x87, 0xe6, 0x06, 0x8a, 0x0b, 0x99, 0x90, 0x2f,
+	0x20, 0x81, 0xc8, 0x44, 0x36, 0xee, 0x88, 0x40, 0x44, 0x07, 0x64, 0x1f, 0xfa, 0x31, 0xf0, 0x05,
+	0x31, 0xea, 0x0b, 0x32, 0x14, 0xea, 0x4f, 0x3e, 0x05, 0x59, 0xf6, 0xd4, 0xf0, 0xc9, 0xe2, 0x8c,
+	0x8c, 0x42, 0x05, 0x99, 0xf2, 0x1d, 0x09, 0x26, 0x85, 0xe3, 0x3b, 0xaa, 0xc9, 0x5f, 0x87, 0xb4,
+	0x70, 0xbf, 0x24, 0x24, 0x1d, 0xcf, 0xc8, 0x80, 0x2f, 0x51, 0x45, 0x48, 0xf9, 0x81, 0x04, 0x27,
+	0xd6, 0x4d, 0x53, 0xc4, 0xa5, 0x2f, 0x1d, 0x89, 0x57, 0x21, 0x49, 0x2f, 0x8a, 0xb2, 0x1c, 0xf1,
+	0xf2, 0x62, 0x13, 0xae, 0x09, 0x8c, 0x30, 0x12, 0x24, 0xff, 0x2e, 0xc1, 0xbc, 0xe0, 0xf6, 0x9a,
+	0xd5, 0x74, 0x68, 0x04, 0xf9, 0xd2, 0xa7, 0x8a, 0xaa, 0x50, 0x7c, 0x48, 0x85, 0x8e, 0x1b, 0x41,
+	0x47, 0x54, 0x53, 0x92, 0xa3, 0xaa, 0x29, 0x11, 0x36, 0xdf, 0x93, 0x20, 0x3f, 0xc4, 0xe6, 0xbd,
+	0x0e, 0x21, 0x3d, 0xe0, 0x21, 0x62, 0x23, 0x4b, 0x3a, 0x83, 0x0c, 0x34, 0x1e, 0xcc, 0x40, 0x95,
+	0xef, 0x4b, 0x90, 0x2d, 0xd3, 0xa5, 0x7d, 0x4d, 0x3b, 0xee, 0x59, 0x66, 0x20, 0x89, 0xba, 0xd8,
+	0x68, 0xf1, 0x13, 0xb0, 0xc1, 0xa8, 0x13, 0xc6, 0x47, 0x9d, 0x90, 0xbc, 0xb9, 0x66, 0x7d, 0x65,
+	0xd4, 0x7b, 0x2e, 0x7a, 0x0c, 0x77, 0x3f, 0x07, 0xe3, 0x5d, 0xb2, 0x95, 0xa8, 0xdf, 0xf1, 0x51,
+	0xe4, 0xca, 0xfe, 0x28, 0xc1, 0xfc, 0xeb, 0x3c, 0xe3, 0xda, 0x50, 0xb1, 0xf7, 0xb8, 0x34, 0x33,
+	0x9c, 0xfa, 0x25, 0xa2, 0xa9, 0xdf, 0xcb, 0x90, 0x67, 0xa5, 0x48, 0xdd, 0x36, 0x90, 0xc6, 0x23,
+	0x39, 0x53, 0xc1, 0xdc, 0x00, 0xf1, 0x16, 0x85, 0x47, 0x38, 0xda, 0x81, 0xfc, 0x10, 0x43, 0x24,
+	0x0a, 0x76, 0x1d, 0xd4, 0xb7, 0x70, 0xcf, 0xd5, 0x02, 0xfb, 0x32, 0xb6, 0xf2, 0x02, 0xf5, 0xba,
+	0xbf, 0xff, 0x49, 0x00, 0x64, 0x9b, 0x61, 0xb5, 0x4b, 0x21, 0xdb, 0xe4, 0xf7, 0xf9, 0xdb, 0x18,
+	0x14, 0x54, 0xd4, 0xd6, 0xf7, 0x90, 0x53, 0xb3, 0x0d, 0x64, 0x93, 0x9c, 0xe9, 0x31, 0x08, 0xcd,
+	0x08, 0xa4, 0xfc, 0xf1, 0x7b, 0x87, 0xa5, 0x55, 0xe2, 0x8c, 0x3e, 0xfa, 0xac, 0xb8, 0x74, 0x04,
+	0xef, 0x49, 0x26, 0xb8, 0xfe, 0xf3, 0x60, 0x05, 0x4e, 0x98, 0x96, 0xbb, 0xd3, 0x73, 0x5c, 0xd4,
+	0x21, 0x31, 0xba, 0x8b, 0x1c, 0x0b, 0x9b, 0x5c, 0xf8, 0x72, 0x10, 0x75, 0x83, 0x62, 0xe4, 0xe7,
+	0x21, 0x13, 0x84, 0x8a, 0xa4, 0x39, 0x0c, 0x8c, 0x5c, 0xd2, 0x9f, 0xe2, 0x90, 0x8b, 0x0a, 0x70,
+	0xa8, 0xa4, 0x72, 0xff, 0x10, 0x39, 0x10, 0x48, 0xfc, 0xd1, 0x09, 0xc4, 0x82, 0x94, 0x60, 0xc5,
+	0x7c, 0x14, 0x82, 0x1f, 0xac, 0xfe, 0x88, 0x64, 0x4f, 0xde, 0xd8, 0x21, 0x80, 0xd6, 0xd1, 0x4d,
+	0x44, 0x53, 0x9b, 0x84, 0x9a, 0x0f, 0x61, 0xae, 0xe9, 0x26, 0x92, 0x5f, 0x83, 0x82, 0x8d, 0x76,
+	0x3d, 0x2d, 0x74, 0x94, 0xd0, 0x1b, 0x7f, 0x8e, 0xe0, 0x37, 0x02, 0x68, 0x6e, 0x17, 0x1f, 0x4b,
+	0xb0, 0x18, 0x6e, 0x40, 0xd0, 0x9e, 0xc1, 0xe3, 0x71, 0x29, 0x91, 0xac, 0x32, 0x31, 0x94, 0x55,
+	0x9e, 0x04, 0x36, 0xd2, 0x5a, 0xba, 0xdb, 0xe2, 0x39, 0x6d, 0x8a, 0x42, 0xde, 0xd0, 0xdd, 0x56,
+	0x44, 0x43, 0x7f, 0x11, 0x83, 0x42, 0xcd, 0x36, 0x2c, 0x93, 0x72, 0x61, 0xe0, 0x3e, 0x72, 0xf6,
+	0xbe, 0x34, 0x13, 0x6b, 0x30, 0xce, 0xea, 0x98, 0xf4, 0xf8, 0xd9, 0x70, 0xfd, 0x5b, 0xec, 0xb6,
+	0x4e, 0x29, 0x54, 0x4e, 0x49, 0xab, 0x42, 0x86, 0xe1, 0x3f, 0xf4, 0x53, 0xaa, 0x18, 0x06, 0xb4,
+	0x3f, 0xf9, 0xe8, 0xb4, 0x7f, 0x0e, 0xc6, 0x1d, 0xa4, 0xbb, 0xbc, 0x48, 0x9a, 0x52, 0xf9, 0x28,
+	0x22, 0xad, 0x9f, 0xc5, 0x20, 0x1b, 0x94, 0x96, 0x63, 0x0e, 0x59, 0xf3, 0x80, 0xf7, 0xd8, 0x91,
+	0x79, 0x7f, 0x06, 0x52, 0x7a, 0xcf, 0x6b, 0x61, 0x87, 0x3c, 0xe5, 0x79, 0x7d, 0xc0, 0x07, 0x7c,
+	0x45, 0x25, 0x13, 0x48, 0x47, 0x26, 0x42, 0xe9, 0xc8, 0xbf, 0x13, 0x30, 0xc5, 0xd2, 0x11, 0x15,
+	0x75, 0xb1, 0xe3, 0x0d, 0x49, 0xe8, 0x59, 0x98, 0xa2, 0x4f, 0xd0, 0x70, 0xd8, 0x49, 0x53, 0x18,
+	0x4f, 0x75, 0xc2, 0x71, 0x29, 0x1e, 0x89, 0x4b, 0xa4, 0x33, 0x47, 0x9e, 0x4b, 0xae, 0xe6, 0x61,
+	0x3f, 0xc1, 0xe1, 0x86, 0x30, 0x4d, 0x11, 0x81, 0x02, 0xf6, 0x0b, 0x30, 0xed, 0xd3, 0x32, 0x4e,
+	0xb9, 0x9f, 0xc9, 0x70, 0xca, 0x0a, 0x05, 0x92, 0x1e, 0x17, 0xad, 0xef, 0x23, 0x57, 0x43, 0xbb,
+	0xc8, 0xe8, 0x91, 0x8e, 0x20, 0xf3, 0x32, 0xd3, 0x1c, 0xbe, 0xc9, 0xc1, 0x24, 0xbb, 0x12, 0x89,
+	0xbe, 0x46, 0xde, 0x8a, 0x81, 0x19, 0x4c, 0x14, 0xb3, 0x46, 0xa0, 0x9e, 0x3a, 0x98, 0xe7, 0x40,
+	0x96, 0x94, 0x12, 0x35, 0x03, 0xb7, 0xdb, 0xac, 0xe5, 0x38, 0xf9, 0xf0, 0xaf, 0x2d, 0x43, 0xb6,
+	0xa8, 0x88, 0x1d, 0x88, 0x4f, 0xe4, 0xf5, 0x57, 0xec, 0xb8, 0x9a, 0xdb, 0xd6, 0xdd, 0x16, 0x32,
+	0x69, 0x89, 0x32, 0xa1, 0xe6, 0x07, 0x98, 0x3a, 0x43, 0xc8, 0xab, 0x30, 0x23, 0xfa, 0x9f, 0x1a,
+	0x73, 0x22, 0xac, 0xa1, 0x0a, 0xcc, 0x35, 0x0b, 0x9c, 0xdf, 0xb7, 0x75, 0x49, 0xca, 0xd1, 0x47,
+	0x1e, 0x46, 0xa6, 0x86, 0x7b, 0x5e, 0x13, 0x5b, 0x76, 0x53, 0xf3, 0x76, 0x49, 0x09, 0x85, 0xed,
+	0x40, 0x51, 0xd7, 0x39, 0x66, 0x7b, 0xd7, 0x95, 0xcf, 0xc1, 0x9c, 0x67, 0x75, 0x18, 0x79, 0x78,
+	0xca, 0x14, 0x9d, 0x72, 0x82, 0x62, 0xaf, 0xf7, 0xbc, 0xe0, 0xa4, 0xd3, 0x90, 0xb3, 0xb8, 0xe9,
+	0x90, 0xee, 0x02, 0x76, 0x4c, 0xb7, 0x90, 0x61, 0x97, 0x63, 0x85, 0xcc, 0xd1, 0x55, 0x7e, 0x17,
+	0x83, 0xec, 0x36, 0xe9, 0xa4, 0x34, 0x90, 0xc3, 0x4d, 0xf4, 0x21, 0xbf, 0xd4, 0xef, 0x95, 0x02,
+	0x93, 0x37, 0xf0, 0x2d, 0xcb, 0x16, 0xa9, 0x1e, 0xfd, 0x1e, 0xf1, 0x3c, 0x4c, 0x1e, 0xd2, 0xcb,
+	0xe1, 0xd6, 0xcc, 0x0d, 0x8d, 0x8d, 0x46, 0x55, 0x09, 0x26, 0x46, 0x56, 0x09, 0x5e, 0x84, 0x69,
+	0xde, 0xf3, 0xf5, 0x5f, 0xfc, 0xac, 0xcc, 0x96, 0x65, 0x60, 0x95, 0x43, 0xa3, 0xed, 0xad, 0x54,
+	0xb4, 0xbd, 0xa5, 0x78, 0x90, 0x21, 0x75, 0xde, 0xf5, 0x66, 0xd3, 0x41, 0x4d, 0xf2, 0xb4, 0x9f,
+	0x81, 0x24, 0xf3, 0x40, 0xbc, 0x8d, 0x4b, 0x07, 0xf2, 0x35, 0x00, 0x0f, 0x7b, 0x7a, 0x5b, 0xa3,
+	0xb5, 0xf4, 0xe3, 0xbd, 0x67, 0x53, 0x74, 0x85, 0x2a, 0x42, 0xae, 0x72, 0x01, 0x26, 0x89, 0x4e,
+	0x91, 0xce, 0xb1, 0x7c, 0x1a, 0xc6, 0x89, 0xe6, 0x39, 0xac, 0x10, 0x9d, 0x5e, 0xcb, 0x07, 0xdd,
+	0x28, 0xa5, 0x52, 0x39, 0x81, 0xf2, 0x7f, 0x90, 0xa4, 0x00, 0x92, 0x4d, 0xfb, 0x1a, 0x1d, 0x79,
+	0xef, 0xe4, 0x7c, 0x44, 0xe0, 0xa9, 0xc3, 0x3a, 0xdd, 0xe4, 0xd8, 0x71, 0xde, 0xe9, 0x56, 0xfa,
+	0x20, 0xaf, 0x7b, 0x1e, 0x72, 0x59, 0x3e, 0x4d, 0xfa, 0xf0, 0xb6, 0x41, 0x3d, 0xb0, 0xab, 0x77,
+	0xba, 0x6d, 0x24, 0xca, 0xe2, 0x62, 0x48, 0x8a, 0xe5, 0xdd, 0x0b, 0xab, 0x5c, 0x61, 0xc8, 0x27,
+	0x85, 0x5c, 0x5c, 0xe5, 0xda, 0x41, 0x3e, 0x19, 0xe4, 0x22, 0xf7, 0x4f, 0xe4, 0x93, 0x40, 0x3a,
+	0xfa, 0x2e, 0xf7, 0x43, 0xe4, 0x53, 0xf9, 0x7d, 0x0c, 0xd2, 0xcc, 0x69, 0xd6, 0x3d, 0x22, 0xef,
+	0x81, 0x73, 0x95, 0x42, 0xdd, 0x86, 0xcb, 0x30, 0x4e, 0xd5, 0x98, 0xfd, 0xc4, 0x21, 0xbd, 0x56,
+	0x1c, 0xf9, 0x44, 0x1f, 0x2c, 0x24, 0xea, 0x36, 0x6c, 0x92, 0x7c, 0x11, 0xe6, 0xdb, 0xba, 0x1b,
+	0xb0, 0xbb, 0xa0, 0x1a, 0xb0, 0x23, 0xcf, 0x11, 0x02, 0x61, 0x7b, 0xe5, 0x41, 0xc7, 0xf3, 0x15,
+	0x28, 0xd0, 0xa9, 0x44, 0x03, 0x83, 0x7e, 0x57, 0xbc, 0x6b, 0x12, 0xea, 0x0c, 0xc1, 0x87, 0xdb,
+	0xc9, 0x35, 0xea, 0xf4, 0xfa, 0xb8, 0x67, 0xb4, 0xc8, 0x4f, 0x23, 0x7a, 0xdd, 0x6e, 0x7b, 0xef,
+	0x51, 0xc4, 0xaa, 0x0c, 0xdf, 0xa2, 0x4e, 0x77, 0x50, 0x3e, 0x89, 0xc1, 0x89, 0x11, 0xc2, 0xb8,
+	0x57, 0xdd, 0xde, 0x97, 0xcc, 0x8e, 0x8b, 0x9c, 0xbe, 0xef, 0xfd, 0x82, 0xfe, 0x80, 0x49, 0x86,
+	0xe3, 0x37, 0x07, 0xbe, 0xe1, 0x12, 0x2c, 0xb4, 0xe9, 0x0f, 0x36, 0xb4, 0xc0, 0x4f, 0x40, 0xbc,
+	0xdd, 0xa8, 0x54, 0x09, 0x45, 0xe0, 0x97, 0x11, 0x6c, 0xee, 0x6b, 0x50, 0x08, 0x6f, 0x3b, 0x68,
+	0x05, 0xf1, 0x32, 0x57, 0x68, 0xd7, 0x8a, 0x8f, 0x95, 0x9b, 0x30, 0x49, 0x32, 0x2e, 0x7c, 0x1b,
+	0x99, 0x8f, 0x42, 0xa2, 0xfe, 0xe2, 0xca, 0x65, 0x98, 0x0e, 0xc8, 0x90, 0xa4, 0x90, 0x87, 0x6a,
+	0xa7, 0x0c, 0x09, 0x9a, 0x73, 0xb2, 0xd6, 0x1f, 0xfd, 0x56, 0xfe, 0x12, 0x83, 0xa5, 0xfb, 0x37,
+	0x8f, 0xaa, 0xd8, 0xa9, 0x5c, 0xad, 0xc9, 0x2f, 0x84, 0x12, 0xce, 0x72, 0xee, 0x60, 0xbf, 0x38,
+	0xb5, 0xa7, 0x77, 0xda, 0x97, 0x14, 0x0a, 0x56, 0x44, 0x0a, 0xfa, 0xda, 0x88, 0x14, 0xb4, 0x3c,
+	0x77, 0xb0, 0x5f, 0x94, 0x19, 0x75, 0x00, 0xa9, 0x44, 0x53, 0xd3, 0x68, 0xb3, 0xa9, 0x3c, 0x73,
+	0xb0, 0x5f, 0xcc, 0xb1, 0x79, 0x3e, 0x4a, 0x09, 0xb6, 0xa0, 0x4e, 0x87, 0x5a, 0x50, 0xa9, 0x72,
+	0xfe, 0x60, 0xbf, 0x98, 0x61, 0x13, 0x18, 0x5c, 0xf1, 0x7d, 0xf5, 0xf9, 0xa1, 0xa6, 0x53, 0xaa,
+	0x3c, 0x7b, 0xb0, 0x5f, 0xcc, 0x33, 0xf2, 0x01, 0x4e, 0x09, 0xb4, 0x9a, 0xe4, 0xff, 0x82, 0x09,
+	0xde, 0x08, 0x61, 0xae, 0xbf, 0x2c, 0x1f, 0xec, 0x17, 0xb3, 0x82, 0x15, 0x8a, 0x50, 0x54, 0x41,
+	0x72, 0x69, 0x92, 0xa7, 0xa4, 0x92, 0xf2, 0x2f, 0x09, 0xe6, 0x47, 0xd4, 0xff, 0x1e, 0x9b, 0x30,
+	0xff, 0xf7, 0x28, 0xf5, 0xc2, 0x19, 0xa2, 0x7b, 0x83, 0xbd, 0xe9, 0x04, 0x85, 0xd7, 0x0f, 0x83,
+	0x9c, 0x27, 0x1e, 0x84, 0xf3, 0x0f, 0xe2, 0x50, 0x3c, 0xb4, 0xd2, 0xf8, 0xd8, 0xf8, 0xbf, 0x38,
+	0xea, 0xb1, 0x56, 0x7e, 0xea, 0x60, 0xbf, 0x78, 0x82, 0x4d, 0x0d, 0x62, 0x95, 0x50, 0xc6, 0xf1,
+	0xf6, 0x7d, 0x4a, 0x96, 0x65, 0xe5, 0x60, 0xbf, 0xb8, 0x18, 0xd2, 0x9a, 0x28, 0xa1, 0x72, 0x58,
+	0x15, 0xaf, 0x72, 0x48, 0x59, 0xb3, 0xbc, 0x70, 0xb0, 0x5f, 0x9c, 0xe3, 0x27, 0x0b, 0x13, 0x28,
+	0x43, 0x89, 0xcc, 0x71, 0x75, 0xf2, 0x6e, 0x0c, 0x9e, 0x1e, 0x59, 0x03, 0x7c, 0x12, 0x6e, 0xe5,
+	0x74, 0xb8, 0x98, 0x18, 0xb4, 0x74, 0x06, 0x57, 0x44, 0x7d, 0x31, 0x28, 0x9f, 0xe4, 0x03, 0xd9,
+	0x6c, 0x0c, 0x8a, 0x87, 0x56, 0x22, 0x9f, 0x04, 0x19, 0x9d, 0x1f, 0x2e, 0x69, 0x06, 0x5d, 0xdc,
+	0x00, 0xa7, 0x04, 0x2b, 0x9d, 0xb5, 0x43, 0x2b, 0x9d, 0xe5, 0x67, 0x0e, 0xf6, 0x8b, 0x05, 0x36,
+	0x79, 0x88, 0x44, 0x19, 0xae, 0x83, 0x1e, 0x5b, 0x33, 0xdf, 0x82, 0xec, 0x46, 0xa8, 0xdd, 0x1c,
+	0xfe, 0xe5, 0x81, 0x14, 0xfd, 0xe5, 0xc1, 0x8b, 0x30, 0x1d, 0xe9, 0x5e, 0xf3, 0x5a, 0x47, 0x36,
+	0xdc, 0xb5, 0x56, 0x7e, 0x19, 0x87, 0xc5, 0xc3, 0xca, 0xa4, 0x4f, 0x88, 0xd6, 0x1f, 0x35, 0xbe,
+	0x5d, 0xbf, 0x47, 0xe5, 0xae, 0xbc, 0x78, 0xb0, 0x5f, 0x5c, 0xe0, 0xe7, 0x1c, 0x26, 0x52, 0x46,
+	0x56, 0xf6, 0xae, 0x8c, 0xac, 0xec, 0x95, 0x0b, 0x07, 0xfb, 0xc5, 0x99, 0xe1, 0xa5, 0x5c, 0x25,
+	0x5a, 0xf3, 0x0b, 0x28, 0xc3, 0xc4, 0x83, 0x28, 0xc3, 0x3f, 0x63, 0xf0, 0xfc, 0xbd, 0x4b, 0x78,
+	0x4f, 0xc2, 0xcd, 0xbd, 0x3a, 0xa2, 0x16, 0x18, 0xdc, 0x34, 0x80, 0x54, 0x42, 0xef, 0xd9, 0xf3,
+	0xc3, 0x35, 0xc2, 0xa0, 0x11, 0x0f, 0x70, 0x4a, 0xa0, 0x74, 0x78, 0x6c, 0xcb, 0xfb, 0x5e, 0x1c,
+	0x16, 0x0f, 0x2b, 0x32, 0x3e, 0x36, 0x31, 0x6f, 0x1e, 0xbd, 0x28, 0x19, 0xb2, 0x00, 0x83, 0xad,
+	0xc5, 0x27, 0x13, 0x19, 0x84, 0xaa, 0x71, 0x41, 0x19, 0x70, 0x84, 0x32, 0xa8, 0xd0, 0x9d, 0x0e,
+	0x54, 0xe8, 0xee, 0x63, 0x5a, 0xa7, 0xc3, 0x75, 0xb6, 0x20, 0x29, 0x83, 0x2b, 0x7e, 0xe9, 0xed,
+	0x98, 0x4a, 0xff, 0xd2, 0x4f, 0x49, 0xd3, 0x5a, 0xfc, 0x18, 0xe8, 0x02, 0xcc, 0x55, 0x6b, 0x5b,
+	0xeb, 0x57, 0x6b, 0xdb, 0x5f, 0xd3, 0x2a, 0xd7, 0xb7, 0xaa, 0x35, 0xf5, 0xda, 0xfa, 0x76, 0xed,
+	0xfa, 0x56, 0x3d, 0x37, 0xb6, 0x30, 0x7f, 0xe7, 0x6e, 0x69, 0x56, 0x50, 0x86, 0x7f, 0x0e, 0xf4,
+	0x1c, 0x64, 0xfc, 0x69, 0xf5, 0xf5, 0xea, 0x66, 0x4e, 0x5a, 0xc8, 0xdd, 0xb9, 0x5b, 0x9a, 0x12,
+	0xd4, 0x75, 0xbd, 0x41, 0x7f, 0x11, 0xe8, 0x13, 0xb1, 0x8f, 0xb7, 0x37, 0x37, 0x72, 0xb1, 0x85,
+	0xd9, 0x3b, 0x77, 0x4b, 0x79, 0x41, 0xc9, 0xfe, 0x7e, 0x03, 0x99, 0x0b, 0x89, 0x77, 0x7f, 0xbe,
+	0x38, 0xf6, 0xd2, 0x6f, 0x24, 0xc8, 0x86, 0xef, 0x41, 0xbe, 0x02, 0x4f, 0xd7, 0xb6, 0x2a, 0xb5,
+	0x8d, 0xcd, 0xad, 0x6d, 0x6d, 0xbd, 0x42, 0x4e, 0xa7, 0xdd, 0xdc, 0xaa, 0xdf, 0xd8, 0xac, 0xd4,
+	0xaa, 0xb5, 0xcd, 0x8d, 0xdc, 0xd8, 0xc2, 0xc9, 0x3b, 0x77, 0x4b, 0xf3, 0xe1, 0x49, 0x37, 0x6d,
+	0xb7, 0x8b, 0x0c, 0xab, 0x61, 0xb1, 0x72, 0x56, 0x74, 0xfe, 0xb5, 0xda, 0xd6, 0x76, 0x4e, 0x5a,
+	0x98, 0xbb, 0x73, 0xb7, 0x24, 0x87, 0x27, 0x5e, 0x23, 0xcf, 0xaa, 0x11, 0x33, 0xca, 0x37, 0xd5,
+	0xad, 0x5c, 0x6c, 0xd4, 0x8c, 0x72, 0xcf, 0xb1, 0xd9, 0xe1, 0xcb, 0x37, 0x3f, 0xfe, 0x7c, 0x51,
+	0xfa, 0xf4, 0xf3, 0x45, 0xe9, 0x6f, 0x9f, 0x2f, 0x4a, 0xef, 0x7f, 0xb1, 0x38, 0xf6, 0xe9, 0x17,
+	0x8b, 0x63, 0x7f, 0xfe, 0x62, 0x71, 0xec, 0xed, 0xff, 0x0e, 0xbc, 0xb9, 0xba, 0xa8, 0xd9, 0xdc,
+	0x7b, 0xa7, 0x2f, 0xfe, 0x13, 0xe3, 0x0c, 0xcb, 0xdf, 0x56, 0x3a, 0xd8, 0xec, 0xb5, 0xd1, 0x4a,
+	0xff, 0xdc, 0xca, 0xae, 0x40, 0xb1, 0xc7, 0xd8, 0xce, 0x38, 0xfd, 0xcf, 0x87, 0x73, 0xff, 0x19,
+	0x00, 0x41, 0xe8, 0x13, 0x8a, 0xc7, 0x31, 0x00, 0x00,
+}
+
+func (m *EthereumEventVoteRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumEventVoteRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumEventVoteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteBitmap) > 0 {
+		i -= len(m.VoteBitmap)
+		copy(dAtA[i:], m.VoteBitmap)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.VoteBitmap)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SignerSetNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.SignerSetNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FirstVoteHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.FirstVoteHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Rejected {
+		i--
+		if m.Rejected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Votes[iNdEx])
+			copy(dAtA[i:], m.Votes[iNdEx])
+			i = encodeVarintGravity(dAtA, i, uint64(len(m.Votes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGravity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CosmosHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Power != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSetTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSetTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSetTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckpointVersion != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CheckpointVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckpointVersion != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CheckpointVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Erc20Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC1155BatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC1155BatchTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC1155BatchTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckpointVersion != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CheckpointVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendERC1155ToEthereum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendERC1155ToEthereum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendERC1155ToEthereum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumRecipient) > 0 {
+		i -= len(m.EthereumRecipient)
+		copy(dAtA[i:], m.EthereumRecipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC1155Amount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC1155Amount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC1155Amount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC1155Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC1155Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC1155Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Id.Size()
+		i -= size
+		if _, err := m.Id.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckpointVersion != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.CheckpointVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InvalidationScope) > 0 {
+		i -= len(m.InvalidationScope)
+		copy(dAtA[i:], m.InvalidationScope)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.InvalidationScope)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IDSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IDSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA5 := make([]byte, len(m.Ids)*10)
+		var j4 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGravity(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolEthereumSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolEthereumSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolEthereumSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NativeDecimals != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.NativeDecimals))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenDecimals) > 0 {
+		for iNdEx := len(m.TokenDecimals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenDecimals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DepositAddressFactory) > 0 {
+		i -= len(m.DepositAddressFactory)
+		copy(dAtA[i:], m.DepositAddressFactory)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.DepositAddressFactory)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FeeFloors) > 0 {
+		for iNdEx := len(m.FeeFloors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeFloors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MinimumConfirmations != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.MinimumConfirmations))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Finality != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Finality))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.WindowStart != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCForwardChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCForwardChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCForwardChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackContract) > 0 {
+		i -= len(m.CallbackContract)
+		copy(dAtA[i:], m.CallbackContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.CallbackContract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CallbackChannelId) > 0 {
+		i -= len(m.CallbackChannelId)
+		copy(dAtA[i:], m.CallbackChannelId)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.CallbackChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bech32Prefix) > 0 {
+		i -= len(m.Bech32Prefix)
+		copy(dAtA[i:], m.Bech32Prefix)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Bech32Prefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogicCallTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogicCallTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogicCallTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LogicContract) > 0 {
+		i -= len(m.LogicContract)
+		copy(dAtA[i:], m.LogicContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.LogicContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenDecimals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenDecimals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenDecimals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Erc20Decimals != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Erc20Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DenomDecimals != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.DenomDecimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeFloor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeFloor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeFloor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinimumFee.Size()
+		i -= size
+		if _, err := m.MinimumFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddEVMChainProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddEVMChainProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddEVMChainProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMigrationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMigrationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMigrationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMChainPauseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMChainPauseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMChainPauseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GravityIDRotationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GravityIDRotationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GravityIDRotationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcceptanceWindow != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.AcceptanceWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GravityIDRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GravityIDRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GravityIDRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PreviousGravityId) > 0 {
+		i -= len(m.PreviousGravityId)
+		copy(dAtA[i:], m.PreviousGravityId)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.PreviousGravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerIncentiveProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Disbursements != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Disbursements))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DisbursementPeriod != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.DisbursementPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextDisbursementHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.NextDisbursementHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DisbursementsMade != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.DisbursementsMade))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Disbursements != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Disbursements))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DisbursementPeriod != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.DisbursementPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Disbursed) > 0 {
+		for iNdEx := len(m.Disbursed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disbursed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumEventRejectionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumEventRejectionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumEventRejectionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventHash) > 0 {
+		i -= len(m.EventHash)
+		copy(dAtA[i:], m.EventHash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EventHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncidentRecoveryProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncidentRecoveryProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncidentRecoveryProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Action != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncidentRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncidentRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncidentRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncidentRecords != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.IncidentRecords))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TimedOutOutgoingTxs != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.TimedOutOutgoingTxs))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.VetoedOutgoingTxs != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.VetoedOutgoingTxs))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RejectedEventVotes != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.RejectedEventVotes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ValidatorsSlashed != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ValidatorsSlashed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.FeesCollected) > 0 {
+		for iNdEx := len(m.FeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ContractCallsExecuted != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ContractCallsExecuted))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BatchesExecuted != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchesExecuted))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SendsToCosmos != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.SendsToCosmos))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SendsToEthereum != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.SendsToEthereum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolAggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalFees.Size()
+		i -= size
+		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Count != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoterSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoterSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoterSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Voter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Voter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Voter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationLatency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationLatency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.P99 != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.P99))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.P90 != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.P90))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.P50 != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.P50))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Samples) > 0 {
+		dAtA11 := make([]byte, len(m.Samples)*10)
+		var j10 int
+		for _, num := range m.Samples {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintGravity(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoucherSupply) > 0 {
+		for iNdEx := len(m.VoucherSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoucherSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LastSendToEthereumId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.LastSendToEthereumId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastOutgoingBatchNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.LastOutgoingBatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMChainBridgeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMChainBridgeState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMChainBridgeState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGravity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LastObservedCheckpoint) > 0 {
+		i -= len(m.LastObservedCheckpoint)
+		copy(dAtA[i:], m.LastObservedCheckpoint)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.LastObservedCheckpoint)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.LatestSignerSetTxNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeStateHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeStateHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeStateHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BridgeFee) > 0 {
+		i -= len(m.BridgeFee)
+		copy(dAtA[i:], m.BridgeFee)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeFee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddEVMChainProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddEVMChainProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddEVMChainProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGravity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMigrationProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMigrationProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMigrationProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EthereumHeight != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EthereumHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMChainPauseProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMChainPauseProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMChainPauseProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GravityIDRotationProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GravityIDRotationProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GravityIDRotationProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AcceptanceWindow != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.AcceptanceWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositAddress) > 0 {
+		i -= len(m.DepositAddress)
+		copy(dAtA[i:], m.DepositAddress)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.DepositAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerIncentiveProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerIncentiveProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerIncentiveProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Disbursements != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Disbursements))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DisbursementPeriod != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.DisbursementPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumEventRejectionProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumEventRejectionProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumEventRejectionProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EventHash) > 0 {
+		i -= len(m.EventHash)
+		copy(dAtA[i:], m.EventHash)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.EventHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EvmChainId != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.EvmChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncidentRecoveryProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncidentRecoveryProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncidentRecoveryProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Action != 0 {
+		i = encodeVarintGravity(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGravity(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGravity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGravity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EthereumEventVoteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, s := range m.Votes {
+			l = len(s)
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.Accepted {
+		n += 2
+	}
+	if m.Rejected {
+		n += 2
+	}
+	if m.FirstVoteHeight != 0 {
+		n += 1 + sovGravity(uint64(m.FirstVoteHeight))
+	}
+	if m.SignerSetNonce != 0 {
+		n += 1 + sovGravity(uint64(m.SignerSetNonce))
+	}
+	l = len(m.VoteBitmap)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *LatestEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	if m.CosmosHeight != 0 {
+		n += 1 + sovGravity(uint64(m.CosmosHeight))
+	}
+	return n
+}
+
+func (m *EthereumSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovGravity(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerSetTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovGravity(uint64(m.Nonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.CheckpointVersion != 0 {
+		n += 1 + sovGravity(uint64(m.CheckpointVersion))
+	}
+	return n
+}
+
+func (m *BatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.BatchNonce))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if m.CheckpointVersion != 0 {
+		n += 1 + sovGravity(uint64(m.CheckpointVersion))
+	}
+	return n
+}
+
+func (m *SendToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGravity(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumRecipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Erc20Token.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.Erc20Fee.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	return n
+}
+
+func (m *ERC1155BatchTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.BatchNonce))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if m.CheckpointVersion != 0 {
+		n += 1 + sovGravity(uint64(m.CheckpointVersion))
+	}
+	return n
+}
+
+func (m *SendERC1155ToEthereum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGravity(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumRecipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ERC1155Amount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Id.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *ERC1155Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Id.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *ContractCallTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovGravity(uint64(m.InvalidationNonce))
+	}
+	l = len(m.InvalidationScope)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.CheckpointVersion != 0 {
+		n += 1 + sovGravity(uint64(m.CheckpointVersion))
+	}
+	return n
+}
+
+func (m *ERC20Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *IDSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *CommunityPoolEthereumSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *EVMChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovGravity(uint64(m.ChainId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Finality != 0 {
+		n += 1 + sovGravity(uint64(m.Finality))
+	}
+	if m.MinimumConfirmations != 0 {
+		n += 1 + sovGravity(uint64(m.MinimumConfirmations))
+	}
+	if len(m.FeeFloors) > 0 {
+		for _, e := range m.FeeFloors {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.DepositAddressFactory)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.TokenDecimals) > 0 {
+		for _, e := range m.TokenDecimals {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.NativeDecimals != 0 {
+		n += 1 + sovGravity(uint64(m.NativeDecimals))
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovGravity(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *RateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
+		n += 1 + sovGravity(uint64(m.WindowStart))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *IBCForwardChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Bech32Prefix)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGravity(uint64(m.Timeout))
+	}
+	l = len(m.CallbackChannelId)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.CallbackContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGravity(uint64(m.EventNonce))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGravity(uint64(m.Sequence))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *LogicCallTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	l = len(m.LogicContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenDecimals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.DenomDecimals != 0 {
+		n += 1 + sovGravity(uint64(m.DenomDecimals))
+	}
+	if m.Erc20Decimals != 0 {
+		n += 1 + sovGravity(uint64(m.Erc20Decimals))
+	}
+	return n
+}
+
+func (m *FeeFloor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.MinimumFee.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *AddEVMChainProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Chain.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *ContractMigrationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *ContractMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BridgeContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGravity(uint64(m.Epoch))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	return n
+}
+
+func (m *EVMChainPauseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *GravityIDRotationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.AcceptanceWindow != 0 {
+		n += 1 + sovGravity(uint64(m.AcceptanceWindow))
+	}
+	return n
+}
+
+func (m *GravityIDRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousGravityId)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *RelayerIncentiveProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.DisbursementPeriod != 0 {
+		n += 1 + sovGravity(uint64(m.DisbursementPeriod))
+	}
+	if m.Disbursements != 0 {
+		n += 1 + sovGravity(uint64(m.Disbursements))
+	}
+	return n
+}
+
+func (m *RelayerIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGravity(uint64(m.Id))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if len(m.Disbursed) > 0 {
+		for _, e := range m.Disbursed {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.DisbursementPeriod != 0 {
+		n += 1 + sovGravity(uint64(m.DisbursementPeriod))
+	}
+	if m.Disbursements != 0 {
+		n += 1 + sovGravity(uint64(m.Disbursements))
+	}
+	if m.DisbursementsMade != 0 {
+		n += 1 + sovGravity(uint64(m.DisbursementsMade))
+	}
+	if m.NextDisbursementHeight != 0 {
+		n += 1 + sovGravity(uint64(m.NextDisbursementHeight))
+	}
+	return n
+}
+
+func (m *EthereumEventRejectionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGravity(uint64(m.EventNonce))
+	}
+	l = len(m.EventHash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *IncidentRecoveryProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovGravity(uint64(m.Action))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *IncidentRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGravity(uint64(m.Id))
+	}
+	if m.Action != 0 {
+		n += 1 + sovGravity(uint64(m.Action))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BridgeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGravity(uint64(m.Id))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGravity(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EndHeight))
+	}
+	if m.SendsToEthereum != 0 {
+		n += 1 + sovGravity(uint64(m.SendsToEthereum))
+	}
+	if m.SendsToCosmos != 0 {
+		n += 1 + sovGravity(uint64(m.SendsToCosmos))
+	}
+	if m.BatchesExecuted != 0 {
+		n += 1 + sovGravity(uint64(m.BatchesExecuted))
+	}
+	if m.ContractCallsExecuted != 0 {
+		n += 1 + sovGravity(uint64(m.ContractCallsExecuted))
+	}
+	if len(m.FeesCollected) > 0 {
+		for _, e := range m.FeesCollected {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.ValidatorsSlashed != 0 {
+		n += 1 + sovGravity(uint64(m.ValidatorsSlashed))
+	}
+	if m.RejectedEventVotes != 0 {
+		n += 1 + sovGravity(uint64(m.RejectedEventVotes))
+	}
+	if m.VetoedOutgoingTxs != 0 {
+		n += 1 + sovGravity(uint64(m.VetoedOutgoingTxs))
+	}
+	if m.TimedOutOutgoingTxs != 0 {
+		n += 1 + sovGravity(uint64(m.TimedOutOutgoingTxs))
+	}
+	if m.IncidentRecords != 0 {
+		n += 1 + sovGravity(uint64(m.IncidentRecords))
+	}
+	return n
+}
+
+func (m *TransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGravity(uint64(m.EventNonce))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.BatchNonce))
+	}
+	return n
+}
+
+func (m *PoolAggregate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovGravity(uint64(m.Count))
+	}
+	l = m.TotalFees.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	return n
+}
+
+func (m *VoterSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Voter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovGravity(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *AttestationLatency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		l = 0
+		for _, e := range m.Samples {
+			l += sovGravity(uint64(e))
+		}
+		n += 1 + sovGravity(uint64(l)) + l
+	}
+	if m.P50 != 0 {
+		n += 1 + sovGravity(uint64(m.P50))
+	}
+	if m.P90 != 0 {
+		n += 1 + sovGravity(uint64(m.P90))
+	}
+	if m.P99 != 0 {
+		n += 1 + sovGravity(uint64(m.P99))
+	}
+	if m.Max != 0 {
+		n += 1 + sovGravity(uint64(m.Max))
+	}
+	return n
+}
+
+func (m *BridgeState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	if m.LastOutgoingBatchNonce != 0 {
+		n += 1 + sovGravity(uint64(m.LastOutgoingBatchNonce))
+	}
+	if m.LastSendToEthereumId != 0 {
+		n += 1 + sovGravity(uint64(m.LastSendToEthereumId))
+	}
+	if len(m.VoucherSupply) > 0 {
+		for _, e := range m.VoucherSupply {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EVMChainBridgeState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovGravity(uint64(m.ChainId))
+	}
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovGravity(uint64(m.LastObservedEventNonce))
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		n += 1 + sovGravity(uint64(m.LatestSignerSetTxNonce))
+	}
+	l = len(m.LastObservedCheckpoint)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovGravity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeStateHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGravity(uint64(m.Height))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *CommunityPoolEthereumSpendProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.BridgeFee)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *AddEVMChainProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = m.Chain.Size()
+	n += 1 + l + sovGravity(uint64(l))
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractMigrationProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EthereumHeight != 0 {
+		n += 1 + sovGravity(uint64(m.EthereumHeight))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *EVMChainPauseProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *GravityIDRotationProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.AcceptanceWindow != 0 {
+		n += 1 + sovGravity(uint64(m.AcceptanceWindow))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.DepositAddress)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayerIncentiveProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.DisbursementPeriod != 0 {
+		n += 1 + sovGravity(uint64(m.DisbursementPeriod))
+	}
+	if m.Disbursements != 0 {
+		n += 1 + sovGravity(uint64(m.Disbursements))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *EthereumEventRejectionProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.EvmChainId != 0 {
+		n += 1 + sovGravity(uint64(m.EvmChainId))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGravity(uint64(m.EventNonce))
+	}
+	l = len(m.EventHash)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func (m *IncidentRecoveryProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovGravity(uint64(m.Action))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGravity(uint64(l))
+	}
+	return n
+}
+
+func sovGravity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGravity(x uint64) (n int) {
+	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventVoteRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventVoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rejected = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstVoteHeight", wireType)
+			}
+			m.FirstVoteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstVoteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetNonce", wireType)
+			}
+			m.SignerSetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerSetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteBitmap = append(m.VoteBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteBitmap == nil {
+				m.VoteBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+			}
+			m.CosmosHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumSigner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumSigner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSetTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &EthereumSigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointVersion", wireType)
+			}
+			m.CheckpointVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &SendToEthereum{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointVersion", wireType)
+			}
+			m.CheckpointVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC1155BatchTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC1155BatchTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC1155BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &SendERC1155ToEthereum{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointVersion", wireType)
+			}
+			m.CheckpointVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendERC1155ToEthereum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendERC1155ToEthereum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendERC1155ToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, ERC1155Amount{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC1155Amount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC1155Amount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC1155Amount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC1155Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC1155Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC1155Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCallTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationScope == nil {
+				m.InvalidationScope = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, ERC20Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, ERC20Token{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointVersion", wireType)
+			}
+			m.CheckpointVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finality", wireType)
+			}
+			m.Finality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finality |= Finality(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumConfirmations", wireType)
+			}
+			m.MinimumConfirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinimumConfirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeFloors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeFloors = append(m.FeeFloors, FeeFloor{})
+			if err := m.FeeFloors[len(m.FeeFloors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddressFactory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAddressFactory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDecimals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenDecimals = append(m.TokenDecimals, TokenDecimals{})
+			if err := m.TokenDecimals[len(m.TokenDecimals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDecimals", wireType)
+			}
+			m.NativeDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NativeDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCForwardChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCForwardChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCForwardChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogicCallTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogicCallTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogicCallTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenDecimals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenDecimals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenDecimals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomDecimals", wireType)
+			}
+			m.DenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Decimals", wireType)
+			}
+			m.Erc20Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Erc20Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeFloor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeFloor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddEVMChainProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddEVMChainProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddEVMChainProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMigrationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMigrationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMigrationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMChainPauseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMChainPauseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMChainPauseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GravityIDRotationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GravityIDRotationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GravityIDRotationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceWindow", wireType)
+			}
+			m.AcceptanceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptanceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GravityIDRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GravityIDRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GravityIDRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousGravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousGravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerIncentiveProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerIncentiveProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisbursementPeriod", wireType)
+			}
+			m.DisbursementPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisbursementPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disbursements", wireType)
+			}
+			m.Disbursements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Disbursements |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disbursed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disbursed = append(m.Disbursed, types1.Coin{})
+			if err := m.Disbursed[len(m.Disbursed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisbursementPeriod", wireType)
+			}
+			m.DisbursementPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisbursementPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disbursements", wireType)
+			}
+			m.Disbursements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Disbursements |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisbursementsMade", wireType)
+			}
+			m.DisbursementsMade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisbursementsMade |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDisbursementHeight", wireType)
+			}
+			m.NextDisbursementHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDisbursementHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumEventRejectionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumEventRejectionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumEventRejectionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncidentRecoveryProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncidentRecoveryProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncidentRecoveryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= IncidentAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncidentRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncidentRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncidentRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= IncidentAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendsToEthereum", wireType)
+			}
+			m.SendsToEthereum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendsToEthereum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendsToCosmos", wireType)
+			}
+			m.SendsToCosmos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendsToCosmos |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchesExecuted", wireType)
+			}
+			m.BatchesExecuted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchesExecuted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallsExecuted", wireType)
+			}
+			m.ContractCallsExecuted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractCallsExecuted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesCollected = append(m.FeesCollected, types1.Coin{})
+			if err := m.FeesCollected[len(m.FeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSlashed", wireType)
+			}
+			m.ValidatorsSlashed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsSlashed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedEventVotes", wireType)
+			}
+			m.RejectedEventVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedEventVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoedOutgoingTxs", wireType)
+			}
+			m.VetoedOutgoingTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VetoedOutgoingTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOutOutgoingTxs", wireType)
+			}
+			m.TimedOutOutgoingTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimedOutOutgoingTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncidentRecords", wireType)
+			}
+			m.IncidentRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncidentRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
+			}
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			}
+			m.EthereumHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoterSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, &Voter{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Voter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Voter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Voter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationLatency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationLatency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationLatency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Samples = append(m.Samples, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGravity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGravity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGravity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Samples) == 0 {
+					m.Samples = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGravity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Samples = append(m.Samples, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
+			}
+			m.P50 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P50 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
+			}
+			m.P90 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P90 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
+			}
+			m.P99 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.P99 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, EVMChainBridgeState{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutgoingBatchNonce", wireType)
+			}
+			m.LastOutgoingBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOutgoingBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSendToEthereumId", wireType)
+			}
+			m.LastSendToEthereumId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSendToEthereumId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherSupply = append(m.VoucherSupply, types1.Coin{})
+			if err := m.VoucherSupply[len(m.VoucherSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMChainBridgeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMChainBridgeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMChainBridgeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetTxNonce", wireType)
+			}
+			m.LatestSignerSetTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedCheckpoint", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastObservedCheckpoint = append(m.LastObservedCheckpoint[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastObservedCheckpoint == nil {
+				m.LastObservedCheckpoint = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types1.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeStateHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeStateHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeStateHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	l = m.BridgeFee.Size()
-	n += 1 + l + sovGravity(uint64(l))
-	return n
-}
 
-func (m *CommunityPoolEthereumSpendProposalForCLI) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.BridgeFee)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
-	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovGravity(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGravity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGravity(x uint64) (n int) {
-	return sovGravity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
+func (m *AddEVMChainProposalForCLI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1666,17 +15844,17 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthereumEventVoteRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddEVMChainProposalForCLI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthereumEventVoteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddEVMChainProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1686,31 +15864,27 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &types.Any{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1738,13 +15912,13 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1754,12 +15928,57 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Accepted = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -1781,7 +16000,7 @@ func (m *EthereumEventVoteRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *ContractMigrationProposalForCLI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1804,17 +16023,17 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractMigrationProposalForCLI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractMigrationProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.EthereumHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1824,16 +16043,29 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.CosmosHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1843,66 +16075,80 @@ func (m *LatestEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGravity(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGravity
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.EvmChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EthereumSigner: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthereumSigner: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeight", wireType)
 			}
-			m.Power = 0
+			m.EthereumHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -1912,14 +16158,14 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				m.EthereumHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1947,7 +16193,7 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1970,7 +16216,7 @@ func (m *EthereumSigner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
+func (m *EVMChainPauseProposalForCLI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1993,17 +16239,17 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignerSetTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: EVMChainPauseProposalForCLI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignerSetTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EVMChainPauseProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2013,35 +16259,29 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2051,81 +16291,29 @@ func (m *SignerSetTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, &EthereumSigner{})
-			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGravity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGravity
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
 			}
-			m.BatchNonce = 0
+			m.EvmChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2135,35 +16323,16 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				m.EvmChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2173,29 +16342,15 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Transactions = append(m.Transactions, &SendToEthereum{})
-			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+			m.Paused = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2223,27 +16378,8 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGravity(dAtA[iNdEx:])
@@ -2265,7 +16401,7 @@ func (m *BatchTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
+func (m *GravityIDRotationProposalForCLI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2288,17 +16424,17 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendToEthereum: wiretype end group for non-group")
+			return fmt.Errorf("proto: GravityIDRotationProposalForCLI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendToEthereum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GravityIDRotationProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2308,14 +16444,27 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGravity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2343,13 +16492,13 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRecipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
 			}
-			var stringLen uint64
+			m.EvmChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2359,29 +16508,16 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EvmChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumRecipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2391,30 +16527,48 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceWindow", wireType)
+			}
+			m.AcceptanceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptanceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2424,24 +16578,23 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2464,7 +16617,7 @@ func (m *SendToEthereum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
+func (m *DepositAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2487,36 +16640,17 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCallTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCallTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
-			}
-			m.InvalidationNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2526,29 +16660,27 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationScope = append(m.InvalidationScope[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationScope == nil {
-				m.InvalidationScope = []byte{}
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2576,13 +16708,63 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.DepositAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGravity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGravity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerIncentiveProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGravity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerIncentiveProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerIncentiveProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2592,50 +16774,29 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGravity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2645,31 +16806,29 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, ERC20Token{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
 			}
-			var msglen int
+			m.EvmChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2679,31 +16838,16 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EvmChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fees = append(m.Fees, ERC20Token{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2713,66 +16857,29 @@ func (m *ContractCallTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGravity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ERC20Token) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGravity
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGravity
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisbursementPeriod", wireType)
 			}
-			var stringLen uint64
+			m.DisbursementPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -2782,27 +16889,33 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DisbursementPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disbursements", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Disbursements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Disbursements |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2830,9 +16943,7 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2855,133 +16966,7 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IDSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGravity
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IDSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGravity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGravity
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGravity
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGravity
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGravity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGravity
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
+func (m *EthereumEventRejectionProposalForCLI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,10 +16989,10 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthereumEventRejectionProposalForCLI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthereumEventRejectionProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3075,10 +17060,10 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
 			}
-			var stringLen uint64
+			m.EvmChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3088,29 +17073,35 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EvmChainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGravity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGravity
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3120,30 +17111,29 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGravity
@@ -3153,24 +17143,23 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGravity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGravity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3193,7 +17182,7 @@ func (m *CommunityPoolEthereumSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error {
+func (m *IncidentRecoveryProposalForCLI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3216,10 +17205,10 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposalForCLI: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncidentRecoveryProposalForCLI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolEthereumSpendProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncidentRecoveryProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3287,8 +17276,27 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGravity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= IncidentAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3316,9 +17324,9 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -3350,9 +17358,9 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 			}
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3380,9 +17388,9 @@ func (m *CommunityPoolEthereumSpendProposalForCLI) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeFee = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
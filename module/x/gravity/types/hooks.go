@@ -3,11 +3,11 @@ package types
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
 type GravityHooks interface {
-	AfterContractCallExecutedEvent(ctx sdk.Context, event ContractCallExecutedEvent)
-	AfterERC20DeployedEvent(ctx sdk.Context, event ERC20DeployedEvent)
-	AfterSignerSetExecutedEvent(ctx sdk.Context, event SignerSetTxExecutedEvent)
-	AfterBatchExecutedEvent(ctx sdk.Context, event BatchExecutedEvent)
-	AfterSendToCosmosEvent(ctx sdk.Context, event SendToCosmosEvent)
+	AfterContractCallExecutedEvent(ctx sdk.Context, chainID uint64, event ContractCallExecutedEvent)
+	AfterERC20DeployedEvent(ctx sdk.Context, chainID uint64, event ERC20DeployedEvent)
+	AfterSignerSetExecutedEvent(ctx sdk.Context, chainID uint64, event SignerSetTxExecutedEvent)
+	AfterBatchExecutedEvent(ctx sdk.Context, chainID uint64, event BatchExecutedEvent)
+	AfterSendToCosmosEvent(ctx sdk.Context, chainID uint64, event SendToCosmosEvent)
 }
 
 type MultiGravityHooks []GravityHooks
@@ -16,32 +16,32 @@ func NewMultiGravityHooks(hooks ...GravityHooks) MultiGravityHooks {
 	return hooks
 }
 
-func (mghs MultiGravityHooks) AfterContractCallExecutedEvent(ctx sdk.Context, event ContractCallExecutedEvent) {
+func (mghs MultiGravityHooks) AfterContractCallExecutedEvent(ctx sdk.Context, chainID uint64, event ContractCallExecutedEvent) {
 	for i := range mghs {
-		mghs[i].AfterContractCallExecutedEvent(ctx, event)
+		mghs[i].AfterContractCallExecutedEvent(ctx, chainID, event)
 	}
 }
 
-func (mghs MultiGravityHooks) AfterERC20DeployedEvent(ctx sdk.Context, event ERC20DeployedEvent) {
+func (mghs MultiGravityHooks) AfterERC20DeployedEvent(ctx sdk.Context, chainID uint64, event ERC20DeployedEvent) {
 	for i := range mghs {
-		mghs[i].AfterERC20DeployedEvent(ctx, event)
+		mghs[i].AfterERC20DeployedEvent(ctx, chainID, event)
 	}
 }
 
-func (mghs MultiGravityHooks) AfterSignerSetExecutedEvent(ctx sdk.Context, event SignerSetTxExecutedEvent) {
+func (mghs MultiGravityHooks) AfterSignerSetExecutedEvent(ctx sdk.Context, chainID uint64, event SignerSetTxExecutedEvent) {
 	for i := range mghs {
-		mghs[i].AfterSignerSetExecutedEvent(ctx, event)
+		mghs[i].AfterSignerSetExecutedEvent(ctx, chainID, event)
 	}
 }
 
-func (mghs MultiGravityHooks) AfterBatchExecutedEvent(ctx sdk.Context, event BatchExecutedEvent) {
+func (mghs MultiGravityHooks) AfterBatchExecutedEvent(ctx sdk.Context, chainID uint64, event BatchExecutedEvent) {
 	for i := range mghs {
-		mghs[i].AfterBatchExecutedEvent(ctx, event)
+		mghs[i].AfterBatchExecutedEvent(ctx, chainID, event)
 	}
 }
 
-func (mghs MultiGravityHooks) AfterSendToCosmosEvent(ctx sdk.Context, event SendToCosmosEvent) {
+func (mghs MultiGravityHooks) AfterSendToCosmosEvent(ctx sdk.Context, chainID uint64, event SendToCosmosEvent) {
 	for i := range mghs {
-		mghs[i].AfterSendToCosmosEvent(ctx, event)
+		mghs[i].AfterSendToCosmosEvent(ctx, chainID, event)
 	}
 }
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxIncidentReasonLength is the maximum length of the reason kept in an incident record
+const MaxIncidentReasonLength = 1024
+
+// IsBridgedVoucherDenom returns whether the denom is the one of the vouchers of an ERC20 or
+// ERC1155 token bridged from an EVM chain, the only coins manual mints and burns may adjust
+func IsBridgedVoucherDenom(denom string) bool {
+	if _, err := GravityDenomToERC20(denom); err == nil {
+		return true
+	}
+	if _, _, err := EVMChainGravityDenomToERC20(denom); err == nil {
+		return true
+	}
+	return IsERC1155Denom(denom)
+}
+
+// ValidateBasic performs stateless checks on the incident record
+func (r IncidentRecord) ValidateBasic() error {
+	if r.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "incident record id cannot be zero")
+	}
+	if r.Action != IncidentActionMint && r.Action != IncidentActionBurn {
+		return sdkerrors.Wrapf(ErrInvalid, "incident action %s", r.Action)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, r.Authority)
+	}
+	return validateVoucherAdjustment(r.Account, r.Amount, r.Reason)
+}
+
+// validateVoucherAdjustment checks the account, amount and reason of a manual mint or burn
+func validateVoucherAdjustment(account string, amount sdk.Coins, reason string) error {
+	if _, err := sdk.AccAddressFromBech32(account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, account)
+	}
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s", amount)
+	}
+	for _, coin := range amount {
+		if !IsBridgedVoucherDenom(coin.Denom) {
+			return sdkerrors.Wrapf(ErrInvalid, "%s is not a bridged voucher denom", coin.Denom)
+		}
+	}
+	if strings.TrimSpace(reason) == "" {
+		return sdkerrors.Wrap(ErrInvalid, "a reason is required")
+	}
+	if len(reason) > MaxIncidentReasonLength {
+		return sdkerrors.Wrapf(ErrInvalid, "reason longer than %d", MaxIncidentReasonLength)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/gogo/protobuf/proto"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
@@ -24,6 +25,7 @@ type EthereumEvent interface {
 
 	GetEventNonce() uint64
 	GetEthereumHeight() uint64
+	GetEthereumConfirmations() uint64
 	Hash() tmbytes.HexBytes
 	Validate() error
 }
@@ -35,6 +37,11 @@ type OutgoingTx interface {
 	// The only one that will be problematic is BatchTx which needs to pull all the constituent
 	// transactions before calculating the checkpoint
 	GetCheckpoint([]byte) []byte
+	// GetTypedData returns the EIP-712 typed data of the tx under the gravity id and EVM
+	// chain id, which signers may sign instead of its checkpoint
+	GetTypedData([]byte, uint64) apitypes.TypedData
 	GetStoreIndex() []byte
 	GetCosmosHeight() uint64
+	// GetCheckpointVersion returns the version of the encoding of the tx's checkpoint
+	GetCheckpointVersion() uint32
 }
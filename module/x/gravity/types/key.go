@@ -1,11 +1,14 @@
 package types
 
 import (
-	"bytes"
+	"crypto/sha256"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/keycodec"
 )
 
 const (
@@ -15,6 +18,10 @@ const (
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
 
+	// TStoreKey is the key of the transient store holding the bookkeeping of the block in
+	// progress, discarded at its commit
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the module name router key
 	RouterKey = ModuleName
 
@@ -30,6 +37,8 @@ const (
 	EthereumOrchestratorAddressKey
 
 	// Core types
+	// EthereumSignatureKey prefixed the signatures of the outgoing txs by validator, moved to
+	// EthereumSignaturesKey by the store migration to consensus version 8
 	EthereumSignatureKey
 	EthereumEventVoteRecordKey
 	OutgoingTxKey
@@ -62,39 +71,206 @@ const (
 
 	// EthereumHeightVoteKey indexes the latest heights observed by each validator
 	EthereumHeightVoteKey
+
+	// EVMChainKey indexes the EVM chains added by governance
+	EVMChainKey
+
+	// EVMChainStoreKey prefixes the state of each EVM chain, the signatures, event vote
+	// records, outgoing txs, send to ethereum pool, event nonces, observed heights and
+	// ERC20 mappings of a chain are stored under it using the keys of this file
+	EVMChainStoreKey
+
+	// DefaultEVMChainIDKey indexes the chain id of the default EVM chain, it is fixed
+	// when the params are first set so the bridge_chain_id param can't move its state
+	DefaultEVMChainIDKey
+
+	// BridgeContractKey indexes the Gravity contract a chain is bridged through once
+	// it has been migrated away from the one it was added with
+	BridgeContractKey
+
+	// ContractMigrationKey indexes the pending migration of a chain to a new contract
+	ContractMigrationKey
+
+	// EVMChainPausedKey is set while governance has paused bridging to a chain
+	EVMChainPausedKey
+
+	// GravityIDRotationKey indexes the rotation of a chain's gravity id while its
+	// previous id is still accepted
+	GravityIDRotationKey
+
+	// DepositAddressKey indexes the deposit addresses registered for recipients on a chain
+	DepositAddressKey
+
+	// RateLimitUsageKey indexes what has been transferred of each rate limited token to a
+	// chain in the current window
+	RateLimitUsageKey
+
+	// ERC1155TokenKey indexes the ERC1155 token ids by the denoms of their vouchers
+	ERC1155TokenKey
+
+	// SendERC1155ToEthereumKey prefixes the unbatched ERC1155 transfers to a chain
+	SendERC1155ToEthereumKey
+
+	// ForwardedDepositKey indexes the deposits forwarded over IBC by the channel and
+	// sequence of their transfers
+	ForwardedDepositKey
+
+	// ParamsKey holds the params of the module
+	ParamsKey
+
+	// RelayerIncentiveKey indexes the relayer incentives funded by governance by id
+	RelayerIncentiveKey
+
+	// LastRelayerIncentiveIDKey indexes the id of the last relayer incentive
+	LastRelayerIncentiveIDKey
+
+	// ContractVersionKey indexes the attested version of the Gravity contract of a chain
+	ContractVersionKey
+
+	// IncidentRecordKey indexes the incident log of manual voucher mints and burns by id
+	IncidentRecordKey
+
+	// LastIncidentRecordIDKey indexes the id of the last incident record
+	LastIncidentRecordIDKey
+
+	// ScheduledParamsUpdateKey holds the update of the params waiting for its height
+	ScheduledParamsUpdateKey
+
+	// BridgeReportKey indexes the bridge reports of closed periods by id
+	BridgeReportKey
+
+	// CurrentBridgeReportKey holds the bridge report of the period in progress
+	CurrentBridgeReportKey
+
+	// BridgeStateHashKey holds the hash of the bridge state at the end of the last block
+	BridgeStateHashKey
+
+	// BlockSummaryKey holds the counts of the bridge activity of the block in progress, in the
+	// transient store
+	BlockSummaryKey
+
+	// AttestationLatencyKey indexes the latencies of the last observed events of a chain
+	AttestationLatencyKey
+
+	// PoolAggregateKey indexes the aggregates of the unbatched transfers of each ERC20 token
+	// to a chain
+	PoolAggregateKey
+
+	// ERC1155PoolAggregateKey indexes the aggregates of the unbatched transfers of each
+	// ERC1155 token to a chain
+	ERC1155PoolAggregateKey
+
+	// VoterSetKey indexes the voter sets of the signer sets of a chain by nonce
+	VoterSetKey
+
+	// EthereumSignaturesKey indexes the signatures of each outgoing tx of a chain, one entry
+	// per signer
+	EthereumSignaturesKey
+
+	// VoucherIssuanceKey indexes the amount of each voucher denom issued by the bridge
+	VoucherIssuanceKey
+
+	// VoterPowerUpdateKey indexes the validators whose power may have changed since the
+	// powers of the voter sets were last updated
+	VoterPowerUpdateKey
 )
 
+// The keys below are built with keycodec: the Cosmos addresses, denoms and channel ids in them
+// are prefixed by their length since the store migration to consensus version 10, the other
+// components have a fixed length. The store indexes of the outgoing txs are kept as they were
+// as the orchestrators and relayers use them.
+
 ////////////////////
 // Key Delegation //
 ////////////////////
 
 // MakeOrchestratorValidatorAddressKey returns the following key format
-// [0x2][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+// prefix   len   orchestrator
+// [0x2][0x14][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
 func MakeOrchestratorValidatorAddressKey(orc sdk.AccAddress) []byte {
-	return append([]byte{OrchestratorValidatorAddressKey}, orc.Bytes()...)
+	return keycodec.Key(OrchestratorValidatorAddressKey, keycodec.LengthPrefixed(orc))
 }
 
 // MakeValidatorEthereumAddressKey returns the following key format
-// [0x1][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+// prefix   len   validator
+// [0x1][0x14][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
 func MakeValidatorEthereumAddressKey(validator sdk.ValAddress) []byte {
-	return append([]byte{ValidatorEthereumAddressKey}, validator.Bytes()...)
+	return keycodec.Key(ValidatorEthereumAddressKey, keycodec.LengthPrefixed(validator))
 }
 
 // MakeEthereumOrchestratorAddressKey returns the following key format
 // [0x3][0xc783df8a850f42e7F7e57013759C285caa701eB6]
 func MakeEthereumOrchestratorAddressKey(eth common.Address) []byte {
-	return append([]byte{EthereumOrchestratorAddressKey}, eth.Bytes()...)
+	return keycodec.Key(EthereumOrchestratorAddressKey, eth.Bytes())
+}
+
+////////////////
+// EVM Chains //
+////////////////
+
+// MakeEVMChainKey returns the following key format
+// prefix   chain-id
+// [0x15][0 0 0 0 0 0 0 1]
+func MakeEVMChainKey(chainID uint64) []byte {
+	return keycodec.Key(EVMChainKey, keycodec.Uint64(chainID))
+}
+
+// MakeEVMChainStorePrefix returns the prefix of the state of an EVM chain
+// prefix   chain-id
+// [0x16][0 0 0 0 0 0 0 1]
+func MakeEVMChainStorePrefix(chainID uint64) []byte {
+	return keycodec.Key(EVMChainStoreKey, keycodec.Uint64(chainID))
 }
 
 /////////////////////////
 // Ethereum Signatures //
 /////////////////////////
 
-// MakeEthereumSignatureKey returns the following key format
-// prefix   nonce                    validator-address
-// [0x4][0 0 0 0 0 0 0 1][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
-func MakeEthereumSignatureKey(storeIndex []byte, validator sdk.ValAddress) []byte {
-	return bytes.Join([][]byte{{EthereumSignatureKey}, storeIndex, validator.Bytes()}, []byte{})
+// The signatures of an outgoing tx are keyed under the hash of its store index, as those of
+// contract calls vary in length and could otherwise be the prefix of one another. The key of
+// the hash holds the nonce of the signer set whose voter set indexes the signatures, set at
+// the first signature, and the signatures follow under it, those of the voters by their index
+// in the voter set and those of the other validators by address.
+const (
+	voterEthereumSignatureKey byte = iota + 1
+	validatorEthereumSignatureKey
+)
+
+// MakeEthereumSignaturesKey returns the following key format, the prefix of the signatures
+// of the outgoing tx
+// prefix   sha256(store-index)
+// [0x30][32 bytes]
+func MakeEthereumSignaturesKey(storeIndex []byte) []byte {
+	hash := sha256.Sum256(storeIndex)
+	return keycodec.Key(EthereumSignaturesKey, hash[:])
+}
+
+// MakeVoterEthereumSignatureKey returns the following key format
+// prefix   sha256(store-index)   voter-index
+// [0x30][32 bytes][0x1][0 0 0 0 0 0 0 1]
+func MakeVoterEthereumSignatureKey(storeIndex []byte, voterIndex int) []byte {
+	return append(MakeEthereumSignaturesKey(storeIndex), keycodec.Key(voterEthereumSignatureKey, keycodec.Uint64(uint64(voterIndex)))...)
+}
+
+// MakeValidatorEthereumSignatureKey returns the following key format
+// prefix   sha256(store-index)   validator-address
+// [0x30][32 bytes][0x2][20][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeValidatorEthereumSignatureKey(storeIndex []byte, validator sdk.ValAddress) []byte {
+	return append(MakeEthereumSignaturesKey(storeIndex), keycodec.Key(validatorEthereumSignatureKey, keycodec.LengthPrefixed(validator))...)
+}
+
+// ParseEthereumSignatureKey returns the voter index or the validator of the key of a
+// signature without the prefix of its outgoing tx, the index being -1 for a validator
+func ParseEthereumSignatureKey(key []byte) (voterIndex int, validator sdk.ValAddress) {
+	reader := keycodec.NewReader(key[1:])
+	switch key[0] {
+	case voterEthereumSignatureKey:
+		return int(reader.Uint64()), nil
+	case validatorEthereumSignatureKey:
+		return -1, sdk.ValAddress(reader.LengthPrefixed())
+	default:
+		panic(fmt.Sprintf("invalid ethereum signature key %X", key))
+	}
 }
 
 /////////////////////////////////
@@ -105,7 +281,7 @@ func MakeEthereumSignatureKey(storeIndex []byte, validator sdk.ValAddress) []byt
 // prefix     nonce                             claim-details-hash
 // [0x5][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
 func MakeEthereumEventVoteRecordKey(eventNonce uint64, claimHash []byte) []byte {
-	return bytes.Join([][]byte{{EthereumEventVoteRecordKey}, sdk.Uint64ToBigEndian(eventNonce), claimHash}, []byte{})
+	return keycodec.Key(EthereumEventVoteRecordKey, keycodec.Uint64(eventNonce), claimHash)
 }
 
 //////////////////
@@ -114,7 +290,7 @@ func MakeEthereumEventVoteRecordKey(eventNonce uint64, claimHash []byte) []byte
 
 // MakeOutgoingTxKey returns the store index passed with a prefix
 func MakeOutgoingTxKey(storeIndex []byte) []byte {
-	return append([]byte{OutgoingTxKey}, storeIndex...)
+	return keycodec.Key(OutgoingTxKey, storeIndex)
 }
 
 //////////////////////
@@ -126,37 +302,141 @@ func MakeOutgoingTxKey(storeIndex []byte) []byte {
 // [0x7][0xc783df8a850f42e7F7e57013759C285caa701eB6][1000000000][0 0 0 0 0 0 0 1]
 func MakeSendToEthereumKey(id uint64, fee ERC20Token) []byte {
 	amount := make([]byte, 32)
-	return bytes.Join([][]byte{{SendToEthereumKey}, common.HexToAddress(fee.Contract).Bytes(), fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(id)}, []byte{})
+	return keycodec.Key(SendToEthereumKey, common.HexToAddress(fee.Contract).Bytes(), fee.Amount.BigInt().FillBytes(amount), keycodec.Uint64(id))
 }
 
 // MakeLastEventNonceByValidatorKey indexes lateset event nonce by validator
 // MakeLastEventNonceByValidatorKey returns the following key format
-// prefix              cosmos-validator
-// [0x8][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+// prefix   len   cosmos-validator
+// [0x8][0x14][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
 func MakeLastEventNonceByValidatorKey(validator sdk.ValAddress) []byte {
-	return append([]byte{LastEventNonceByValidatorKey}, validator.Bytes()...)
+	return keycodec.Key(LastEventNonceByValidatorKey, keycodec.LengthPrefixed(validator))
 }
 
+// MakeDenomToERC20Key returns the following key format
+// prefix   len   denom
+// [0x10][0x7][ucosmos]
 func MakeDenomToERC20Key(denom string) []byte {
-	return append([]byte{DenomToERC20Key}, []byte(denom)...)
+	return keycodec.Key(DenomToERC20Key, keycodec.String(denom))
 }
 
 func MakeERC20ToDenomKey(erc20 common.Address) []byte {
-	return append([]byte{ERC20ToDenomKey}, erc20.Bytes()...)
+	return keycodec.Key(ERC20ToDenomKey, erc20.Bytes())
 }
 
 func MakeSignerSetTxKey(nonce uint64) []byte {
-	return append([]byte{SignerSetTxPrefixByte}, sdk.Uint64ToBigEndian(nonce)...)
+	return keycodec.Key(SignerSetTxPrefixByte, keycodec.Uint64(nonce))
 }
 
 func MakeBatchTxKey(addr common.Address, nonce uint64) []byte {
-	return bytes.Join([][]byte{{BatchTxPrefixByte}, addr.Bytes(), sdk.Uint64ToBigEndian(nonce)}, []byte{})
+	return keycodec.Key(BatchTxPrefixByte, addr.Bytes(), keycodec.Uint64(nonce))
 }
 
+func MakeERC1155BatchTxKey(addr common.Address, nonce uint64) []byte {
+	return keycodec.Key(ERC1155BatchTxPrefixByte, addr.Bytes(), keycodec.Uint64(nonce))
+}
+
+// MakeContractCallTxKey returns the store index of a contract call tx, its invalidation scope
+// isn't length prefixed as the store index is used by the orchestrators and relayers, the keys
+// of the contract calls of a scope are only iterated together with those of other scopes
 func MakeContractCallTxKey(invalscope []byte, invalnonce uint64) []byte {
-	return bytes.Join([][]byte{{ContractCallTxPrefixByte}, invalscope, sdk.Uint64ToBigEndian(invalnonce)}, []byte{})
+	return keycodec.Key(ContractCallTxPrefixByte, invalscope, keycodec.Uint64(invalnonce))
 }
 
+// MakeEthereumHeightVoteKey returns the following key format
+// prefix   len   cosmos-validator
+// [0x14][0x14][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
 func MakeEthereumHeightVoteKey(validator sdk.ValAddress) []byte {
-	return append([]byte{EthereumHeightVoteKey}, validator.Bytes()...)
+	return keycodec.Key(EthereumHeightVoteKey, keycodec.LengthPrefixed(validator))
+}
+
+// MakeDepositAddressKey returns the following key format
+// prefix   len   recipient
+// [0x1c][0x14][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeDepositAddressKey(recipient sdk.AccAddress) []byte {
+	return keycodec.Key(DepositAddressKey, keycodec.LengthPrefixed(recipient))
+}
+
+// MakeRateLimitUsageKey returns the following key format
+// prefix   token contract
+// [0x1d][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeRateLimitUsageKey(tokenContract common.Address) []byte {
+	return keycodec.Key(RateLimitUsageKey, tokenContract.Bytes())
+}
+
+// MakeERC1155TokenKey returns the following key format
+// prefix   len   denom
+// [0x1e][0x4c][gravity1155/D8B98C56F17292B46C68F0EBC357185C19980BB89CFA9ED58FF5BA88551921FF]
+func MakeERC1155TokenKey(denom string) []byte {
+	return keycodec.Key(ERC1155TokenKey, keycodec.String(denom))
+}
+
+// MakePoolAggregateKey returns the following key format
+// prefix   eth-contract-address
+// [0x2d][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakePoolAggregateKey(contract common.Address) []byte {
+	return keycodec.Key(PoolAggregateKey, contract.Bytes())
+}
+
+// MakeERC1155PoolAggregateKey returns the following key format
+// prefix   eth-contract-address
+// [0x2e][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeERC1155PoolAggregateKey(contract common.Address) []byte {
+	return keycodec.Key(ERC1155PoolAggregateKey, contract.Bytes())
+}
+
+// MakeVoterSetKey returns the following key format
+// prefix   nonce
+// [0x2f][0 0 0 0 0 0 0 1]
+func MakeVoterSetKey(nonce uint64) []byte {
+	return keycodec.Key(VoterSetKey, keycodec.Uint64(nonce))
+}
+
+// MakeSendERC1155ToEthereumKey returns the following key format
+// prefix   eth-contract-address                        id
+// [0x1f][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func MakeSendERC1155ToEthereumKey(contract common.Address, id uint64) []byte {
+	return keycodec.Key(SendERC1155ToEthereumKey, contract.Bytes(), keycodec.Uint64(id))
+}
+
+// MakeForwardedDepositKey returns the following key format
+// prefix   len   channel      sequence
+// [0x20][0x9][channel-3][0 0 0 0 0 0 0 1]
+func MakeForwardedDepositKey(channelID string, sequence uint64) []byte {
+	return keycodec.Key(ForwardedDepositKey, keycodec.String(channelID), keycodec.Uint64(sequence))
+}
+
+// MakeRelayerIncentiveKey returns the following key format
+// prefix   id
+// [0x22][0 0 0 0 0 0 0 1]
+func MakeRelayerIncentiveKey(id uint64) []byte {
+	return keycodec.Key(RelayerIncentiveKey, keycodec.Uint64(id))
+}
+
+// MakeIncidentRecordKey returns the following key format
+// prefix   id
+// [0x25][0 0 0 0 0 0 0 1]
+func MakeIncidentRecordKey(id uint64) []byte {
+	return keycodec.Key(IncidentRecordKey, keycodec.Uint64(id))
+}
+
+// MakeBridgeReportKey returns the following key format
+// prefix   id
+// [0x28][0 0 0 0 0 0 0 1]
+func MakeBridgeReportKey(id uint64) []byte {
+	return keycodec.Key(BridgeReportKey, keycodec.Uint64(id))
+}
+
+// MakeVoucherIssuanceKey returns the following key format
+// prefix   len   denom
+// [0x31][0x32][gravity0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func MakeVoucherIssuanceKey(denom string) []byte {
+	return keycodec.Key(VoucherIssuanceKey, keycodec.String(denom))
+}
+
+// MakeVoterPowerUpdateKey returns the following key format
+// prefix   len   validator
+// [0x32][0x14][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeVoterPowerUpdateKey(validator sdk.ValAddress) []byte {
+	return keycodec.Key(VoterPowerUpdateKey, keycodec.LengthPrefixed(validator))
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,10 +17,22 @@ var (
 	_ sdk.Msg = &MsgSubmitEthereumEvent{}
 	_ sdk.Msg = &MsgSubmitEthereumTxConfirmation{}
 	_ sdk.Msg = &MsgEthereumHeightVote{}
+	_ sdk.Msg = &MsgRequestDepositAddress{}
+	_ sdk.Msg = &MsgSendERC1155ToEthereum{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgBridgeAdminPause{}
+	_ sdk.Msg = &MsgBridgeAdminSetRateLimits{}
+	_ sdk.Msg = &MsgBridgeAdminSetFeeFloors{}
+	_ sdk.Msg = &MsgMintVouchers{}
+	_ sdk.Msg = &MsgBurnVouchers{}
+	_ sdk.Msg = &MsgVetoBatchTx{}
+	_ sdk.Msg = &MsgVetoContractCallTx{}
+	_ sdk.Msg = &MsgInjectEthereumEvent{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvent{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmation{}
 	_ cdctypes.UnpackInterfacesMessage = &EthereumEventVoteRecord{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgInjectEthereumEvent{}
 )
 
 // NewMsgDelegateKeys returns a reference to a new MsgDelegateKeys.
@@ -46,7 +59,7 @@ func (msg MsgDelegateKeys) ValidateBasic() (err error) {
 	if _, err = sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.OrchestratorAddress)
 	}
-	if !common.IsHexAddress(msg.EthereumAddress) {
+	if ValidateEthereumAddress(msg.EthereumAddress) != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
 	}
 	if len(msg.EthSignature) == 0 {
@@ -186,8 +199,11 @@ func (msg MsgSendToEthereum) ValidateBasic() error {
 	if !msg.BridgeFee.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
 	}
-	if !common.IsHexAddress(msg.EthereumRecipient) {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
+	if new(big.Int).Add(msg.Amount.Amount.BigInt(), msg.BridgeFee.Amount.BigInt()).BitLen() > 256 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount and fee don't fit in a uint256")
+	}
+	if ValidateEthereumAddress(msg.EthereumRecipient) != nil {
+		return sdkerrors.Wrapf(ErrInvalidRecipient, "ethereum address %s", msg.EthereumRecipient)
 	}
 
 	return nil
@@ -289,3 +305,456 @@ func (msg MsgEthereumHeightVote) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{acc}
 }
+
+// NewMsgRequestDepositAddress returns a new MsgRequestDepositAddress
+func NewMsgRequestDepositAddress(recipient sdk.AccAddress) *MsgRequestDepositAddress {
+	return &MsgRequestDepositAddress{
+		Recipient: recipient.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRequestDepositAddress) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRequestDepositAddress) Type() string { return "request_deposit_address" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgRequestDepositAddress) ValidateBasic() error {
+	_, err := validateDepositRecipient(msg.Recipient)
+	return err
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRequestDepositAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRequestDepositAddress) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgSendERC1155ToEthereum returns a new MsgSendERC1155ToEthereum
+func NewMsgSendERC1155ToEthereum(sender sdk.AccAddress, counterpartAddress string, tokenContract string, amounts []ERC1155Amount) *MsgSendERC1155ToEthereum {
+	return &MsgSendERC1155ToEthereum{
+		Sender:            sender.String(),
+		EthereumRecipient: counterpartAddress,
+		TokenContract:     tokenContract,
+		Amounts:           amounts,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSendERC1155ToEthereum) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSendERC1155ToEthereum) Type() string { return "send_erc1155_to_eth" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSendERC1155ToEthereum) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if ValidateEthereumAddress(msg.EthereumRecipient) != nil {
+		return sdkerrors.Wrapf(ErrInvalidRecipient, "ethereum address %s", msg.EthereumRecipient)
+	}
+	if ValidateEthereumAddress(msg.TokenContract) != nil {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum contract address")
+	}
+	return validateERC1155Amounts(msg.Amounts)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSendERC1155ToEthereum) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSendERC1155ToEthereum) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgUpdateParams returns a new MsgUpdateParams
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params, effectiveHeight uint64) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority:       authority.String(),
+		Params:          params,
+		EffectiveHeight: effectiveHeight,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Authority)
+	}
+	return msg.Params.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgBridgeAdminPause returns a new MsgBridgeAdminPause
+func NewMsgBridgeAdminPause(admin sdk.AccAddress, chainID uint64, paused bool) *MsgBridgeAdminPause {
+	return &MsgBridgeAdminPause{
+		Admin:      admin.String(),
+		EvmChainId: chainID,
+		Paused:     paused,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgBridgeAdminPause) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBridgeAdminPause) Type() string { return "bridge_admin_pause" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgBridgeAdminPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Admin)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBridgeAdminPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBridgeAdminPause) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgBridgeAdminSetRateLimits returns a new MsgBridgeAdminSetRateLimits
+func NewMsgBridgeAdminSetRateLimits(admin sdk.AccAddress, chainID uint64, rateLimits []RateLimit) *MsgBridgeAdminSetRateLimits {
+	return &MsgBridgeAdminSetRateLimits{
+		Admin:      admin.String(),
+		EvmChainId: chainID,
+		RateLimits: rateLimits,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgBridgeAdminSetRateLimits) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBridgeAdminSetRateLimits) Type() string { return "bridge_admin_set_rate_limits" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgBridgeAdminSetRateLimits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Admin)
+	}
+	if err := validateRateLimits(msg.RateLimits); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBridgeAdminSetRateLimits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBridgeAdminSetRateLimits) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgBridgeAdminSetFeeFloors returns a new MsgBridgeAdminSetFeeFloors
+func NewMsgBridgeAdminSetFeeFloors(admin sdk.AccAddress, chainID uint64, feeFloors []FeeFloor) *MsgBridgeAdminSetFeeFloors {
+	return &MsgBridgeAdminSetFeeFloors{
+		Admin:      admin.String(),
+		EvmChainId: chainID,
+		FeeFloors:  feeFloors,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgBridgeAdminSetFeeFloors) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBridgeAdminSetFeeFloors) Type() string { return "bridge_admin_set_fee_floors" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgBridgeAdminSetFeeFloors) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Admin)
+	}
+	if err := validateFeeFloors(msg.FeeFloors); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBridgeAdminSetFeeFloors) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBridgeAdminSetFeeFloors) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgMintVouchers returns a new MsgMintVouchers
+func NewMsgMintVouchers(authority, recipient sdk.AccAddress, amount sdk.Coins, reason string) *MsgMintVouchers {
+	return &MsgMintVouchers{
+		Authority: authority.String(),
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Reason:    reason,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgMintVouchers) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMintVouchers) Type() string { return "mint_vouchers" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgMintVouchers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Authority)
+	}
+	return validateVoucherAdjustment(msg.Recipient, msg.Amount, msg.Reason)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMintVouchers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMintVouchers) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgBurnVouchers returns a new MsgBurnVouchers
+func NewMsgBurnVouchers(authority, holder sdk.AccAddress, amount sdk.Coins, reason string) *MsgBurnVouchers {
+	return &MsgBurnVouchers{
+		Authority: authority.String(),
+		Holder:    holder.String(),
+		Amount:    amount,
+		Reason:    reason,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgBurnVouchers) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBurnVouchers) Type() string { return "burn_vouchers" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgBurnVouchers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Authority)
+	}
+	return validateVoucherAdjustment(msg.Holder, msg.Amount, msg.Reason)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBurnVouchers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBurnVouchers) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgVetoBatchTx returns a new MsgVetoBatchTx
+func NewMsgVetoBatchTx(council sdk.AccAddress, chainID uint64, tokenContract common.Address, batchNonce uint64) *MsgVetoBatchTx {
+	return &MsgVetoBatchTx{
+		Council:       council.String(),
+		EvmChainId:    chainID,
+		TokenContract: tokenContract.Hex(),
+		BatchNonce:    batchNonce,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgVetoBatchTx) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgVetoBatchTx) Type() string { return "veto_batch_tx" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgVetoBatchTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Council); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Council)
+	}
+	if ValidateEthereumAddress(msg.TokenContract) != nil {
+		return sdkerrors.Wrap(ErrInvalid, "token contract address")
+	}
+	if msg.BatchNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "batch nonce cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgVetoBatchTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgVetoBatchTx) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Council)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgVetoContractCallTx returns a new MsgVetoContractCallTx
+func NewMsgVetoContractCallTx(council sdk.AccAddress, chainID uint64, invalidationScope []byte, invalidationNonce uint64) *MsgVetoContractCallTx {
+	return &MsgVetoContractCallTx{
+		Council:           council.String(),
+		EvmChainId:        chainID,
+		InvalidationScope: invalidationScope,
+		InvalidationNonce: invalidationNonce,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgVetoContractCallTx) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgVetoContractCallTx) Type() string { return "veto_contract_call_tx" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgVetoContractCallTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Council); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Council)
+	}
+	if len(msg.InvalidationScope) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "invalidation scope cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgVetoContractCallTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgVetoContractCallTx) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Council)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgInjectEthereumEvent returns a new MsgInjectEthereumEvent
+func NewMsgInjectEthereumEvent(signer sdk.AccAddress, chainID uint64, event EthereumEvent) (*MsgInjectEthereumEvent, error) {
+	any, err := PackEvent(event)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgInjectEthereumEvent{
+		Event:      any,
+		Signer:     signer.String(),
+		EvmChainId: chainID,
+	}, nil
+}
+
+// Route should return the name of the module
+func (msg MsgInjectEthereumEvent) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgInjectEthereumEvent) Type() string { return "inject_ethereum_event" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgInjectEthereumEvent) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+
+	event, err := UnpackEvent(msg.Event)
+	if err != nil {
+		return err
+	}
+	return event.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgInjectEthereumEvent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgInjectEthereumEvent) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+func (msg MsgInjectEthereumEvent) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var event EthereumEvent
+	return unpacker.UnpackAny(msg.Event, &event)
+}
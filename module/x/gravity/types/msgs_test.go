@@ -2,10 +2,17 @@ package types_test
 
 import (
 	"bytes"
+	"math/big"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/peggyjv/gravity-bridge/module/v3/app"
 	"github.com/peggyjv/gravity-bridge/module/v3/x/gravity/types"
@@ -78,3 +85,186 @@ func TestValidateMsgDelegateKeys(t *testing.T) {
 	}
 
 }
+
+func TestOrchestratorMsgAminoSignBytes(t *testing.T) {
+	event, err := types.PackEvent(&types.ContractCallExecutedEvent{
+		EventNonce:        4,
+		InvalidationScope: []byte{0xab, 0xcd},
+		InvalidationNonce: 5,
+		EthereumHeight:    13,
+	})
+	require.NoError(t, err)
+	deposit, err := types.PackEvent(&types.SendToCosmosEvent{
+		EventNonce:        1,
+		TokenContract:     "0x01",
+		Amount:            sdk.NewInt(100),
+		EthereumSender:    "0x02",
+		CosmosReceiver:    "cosmos1x",
+		EthereumHeight:    10,
+		ForwardIbcChannel: "channel-3",
+	})
+	require.NoError(t, err)
+	confirmation, err := types.PackConfirmation(&types.BatchTxConfirmation{
+		TokenContract:  "0x01",
+		BatchNonce:     2,
+		EthereumSigner: "0x02",
+		Signature:      []byte{1, 2, 3},
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		msg legacytx.LegacyMsg
+		exp string
+	}{
+		"ethereum event": {
+			msg: &types.MsgSubmitEthereumEvent{Event: event, Signer: "cosmos1s"},
+			exp: `{"type":"gravity-bridge/MsgSubmitEthereumEvent","value":{"event":{"type":"gravity-bridge/ContractCallExecutedEvent","value":{"ethereum_height":"13","event_nonce":"4","invalidation_nonce":"5","invalidation_scope":"ABCD"}},"signer":"cosmos1s"}}`,
+		},
+		"ibc forwarded deposit": {
+			msg: &types.MsgSubmitEthereumEvent{Event: deposit, Signer: "cosmos1s"},
+			exp: `{"type":"gravity-bridge/MsgSubmitEthereumEvent","value":{"event":{"type":"gravity-bridge/SendToCosmosEvent","value":{"amount":"100","cosmos_receiver":"cosmos1x","ethereum_height":"10","ethereum_sender":"0x02","event_nonce":"1","forward_ibc_channel":"channel-3","token_contract":"0x01"}},"signer":"cosmos1s"}}`,
+		},
+		"tx confirmation": {
+			msg: &types.MsgSubmitEthereumTxConfirmation{Confirmation: confirmation, Signer: "cosmos1s", EvmChainId: 5},
+			exp: `{"type":"gravity-bridge/MsgSubmitEthereumTxConfirmation","value":{"confirmation":{"type":"gravity-bridge/BatchTxConfirmation","value":{"batch_nonce":"2","ethereum_signer":"0x02","signature":"AQID","token_contract":"0x01"}},"evm_chain_id":"5","signer":"cosmos1s"}}`,
+		},
+		"height vote": {
+			msg: &types.MsgEthereumHeightVote{EthereumHeight: 100, Signer: "cosmos1s"},
+			exp: `{"type":"gravity-bridge/MsgEthereumHeightVote","value":{"ethereum_height":"100","signer":"cosmos1s"}}`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, spec.exp, string(spec.msg.GetSignBytes()))
+		})
+	}
+}
+
+func TestInterchainAccountMsgs(t *testing.T) {
+	encoding := app.MakeEncodingConfig()
+	for _, typeURL := range types.InterchainAccountMsgs() {
+		msg, err := encoding.InterfaceRegistry.Resolve(typeURL)
+		require.NoError(t, err, typeURL)
+		require.Implements(t, (*sdk.Msg)(nil), msg)
+	}
+
+	// the interchain accounts host allows them by default
+	var genesis icatypes.GenesisState
+	encoding.Marshaler.MustUnmarshalJSON(app.NewDefaultGenesisState()[icatypes.ModuleName], &genesis)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, types.InterchainAccountMsgs(), genesis.HostGenesisState.Params.AllowMessages)
+}
+
+// fuzzMsgs are the messages FuzzUnmarshalMsgs decodes crafted bytes into
+var fuzzMsgs = []func() legacytx.LegacyMsg{
+	func() legacytx.LegacyMsg { return &types.MsgSendToEthereum{} },
+	func() legacytx.LegacyMsg { return &types.MsgSubmitEthereumTxConfirmation{} },
+	func() legacytx.LegacyMsg { return &types.MsgSubmitEthereumEvent{} },
+}
+
+// fuzzSeedMsgs returns a valid message of each kind FuzzUnmarshalMsgs decodes, with every
+// confirmation and event, and some with malformed addresses, huge ints and invalid hex
+func fuzzSeedMsgs(t testing.TB) (kinds []uint8, msgs []codec.ProtoMarshaler) {
+	var (
+		signer   = "cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn"
+		contract = "0x2a24af0501a534fca004ee1bd667b783f205a546"
+		maxInt   = sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+	)
+	kinds = append(kinds, 0, 0, 0)
+	msgs = append(msgs,
+		types.NewMsgSendToEthereum(sdk.AccAddress(bytes.Repeat([]byte{1}, 20)), contract, sdk.NewInt64Coin("gravity"+contract, 10), sdk.NewInt64Coin("gravity"+contract, 1)),
+		&types.MsgSendToEthereum{Sender: signer, EthereumRecipient: "0xzz", Amount: sdk.NewCoin("stake", maxInt), BridgeFee: sdk.NewCoin("stake", maxInt)},
+		&types.MsgSendToEthereum{Sender: "cosmos1", EthereumRecipient: contract, Amount: sdk.NewInt64Coin("stake", 1)},
+	)
+
+	confirmations := []types.EthereumTxConfirmation{
+		&types.SignerSetTxConfirmation{SignerSetNonce: 1, EthereumSigner: contract, Signature: []byte{1}},
+		&types.BatchTxConfirmation{TokenContract: contract, BatchNonce: 1, EthereumSigner: contract, Signature: []byte{1}},
+		&types.ContractCallTxConfirmation{InvalidationScope: []byte{1}, InvalidationNonce: 1, EthereumSigner: contract, Signature: []byte{1}},
+		&types.ERC1155BatchTxConfirmation{TokenContract: "0xzz", BatchNonce: 1, EthereumSigner: contract},
+	}
+	for _, confirmation := range confirmations {
+		packed, err := types.PackConfirmation(confirmation)
+		require.NoError(t, err)
+		kinds = append(kinds, 1)
+		msgs = append(msgs, &types.MsgSubmitEthereumTxConfirmation{Confirmation: packed, Signer: signer})
+	}
+
+	events := []types.EthereumEvent{
+		&types.SendToCosmosEvent{EventNonce: 1, TokenContract: contract, Amount: maxInt, EthereumSender: contract, CosmosReceiver: signer, EthereumHeight: 1, ForwardIbcChannel: "channel-0"},
+		&types.SendToCosmosEvent{EventNonce: 1, TokenContract: contract, Amount: sdk.NewInt(-1), EthereumSender: "0x", CosmosReceiver: "cosmos1"},
+		&types.BatchExecutedEvent{TokenContract: contract, EventNonce: 1, BatchNonce: 1},
+		&types.ContractCallExecutedEvent{EventNonce: 1, InvalidationScope: []byte{1}, InvalidationNonce: 1},
+		&types.ERC20DeployedEvent{EventNonce: 1, CosmosDenom: "stake", TokenContract: contract, Erc20Name: "stake", Erc20Symbol: "stake", Erc20Decimals: 6},
+		&types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: 1, Members: types.EthereumSigners{{Power: 1, EthereumAddress: contract}}},
+		&types.SendERC1155ToCosmosEvent{EventNonce: 1, TokenContract: contract, Amounts: []types.ERC1155Amount{{Id: maxInt, Amount: maxInt}}, EthereumSender: contract, CosmosReceiver: signer},
+		&types.ERC1155BatchExecutedEvent{TokenContract: contract, EventNonce: 1, BatchNonce: 1},
+		&types.ContractVersionEvent{EventNonce: 1, Version: 2},
+	}
+	for _, event := range events {
+		packed, err := types.PackEvent(event)
+		require.NoError(t, err)
+		kinds = append(kinds, 2)
+		msgs = append(msgs, &types.MsgSubmitEthereumEvent{Event: packed, Signer: signer})
+	}
+	return kinds, msgs
+}
+
+// FuzzUnmarshalMsgs checks that the stateless validation of the messages a crafted tx
+// decodes to doesn't panic, and that the messages it accepts have signers and sign bytes
+func FuzzUnmarshalMsgs(f *testing.F) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	kinds, msgs := fuzzSeedMsgs(f)
+	for i, msg := range msgs {
+		f.Add(kinds[i], cdc.MustMarshal(msg))
+	}
+	f.Fuzz(func(t *testing.T, kind uint8, bz []byte) {
+		msg := fuzzMsgs[int(kind)%len(fuzzMsgs)]()
+		if err := cdc.Unmarshal(bz, msg.(codec.ProtoMarshaler)); err != nil {
+			return
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return
+		}
+		require.NotEmpty(t, msg.GetSigners())
+		require.NotEmpty(t, msg.GetSignBytes())
+	})
+}
+
+// FuzzMsgSendToEthereumValidateBasic checks that the sends to Ethereum ValidateBasic accepts
+// have a sender, an Ethereum recipient and a positive amount with a fee of the same denom
+func FuzzMsgSendToEthereumValidateBasic(f *testing.F) {
+	f.Add("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn", "0x2a24af0501a534fca004ee1bd667b783f205a546", "stake", "10", "stake", "1")
+	f.Add("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn", "0x2a24af0501a534fca004ee1bd667b783f205a54", "stake", "10", "stake", "1")
+	f.Add("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn", "2a24af0501a534fca004ee1bd667b783f205a546", "stake", "0", "stake", "-1")
+	f.Add("cosmos1", "0xzz24af0501a534fca004ee1bd667b783f205a546", "gravity0x2a24af0501a534fca004ee1bd667b783f205a546", "115792089237316195423570985008687907853269984665640564039457584007913129639935", "stake", "115792089237316195423570985008687907853269984665640564039457584007913129639935")
+	f.Fuzz(func(t *testing.T, sender, recipient, denom, amount, feeDenom, fee string) {
+		amountInt, ok := sdk.NewIntFromString(amount)
+		if !ok {
+			return
+		}
+		feeInt, ok := sdk.NewIntFromString(fee)
+		if !ok {
+			return
+		}
+		msg := &types.MsgSendToEthereum{
+			Sender:            sender,
+			EthereumRecipient: recipient,
+			Amount:            sdk.Coin{Denom: denom, Amount: amountInt},
+			BridgeFee:         sdk.Coin{Denom: feeDenom, Amount: feeInt},
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return
+		}
+		require.Len(t, msg.GetSigners(), 1)
+		require.True(t, gethcommon.IsHexAddress(recipient))
+		require.Equal(t, denom, feeDenom)
+		require.True(t, amountInt.IsPositive())
+		require.False(t, feeInt.IsNegative())
+		// the total the sender pays doesn't overflow
+		require.True(t, amountInt.Add(feeInt).IsPositive())
+	})
+}
@@ -1,19 +1,16 @@
 package types
 
 import (
-	"math/big"
-	"strings"
-
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/internal/calldata"
 )
 
 var (
 	_ OutgoingTx = &SignerSetTx{}
 	_ OutgoingTx = &BatchTx{}
 	_ OutgoingTx = &ContractCallTx{}
+	_ OutgoingTx = &ERC1155BatchTx{}
 )
 
 const (
@@ -21,16 +18,9 @@ const (
 	SignerSetTxPrefixByte
 	BatchTxPrefixByte
 	ContractCallTxPrefixByte
+	ERC1155BatchTxPrefixByte
 )
 
-type ABIEncodedValsetArgs struct {
-	Validators   []gethcommon.Address `abi:"validators"`
-	Powers       []*big.Int           `abi:"powers"`
-	Nonce        *big.Int             `abi:"valsetNonce"`
-	RewardAmount *big.Int             `abi:"rewardAmount"`
-	RewardToken  gethcommon.Address   `abi:"rewardToken"`
-}
-
 ///////////////////
 // GetStoreIndex //
 ///////////////////
@@ -48,6 +38,10 @@ func (cctx *ContractCallTx) GetStoreIndex() []byte {
 	return MakeContractCallTxKey(cctx.InvalidationScope, cctx.InvalidationNonce)
 }
 
+func (ebtx *ERC1155BatchTx) GetStoreIndex() []byte {
+	return MakeERC1155BatchTxKey(gethcommon.HexToAddress(ebtx.TokenContract), ebtx.BatchNonce)
+}
+
 ///////////////////
 // GetCheckpoint //
 ///////////////////
@@ -64,157 +58,60 @@ func (cctx *ContractCallTx) GetCosmosHeight() uint64 {
 	return cctx.Height
 }
 
-///////////////////
-// GetCheckpoint //
-///////////////////
-
-// GetCheckpoint returns the checkpoint
-func (u SignerSetTx) GetCheckpoint(gravityID []byte) []byte {
-
-	// the contract argument is not a arbitrary length array but a fixed length 32 byte
-	// array, therefore we have to utf8 encode the string (the default in this case) and
-	// then copy the variable length encoded data into a fixed length array. This function
-	// will panic if gravityId is too long to fit in 32 bytes
-	gravityIDFixed, err := byteArrayToFixByteArray(gravityID)
-	if err != nil {
-		panic(err)
-	}
-
-	checkpointBytes := []uint8("checkpoint")
-	var checkpoint [32]uint8
-	copy(checkpoint[:], checkpointBytes[:])
-
-	u.Signers.Sort()
+func (ebtx *ERC1155BatchTx) GetCosmosHeight() uint64 {
+	return ebtx.Height
+}
 
-	memberAddresses := make([]gethcommon.Address, len(u.Signers))
-	convertedPowers := make([]*big.Int, len(u.Signers))
-	for i, m := range u.Signers {
-		memberAddresses[i] = gethcommon.HexToAddress(m.EthereumAddress)
-		convertedPowers[i] = big.NewInt(int64(m.Power))
+// SendToEthereumIDs returns the ids of the transfers in the batch
+func (btx *BatchTx) SendToEthereumIDs() []uint64 {
+	ids := make([]uint64, len(btx.Transactions))
+	for i, tx := range btx.Transactions {
+		ids[i] = tx.Id
 	}
+	return ids
+}
 
-	// the word 'checkpoint' needs to be the same as the 'name' above in the checkpointAbiJson
-	// but other than that it's a constant that has no impact on the output. This is because
-	// it gets encoded as a function name which we must then discard.
-	args := []interface{}{
-		gravityIDFixed,
-		checkpoint,
-		big.NewInt(int64(u.Nonce)),
-		memberAddresses,
-		convertedPowers,
-		big.NewInt(0),
-		gethcommon.HexToAddress("0x0000000000000000000000000000000000000000"),
+// SendToEthereumIDs returns the ids of the transfers in the batch
+func (ebtx *ERC1155BatchTx) SendToEthereumIDs() []uint64 {
+	ids := make([]uint64, len(ebtx.Transactions))
+	for i, tx := range ebtx.Transactions {
+		ids[i] = tx.Id
 	}
-
-	return packCall(ValsetCheckpointABIJSON, "checkpoint", args)
+	return ids
 }
 
-// GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
-func (b BatchTx) GetCheckpoint(gravityID []byte) []byte {
+///////////////////
+// GetCheckpoint //
+///////////////////
+
+// The checkpoints of CheckpointVersion1 are those of the contract arguments of the txs as
+// the relayers submit them, the gravity id being utf8 encoded into the fixed 32 bytes the
+// contract takes. They panic if the gravity id is too long to fit.
 
-	// the contract argument is not a arbitrary length array but a fixed length 32 byte
-	// array, therefore we have to utf8 encode the string (the default in this case) and
-	// then copy the variable length encoded data into a fixed length array. This function
-	// will panic if gravityId is too long to fit in 32 bytes
+func mustGravityIDFixed(gravityID []byte) [32]byte {
 	gravityIDFixed, err := byteArrayToFixByteArray(gravityID)
 	if err != nil {
 		panic(err)
 	}
-
-	// Create the methodName argument which salts the signature
-	methodNameBytes := []uint8("transactionBatch")
-	var batchMethodName [32]uint8
-	copy(batchMethodName[:], methodNameBytes[:])
-
-	// Run through the elements of the batch and serialize them
-	txAmounts := make([]*big.Int, len(b.Transactions))
-	txDestinations := make([]gethcommon.Address, len(b.Transactions))
-	txFees := make([]*big.Int, len(b.Transactions))
-	for i, tx := range b.Transactions {
-		txAmounts[i] = tx.Erc20Token.Amount.BigInt()
-		txDestinations[i] = gethcommon.HexToAddress(tx.EthereumRecipient)
-		txFees[i] = tx.Erc20Fee.Amount.BigInt()
-	}
-
-	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
-	// but other than that it's a constant that has no impact on the output. This is because
-	// it gets encoded as a function name which we must then discard.
-	args := []interface{}{
-		gravityIDFixed,
-		batchMethodName,
-		txAmounts,
-		txDestinations,
-		txFees,
-		big.NewInt(int64(b.BatchNonce)),
-		gethcommon.HexToAddress(b.TokenContract),
-		big.NewInt(int64(b.Timeout)),
-	}
-
-	return packCall(OutgoingBatchTxCheckpointABIJSON, "submitBatch", args)
+	return gravityIDFixed
 }
 
-// GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
-func (c ContractCallTx) GetCheckpoint(gravityID []byte) []byte {
-	// Create the methodName argument which salts the signature
-	methodNameBytes := []uint8("logicCall")
-	var logicCallMethodName [32]uint8
-	copy(logicCallMethodName[:], methodNameBytes[:])
-
-	// the contract argument is not an arbitrary length array but a fixed length 32 byte
-	// array, therefore we have to utf8 encode the string (the default in this case) and
-	// then copy the variable length encoded data into a fixed length array. This function
-	// will panic if gravityId is too long to fit in 32 bytes
-	gravityIDFixed, err := byteArrayToFixByteArray(gravityID)
-	if err != nil {
-		panic(err)
-	}
+// checkpointV1 returns the checkpoint of the signer set in CheckpointVersion1
+func (u SignerSetTx) checkpointV1(gravityID []byte) []byte {
+	return calldata.ValsetCheckpoint(mustGravityIDFixed(gravityID), u.ABIEncodedValsetArgs())
+}
 
-	// Run through the elements of the logic call and serialize them
-	transferAmounts := make([]*big.Int, len(c.Tokens))
-	transferTokenContracts := make([]gethcommon.Address, len(c.Tokens))
-	feeAmounts := make([]*big.Int, len(c.Fees))
-	feeTokenContracts := make([]gethcommon.Address, len(c.Fees))
-	for i, coin := range c.Tokens {
-		transferAmounts[i] = coin.Amount.BigInt()
-		transferTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
-	}
-	for i, coin := range c.Fees {
-		feeAmounts[i] = coin.Amount.BigInt()
-		feeTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
-	}
-	payload := make([]byte, len(c.Payload))
-	copy(payload, c.Payload)
-	var invalidationId [32]byte
-	copy(invalidationId[:], c.InvalidationScope[:])
-
-	// the methodName needs to be the same as the 'name' above in the checkpointAbiJson
-	// but other than that it's a constant that has no impact on the output. This is because
-	// it gets encoded as a function name which we must then discard.
-	args := []interface{}{
-		gravityIDFixed,
-		logicCallMethodName,
-		transferAmounts,
-		transferTokenContracts,
-		feeAmounts,
-		feeTokenContracts,
-		gethcommon.HexToAddress(c.Address),
-		payload,
-		big.NewInt(int64(c.Timeout)),
-		invalidationId,
-		big.NewInt(int64(c.InvalidationNonce)),
-	}
+// checkpointV1 returns the checkpoint of the batch in CheckpointVersion1
+func (b BatchTx) checkpointV1(gravityID []byte) []byte {
+	return calldata.BatchCheckpoint(mustGravityIDFixed(gravityID), b.abiEncodedBatchArgs())
+}
 
-	return packCall(OutgoingLogicCallABIJSON, "checkpoint", args)
+// checkpointV1 returns the checkpoint of the logic call in CheckpointVersion1
+func (c ContractCallTx) checkpointV1(gravityID []byte) []byte {
+	return calldata.LogicCallCheckpoint(mustGravityIDFixed(gravityID), c.ABIEncodedLogicCallArgs())
 }
 
-func packCall(abiString, method string, args []interface{}) []byte {
-	encodedCall, err := abi.JSON(strings.NewReader(abiString))
-	if err != nil {
-		panic(sdkerrors.Wrap(err, "bad ABI definition in code"))
-	}
-	abiEncodedCall, err := encodedCall.Pack(method, args...)
-	if err != nil {
-		panic(sdkerrors.Wrap(err, "packing checkpoint"))
-	}
-	return crypto.Keccak256Hash(abiEncodedCall[4:]).Bytes()
+// checkpointV1 returns the checkpoint of the ERC1155 batch in CheckpointVersion1
+func (b ERC1155BatchTx) checkpointV1(gravityID []byte) []byte {
+	return calldata.ERC1155BatchCheckpoint(mustGravityIDFixed(gravityID), b.abiEncodedBatchArgs())
 }
@@ -0,0 +1,4002 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gravity/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ContractFeature is a feature whose checkpoints older Gravity contracts can't
+// verify
+type ContractFeature int32
+
+const (
+	ContractFeatureUnspecified ContractFeature = 0
+	// batches of ERC1155 transfers
+	ContractFeatureERC1155Batches ContractFeature = 1
+	// arbitrary logic calls
+	ContractFeatureContractCalls ContractFeature = 2
+)
+
+var ContractFeature_name = map[int32]string{
+	0: "CONTRACT_FEATURE_UNSPECIFIED",
+	1: "CONTRACT_FEATURE_ERC1155_BATCHES",
+	2: "CONTRACT_FEATURE_CONTRACT_CALLS",
+}
+
+var ContractFeature_value = map[string]int32{
+	"CONTRACT_FEATURE_UNSPECIFIED":     0,
+	"CONTRACT_FEATURE_ERC1155_BATCHES": 1,
+	"CONTRACT_FEATURE_CONTRACT_CALLS":  2,
+}
+
+func (x ContractFeature) String() string {
+	return proto.EnumName(ContractFeature_name, int32(x))
+}
+
+func (ContractFeature) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{0}
+}
+
+// SignatureSchemeType selects how the validators sign outgoing txs and how
+// their signatures are verified and submitted to the Gravity contracts
+type SignatureSchemeType int32
+
+const (
+	// a recoverable secp256k1 signature of each validator's Ethereum key,
+	// submitted one by one
+	SignatureSchemeECDSA SignatureSchemeType = 0
+)
+
+var SignatureSchemeType_name = map[int32]string{
+	0: "SIGNATURE_SCHEME_TYPE_ECDSA",
+}
+
+var SignatureSchemeType_value = map[string]int32{
+	"SIGNATURE_SCHEME_TYPE_ECDSA": 0,
+}
+
+func (x SignatureSchemeType) String() string {
+	return proto.EnumName(SignatureSchemeType_name, int32(x))
+}
+
+func (SignatureSchemeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{1}
+}
+
+// BridgeAdminPermission is an action the bridge admin may be permitted to take
+type BridgeAdminPermission int32
+
+const (
+	BridgeAdminPermissionUnspecified BridgeAdminPermission = 0
+	// pausing and unpausing EVM chains
+	BridgeAdminPermissionPause BridgeAdminPermission = 1
+	// replacing the rate limits of EVM chains
+	BridgeAdminPermissionRateLimits BridgeAdminPermission = 2
+	// replacing the fee floors of EVM chains
+	BridgeAdminPermissionFeeFloors BridgeAdminPermission = 3
+)
+
+var BridgeAdminPermission_name = map[int32]string{
+	0: "BRIDGE_ADMIN_PERMISSION_UNSPECIFIED",
+	1: "BRIDGE_ADMIN_PERMISSION_PAUSE",
+	2: "BRIDGE_ADMIN_PERMISSION_RATE_LIMITS",
+	3: "BRIDGE_ADMIN_PERMISSION_FEE_FLOORS",
+}
+
+var BridgeAdminPermission_value = map[string]int32{
+	"BRIDGE_ADMIN_PERMISSION_UNSPECIFIED": 0,
+	"BRIDGE_ADMIN_PERMISSION_PAUSE":       1,
+	"BRIDGE_ADMIN_PERMISSION_RATE_LIMITS": 2,
+	"BRIDGE_ADMIN_PERMISSION_FEE_FLOORS":  3,
+}
+
+func (x BridgeAdminPermission) String() string {
+	return proto.EnumName(BridgeAdminPermission_name, int32(x))
+}
+
+func (BridgeAdminPermission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{2}
+}
+
+// Params represent the Gravity genesis and store parameters
+// gravity_id:
+// a random 32 byte value to prevent signature reuse, for example if the
+// cosmos validators decided to use the same Ethereum keys for another chain
+// also running Gravity we would not want it to be possible to play a deposit
+// from chain A back on chain B's Gravity. This value IS USED ON ETHEREUM so
+// it must be set in your genesis.json before launch and not changed after
+// deploying Gravity
+//
+// contract_hash:
+// the code hash of a known good version of the Gravity contract
+// solidity code. This can be used to verify the correct version
+// of the contract has been deployed. This is a reference value for
+// goernance action only it is never read by any Gravity code
+//
+// bridge_ethereum_address:
+// is address of the bridge contract on the Ethereum side, this is a
+// reference value for governance only and is not actually used by any
+// Gravity code
+//
+// bridge_chain_id:
+// the unique identifier of the default Ethereum chain. The state of the
+// default chain is kept under this id and messages or queries with a zero
+// evm_chain_id refer to it, so like gravity_id it must not be changed once
+// the bridge is running. Additional EVM chains are added by governance and
+// described by their EVMChain entry rather than by these params
+//
+// These reference values may be used by future Gravity client implemetnations
+// to allow for saftey features or convenience features like the Gravity address
+// in your relayer. A relayer would require a configured Gravity address if
+// governance had not set the address on the chain it was relaying for.
+//
+// signed_signer_set_txs_window
+// signed_batches_window
+// signed_ethereum_signatures_window
+//
+// These values represent the time in blocks that a validator has to submit
+// a signature for a batch or valset, or to submit a ethereum_signature for a
+// particular attestation nonce. In the case of attestations this clock starts
+// when the attestation is created, but only allows for slashing once the event
+// has passed
+//
+// target_eth_tx_timeout:
+//
+// This is the 'target' value for when ethereum transactions time out, this is a
+// target because Ethereum is a probabilistic chain and you can't say for sure
+// what the block frequency is ahead of time.
+//
+// average_block_time
+// average_ethereum_block_time
+//
+// These values are the average Cosmos block time and Ethereum block time
+// respectively and they are used to compute what the target batch timeout is.
+// It is important that governance updates these in case of any major, prolonged
+// change in the time it takes to produce a block
+//
+// slash_fraction_signer_set_tx
+// slash_fraction_batch
+// slash_fraction_ethereum_signature
+// slash_fraction_conflicting_ethereum_signature
+//
+// The slashing fractions for the various gravity related slashing conditions.
+// The first three refer to not submitting a particular message, the third for
+// submitting a different ethereum_signature for the same Ethereum event
+type Params struct {
+	GravityId                string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	ContractSourceHash       string `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
+	BridgeEthereumAddress    string `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId            uint64 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	SignedSignerSetTxsWindow uint64 `protobuf:"varint,6,opt,name=signed_signer_set_txs_window,json=signedSignerSetTxsWindow,proto3" json:"signed_signer_set_txs_window,omitempty"`
+	SignedBatchesWindow      uint64 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	EthereumSignaturesWindow uint64 `protobuf:"varint,8,opt,name=ethereum_signatures_window,json=ethereumSignaturesWindow,proto3" json:"ethereum_signatures_window,omitempty"`
+	TargetEthTxTimeout       uint64 `protobuf:"varint,10,opt,name=target_eth_tx_timeout,json=targetEthTxTimeout,proto3" json:"target_eth_tx_timeout,omitempty"`
+	AverageBlockTime         uint64 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
+	AverageEthereumBlockTime uint64 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
+	// TODO: slash fraction for contract call txs too
+	SlashFractionSignerSetTx                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_signer_set_tx,json=slashFractionSignerSetTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_signer_set_tx"`
+	SlashFractionBatch                        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
+	SlashFractionEthereumSignature            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_ethereum_signature,json=slashFractionEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_ethereum_signature"`
+	SlashFractionConflictingEthereumSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_ethereum_signature,json=slashFractionConflictingEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_ethereum_signature"`
+	UnbondSlashingSignerSetTxsWindow          uint64                                 `protobuf:"varint,17,opt,name=unbond_slashing_signer_set_txs_window,json=unbondSlashingSignerSetTxsWindow,proto3" json:"unbond_slashing_signer_set_txs_window,omitempty"`
+	// the minimum number of blocks the last observed Ethereum height must be
+	// past an event's block before the event is accepted
+	MinimumEthereumConfirmations uint64 `protobuf:"varint,18,opt,name=minimum_ethereum_confirmations,json=minimumEthereumConfirmations,proto3" json:"minimum_ethereum_confirmations,omitempty"`
+	// when the default chain's events are final, with a tag based finality the
+	// minimum confirmations must be zero
+	EthereumFinality Finality `protobuf:"varint,19,opt,name=ethereum_finality,json=ethereumFinality,proto3,enum=gravity.v1.Finality" json:"ethereum_finality,omitempty"`
+	// the fee floors of the default chain
+	EthereumFeeFloors []FeeFloor `protobuf:"bytes,20,rep,name=ethereum_fee_floors,json=ethereumFeeFloors,proto3" json:"ethereum_fee_floors"`
+	// the deposit address factory of the default chain
+	EthereumDepositAddressFactory string `protobuf:"bytes,21,opt,name=ethereum_deposit_address_factory,json=ethereumDepositAddressFactory,proto3" json:"ethereum_deposit_address_factory,omitempty"`
+	// the token decimals and native token decimals of the default chain
+	EthereumTokenDecimals  []TokenDecimals `protobuf:"bytes,22,rep,name=ethereum_token_decimals,json=ethereumTokenDecimals,proto3" json:"ethereum_token_decimals"`
+	EthereumNativeDecimals uint32          `protobuf:"varint,23,opt,name=ethereum_native_decimals,json=ethereumNativeDecimals,proto3" json:"ethereum_native_decimals,omitempty"`
+	// the rate limits of the default chain
+	EthereumRateLimits []RateLimit `protobuf:"bytes,24,rep,name=ethereum_rate_limits,json=ethereumRateLimits,proto3" json:"ethereum_rate_limits"`
+	// the IBC channels deposits may be forwarded on
+	IbcForwardChannels []IBCForwardChannel `protobuf:"bytes,25,rep,name=ibc_forward_channels,json=ibcForwardChannels,proto3" json:"ibc_forward_channels"`
+	// the logic calls remote chains may make over IBC
+	LogicCallTemplates []LogicCallTemplate `protobuf:"bytes,26,rep,name=logic_call_templates,json=logicCallTemplates,proto3" json:"logic_call_templates"`
+	// the authority allowed to take time-sensitive actions on the bridge without
+	// waiting for a governance vote
+	BridgeAdmin BridgeAdmin `protobuf:"bytes,27,opt,name=bridge_admin,json=bridgeAdmin,proto3" json:"bridge_admin"`
+	// the contract versions features require, no outgoing txs of a feature are
+	// created for chains whose attested contract version is lower
+	MinimumContractVersions []MinimumContractVersion `protobuf:"bytes,28,rep,name=minimum_contract_versions,json=minimumContractVersions,proto3" json:"minimum_contract_versions"`
+	// the council allowed to veto outgoing batches and contract calls before
+	// their signatures are collected
+	VetoCouncil VetoCouncil `protobuf:"bytes,29,opt,name=veto_council,json=vetoCouncil,proto3" json:"veto_council"`
+	// the number of Cosmos blocks covered by each bridge report, zero disables
+	// the reports
+	BridgeReportPeriod uint64 `protobuf:"varint,30,opt,name=bridge_report_period,json=bridgeReportPeriod,proto3" json:"bridge_report_period,omitempty"`
+	// the caps on the unbatched transfers waiting in the pools of the EVM chains
+	PoolLimits PoolLimits `protobuf:"bytes,31,opt,name=pool_limits,json=poolLimits,proto3" json:"pool_limits"`
+	// reject the confirmations signing the checkpoints of outgoing txs on the
+	// chains whose attested contract version verifies EIP-712 typed data
+	// signatures, completing the migration of their orchestrators to typed data
+	TypedDataSignaturesOnly bool `protobuf:"varint,32,opt,name=typed_data_signatures_only,json=typedDataSignaturesOnly,proto3" json:"typed_data_signatures_only,omitempty"`
+	// the scheme the validators sign outgoing txs with, which must be the one
+	// the Gravity contracts verify
+	SignatureScheme SignatureSchemeType `protobuf:"varint,33,opt,name=signature_scheme,json=signatureScheme,proto3,enum=gravity.v1.SignatureSchemeType" json:"signature_scheme,omitempty"`
+	// the heights the checkpoint versions activate at, the outgoing txs created
+	// from an activation height on are encoded with its version
+	CheckpointVersionActivations []CheckpointVersionActivation `protobuf:"bytes,34,rep,name=checkpoint_version_activations,json=checkpointVersionActivations,proto3" json:"checkpoint_version_activations"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
+	}
+	return ""
+}
+
+func (m *Params) GetContractSourceHash() string {
+	if m != nil {
+		return m.ContractSourceHash
+	}
+	return ""
+}
+
+func (m *Params) GetBridgeEthereumAddress() string {
+	if m != nil {
+		return m.BridgeEthereumAddress
+	}
+	return ""
+}
+
+func (m *Params) GetBridgeChainId() uint64 {
+	if m != nil {
+		return m.BridgeChainId
+	}
+	return 0
+}
+
+func (m *Params) GetSignedSignerSetTxsWindow() uint64 {
+	if m != nil {
+		return m.SignedSignerSetTxsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSignedBatchesWindow() uint64 {
+	if m != nil {
+		return m.SignedBatchesWindow
+	}
+	return 0
+}
+
+func (m *Params) GetEthereumSignaturesWindow() uint64 {
+	if m != nil {
+		return m.EthereumSignaturesWindow
+	}
+	return 0
+}
+
+func (m *Params) GetTargetEthTxTimeout() uint64 {
+	if m != nil {
+		return m.TargetEthTxTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetAverageBlockTime() uint64 {
+	if m != nil {
+		return m.AverageBlockTime
+	}
+	return 0
+}
+
+func (m *Params) GetAverageEthereumBlockTime() uint64 {
+	if m != nil {
+		return m.AverageEthereumBlockTime
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondSlashingSignerSetTxsWindow() uint64 {
+	if m != nil {
+		return m.UnbondSlashingSignerSetTxsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMinimumEthereumConfirmations() uint64 {
+	if m != nil {
+		return m.MinimumEthereumConfirmations
+	}
+	return 0
+}
+
+func (m *Params) GetEthereumFinality() Finality {
+	if m != nil {
+		return m.EthereumFinality
+	}
+	return FinalityConfirmations
+}
+
+func (m *Params) GetEthereumFeeFloors() []FeeFloor {
+	if m != nil {
+		return m.EthereumFeeFloors
+	}
+	return nil
+}
+
+func (m *Params) GetEthereumDepositAddressFactory() string {
+	if m != nil {
+		return m.EthereumDepositAddressFactory
+	}
+	return ""
+}
+
+func (m *Params) GetEthereumTokenDecimals() []TokenDecimals {
+	if m != nil {
+		return m.EthereumTokenDecimals
+	}
+	return nil
+}
+
+func (m *Params) GetEthereumNativeDecimals() uint32 {
+	if m != nil {
+		return m.EthereumNativeDecimals
+	}
+	return 0
+}
+
+func (m *Params) GetEthereumRateLimits() []RateLimit {
+	if m != nil {
+		return m.EthereumRateLimits
+	}
+	return nil
+}
+
+func (m *Params) GetIbcForwardChannels() []IBCForwardChannel {
+	if m != nil {
+		return m.IbcForwardChannels
+	}
+	return nil
+}
+
+func (m *Params) GetLogicCallTemplates() []LogicCallTemplate {
+	if m != nil {
+		return m.LogicCallTemplates
+	}
+	return nil
+}
+
+func (m *Params) GetBridgeAdmin() BridgeAdmin {
+	if m != nil {
+		return m.BridgeAdmin
+	}
+	return BridgeAdmin{}
+}
+
+func (m *Params) GetMinimumContractVersions() []MinimumContractVersion {
+	if m != nil {
+		return m.MinimumContractVersions
+	}
+	return nil
+}
+
+func (m *Params) GetVetoCouncil() VetoCouncil {
+	if m != nil {
+		return m.VetoCouncil
+	}
+	return VetoCouncil{}
+}
+
+func (m *Params) GetBridgeReportPeriod() uint64 {
+	if m != nil {
+		return m.BridgeReportPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetPoolLimits() PoolLimits {
+	if m != nil {
+		return m.PoolLimits
+	}
+	return PoolLimits{}
+}
+
+func (m *Params) GetTypedDataSignaturesOnly() bool {
+	if m != nil {
+		return m.TypedDataSignaturesOnly
+	}
+	return false
+}
+
+func (m *Params) GetSignatureScheme() SignatureSchemeType {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return SignatureSchemeECDSA
+}
+
+func (m *Params) GetCheckpointVersionActivations() []CheckpointVersionActivation {
+	if m != nil {
+		return m.CheckpointVersionActivations
+	}
+	return nil
+}
+
+// CheckpointVersionActivation is the height from which the outgoing txs are
+// encoded with a checkpoint version, set by governance once the contracts and
+// orchestrators support the version
+type CheckpointVersionActivation struct {
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CheckpointVersionActivation) Reset()         { *m = CheckpointVersionActivation{} }
+func (m *CheckpointVersionActivation) String() string { return proto.CompactTextString(m) }
+func (*CheckpointVersionActivation) ProtoMessage()    {}
+func (*CheckpointVersionActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{1}
+}
+func (m *CheckpointVersionActivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointVersionActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointVersionActivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointVersionActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointVersionActivation.Merge(m, src)
+}
+func (m *CheckpointVersionActivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointVersionActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointVersionActivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointVersionActivation proto.InternalMessageInfo
+
+func (m *CheckpointVersionActivation) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CheckpointVersionActivation) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MinimumContractVersion is the lowest Gravity contract version able to verify
+// the checkpoints of a feature
+type MinimumContractVersion struct {
+	Feature ContractFeature `protobuf:"varint,1,opt,name=feature,proto3,enum=gravity.v1.ContractFeature" json:"feature,omitempty"`
+	Version uint64          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MinimumContractVersion) Reset()         { *m = MinimumContractVersion{} }
+func (m *MinimumContractVersion) String() string { return proto.CompactTextString(m) }
+func (*MinimumContractVersion) ProtoMessage()    {}
+func (*MinimumContractVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{2}
+}
+func (m *MinimumContractVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinimumContractVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinimumContractVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinimumContractVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinimumContractVersion.Merge(m, src)
+}
+func (m *MinimumContractVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinimumContractVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinimumContractVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinimumContractVersion proto.InternalMessageInfo
+
+func (m *MinimumContractVersion) GetFeature() ContractFeature {
+	if m != nil {
+		return m.Feature
+	}
+	return ContractFeatureUnspecified
+}
+
+func (m *MinimumContractVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// BridgeAdmin is an account, for example a DAO contract or a group account,
+// allowed to take the time-sensitive actions it is permitted on the bridge.
+// Structural changes to the bridge remain with governance, which also sets the
+// admin and its permissions. An empty address leaves the bridge without admin.
+type BridgeAdmin struct {
+	Address     string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Permissions []BridgeAdminPermission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=gravity.v1.BridgeAdminPermission" json:"permissions,omitempty"`
+}
+
+func (m *BridgeAdmin) Reset()         { *m = BridgeAdmin{} }
+func (m *BridgeAdmin) String() string { return proto.CompactTextString(m) }
+func (*BridgeAdmin) ProtoMessage()    {}
+func (*BridgeAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{3}
+}
+func (m *BridgeAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeAdmin.Merge(m, src)
+}
+func (m *BridgeAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeAdmin proto.InternalMessageInfo
+
+func (m *BridgeAdmin) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BridgeAdmin) GetPermissions() []BridgeAdminPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+// VetoCouncil is an account designated by governance, typically a group
+// account, allowed to veto outgoing batches and contract calls during the veto
+// delay following their creation. Their signatures are only collected once the
+// delay has passed, as a human firewall against anomalous large withdrawals. An
+// empty address leaves outgoing txs without delay.
+type VetoCouncil struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the number of Cosmos blocks outgoing batches and contract calls wait for
+	// signatures, less than the signed batches window
+	VetoDelay uint64 `protobuf:"varint,2,opt,name=veto_delay,json=vetoDelay,proto3" json:"veto_delay,omitempty"`
+}
+
+func (m *VetoCouncil) Reset()         { *m = VetoCouncil{} }
+func (m *VetoCouncil) String() string { return proto.CompactTextString(m) }
+func (*VetoCouncil) ProtoMessage()    {}
+func (*VetoCouncil) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{4}
+}
+func (m *VetoCouncil) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VetoCouncil) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VetoCouncil.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VetoCouncil) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VetoCouncil.Merge(m, src)
+}
+func (m *VetoCouncil) XXX_Size() int {
+	return m.Size()
+}
+func (m *VetoCouncil) XXX_DiscardUnknown() {
+	xxx_messageInfo_VetoCouncil.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VetoCouncil proto.InternalMessageInfo
+
+func (m *VetoCouncil) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VetoCouncil) GetVetoDelay() uint64 {
+	if m != nil {
+		return m.VetoDelay
+	}
+	return 0
+}
+
+// PoolLimits caps the unbatched transfers waiting in the pool of each EVM
+// chain, so that the pools can't grow past what batching keeps up with. A
+// transfer to a pool at one of its caps is rejected, or with above_median_fee
+// only accepted if its fee is above the median fee of the pool of its token,
+// crowding out the cheaper transfers waiting to be batched. Zero leaves a cap
+// unset.
+type PoolLimits struct {
+	// the maximum number of unbatched transfers to a chain, all tokens included
+	MaxEntries uint64 `protobuf:"varint,1,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// the maximum number of unbatched transfers of a token to a chain
+	MaxTokenEntries uint64 `protobuf:"varint,2,opt,name=max_token_entries,json=maxTokenEntries,proto3" json:"max_token_entries,omitempty"`
+	AboveMedianFee  bool   `protobuf:"varint,3,opt,name=above_median_fee,json=aboveMedianFee,proto3" json:"above_median_fee,omitempty"`
+}
+
+func (m *PoolLimits) Reset()         { *m = PoolLimits{} }
+func (m *PoolLimits) String() string { return proto.CompactTextString(m) }
+func (*PoolLimits) ProtoMessage()    {}
+func (*PoolLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{5}
+}
+func (m *PoolLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolLimits.Merge(m, src)
+}
+func (m *PoolLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolLimits proto.InternalMessageInfo
+
+func (m *PoolLimits) GetMaxEntries() uint64 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
+func (m *PoolLimits) GetMaxTokenEntries() uint64 {
+	if m != nil {
+		return m.MaxTokenEntries
+	}
+	return 0
+}
+
+func (m *PoolLimits) GetAboveMedianFee() bool {
+	if m != nil {
+		return m.AboveMedianFee
+	}
+	return false
+}
+
+// UpdateParamsProposal replaces the params of the module, it is the governance
+// route to MsgUpdateParams for as long as governance can't execute messages.
+type UpdateParamsProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// the Cosmos height the params take effect at, zero to replace them as soon
+	// as the proposal passes
+	EffectiveHeight uint64 `protobuf:"varint,4,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{6}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
+// ScheduledParamsUpdate is an update of the params waiting for the height it
+// takes effect at. There is at most one, scheduling another update replaces it
+// and updating the params right away cancels it.
+type ScheduledParamsUpdate struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ScheduledParamsUpdate) Reset()         { *m = ScheduledParamsUpdate{} }
+func (m *ScheduledParamsUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsUpdate) ProtoMessage()    {}
+func (*ScheduledParamsUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{7}
+}
+func (m *ScheduledParamsUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamsUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParamsUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsUpdate.Merge(m, src)
+}
+func (m *ScheduledParamsUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParamsUpdate proto.InternalMessageInfo
+
+func (m *ScheduledParamsUpdate) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *ScheduledParamsUpdate) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// This format of the update params proposal is specifically for the CLI to
+// allow simple text serialization.
+type UpdateParamsProposalForCLI struct {
+	Title           string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Params          Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params" yaml:"params"`
+	Deposit         string `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+	EffectiveHeight uint64 `protobuf:"varint,5,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty" yaml:"effective_height"`
+}
+
+func (m *UpdateParamsProposalForCLI) Reset()         { *m = UpdateParamsProposalForCLI{} }
+func (m *UpdateParamsProposalForCLI) String() string { return proto.CompactTextString(m) }
+func (*UpdateParamsProposalForCLI) ProtoMessage()    {}
+func (*UpdateParamsProposalForCLI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8772bac9489530eb, []int{8}
+}
+func (m *UpdateParamsProposalForCLI) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposalForCLI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposalForCLI.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposalForCLI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposalForCLI.Merge(m, src)
+}
+func (m *UpdateParamsProposalForCLI) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposalForCLI) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposalForCLI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposalForCLI proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("gravity.v1.ContractFeature", ContractFeature_name, ContractFeature_value)
+	proto.RegisterEnum("gravity.v1.SignatureSchemeType", SignatureSchemeType_name, SignatureSchemeType_value)
+	proto.RegisterEnum("gravity.v1.BridgeAdminPermission", BridgeAdminPermission_name, BridgeAdminPermission_value)
+	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*CheckpointVersionActivation)(nil), "gravity.v1.CheckpointVersionActivation")
+	proto.RegisterType((*MinimumContractVersion)(nil), "gravity.v1.MinimumContractVersion")
+	proto.RegisterType((*BridgeAdmin)(nil), "gravity.v1.BridgeAdmin")
+	proto.RegisterType((*VetoCouncil)(nil), "gravity.v1.VetoCouncil")
+	proto.RegisterType((*PoolLimits)(nil), "gravity.v1.PoolLimits")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "gravity.v1.UpdateParamsProposal")
+	proto.RegisterType((*ScheduledParamsUpdate)(nil), "gravity.v1.ScheduledParamsUpdate")
+	proto.RegisterType((*UpdateParamsProposalForCLI)(nil), "gravity.v1.UpdateParamsProposalForCLI")
+}
+
+func init() { proto.RegisterFile("gravity/v1/params.proto", fileDescriptor_8772bac9489530eb) }
+
+var fileDescriptor_8772bac9489530eb = []byte{
+	// 1838 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x17, 0x1d, 0xaf, 0x93, 0x8c, 0xfc, 0xa1, 0x4c, 0x64, 0x9b, 0x91, 0x6d, 0x89, 0xd1, 0xb6,
+	0xa9, 0x36, 0xd8, 0xd8, 0x6b, 0x2f, 0x52, 0x6c, 0xb3, 0xdd, 0x62, 0xf5, 0x41, 0x25, 0x5a, 0xf8,
+	0x43, 0xa0, 0xe4, 0x2c, 0xda, 0xcb, 0x74, 0x44, 0x8e, 0x24, 0x36, 0x24, 0x47, 0x20, 0x47, 0x8a,
+	0x75, 0x2b, 0xd0, 0xcb, 0xc2, 0xa7, 0x3d, 0xf6, 0x62, 0x20, 0x40, 0xff, 0x8a, 0x1e, 0x7b, 0xdb,
+	0xbd, 0xed, 0xb1, 0x28, 0x0a, 0xa3, 0x48, 0x2e, 0xed, 0xd5, 0x7f, 0x41, 0xc1, 0x99, 0x21, 0x45,
+	0xc9, 0xf2, 0x22, 0xc8, 0xc9, 0xe6, 0xfb, 0xfd, 0xde, 0xef, 0xbd, 0x79, 0x6f, 0xf8, 0x66, 0x28,
+	0xb0, 0xd9, 0xf3, 0xf1, 0xc8, 0x66, 0xe3, 0xbd, 0xd1, 0xfe, 0xde, 0x00, 0xfb, 0xd8, 0x0d, 0x76,
+	0x07, 0x3e, 0x65, 0x14, 0x02, 0x09, 0xec, 0x8e, 0xf6, 0x73, 0xd9, 0x1e, 0xed, 0x51, 0x6e, 0xde,
+	0x0b, 0xff, 0x13, 0x8c, 0x9c, 0x9a, 0x70, 0x8d, 0xc8, 0x1c, 0x29, 0xfe, 0x08, 0xc1, 0x52, 0x93,
+	0x8b, 0xc1, 0x1d, 0x10, 0x09, 0x21, 0xdb, 0x52, 0x15, 0x4d, 0x29, 0xdd, 0x35, 0xee, 0x4a, 0x4b,
+	0xc3, 0x82, 0x9f, 0x81, 0xac, 0x49, 0x3d, 0xe6, 0x63, 0x93, 0xa1, 0x80, 0x0e, 0x7d, 0x93, 0xa0,
+	0x3e, 0x0e, 0xfa, 0xea, 0x02, 0x27, 0xc2, 0x08, 0x6b, 0x71, 0xe8, 0x05, 0x0e, 0xfa, 0xf0, 0xd7,
+	0x60, 0xb3, 0xe3, 0xdb, 0x56, 0x8f, 0x20, 0xc2, 0xfa, 0xc4, 0x27, 0x43, 0x17, 0x61, 0xcb, 0xf2,
+	0x49, 0x10, 0xa8, 0x8b, 0xdc, 0x69, 0x5d, 0xc0, 0xba, 0x44, 0xcb, 0x02, 0x84, 0x8f, 0xc0, 0x9a,
+	0xf4, 0x33, 0xfb, 0xd8, 0xf6, 0xc2, 0x6c, 0x3e, 0xd2, 0x94, 0xd2, 0xa2, 0xb1, 0x22, 0xcc, 0xd5,
+	0xd0, 0xda, 0xb0, 0xe0, 0xef, 0xc0, 0x76, 0x60, 0xf7, 0x3c, 0x62, 0x21, 0xfe, 0xc7, 0x47, 0x01,
+	0x61, 0x88, 0x9d, 0x05, 0xe8, 0xb5, 0xed, 0x59, 0xf4, 0xb5, 0xba, 0xc4, 0x9d, 0x54, 0xc1, 0x69,
+	0x71, 0x4a, 0x8b, 0xb0, 0xf6, 0x59, 0xf0, 0x2d, 0xc7, 0xe1, 0x01, 0x58, 0x97, 0xfe, 0x1d, 0xcc,
+	0xcc, 0x3e, 0x89, 0x1d, 0x6f, 0x73, 0xc7, 0xfb, 0x02, 0xac, 0x08, 0x4c, 0xfa, 0xfc, 0x16, 0xe4,
+	0xe2, 0xc5, 0x84, 0x38, 0x66, 0x43, 0x7f, 0xe2, 0x78, 0x47, 0x44, 0x8c, 0x18, 0xad, 0x98, 0x20,
+	0xbd, 0xf7, 0xc1, 0x3a, 0xc3, 0x7e, 0x8f, 0xb0, 0xb0, 0x22, 0x88, 0x9d, 0x21, 0x66, 0xbb, 0x84,
+	0x0e, 0x99, 0x0a, 0xb8, 0x23, 0x14, 0xa0, 0xce, 0xfa, 0xed, 0xb3, 0xb6, 0x40, 0xe0, 0xa7, 0x00,
+	0xe2, 0x11, 0xf1, 0x71, 0x8f, 0xa0, 0x8e, 0x43, 0xcd, 0x57, 0xdc, 0x45, 0x4d, 0x73, 0x7e, 0x46,
+	0x22, 0x95, 0x10, 0x08, 0x1d, 0xe0, 0x57, 0x60, 0x2b, 0x62, 0xc7, 0x69, 0x26, 0xdc, 0x96, 0x45,
+	0x7e, 0x92, 0x12, 0xd5, 0x7d, 0xe2, 0xee, 0x81, 0xed, 0xc0, 0xc1, 0x41, 0x1f, 0x75, 0xc3, 0x56,
+	0xda, 0xd4, 0x9b, 0xae, 0xac, 0xba, 0xa2, 0x29, 0xa5, 0xe5, 0xca, 0xee, 0x0f, 0x97, 0x85, 0xd4,
+	0xbf, 0x2e, 0x0b, 0x8f, 0x7a, 0x36, 0xeb, 0x0f, 0x3b, 0xbb, 0x26, 0x75, 0xf7, 0x4c, 0x1a, 0xb8,
+	0x34, 0x90, 0x7f, 0x9e, 0x04, 0xd6, 0xab, 0x3d, 0x36, 0x1e, 0x90, 0x60, 0xb7, 0x46, 0x4c, 0x43,
+	0xe5, 0x9a, 0x75, 0x29, 0x99, 0x68, 0x04, 0xfc, 0x23, 0xc8, 0xce, 0xc4, 0xe3, 0x9d, 0x50, 0x57,
+	0x3f, 0x28, 0x0e, 0x9c, 0x8a, 0xc3, 0xfb, 0x06, 0xc7, 0xe0, 0xe1, 0x4c, 0x84, 0xeb, 0xed, 0x53,
+	0xd7, 0x3e, 0x28, 0x5c, 0x7e, 0x2a, 0x9c, 0x3e, 0xdb, 0x73, 0xf8, 0xbd, 0x02, 0x9e, 0xcc, 0xc4,
+	0x36, 0xa9, 0xd7, 0x75, 0x6c, 0x93, 0xd9, 0x5e, 0x6f, 0x5e, 0x1e, 0x99, 0x0f, 0xca, 0xe3, 0x93,
+	0xa9, 0x3c, 0xaa, 0x93, 0x10, 0xd7, 0x53, 0x3a, 0x01, 0xbf, 0x1c, 0x7a, 0x1d, 0xea, 0x59, 0x88,
+	0xfb, 0x84, 0x69, 0xcc, 0x7f, 0x75, 0xee, 0xf1, 0x8d, 0xa2, 0x09, 0x72, 0x4b, 0x72, 0xe7, 0xbc,
+	0x42, 0x35, 0x90, 0x77, 0x6d, 0xcf, 0x76, 0x87, 0xee, 0x64, 0x3d, 0xe1, 0x22, 0x6d, 0xdf, 0xc5,
+	0x61, 0x36, 0x81, 0x0a, 0xb9, 0xd2, 0xb6, 0x64, 0x45, 0x29, 0x55, 0x93, 0x1c, 0x58, 0x06, 0xf7,
+	0x62, 0xef, 0xae, 0xed, 0x61, 0xc7, 0x66, 0x63, 0xf5, 0xbe, 0xa6, 0x94, 0x56, 0x0f, 0xb2, 0xbb,
+	0x93, 0xe1, 0xb6, 0x5b, 0x97, 0x98, 0x91, 0x89, 0xe8, 0x91, 0x05, 0x7e, 0x03, 0xee, 0x4f, 0x24,
+	0x08, 0x41, 0x5d, 0x87, 0x52, 0x3f, 0x50, 0xb3, 0xda, 0xad, 0x52, 0x7a, 0x46, 0x84, 0x90, 0x7a,
+	0x08, 0x56, 0x16, 0xc3, 0x3a, 0x1b, 0x71, 0xe4, 0xc8, 0x1e, 0xc0, 0xe7, 0x40, 0x8b, 0xb5, 0x2c,
+	0x32, 0xa0, 0x81, 0xcd, 0xa2, 0xc1, 0x85, 0xba, 0xd8, 0x64, 0xd4, 0x1f, 0xab, 0xeb, 0x7c, 0x80,
+	0xed, 0x44, 0xbc, 0x9a, 0xa0, 0xc9, 0x09, 0x56, 0x17, 0x24, 0xf8, 0x2d, 0xd8, 0x8c, 0x85, 0x18,
+	0x7d, 0x45, 0x3c, 0x64, 0x11, 0xd3, 0x76, 0xb1, 0x13, 0xa8, 0x1b, 0x3c, 0xb1, 0x07, 0xc9, 0xc4,
+	0xda, 0x21, 0xa3, 0x26, 0x09, 0x32, 0xbb, 0xf5, 0xc8, 0x7f, 0x0a, 0x84, 0x5f, 0x80, 0x78, 0xc6,
+	0x20, 0x0f, 0x33, 0x7b, 0x44, 0x26, 0xca, 0x9b, 0x9a, 0x52, 0x5a, 0x31, 0x36, 0x22, 0xfc, 0x98,
+	0xc3, 0xb1, 0xe7, 0x11, 0xc8, 0xc6, 0x9e, 0x3e, 0x66, 0x04, 0x39, 0xb6, 0x6b, 0xb3, 0x40, 0x55,
+	0x79, 0x3e, 0xeb, 0xc9, 0x7c, 0x0c, 0xcc, 0xc8, 0x61, 0x88, 0xca, 0x5c, 0x60, 0xe4, 0x18, 0x03,
+	0x01, 0x3c, 0x05, 0x59, 0xbb, 0x63, 0xa2, 0x2e, 0xf5, 0x5f, 0x63, 0xdf, 0x0a, 0xe7, 0xb5, 0xe7,
+	0x11, 0x27, 0x50, 0x1f, 0x70, 0xb9, 0x9d, 0xa4, 0x5c, 0xa3, 0x52, 0xad, 0x0b, 0x5a, 0x55, 0xb0,
+	0x22, 0x59, 0xbb, 0x63, 0x4e, 0x03, 0x5c, 0xd6, 0xa1, 0x3d, 0xdb, 0x44, 0x26, 0x76, 0x1c, 0xc4,
+	0x88, 0x3b, 0x70, 0x30, 0x23, 0x81, 0x9a, 0xbb, 0x2e, 0x7b, 0x18, 0xf2, 0xaa, 0xd8, 0x71, 0xda,
+	0x92, 0x15, 0xc9, 0x3a, 0xb3, 0x40, 0x00, 0xbf, 0x06, 0xcb, 0xf2, 0x60, 0xc1, 0x96, 0x6b, 0x7b,
+	0xea, 0x96, 0xa6, 0x94, 0xd2, 0x07, 0x9b, 0x49, 0xb9, 0x0a, 0xc7, 0xcb, 0x21, 0x2c, 0x85, 0xd2,
+	0x9d, 0x89, 0x09, 0x5a, 0xe0, 0x41, 0xb4, 0xdf, 0xe3, 0xc3, 0x70, 0x44, 0xfc, 0x80, 0x6f, 0xf5,
+	0x6d, 0x9e, 0x5d, 0x31, 0x29, 0x77, 0x24, 0xc8, 0x55, 0xc9, 0x7d, 0x29, 0xa8, 0x52, 0x79, 0xd3,
+	0x9d, 0x8b, 0xf2, 0x3c, 0x47, 0x84, 0x51, 0x64, 0xd2, 0xa1, 0x67, 0xda, 0x8e, 0xba, 0x73, 0x3d,
+	0xcf, 0x97, 0x84, 0xd1, 0xaa, 0x80, 0xa3, 0x3c, 0x47, 0x13, 0x53, 0x78, 0x58, 0xcb, 0x95, 0xfa,
+	0x64, 0x40, 0x7d, 0x86, 0x06, 0xc4, 0xb7, 0xa9, 0xa5, 0xe6, 0xc5, 0x39, 0x23, 0x30, 0x83, 0x43,
+	0x4d, 0x8e, 0xc0, 0xaf, 0x40, 0x7a, 0x40, 0xa9, 0x13, 0xed, 0x87, 0x02, 0x0f, 0xb9, 0x91, 0x0c,
+	0xd9, 0xa4, 0xd4, 0x11, 0x6d, 0x97, 0x11, 0xc1, 0x20, 0xb6, 0xc0, 0x2f, 0x41, 0x2e, 0x9c, 0x48,
+	0x16, 0xb2, 0x30, 0xc3, 0xc9, 0x93, 0x91, 0x7a, 0xce, 0x58, 0xd5, 0x34, 0xa5, 0x74, 0xc7, 0xd8,
+	0xe4, 0x8c, 0x1a, 0x66, 0x78, 0x72, 0x30, 0x9e, 0x78, 0x4e, 0xf8, 0xf2, 0x66, 0x62, 0x0f, 0x14,
+	0x98, 0x7d, 0xe2, 0x12, 0xf5, 0x21, 0x7f, 0xfd, 0x0b, 0xc9, 0x04, 0x62, 0xaf, 0x16, 0xa7, 0xb4,
+	0xc7, 0x03, 0x62, 0xac, 0x05, 0xd3, 0x46, 0x18, 0x80, 0xbc, 0xd9, 0x27, 0xe6, 0xab, 0x01, 0xb5,
+	0xbd, 0xb8, 0x37, 0x28, 0x9c, 0x8d, 0x23, 0x39, 0x91, 0x8a, 0xbc, 0x4d, 0xbf, 0x4a, 0x2a, 0x57,
+	0x63, 0x0f, 0xd9, 0x83, 0x72, 0xcc, 0x97, 0x6b, 0xdd, 0x36, 0x6f, 0xa6, 0x04, 0xcf, 0x16, 0xff,
+	0xfc, 0x6f, 0x2d, 0x55, 0x3c, 0x01, 0x5b, 0x3f, 0x23, 0x04, 0x55, 0x70, 0x5b, 0xa6, 0xc3, 0x2f,
+	0x57, 0x2b, 0x46, 0xf4, 0x08, 0x37, 0xc0, 0x52, 0x9f, 0xd8, 0xbd, 0x3e, 0xe3, 0x97, 0xa9, 0x45,
+	0x43, 0x3e, 0x15, 0x6d, 0xb0, 0x31, 0x7f, 0x03, 0xc1, 0xa7, 0xe0, 0x76, 0x97, 0x88, 0x43, 0x43,
+	0xe1, 0x85, 0xda, 0x9a, 0x5a, 0x8e, 0x64, 0xd7, 0x05, 0xc5, 0x88, 0xb8, 0xc9, 0x14, 0x44, 0xa4,
+	0xe8, 0xb1, 0xe8, 0x80, 0x74, 0x62, 0xeb, 0x87, 0xc4, 0xe8, 0xaa, 0x26, 0x2e, 0x82, 0xd1, 0x23,
+	0xac, 0x82, 0xf4, 0x80, 0xf8, 0xae, 0x1d, 0x88, 0x3d, 0xbf, 0xa0, 0xdd, 0x2a, 0xad, 0x1e, 0x3c,
+	0xbc, 0xe1, 0x15, 0x6a, 0xc6, 0x4c, 0x23, 0xe9, 0x55, 0xac, 0x83, 0x74, 0x62, 0x03, 0xff, 0x4c,
+	0xb4, 0x1d, 0x00, 0xf8, 0x9b, 0x60, 0x11, 0x07, 0x8f, 0x65, 0xce, 0x77, 0x43, 0x4b, 0x2d, 0x34,
+	0x14, 0xff, 0xa2, 0x00, 0x30, 0xd9, 0x96, 0xb0, 0x00, 0xd2, 0x2e, 0x3e, 0x43, 0xc4, 0x63, 0xbe,
+	0x4d, 0x84, 0xd6, 0xa2, 0x01, 0x5c, 0x7c, 0xa6, 0x0b, 0x0b, 0x7c, 0x0c, 0xee, 0x85, 0x04, 0x31,
+	0x8b, 0x23, 0x9a, 0x50, 0x5d, 0x73, 0xf1, 0x19, 0x1f, 0xb2, 0x11, 0xb7, 0x04, 0x32, 0xb8, 0x43,
+	0x47, 0x04, 0xb9, 0xc4, 0xb2, 0xb1, 0x17, 0x9e, 0x2a, 0xea, 0x2d, 0xbe, 0x8f, 0x57, 0xb9, 0xfd,
+	0x88, 0x9b, 0xeb, 0x84, 0x14, 0xff, 0xae, 0x80, 0xec, 0xe9, 0xc0, 0xc2, 0x8c, 0x88, 0x9b, 0x74,
+	0xd3, 0xa7, 0x03, 0x1a, 0x60, 0x07, 0x66, 0xc1, 0x47, 0xcc, 0x66, 0x0e, 0x91, 0xab, 0x12, 0x0f,
+	0x50, 0x03, 0x69, 0x8b, 0x04, 0xa6, 0x6f, 0x0f, 0x58, 0xd4, 0x88, 0xbb, 0x46, 0xd2, 0x04, 0x3f,
+	0x03, 0x4b, 0xe2, 0x82, 0xcf, 0x03, 0xa6, 0x0f, 0xe0, 0xd4, 0x6b, 0xc8, 0x11, 0xb9, 0x2d, 0x25,
+	0x0f, 0x7e, 0x02, 0x32, 0xa4, 0xdb, 0x25, 0x26, 0x3f, 0x0a, 0xe4, 0x5e, 0x5a, 0x14, 0xeb, 0x8a,
+	0xed, 0x2f, 0xb8, 0xf9, 0xd9, 0xf2, 0x77, 0x6f, 0x0a, 0xa9, 0xbf, 0xbe, 0x29, 0xa4, 0xfe, 0xfb,
+	0xa6, 0x90, 0x2a, 0x62, 0xb0, 0x1e, 0xbe, 0x38, 0xd6, 0xd0, 0x21, 0x96, 0x50, 0x16, 0x2b, 0x49,
+	0xe4, 0xa0, 0xbc, 0x67, 0x0e, 0x37, 0xed, 0xe2, 0x7f, 0x2c, 0x80, 0xdc, 0xbc, 0xf2, 0xd4, 0xa9,
+	0x5f, 0x3d, 0x6c, 0xc0, 0x47, 0x53, 0x45, 0xaa, 0x64, 0xae, 0x2e, 0x0b, 0xcb, 0x63, 0xec, 0x3a,
+	0xcf, 0x8a, 0xdc, 0x5c, 0x8c, 0xca, 0xf6, 0xc5, 0x9c, 0xb2, 0x55, 0x36, 0xae, 0x2e, 0x0b, 0x50,
+	0xb0, 0x13, 0x60, 0x71, 0xba, 0x9c, 0xe5, 0xf7, 0x28, 0xe7, 0x7a, 0xb8, 0x94, 0xab, 0xcb, 0xc2,
+	0x8a, 0x10, 0x13, 0xfc, 0x62, 0xbc, 0xb6, 0x4f, 0xc1, 0x6d, 0x79, 0x13, 0x10, 0x9f, 0x2e, 0x15,
+	0x78, 0x75, 0x59, 0x58, 0x8d, 0x02, 0x73, 0xa0, 0x68, 0x44, 0x14, 0x58, 0x9f, 0xd3, 0x0d, 0xfe,
+	0x05, 0x53, 0xd9, 0xba, 0xba, 0x2c, 0x6c, 0x0a, 0xb7, 0x59, 0x46, 0xf1, 0x7a, 0xab, 0xee, 0xc8,
+	0x56, 0x29, 0x8f, 0xff, 0xa7, 0x80, 0xb5, 0x99, 0xb7, 0x1a, 0x7e, 0x0d, 0xb6, 0xab, 0x27, 0xc7,
+	0x6d, 0xa3, 0x5c, 0x6d, 0xa3, 0xba, 0x5e, 0x6e, 0x9f, 0x1a, 0x3a, 0x3a, 0x3d, 0x6e, 0x35, 0xf5,
+	0x6a, 0xa3, 0xde, 0xd0, 0x6b, 0x99, 0x54, 0x2e, 0x7f, 0x7e, 0xa1, 0xe5, 0x66, 0xdc, 0x4e, 0xbd,
+	0x60, 0x40, 0x4c, 0xbb, 0x6b, 0x13, 0x2b, 0xbc, 0xe8, 0x5c, 0x53, 0xd0, 0x8d, 0xea, 0xfe, 0xfe,
+	0xd3, 0xa7, 0xa8, 0x52, 0x6e, 0x57, 0x5f, 0xe8, 0xad, 0x8c, 0x92, 0x7b, 0x78, 0x7e, 0xa1, 0xed,
+	0xcc, 0xa8, 0x48, 0x96, 0xfc, 0x36, 0x82, 0x3a, 0x28, 0x5c, 0x13, 0x8a, 0x0d, 0xd5, 0xf2, 0xe1,
+	0x61, 0x2b, 0xb3, 0x90, 0xd3, 0xce, 0x2f, 0xb4, 0xed, 0x19, 0x9d, 0xe8, 0x31, 0x3c, 0xa7, 0x83,
+	0xdc, 0xe2, 0x77, 0x7f, 0xcb, 0xa7, 0x1e, 0xbf, 0x04, 0xf7, 0xe7, 0x4c, 0x7a, 0xf8, 0x1b, 0xb0,
+	0xd5, 0x6a, 0x3c, 0x3f, 0x16, 0xe2, 0xad, 0xea, 0x0b, 0xfd, 0x48, 0x47, 0xed, 0xdf, 0x37, 0x75,
+	0xa4, 0x57, 0x6b, 0xad, 0x72, 0x26, 0x95, 0x53, 0xcf, 0x2f, 0xb4, 0xec, 0x8c, 0x27, 0xc7, 0xa4,
+	0xee, 0x8f, 0x0b, 0x60, 0x7d, 0xee, 0x6c, 0x82, 0x47, 0xe0, 0xe3, 0x8a, 0xd1, 0xa8, 0x3d, 0xd7,
+	0x51, 0xb9, 0x76, 0xd4, 0x38, 0x46, 0x4d, 0xdd, 0x38, 0x6a, 0xb4, 0x5a, 0x8d, 0x93, 0xe3, 0x99,
+	0x82, 0xfe, 0xe2, 0xfc, 0x42, 0xd3, 0xe6, 0x6a, 0x24, 0xcb, 0x5a, 0x06, 0x3b, 0x37, 0xc9, 0x35,
+	0xcb, 0xa7, 0x2d, 0x3d, 0xa3, 0x88, 0xce, 0xcc, 0x15, 0x6a, 0xe2, 0x61, 0x40, 0xe0, 0xe1, 0xcd,
+	0x19, 0x19, 0xe5, 0xb6, 0x8e, 0x0e, 0x1b, 0x47, 0x8d, 0x76, 0x58, 0xd4, 0x8f, 0xcf, 0x2f, 0xb4,
+	0xc2, 0xfc, 0x89, 0x3b, 0xb9, 0xa5, 0x7d, 0x03, 0x8a, 0x37, 0xa9, 0xd5, 0x75, 0x1d, 0xd5, 0x0f,
+	0x4f, 0x4e, 0x8c, 0x56, 0xe6, 0x56, 0xae, 0x78, 0x7e, 0xa1, 0xe5, 0xe7, 0x8a, 0xc5, 0x97, 0x63,
+	0x51, 0xcb, 0xca, 0xe9, 0x0f, 0x6f, 0xf3, 0xca, 0x4f, 0x6f, 0xf3, 0xca, 0x7f, 0xde, 0xe6, 0x95,
+	0xef, 0xdf, 0xe5, 0x53, 0x3f, 0xbd, 0xcb, 0xa7, 0xfe, 0xf9, 0x2e, 0x9f, 0xfa, 0xc3, 0x97, 0x89,
+	0xaf, 0x96, 0x01, 0xe9, 0xf5, 0xc6, 0x7f, 0x1a, 0x45, 0x3f, 0x39, 0x3c, 0x11, 0xd7, 0x8e, 0x3d,
+	0x97, 0x86, 0xe3, 0x66, 0x6f, 0xf4, 0xf9, 0xde, 0x59, 0x04, 0x89, 0xcf, 0x99, 0xce, 0x12, 0xff,
+	0x51, 0xe2, 0xf3, 0xff, 0x0f, 0x00, 0xa2, 0x0c, 0xe4, 0x4e, 0xeb, 0x10, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CheckpointVersionActivations) > 0 {
+		for iNdEx := len(m.CheckpointVersionActivations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CheckpointVersionActivations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.SignatureScheme != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignatureScheme))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.TypedDataSignaturesOnly {
+		i--
+		if m.TypedDataSignaturesOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.PoolLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	if m.BridgeReportPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BridgeReportPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size, err := m.VetoCouncil.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if len(m.MinimumContractVersions) > 0 {
+		for iNdEx := len(m.MinimumContractVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumContractVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	{
+		size, err := m.BridgeAdmin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if len(m.LogicCallTemplates) > 0 {
+		for iNdEx := len(m.LogicCallTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.IbcForwardChannels) > 0 {
+		for iNdEx := len(m.IbcForwardChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcForwardChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.EthereumRateLimits) > 0 {
+		for iNdEx := len(m.EthereumRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.EthereumNativeDecimals != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EthereumNativeDecimals))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.EthereumTokenDecimals) > 0 {
+		for iNdEx := len(m.EthereumTokenDecimals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumTokenDecimals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.EthereumDepositAddressFactory) > 0 {
+		i -= len(m.EthereumDepositAddressFactory)
+		copy(dAtA[i:], m.EthereumDepositAddressFactory)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EthereumDepositAddressFactory)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.EthereumFeeFloors) > 0 {
+		for iNdEx := len(m.EthereumFeeFloors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumFeeFloors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.EthereumFinality != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EthereumFinality))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MinimumEthereumConfirmations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinimumEthereumConfirmations))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.UnbondSlashingSignerSetTxsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.SlashFractionConflictingEthereumSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionConflictingEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.SlashFractionEthereumSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionSignerSetTx.Size()
+		i -= size
+		if _, err := m.SlashFractionSignerSetTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AverageBlockTime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TargetEthTxTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetEthTxTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EthereumSignaturesWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EthereumSignaturesWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignedSignerSetTxsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedSignerSetTxsWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractSourceHash) > 0 {
+		i -= len(m.ContractSourceHash)
+		copy(dAtA[i:], m.ContractSourceHash)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ContractSourceHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointVersionActivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointVersionActivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointVersionActivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinimumContractVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinimumContractVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinimumContractVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Feature != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Feature))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA5 := make([]byte, len(m.Permissions)*10)
+		var j4 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintParams(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VetoCouncil) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VetoCouncil) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VetoCouncil) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VetoDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VetoDelay))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AboveMedianFee {
+		i--
+		if m.AboveMedianFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTokenEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTokenEntries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledParamsUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamsUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposalForCLI) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposalForCLI) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposalForCLI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ContractSourceHash)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovParams(uint64(m.BridgeChainId))
+	}
+	if m.SignedSignerSetTxsWindow != 0 {
+		n += 1 + sovParams(uint64(m.SignedSignerSetTxsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovParams(uint64(m.SignedBatchesWindow))
+	}
+	if m.EthereumSignaturesWindow != 0 {
+		n += 1 + sovParams(uint64(m.EthereumSignaturesWindow))
+	}
+	if m.TargetEthTxTimeout != 0 {
+		n += 1 + sovParams(uint64(m.TargetEthTxTimeout))
+	}
+	if m.AverageBlockTime != 0 {
+		n += 1 + sovParams(uint64(m.AverageBlockTime))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovParams(uint64(m.AverageEthereumBlockTime))
+	}
+	l = m.SlashFractionSignerSetTx.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionEthereumSignature.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionConflictingEthereumSignature.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.UnbondSlashingSignerSetTxsWindow != 0 {
+		n += 2 + sovParams(uint64(m.UnbondSlashingSignerSetTxsWindow))
+	}
+	if m.MinimumEthereumConfirmations != 0 {
+		n += 2 + sovParams(uint64(m.MinimumEthereumConfirmations))
+	}
+	if m.EthereumFinality != 0 {
+		n += 2 + sovParams(uint64(m.EthereumFinality))
+	}
+	if len(m.EthereumFeeFloors) > 0 {
+		for _, e := range m.EthereumFeeFloors {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.EthereumDepositAddressFactory)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if len(m.EthereumTokenDecimals) > 0 {
+		for _, e := range m.EthereumTokenDecimals {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.EthereumNativeDecimals != 0 {
+		n += 2 + sovParams(uint64(m.EthereumNativeDecimals))
+	}
+	if len(m.EthereumRateLimits) > 0 {
+		for _, e := range m.EthereumRateLimits {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.IbcForwardChannels) > 0 {
+		for _, e := range m.IbcForwardChannels {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.LogicCallTemplates) > 0 {
+		for _, e := range m.LogicCallTemplates {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.BridgeAdmin.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.MinimumContractVersions) > 0 {
+		for _, e := range m.MinimumContractVersions {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.VetoCouncil.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.BridgeReportPeriod != 0 {
+		n += 2 + sovParams(uint64(m.BridgeReportPeriod))
+	}
+	l = m.PoolLimits.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.TypedDataSignaturesOnly {
+		n += 3
+	}
+	if m.SignatureScheme != 0 {
+		n += 2 + sovParams(uint64(m.SignatureScheme))
+	}
+	if len(m.CheckpointVersionActivations) > 0 {
+		for _, e := range m.CheckpointVersionActivations {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointVersionActivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovParams(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MinimumContractVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Feature != 0 {
+		n += 1 + sovParams(uint64(m.Feature))
+	}
+	if m.Version != 0 {
+		n += 1 + sovParams(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *BridgeAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *VetoCouncil) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.VetoDelay != 0 {
+		n += 1 + sovParams(uint64(m.VetoDelay))
+	}
+	return n
+}
+
+func (m *PoolLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxEntries))
+	}
+	if m.MaxTokenEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxTokenEntries))
+	}
+	if m.AboveMedianFee {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovParams(uint64(m.EffectiveHeight))
+	}
+	return n
+}
+
+func (m *ScheduledParamsUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *UpdateParamsProposalForCLI) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovParams(uint64(m.EffectiveHeight))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+			}
+			m.SignedSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+			}
+			m.EthereumSignaturesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+			}
+			m.TargetEthTxTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+			}
+			m.UnbondSlashingSignerSetTxsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumEthereumConfirmations", wireType)
+			}
+			m.MinimumEthereumConfirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinimumEthereumConfirmations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumFinality", wireType)
+			}
+			m.EthereumFinality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumFinality |= Finality(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumFeeFloors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumFeeFloors = append(m.EthereumFeeFloors, FeeFloor{})
+			if err := m.EthereumFeeFloors[len(m.EthereumFeeFloors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumDepositAddressFactory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumDepositAddressFactory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumTokenDecimals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumTokenDecimals = append(m.EthereumTokenDecimals, TokenDecimals{})
+			if err := m.EthereumTokenDecimals[len(m.EthereumTokenDecimals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumNativeDecimals", wireType)
+			}
+			m.EthereumNativeDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumNativeDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumRateLimits = append(m.EthereumRateLimits, RateLimit{})
+			if err := m.EthereumRateLimits[len(m.EthereumRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcForwardChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcForwardChannels = append(m.IbcForwardChannels, IBCForwardChannel{})
+			if err := m.IbcForwardChannels[len(m.IbcForwardChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallTemplates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallTemplates = append(m.LogicCallTemplates, LogicCallTemplate{})
+			if err := m.LogicCallTemplates[len(m.LogicCallTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeAdmin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeAdmin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumContractVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumContractVersions = append(m.MinimumContractVersions, MinimumContractVersion{})
+			if err := m.MinimumContractVersions[len(m.MinimumContractVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCouncil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetoCouncil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeReportPeriod", wireType)
+			}
+			m.BridgeReportPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeReportPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypedDataSignaturesOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TypedDataSignaturesOnly = bool(v != 0)
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureScheme", wireType)
+			}
+			m.SignatureScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureScheme |= SignatureSchemeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointVersionActivations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointVersionActivations = append(m.CheckpointVersionActivations, CheckpointVersionActivation{})
+			if err := m.CheckpointVersionActivations[len(m.CheckpointVersionActivations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointVersionActivation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointVersionActivation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointVersionActivation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinimumContractVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinimumContractVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinimumContractVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feature", wireType)
+			}
+			m.Feature = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Feature |= ContractFeature(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v BridgeAdminPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= BridgeAdminPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]BridgeAdminPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v BridgeAdminPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= BridgeAdminPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VetoCouncil) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VetoCouncil: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VetoCouncil: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoDelay", wireType)
+			}
+			m.VetoDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VetoDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenEntries", wireType)
+			}
+			m.MaxTokenEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokenEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AboveMedianFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AboveMedianFee = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParamsUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParamsUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParamsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsProposalForCLI) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposalForCLI: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposalForCLI: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
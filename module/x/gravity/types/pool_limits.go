@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic checks that the token cap fits within the chain cap and that the fee
+// requirement applies to a cap
+func (l PoolLimits) ValidateBasic() error {
+	if l.MaxEntries != 0 && l.MaxTokenEntries > l.MaxEntries {
+		return sdkerrors.Wrapf(ErrInvalid, "max token entries %d exceed the max entries %d", l.MaxTokenEntries, l.MaxEntries)
+	}
+	if l.AboveMedianFee && l.MaxEntries == 0 && l.MaxTokenEntries == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "above median fee without a cap")
+	}
+	return nil
+}
+
+// IsCapped returns true if either cap is set
+func (l PoolLimits) IsCapped() bool {
+	return l.MaxEntries != 0 || l.MaxTokenEntries != 0
+}
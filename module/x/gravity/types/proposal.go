@@ -1,25 +1,77 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	"github.com/ethereum/go-ethereum/common"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 const (
 	// ProposalTypeCommunityPoolEthereumSpend defines the type for a CommunityPoolEthereumSpendProposal
 	ProposalTypeCommunityPoolEthereumSpend = "CommunityPoolEthereumSpend"
+
+	// ProposalTypeAddEVMChain defines the type for a AddEVMChainProposal
+	ProposalTypeAddEVMChain = "AddEVMChain"
+
+	// ProposalTypeContractMigration defines the type for a ContractMigrationProposal
+	ProposalTypeContractMigration = "ContractMigration"
+
+	// ProposalTypeEVMChainPause defines the type for a EVMChainPauseProposal
+	ProposalTypeEVMChainPause = "EVMChainPause"
+
+	// ProposalTypeGravityIDRotation defines the type for a GravityIDRotationProposal
+	ProposalTypeGravityIDRotation = "GravityIDRotation"
+
+	// ProposalTypeUpdateParams defines the type for a UpdateParamsProposal
+	ProposalTypeUpdateParams = "UpdateParams"
+
+	// ProposalTypeRelayerIncentive defines the type for a RelayerIncentiveProposal
+	ProposalTypeRelayerIncentive = "RelayerIncentive"
+
+	// ProposalTypeEthereumEventRejection defines the type for a EthereumEventRejectionProposal
+	ProposalTypeEthereumEventRejection = "EthereumEventRejection"
+
+	// ProposalTypeIncidentRecovery defines the type for a IncidentRecoveryProposal
+	ProposalTypeIncidentRecovery = "IncidentRecovery"
 )
 
-// Assert CommunityPoolEthereumSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
+	_ govtypes.Content = &AddEVMChainProposal{}
+	_ govtypes.Content = &ContractMigrationProposal{}
+	_ govtypes.Content = &EVMChainPauseProposal{}
+	_ govtypes.Content = &GravityIDRotationProposal{}
+	_ govtypes.Content = &UpdateParamsProposal{}
+	_ govtypes.Content = &RelayerIncentiveProposal{}
+	_ govtypes.Content = &EthereumEventRejectionProposal{}
+	_ govtypes.Content = &IncidentRecoveryProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolEthereumSpend)
 	govtypes.RegisterProposalTypeCodec(&CommunityPoolEthereumSpendProposal{}, "gravity/CommunityPoolEthereumSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddEVMChain)
+	govtypes.RegisterProposalTypeCodec(&AddEVMChainProposal{}, "gravity/AddEVMChainProposal")
+	govtypes.RegisterProposalType(ProposalTypeContractMigration)
+	govtypes.RegisterProposalTypeCodec(&ContractMigrationProposal{}, "gravity/ContractMigrationProposal")
+	govtypes.RegisterProposalType(ProposalTypeEVMChainPause)
+	govtypes.RegisterProposalTypeCodec(&EVMChainPauseProposal{}, "gravity/EVMChainPauseProposal")
+	govtypes.RegisterProposalType(ProposalTypeGravityIDRotation)
+	govtypes.RegisterProposalTypeCodec(&GravityIDRotationProposal{}, "gravity/GravityIDRotationProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "gravity/UpdateParamsProposal")
+	govtypes.RegisterProposalType(ProposalTypeRelayerIncentive)
+	govtypes.RegisterProposalTypeCodec(&RelayerIncentiveProposal{}, "gravity/RelayerIncentiveProposal")
+	govtypes.RegisterProposalType(ProposalTypeEthereumEventRejection)
+	govtypes.RegisterProposalTypeCodec(&EthereumEventRejectionProposal{}, "gravity/EthereumEventRejectionProposal")
+	govtypes.RegisterProposalType(ProposalTypeIncidentRecovery)
+	govtypes.RegisterProposalTypeCodec(&IncidentRecoveryProposal{}, "gravity/IncidentRecoveryProposal")
 }
 
 // NewCommunityPoolEthereumSpendProposal creates a new community pool spend proposal.
@@ -49,7 +101,7 @@ func (csp *CommunityPoolEthereumSpendProposal) ValidateBasic() error {
 		return err
 	}
 
-	if !common.IsHexAddress(csp.Recipient) {
+	if ValidateEthereumAddress(csp.Recipient) != nil {
 		return ErrInvalidEthereumProposalRecipient
 	}
 
@@ -80,3 +132,372 @@ func (csp CommunityPoolEthereumSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.BridgeFee))
 	return b.String()
 }
+
+// NewAddEVMChainProposal creates a new proposal to add an EVM chain.
+func NewAddEVMChainProposal(title, description string, chain EVMChain) *AddEVMChainProposal {
+	return &AddEVMChainProposal{title, description, chain}
+}
+
+// GetTitle returns the title of an add EVM chain proposal.
+func (p *AddEVMChainProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add EVM chain proposal.
+func (p *AddEVMChainProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add EVM chain proposal.
+func (p *AddEVMChainProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add EVM chain proposal.
+func (p *AddEVMChainProposal) ProposalType() string {
+	return ProposalTypeAddEVMChain
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddEVMChainProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Chain.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p AddEVMChainProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add EVM Chain Proposal:
+  Title:                   %s
+  Description:             %s
+  Chain ID:                %d
+  Name:                    %s
+  Gravity ID:              %s
+  Bridge Ethereum Address: %s
+`, p.Title, p.Description, p.Chain.ChainId, p.Chain.Name, p.Chain.GravityId, p.Chain.BridgeEthereumAddress))
+	return b.String()
+}
+
+// NewContractMigrationProposal creates a new proposal to migrate an EVM chain to a new
+// Gravity contract.
+func NewContractMigrationProposal(title, description string, chainID uint64, contract string, ethereumHeight uint64) *ContractMigrationProposal {
+	return &ContractMigrationProposal{title, description, chainID, contract, ethereumHeight}
+}
+
+// GetTitle returns the title of a contract migration proposal.
+func (p *ContractMigrationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a contract migration proposal.
+func (p *ContractMigrationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a contract migration proposal.
+func (p *ContractMigrationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a contract migration proposal.
+func (p *ContractMigrationProposal) ProposalType() string {
+	return ProposalTypeContractMigration
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ContractMigrationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.BridgeEthereumAddress == "" {
+		return sdkerrors.Wrap(ErrInvalid, "bridge contract address cannot be empty")
+	}
+	if err := validateBridgeContractAddress(p.BridgeEthereumAddress); err != nil {
+		return sdkerrors.Wrap(err, "bridge contract address")
+	}
+	if p.EthereumHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "ethereum height cannot be zero")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ContractMigrationProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Contract Migration Proposal:
+  Title:                   %s
+  Description:             %s
+  EVM Chain ID:            %d
+  Bridge Ethereum Address: %s
+  Ethereum Height:         %d
+`, p.Title, p.Description, p.EvmChainId, p.BridgeEthereumAddress, p.EthereumHeight))
+	return b.String()
+}
+
+// NewEVMChainPauseProposal creates a new proposal to pause or resume an EVM chain.
+func NewEVMChainPauseProposal(title, description string, chainID uint64, paused bool) *EVMChainPauseProposal {
+	return &EVMChainPauseProposal{title, description, chainID, paused}
+}
+
+// GetTitle returns the title of an EVM chain pause proposal.
+func (p *EVMChainPauseProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an EVM chain pause proposal.
+func (p *EVMChainPauseProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an EVM chain pause proposal.
+func (p *EVMChainPauseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an EVM chain pause proposal.
+func (p *EVMChainPauseProposal) ProposalType() string {
+	return ProposalTypeEVMChainPause
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *EVMChainPauseProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface.
+func (p EVMChainPauseProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`EVM Chain Pause Proposal:
+  Title:        %s
+  Description:  %s
+  EVM Chain ID: %d
+  Paused:       %t
+`, p.Title, p.Description, p.EvmChainId, p.Paused))
+	return b.String()
+}
+
+// NewGravityIDRotationProposal creates a new proposal to rotate the gravity id of an
+// EVM chain, the previous id stays accepted for the acceptance window in blocks.
+func NewGravityIDRotationProposal(title, description string, chainID uint64, gravityID string, acceptanceWindow uint64) *GravityIDRotationProposal {
+	return &GravityIDRotationProposal{title, description, chainID, gravityID, acceptanceWindow}
+}
+
+// GetTitle returns the title of a gravity id rotation proposal.
+func (p *GravityIDRotationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a gravity id rotation proposal.
+func (p *GravityIDRotationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a gravity id rotation proposal.
+func (p *GravityIDRotationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a gravity id rotation proposal.
+func (p *GravityIDRotationProposal) ProposalType() string {
+	return ProposalTypeGravityIDRotation
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *GravityIDRotationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.GravityId == "" {
+		return sdkerrors.Wrap(ErrInvalid, "gravity id cannot be empty")
+	}
+	if err := validateGravityID(p.GravityId); err != nil {
+		return sdkerrors.Wrap(err, "gravity id")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p GravityIDRotationProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Gravity ID Rotation Proposal:
+  Title:             %s
+  Description:       %s
+  EVM Chain ID:      %d
+  Gravity ID:        %s
+  Acceptance Window: %d
+`, p.Title, p.Description, p.EvmChainId, p.GravityId, p.AcceptanceWindow))
+	return b.String()
+}
+
+// NewUpdateParamsProposal creates a new proposal to replace the params of the module at the
+// effective height, or as soon as it passes if the height is zero.
+func NewUpdateParamsProposal(title, description string, params Params, effectiveHeight uint64) *UpdateParamsProposal {
+	return &UpdateParamsProposal{title, description, params, effectiveHeight}
+}
+
+// GetTitle returns the title of an update params proposal.
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update params proposal.
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update params proposal.
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update params proposal.
+func (p *UpdateParamsProposal) ProposalType() string {
+	return ProposalTypeUpdateParams
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Params.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p UpdateParamsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Params Proposal:
+  Title:            %s
+  Description:      %s
+  Params:           %s
+  Effective Height: %d
+`, p.Title, p.Description, p.Params.String(), p.EffectiveHeight))
+	return b.String()
+}
+
+// NewRelayerIncentiveProposal creates a new proposal to fund relayer incentives on an EVM
+// chain out of the community pool, the amount being disbursed in the given number of parts
+// every disbursement period in blocks.
+func NewRelayerIncentiveProposal(title, description string, chainID uint64, amount sdk.Coins, disbursementPeriod, disbursements uint64) *RelayerIncentiveProposal {
+	return &RelayerIncentiveProposal{title, description, chainID, amount, disbursementPeriod, disbursements}
+}
+
+// GetTitle returns the title of a relayer incentive proposal.
+func (p *RelayerIncentiveProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a relayer incentive proposal.
+func (p *RelayerIncentiveProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a relayer incentive proposal.
+func (p *RelayerIncentiveProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a relayer incentive proposal.
+func (p *RelayerIncentiveProposal) ProposalType() string {
+	return ProposalTypeRelayerIncentive
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RelayerIncentiveProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return validateRelayerIncentiveSchedule(p.Amount, p.DisbursementPeriod, p.Disbursements)
+}
+
+// String implements the Stringer interface.
+func (p RelayerIncentiveProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Relayer Incentive Proposal:
+  Title:               %s
+  Description:         %s
+  EVM Chain ID:        %d
+  Amount:              %s
+  Disbursement Period: %d
+  Disbursements:       %d
+`, p.Title, p.Description, p.EvmChainId, p.Amount, p.DisbursementPeriod, p.Disbursements))
+	return b.String()
+}
+
+// NewEthereumEventRejectionProposal creates a new proposal to reject the vote record of the
+// event with the hex encoded hash at the next event nonce of an EVM chain.
+func NewEthereumEventRejectionProposal(title, description string, chainID uint64, eventNonce uint64, eventHash string) *EthereumEventRejectionProposal {
+	return &EthereumEventRejectionProposal{title, description, chainID, eventNonce, eventHash}
+}
+
+// GetTitle returns the title of an Ethereum event rejection proposal.
+func (p *EthereumEventRejectionProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an Ethereum event rejection proposal.
+func (p *EthereumEventRejectionProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an Ethereum event rejection proposal.
+func (p *EthereumEventRejectionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an Ethereum event rejection proposal.
+func (p *EthereumEventRejectionProposal) ProposalType() string {
+	return ProposalTypeEthereumEventRejection
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *EthereumEventRejectionProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "event nonce cannot be zero")
+	}
+	if _, err := p.GetEventHash(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetEventHash decodes the hash of the event whose vote record is rejected
+func (p *EthereumEventRejectionProposal) GetEventHash() (tmbytes.HexBytes, error) {
+	hash, err := hex.DecodeString(strings.TrimPrefix(p.EventHash, "0x"))
+	if err != nil || len(hash) == 0 {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "event hash %s", p.EventHash)
+	}
+	return hash, nil
+}
+
+// String implements the Stringer interface.
+func (p EthereumEventRejectionProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Ethereum Event Rejection Proposal:
+  Title:        %s
+  Description:  %s
+  EVM Chain ID: %d
+  Event Nonce:  %d
+  Event Hash:   %s
+`, p.Title, p.Description, p.EvmChainId, p.EventNonce, p.EventHash))
+	return b.String()
+}
+
+// NewIncidentRecoveryProposal creates a new proposal to mint bridged vouchers to an account or
+// burn them from it, the reason being kept in the incident log.
+func NewIncidentRecoveryProposal(title, description string, action IncidentAction, account string, amount sdk.Coins, reason string) *IncidentRecoveryProposal {
+	return &IncidentRecoveryProposal{title, description, action, account, amount, reason}
+}
+
+// GetTitle returns the title of an incident recovery proposal.
+func (p *IncidentRecoveryProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an incident recovery proposal.
+func (p *IncidentRecoveryProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an incident recovery proposal.
+func (p *IncidentRecoveryProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an incident recovery proposal.
+func (p *IncidentRecoveryProposal) ProposalType() string {
+	return ProposalTypeIncidentRecovery
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *IncidentRecoveryProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.Action != IncidentActionMint && p.Action != IncidentActionBurn {
+		return sdkerrors.Wrapf(ErrInvalid, "incident action %s", p.Action)
+	}
+
+	return validateVoucherAdjustment(p.Account, p.Amount, p.Reason)
+}
+
+// String implements the Stringer interface.
+func (p IncidentRecoveryProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Incident Recovery Proposal:
+  Title:       %s
+  Description: %s
+  Action:      %s
+  Account:     %s
+  Amount:      %s
+  Reason:      %s
+`, p.Title, p.Description, p.Action, p.Account, p.Amount, p.Reason))
+	return b.String()
+}
@@ -0,0 +1,10 @@
+package types
+
+const (
+	// MaxPageLimit is the largest page the paginated queries return, larger limits are refused
+	MaxPageLimit uint64 = 1000
+
+	// QueryGasLimit is the gas a query may consume reading the store before it is aborted, so
+	// that the queries going through the pools and outgoing txs stay bounded as they grow
+	QueryGasLimit uint64 = 100_000_000
+)
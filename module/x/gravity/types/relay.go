@@ -0,0 +1,145 @@
+package types
+
+import (
+	"math/big"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v3/internal/calldata"
+)
+
+// ContractPowerThreshold is the power the signatures of an outgoing tx must exceed for the
+// Gravity contract to accept it, 66% of the normalized total power as the contract is deployed
+// with
+const ContractPowerThreshold uint64 = 2834678415
+
+// The contract arguments of the outgoing txs, which their checkpoints are the hash of and the
+// relayers submit them with
+type (
+	ABIEncodedValsetArgs    = calldata.ValsetArgs
+	ABIEncodedValSignature  = calldata.ValSignature
+	ABIEncodedLogicCallArgs = calldata.LogicCallArgs
+)
+
+// ABIEncodedValsetArgs returns the signer set as the ValsetArgs struct of the Gravity
+// contract, with its signers in the order of its checkpoint
+func (u SignerSetTx) ABIEncodedValsetArgs() ABIEncodedValsetArgs {
+	signers := make(EthereumSigners, len(u.Signers))
+	copy(signers, u.Signers)
+	signers.Sort()
+
+	args := ABIEncodedValsetArgs{
+		Validators:   make([]gethcommon.Address, len(signers)),
+		Powers:       make([]*big.Int, len(signers)),
+		Nonce:        new(big.Int).SetUint64(u.Nonce),
+		RewardAmount: big.NewInt(0),
+		RewardToken:  gethcommon.Address{},
+	}
+	for i, s := range signers {
+		args.Validators[i] = gethcommon.HexToAddress(s.EthereumAddress)
+		args.Powers[i] = new(big.Int).SetUint64(s.Power)
+	}
+	return args
+}
+
+// abiEncodedBatchArgs returns the batch as the arguments of submitBatch
+func (b BatchTx) abiEncodedBatchArgs() calldata.BatchArgs {
+	args := calldata.BatchArgs{
+		Amounts:       make([]*big.Int, len(b.Transactions)),
+		Destinations:  make([]gethcommon.Address, len(b.Transactions)),
+		Fees:          make([]*big.Int, len(b.Transactions)),
+		BatchNonce:    new(big.Int).SetUint64(b.BatchNonce),
+		TokenContract: gethcommon.HexToAddress(b.TokenContract),
+		BatchTimeout:  new(big.Int).SetUint64(b.Timeout),
+	}
+	for i, tx := range b.Transactions {
+		args.Amounts[i] = tx.Erc20Token.Amount.BigInt()
+		args.Destinations[i] = gethcommon.HexToAddress(tx.EthereumRecipient)
+		args.Fees[i] = tx.Erc20Fee.Amount.BigInt()
+	}
+	return args
+}
+
+// abiEncodedBatchArgs returns the ERC1155 batch as the arguments of submitERC1155Batch, its
+// transfers flattened so each entry moves one id to a recipient
+func (b ERC1155BatchTx) abiEncodedBatchArgs() calldata.ERC1155BatchArgs {
+	args := calldata.ERC1155BatchArgs{
+		Destinations:  []gethcommon.Address{},
+		IDs:           []*big.Int{},
+		Amounts:       []*big.Int{},
+		BatchNonce:    new(big.Int).SetUint64(b.BatchNonce),
+		TokenContract: gethcommon.HexToAddress(b.TokenContract),
+		BatchTimeout:  new(big.Int).SetUint64(b.Timeout),
+	}
+	for _, tx := range b.Transactions {
+		for _, amount := range tx.Amounts {
+			args.Destinations = append(args.Destinations, gethcommon.HexToAddress(tx.EthereumRecipient))
+			args.IDs = append(args.IDs, amount.Id.BigInt())
+			args.Amounts = append(args.Amounts, amount.Amount.BigInt())
+		}
+	}
+	return args
+}
+
+// ABIEncodedLogicCallArgs returns the logic call as the LogicCallArgs struct of the Gravity
+// contract
+func (c ContractCallTx) ABIEncodedLogicCallArgs() ABIEncodedLogicCallArgs {
+	args := ABIEncodedLogicCallArgs{
+		TransferAmounts:        make([]*big.Int, len(c.Tokens)),
+		TransferTokenContracts: make([]gethcommon.Address, len(c.Tokens)),
+		FeeAmounts:             make([]*big.Int, len(c.Fees)),
+		FeeTokenContracts:      make([]gethcommon.Address, len(c.Fees)),
+		LogicContractAddress:   gethcommon.HexToAddress(c.Address),
+		Payload:                append([]byte{}, c.Payload...),
+		TimeOut:                new(big.Int).SetUint64(c.Timeout),
+		InvalidationNonce:      new(big.Int).SetUint64(c.InvalidationNonce),
+	}
+	for i, coin := range c.Tokens {
+		args.TransferAmounts[i] = coin.Amount.BigInt()
+		args.TransferTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
+	}
+	for i, coin := range c.Fees {
+		args.FeeAmounts[i] = coin.Amount.BigInt()
+		args.FeeTokenContracts[i] = gethcommon.HexToAddress(coin.Contract)
+	}
+	copy(args.InvalidationId[:], c.InvalidationScope)
+	return args
+}
+
+// RelayCalldata returns the calldata of the Gravity contract call relaying the outgoing tx,
+// given the signer set of the contract and the signatures of the tx by the Ethereum address
+// of their signer. The signatures are ordered as the signers of the contract, those missing
+// are left empty, and the signed power must pass the ContractPowerThreshold.
+func RelayCalldata(otx OutgoingTx, current SignerSetTx, signatures map[gethcommon.Address][]byte) ([]byte, error) {
+	if power := EthereumSigners(current.Signers).SignedPower(signatures); power <= ContractPowerThreshold {
+		return nil, sdkerrors.Wrapf(ErrNotRelayable, "signed power %d does not pass the threshold %d of the contract", power, ContractPowerThreshold)
+	}
+
+	currentValset := current.ABIEncodedValsetArgs()
+	sigs := make([]ABIEncodedValSignature, len(currentValset.Validators))
+	for i, signer := range currentValset.Validators {
+		sigs[i] = calldata.NewValSignature(signatures[signer])
+	}
+
+	var (
+		bz  []byte
+		err error
+	)
+	switch otx := otx.(type) {
+	case *SignerSetTx:
+		bz, err = calldata.UpdateValset(otx.ABIEncodedValsetArgs(), currentValset, sigs)
+	case *BatchTx:
+		bz, err = calldata.SubmitBatch(currentValset, sigs, otx.abiEncodedBatchArgs())
+	case *ERC1155BatchTx:
+		bz, err = calldata.SubmitERC1155Batch(currentValset, sigs, otx.abiEncodedBatchArgs())
+	case *ContractCallTx:
+		bz, err = calldata.SubmitLogicCall(currentValset, sigs, otx.ABIEncodedLogicCallArgs())
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalid, "outgoing tx of type %T", otx)
+	}
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "packing relay calldata")
+	}
+	return bz, nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RelayerRewardAddress returns the address of the sub-account of the gravity module holding
+// the coins of the relayer incentives still to be disbursed
+func RelayerRewardAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte("relayer-rewards"))
+}
+
+// ValidateBasic performs stateless checks on the relayer incentive
+func (i RelayerIncentive) ValidateBasic() error {
+	if i.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "relayer incentive id cannot be zero")
+	}
+	if err := validateRelayerIncentiveSchedule(i.Amount, i.DisbursementPeriod, i.Disbursements); err != nil {
+		return err
+	}
+	if !i.Disbursed.IsValid() || !i.Amount.IsAllGTE(i.Disbursed) {
+		return sdkerrors.Wrapf(ErrInvalid, "disbursed %s of the %s funded", i.Disbursed, i.Amount)
+	}
+	if i.DisbursementsMade > i.Disbursements {
+		return sdkerrors.Wrapf(ErrInvalid, "%d disbursements made of %d", i.DisbursementsMade, i.Disbursements)
+	}
+	return nil
+}
+
+// Completed is true once every disbursement of the incentive has been made
+func (i RelayerIncentive) Completed() bool {
+	return i.DisbursementsMade >= i.Disbursements
+}
+
+// NextDisbursement returns the coins paid out by the next disbursement of the incentive, an
+// equal part of the amount, the last one paying out what the rounding down left
+func (i RelayerIncentive) NextDisbursement() sdk.Coins {
+	if i.Completed() {
+		return sdk.NewCoins()
+	}
+	if i.DisbursementsMade+1 == i.Disbursements {
+		return i.Amount.Sub(i.Disbursed)
+	}
+	part := make([]sdk.Coin, 0, len(i.Amount))
+	for _, coin := range i.Amount {
+		part = append(part, sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(i.Disbursements))))
+	}
+	return sdk.NewCoins(part...)
+}
+
+func validateRelayerIncentiveSchedule(amount sdk.Coins, disbursementPeriod, disbursements uint64) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalid, "relayer incentive amount %s", amount)
+	}
+	if disbursementPeriod == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "disbursement period cannot be zero")
+	}
+	if disbursements == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "disbursements cannot be zero")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// SigningPayloadKind is a message a validator may sign to confirm an outgoing tx
+type SigningPayloadKind int
+
+const (
+	// SigningPayloadCheckpoint is the checkpoint of the tx
+	SigningPayloadCheckpoint SigningPayloadKind = iota
+	// SigningPayloadTypedData is the digest of the EIP-712 typed data of the tx
+	SigningPayloadTypedData
+)
+
+// SigningPayload is the hash a validator signs to confirm an outgoing tx under a gravity id
+type SigningPayload struct {
+	Kind SigningPayloadKind
+	Hash []byte
+}
+
+// SignatureScheme is how the validators sign the outgoing txs for the Gravity contracts: how
+// their confirmations are verified and how their signatures are assembled into the contract
+// call relaying a tx. The scheme is selected by the signature_scheme param, so that a contract
+// verifying signatures another way, e.g. an aggregated BLS signature, only takes a scheme
+// registered under a new SignatureSchemeType for the keeper to confirm and relay its txs.
+type SignatureScheme interface {
+	// ValidateSignature returns an error unless the signature is the signer's over the payload
+	ValidateSignature(payload SigningPayload, signature []byte, signer gethcommon.Address) error
+	// RelayCalldata returns the calldata of the contract call relaying the outgoing tx, given
+	// the signer set of the contract and the signatures of the tx by their signer
+	RelayCalldata(otx OutgoingTx, current SignerSetTx, signatures map[gethcommon.Address][]byte) ([]byte, error)
+}
+
+var signatureSchemes = map[SignatureSchemeType]SignatureScheme{
+	SignatureSchemeECDSA: ecdsaSignatureScheme{},
+}
+
+// GetSignatureScheme returns the scheme registered under the type
+func GetSignatureScheme(schemeType SignatureSchemeType) (SignatureScheme, error) {
+	scheme, ok := signatureSchemes[schemeType]
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "no signature scheme %s", schemeType)
+	}
+	return scheme, nil
+}
+
+// ecdsaSignatureScheme is the scheme of the Gravity contracts so far, each validator signs
+// with its Ethereum key and the contract recovers the signers one by one
+type ecdsaSignatureScheme struct{}
+
+func (ecdsaSignatureScheme) ValidateSignature(payload SigningPayload, signature []byte, signer gethcommon.Address) error {
+	switch payload.Kind {
+	case SigningPayloadCheckpoint:
+		return ValidateEthereumSignature(payload.Hash, signature, signer)
+	case SigningPayloadTypedData:
+		return ValidateTypedDataSignature(payload.Hash, signature, signer)
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "signing payload kind %d", payload.Kind)
+	}
+}
+
+func (ecdsaSignatureScheme) RelayCalldata(otx OutgoingTx, current SignerSetTx, signatures map[gethcommon.Address][]byte) ([]byte, error) {
+	return RelayCalldata(otx, current, signatures)
+}
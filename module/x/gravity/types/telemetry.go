@@ -0,0 +1,80 @@
+package types
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/status"
+)
+
+// The keys of the bridge health metrics, exposed under the module name by nodes running with
+// telemetry enabled
+const (
+	MetricKeyBatchTxsCreated        = "batch_txs_created"
+	MetricKeyBatchTxSize            = "batch_tx_size"
+	MetricKeyPoolDepth              = "pool_depth"
+	MetricKeySignerSetTxsCreated    = "signer_set_txs_created"
+	MetricKeyContractCallTxsCreated = "contract_call_txs_created"
+	MetricKeyConfirmationsReceived  = "confirmations_received"
+	MetricKeyEventVotesReceived     = "event_votes_received"
+	MetricKeyEventsObserved         = "events_observed"
+	MetricKeyEventNonceLag          = "event_nonce_lag"
+	MetricKeyValidatorsSlashed      = "validators_slashed"
+	MetricKeyQueries                = "queries"
+	MetricKeyQueryErrors            = "query_errors"
+	MetricKeyQueryLatency           = "query_latency"
+)
+
+// The labels of the bridge health metrics
+const (
+	MetricLabelEVMChainID = "evm_chain_id"
+	MetricLabelKind       = "kind"
+	MetricLabelEventType  = "event_type"
+	MetricLabelMethod     = "method"
+	MetricLabelCode       = "code"
+)
+
+// The kinds of the batches and pools in metric labels
+const (
+	MetricKindERC20   = "erc20"
+	MetricKindERC1155 = "erc1155"
+)
+
+// IncrMetricCounter increments the counter of the bridge health metric of the chain
+func IncrMetricCounter(key string, chainID uint64, labels ...metrics.Label) {
+	telemetry.IncrCounterWithLabels([]string{ModuleName, key}, 1, chainMetricLabels(chainID, labels))
+}
+
+// SetMetricGauge sets the gauge of the bridge health metric of the chain
+func SetMetricGauge(key string, chainID uint64, value float32, labels ...metrics.Label) {
+	telemetry.SetGaugeWithLabels([]string{ModuleName, key}, value, chainMetricLabels(chainID, labels))
+}
+
+// MeasureQuery records the latency of a query of the method started at start, and counts it
+// along with its error if it failed, so that the error rate of each method can be derived
+func MeasureQuery(method string, start time.Time, err error) {
+	labels := []metrics.Label{telemetry.NewLabel(MetricLabelMethod, method)}
+	metrics.MeasureSinceWithLabels([]string{ModuleName, MetricKeyQueryLatency}, start.UTC(), labels)
+	telemetry.IncrCounterWithLabels([]string{ModuleName, MetricKeyQueries}, 1, labels)
+	if err != nil {
+		code := telemetry.NewLabel(MetricLabelCode, queryErrorCode(err))
+		telemetry.IncrCounterWithLabels([]string{ModuleName, MetricKeyQueryErrors}, 1, append(labels, code))
+	}
+}
+
+// queryErrorCode returns the gRPC code of the status errors and the codespace and ABCI code of
+// the others, such as the ErrUnknownEVMChain of the chain queries
+func queryErrorCode(err error) string {
+	if s, ok := status.FromError(err); ok {
+		return s.Code().String()
+	}
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	return codespace + ":" + strconv.FormatUint(uint64(code), 10)
+}
+
+func chainMetricLabels(chainID uint64, labels []metrics.Label) []metrics.Label {
+	return append([]metrics.Label{telemetry.NewLabel(MetricLabelEVMChainID, strconv.FormatUint(chainID, 10))}, labels...)
+}
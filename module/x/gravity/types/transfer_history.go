@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The kinds of the transfer records
+const (
+	TransferKindSendToCosmos         = "send_to_cosmos"
+	TransferKindSendERC1155ToCosmos  = "send_erc1155_to_cosmos"
+	TransferKindBatchExecuted        = "batch_executed"
+	TransferKindERC1155BatchExecuted = "erc1155_batch_executed"
+)
+
+// The formats the transfer history can be written in
+const (
+	TransferHistoryFormatCSV  = "csv"
+	TransferHistoryFormatJSON = "json"
+)
+
+// NewTransferRecord returns the transfer record of an observed event, false if the event
+// isn't a transfer
+func NewTransferRecord(chainID uint64, event EthereumEvent) (TransferRecord, bool) {
+	record := TransferRecord{
+		EvmChainId:     chainID,
+		EventNonce:     event.GetEventNonce(),
+		EthereumHeight: event.GetEthereumHeight(),
+	}
+	switch event := event.(type) {
+	case *SendToCosmosEvent:
+		record.Kind = TransferKindSendToCosmos
+		record.TokenContract = event.TokenContract
+		record.Amount = event.Amount.String()
+		record.EthereumSender = event.EthereumSender
+		record.CosmosReceiver = event.CosmosReceiver
+	case *SendERC1155ToCosmosEvent:
+		amounts := make([]string, len(event.Amounts))
+		for i, amount := range event.Amounts {
+			amounts[i] = fmt.Sprintf("%s:%s", amount.Id, amount.Amount)
+		}
+		record.Kind = TransferKindSendERC1155ToCosmos
+		record.TokenContract = event.TokenContract
+		record.Amount = strings.Join(amounts, ",")
+		record.EthereumSender = event.EthereumSender
+		record.CosmosReceiver = event.CosmosReceiver
+	case *BatchExecutedEvent:
+		record.Kind = TransferKindBatchExecuted
+		record.TokenContract = event.TokenContract
+		record.BatchNonce = event.BatchNonce
+	case *ERC1155BatchExecutedEvent:
+		record.Kind = TransferKindERC1155BatchExecuted
+		record.TokenContract = event.TokenContract
+		record.BatchNonce = event.BatchNonce
+	default:
+		return TransferRecord{}, false
+	}
+	return record, true
+}
+
+// TransferHistoryWriter writes transfer records as CSV with a header row, or as JSON lines
+type TransferHistoryWriter struct {
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+// NewTransferHistoryWriter returns a writer of transfer records in the format
+func NewTransferHistoryWriter(w io.Writer, format string) (*TransferHistoryWriter, error) {
+	switch format {
+	case TransferHistoryFormatCSV:
+		writer := &TransferHistoryWriter{csv: csv.NewWriter(w)}
+		header := []string{"evm_chain_id", "event_nonce", "ethereum_height", "kind", "token_contract", "amount", "ethereum_sender", "cosmos_receiver", "batch_nonce"}
+		return writer, writer.csv.Write(header)
+	case TransferHistoryFormatJSON:
+		return &TransferHistoryWriter{json: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown transfer history format %s", format)
+	}
+}
+
+// Write writes the record
+func (w *TransferHistoryWriter) Write(record TransferRecord) error {
+	if w.json != nil {
+		return w.json.Encode(record)
+	}
+	return w.csv.Write([]string{
+		strconv.FormatUint(record.EvmChainId, 10),
+		strconv.FormatUint(record.EventNonce, 10),
+		strconv.FormatUint(record.EthereumHeight, 10),
+		record.Kind,
+		record.TokenContract,
+		record.Amount,
+		record.EthereumSender,
+		record.CosmosReceiver,
+		strconv.FormatUint(record.BatchNonce, 10),
+	})
+}
+
+// Flush writes any buffered record
+func (w *TransferHistoryWriter) Flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	return nil
+}
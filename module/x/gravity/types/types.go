@@ -3,7 +3,9 @@ package types
 import (
 	"bytes"
 	"crypto/sha256"
+	"fmt"
 	"math"
+	"math/big"
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,7 +19,7 @@ import (
 
 // ValidateBasic performs stateless checks on validity
 func (b *EthereumSigner) ValidateBasic() error {
-	if !common.IsHexAddress(b.EthereumAddress) {
+	if ValidateEthereumAddress(b.EthereumAddress) != nil {
 		return sdkerrors.Wrap(ErrInvalid, "ethereum address")
 	}
 	return nil
@@ -63,20 +65,14 @@ func (b EthereumSigners) Hash() []byte {
 // set, after all the validators retained their relative percentages during inflation and normalized Gravity bridge power
 // shows no difference.
 func (b EthereumSigners) PowerDiff(c EthereumSigners) float64 {
-	// loop over b and initialize the map with their powers
-	powers := map[string]int64{}
+	// sum the powers of b by address, then subtract those of c, so that the diff doesn't
+	// depend on the order of the signers or the case of their addresses
+	powers := map[common.Address]int64{}
 	for _, bv := range b {
-		powers[bv.EthereumAddress] = int64(bv.Power)
+		powers[common.HexToAddress(bv.EthereumAddress)] += int64(bv.Power)
 	}
-
-	// subtract c powers from powers in the map, initializing
-	// uninitialized keys with negative numbers
 	for _, es := range c {
-		if val, ok := powers[es.EthereumAddress]; ok {
-			powers[es.EthereumAddress] = val - int64(es.Power)
-		} else {
-			powers[es.EthereumAddress] = -int64(es.Power)
-		}
+		powers[common.HexToAddress(es.EthereumAddress)] -= int64(es.Power)
 	}
 
 	var delta int64
@@ -103,6 +99,17 @@ func (b EthereumSigners) TotalPower() (out uint64) {
 	return
 }
 
+// SignedPower returns the power of the members with a signature in the map of the
+// signatures by Ethereum address
+func (b EthereumSigners) SignedPower(signatures map[common.Address][]byte) (out uint64) {
+	for _, v := range b {
+		if _, ok := signatures[common.HexToAddress(v.EthereumAddress)]; ok {
+			out += v.Power
+		}
+	}
+	return
+}
+
 // GetPowers returns only the power values for all members
 func (b EthereumSigners) GetPowers() []uint64 {
 	r := make([]uint64, len(b))
@@ -130,3 +137,228 @@ func (b BatchTx) GetFees() sdk.Int {
 	}
 	return sum
 }
+
+//////////////////////////////////////
+//           EVM Chains             //
+//////////////////////////////////////
+
+// ValidateBasic performs stateless checks on validity
+func (c EVMChain) ValidateBasic() error {
+	if c.ChainId == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "chain id cannot be zero")
+	}
+	if c.GravityId == "" {
+		return sdkerrors.Wrap(ErrInvalid, "gravity id cannot be empty")
+	}
+	if err := validateGravityID(c.GravityId); err != nil {
+		return sdkerrors.Wrap(err, "gravity id")
+	}
+	if err := validateBridgeContractAddress(c.BridgeEthereumAddress); err != nil {
+		return sdkerrors.Wrap(err, "bridge contract address")
+	}
+	if err := validateEthereumFinality(c.Finality); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateFinality(c.Finality, c.MinimumConfirmations); err != nil {
+		return err
+	}
+	if err := validateFeeFloors(c.FeeFloors); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateDepositAddressFactory(c.DepositAddressFactory); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateTokenDecimals(c.TokenDecimals); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateNativeDecimals(c.NativeDecimals); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateRateLimits(c.RateLimits); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+// NormalizeEthereumAddresses rewrites the contract addresses of the chain in their
+// EIP-55 checksummed form, leaving an unset deposit address factory unset
+func (c *EVMChain) NormalizeEthereumAddresses() {
+	c.BridgeEthereumAddress = NormalizeEthereumAddress(c.BridgeEthereumAddress)
+	if c.DepositAddressFactory != "" {
+		c.DepositAddressFactory = NormalizeEthereumAddress(c.DepositAddressFactory)
+	}
+	NormalizeFeeFloors(c.FeeFloors)
+	for i := range c.RateLimits {
+		c.RateLimits[i].TokenContract = NormalizeEthereumAddress(c.RateLimits[i].TokenContract)
+	}
+}
+
+// NormalizeFeeFloors rewrites the token contracts of the floors in their EIP-55 checksummed
+// form
+func NormalizeFeeFloors(floors []FeeFloor) {
+	for i := range floors {
+		floors[i].TokenContract = NormalizeEthereumAddress(floors[i].TokenContract)
+	}
+}
+
+// MinimumFee returns the fee floor of transfers of the token to the chain, zero if the
+// token has none
+func (c EVMChain) MinimumFee(tokenContract common.Address) sdk.Int {
+	for _, floor := range c.FeeFloors {
+		if common.HexToAddress(floor.TokenContract) == tokenContract {
+			return floor.MinimumFee
+		}
+	}
+	return sdk.ZeroInt()
+}
+
+// GetRateLimit returns the rate limit of transfers of the token to the chain, if any
+func (c EVMChain) GetRateLimit(tokenContract common.Address) (RateLimit, bool) {
+	for _, limit := range c.RateLimits {
+		if common.HexToAddress(limit.TokenContract) == tokenContract {
+			return limit, true
+		}
+	}
+	return RateLimit{}, false
+}
+
+// ERC20Amount returns the amount of the denom in the units of its ERC20 on the chain, it
+// errors if the amount can't be represented exactly or overflows a uint256
+func (c EVMChain) ERC20Amount(denom string, amount sdk.Int) (sdk.Int, error) {
+	decimals, found := c.getTokenDecimals(denom)
+	if !found {
+		return amount, nil
+	}
+
+	scaled := new(big.Int).Set(amount.BigInt())
+	if decimals.Erc20Decimals >= decimals.DenomDecimals {
+		scaled.Mul(scaled, decimalsFactor(decimals.Erc20Decimals-decimals.DenomDecimals))
+	} else if _, remainder := scaled.QuoRem(scaled, decimalsFactor(decimals.DenomDecimals-decimals.Erc20Decimals), new(big.Int)); remainder.Sign() != 0 {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalid, "%s%s has more than the %d decimals of its ERC20 on chain id %d", amount, denom, decimals.Erc20Decimals, c.ChainId)
+	}
+	if scaled.BitLen() > 256 {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrSupplyOverflow, "%s%s scaled to %d decimals on chain id %d", amount, denom, decimals.Erc20Decimals, c.ChainId)
+	}
+	return sdk.NewIntFromBigInt(scaled), nil
+}
+
+// DenomAmount returns the amount of the denom's ERC20 on the chain in the units of the
+// denom, what is below the smallest unit of the denom is dropped
+func (c EVMChain) DenomAmount(denom string, amount sdk.Int) sdk.Int {
+	decimals, found := c.getTokenDecimals(denom)
+	if !found {
+		return amount
+	}
+
+	scaled := new(big.Int).Set(amount.BigInt())
+	if decimals.Erc20Decimals >= decimals.DenomDecimals {
+		scaled.Quo(scaled, decimalsFactor(decimals.Erc20Decimals-decimals.DenomDecimals))
+	} else {
+		scaled.Mul(scaled, decimalsFactor(decimals.DenomDecimals-decimals.Erc20Decimals))
+	}
+	return sdk.NewIntFromBigInt(scaled)
+}
+
+// MinimumDenomFee returns the fee floor of the token in units of its denom, rounded up
+// so that the fee still meets the floor once scaled to the token's decimals
+func (c EVMChain) MinimumDenomFee(denom string, tokenContract common.Address) sdk.Int {
+	minimumFee := c.MinimumFee(tokenContract)
+	fee := c.DenomAmount(denom, minimumFee)
+	if scaled, err := c.ERC20Amount(denom, fee); err == nil && scaled.LT(minimumFee) {
+		fee = fee.AddRaw(1)
+	}
+	return fee
+}
+
+func (c EVMChain) getTokenDecimals(denom string) (TokenDecimals, bool) {
+	for _, decimals := range c.TokenDecimals {
+		if decimals.Denom == denom {
+			return decimals, true
+		}
+	}
+	return TokenDecimals{}, false
+}
+
+func decimalsFactor(decimals uint32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+}
+
+// maxDecimals keeps a single unit of any denom or token within a uint256
+const maxDecimals = 77
+
+// validateTokenDecimals checks that each denom is scaled at most once
+func validateTokenDecimals(tokenDecimals []TokenDecimals) error {
+	seen := make(map[string]bool, len(tokenDecimals))
+	for _, decimals := range tokenDecimals {
+		if err := sdk.ValidateDenom(decimals.Denom); err != nil {
+			return err
+		}
+		if seen[decimals.Denom] {
+			return fmt.Errorf("duplicate token decimals for denom %s", decimals.Denom)
+		}
+		seen[decimals.Denom] = true
+		if decimals.DenomDecimals > maxDecimals || decimals.Erc20Decimals > maxDecimals {
+			return fmt.Errorf("token decimals of denom %s above %d", decimals.Denom, maxDecimals)
+		}
+	}
+	return nil
+}
+
+func validateNativeDecimals(decimals uint32) error {
+	if decimals > maxDecimals {
+		return fmt.Errorf("native decimals %d above %d", decimals, maxDecimals)
+	}
+	return nil
+}
+
+// validateFeeFloors checks that each token has at most one floor and that floors aren't
+// negative
+func validateFeeFloors(floors []FeeFloor) error {
+	seen := make(map[common.Address]bool, len(floors))
+	for _, floor := range floors {
+		if ValidateEthereumAddress(floor.TokenContract) != nil {
+			return fmt.Errorf("invalid fee floor token contract %s", floor.TokenContract)
+		}
+		tokenContract := common.HexToAddress(floor.TokenContract)
+		if seen[tokenContract] {
+			return fmt.Errorf("duplicate fee floor for token contract %s", floor.TokenContract)
+		}
+		seen[tokenContract] = true
+		if floor.MinimumFee.IsNil() || floor.MinimumFee.IsNegative() {
+			return fmt.Errorf("invalid minimum fee for token contract %s", floor.TokenContract)
+		}
+	}
+	return nil
+}
+
+// validateRateLimits checks that each token has at most one rate limit and that limits
+// and windows are positive
+func validateRateLimits(limits []RateLimit) error {
+	seen := make(map[common.Address]bool, len(limits))
+	for _, limit := range limits {
+		if ValidateEthereumAddress(limit.TokenContract) != nil {
+			return fmt.Errorf("invalid rate limit token contract %s", limit.TokenContract)
+		}
+		tokenContract := common.HexToAddress(limit.TokenContract)
+		if seen[tokenContract] {
+			return fmt.Errorf("duplicate rate limit for token contract %s", limit.TokenContract)
+		}
+		seen[tokenContract] = true
+		if limit.Limit.IsNil() || !limit.Limit.IsPositive() {
+			return fmt.Errorf("invalid rate limit for token contract %s", limit.TokenContract)
+		}
+		if limit.Window == 0 {
+			return fmt.Errorf("invalid rate limit window for token contract %s", limit.TokenContract)
+		}
+	}
+	return nil
+}
+
+// validateFinality rejects a confirmation depth for chains whose finality is read from
+// a block tag, the tag already accounts for reorgs
+func validateFinality(finality Finality, minimumConfirmations uint64) error {
+	if finality != FinalityConfirmations && minimumConfirmations > 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "minimum confirmations must be zero with %s finality", finality)
+	}
+	return nil
+}
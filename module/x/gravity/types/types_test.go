@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"encoding/hex"
 	mrand "math/rand"
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 )
@@ -168,3 +170,141 @@ func shuffled(v EthereumSigners) EthereumSigners {
 	})
 	return v
 }
+
+// FuzzEthereumSignersOrder checks that the order of the signers of a signer set, which comes
+// from the validator set of a node, doesn't change its hash, checkpoint or power diff
+func FuzzEthereumSignersOrder(f *testing.F) {
+	f.Add([]byte{1, 10, 2, 20, 3, 30}, []byte{1, 10, 2, 25}, int64(1))
+	f.Add([]byte{5, 1, 5, 2, 5, 3, 5, 1}, []byte{}, int64(2))
+	f.Add([]byte{255, 7, 0, 7, 128, 9}, []byte{255, 7, 0, 8}, int64(3))
+	f.Fuzz(func(t *testing.T, start, diff []byte, seed int64) {
+		// each pair of bytes is the power and address of a signer, whose address is
+		// lowercased for odd powers
+		signers := func(bz []byte) EthereumSigners {
+			var out EthereumSigners
+			for i := 0; i+1 < len(bz); i += 2 {
+				address := gethcommon.BytesToAddress([]byte{bz[i+1]}).Hex()
+				if bz[i]%2 == 1 {
+					address = strings.ToLower(address)
+				}
+				out = append(out, &EthereumSigner{Power: uint64(bz[i]) << 24, EthereumAddress: address})
+			}
+			return out
+		}
+		rng := mrand.New(mrand.NewSource(seed))
+		reordered := func(v EthereumSigners) EthereumSigners {
+			out := make(EthereumSigners, len(v))
+			for i, j := range rng.Perm(len(v)) {
+				out[i] = &EthereumSigner{Power: v[j].Power, EthereumAddress: v[j].EthereumAddress}
+			}
+			return out
+		}
+
+		a, b := signers(start), signers(diff)
+		ra, rb := reordered(a), reordered(b)
+		assert.Equal(t, a.PowerDiff(b), ra.PowerDiff(rb))
+		assert.Equal(t, a.Hash(), ra.Hash())
+		assert.Equal(t, NewSignerSetTx(1, 1, a).GetCheckpoint([]byte("foo")), NewSignerSetTx(1, 1, ra).GetCheckpoint([]byte("foo")))
+
+		ra.Sort()
+		for i := 1; i < len(ra); i++ {
+			prev, cur := ra[i-1], ra[i]
+			assert.True(t, prev.Power > cur.Power || (prev.Power == cur.Power && !EthereumAddrLessThan(cur.EthereumAddress, prev.EthereumAddress)))
+		}
+	})
+}
+
+func TestDeriveDepositAddress(t *testing.T) {
+	recipient, err := sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+	assert.NoError(t, err)
+	factory := gethcommon.HexToAddress("0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf")
+
+	// the clone of the factory's forwarder at 0xC451eB00627aDFA5880868EDA62493466C5BaFbD
+	// created with the recipient as salt
+	assert.Equal(t, "0x1946fCD6b3B5A4a817Ee7Fbf921A685981e179A3", DeriveDepositAddress(factory, recipient).Hex())
+	assert.Equal(t, gethcommon.HexToHash("0x000000000000000000000000edcde49dc4c8d7ce40a353d47ae64fec07079e98"), DepositDestination(recipient))
+}
+
+func TestValidateEthereumAddress(t *testing.T) {
+	checksummed := "0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCf"
+	for _, address := range []string{
+		checksummed,
+		strings.ToLower(checksummed),
+		"0x" + strings.ToUpper(checksummed[2:]),
+		checksummed[2:],
+	} {
+		assert.NoError(t, ValidateEthereumAddress(address), address)
+		assert.Equal(t, checksummed, NormalizeEthereumAddress(address))
+	}
+
+	// mixed case must be the checksum
+	for _, address := range []string{
+		"0x8858eeb3DfffA017D4BCE9801D340D36Cf895CCf",
+		"0x8858eeB3DfffA017D4BCE9801D340D36Cf895CC",
+		"0x8858eeB3DfffA017D4BCE9801D340D36Cf895CCg",
+		"",
+	} {
+		assert.Error(t, ValidateEthereumAddress(address), address)
+	}
+}
+
+func TestTokenDecimalsScaling(t *testing.T) {
+	chain := EVMChain{TokenDecimals: []TokenDecimals{
+		{Denom: "uatom", DenomDecimals: 6, Erc20Decimals: 18},
+		{Denom: "wei", DenomDecimals: 18, Erc20Decimals: 6},
+	}}
+
+	amount, err := chain.ERC20Amount("uatom", sdk.NewInt(5))
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(5000000000000), amount)
+	assert.Equal(t, sdk.NewInt(5), chain.DenomAmount("uatom", sdk.NewInt(5999999999999)))
+
+	// a chain with fewer decimals can only take whole units of its tokens
+	amount, err = chain.ERC20Amount("wei", sdk.NewInt(3000000000000))
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(3), amount)
+	_, err = chain.ERC20Amount("wei", sdk.NewInt(3000000000001))
+	assert.Error(t, err)
+	assert.Equal(t, sdk.NewInt(3000000000000), chain.DenomAmount("wei", sdk.NewInt(3)))
+
+	// other denoms aren't scaled
+	amount, err = chain.ERC20Amount("stake", sdk.NewInt(7))
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(7), amount)
+}
+
+func TestAttestationLatencyPercentiles(t *testing.T) {
+	var latency AttestationLatency
+	for blocks := uint64(1); blocks <= 100; blocks++ {
+		latency.Record(blocks)
+	}
+	assert.Equal(t, uint64(50), latency.P50)
+	assert.Equal(t, uint64(90), latency.P90)
+	assert.Equal(t, uint64(99), latency.P99)
+	assert.Equal(t, uint64(100), latency.Max)
+
+	// only the last samples are kept
+	for i := 0; i < MaxAttestationLatencySamples; i++ {
+		latency.Record(3)
+	}
+	assert.Len(t, latency.Samples, MaxAttestationLatencySamples)
+	assert.Equal(t, AttestationLatency{Samples: latency.Samples, P50: 3, P90: 3, P99: 3, Max: 3}, latency)
+	assert.NoError(t, latency.ValidateBasic())
+}
+
+func TestVoteBitmap(t *testing.T) {
+	var record EthereumEventVoteRecord
+	record.SetVoteBit(0)
+	record.SetVoteBit(9)
+	record.SetVoteBit(9)
+	assert.Equal(t, []byte{0x01, 0x02}, record.VoteBitmap)
+	assert.Equal(t, []int{0, 9}, record.VoteBitIndexes())
+
+	// the bitmap shrinks back as the last votes are cleared
+	record.ClearVoteBit(9)
+	assert.Equal(t, []byte{0x01}, record.VoteBitmap)
+	record.ClearVoteBit(100)
+	record.ClearVoteBit(0)
+	assert.Nil(t, record.VoteBitmap)
+	assert.Empty(t, record.VoteBitIndexes())
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic checks that the council is either unset or a valid address with a veto delay
+func (c VetoCouncil) ValidateBasic() error {
+	if c.Address == "" {
+		if c.VetoDelay != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "veto delay without a veto council")
+		}
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Address); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, c.Address)
+	}
+	if c.VetoDelay == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "veto delay cannot be zero")
+	}
+	return nil
+}
+
+// InVetoDelay returns true if the outgoing tx is still waiting out the veto delay at the
+// height. Signer set txs are never delayed.
+func (c VetoCouncil) InVetoDelay(otx OutgoingTx, height uint64) bool {
+	if c.Address == "" {
+		return false
+	}
+	switch otx.(type) {
+	case *BatchTx, *ContractCallTx:
+		return otx.GetCosmosHeight()+c.VetoDelay > height
+	default:
+		return false
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Index returns the index of the validator in the voter set
+func (vs VoterSet) Index(val sdk.ValAddress) (int, bool) {
+	address := val.String()
+	for i, voter := range vs.Voters {
+		if voter.ValidatorAddress == address {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// SetVoteBit records the vote of the voter at index i of the voter set of the record
+func (m *EthereumEventVoteRecord) SetVoteBit(i int) {
+	for len(m.VoteBitmap) <= i/8 {
+		m.VoteBitmap = append(m.VoteBitmap, 0)
+	}
+	m.VoteBitmap[i/8] |= 1 << (i % 8)
+}
+
+// ClearVoteBit removes the vote of the voter at index i of the voter set of the record, the
+// bitmap is trimmed of its trailing empty bytes
+func (m *EthereumEventVoteRecord) ClearVoteBit(i int) {
+	if i/8 < len(m.VoteBitmap) {
+		m.VoteBitmap[i/8] &^= 1 << (i % 8)
+	}
+	for len(m.VoteBitmap) > 0 && m.VoteBitmap[len(m.VoteBitmap)-1] == 0 {
+		m.VoteBitmap = m.VoteBitmap[:len(m.VoteBitmap)-1]
+	}
+	if len(m.VoteBitmap) == 0 {
+		m.VoteBitmap = nil
+	}
+}
+
+// VoteBitIndexes returns the indexes in the voter set of the record of the voters with a bit
+// set, in increasing order
+func (m EthereumEventVoteRecord) VoteBitIndexes() (indexes []int) {
+	for i, b := range m.VoteBitmap {
+		for bit := 0; bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				indexes = append(indexes, i*8+bit)
+			}
+		}
+	}
+	return indexes
+}
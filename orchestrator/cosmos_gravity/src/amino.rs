@@ -0,0 +1,617 @@
+//! Legacy amino JSON encoding of the transactions sent by the orchestrator, needed to sign them
+//! in SIGN_MODE_LEGACY_AMINO_JSON, the only sign mode supported by Ledger devices. The sign bytes
+//! must match what the Cosmos SDK produces byte for byte: object keys are sorted, 64 bit integers
+//! are strings, byte slices are base64 and zero values are omitted.
+
+use deep_space::coin::Coin;
+use deep_space::MessageArgs;
+use gravity_proto::cosmos_sdk_proto::cosmos::base::v1beta1::Coin as ProtoCoin;
+use gravity_proto::gravity as proto;
+use gravity_utils::error::GravityError;
+use prost::Message;
+use prost_types::Any;
+use serde_json::{json, Map, Value};
+
+/// Returns the sign bytes of a transaction carrying the provided messages
+pub fn std_sign_bytes(
+    messages: &[Any],
+    args: &MessageArgs,
+    memo: &str,
+) -> Result<Vec<u8>, GravityError> {
+    let msgs = messages
+        .iter()
+        .map(encode_msg)
+        .collect::<Result<Vec<Value>, GravityError>>()?;
+
+    let mut doc = Map::new();
+    doc.insert(
+        "account_number".into(),
+        json!(args.account_number.to_string()),
+    );
+    doc.insert("chain_id".into(), json!(args.chain_id));
+    doc.insert(
+        "fee".into(),
+        json!({
+            "amount": args.fee.amount.iter().map(encode_coin).collect::<Vec<Value>>(),
+            "gas": args.fee.gas_limit.to_string(),
+        }),
+    );
+    doc.insert("memo".into(), json!(memo));
+    doc.insert("msgs".into(), Value::Array(msgs));
+    doc.insert("sequence".into(), json!(args.sequence.to_string()));
+    insert_u64(&mut doc, "timeout_height", args.timeout_height);
+
+    Ok(sorted(Value::Object(doc)).to_string().into_bytes())
+}
+
+fn encode_msg(msg: &Any) -> Result<Value, GravityError> {
+    let value = match msg.type_url.as_str() {
+        "/gravity.v1.MsgSubmitEthereumEvent" => {
+            let msg: proto::MsgSubmitEthereumEvent = decode(msg)?;
+            let mut value = Map::new();
+            if let Some(event) = &msg.event {
+                value.insert("event".into(), encode_event(event)?);
+            }
+            insert_str(&mut value, "signer", &msg.signer);
+            insert_u64(&mut value, "evm_chain_id", msg.evm_chain_id);
+            typed("MsgSubmitEthereumEvent", value)
+        }
+        "/gravity.v1.MsgSubmitEthereumTxConfirmation" => {
+            let msg: proto::MsgSubmitEthereumTxConfirmation = decode(msg)?;
+            let mut value = Map::new();
+            if let Some(confirmation) = &msg.confirmation {
+                value.insert("confirmation".into(), encode_confirmation(confirmation)?);
+            }
+            insert_str(&mut value, "signer", &msg.signer);
+            insert_u64(&mut value, "evm_chain_id", msg.evm_chain_id);
+            typed("MsgSubmitEthereumTxConfirmation", value)
+        }
+        "/gravity.v1.MsgEthereumHeightVote" => {
+            let msg: proto::MsgEthereumHeightVote = decode(msg)?;
+            let mut value = Map::new();
+            insert_u64(&mut value, "ethereum_height", msg.ethereum_height);
+            insert_str(&mut value, "signer", &msg.signer);
+            insert_u64(&mut value, "evm_chain_id", msg.evm_chain_id);
+            // amino only encodes 64 bit integers as strings
+            if msg.finality != 0 {
+                value.insert("finality".into(), json!(msg.finality));
+            }
+            typed("MsgEthereumHeightVote", value)
+        }
+        "/gravity.v1.MsgDelegateKeys" => {
+            let msg: proto::MsgDelegateKeys = decode(msg)?;
+            let mut value = Map::new();
+            insert_str(&mut value, "validator_address", &msg.validator_address);
+            insert_str(
+                &mut value,
+                "orchestrator_address",
+                &msg.orchestrator_address,
+            );
+            insert_str(&mut value, "ethereum_address", &msg.ethereum_address);
+            insert_bytes(&mut value, "eth_signature", &msg.eth_signature);
+            typed("MsgDelegateKeys", value)
+        }
+        "/gravity.v1.MsgSendToEthereum" => {
+            let msg: proto::MsgSendToEthereum = decode(msg)?;
+            let mut value = Map::new();
+            insert_str(&mut value, "sender", &msg.sender);
+            insert_str(&mut value, "ethereum_recipient", &msg.ethereum_recipient);
+            value.insert("amount".into(), encode_proto_coin(msg.amount.as_ref()));
+            value.insert(
+                "bridge_fee".into(),
+                encode_proto_coin(msg.bridge_fee.as_ref()),
+            );
+            insert_u64(&mut value, "evm_chain_id", msg.evm_chain_id);
+            typed("MsgSendToEthereum", value)
+        }
+        "/gravity.v1.MsgCancelSendToEthereum" => {
+            let msg: proto::MsgCancelSendToEthereum = decode(msg)?;
+            let mut value = Map::new();
+            insert_u64(&mut value, "id", msg.id);
+            insert_str(&mut value, "sender", &msg.sender);
+            insert_u64(&mut value, "evm_chain_id", msg.evm_chain_id);
+            typed("MsgCancelSendToEthereum", value)
+        }
+        type_url => return Err(unsupported(type_url)),
+    };
+
+    Ok(value)
+}
+
+fn encode_event(event: &Any) -> Result<Value, GravityError> {
+    let value = match event.type_url.as_str() {
+        "/gravity.v1.SendToCosmosEvent" => {
+            let event: proto::SendToCosmosEvent = decode(event)?;
+            let mut value = Map::new();
+            insert_u64(&mut value, "event_nonce", event.event_nonce);
+            insert_str(&mut value, "token_contract", &event.token_contract);
+            // sdk.Int amounts are never omitted
+            value.insert("amount".into(), json!(int_or_zero(&event.amount)));
+            insert_str(&mut value, "ethereum_sender", &event.ethereum_sender);
+            insert_str(&mut value, "cosmos_receiver", &event.cosmos_receiver);
+            insert_u64(&mut value, "ethereum_height", event.ethereum_height);
+            insert_u64(
+                &mut value,
+                "ethereum_confirmations",
+                event.ethereum_confirmations,
+            );
+            insert_u64(
+                &mut value,
+                "forward_evm_chain_id",
+                event.forward_evm_chain_id,
+            );
+            insert_str(
+                &mut value,
+                "forward_ibc_channel",
+                &event.forward_ibc_channel,
+            );
+            typed("SendToCosmosEvent", value)
+        }
+        "/gravity.v1.BatchExecutedEvent" => {
+            let event: proto::BatchExecutedEvent = decode(event)?;
+            let mut value = Map::new();
+            insert_str(&mut value, "token_contract", &event.token_contract);
+            insert_u64(&mut value, "event_nonce", event.event_nonce);
+            insert_u64(&mut value, "ethereum_height", event.ethereum_height);
+            insert_u64(&mut value, "batch_nonce", event.batch_nonce);
+            insert_u64(
+                &mut value,
+                "ethereum_confirmations",
+                event.ethereum_confirmations,
+            );
+            typed("BatchExecutedEvent", value)
+        }
+        "/gravity.v1.SendERC1155ToCosmosEvent" => {
+            let event: proto::SendErc1155ToCosmosEvent = decode(event)?;
+            let mut value = Map::new();
+            insert_u64(&mut value, "event_nonce", event.event_nonce);
+            insert_str(&mut value, "token_contract", &event.token_contract);
+            if !event.amounts.is_empty() {
+                let amounts = event
+                    .amounts
+                    .iter()
+                    .map(|amount| {
+                        json!({
+                            "id": int_or_zero(&amount.id),
+                            "amount": int_or_zero(&amount.amount),
+                        })
+                    })
+                    .collect();
+                value.insert("amounts".into(), Value::Array(amounts));
+            }
+            insert_str(&mut value, "ethereum_sender", &event.ethereum_sender);
+            insert_str(&mut value, "cosmos_receiver", &event.cosmos_receiver);
+            insert_u64(&mut value, "ethereum_height", event.ethereum_height);
+            insert_u64(
+                &mut value,
+                "ethereum_confirmations",
+                event.ethereum_confirmations,
+            );
+            typed("SendERC1155ToCosmosEvent", value)
+        }
+        "/gravity.v1.ERC1155BatchExecutedEvent" => {
+            let event: proto::Erc1155BatchExecutedEvent = decode(event)?;
+            let mut value = Map::new();
+            insert_str(&mut value, "token_contract", &event.token_contract);
+            insert_u64(&mut value, "event_nonce", event.event_nonce);
+            insert_u64(&mut value, "ethereum_height", event.ethereum_height);
+            insert_u64(&mut value, "batch_nonce", event.batch_nonce);
+            insert_u64(
+                &mut value,
+                "ethereum_confirmations",
+                event.ethereum_confirmations,
+            );
+            typed("ERC1155BatchExecutedEvent", value)
+        }
+        "/gravity.v1.ContractVersionEvent" => {
+            let event: proto::ContractVersionEvent = decode(event)?;
+            let mut value = Map::new();
+            insert_u64(&mut value, "event_nonce", event.event_nonce);
+            insert_u64(&mut value, "version", event.version);
+            insert_u64(&mut value, "ethereum_height", event.ethereum_height);
+            insert_u64(
+                &mut value,
+                "ethereum_confirmations",
+                event.ethereum_confirmations,
+            );
+            typed("ContractVersionEvent", value)
+        }
+        "/gravity.v1.ERC20DeployedEvent" => {
+            let event: proto::Erc20DeployedEvent = decode(event)?;
+            let mut value = Map::new();
+            insert_u64(&mut value, "event_nonce", event.event_nonce);
+            insert_str(&mut value, "cosmos_denom", &event.cosmos_denom);
+            insert_str(&mut value, "token_contract", &event.token_contract);
+            insert_str(&mut value, "erc20_name", &event.erc20_name);
+            insert_str(&mut value, "erc20_symbol", &event.erc20_symbol);
+            insert_u64(&mut value, "erc20_decimals", event.erc20_decimals);
+            insert_u64(&mut value, "ethereum_height", event.ethereum_height);
+            insert_u64(
+                &mut value,
+                "ethereum_confirmations",
+                event.ethereum_confirmations,
+            );
+            typed("ERC20DeployedEvent", value)
+        }
+        "/gravity.v1.ContractCallExecutedEvent" => {
+            let event: proto::ContractCallExecutedEvent = decode(event)?;
+            let mut value = Map::new();
+            insert_u64(&mut value, "event_nonce", event.event_nonce);
+            // the invalidation scope of the event is a tendermint HexBytes
+            if !event.invalidation_scope.is_empty() {
+                value.insert(
+                    "invalidation_scope".into(),
+                    json!(hex_upper(&event.invalidation_scope)),
+                );
+            }
+            insert_u64(&mut value, "invalidation_nonce", event.invalidation_nonce);
+            insert_u64(&mut value, "ethereum_height", event.ethereum_height);
+            insert_u64(
+                &mut value,
+                "ethereum_confirmations",
+                event.ethereum_confirmations,
+            );
+            typed("ContractCallExecutedEvent", value)
+        }
+        "/gravity.v1.SignerSetTxExecutedEvent" => {
+            let event: proto::SignerSetTxExecutedEvent = decode(event)?;
+            let mut value = Map::new();
+            insert_u64(&mut value, "event_nonce", event.event_nonce);
+            insert_u64(&mut value, "signer_set_tx_nonce", event.signer_set_tx_nonce);
+            insert_u64(&mut value, "ethereum_height", event.ethereum_height);
+            if !event.members.is_empty() {
+                let members = event
+                    .members
+                    .iter()
+                    .map(|member| {
+                        let mut value = Map::new();
+                        insert_u64(&mut value, "power", member.power);
+                        insert_str(&mut value, "ethereum_address", &member.ethereum_address);
+                        Value::Object(value)
+                    })
+                    .collect();
+                value.insert("members".into(), Value::Array(members));
+            }
+            insert_u64(
+                &mut value,
+                "ethereum_confirmations",
+                event.ethereum_confirmations,
+            );
+            typed("SignerSetTxExecutedEvent", value)
+        }
+        type_url => return Err(unsupported(type_url)),
+    };
+
+    Ok(value)
+}
+
+fn encode_confirmation(confirmation: &Any) -> Result<Value, GravityError> {
+    let value = match confirmation.type_url.as_str() {
+        "/gravity.v1.BatchTxConfirmation" => {
+            let confirmation: proto::BatchTxConfirmation = decode(confirmation)?;
+            let mut value = Map::new();
+            insert_str(&mut value, "token_contract", &confirmation.token_contract);
+            insert_u64(&mut value, "batch_nonce", confirmation.batch_nonce);
+            insert_str(&mut value, "ethereum_signer", &confirmation.ethereum_signer);
+            insert_bytes(&mut value, "signature", &confirmation.signature);
+            typed("BatchTxConfirmation", value)
+        }
+        "/gravity.v1.ERC1155BatchTxConfirmation" => {
+            let confirmation: proto::Erc1155BatchTxConfirmation = decode(confirmation)?;
+            let mut value = Map::new();
+            insert_str(&mut value, "token_contract", &confirmation.token_contract);
+            insert_u64(&mut value, "batch_nonce", confirmation.batch_nonce);
+            insert_str(&mut value, "ethereum_signer", &confirmation.ethereum_signer);
+            insert_bytes(&mut value, "signature", &confirmation.signature);
+            typed("ERC1155BatchTxConfirmation", value)
+        }
+        "/gravity.v1.ContractCallTxConfirmation" => {
+            let confirmation: proto::ContractCallTxConfirmation = decode(confirmation)?;
+            let mut value = Map::new();
+            insert_bytes(
+                &mut value,
+                "invalidation_scope",
+                &confirmation.invalidation_scope,
+            );
+            insert_u64(
+                &mut value,
+                "invalidation_nonce",
+                confirmation.invalidation_nonce,
+            );
+            insert_str(&mut value, "ethereum_signer", &confirmation.ethereum_signer);
+            insert_bytes(&mut value, "signature", &confirmation.signature);
+            typed("ContractCallTxConfirmation", value)
+        }
+        "/gravity.v1.SignerSetTxConfirmation" => {
+            let confirmation: proto::SignerSetTxConfirmation = decode(confirmation)?;
+            let mut value = Map::new();
+            insert_u64(
+                &mut value,
+                "signer_set_nonce",
+                confirmation.signer_set_nonce,
+            );
+            insert_str(&mut value, "ethereum_signer", &confirmation.ethereum_signer);
+            insert_bytes(&mut value, "signature", &confirmation.signature);
+            typed("SignerSetTxConfirmation", value)
+        }
+        type_url => return Err(unsupported(type_url)),
+    };
+
+    Ok(value)
+}
+
+fn decode<T: Message + Default>(any: &Any) -> Result<T, GravityError> {
+    T::decode(any.value.as_slice()).map_err(|e| {
+        GravityError::CosmosSignerError(format!("could not decode {}: {}", any.type_url, e))
+    })
+}
+
+fn unsupported(type_url: &str) -> GravityError {
+    GravityError::CosmosSignerError(format!(
+        "{} can not be signed in amino JSON sign mode",
+        type_url
+    ))
+}
+
+/// Wraps the value in the amino envelope of the concrete type registered under the name
+fn typed(name: &str, value: Map<String, Value>) -> Value {
+    json!({
+        "type": format!("gravity-bridge/{}", name),
+        "value": Value::Object(value),
+    })
+}
+
+fn encode_coin(coin: &Coin) -> Value {
+    json!({
+        "amount": coin.amount.to_string(),
+        "denom": coin.denom,
+    })
+}
+
+fn encode_proto_coin(coin: Option<&ProtoCoin>) -> Value {
+    let mut value = Map::new();
+    value.insert(
+        "amount".into(),
+        json!(int_or_zero(coin.map_or("", |c| c.amount.as_str()))),
+    );
+    if let Some(coin) = coin {
+        insert_str(&mut value, "denom", &coin.denom);
+    }
+    Value::Object(value)
+}
+
+fn int_or_zero(amount: &str) -> &str {
+    if amount.is_empty() {
+        "0"
+    } else {
+        amount
+    }
+}
+
+fn insert_str(map: &mut Map<String, Value>, key: &str, value: &str) {
+    if !value.is_empty() {
+        map.insert(key.into(), json!(value));
+    }
+}
+
+fn insert_u64(map: &mut Map<String, Value>, key: &str, value: u64) {
+    if value != 0 {
+        map.insert(key.into(), json!(value.to_string()));
+    }
+}
+
+fn insert_bytes(map: &mut Map<String, Value>, key: &str, value: &[u8]) {
+    if !value.is_empty() {
+        map.insert(key.into(), json!(base64::encode(value)));
+    }
+}
+
+fn hex_upper(bytes: &[u8]) -> String {
+    bytes.iter().map(|b| format!("{:02X}", b)).collect()
+}
+
+/// Sorts object keys recursively, serde_json only keeps them sorted if its preserve_order
+/// feature isn't enabled by any crate in the build
+fn sorted(value: Value) -> Value {
+    match value {
+        Value::Object(map) => {
+            let mut entries: Vec<(String, Value)> = map.into_iter().collect();
+            entries.sort_by(|a, b| a.0.cmp(&b.0));
+            Value::Object(entries.into_iter().map(|(k, v)| (k, sorted(v))).collect())
+        }
+        Value::Array(values) => Value::Array(values.into_iter().map(sorted).collect()),
+        value => value,
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use gravity_proto::ToAny;
+
+    fn any<T: Message>(type_url: &str, msg: T) -> Any {
+        let mut value = Vec::new();
+        msg.encode(&mut value).unwrap();
+        Any {
+            type_url: type_url.into(),
+            value,
+        }
+    }
+
+    fn encode(msg: Any) -> String {
+        encode_msg(&msg).unwrap().to_string()
+    }
+
+    // the expected encodings are the sign bytes produced by the gravity module
+    #[test]
+    fn test_ethereum_event_sign_bytes() {
+        let event = proto::ContractCallExecutedEvent {
+            event_nonce: 4,
+            invalidation_scope: vec![0xab, 0xcd],
+            invalidation_nonce: 5,
+            ethereum_height: 13,
+            ethereum_confirmations: 0,
+        };
+        let msg = proto::MsgSubmitEthereumEvent {
+            event: event.to_any(),
+            signer: "cosmos1s".into(),
+            evm_chain_id: 0,
+        };
+        assert_eq!(
+            encode(any("/gravity.v1.MsgSubmitEthereumEvent", msg)),
+            r#"{"type":"gravity-bridge/MsgSubmitEthereumEvent","value":{"event":{"type":"gravity-bridge/ContractCallExecutedEvent","value":{"ethereum_height":"13","event_nonce":"4","invalidation_nonce":"5","invalidation_scope":"ABCD"}},"signer":"cosmos1s"}}"#
+        );
+
+        let event = proto::SignerSetTxExecutedEvent {
+            event_nonce: 5,
+            signer_set_tx_nonce: 6,
+            ethereum_height: 14,
+            members: vec![proto::EthereumSigner {
+                power: 7,
+                ethereum_address: "0x03".into(),
+            }],
+            ethereum_confirmations: 0,
+        };
+        let msg = proto::MsgSubmitEthereumEvent {
+            event: event.to_any(),
+            signer: "cosmos1s".into(),
+            evm_chain_id: 0,
+        };
+        assert_eq!(
+            encode(any("/gravity.v1.MsgSubmitEthereumEvent", msg)),
+            r#"{"type":"gravity-bridge/MsgSubmitEthereumEvent","value":{"event":{"type":"gravity-bridge/SignerSetTxExecutedEvent","value":{"ethereum_height":"14","event_nonce":"5","members":[{"ethereum_address":"0x03","power":"7"}],"signer_set_tx_nonce":"6"}},"signer":"cosmos1s"}}"#
+        );
+
+        let event = proto::SendToCosmosEvent {
+            event_nonce: 1,
+            token_contract: "0x01".into(),
+            amount: "100".into(),
+            ethereum_sender: "0x02".into(),
+            cosmos_receiver: "cosmos1x".into(),
+            ethereum_height: 10,
+            ethereum_confirmations: 6,
+            forward_evm_chain_id: 42161,
+            forward_ibc_channel: String::new(),
+        };
+        let msg = proto::MsgSubmitEthereumEvent {
+            event: event.to_any(),
+            signer: "cosmos1s".into(),
+            evm_chain_id: 0,
+        };
+        assert_eq!(
+            encode(any("/gravity.v1.MsgSubmitEthereumEvent", msg)),
+            r#"{"type":"gravity-bridge/MsgSubmitEthereumEvent","value":{"event":{"type":"gravity-bridge/SendToCosmosEvent","value":{"amount":"100","cosmos_receiver":"cosmos1x","ethereum_confirmations":"6","ethereum_height":"10","ethereum_sender":"0x02","event_nonce":"1","forward_evm_chain_id":"42161","token_contract":"0x01"}},"signer":"cosmos1s"}}"#
+        );
+
+        let event = proto::SendToCosmosEvent {
+            event_nonce: 1,
+            token_contract: "0x01".into(),
+            amount: "100".into(),
+            ethereum_sender: "0x02".into(),
+            cosmos_receiver: "cosmos1x".into(),
+            ethereum_height: 10,
+            ethereum_confirmations: 0,
+            forward_evm_chain_id: 0,
+            forward_ibc_channel: "channel-3".into(),
+        };
+        let msg = proto::MsgSubmitEthereumEvent {
+            event: event.to_any(),
+            signer: "cosmos1s".into(),
+            evm_chain_id: 0,
+        };
+        assert_eq!(
+            encode(any("/gravity.v1.MsgSubmitEthereumEvent", msg)),
+            r#"{"type":"gravity-bridge/MsgSubmitEthereumEvent","value":{"event":{"type":"gravity-bridge/SendToCosmosEvent","value":{"amount":"100","cosmos_receiver":"cosmos1x","ethereum_height":"10","ethereum_sender":"0x02","event_nonce":"1","forward_ibc_channel":"channel-3","token_contract":"0x01"}},"signer":"cosmos1s"}}"#
+        );
+
+        let event = proto::SendErc1155ToCosmosEvent {
+            event_nonce: 3,
+            token_contract: "0x01".into(),
+            amounts: vec![proto::Erc1155Amount {
+                id: "7".into(),
+                amount: "2".into(),
+            }],
+            ethereum_sender: "0x02".into(),
+            cosmos_receiver: "cosmos1x".into(),
+            ethereum_height: 12,
+            ethereum_confirmations: 6,
+        };
+        let msg = proto::MsgSubmitEthereumEvent {
+            event: event.to_any(),
+            signer: "cosmos1s".into(),
+            evm_chain_id: 0,
+        };
+        assert_eq!(
+            encode(any("/gravity.v1.MsgSubmitEthereumEvent", msg)),
+            r#"{"type":"gravity-bridge/MsgSubmitEthereumEvent","value":{"event":{"type":"gravity-bridge/SendERC1155ToCosmosEvent","value":{"amounts":[{"amount":"2","id":"7"}],"cosmos_receiver":"cosmos1x","ethereum_confirmations":"6","ethereum_height":"12","ethereum_sender":"0x02","event_nonce":"3","token_contract":"0x01"}},"signer":"cosmos1s"}}"#
+        );
+    }
+
+    #[test]
+    fn test_tx_confirmation_sign_bytes() {
+        let confirmation = proto::ContractCallTxConfirmation {
+            invalidation_scope: vec![0xab],
+            invalidation_nonce: 2,
+            ethereum_signer: "0x02".into(),
+            signature: vec![1, 2, 3],
+        };
+        let msg = proto::MsgSubmitEthereumTxConfirmation {
+            confirmation: confirmation.to_any(),
+            signer: "cosmos1s".into(),
+            evm_chain_id: 5,
+        };
+        assert_eq!(
+            encode(any("/gravity.v1.MsgSubmitEthereumTxConfirmation", msg)),
+            r#"{"type":"gravity-bridge/MsgSubmitEthereumTxConfirmation","value":{"confirmation":{"type":"gravity-bridge/ContractCallTxConfirmation","value":{"ethereum_signer":"0x02","invalidation_nonce":"2","invalidation_scope":"qw==","signature":"AQID"}},"evm_chain_id":"5","signer":"cosmos1s"}}"#
+        );
+    }
+
+    #[test]
+    fn test_encode_height_vote_finality() {
+        let msg = proto::MsgEthereumHeightVote {
+            ethereum_height: 100,
+            signer: "cosmos1s".into(),
+            evm_chain_id: 10,
+            finality: proto::Finality::Finalized as i32,
+        };
+        assert_eq!(
+            encode(any("/gravity.v1.MsgEthereumHeightVote", msg)),
+            r#"{"type":"gravity-bridge/MsgEthereumHeightVote","value":{"ethereum_height":"100","evm_chain_id":"10","finality":2,"signer":"cosmos1s"}}"#
+        );
+    }
+
+    #[test]
+    fn test_std_sign_bytes() {
+        let msg = proto::MsgEthereumHeightVote {
+            ethereum_height: 100,
+            signer: "cosmos1s".into(),
+            evm_chain_id: 0,
+            finality: 0,
+        };
+        let args = MessageArgs {
+            sequence: 2,
+            fee: deep_space::Fee {
+                amount: vec![Coin {
+                    amount: 10u8.into(),
+                    denom: "stake".into(),
+                }],
+                gas_limit: 200000,
+                granter: None,
+                payer: None,
+            },
+            timeout_height: 0,
+            chain_id: "chain".into(),
+            account_number: 1,
+        };
+        let sign_bytes = std_sign_bytes(
+            &[any("/gravity.v1.MsgEthereumHeightVote", msg)],
+            &args,
+            "memo",
+        )
+        .unwrap();
+        assert_eq!(
+            String::from_utf8(sign_bytes).unwrap(),
+            r#"{"account_number":"1","chain_id":"chain","fee":{"amount":[{"amount":"10","denom":"stake"}],"gas":"200000"},"memo":"memo","msgs":[{"type":"gravity-bridge/MsgEthereumHeightVote","value":{"ethereum_height":"100","signer":"cosmos1s"}}],"sequence":"2"}"#
+        );
+    }
+}
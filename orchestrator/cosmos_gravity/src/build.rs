@@ -5,9 +5,10 @@ use ethers::prelude::*;
 use ethers::utils::keccak256;
 use gravity_proto::gravity as proto;
 use gravity_proto::ToAny;
-use gravity_utils::ethereum::{downcast_to_u64, format_eth_address};
+use gravity_utils::ethereum::{bytes_to_hex_str, downcast_to_u64, format_eth_address};
 use gravity_utils::message_signatures::{
-    encode_logic_call_confirm, encode_tx_batch_confirm, encode_valset_confirm,
+    encode_erc1155_batch_confirm, encode_logic_call_confirm, encode_tx_batch_confirm,
+    encode_valset_confirm,
 };
 use gravity_utils::types::*;
 use lazy_static::lazy_static;
@@ -33,9 +34,20 @@ pub async fn signer_set_tx_confirmation_messages(
     let mut msgs = Vec::new();
     for valset in valsets {
         let data = keccak256(encode_valset_confirm(gravity_id.clone(), valset.clone()).as_slice());
-        // Signer trait responds with a Result, but we use a LocalWallet and it
-        // will never throw an error
-        let signature = eth_client.signer().sign_message(data).await.unwrap();
+        // remote signers may be unreachable, in which case we skip this confirmation
+        // and it will be retried on the next signer loop
+        let signature = match eth_client.signer().sign_message(data).await {
+            Ok(signature) => signature,
+            Err(e) => {
+                error!("Could not sign valset confirmation: {}", e);
+                continue;
+            }
+        };
+        debug!(
+            "Signed valset confirmation for valset_nonce={} checkpoint=0x{}",
+            valset.nonce,
+            bytes_to_hex_str(&data)
+        );
         let confirmation = proto::SignerSetTxConfirmation {
             ethereum_signer: format_eth_address(ethereum_address),
             signer_set_nonce: valset.nonce,
@@ -44,6 +56,7 @@ pub async fn signer_set_tx_confirmation_messages(
         let msg = proto::MsgSubmitEthereumTxConfirmation {
             signer: cosmos_address.to_string(),
             confirmation: confirmation.to_any(),
+            evm_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgSubmitEthereumTxConfirmation", msg);
         msgs.push(msg);
@@ -64,9 +77,21 @@ pub async fn batch_tx_confirmation_messages(
     let mut msgs = Vec::new();
     for batch in batches {
         let data = keccak256(encode_tx_batch_confirm(gravity_id.clone(), batch.clone()).as_slice());
-        // Signer trait responds with a Result, but we use a LocalWallet and it
-        // will never throw an error
-        let signature = eth_client.signer().sign_message(data).await.unwrap();
+        // remote signers may be unreachable, in which case we skip this confirmation
+        // and it will be retried on the next signer loop
+        let signature = match eth_client.signer().sign_message(data).await {
+            Ok(signature) => signature,
+            Err(e) => {
+                error!("Could not sign batch confirmation: {}", e);
+                continue;
+            }
+        };
+        debug!(
+            "Signed batch confirmation for token_contract={} batch_nonce={} checkpoint=0x{}",
+            format_eth_address(batch.token_contract),
+            batch.nonce,
+            bytes_to_hex_str(&data)
+        );
         let confirmation = proto::BatchTxConfirmation {
             token_contract: format_eth_address(batch.token_contract),
             batch_nonce: batch.nonce,
@@ -76,6 +101,53 @@ pub async fn batch_tx_confirmation_messages(
         let msg = proto::MsgSubmitEthereumTxConfirmation {
             signer: cosmos_address.to_string(),
             confirmation: confirmation.to_any(),
+            evm_chain_id: 0,
+        };
+        let msg = Msg::new("/gravity.v1.MsgSubmitEthereumTxConfirmation", msg);
+        msgs.push(msg);
+    }
+    msgs
+}
+
+pub async fn erc1155_batch_tx_confirmation_messages(
+    contact: &Contact,
+    eth_client: EthClient,
+    batches: Vec<Erc1155Batch>,
+    cosmos_key: CosmosPrivateKey,
+    gravity_id: String,
+) -> Vec<Msg> {
+    let cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
+    let ethereum_address = eth_client.address();
+
+    let mut msgs = Vec::new();
+    for batch in batches {
+        let data =
+            keccak256(encode_erc1155_batch_confirm(gravity_id.clone(), batch.clone()).as_slice());
+        // remote signers may be unreachable, in which case we skip this confirmation
+        // and it will be retried on the next signer loop
+        let signature = match eth_client.signer().sign_message(data).await {
+            Ok(signature) => signature,
+            Err(e) => {
+                error!("Could not sign ERC1155 batch confirmation: {}", e);
+                continue;
+            }
+        };
+        debug!(
+            "Signed ERC1155 batch confirmation for token_contract={} batch_nonce={} checkpoint=0x{}",
+            format_eth_address(batch.token_contract),
+            batch.nonce,
+            bytes_to_hex_str(&data)
+        );
+        let confirmation = proto::Erc1155BatchTxConfirmation {
+            token_contract: format_eth_address(batch.token_contract),
+            batch_nonce: batch.nonce,
+            ethereum_signer: format_eth_address(ethereum_address),
+            signature: signature.into(),
+        };
+        let msg = proto::MsgSubmitEthereumTxConfirmation {
+            signer: cosmos_address.to_string(),
+            confirmation: confirmation.to_any(),
+            evm_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgSubmitEthereumTxConfirmation", msg);
         msgs.push(msg);
@@ -97,9 +169,21 @@ pub async fn contract_call_tx_confirmation_messages(
     for logic_call in logic_calls {
         let data =
             keccak256(encode_logic_call_confirm(gravity_id.clone(), logic_call.clone()).as_slice());
-        // Signer trait responds with a Result, but we use a LocalWallet and it
-        // will never throw an error
-        let signature = eth_client.signer().sign_message(data).await.unwrap();
+        // remote signers may be unreachable, in which case we skip this confirmation
+        // and it will be retried on the next signer loop
+        let signature = match eth_client.signer().sign_message(data).await {
+            Ok(signature) => signature,
+            Err(e) => {
+                error!("Could not sign logic call confirmation: {}", e);
+                continue;
+            }
+        };
+        debug!(
+            "Signed logic call confirmation for invalidation_id={} invalidation_nonce={} checkpoint=0x{}",
+            bytes_to_hex_str(&logic_call.invalidation_id),
+            logic_call.invalidation_nonce,
+            bytes_to_hex_str(&data)
+        );
         let confirmation = proto::ContractCallTxConfirmation {
             ethereum_signer: format_eth_address(ethereum_address),
             signature: signature.into(),
@@ -109,6 +193,7 @@ pub async fn contract_call_tx_confirmation_messages(
         let msg = proto::MsgSubmitEthereumTxConfirmation {
             signer: cosmos_address.to_string(),
             confirmation: confirmation.to_any(),
+            evm_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgSubmitEthereumTxConfirmation", msg);
         msgs.push(msg);
@@ -120,12 +205,15 @@ pub async fn ethereum_vote_height_messages(
     contact: &Contact,
     cosmos_key: CosmosPrivateKey,
     ethereum_height: U64,
+    finality: proto::Finality,
 ) -> Vec<Msg> {
     let cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
 
     let msg = proto::MsgEthereumHeightVote {
         ethereum_height: ethereum_height.as_u64(),
         signer: cosmos_address.to_string(),
+        evm_chain_id: 0,
+        finality: finality as i32,
     };
     let msg = Msg::new("/gravity.v1.MsgEthereumHeightVote", msg);
 
@@ -135,6 +223,7 @@ pub async fn ethereum_vote_height_messages(
     msgs
 }
 
+#[allow(clippy::too_many_arguments)]
 pub fn ethereum_event_messages(
     contact: &Contact,
     cosmos_key: CosmosPrivateKey,
@@ -143,8 +232,16 @@ pub fn ethereum_event_messages(
     erc20_deploys: Vec<Erc20DeployedEvent>,
     logic_calls: Vec<LogicCallExecutedEvent>,
     valsets: Vec<ValsetUpdatedEvent>,
+    erc1155_deposits: Vec<SendErc1155ToCosmosEvent>,
+    erc1155_batches: Vec<Erc1155BatchExecutedEvent>,
+    contract_versions: Vec<ContractVersionEvent>,
+    chain_head: U256,
 ) -> Vec<Msg> {
     let cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
+    // the confirmations an event had at the chain head observed when it was found
+    let confirmations = |block_height: U256| {
+        downcast_to_u64(chain_head.saturating_sub(block_height)).unwrap_or(u64::MAX)
+    };
 
     // This sorts oracle messages by event nonce before submitting them. It's not a pretty implementation because
     // we're missing an intermediary layer of abstraction. We could implement 'EventTrait' and then implement sort
@@ -163,10 +260,14 @@ pub fn ethereum_event_messages(
             amount: deposit.amount.to_string(),
             cosmos_receiver: deposit.destination.to_string(),
             ethereum_sender: format_eth_address(deposit.sender),
+            ethereum_confirmations: confirmations(deposit.block_height),
+            forward_evm_chain_id: deposit.forward_evm_chain_id,
+            forward_ibc_channel: deposit.forward_ibc_channel.clone(),
         };
         let msg = proto::MsgSubmitEthereumEvent {
             signer: cosmos_address.to_string(),
             event: event.to_any(),
+            evm_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgSubmitEthereumEvent", msg);
         unordered_msgs.insert(deposit.event_nonce, msg);
@@ -177,10 +278,12 @@ pub fn ethereum_event_messages(
             batch_nonce: downcast_to_u64(batch.batch_nonce).unwrap(),
             ethereum_height: downcast_to_u64(batch.block_height).unwrap(),
             token_contract: format_eth_address(batch.erc20),
+            ethereum_confirmations: confirmations(batch.block_height),
         };
         let msg = proto::MsgSubmitEthereumEvent {
             signer: cosmos_address.to_string(),
             event: event.to_any(),
+            evm_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgSubmitEthereumEvent", msg);
         unordered_msgs.insert(batch.event_nonce, msg);
@@ -194,10 +297,12 @@ pub fn ethereum_event_messages(
             erc20_name: deploy.name,
             erc20_symbol: deploy.symbol,
             erc20_decimals: deploy.decimals as u64,
+            ethereum_confirmations: confirmations(deploy.block_height),
         };
         let msg = proto::MsgSubmitEthereumEvent {
             signer: cosmos_address.to_string(),
             event: event.to_any(),
+            evm_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgSubmitEthereumEvent", msg);
         unordered_msgs.insert(deploy.event_nonce, msg);
@@ -208,10 +313,12 @@ pub fn ethereum_event_messages(
             ethereum_height: downcast_to_u64(logic_call.block_height).unwrap(),
             invalidation_scope: logic_call.invalidation_id,
             invalidation_nonce: downcast_to_u64(logic_call.invalidation_nonce).unwrap(),
+            ethereum_confirmations: confirmations(logic_call.block_height),
         };
         let msg = proto::MsgSubmitEthereumEvent {
             signer: cosmos_address.to_string(),
             event: event.to_any(),
+            evm_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgSubmitEthereumEvent", msg);
         unordered_msgs.insert(logic_call.event_nonce, msg);
@@ -225,14 +332,72 @@ pub fn ethereum_event_messages(
             signer_set_tx_nonce: downcast_to_u64(valset.valset_nonce).unwrap(),
             ethereum_height: downcast_to_u64(valset.block_height).unwrap(),
             members: valset.members.iter().map(|v| v.into()).collect(),
+            ethereum_confirmations: confirmations(valset.block_height),
         };
         let msg = proto::MsgSubmitEthereumEvent {
             signer: cosmos_address.to_string(),
             event: event.to_any(),
+            evm_chain_id: 0,
         };
         let msg = Msg::new("/gravity.v1.MsgSubmitEthereumEvent", msg);
         unordered_msgs.insert(valset.event_nonce, msg);
     }
+    for deposit in erc1155_deposits {
+        let event = proto::SendErc1155ToCosmosEvent {
+            event_nonce: downcast_to_u64(deposit.event_nonce).unwrap(),
+            ethereum_height: downcast_to_u64(deposit.block_height).unwrap(),
+            token_contract: format_eth_address(deposit.token_contract),
+            amounts: deposit
+                .amounts
+                .iter()
+                .map(|(id, amount)| proto::Erc1155Amount {
+                    id: id.to_string(),
+                    amount: amount.to_string(),
+                })
+                .collect(),
+            cosmos_receiver: deposit.destination.to_string(),
+            ethereum_sender: format_eth_address(deposit.sender),
+            ethereum_confirmations: confirmations(deposit.block_height),
+        };
+        let msg = proto::MsgSubmitEthereumEvent {
+            signer: cosmos_address.to_string(),
+            event: event.to_any(),
+            evm_chain_id: 0,
+        };
+        let msg = Msg::new("/gravity.v1.MsgSubmitEthereumEvent", msg);
+        unordered_msgs.insert(deposit.event_nonce, msg);
+    }
+    for batch in erc1155_batches {
+        let event = proto::Erc1155BatchExecutedEvent {
+            event_nonce: downcast_to_u64(batch.event_nonce).unwrap(),
+            batch_nonce: downcast_to_u64(batch.batch_nonce).unwrap(),
+            ethereum_height: downcast_to_u64(batch.block_height).unwrap(),
+            token_contract: format_eth_address(batch.token_contract),
+            ethereum_confirmations: confirmations(batch.block_height),
+        };
+        let msg = proto::MsgSubmitEthereumEvent {
+            signer: cosmos_address.to_string(),
+            event: event.to_any(),
+            evm_chain_id: 0,
+        };
+        let msg = Msg::new("/gravity.v1.MsgSubmitEthereumEvent", msg);
+        unordered_msgs.insert(batch.event_nonce, msg);
+    }
+    for contract_version in contract_versions {
+        let event = proto::ContractVersionEvent {
+            event_nonce: downcast_to_u64(contract_version.event_nonce).unwrap(),
+            version: downcast_to_u64(contract_version.version).unwrap_or(u64::MAX),
+            ethereum_height: downcast_to_u64(contract_version.block_height).unwrap(),
+            ethereum_confirmations: confirmations(contract_version.block_height),
+        };
+        let msg = proto::MsgSubmitEthereumEvent {
+            signer: cosmos_address.to_string(),
+            event: event.to_any(),
+            evm_chain_id: 0,
+        };
+        let msg = Msg::new("/gravity.v1.MsgSubmitEthereumEvent", msg);
+        unordered_msgs.insert(contract_version.event_nonce, msg);
+    }
 
     let mut msgs = Vec::new();
     for (i, _) in unordered_msgs.clone().iter() {
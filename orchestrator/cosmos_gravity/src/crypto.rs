@@ -1,4 +1,20 @@
+use crate::amino;
+use async_trait::async_trait;
 use deep_space::private_key::TxParts;
+use deep_space::Contact;
+use gravity_proto::cosmos_sdk_proto::cosmos::base::v1beta1::Coin as ProtoCoin;
+use gravity_proto::cosmos_sdk_proto::cosmos::crypto::secp256k1::PubKey as ProtoPubKey;
+use gravity_proto::cosmos_sdk_proto::cosmos::tx::signing::v1beta1::SignMode;
+use gravity_proto::cosmos_sdk_proto::cosmos::tx::v1beta1::{
+    mode_info, service_client::ServiceClient as TxServiceClient, AuthInfo, Fee as ProtoFee,
+    ModeInfo, SignDoc, SignerInfo, SimulateRequest, Tx, TxBody, TxRaw,
+};
+use gravity_utils::error::GravityError;
+use prost::Message;
+use prost_types::Any;
+use ripemd160::Ripemd160;
+use sha2::{Digest, Sha256};
+use std::fmt::Debug;
 use std::str::FromStr;
 
 #[cfg(not(feature = "ethermint"))]
@@ -14,20 +30,36 @@ pub const DEFAULT_HD_PATH: &str = "m/44'/60'/0'/0/0";
 #[cfg(not(feature = "ethermint"))]
 pub const DEFAULT_HD_PATH: &str = "m/44'/118'/0'/0/0";
 
+/// The type url of the secp256k1 public key attached to transactions signed by external signers
+const SECP256K1_PUBKEY_URL: &str = "/cosmos.crypto.secp256k1.PubKey";
+
+/// ExternalSigner is implemented by the signing backends that hold the Cosmos key outside of
+/// the orchestrator process, such as a Ledger device or a remote signing service.
+#[async_trait]
+pub trait ExternalSigner: Debug + Send + Sync {
+    /// The compressed secp256k1 public key of the signing key
+    fn public_key(&self) -> Vec<u8>;
+
+    /// The sign mode the signer expects the sign bytes to be encoded in, Ledger devices only
+    /// support legacy amino JSON
+    fn sign_mode(&self) -> SignMode;
+
+    /// Signs the provided sign bytes, returning the 64 byte r || s signature
+    async fn sign(&self, sign_bytes: Vec<u8>) -> Result<Vec<u8>, GravityError>;
+}
+
 /// PrivateKey wraps cosmos private key, switch between cosmos and ethermint behavior according to cargo features.
+/// Keys held by an external signer are only supported for cosmos.
 #[derive(Debug, Copy, Clone)]
-pub struct PrivateKey(InnerPrivateKey);
+pub enum PrivateKey {
+    Local(InnerPrivateKey),
+    External(&'static dyn ExternalSigner),
+}
 
 impl FromStr for PrivateKey {
     type Err = PrivateKeyError;
     fn from_str(s: &str) -> Result<Self, Self::Err> {
-        InnerPrivateKey::from_str(s).map(Self)
-    }
-}
-
-impl Into<InnerPrivateKey> for PrivateKey {
-    fn into(self) -> InnerPrivateKey {
-        self.0
+        InnerPrivateKey::from_str(s).map(PrivateKey::Local)
     }
 }
 
@@ -37,7 +69,7 @@ impl PrivateKey {
         phrase: &str,
         passphrase: &str,
     ) -> Result<Self, PrivateKeyError> {
-        InnerPrivateKey::from_hd_wallet_path(hd_path, phrase, passphrase).map(Self)
+        InnerPrivateKey::from_hd_wallet_path(hd_path, phrase, passphrase).map(PrivateKey::Local)
     }
 
     pub fn from_phrase(phrase: &str, passphrase: &str) -> Result<Self, PrivateKeyError> {
@@ -48,48 +80,152 @@ impl PrivateKey {
     }
 
     pub fn from_secret(secret: &[u8]) -> Self {
-        Self(InnerPrivateKey::from_secret(secret))
+        PrivateKey::Local(InnerPrivateKey::from_secret(secret))
     }
 
-    pub fn to_address(&self, prefix: &str) -> Result<Address, PrivateKeyError> {
-        #[cfg(feature = "ethermint")]
-        let result = {
-            let pubkey = self.0.to_public_key("")?;
-            Ok(pubkey.to_ethermint_address_with_prefix(prefix)?)
-        };
-        #[cfg(not(feature = "ethermint"))]
-        let result = self.0.to_address(prefix);
+    /// Wraps an external signer. The signer is leaked so that the key stays Copy like a
+    /// local key and may be used for the lifetime of the process.
+    pub fn external(signer: impl ExternalSigner + 'static) -> Result<Self, GravityError> {
+        if cfg!(feature = "ethermint") {
+            return Err(GravityError::CosmosSignerError(
+                "external signers are not supported for ethermint keys".into(),
+            ));
+        }
+        Ok(PrivateKey::External(Box::leak(Box::new(signer))))
+    }
 
-        result
+    pub fn to_address(&self, prefix: &str) -> Result<Address, GravityError> {
+        match self {
+            PrivateKey::Local(key) => {
+                #[cfg(feature = "ethermint")]
+                let result = {
+                    let pubkey = key.to_public_key("")?;
+                    Ok(pubkey.to_ethermint_address_with_prefix(prefix)?)
+                };
+                #[cfg(not(feature = "ethermint"))]
+                let result = Ok(key.to_address(prefix)?);
+
+                result
+            }
+            PrivateKey::External(signer) => Ok(Address::from_slice(
+                &Ripemd160::digest(&Sha256::digest(&signer.public_key())),
+                prefix,
+            )?),
+        }
+    }
+
+    /// Simulates a transaction containing the messages, returning the gas it used
+    pub async fn simulate(
+        &self,
+        contact: &Contact,
+        messages: &[Msg],
+        args: MessageArgs,
+        memo: impl Into<String>,
+    ) -> Result<u64, GravityError> {
+        match self {
+            PrivateKey::Local(_) => {
+                let tx_parts = self.build_tx(messages, args, memo)?;
+                Ok(contact.simulate_tx(tx_parts).await?.gas_used)
+            }
+            PrivateKey::External(signer) => {
+                // the signature is not verified during simulation, so we avoid asking the
+                // signer (and possibly the Ledger's owner) to sign twice
+                let (body, auth_info) = unsigned_tx(*signer, messages, &args, memo.into());
+                let tx = Tx {
+                    body: Some(body),
+                    auth_info: Some(auth_info),
+                    signatures: vec![vec![0u8; 64]],
+                };
+                let mut client = TxServiceClient::connect(contact.get_url())
+                    .await
+                    .map_err(|e| GravityError::CosmosSignerError(e.to_string()))?;
+                let response = client
+                    .simulate(SimulateRequest {
+                        tx: Some(tx),
+                        ..Default::default()
+                    })
+                    .await?
+                    .into_inner();
+                Ok(response.gas_info.map(|g| g.gas_used).unwrap_or_default())
+            }
+        }
     }
 
-    pub fn sign_std_msg(
+    /// Signs a transaction containing the messages, returning the encoded TxRaw ready for
+    /// broadcasting
+    pub async fn sign_tx(
+        &self,
+        messages: &[Msg],
+        args: MessageArgs,
+        memo: impl Into<String>,
+    ) -> Result<Vec<u8>, GravityError> {
+        match self {
+            PrivateKey::Local(_) => Ok(self.sign_std_msg(messages, args, memo)?),
+            PrivateKey::External(signer) => {
+                let memo = memo.into();
+                let (body, auth_info) = unsigned_tx(*signer, messages, &args, memo.clone());
+                let body_bytes = encode(&body);
+                let auth_info_bytes = encode(&auth_info);
+
+                let sign_bytes = match signer.sign_mode() {
+                    SignMode::LegacyAminoJson => {
+                        let messages: Vec<Any> =
+                            messages.iter().map(|m| m.clone().into()).collect();
+                        amino::std_sign_bytes(&messages, &args, &memo)?
+                    }
+                    _ => encode(&SignDoc {
+                        body_bytes: body_bytes.clone(),
+                        auth_info_bytes: auth_info_bytes.clone(),
+                        chain_id: args.chain_id.clone(),
+                        account_number: args.account_number,
+                    }),
+                };
+                let signature = signer.sign(sign_bytes).await?;
+
+                Ok(encode(&TxRaw {
+                    body_bytes,
+                    auth_info_bytes,
+                    signatures: vec![signature],
+                }))
+            }
+        }
+    }
+
+    fn sign_std_msg(
         &self,
         messages: &[Msg],
         args: MessageArgs,
         memo: impl Into<String>,
     ) -> Result<Vec<u8>, PrivateKeyError> {
+        let key = match self {
+            PrivateKey::Local(key) => key,
+            PrivateKey::External(_) => unreachable!("external keys are signed by their signer"),
+        };
         #[cfg(feature = "ethermint")]
-        let result = self.0.sign_std_msg_ethermint(
+        let result = key.sign_std_msg_ethermint(
             messages,
             args,
             memo,
             "/ethermint.crypto.v1.ethsecp256k1.PubKey",
         );
         #[cfg(not(feature = "ethermint"))]
-        let result = self.0.sign_std_msg(messages, args, memo);
+        let result = key.sign_std_msg(messages, args, memo);
 
         result
     }
 
-    pub fn build_tx(
+    fn build_tx(
         &self,
         messages: &[Msg],
         args: MessageArgs,
         memo: impl Into<String>,
     ) -> Result<TxParts, PrivateKeyError> {
+        let key = match self {
+            PrivateKey::Local(key) => key,
+            PrivateKey::External(_) => unreachable!("external keys are signed by their signer"),
+        };
         #[cfg(feature = "ethermint")]
-        return self.0.build_tx(
+        return key.build_tx(
             messages,
             args,
             memo,
@@ -97,8 +233,62 @@ impl PrivateKey {
             SignType::Ethermint,
         );
         #[cfg(not(feature = "ethermint"))]
-        return self
-            .0
-            .build_tx(messages, args, memo, COSMOS_PUBKEY_URL, SignType::Cosmos);
+        return key.build_tx(messages, args, memo, COSMOS_PUBKEY_URL, SignType::Cosmos);
     }
 }
+
+/// Builds the body and auth info of a transaction signed by the external signer
+fn unsigned_tx(
+    signer: &dyn ExternalSigner,
+    messages: &[Msg],
+    args: &MessageArgs,
+    memo: String,
+) -> (TxBody, AuthInfo) {
+    let body = TxBody {
+        messages: messages.iter().map(|m| m.clone().into()).collect(),
+        memo,
+        timeout_height: args.timeout_height,
+        extension_options: Vec::new(),
+        non_critical_extension_options: Vec::new(),
+    };
+
+    let public_key = Any {
+        type_url: SECP256K1_PUBKEY_URL.into(),
+        value: encode(&ProtoPubKey {
+            key: signer.public_key(),
+        }),
+    };
+    let auth_info = AuthInfo {
+        signer_infos: vec![SignerInfo {
+            public_key: Some(public_key),
+            mode_info: Some(ModeInfo {
+                sum: Some(mode_info::Sum::Single(mode_info::Single {
+                    mode: signer.sign_mode() as i32,
+                })),
+            }),
+            sequence: args.sequence,
+        }],
+        fee: Some(ProtoFee {
+            amount: args
+                .fee
+                .amount
+                .iter()
+                .map(|c| ProtoCoin {
+                    denom: c.denom.clone(),
+                    amount: c.amount.to_string(),
+                })
+                .collect(),
+            gas_limit: args.fee.gas_limit,
+            payer: args.fee.payer.clone().unwrap_or_default(),
+            granter: args.fee.granter.clone().unwrap_or_default(),
+        }),
+    };
+
+    (body, auth_info)
+}
+
+fn encode(message: &impl Message) -> Vec<u8> {
+    let mut buf = Vec::with_capacity(message.encoded_len());
+    message.encode(&mut buf).expect("encoding failed");
+    buf
+}
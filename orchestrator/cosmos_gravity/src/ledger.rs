@@ -0,0 +1,275 @@
+//! A Cosmos signer backed by the Cosmos app of a Ledger device. The app only signs transactions
+//! in the legacy amino JSON sign mode, displaying them for the device's owner to approve.
+
+use crate::crypto::ExternalSigner;
+use async_trait::async_trait;
+use gravity_proto::cosmos_sdk_proto::cosmos::tx::signing::v1beta1::SignMode;
+use gravity_utils::error::GravityError;
+use ledger_transport::APDUCommand;
+use ledger_transport_hid::{hidapi::HidApi, TransportNativeHID};
+use std::fmt;
+use std::sync::{Arc, Mutex};
+
+const CLA_COSMOS: u8 = 0x55;
+const INS_SIGN_SECP256K1: u8 = 0x02;
+const INS_GET_ADDR_SECP256K1: u8 = 0x04;
+
+const P1_SIGN_INIT: u8 = 0x00;
+const P1_SIGN_ADD: u8 = 0x01;
+const P1_SIGN_LAST: u8 = 0x02;
+
+const APDU_CODE_OK: u16 = 0x9000;
+const CHUNK_SIZE: usize = 250;
+
+/// Half of the secp256k1 curve order, signatures with a larger s are rejected by the chain
+const HALF_ORDER: [u8; 32] = [
+    0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0x5d, 0x57, 0x6e, 0x73, 0x57, 0xa4, 0x50, 0x1d, 0xdf, 0xe9, 0x2f, 0x46, 0x68, 0x1b, 0x20, 0xa0,
+];
+const ORDER: [u8; 32] = [
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe,
+    0xba, 0xae, 0xdc, 0xe6, 0xaf, 0x48, 0xa0, 0x3b, 0xbf, 0xd2, 0x5e, 0x8c, 0xd0, 0x36, 0x41, 0x41,
+];
+
+pub struct LedgerSigner {
+    transport: Arc<Mutex<TransportNativeHID>>,
+    path: Vec<u8>,
+    public_key: Vec<u8>,
+}
+
+impl fmt::Debug for LedgerSigner {
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result {
+        f.debug_struct("LedgerSigner")
+            .field("public_key", &self.public_key)
+            .finish()
+    }
+}
+
+impl LedgerSigner {
+    /// Opens the first connected Ledger device and retrieves the public key at the
+    /// derivation path, the Cosmos app must be open on the device
+    pub fn connect(hd_path: &str, prefix: &str) -> Result<Self, GravityError> {
+        let path = serialize_path(hd_path)?;
+        let api = HidApi::new().map_err(ledger_error)?;
+        let transport = TransportNativeHID::new(&api).map_err(ledger_error)?;
+
+        let mut data = vec![prefix.len() as u8];
+        data.extend_from_slice(prefix.as_bytes());
+        data.extend_from_slice(&path);
+        let response = exchange(&transport, INS_GET_ADDR_SECP256K1, 0, data)?;
+        if response.len() < 33 {
+            return Err(GravityError::CosmosSignerError(
+                "Ledger returned a truncated public key".into(),
+            ));
+        }
+
+        Ok(LedgerSigner {
+            transport: Arc::new(Mutex::new(transport)),
+            path,
+            public_key: response[..33].to_vec(),
+        })
+    }
+}
+
+#[async_trait]
+impl ExternalSigner for LedgerSigner {
+    fn public_key(&self) -> Vec<u8> {
+        self.public_key.clone()
+    }
+
+    fn sign_mode(&self) -> SignMode {
+        SignMode::LegacyAminoJson
+    }
+
+    async fn sign(&self, sign_bytes: Vec<u8>) -> Result<Vec<u8>, GravityError> {
+        let transport = self.transport.clone();
+        let path = self.path.clone();
+        // the device blocks until its owner approves the transaction
+        let der = tokio::task::spawn_blocking(move || {
+            let transport = transport.lock().unwrap();
+            exchange(&transport, INS_SIGN_SECP256K1, P1_SIGN_INIT, path)?;
+            let chunks: Vec<&[u8]> = sign_bytes.chunks(CHUNK_SIZE).collect();
+            let mut response = Vec::new();
+            for (i, chunk) in chunks.iter().enumerate() {
+                let p1 = if i == chunks.len() - 1 {
+                    P1_SIGN_LAST
+                } else {
+                    P1_SIGN_ADD
+                };
+                response = exchange(&transport, INS_SIGN_SECP256K1, p1, chunk.to_vec())?;
+            }
+            Ok::<_, GravityError>(response)
+        })
+        .await
+        .map_err(|e| GravityError::CosmosSignerError(e.to_string()))??;
+
+        der_to_compact(&der)
+    }
+}
+
+fn exchange(
+    transport: &TransportNativeHID,
+    ins: u8,
+    p1: u8,
+    data: Vec<u8>,
+) -> Result<Vec<u8>, GravityError> {
+    let command = APDUCommand {
+        cla: CLA_COSMOS,
+        ins,
+        p1,
+        p2: 0,
+        data,
+    };
+    let answer = transport.exchange(&command).map_err(ledger_error)?;
+    if answer.retcode() != APDU_CODE_OK {
+        return Err(GravityError::CosmosSignerError(format!(
+            "Ledger returned error code {:#06x}",
+            answer.retcode()
+        )));
+    }
+    Ok(answer.data().to_vec())
+}
+
+fn ledger_error(error: impl fmt::Display) -> GravityError {
+    GravityError::CosmosSignerError(format!("Ledger error: {}", error))
+}
+
+/// Serializes a BIP44 derivation path such as m/44'/118'/0'/0/0 the way the Cosmos app
+/// expects it, as five little endian u32s
+fn serialize_path(hd_path: &str) -> Result<Vec<u8>, GravityError> {
+    let invalid = || GravityError::CosmosSignerError(format!("invalid HD path {}", hd_path));
+
+    let components: Vec<&str> = hd_path.trim_start_matches("m/").split('/').collect();
+    if components.len() != 5 {
+        return Err(invalid());
+    }
+    let mut path = Vec::with_capacity(20);
+    for component in components {
+        let (index, hardened) = match component.strip_suffix('\'') {
+            Some(index) => (index, 0x8000_0000),
+            None => (component, 0),
+        };
+        let index: u32 = index.parse().map_err(|_| invalid())?;
+        if index >= 0x8000_0000 {
+            return Err(invalid());
+        }
+        path.extend_from_slice(&(index | hardened).to_le_bytes());
+    }
+    Ok(path)
+}
+
+/// Converts the DER signature returned by the device into the 64 byte r || s form used by
+/// Cosmos transactions, normalizing s to the lower half of the curve order
+fn der_to_compact(der: &[u8]) -> Result<Vec<u8>, GravityError> {
+    let invalid = || GravityError::CosmosSignerError("Ledger returned an invalid signature".into());
+
+    if der.len() < 8 || der[0] != 0x30 || der[1] as usize != der.len() - 2 {
+        return Err(invalid());
+    }
+    let (r, rest) = der_integer(&der[2..]).ok_or_else(invalid)?;
+    let (s, rest) = der_integer(rest).ok_or_else(invalid)?;
+    if !rest.is_empty() {
+        return Err(invalid());
+    }
+
+    let mut s = s;
+    if s > HALF_ORDER {
+        s = sub(&ORDER, &s);
+    }
+
+    let mut signature = r.to_vec();
+    signature.extend_from_slice(&s);
+    Ok(signature)
+}
+
+/// Parses a DER encoded integer of at most 32 bytes, returning it left padded to 32 bytes
+fn der_integer(der: &[u8]) -> Option<([u8; 32], &[u8])> {
+    if der.len() < 2 || der[0] != 0x02 {
+        return None;
+    }
+    let len = der[1] as usize;
+    let rest = der.get(2..)?;
+    let mut value = rest.get(..len)?;
+    // a leading zero byte keeps integers with the high bit set positive
+    while value.len() > 32 && value[0] == 0 {
+        value = &value[1..];
+    }
+    if value.len() > 32 {
+        return None;
+    }
+    let mut padded = [0u8; 32];
+    padded[32 - value.len()..].copy_from_slice(value);
+    Some((padded, &rest[len..]))
+}
+
+/// Big endian subtraction a - b, a must not be smaller than b
+fn sub(a: &[u8; 32], b: &[u8; 32]) -> [u8; 32] {
+    let mut result = [0u8; 32];
+    let mut borrow = 0i16;
+    for i in (0..32).rev() {
+        let mut difference = a[i] as i16 - b[i] as i16 - borrow;
+        borrow = 0;
+        if difference < 0 {
+            difference += 256;
+            borrow = 1;
+        }
+        result[i] = difference as u8;
+    }
+    result
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_serialize_path() {
+        assert_eq!(
+            serialize_path("m/44'/118'/0'/0/3").unwrap(),
+            vec![44, 0, 0, 0x80, 118, 0, 0, 0x80, 0, 0, 0, 0x80, 0, 0, 0, 0, 3, 0, 0, 0]
+        );
+        assert!(serialize_path("m/44'/118'/0'/0").is_err());
+        assert!(serialize_path("m/44'/118'/0'/0/x").is_err());
+    }
+
+    #[test]
+    fn test_der_to_compact() {
+        // r has its high bit set and so carries a leading zero byte
+        let mut r = vec![0x00, 0x80];
+        r.extend_from_slice(&[0x11; 31]);
+        let s = vec![0x01; 32];
+        let mut der = vec![0x30, (4 + r.len() + s.len()) as u8, 0x02, r.len() as u8];
+        der.extend_from_slice(&r);
+        der.extend_from_slice(&[0x02, s.len() as u8]);
+        der.extend_from_slice(&s);
+
+        let signature = der_to_compact(&der).unwrap();
+        assert_eq!(&signature[..32], &r[1..]);
+        assert_eq!(&signature[32..], &s[..]);
+    }
+
+    #[test]
+    fn test_der_to_compact_normalizes_s() {
+        let r = vec![0x01; 32];
+        // n - 1 is a high s, its low counterpart is 1
+        let mut s = vec![0x00];
+        let mut high = ORDER;
+        high[31] -= 1;
+        s.extend_from_slice(&high);
+        let mut der = vec![0x30, (4 + r.len() + s.len()) as u8, 0x02, r.len() as u8];
+        der.extend_from_slice(&r);
+        der.extend_from_slice(&[0x02, s.len() as u8]);
+        der.extend_from_slice(&s);
+
+        let signature = der_to_compact(&der).unwrap();
+        let mut one = [0u8; 32];
+        one[31] = 1;
+        assert_eq!(&signature[32..], &one[..]);
+    }
+
+    #[test]
+    fn test_der_to_compact_rejects_garbage() {
+        assert!(der_to_compact(&[0x30, 0x02, 0x02, 0x00]).is_err());
+        assert!(der_to_compact(&[0x31; 72]).is_err());
+    }
+}
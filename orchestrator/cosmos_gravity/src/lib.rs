@@ -6,8 +6,11 @@
 #[macro_use]
 extern crate log;
 
+pub mod amino;
 pub mod build;
 pub mod crypto;
+pub mod ledger;
 pub mod query;
+pub mod remote_signer;
 pub mod send;
 pub mod utils;
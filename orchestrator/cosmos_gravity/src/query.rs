@@ -15,6 +15,7 @@ pub async fn get_valset(
     let response = client
         .signer_set_tx(SignerSetTxRequest {
             signer_set_nonce: nonce,
+            evm_chain_id: 0,
         })
         .await?;
     let valset = response.into_inner().signer_set.map(Into::into);
@@ -30,6 +31,7 @@ pub async fn get_oldest_unsigned_valsets(
     let response = client
         .unsigned_signer_set_txs(UnsignedSignerSetTxsRequest {
             address: address.to_string(),
+            evm_chain_id: 0,
         })
         .await?;
     let valsets = response.into_inner().signer_sets;
@@ -44,7 +46,7 @@ pub async fn get_latest_valset(
     client: &mut GravityQueryClient<Channel>,
 ) -> Result<Option<Valset>, GravityError> {
     let response = client
-        .latest_signer_set_tx(LatestSignerSetTxRequest {})
+        .latest_signer_set_tx(LatestSignerSetTxRequest { evm_chain_id: 0 })
         .await?;
     let valset = response.into_inner().signer_set.map(Into::into);
     Ok(valset)
@@ -58,6 +60,7 @@ pub async fn get_all_valset_confirms(
     let request = client
         .signer_set_tx_confirmations(SignerSetTxConfirmationsRequest {
             signer_set_nonce: nonce,
+            evm_chain_id: 0,
         })
         .await?;
     let confirms = request.into_inner().signatures;
@@ -72,12 +75,7 @@ pub async fn get_oldest_unsigned_transaction_batch(
     client: &mut GravityQueryClient<Channel>,
     address: Address,
 ) -> Result<Option<TransactionBatch>, GravityError> {
-    let request = client
-        .unsigned_batch_txs(UnsignedBatchTxsRequest {
-            address: address.to_string(),
-        })
-        .await?;
-    let batches = extract_valid_batches(request.into_inner().batches);
+    let batches = get_unsigned_transaction_batches(client, address).await?;
     let batch = batches.get(0);
     match batch {
         Some(batch) => Ok(Some(batch.clone())),
@@ -85,13 +83,30 @@ pub async fn get_oldest_unsigned_transaction_batch(
     }
 }
 
+/// gets all of the transaction batches the provided address has not yet signed, oldest first
+pub async fn get_unsigned_transaction_batches(
+    client: &mut GravityQueryClient<Channel>,
+    address: Address,
+) -> Result<Vec<TransactionBatch>, GravityError> {
+    let request = client
+        .unsigned_batch_txs(UnsignedBatchTxsRequest {
+            address: address.to_string(),
+            evm_chain_id: 0,
+        })
+        .await?;
+    Ok(extract_valid_batches(request.into_inner().batches))
+}
+
 /// gets the latest 100 transaction batches, regardless of token type
 /// for relayers to consider relaying
 pub async fn get_latest_transaction_batches(
     client: &mut GravityQueryClient<Channel>,
 ) -> Result<Vec<TransactionBatch>, GravityError> {
     let request = client
-        .batch_txs(BatchTxsRequest { pagination: None })
+        .batch_txs(BatchTxsRequest {
+            pagination: None,
+            evm_chain_id: 0,
+        })
         .await?;
     Ok(extract_valid_batches(request.into_inner().batches))
 }
@@ -119,6 +134,7 @@ pub async fn get_transaction_batch_signatures(
         .batch_tx_confirmations(BatchTxConfirmationsRequest {
             batch_nonce: nonce,
             token_contract: format_eth_address(contract_address),
+            evm_chain_id: 0,
         })
         .await?;
     let batch_confirms = request.into_inner().signatures;
@@ -129,6 +145,65 @@ pub async fn get_transaction_batch_signatures(
     Ok(out)
 }
 
+/// gets all of the erc1155 batches the provided address has not yet signed, oldest first
+pub async fn get_unsigned_erc1155_batches(
+    client: &mut GravityQueryClient<Channel>,
+    address: Address,
+) -> Result<Vec<Erc1155Batch>, GravityError> {
+    let request = client
+        .unsigned_erc1155_batch_txs(UnsignedErc1155BatchTxsRequest {
+            address: address.to_string(),
+            evm_chain_id: 0,
+        })
+        .await?;
+    Ok(extract_valid_erc1155_batches(request.into_inner().batches))
+}
+
+/// gets the pending erc1155 batches for relayers to consider relaying
+pub async fn get_latest_erc1155_batches(
+    client: &mut GravityQueryClient<Channel>,
+) -> Result<Vec<Erc1155Batch>, GravityError> {
+    let request = client
+        .erc1155_batch_txs(Erc1155BatchTxsRequest {
+            pagination: None,
+            evm_chain_id: 0,
+        })
+        .await?;
+    Ok(extract_valid_erc1155_batches(request.into_inner().batches))
+}
+
+fn extract_valid_erc1155_batches(batches: Vec<Erc1155BatchTx>) -> Vec<Erc1155Batch> {
+    let mut valid_batches = Vec::new();
+    for batch in batches {
+        match Erc1155Batch::from_proto(batch.clone()) {
+            Ok(valid_batch) => valid_batches.push(valid_batch),
+            Err(e) => warn!("{}, skipping invalid erc1155 batch: {:?}", e, batch),
+        }
+    }
+    valid_batches
+}
+
+/// get all erc1155 batch confirmations for a given nonce and token contract
+pub async fn get_erc1155_batch_signatures(
+    client: &mut GravityQueryClient<Channel>,
+    nonce: u64,
+    contract_address: EthAddress,
+) -> Result<Vec<Erc1155BatchConfirmResponse>, GravityError> {
+    let request = client
+        .erc1155_batch_tx_confirmations(Erc1155BatchTxConfirmationsRequest {
+            batch_nonce: nonce,
+            token_contract: format_eth_address(contract_address),
+            evm_chain_id: 0,
+        })
+        .await?;
+    let batch_confirms = request.into_inner().signatures;
+    let mut out = Vec::new();
+    for confirm in batch_confirms {
+        out.push(Erc1155BatchConfirmResponse::from_proto(confirm)?)
+    }
+    Ok(out)
+}
+
 /// Gets the last event nonce that a given validator has attested to, this lets us
 /// catch up with what the current event nonce should be if a oracle is restarted
 pub async fn get_last_event_nonce(
@@ -138,17 +213,71 @@ pub async fn get_last_event_nonce(
     let request = client
         .last_submitted_ethereum_event(LastSubmittedEthereumEventRequest {
             address: address.to_string(),
+            evm_chain_id: 0,
         })
         .await?;
     Ok(request.into_inner().event_nonce)
 }
 
+/// Gets the Gravity contract the chain currently bridges through and its bridging
+/// epoch, the address is empty if governance never set one
+pub async fn get_bridge_contract(
+    client: &mut GravityQueryClient<Channel>,
+) -> Result<BridgeContract, GravityError> {
+    let request = client
+        .bridge_contract(BridgeContractRequest { evm_chain_id: 0 })
+        .await?;
+    Ok(request.into_inner().bridge_contract.unwrap_or_default())
+}
+
+/// Gets the last event nonce the chain observed from the Gravity contract
+pub async fn get_last_observed_event_nonce(
+    client: &mut GravityQueryClient<Channel>,
+) -> Result<u64, GravityError> {
+    let request = client.evm_chains(EvmChainsRequest {}).await?;
+    // the default chain comes first
+    Ok(request
+        .into_inner()
+        .chains
+        .into_iter()
+        .next()
+        .map(|status| status.last_observed_event_nonce)
+        .unwrap_or_default())
+}
+
+/// Gets the params of the gravity module
+pub async fn get_gravity_params(
+    client: &mut GravityQueryClient<Channel>,
+) -> Result<Params, GravityError> {
+    let request = client.params(ParamsRequest {}).await?;
+    Ok(request.into_inner().params.unwrap_or_default())
+}
+
+/// Gets the decimals of the chain's native gas token, zero for the usual 18
+pub async fn get_native_decimals(
+    client: &mut GravityQueryClient<Channel>,
+) -> Result<u32, GravityError> {
+    let request = client.evm_chains(EvmChainsRequest {}).await?;
+    // the default chain comes first
+    Ok(request
+        .into_inner()
+        .chains
+        .into_iter()
+        .next()
+        .and_then(|status| status.chain)
+        .map(|chain| chain.native_decimals)
+        .unwrap_or_default())
+}
+
 /// Gets the 100 latest logic calls for a relayer to consider relaying
 pub async fn get_latest_logic_calls(
     client: &mut GravityQueryClient<Channel>,
 ) -> Result<Vec<LogicCall>, GravityError> {
     let request = client
-        .contract_call_txs(ContractCallTxsRequest { pagination: None })
+        .contract_call_txs(ContractCallTxsRequest {
+            pagination: None,
+            evm_chain_id: 0,
+        })
         .await?;
     let calls = request.into_inner().calls;
     let mut out = Vec::new();
@@ -174,6 +303,7 @@ pub async fn get_logic_call_signatures(
         .contract_call_tx_confirmations(ContractCallTxConfirmationsRequest {
             invalidation_scope,
             invalidation_nonce,
+            evm_chain_id: 0,
         })
         .await?;
     let call_confirms = request.into_inner().signatures;
@@ -191,6 +321,7 @@ pub async fn get_oldest_unsigned_logic_call(
     let request = client
         .unsigned_contract_call_txs(UnsignedContractCallTxsRequest {
             address: address.to_string(),
+            evm_chain_id: 0,
         })
         .await?;
     let calls = request.into_inner().calls;
@@ -201,6 +332,47 @@ pub async fn get_oldest_unsigned_logic_call(
     Ok(out)
 }
 
+/// Gets the Cosmos heights the signer set txs, and the batches and logic calls, the provided
+/// address has not yet signed were created at, the validator is slashed for those left
+/// unsigned past the signing windows
+pub async fn get_unsigned_outgoing_tx_heights(
+    client: &mut GravityQueryClient<Channel>,
+    address: Address,
+) -> Result<(Vec<u64>, Vec<u64>), GravityError> {
+    let signer_sets = client
+        .unsigned_signer_set_txs(UnsignedSignerSetTxsRequest {
+            address: address.to_string(),
+            evm_chain_id: 0,
+        })
+        .await?
+        .into_inner()
+        .signer_sets;
+    let batches = client
+        .unsigned_batch_txs(UnsignedBatchTxsRequest {
+            address: address.to_string(),
+            evm_chain_id: 0,
+        })
+        .await?
+        .into_inner()
+        .batches;
+    let calls = client
+        .unsigned_contract_call_txs(UnsignedContractCallTxsRequest {
+            address: address.to_string(),
+            evm_chain_id: 0,
+        })
+        .await?
+        .into_inner()
+        .calls;
+
+    let signer_set_heights = signer_sets.iter().map(|s| s.height).collect();
+    let outgoing_tx_heights = batches
+        .iter()
+        .map(|b| b.height)
+        .chain(calls.iter().map(|c| c.height))
+        .collect();
+    Ok((signer_set_heights, outgoing_tx_heights))
+}
+
 #[test]
 fn extract_valid_batches_test() {
     let erc20_addr = "0x0635FF793Edf48cf5dB294916720A78e6e490E40".to_string();
@@ -217,6 +389,7 @@ fn extract_valid_batches_test() {
             contract: erc20_addr,
             amount: "1".to_string(),
         }),
+        evm_chain_id: 0,
     }];
 
     let valid_batch = BatchTx {
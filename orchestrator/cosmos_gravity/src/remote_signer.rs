@@ -0,0 +1,77 @@
+//! A Cosmos signer backed by a remote service implementing the gravity.signer.v1.Signer gRPC
+//! service, the orchestrator's Cosmos key then never touches the machine doing the relaying.
+
+use crate::crypto::ExternalSigner;
+use async_trait::async_trait;
+use gravity_proto::cosmos_sdk_proto::cosmos::tx::signing::v1beta1::SignMode;
+use gravity_proto::signer::{signer_client::SignerClient, PublicKeyRequest, SignRequest};
+use gravity_utils::error::GravityError;
+use tonic::transport::Channel;
+
+#[derive(Debug)]
+pub struct RemoteSigner {
+    client: SignerClient<Channel>,
+    key_name: String,
+    public_key: Vec<u8>,
+}
+
+impl RemoteSigner {
+    /// Connects to the signer at the url and retrieves the public key of the named key
+    pub async fn connect(url: String, key_name: String) -> Result<Self, GravityError> {
+        let mut client = SignerClient::connect(url.clone()).await.map_err(|e| {
+            GravityError::CosmosSignerError(format!("could not connect to {}: {}", url, e))
+        })?;
+        let public_key = client
+            .public_key(PublicKeyRequest {
+                key_name: key_name.clone(),
+            })
+            .await?
+            .into_inner()
+            .public_key;
+        if public_key.len() != 33 {
+            return Err(GravityError::CosmosSignerError(format!(
+                "expected a 33 byte compressed public key for {}, got {} bytes",
+                key_name,
+                public_key.len()
+            )));
+        }
+
+        Ok(RemoteSigner {
+            client,
+            key_name,
+            public_key,
+        })
+    }
+}
+
+#[async_trait]
+impl ExternalSigner for RemoteSigner {
+    fn public_key(&self) -> Vec<u8> {
+        self.public_key.clone()
+    }
+
+    fn sign_mode(&self) -> SignMode {
+        SignMode::Direct
+    }
+
+    async fn sign(&self, sign_bytes: Vec<u8>) -> Result<Vec<u8>, GravityError> {
+        // the channel is cheap to clone and multiplexes requests
+        let mut client = self.client.clone();
+        let signature = client
+            .sign(SignRequest {
+                key_name: self.key_name.clone(),
+                sign_bytes,
+            })
+            .await?
+            .into_inner()
+            .signature;
+        if signature.len() != 64 {
+            return Err(GravityError::CosmosSignerError(format!(
+                "expected a 64 byte signature from the remote signer, got {} bytes",
+                signature.len()
+            )));
+        }
+
+        Ok(signature)
+    }
+}
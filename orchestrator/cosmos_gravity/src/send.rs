@@ -11,18 +11,27 @@ use ethers::utils::keccak256;
 use gravity_proto::cosmos_sdk_proto::cosmos::base::abci::v1beta1::TxResponse;
 use gravity_proto::cosmos_sdk_proto::cosmos::tx::v1beta1::BroadcastMode;
 use gravity_proto::gravity as proto;
+use gravity_utils::connection_prep::{updated_endpoints, Endpoints};
 use gravity_utils::error::GravityError;
-use gravity_utils::ethereum::format_eth_address;
+use gravity_utils::ethereum::{bytes_to_hex_str, format_eth_address};
+use gravity_utils::metrics;
 use prost::Message;
 use std::cmp;
 use std::collections::HashSet;
 use std::{result::Result, time::Duration};
+use tokio::sync::watch;
+use tokio::time::sleep as delay_for;
 
 use crate::crypto::PrivateKey as CosmosPrivateKey;
 
 pub const MEMO: &str = "Sent using Gravity Bridge Orchestrator";
 pub const TIMEOUT: Duration = Duration::from_secs(60);
 
+/// the number of times a transaction is attempted before giving up on it
+const MAX_SEND_ATTEMPTS: u32 = 5;
+const INITIAL_BACKOFF: Duration = Duration::from_secs(1);
+const MAX_BACKOFF: Duration = Duration::from_secs(30);
+
 /// Send a transaction updating the eth address for the sending
 /// Cosmos address. The sending Cosmos address should be a validator
 pub async fn update_gravity_delegate_addresses(
@@ -97,6 +106,7 @@ pub async fn send_to_eth(
         ethereum_recipient: format_eth_address(destination),
         amount: Some(amount.into()),
         bridge_fee: Some(bridge_fee.clone().into()),
+        evm_chain_id: 0,
     };
     let msg = Msg::new("/gravity.v1.MsgSendToEthereum", msg);
     send_messages(contact, cosmos_key, gas_price, vec![msg], gas_adjustment).await
@@ -108,6 +118,57 @@ pub async fn send_messages(
     gas_price: (f64, String),
     messages: Vec<Msg>,
     gas_adjustment: f64,
+) -> Result<TxResponse, GravityError> {
+    send_messages_with_sequence(
+        contact,
+        cosmos_key,
+        gas_price,
+        messages,
+        gas_adjustment,
+        &mut SequenceManager::new(),
+    )
+    .await
+}
+
+/// Tracks the sequence of the sending account locally so that a transaction can be
+/// broadcast while the previous one is still in the mempool, rather than reusing the
+/// committed sequence reported by the chain and failing with a sequence mismatch
+#[derive(Debug, Default)]
+pub struct SequenceManager {
+    next_sequence: Option<u64>,
+}
+
+impl SequenceManager {
+    pub fn new() -> Self {
+        Self::default()
+    }
+
+    /// Returns the sequence to use given the committed sequence reported by the chain
+    fn next(&self, chain_sequence: u64) -> u64 {
+        match self.next_sequence {
+            Some(sequence) if sequence > chain_sequence => sequence,
+            _ => chain_sequence,
+        }
+    }
+
+    /// Records that a transaction with the given sequence was accepted into the mempool
+    fn advance(&mut self, used_sequence: u64) {
+        self.next_sequence = Some(used_sequence + 1);
+    }
+
+    /// Discards the local sequence so the next transaction resyncs with the chain
+    fn reset(&mut self) {
+        self.next_sequence = None;
+    }
+}
+
+pub async fn send_messages_with_sequence(
+    contact: &Contact,
+    cosmos_key: CosmosPrivateKey,
+    gas_price: (f64, String),
+    messages: Vec<Msg>,
+    gas_adjustment: f64,
+    sequences: &mut SequenceManager,
 ) -> Result<TxResponse, GravityError> {
     let cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
 
@@ -124,12 +185,15 @@ pub async fn send_messages(
     };
 
     let mut args = contact.get_message_args(cosmos_address, fee).await?;
+    args.sequence = sequences.next(args.sequence);
+    let sequence = args.sequence;
 
-    let tx_parts = cosmos_key.build_tx(&messages, args.clone(), MEMO)?;
-    let gas = contact.simulate_tx(tx_parts).await?;
+    let gas_used = cosmos_key
+        .simulate(contact, &messages, args.clone(), MEMO)
+        .await?;
 
     // multiply the estimated gas by the configured gas adjustment
-    let gas_limit: f64 = (gas.gas_used as f64) * gas_adjustment;
+    let gas_limit: f64 = (gas_used as f64) * gas_adjustment;
     args.fee.gas_limit = cmp::max(gas_limit as u64, 500000 * messages.len() as u64);
 
     // compute the fee as fee=ceil(gas_limit * gas_price)
@@ -141,14 +205,73 @@ pub async fn send_messages(
     };
     args.fee.amount = vec![fee_amount];
 
-    let msg_bytes = cosmos_key.sign_std_msg(&messages, args, MEMO)?;
+    let msg_bytes = cosmos_key.sign_tx(&messages, args, MEMO).await?;
     let response = contact
         .send_transaction(msg_bytes, BroadcastMode::Sync)
         .await?;
+    sequences.advance(sequence);
 
     Ok(contact.wait_for_tx(response, TIMEOUT).await?)
 }
 
+/// Sends the messages, retrying with exponential backoff when the transaction is
+/// rejected by the mempool. A sequence mismatch resyncs the local sequence with the
+/// chain before the next attempt.
+pub async fn send_messages_with_backoff(
+    contact: &Contact,
+    cosmos_key: CosmosPrivateKey,
+    gas_price: (f64, String),
+    messages: Vec<Msg>,
+    gas_adjustment: f64,
+    sequences: &mut SequenceManager,
+) -> Result<TxResponse, GravityError> {
+    let mut backoff = INITIAL_BACKOFF;
+    let mut attempt = 1;
+    loop {
+        let err = match send_messages_with_sequence(
+            contact,
+            cosmos_key,
+            gas_price.to_owned(),
+            messages.clone(),
+            gas_adjustment,
+            sequences,
+        )
+        .await
+        {
+            Ok(res) => return Ok(res),
+            Err(err) => err,
+        };
+
+        let err_msg = format!("{:?}", err);
+        if is_sequence_mismatch(&err_msg) {
+            sequences.reset();
+        }
+        if attempt >= MAX_SEND_ATTEMPTS || !is_retryable(&err_msg) {
+            return Err(err);
+        }
+
+        warn!(
+            "Cosmos transaction rejected on attempt {}, retrying in {:?}: {}",
+            attempt, backoff, err_msg
+        );
+        delay_for(backoff).await;
+        backoff = cmp::min(backoff * 2, MAX_BACKOFF);
+        attempt += 1;
+    }
+}
+
+fn is_sequence_mismatch(err_msg: &str) -> bool {
+    err_msg.contains("account sequence mismatch") || err_msg.contains("incorrect account sequence")
+}
+
+fn is_retryable(err_msg: &str) -> bool {
+    is_sequence_mismatch(err_msg) || err_msg.contains("mempool is full")
+}
+
+/// Sends the messages received on rx to Cosmos. In dry run mode the messages are only
+/// logged and nothing is sent. If an endpoints channel is provided, messages received after
+/// new endpoints were sent on it are sent through the new Cosmos gRPC endpoint.
+#[allow(clippy::too_many_arguments)]
 pub async fn send_main_loop(
     contact: &Contact,
     cosmos_key: CosmosPrivateKey,
@@ -156,16 +279,36 @@ pub async fn send_main_loop(
     mut rx: tokio::sync::mpsc::Receiver<Vec<Msg>>,
     gas_adjustment: f64,
     msg_batch_size: usize,
+    dry_run: bool,
+    mut endpoint_updates: Option<watch::Receiver<Endpoints>>,
 ) {
-    while let Some(messages) = rx.recv().await {
+    let mut contact = contact.clone();
+    let mut sequences = SequenceManager::new();
+    while let Some(mut messages) = rx.recv().await {
+        if let Some(endpoints) = updated_endpoints(&mut endpoint_updates) {
+            contact = endpoints.contact;
+        }
+
+        // pick up everything queued while the previous transaction was waiting to be
+        // included in a block so it is submitted in as few transactions as possible
+        while let Ok(more) = rx.try_recv() {
+            messages.extend(more);
+        }
+
+        if dry_run {
+            log_dry_run(&messages);
+            continue;
+        }
+
         for msg_chunk in messages.chunks(msg_batch_size) {
             let batch = msg_chunk.to_vec();
-            match send_messages(
-                contact,
+            match send_messages_with_backoff(
+                &contact,
                 cosmos_key,
                 gas_price.to_owned(),
                 msg_chunk.to_vec(),
                 gas_adjustment,
+                &mut sequences,
             )
             .await
             {
@@ -179,12 +322,13 @@ pub async fn send_main_loop(
                     info!("Trying each message in batch individually");
                     for msg in batch {
                         let msg_vec = vec![msg];
-                        match send_messages(
-                            contact,
+                        match send_messages_with_backoff(
+                            &contact,
                             cosmos_key,
                             gas_price.to_owned(),
                             msg_vec.clone(),
                             gas_adjustment,
+                            &mut sequences,
                         )
                         .await
                         {
@@ -198,7 +342,21 @@ pub async fn send_main_loop(
     }
 }
 
+fn log_dry_run(messages: &[Msg]) {
+    for msg in messages {
+        let any = prost_types::Any::from(msg.clone());
+        info!(
+            "Dry run: would have sent Cosmos message of type {} ({} bytes): 0x{}",
+            any.type_url,
+            any.value.len(),
+            bytes_to_hex_str(&any.value)
+        );
+    }
+}
+
 fn log_send_error(messages: &Vec<Msg>, err: GravityError) {
+    metrics::COSMOS_TX_FAILURES.inc();
+
     let msg_types = messages
         .iter()
         .map(|msg| prost_types::Any::from(msg.clone()).type_url)
@@ -211,3 +369,51 @@ fn log_send_error(messages: &Vec<Msg>, err: GravityError) {
         err
     );
 }
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_sequence_manager_follows_chain_until_used() {
+        let sequences = SequenceManager::new();
+        assert_eq!(sequences.next(7), 7);
+    }
+
+    #[test]
+    fn test_sequence_manager_runs_ahead_of_chain() {
+        let mut sequences = SequenceManager::new();
+        sequences.advance(7);
+        // the chain still reports the committed sequence while the transaction is in the
+        // mempool, so the local sequence is used
+        assert_eq!(sequences.next(7), 8);
+        sequences.advance(8);
+        assert_eq!(sequences.next(7), 9);
+
+        // once the chain has caught up or moved past us its sequence wins
+        assert_eq!(sequences.next(9), 9);
+        assert_eq!(sequences.next(12), 12);
+    }
+
+    #[test]
+    fn test_sequence_manager_reset() {
+        let mut sequences = SequenceManager::new();
+        sequences.advance(7);
+        sequences.reset();
+        assert_eq!(sequences.next(5), 5);
+    }
+
+    #[test]
+    fn test_is_retryable() {
+        assert!(is_retryable(
+            "account sequence mismatch, expected 8, got 7: incorrect account sequence"
+        ));
+        assert!(is_retryable("incorrect account sequence"));
+        assert!(is_retryable("mempool is full"));
+        assert!(!is_retryable("insufficient fees"));
+        assert!(!is_retryable("out of gas in location: WriteFlat"));
+
+        assert!(is_sequence_mismatch("account sequence mismatch"));
+        assert!(!is_sequence_mismatch("mempool is full"));
+    }
+}
@@ -4,13 +4,17 @@ use ethers::types::U256;
 
 #[macro_use]
 extern crate log;
+#[macro_use]
+extern crate serde_derive;
 
 pub mod deploy_erc20;
 pub mod erc20_utils;
 pub mod logic_call;
 pub mod send_to_cosmos;
 pub mod submit_batch;
+pub mod submit_erc1155_batch;
 pub mod types;
+pub mod user_operation;
 pub mod utils;
 pub mod valset_update;
 
@@ -21,3 +25,12 @@ pub fn one_eth() -> U256 {
 pub fn one_eth_f32() -> f32 {
     1000000000000000000u128 as f32
 }
+
+/// One unit of a chain's native gas token with the given decimals, zero decimals
+/// meaning the usual 18 of ETH
+pub fn one_native_f32(decimals: u32) -> f32 {
+    if decimals == 0 {
+        return one_eth_f32();
+    }
+    10f32.powi(decimals as i32)
+}
@@ -1,12 +1,13 @@
 use crate::{
     types::{EthClient, EthSignerMiddleware},
-    utils::{get_gas_price, get_logic_call_nonce, GasCost},
+    utils::{get_gas_price, get_logic_call_nonce, send_contract_call, GasCost},
 };
 use ethers::contract::builders::ContractCall;
 use ethers::prelude::*;
 use ethers::types::Address as EthAddress;
 use gravity_abi::gravity::*;
 use gravity_utils::ethereum::{bytes_to_hex_str, vec_u8_to_fixed_32};
+use gravity_utils::metrics;
 use gravity_utils::types::*;
 use gravity_utils::{error::GravityError, message_signatures::encode_logic_call_confirm_hashed};
 use std::{collections::HashMap, result::Result, time::Duration};
@@ -23,11 +24,12 @@ pub async fn send_eth_logic_call(
     gravity_id: String,
     gas_cost: GasCost,
     eth_client: EthClient,
+    private_relay: Option<Provider<Http>>,
     logic_call_skips: &mut LogicCallSkips,
 ) -> Result<(), GravityError> {
     let new_call_nonce = call.invalidation_nonce;
     info!(
-        "Ordering signatures and submitting LogicCall {}:{} to Ethereum",
+        "Ordering signatures and submitting LogicCall invalidation_id={} invalidation_nonce={} to Ethereum",
         bytes_to_hex_str(&call.invalidation_id),
         new_call_nonce
     );
@@ -75,15 +77,20 @@ pub async fn send_eth_logic_call(
         .gas_price(gas_cost.gas_price)
         .legacy(); // must submit transactions as legacy due to bug in manually-specified EIP1559 gas limits
 
-    let pending_tx = contract_call.send().await?;
-    let tx_hash = *pending_tx;
-    info!("Sent logic call with txid {}", tx_hash);
+    metrics::inc_relay_attempts(metrics::RELAY_KIND_LOGIC_CALL);
+    let tx_hash =
+        send_contract_call(contract_call, private_relay.as_ref(), eth_client.clone()).await?;
+    info!(
+        "Sent logic call for invalidation_nonce={} with tx_hash={:?}",
+        new_call_nonce, tx_hash
+    );
     // TODO(bolten): ethers interval default is 7s, this mirrors what web30 was doing, should we adjust?
     // additionally we are mirroring only waiting for 1 confirmation by leaving that as default
-    let pending_tx = pending_tx.interval(Duration::from_secs(1));
+    let pending_tx =
+        PendingTransaction::new(tx_hash, eth_client.provider()).interval(Duration::from_secs(1));
 
     match tokio::time::timeout(timeout, pending_tx).await?? {
-        Some(_) => (),
+        Some(receipt) => metrics::record_gas_spent(metrics::RELAY_KIND_LOGIC_CALL, &receipt),
         None => error!(
             "Did not receive transaction receipt when submitting batch: {}",
             tx_hash
@@ -103,6 +110,7 @@ pub async fn send_eth_logic_call(
             last_nonce, new_call_nonce
         );
     } else {
+        metrics::inc_relay_successes(metrics::RELAY_KIND_LOGIC_CALL);
         info!(
             "Successfully updated LogicCall with new Nonce {:?}",
             last_nonce
@@ -1,6 +1,7 @@
 use crate::{
     types::{EthClient, EthSignerMiddleware},
-    utils::{get_gas_price, get_tx_batch_nonce, GasCost},
+    user_operation::Bundler,
+    utils::{get_gas_price, get_tx_batch_nonce, send_contract_call, GasCost},
 };
 use ethers::contract::builders::ContractCall;
 use ethers::prelude::*;
@@ -8,11 +9,13 @@ use ethers::types::Address as EthAddress;
 use gravity_abi::gravity::*;
 use gravity_utils::error::GravityError;
 use gravity_utils::message_signatures::encode_tx_batch_confirm_hashed;
+use gravity_utils::metrics;
 use gravity_utils::types::*;
 use std::{result::Result, time::Duration};
 
 /// this function generates an appropriate Ethereum transaction
-/// to submit the provided transaction batch
+/// to submit the provided transaction batch, if a bundler is provided the batch is
+/// submitted as an ERC-4337 user operation of the bundler's smart account instead
 #[allow(clippy::too_many_arguments)]
 pub async fn send_eth_transaction_batch(
     current_valset: Valset,
@@ -23,10 +26,12 @@ pub async fn send_eth_transaction_batch(
     gravity_id: String,
     gas_cost: GasCost,
     eth_client: EthClient,
+    private_relay: Option<Provider<Http>>,
+    bundler: Option<Bundler>,
 ) -> Result<(), GravityError> {
     let new_batch_nonce = batch.nonce;
     info!(
-        "Ordering signatures and submitting TransactionBatch {}:{} to Ethereum",
+        "Ordering signatures and submitting TransactionBatch token_contract={:?} batch_nonce={} to Ethereum",
         batch.token_contract, new_batch_nonce
     );
     trace!("Batch {:?}", batch);
@@ -67,15 +72,33 @@ pub async fn send_eth_transaction_batch(
         .gas_price(gas_cost.gas_price)
         .legacy(); // must submit transactions as legacy due to bug in manually-specified EIP1559 gas limits
 
-    let pending_tx = contract_call.send().await?;
-    let tx_hash = *pending_tx;
-    info!("Sent batch update with txid {}", tx_hash);
+    metrics::inc_relay_attempts(metrics::RELAY_KIND_BATCH);
+    let tx_hash = match bundler {
+        Some(bundler) => {
+            bundler
+                .send_contract_call(
+                    contract_call,
+                    gas_cost.gas_price,
+                    timeout,
+                    eth_client.clone(),
+                )
+                .await?
+        }
+        None => {
+            send_contract_call(contract_call, private_relay.as_ref(), eth_client.clone()).await?
+        }
+    };
+    info!(
+        "Sent batch update for batch_nonce={} with tx_hash={:?}",
+        new_batch_nonce, tx_hash
+    );
     // TODO(bolten): ethers interval default is 7s, this mirrors what web30 was doing, should we adjust?
     // additionally we are mirroring only waiting for 1 confirmation by leaving that as default
-    let pending_tx = pending_tx.interval(Duration::from_secs(1));
+    let pending_tx =
+        PendingTransaction::new(tx_hash, eth_client.provider()).interval(Duration::from_secs(1));
 
     match tokio::time::timeout(timeout, pending_tx).await?? {
-        Some(_) => (),
+        Some(receipt) => metrics::record_gas_spent(metrics::RELAY_KIND_BATCH, &receipt),
         None => error!(
             "Did not receive transaction receipt when submitting batch: {}",
             tx_hash
@@ -95,6 +118,7 @@ pub async fn send_eth_transaction_batch(
             last_nonce, new_batch_nonce
         );
     } else {
+        metrics::inc_relay_successes(metrics::RELAY_KIND_BATCH);
         info!("Successfully updated Batch with new Nonce {:?}", last_nonce);
     }
 
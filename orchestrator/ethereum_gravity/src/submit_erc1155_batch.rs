@@ -0,0 +1,178 @@
+use crate::{
+    types::{EthClient, EthSignerMiddleware},
+    utils::{get_gas_price, get_tx_batch_nonce, send_contract_call, GasCost},
+};
+use ethers::contract::builders::ContractCall;
+use ethers::prelude::*;
+use ethers::types::Address as EthAddress;
+use gravity_abi::gravity::*;
+use gravity_utils::error::GravityError;
+use gravity_utils::message_signatures::encode_erc1155_batch_confirm_hashed;
+use gravity_utils::metrics;
+use gravity_utils::types::*;
+use std::{result::Result, time::Duration};
+
+/// this function generates an appropriate Ethereum transaction
+/// to submit the provided ERC1155 batch
+#[allow(clippy::too_many_arguments)]
+pub async fn send_eth_erc1155_batch(
+    current_valset: Valset,
+    batch: Erc1155Batch,
+    confirms: &[Erc1155BatchConfirmResponse],
+    timeout: Duration,
+    gravity_contract_address: EthAddress,
+    gravity_id: String,
+    gas_cost: GasCost,
+    eth_client: EthClient,
+    private_relay: Option<Provider<Http>>,
+) -> Result<(), GravityError> {
+    let new_batch_nonce = batch.nonce;
+    info!(
+        "Ordering signatures and submitting Erc1155Batch token_contract={:?} batch_nonce={} to Ethereum",
+        batch.token_contract, new_batch_nonce
+    );
+    trace!("Batch {:?}", batch);
+
+    // ERC1155 batches share the batch nonces of the ERC20 batches
+    let before_nonce = get_tx_batch_nonce(
+        gravity_contract_address,
+        batch.token_contract,
+        eth_client.clone(),
+    )
+    .await?;
+
+    let current_block_height = eth_client.get_block_number().await?;
+    if before_nonce >= new_batch_nonce {
+        info!(
+            "Someone else updated the batch to {}, exiting early",
+            before_nonce
+        );
+        return Ok(());
+    } else if current_block_height > batch.batch_timeout.into() {
+        info!(
+            "This batch is timed out. timeout block: {} current block: {}, exiting early",
+            current_block_height, batch.batch_timeout
+        );
+        return Ok(());
+    }
+
+    let contract_call = build_submit_erc1155_batch_contract_call(
+        current_valset,
+        &batch,
+        confirms,
+        gravity_contract_address,
+        gravity_id,
+        eth_client.clone(),
+    )?;
+
+    let contract_call = contract_call
+        .gas(gas_cost.gas)
+        .gas_price(gas_cost.gas_price)
+        .legacy(); // must submit transactions as legacy due to bug in manually-specified EIP1559 gas limits
+
+    metrics::inc_relay_attempts(metrics::RELAY_KIND_ERC1155_BATCH);
+    let tx_hash =
+        send_contract_call(contract_call, private_relay.as_ref(), eth_client.clone()).await?;
+    info!(
+        "Sent erc1155 batch update for batch_nonce={} with tx_hash={:?}",
+        new_batch_nonce, tx_hash
+    );
+    let pending_tx =
+        PendingTransaction::new(tx_hash, eth_client.provider()).interval(Duration::from_secs(1));
+
+    match tokio::time::timeout(timeout, pending_tx).await?? {
+        Some(receipt) => metrics::record_gas_spent(metrics::RELAY_KIND_ERC1155_BATCH, &receipt),
+        None => error!(
+            "Did not receive transaction receipt when submitting erc1155 batch: {}",
+            tx_hash
+        ),
+    }
+
+    let last_nonce = get_tx_batch_nonce(
+        gravity_contract_address,
+        batch.token_contract,
+        eth_client.clone(),
+    )
+    .await?;
+
+    if last_nonce != new_batch_nonce {
+        error!(
+            "Current nonce is {} expected to update to nonce {}",
+            last_nonce, new_batch_nonce
+        );
+    } else {
+        metrics::inc_relay_successes(metrics::RELAY_KIND_ERC1155_BATCH);
+        info!(
+            "Successfully updated Erc1155Batch with new Nonce {:?}",
+            last_nonce
+        );
+    }
+
+    Ok(())
+}
+
+/// Returns the cost in Eth of sending this ERC1155 batch
+pub async fn estimate_erc1155_batch_cost(
+    current_valset: Valset,
+    batch: Erc1155Batch,
+    confirms: &[Erc1155BatchConfirmResponse],
+    gravity_contract_address: EthAddress,
+    gravity_id: String,
+    eth_client: EthClient,
+) -> Result<GasCost, GravityError> {
+    let contract_call = build_submit_erc1155_batch_contract_call(
+        current_valset,
+        &batch,
+        confirms,
+        gravity_contract_address,
+        gravity_id,
+        eth_client.clone(),
+    )?;
+
+    Ok(GasCost {
+        gas: contract_call.estimate_gas().await?,
+        gas_price: get_gas_price(eth_client.clone()).await?,
+    })
+}
+
+pub fn build_submit_erc1155_batch_contract_call(
+    current_valset: Valset,
+    batch: &Erc1155Batch,
+    confirms: &[Erc1155BatchConfirmResponse],
+    gravity_contract_address: EthAddress,
+    gravity_id: String,
+    eth_client: EthClient,
+) -> Result<ContractCall<EthSignerMiddleware, ()>, GravityError> {
+    let (current_addresses, current_powers) = current_valset.filter_empty_addresses();
+    let current_powers: Vec<U256> = current_powers.iter().map(|power| (*power).into()).collect();
+    let current_valset_nonce = current_valset.nonce;
+    let new_batch_nonce = batch.nonce;
+    let hash = encode_erc1155_batch_confirm_hashed(gravity_id, batch.clone());
+    let sig_data = current_valset.order_sigs(&hash, confirms)?;
+    let (destinations, ids, amounts) = batch.get_checkpoint_values();
+
+    let contract_call = Gravity::new(gravity_contract_address, eth_client.clone())
+        .submit_erc1155_batch(
+            ValsetArgs {
+                validators: current_addresses,
+                powers: current_powers,
+                valset_nonce: current_valset_nonce.into(),
+                reward_amount: U256::zero(),
+                reward_token: H160::zero(),
+            },
+            sig_data
+                .iter()
+                .map(|sig_data| sig_data.to_val_sig())
+                .collect(),
+            destinations,
+            ids,
+            amounts,
+            new_batch_nonce.into(),
+            batch.token_contract,
+            batch.batch_timeout.into(),
+        )
+        .from(eth_client.address())
+        .value(U256::zero());
+
+    Ok(contract_call)
+}
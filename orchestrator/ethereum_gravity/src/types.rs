@@ -1,5 +1,6 @@
 use ethers::prelude::*;
+use gravity_utils::signer::EthSigner;
 use std::sync::Arc;
 
-pub type EthSignerMiddleware = SignerMiddleware<Provider<Http>, LocalWallet>;
+pub type EthSignerMiddleware = SignerMiddleware<Provider<Http>, EthSigner>;
 pub type EthClient = Arc<EthSignerMiddleware>;
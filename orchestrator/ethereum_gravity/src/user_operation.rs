@@ -0,0 +1,330 @@
+//! Submission of Gravity contract calls as ERC-4337 user operations. Instead of sending a
+//! transaction from the relayer's own address, the call is wrapped in a user operation for a
+//! smart account controlled by the relayer's key and handed to a bundler. A paymaster may
+//! sponsor the operation, so the relayer does not need to hold ETH and relaying costs can be
+//! covered by a third party or paid in ERC20 tokens.
+//!
+//! This targets version 0.6 of the EntryPoint contract and smart accounts exposing the
+//! `execute(address,uint256,bytes)` function of the reference SimpleAccount.
+
+use crate::types::{EthClient, EthSignerMiddleware};
+use ethers::abi::{encode, parse_abi, Abi, Token};
+use ethers::contract::builders::ContractCall;
+use ethers::prelude::*;
+use ethers::types::Address as EthAddress;
+use ethers::utils::keccak256;
+use gravity_utils::error::GravityError;
+use std::time::Duration;
+use tokio::time::{sleep, Instant};
+
+/// How often the bundler is polled for the receipt of a submitted user operation
+const RECEIPT_POLL_INTERVAL: Duration = Duration::from_secs(2);
+
+/// A placeholder signature of the right length used while estimating gas, smart
+/// accounts must not revert validation for an invalid signature
+const DUMMY_SIGNATURE: [u8; 65] = [
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0x1c,
+];
+
+#[derive(Clone, Debug, Default, Serialize, Deserialize)]
+#[serde(rename_all = "camelCase")]
+pub struct UserOperation {
+    pub sender: EthAddress,
+    pub nonce: U256,
+    pub init_code: Bytes,
+    pub call_data: Bytes,
+    pub call_gas_limit: U256,
+    pub verification_gas_limit: U256,
+    pub pre_verification_gas: U256,
+    pub max_fee_per_gas: U256,
+    pub max_priority_fee_per_gas: U256,
+    pub paymaster_and_data: Bytes,
+    pub signature: Bytes,
+}
+
+impl UserOperation {
+    /// The hash signed by the smart account owner, as computed by the EntryPoint
+    pub fn hash(&self, entry_point: EthAddress, chain_id: U256) -> [u8; 32] {
+        let packed = encode(&[
+            Token::Address(self.sender),
+            Token::Uint(self.nonce),
+            Token::FixedBytes(keccak256(&self.init_code).to_vec()),
+            Token::FixedBytes(keccak256(&self.call_data).to_vec()),
+            Token::Uint(self.call_gas_limit),
+            Token::Uint(self.verification_gas_limit),
+            Token::Uint(self.pre_verification_gas),
+            Token::Uint(self.max_fee_per_gas),
+            Token::Uint(self.max_priority_fee_per_gas),
+            Token::FixedBytes(keccak256(&self.paymaster_and_data).to_vec()),
+        ]);
+
+        keccak256(encode(&[
+            Token::FixedBytes(keccak256(packed).to_vec()),
+            Token::Address(entry_point),
+            Token::Uint(chain_id),
+        ]))
+    }
+}
+
+#[derive(Debug, Deserialize)]
+#[serde(rename_all = "camelCase")]
+struct UserOperationGas {
+    pre_verification_gas: U256,
+    verification_gas_limit: U256,
+    call_gas_limit: U256,
+}
+
+#[derive(Debug, Deserialize)]
+#[serde(rename_all = "camelCase")]
+struct SponsoredUserOperation {
+    paymaster_and_data: Bytes,
+    pre_verification_gas: Option<U256>,
+    verification_gas_limit: Option<U256>,
+    call_gas_limit: Option<U256>,
+}
+
+#[derive(Debug, Deserialize)]
+#[serde(rename_all = "camelCase")]
+struct UserOperationReceipt {
+    success: bool,
+    receipt: UserOperationTransaction,
+}
+
+#[derive(Debug, Deserialize)]
+#[serde(rename_all = "camelCase")]
+struct UserOperationTransaction {
+    transaction_hash: H256,
+}
+
+/// How user operations are paid for
+#[derive(Clone, Debug)]
+pub enum Paymaster {
+    /// the smart account pays for its own operations
+    None,
+    /// fixed paymasterAndData, for example for a paymaster taking ERC20 from the account
+    Static(Bytes),
+    /// a paymaster service implementing pm_sponsorUserOperation
+    Rpc(Provider<Http>),
+}
+
+#[derive(Clone, Debug)]
+pub struct Bundler {
+    provider: Provider<Http>,
+    entry_point: EthAddress,
+    smart_account: EthAddress,
+    paymaster: Paymaster,
+    entry_point_abi: Abi,
+    account_abi: Abi,
+}
+
+impl Bundler {
+    pub fn new(
+        provider: Provider<Http>,
+        entry_point: EthAddress,
+        smart_account: EthAddress,
+        paymaster: Paymaster,
+    ) -> Self {
+        let entry_point_abi = parse_abi(&[
+            "function getNonce(address sender, uint192 key) external view returns (uint256)",
+        ])
+        .expect("invalid EntryPoint abi");
+        let account_abi =
+            parse_abi(&["function execute(address dest, uint256 value, bytes func) external"])
+                .expect("invalid smart account abi");
+
+        Bundler {
+            provider,
+            entry_point,
+            smart_account,
+            paymaster,
+            entry_point_abi,
+            account_abi,
+        }
+    }
+
+    pub fn smart_account(&self) -> EthAddress {
+        self.smart_account
+    }
+
+    /// Submits the contract call as a user operation of the smart account and waits up to
+    /// timeout for it to be bundled, returning the hash of the bundle transaction
+    pub async fn send_contract_call<D: Detokenize>(
+        &self,
+        contract_call: ContractCall<EthSignerMiddleware, D>,
+        gas_price: U256,
+        timeout: Duration,
+        eth_client: EthClient,
+    ) -> Result<TxHash, GravityError> {
+        let target = match contract_call.tx.to() {
+            Some(NameOrAddress::Address(target)) => *target,
+            _ => {
+                return Err(GravityError::EthereumBadDataError(
+                    "user operation call has no target address".to_string(),
+                ))
+            }
+        };
+        let data = contract_call.tx.data().cloned().unwrap_or_default();
+        let call_data = self.account_abi.function("execute")?.encode_input(&[
+            Token::Address(target),
+            Token::Uint(U256::zero()),
+            Token::Bytes(data.to_vec()),
+        ])?;
+
+        let entry_point = Contract::new(
+            self.entry_point,
+            self.entry_point_abi.clone(),
+            eth_client.clone(),
+        );
+        let nonce: U256 = entry_point
+            .method("getNonce", (self.smart_account, U256::zero()))?
+            .call()
+            .await?;
+
+        let mut user_op = UserOperation {
+            sender: self.smart_account,
+            nonce,
+            call_data: call_data.into(),
+            max_fee_per_gas: gas_price,
+            max_priority_fee_per_gas: gas_price,
+            signature: DUMMY_SIGNATURE.to_vec().into(),
+            ..Default::default()
+        };
+        if let Paymaster::Static(paymaster_and_data) = &self.paymaster {
+            user_op.paymaster_and_data = paymaster_and_data.clone();
+        }
+
+        let gas: UserOperationGas = self
+            .provider
+            .request("eth_estimateUserOperationGas", (&user_op, self.entry_point))
+            .await?;
+        user_op.pre_verification_gas = gas.pre_verification_gas;
+        user_op.verification_gas_limit = gas.verification_gas_limit;
+        user_op.call_gas_limit = gas.call_gas_limit;
+
+        if let Paymaster::Rpc(paymaster) = &self.paymaster {
+            let sponsored: SponsoredUserOperation = paymaster
+                .request("pm_sponsorUserOperation", (&user_op, self.entry_point))
+                .await?;
+            user_op.paymaster_and_data = sponsored.paymaster_and_data;
+            if let Some(gas) = sponsored.pre_verification_gas {
+                user_op.pre_verification_gas = gas;
+            }
+            if let Some(gas) = sponsored.verification_gas_limit {
+                user_op.verification_gas_limit = gas;
+            }
+            if let Some(gas) = sponsored.call_gas_limit {
+                user_op.call_gas_limit = gas;
+            }
+        }
+
+        let chain_id = eth_client.signer().chain_id();
+        let hash = user_op.hash(self.entry_point, chain_id.into());
+        let signature = eth_client.signer().sign_message(hash).await?;
+        user_op.signature = signature.to_vec().into();
+
+        let user_op_hash: H256 = self
+            .provider
+            .request("eth_sendUserOperation", (&user_op, self.entry_point))
+            .await?;
+        info!("Sent user operation with hash {:?}", user_op_hash);
+
+        self.wait_for_receipt(user_op_hash, timeout).await
+    }
+
+    async fn wait_for_receipt(
+        &self,
+        user_op_hash: H256,
+        timeout: Duration,
+    ) -> Result<TxHash, GravityError> {
+        let deadline = Instant::now() + timeout;
+        while Instant::now() < deadline {
+            let receipt: Option<UserOperationReceipt> = self
+                .provider
+                .request("eth_getUserOperationReceipt", [user_op_hash])
+                .await?;
+            if let Some(receipt) = receipt {
+                if !receipt.success {
+                    return Err(GravityError::GravityContractError(format!(
+                        "User operation {:?} reverted in transaction {:?}",
+                        user_op_hash, receipt.receipt.transaction_hash
+                    )));
+                }
+                return Ok(receipt.receipt.transaction_hash);
+            }
+            sleep(RECEIPT_POLL_INTERVAL).await;
+        }
+
+        Err(GravityError::GravityContractError(format!(
+            "User operation {:?} was not bundled within {:?}",
+            user_op_hash, timeout
+        )))
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use gravity_utils::ethereum::{bytes_to_hex_str, hex_str_to_bytes};
+
+    // the canonical EntryPoint v0.6 deployment
+    const ENTRY_POINT: &str = "0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789";
+
+    fn test_user_operation() -> UserOperation {
+        UserOperation {
+            sender: "0x9406Cc6185a346906296840746125a0E44976454"
+                .parse()
+                .unwrap(),
+            nonce: 1.into(),
+            init_code: Bytes::default(),
+            call_data: hex_str_to_bytes(
+                "0xb61d27f6000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+            )
+            .unwrap()
+            .into(),
+            call_gas_limit: 100_000.into(),
+            verification_gas_limit: 200_000.into(),
+            pre_verification_gas: 50_000.into(),
+            max_fee_per_gas: U256::from(30) * U256::exp10(9),
+            max_priority_fee_per_gas: U256::exp10(9),
+            paymaster_and_data: hex_str_to_bytes("0xe93eca6595fe94091dc1af46aac2a8b5d7990770")
+                .unwrap()
+                .into(),
+            signature: Bytes::default(),
+        }
+    }
+
+    #[test]
+    fn test_user_operation_hash() {
+        // keccak256(abi.encode(keccak256(pack(op)), entryPoint, chainId)) as in the
+        // EntryPoint's getUserOpHash, where pack hashes the dynamic fields and leaves out
+        // the signature
+        let op = test_user_operation();
+        let entry_point = ENTRY_POINT.parse().unwrap();
+
+        assert_eq!(
+            bytes_to_hex_str(&op.hash(entry_point, 1.into())),
+            "0cef199f3b79a2c391ea379816fad1e8707c22969bd168955e80befe1520b2b4"
+        );
+        assert_eq!(
+            bytes_to_hex_str(&op.hash(entry_point, 137.into())),
+            "0dd4253c556d1c2cde12a6cc4fc4857532aeaae8df3466f517f2e47ef0c2bd5e"
+        );
+    }
+
+    #[test]
+    fn test_user_operation_hash_ignores_signature() {
+        let op = test_user_operation();
+        let mut signed = test_user_operation();
+        signed.signature = vec![1u8; 65].into();
+        let entry_point = ENTRY_POINT.parse().unwrap();
+
+        assert_eq!(
+            op.hash(entry_point, 1.into()),
+            signed.hash(entry_point, 1.into())
+        );
+    }
+}
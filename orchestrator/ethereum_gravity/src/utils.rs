@@ -1,4 +1,5 @@
-use crate::types::EthClient;
+use crate::types::{EthClient, EthSignerMiddleware};
+use ethers::contract::builders::ContractCall;
 use ethers::middleware::gas_oracle::Etherscan;
 use ethers::prelude::gas_oracle::GasOracle;
 use ethers::prelude::*;
@@ -177,6 +178,36 @@ pub async fn get_chain(eth_client: EthClient) -> Result<Chain, GravityError> {
     })
 }
 
+/// Sends the transaction for the provided contract call and returns its hash. If a private
+/// relay (such as Flashbots Protect) is provided, the transaction is signed locally and only
+/// submitted to that relay, keeping it out of the public mempool where a profitable
+/// submission could be frontrun or its gas price sniped.
+pub async fn send_contract_call<D: Detokenize>(
+    contract_call: ContractCall<EthSignerMiddleware, D>,
+    private_relay: Option<&Provider<Http>>,
+    eth_client: EthClient,
+) -> Result<TxHash, GravityError> {
+    let private_relay = match private_relay {
+        Some(private_relay) => private_relay,
+        None => return Ok(*contract_call.send().await?),
+    };
+
+    let mut tx = contract_call.tx;
+    tx.set_from(eth_client.address());
+    let nonce = eth_client
+        .get_transaction_count(eth_client.address(), Some(BlockNumber::Pending.into()))
+        .await?;
+    tx.set_nonce(nonce);
+
+    let chain_id = eth_client.signer().chain_id();
+    let signature = eth_client.signer().sign_transaction(&tx).await?;
+    let pending_tx = private_relay
+        .send_raw_transaction(tx.rlp_signed(chain_id, &signature))
+        .await?;
+
+    Ok(*pending_tx)
+}
+
 /// Just a helper struct to represent the cost of actions on Ethereum
 #[derive(Debug, Default, Clone)]
 pub struct GasCost {
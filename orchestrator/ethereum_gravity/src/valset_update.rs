@@ -7,7 +7,7 @@ use ethers::prelude::*;
 use ethers::types::Address as EthAddress;
 use gravity_abi::gravity::*;
 use gravity_utils::{
-    error::GravityError, message_signatures::encode_valset_confirm_hashed, types::*,
+    error::GravityError, message_signatures::encode_valset_confirm_hashed, metrics, types::*,
 };
 use std::{result::Result, time::Duration};
 
@@ -53,15 +53,19 @@ pub async fn send_eth_valset_update(
         .gas_price(gas_cost.gas_price)
         .legacy(); // must submit transactions as legacy due to bug in manually-specified EIP1559 gas limits
 
+    metrics::inc_relay_attempts(metrics::RELAY_KIND_VALSET);
     let pending_tx = contract_call.send().await?;
     let tx_hash = *pending_tx;
-    info!("Sent valset update with txid {}", tx_hash);
+    info!(
+        "Sent valset update for valset_nonce={} with tx_hash={:?}",
+        new_nonce, tx_hash
+    );
     // TODO(bolten): ethers interval default is 7s, this mirrors what web30 was doing, should we adjust?
     // additionally we are mirroring only waiting for 1 confirmation by leaving that as default
     let pending_tx = pending_tx.interval(Duration::from_secs(1));
 
     match tokio::time::timeout(timeout, pending_tx).await?? {
-        Some(_) => (),
+        Some(receipt) => metrics::record_gas_spent(metrics::RELAY_KIND_VALSET, &receipt),
         None => error!(
             "Did not receive transaction receipt when sending valset update: {}",
             tx_hash
@@ -75,6 +79,7 @@ pub async fn send_eth_valset_update(
             last_nonce, new_nonce
         );
     } else {
+        metrics::inc_relay_successes(metrics::RELAY_KIND_VALSET);
         info!(
             "Successfully updated Valset with new Nonce {:?}",
             last_nonce
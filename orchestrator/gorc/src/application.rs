@@ -3,9 +3,13 @@
 use crate::{commands::EntryPoint, config::GorcConfig};
 use abscissa_core::{
     application::{self, AppCell},
+    component::Component,
     config::{self, CfgCell},
-    trace, Application, FrameworkError, StandardPaths,
+    terminal::component::Terminal,
+    trace::{self, Tracing},
+    Application, FrameworkError, StandardPaths,
 };
+use gravity_utils::logging::{self, LogFormat};
 
 /// Application state
 pub static APP: AppCell<GorcApp> = AppCell::new();
@@ -33,6 +37,22 @@ impl Default for GorcApp {
     }
 }
 
+impl GorcApp {
+    /// Replaces the log filter of the running application, filters use the RUST_LOG syntax
+    pub fn set_log_filter(&self, filter: &str) -> Result<(), FrameworkError> {
+        match LogFormat::from_env() {
+            LogFormat::Text => {
+                let mut components = self.state.components_mut();
+                if let Some(tracing) = components.get_downcast_mut::<Tracing>() {
+                    tracing.reload_filter(filter)?;
+                }
+            }
+            LogFormat::Json => logging::set_log_filter(filter),
+        }
+        Ok(())
+    }
+}
+
 impl Application for GorcApp {
     /// Entrypoint command for this application.
     type Cmd = EntryPoint;
@@ -59,7 +79,17 @@ impl Application for GorcApp {
     /// beyond the default ones provided by the framework, this is the place
     /// to do so.
     fn register_components(&mut self, command: &Self::Cmd) -> Result<(), FrameworkError> {
-        let mut framework_components = self.framework_components(command)?;
+        let mut framework_components = match LogFormat::from_env() {
+            LogFormat::Text => self.framework_components(command)?,
+            // json logs are written by our own logger in place of the tracing component
+            LogFormat::Json => {
+                let filter = if command.verbose { "debug" } else { "info" };
+                logging::init_json_logger(filter);
+                let terminal: Box<dyn Component<Self>> =
+                    Box::new(Terminal::new(self.term_colors(command)));
+                vec![terminal]
+            }
+        };
         let mut app_components = self.state.components_mut();
         framework_components.push(Box::new(abscissa_tokio::TokioComponent::new()?));
 
@@ -73,8 +103,10 @@ impl Application for GorcApp {
     /// possible.
     fn after_config(&mut self, config: Self::Cfg) -> Result<(), FrameworkError> {
         // Configure components
-        let mut components = self.state.components_mut();
-        components.after_config(&config)?;
+        self.state.components_mut().after_config(&config)?;
+        if let Some(filter) = &config.log_level {
+            self.set_log_filter(filter)?;
+        }
         self.config.set_once(config);
         Ok(())
     }
@@ -50,13 +50,14 @@ impl Runnable for CosmosToEthCmd {
         let amount: Uint256 = amount.parse().expect("cannot parse amount");
 
         let cosmos_key = self.args.get(2).expect("name is required");
-        let cosmos_key = config.load_deep_space_key(cosmos_key.to_string());
 
         let cosmos_prefix = config.cosmos.prefix.trim();
-        let cosmos_address = cosmos_key.to_address(cosmos_prefix).unwrap();
         let cosmos_grpc = config.cosmos.grpc.trim();
-        println!("Sending from Cosmos address {}", cosmos_address);
         abscissa_tokio::run_with_actix(&APP, async {
+            let cosmos_key = config.load_deep_space_key(cosmos_key.to_string()).await;
+            let cosmos_address = cosmos_key.to_address(cosmos_prefix).unwrap();
+            println!("Sending from Cosmos address {}", cosmos_address);
+
             let connections = create_rpc_connections(
                 cosmos_prefix.to_string(),
                 Some(cosmos_grpc.to_string()),
@@ -69,6 +70,7 @@ impl Runnable for CosmosToEthCmd {
             let res = grpc
                 .denom_to_erc20(DenomToErc20Request {
                     denom: gravity_denom.clone(),
+                    evm_chain_id: 0,
                 })
                 .await;
             match res {
@@ -42,7 +42,6 @@ impl Erc20 {
 
         let config = APP.config();
 
-        let ethereum_wallet = config.load_ethers_wallet(self.ethereum_key.clone());
         let contract_address = config
             .gravity
             .contract
@@ -65,8 +64,10 @@ impl Erc20 {
             .expect("Could not retrieve chain ID");
         let chain_id =
             downcast_to_u64(chain_id).expect("Chain ID overflowed when downcasting to u64");
-        let eth_client =
-            SignerMiddleware::new(provider, ethereum_wallet.clone().with_chain_id(chain_id));
+        let ethereum_signer = config
+            .load_ethereum_signer(self.ethereum_key.clone(), chain_id)
+            .await;
+        let eth_client = SignerMiddleware::new(provider, ethereum_signer);
         let eth_client = Arc::new(eth_client);
         let mut grpc = connections.grpc.clone().unwrap();
 
@@ -74,6 +75,7 @@ impl Erc20 {
 
         let req = DenomToErc20ParamsRequest {
             denom: denom.clone(),
+            evm_chain_id: 0,
         };
 
         let res = grpc
@@ -104,6 +106,7 @@ impl Erc20 {
             loop {
                 let req = DenomToErc20Request {
                     denom: denom.clone(),
+                    evm_chain_id: 0,
                 };
 
                 let res = grpc.denom_to_erc20(req).await;
@@ -28,7 +28,6 @@ impl Runnable for EthToCosmosCmd {
             .expect("Invalid ERC20 contract address!");
 
         let ethereum_key = self.args.get(1).expect("key is required");
-        let ethereum_wallet = config.load_ethers_wallet(ethereum_key.clone());
 
         let contract_address = self.args.get(2).expect("contract address is required");
         let contract_address: EthAddress =
@@ -53,8 +52,10 @@ impl Runnable for EthToCosmosCmd {
                 .expect("Could not retrieve chain ID");
             let chain_id =
                 downcast_to_u64(chain_id).expect("Chain ID overflowed when downcasting to u64");
-            let eth_client =
-                SignerMiddleware::new(provider, ethereum_wallet.clone().with_chain_id(chain_id));
+            let ethereum_signer = config
+                .load_ethereum_signer(ethereum_key.clone(), chain_id)
+                .await;
+            let eth_client = SignerMiddleware::new(provider, ethereum_signer);
             let eth_client = Arc::new(eth_client);
             let cosmos_dest = self.args.get(3).expect("cosmos destination is required");
             let cosmos_dest: CosmosAddress = cosmos_dest.parse().unwrap();
@@ -1,10 +1,8 @@
 use super::show::ShowCosmosKeyCmd;
 use crate::application::APP;
+use crate::keyring::KeyringBackend;
 use abscissa_core::{clap::Parser, Application, Command, Runnable};
-use k256::pkcs8::ToPrivateKey;
 use rand_core::OsRng;
-use signatory::FsKeyStore;
-use std::path;
 
 /// Add a new Cosmos Key
 #[derive(Command, Debug, Default, Parser)]
@@ -18,18 +16,34 @@ pub struct AddCosmosKeyCmd {
 impl Runnable for AddCosmosKeyCmd {
     fn run(&self) {
         let config = APP.config();
-        let keystore = path::Path::new(&config.keystore);
-        let keystore = FsKeyStore::create_or_open(keystore).expect("Could not open keystore");
+        let keyring = config.open_keyring();
 
         let name = self.args.get(0).expect("name is required");
-        let name = name.parse().expect("Could not parse name");
-        if let Ok(_info) = keystore.info(&name) {
+        if keyring.exists(name) {
             if !self.overwrite {
                 eprintln!("Key already exists, exiting.");
                 return;
             }
         }
 
+        // ledger and remote keys are generated by their signer, we only record where they are
+        match config.keyring_backend {
+            KeyringBackend::Ledger => {
+                keyring.store_external(name, &config.cosmos.key_derivation_path);
+            }
+            KeyringBackend::Remote => {
+                let remote_name = self.args.get(1).unwrap_or(name);
+                keyring.store_external(name, remote_name);
+            }
+            _ => {}
+        }
+        if config.keyring_backend.is_external() {
+            let args = vec![name.to_string()];
+            let show_cmd = ShowCosmosKeyCmd { args };
+            show_cmd.run();
+            return;
+        }
+
         let mnemonic = bip32::Mnemonic::random(&mut OsRng, Default::default());
         eprintln!("**Important** record this bip39-mnemonic in a safe place:");
         println!("{}", mnemonic.phrase());
@@ -43,11 +57,7 @@ impl Runnable for AddCosmosKeyCmd {
 
         let key = bip32::XPrv::derive_from_path(seed, &path).expect("Could not derive key");
         let key = k256::SecretKey::from(key.private_key());
-        let key = key
-            .to_pkcs8_der()
-            .expect("Could not PKCS8 encod private key");
-
-        keystore.store(&name, &key).expect("Could not store key");
+        keyring.store(name, &key);
 
         let args = vec![name.to_string()];
         let show_cmd = ShowCosmosKeyCmd { args };
@@ -1,7 +1,6 @@
 use super::show::ShowCosmosKeyCmd;
 use crate::application::APP;
 use abscissa_core::{clap::Parser, Application, Command, Runnable};
-use std::path::Path;
 
 /// List all Cosmos Keys
 #[derive(Command, Debug, Default, Parser)]
@@ -11,21 +10,10 @@ pub struct ListCosmosKeyCmd {}
 impl Runnable for ListCosmosKeyCmd {
     fn run(&self) {
         let config = APP.config();
-        let keystore = Path::new(&config.keystore);
-
-        for entry in keystore.read_dir().expect("Could not read keystore") {
-            let path = entry.unwrap().path();
-            if path.is_file() {
-                if let Some(extension) = path.extension() {
-                    if extension == "pem" {
-                        let name = path.file_stem().unwrap();
-                        let name = name.to_str().unwrap();
-                        let args = vec![name.to_string()];
-                        let show_cmd = ShowCosmosKeyCmd { args };
-                        show_cmd.run();
-                    }
-                }
-            }
+        for name in config.open_keyring().names() {
+            let args = vec![name];
+            let show_cmd = ShowCosmosKeyCmd { args };
+            show_cmd.run();
         }
     }
 }
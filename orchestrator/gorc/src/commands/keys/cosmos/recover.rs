@@ -1,9 +1,6 @@
 use super::show::ShowCosmosKeyCmd;
 use crate::application::APP;
 use abscissa_core::{clap::Parser, Application, Command, Runnable};
-use k256::pkcs8::ToPrivateKey;
-use signatory::FsKeyStore;
-use std::path;
 
 /// Recover a Cosmos Key
 #[derive(Command, Debug, Default, Parser)]
@@ -20,18 +17,24 @@ pub struct RecoverCosmosKeyCmd {
 impl Runnable for RecoverCosmosKeyCmd {
     fn run(&self) {
         let config = APP.config();
-        let keystore = path::Path::new(&config.keystore);
-        let keystore = FsKeyStore::create_or_open(keystore).expect("Could not open keystore");
+        let keyring = config.open_keyring();
 
         let name = self.args.get(0).expect("name is required");
-        let name = name.parse().expect("Could not parse name");
-        if let Ok(_info) = keystore.info(&name) {
+        if keyring.exists(name) {
             if !self.overwrite {
                 eprintln!("Key already exists, exiting.");
                 return;
             }
         }
 
+        if config.keyring_backend.is_external() {
+            eprintln!(
+                "Keys of the {:?} keyring backend are held by their signer, use add instead.",
+                config.keyring_backend
+            );
+            return;
+        }
+
         let mnemonic = match self.args.get(1) {
             Some(mnemonic) => mnemonic.clone(),
             None => rpassword::read_password_from_tty(Some("> Enter your bip39-mnemonic:\n"))
@@ -50,11 +53,7 @@ impl Runnable for RecoverCosmosKeyCmd {
 
         let key = bip32::XPrv::derive_from_path(seed, &path).expect("Could not derive key");
         let key = k256::SecretKey::from(key.private_key());
-        let key = key
-            .to_pkcs8_der()
-            .expect("Could not PKCS8 encod private key");
-
-        keystore.store(&name, &key).expect("Could not store key");
+        keyring.store(name, &key);
 
         let args = vec![name.to_string()];
         let show_cmd = ShowCosmosKeyCmd { args };
@@ -1,5 +1,5 @@
 use crate::application::APP;
-use abscissa_core::{clap::Parser, Application, Command, Runnable};
+use abscissa_core::{clap::Parser, status_err, Application, Command, Runnable};
 
 /// Show a Cosmos Key
 #[derive(Command, Debug, Default, Parser)]
@@ -12,12 +12,19 @@ impl Runnable for ShowCosmosKeyCmd {
     fn run(&self) {
         let config = APP.config();
         let name = self.args.get(0).expect("name is required");
-        let key = config.load_deep_space_key(name.clone());
 
-        let address = key
-            .to_address(config.cosmos.prefix.trim())
-            .expect("Could not generate public key");
+        abscissa_tokio::run_with_actix(&APP, async {
+            let key = config.load_deep_space_key(name.clone()).await;
 
-        println!("{}\t{}", name, address)
+            let address = key
+                .to_address(config.cosmos.prefix.trim())
+                .expect("Could not generate public key");
+
+            println!("{}\t{}", name, address)
+        })
+        .unwrap_or_else(|e| {
+            status_err!("executor exited with error: {}", e);
+            std::process::exit(1);
+        });
     }
 }
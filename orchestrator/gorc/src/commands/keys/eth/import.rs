@@ -1,10 +1,7 @@
 use super::show::ShowEthKeyCmd;
 use crate::application::APP;
 use abscissa_core::{clap::Parser, Application, Command, Runnable};
-use k256::{pkcs8::ToPrivateKey, SecretKey};
-
-use signatory::FsKeyStore;
-use std::path;
+use k256::SecretKey;
 
 ///Import an Eth Key
 #[derive(Command, Debug, Default, Parser)]
@@ -24,12 +21,10 @@ pub struct ImportEthKeyCmd {
 impl Runnable for ImportEthKeyCmd {
     fn run(&self) {
         let config = APP.config();
-        let keystore = path::Path::new(&config.keystore);
-        let keystore = FsKeyStore::create_or_open(keystore).expect("Could not open keystore");
+        let keyring = config.open_keyring();
 
         let name = self.args.get(0).expect("name is required");
-        let name = name.parse().expect("Could not parse name");
-        if let Ok(_info) = keystore.info(&name) {
+        if keyring.exists(name) {
             if !self.overwrite {
                 eprintln!("Key already exists, exiting.");
                 return;
@@ -48,11 +43,7 @@ impl Runnable for ImportEthKeyCmd {
 
         let key = SecretKey::from_bytes(key.to_bytes()).expect("Could not convert private-key");
 
-        let key = key
-            .to_pkcs8_der()
-            .expect("Could not PKCS8 encod private key");
-
-        keystore.store(&name, &key).expect("Could not store key");
+        keyring.store(name, &key);
 
         let show_cmd = ShowEthKeyCmd {
             args: vec![name.to_string()],
@@ -1,7 +1,6 @@
 use super::show::ShowEthKeyCmd;
 use crate::application::APP;
 use abscissa_core::{clap::Parser, Application, Command, Runnable};
-use std::path;
 
 /// List all Eth Keys
 #[derive(Command, Debug, Default, Parser)]
@@ -14,24 +13,13 @@ pub struct ListEthKeyCmd {
 impl Runnable for ListEthKeyCmd {
     fn run(&self) {
         let config = APP.config();
-        let keystore = path::Path::new(&config.keystore);
-
-        for entry in keystore.read_dir().expect("Could not read keystore") {
-            let path = entry.unwrap().path();
-            if path.is_file() {
-                if let Some(extension) = path.extension() {
-                    if extension == "pem" {
-                        let name = path.file_stem().unwrap();
-                        let name = name.to_str().unwrap();
-                        let show_cmd = ShowEthKeyCmd {
-                            args: vec![name.to_string()],
-                            show_private_key: self.show_private_key,
-                            show_name: true,
-                        };
-                        show_cmd.run();
-                    }
-                }
-            }
+        for name in config.open_keyring().names() {
+            let show_cmd = ShowEthKeyCmd {
+                args: vec![name],
+                show_private_key: self.show_private_key,
+                show_name: true,
+            };
+            show_cmd.run();
         }
     }
 }
@@ -1,9 +1,6 @@
 use super::show::ShowEthKeyCmd;
 use crate::application::APP;
 use abscissa_core::{clap::Parser, Application, Command, Runnable};
-use k256::pkcs8::ToPrivateKey;
-use signatory::FsKeyStore;
-use std::path;
 
 /// Recover an Eth Key
 #[derive(Command, Debug, Default, Parser)]
@@ -23,12 +20,10 @@ pub struct RecoverEthKeyCmd {
 impl Runnable for RecoverEthKeyCmd {
     fn run(&self) {
         let config = APP.config();
-        let keystore = path::Path::new(&config.keystore);
-        let keystore = FsKeyStore::create_or_open(keystore).expect("Could not open keystore");
+        let keyring = config.open_keyring();
 
         let name = self.args.get(0).expect("name is required");
-        let name = name.parse().expect("Could not parse name");
-        if let Ok(_info) = keystore.info(&name) {
+        if keyring.exists(name) {
             if !self.overwrite {
                 eprintln!("Key already exists, exiting.");
                 return;
@@ -53,11 +48,7 @@ impl Runnable for RecoverEthKeyCmd {
 
         let key = bip32::XPrv::derive_from_path(seed, &path).expect("Could not derive key");
         let key = k256::SecretKey::from(key.private_key());
-        let key = key
-            .to_pkcs8_der()
-            .expect("Could not PKCS8 encod private key");
-
-        keystore.store(&name, &key).expect("Could not store key");
+        keyring.store(name, &key);
 
         let show_cmd = ShowEthKeyCmd {
             args: vec![name.to_string()],
@@ -1,10 +1,12 @@
-use crate::{application::APP, prelude::*};
-use abscissa_core::{clap::Parser, Command, Runnable};
+use crate::{application::APP, commands::EntryPoint, config::GorcConfig, prelude::*};
+use abscissa_core::{clap::Parser, Command, Configurable, Runnable};
+use deep_space::Contact;
 use ethers::{prelude::*, types::Address as EthAddress};
+use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
 use gravity_utils::{
     connection_prep::{
         check_delegate_addresses, check_for_eth, check_for_fee_denom, create_rpc_connections,
-        wait_for_cosmos_node_ready,
+        wait_for_cosmos_node_ready, Endpoints,
     },
     ethereum::{downcast_to_u64, format_eth_address},
 };
@@ -12,7 +14,11 @@ use orchestrator::main_loop::{
     orchestrator_main_loop, ETH_ORACLE_LOOP_SPEED, ETH_SIGNER_LOOP_SPEED,
 };
 use relayer::main_loop::LOOP_SPEED as RELAYER_LOOP_SPEED;
-use std::{cmp::min, sync::Arc};
+use relayer::settings::RelayerSettings;
+use std::{cmp::min, path::PathBuf, sync::Arc, time::Duration};
+use tokio::signal::unix::{signal, SignalKind};
+use tokio::sync::watch;
+use tonic::transport::Channel;
 
 /// Start the Orchestrator
 #[derive(Command, Debug, Parser)]
@@ -25,6 +31,16 @@ pub struct StartCommand {
 
     #[clap(short, long)]
     orchestrator_only: bool,
+
+    /// observe, sign and plan relays as usual but log the transactions that would
+    /// be sent instead of sending them
+    #[clap(long)]
+    dry_run: bool,
+
+    /// testnets only: randomly delay, drop and reorder the submitted claims and
+    /// confirmations within the bounds of the [chaos] config section
+    #[clap(long)]
+    chaos: bool,
 }
 
 impl Runnable for StartCommand {
@@ -34,12 +50,6 @@ impl Runnable for StartCommand {
         let config = APP.config();
         let cosmos_prefix = config.cosmos.prefix.clone();
 
-        let cosmos_key = config.load_deep_space_key(self.cosmos_key.clone());
-        let cosmos_address = cosmos_key.to_address(&cosmos_prefix).unwrap();
-
-        let ethereum_wallet = config.load_ethers_wallet(self.ethereum_key.clone());
-        let ethereum_address = ethereum_wallet.address();
-
         let contract_address: EthAddress = config
             .gravity
             .contract
@@ -54,6 +64,9 @@ impl Runnable for StartCommand {
         );
 
         abscissa_tokio::run_with_actix(&APP, async {
+            let cosmos_key = config.load_deep_space_key(self.cosmos_key.clone()).await;
+            let cosmos_address = cosmos_key.to_address(&cosmos_prefix).unwrap();
+
             let connections = create_rpc_connections(
                 cosmos_prefix,
                 Some(config.cosmos.grpc.clone()),
@@ -71,8 +84,21 @@ impl Runnable for StartCommand {
                 .expect("Could not retrieve chain ID during orchestrator start");
             let chain_id =
                 downcast_to_u64(chain_id).expect("Chain ID overflowed when downcasting to u64");
-            let eth_client =
-                SignerMiddleware::new(provider, ethereum_wallet.clone().with_chain_id(chain_id));
+            let chaos = if self.chaos {
+                let chaos = config.load_chaos_config();
+                if let Err(e) = chaos.validate(chain_id) {
+                    status_err!("Could not start in chaos mode: {}", e);
+                    std::process::exit(1);
+                }
+                Some(chaos)
+            } else {
+                None
+            };
+            let ethereum_signer = config
+                .load_ethereum_signer(self.ethereum_key.clone(), chain_id)
+                .await;
+            let ethereum_address = ethereum_signer.address();
+            let eth_client = SignerMiddleware::new(provider, ethereum_signer);
             let eth_client = Arc::new(eth_client);
 
             info!("Starting Relayer + Oracle + Ethereum Signer");
@@ -84,6 +110,13 @@ impl Runnable for StartCommand {
             // historic chain state while syncing occurs
             wait_for_cosmos_node_ready(&contact).await;
 
+            let (contract_address, contract_deployment_height) = registered_contract(
+                &mut grpc,
+                contract_address,
+                config.ethereum.contract_deployment_height,
+            )
+            .await;
+
             // check if the delegate addresses are correctly configured
             check_delegate_addresses(
                 &mut grpc,
@@ -99,6 +132,35 @@ impl Runnable for StartCommand {
 
             let gas_price = config.cosmos.gas_price.as_tuple();
 
+            let relayer_settings = config
+                .load_relayer_settings(eth_client.clone())
+                .expect("Could not load relayer settings");
+            if let Some(url) = &config.ethereum.private_relay_rpc {
+                info!(
+                    "Submitting batches and logic calls through private relay {}",
+                    url
+                );
+            }
+            if let Some(bundler) = &relayer_settings.bundler {
+                info!(
+                    "Submitting batches as user operations of smart account {}",
+                    format_eth_address(bundler.smart_account())
+                );
+            }
+            let endpoints = Endpoints {
+                contact: contact.clone(),
+                eth_client: eth_client.clone(),
+                grpc: grpc.clone(),
+            };
+            let (settings_tx, settings_rx) = watch::channel(relayer_settings.clone());
+            let (endpoints_tx, endpoints_rx) = watch::channel(endpoints.clone());
+            tokio::spawn(reload_on_hangup(
+                endpoints,
+                timeout,
+                settings_tx,
+                endpoints_tx,
+            ));
+
             orchestrator_main_loop(
                 cosmos_key,
                 contact,
@@ -107,12 +169,33 @@ impl Runnable for StartCommand {
                 contract_address,
                 gas_price,
                 &config.metrics.listen_addr,
-                config.ethereum.gas_price_multiplier,
-                config.ethereum.gas_multiplier,
+                relayer_settings.eth_gas_price_multiplier,
+                relayer_settings.eth_gas_multiplier,
                 config.ethereum.blocks_to_search,
                 config.cosmos.gas_adjustment,
                 self.orchestrator_only,
                 config.cosmos.msg_batch_size,
+                relayer_settings.private_relay,
+                config
+                    .ethereum
+                    .oracle_checkpoint_file
+                    .as_ref()
+                    .map(PathBuf::from),
+                contract_deployment_height,
+                config.ethereum.claim_confirmations.to_overrides(),
+                config.ethereum.finality.to_finality(),
+                relayer_settings.fee_floor,
+                self.dry_run,
+                config.load_gas_tank_config(),
+                config.load_alerts_config(),
+                chaos,
+                config
+                    .relayer
+                    .work_sharing_turn_secs
+                    .map(std::time::Duration::from_secs),
+                relayer_settings.bundler,
+                Some(settings_rx),
+                Some(endpoints_rx),
             )
             .await;
         })
@@ -122,3 +205,181 @@ impl Runnable for StartCommand {
         });
     }
 }
+
+/// Returns the Gravity contract registered on the chain and the height to search for its
+/// events from, the configured contract is only used while the chain has none
+async fn registered_contract(
+    grpc: &mut GravityQueryClient<Channel>,
+    configured: EthAddress,
+    deployment_height: u64,
+) -> (EthAddress, u64) {
+    let contract = match cosmos_gravity::query::get_bridge_contract(grpc).await {
+        Ok(contract) => contract,
+        Err(e) => {
+            warn!(
+                "Could not query the bridge contract, using the configured one {:?}",
+                e
+            );
+            return (configured, deployment_height);
+        }
+    };
+    let registered = match contract.bridge_ethereum_address.parse::<EthAddress>() {
+        Ok(registered) => registered,
+        Err(_) => return (configured, deployment_height),
+    };
+    if registered == configured {
+        return (configured, deployment_height);
+    }
+
+    warn!(
+        "Configured Gravity contract {} differs from {} registered on chain, using the latter",
+        format_eth_address(configured),
+        format_eth_address(registered)
+    );
+    if contract.epoch > 0 {
+        (registered, contract.ethereum_height)
+    } else {
+        (registered, deployment_height)
+    }
+}
+
+/// Reloads the configuration file on SIGHUP and applies the settings that can change while
+/// the orchestrator is running: the log level, the Cosmos gRPC and Ethereum RPC endpoints and
+/// the relayer's gas multipliers, fee floor, private relay and bundler. The loops switch to the
+/// new settings at the start of their next iteration so the signer loop is never interrupted.
+/// A configuration with a setting that can't be applied is rejected as a whole and the current
+/// settings are kept. All other settings only take effect on restart.
+async fn reload_on_hangup(
+    endpoints: Endpoints,
+    timeout: Duration,
+    settings: watch::Sender<RelayerSettings>,
+    endpoint_updates: watch::Sender<Endpoints>,
+) {
+    let mut hangup = match signal(SignalKind::hangup()) {
+        Ok(hangup) => hangup,
+        Err(e) => {
+            error!(
+                "Could not listen for SIGHUP, configuration reload is disabled: {}",
+                e
+            );
+            return;
+        }
+    };
+
+    let mut endpoints = endpoints;
+    let mut grpc_url = APP.config().cosmos.grpc.clone();
+    let mut eth_rpc_url = APP.config().ethereum.rpc.clone();
+    while hangup.recv().await.is_some() {
+        let config = match reload_config() {
+            Ok(config) => config,
+            Err(e) => {
+                error!(
+                    "Could not reload configuration, keeping the current one: {}",
+                    e
+                );
+                continue;
+            }
+        };
+
+        // everything is loaded before anything is applied so that a bad setting leaves all
+        // of the current ones in place
+        let new_endpoints = if config.cosmos.grpc != grpc_url || config.ethereum.rpc != eth_rpc_url
+        {
+            match connect_endpoints(&config, &endpoints, timeout).await {
+                Ok(new_endpoints) => Some(new_endpoints),
+                Err(e) => {
+                    error!(
+                        "Could not switch RPC endpoints, keeping the current configuration: {}",
+                        e
+                    );
+                    continue;
+                }
+            }
+        } else {
+            None
+        };
+        let eth_client = new_endpoints
+            .as_ref()
+            .unwrap_or(&endpoints)
+            .eth_client
+            .clone();
+        let relayer_settings = match config.load_relayer_settings(eth_client) {
+            Ok(relayer_settings) => relayer_settings,
+            Err(e) => {
+                error!(
+                    "Could not reload relayer settings, keeping the current configuration: {}",
+                    e
+                );
+                continue;
+            }
+        };
+
+        if let Some(filter) = &config.log_level {
+            if let Err(e) = APP.set_log_filter(filter) {
+                error!("Could not apply log level {}: {}", filter, e);
+            }
+        }
+        if let Some(new_endpoints) = new_endpoints {
+            info!(
+                "Switching to Cosmos gRPC {} and Ethereum RPC {}",
+                config.cosmos.grpc, config.ethereum.rpc
+            );
+            grpc_url = config.cosmos.grpc.clone();
+            eth_rpc_url = config.ethereum.rpc.clone();
+            endpoints = new_endpoints.clone();
+            if endpoint_updates.send(new_endpoints).is_err() {
+                warn!("Orchestrator is not running, RPC endpoints were not reloaded");
+            }
+        }
+        if settings.send(relayer_settings).is_err() {
+            warn!("Relayer is not running, relayer settings were not reloaded");
+        }
+        info!("Reloaded configuration");
+    }
+}
+
+/// Connects to the configured RPC endpoints, keeping the current Ethereum signer. An Ethereum
+/// RPC endpoint of another chain than the signer's is rejected.
+async fn connect_endpoints(
+    config: &GorcConfig,
+    current: &Endpoints,
+    timeout: Duration,
+) -> Result<Endpoints, String> {
+    let grpc_url = config.cosmos.grpc.trim_end_matches('/').to_string();
+    let grpc = GravityQueryClient::connect(grpc_url.clone())
+        .await
+        .map_err(|e| format!("could not connect to Cosmos gRPC {}: {}", grpc_url, e))?;
+    let contact = Contact::new(&grpc_url, timeout, &current.contact.get_prefix())
+        .map_err(|e| format!("could not connect to Cosmos gRPC {}: {:?}", grpc_url, e))?;
+
+    let eth_rpc_url = &config.ethereum.rpc;
+    let provider = Provider::<Http>::try_from(eth_rpc_url.as_str())
+        .map_err(|e| format!("invalid Ethereum RPC url {}: {}", eth_rpc_url, e))?;
+    let chain_id = provider
+        .get_chainid()
+        .await
+        .map_err(|e| format!("could not reach Ethereum RPC {}: {}", eth_rpc_url, e))?;
+    let signer = current.eth_client.signer().clone();
+    if chain_id != signer.chain_id().into() {
+        return Err(format!(
+            "Ethereum RPC {} is on chain {}, the orchestrator is signing for chain {}",
+            eth_rpc_url,
+            chain_id,
+            signer.chain_id()
+        ));
+    }
+
+    Ok(Endpoints {
+        contact,
+        eth_client: Arc::new(SignerMiddleware::new(provider, signer)),
+        grpc,
+    })
+}
+
+fn reload_config() -> Result<GorcConfig, String> {
+    let path = EntryPoint::parse()
+        .config_path()
+        .ok_or_else(|| "no configuration file".to_string())?;
+    let config = std::fs::read_to_string(&path).map_err(|e| e.to_string())?;
+    toml::from_str(&config).map_err(|e| e.to_string())
+}
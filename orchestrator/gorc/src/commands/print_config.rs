@@ -17,10 +17,16 @@ impl Runnable for PrintConfigCmd {
             let config = APP.config();
             GorcConfig {
                 keystore: config.keystore.to_owned(),
+                keyring_backend: config.keyring_backend,
+                log_level: config.log_level.to_owned(),
                 gravity: config.gravity.to_owned(),
                 ethereum: config.ethereum.to_owned(),
                 cosmos: config.cosmos.to_owned(),
                 metrics: config.metrics.to_owned(),
+                relayer: config.relayer.to_owned(),
+                gas_tank: config.gas_tank.to_owned(),
+                alerts: config.alerts.to_owned(),
+                chaos: config.chaos.to_owned(),
             }
         };
 
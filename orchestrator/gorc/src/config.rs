@@ -1,27 +1,57 @@
-use cosmos_gravity::crypto::DEFAULT_HD_PATH;
+use crate::keyring::{Keyring, KeyringBackend};
+use cosmos_gravity::crypto::{PrivateKey, DEFAULT_HD_PATH};
+use cosmos_gravity::ledger::LedgerSigner;
+use cosmos_gravity::remote_signer::RemoteSigner as CosmosRemoteSigner;
+use ethereum_gravity::types::EthClient;
+use ethereum_gravity::user_operation::{Bundler, Paymaster};
+use ethers::providers::{Http, Provider};
 use ethers::signers::LocalWallet as EthWallet;
+use ethers::signers::Signer;
+use ethers::types::{Address as EthAddress, Bytes};
+use gravity_proto::gravity::Finality;
+use gravity_utils::signer::{EthSigner, RemoteSigner};
+use orchestrator::alerts::{AlertWebhook, AlertsConfig, PAGERDUTY_EVENTS_URL};
+use orchestrator::chaos::ChaosConfig;
+use orchestrator::ethereum_event_watcher::ConfirmationOverrides;
+use orchestrator::gas_tank::{FeeSwapConfig, GasTankConfig};
+use relayer::price_provider::{
+    ChainlinkPriceProvider, CoinGeckoPriceProvider, FeeFloor, PriceProvider, StaticPriceProvider,
+    COINGECKO_API_URL,
+};
+use relayer::settings::RelayerSettings;
 use serde::{Deserialize, Serialize};
-use signatory::FsKeyStore;
+use std::collections::HashMap;
+use std::convert::TryFrom;
 use std::net::SocketAddr;
 use std::path::Path;
+use std::sync::Arc;
+use std::time::Duration;
 
 #[derive(Clone, Debug, Deserialize, Serialize)]
 #[serde(default, deny_unknown_fields)]
 pub struct GorcConfig {
     pub keystore: String,
+    pub keyring_backend: KeyringBackend,
+    /// log filter in the RUST_LOG syntax, overrides RUST_LOG and --verbose when set
+    pub log_level: Option<String>,
     pub gravity: GravitySection,
     pub ethereum: EthereumSection,
     pub cosmos: CosmosSection,
     pub metrics: MetricsSection,
+    pub relayer: RelayerSection,
+    pub gas_tank: Option<GasTankSection>,
+    pub alerts: Option<AlertsSection>,
+    pub chaos: Option<ChaosSection>,
 }
 
 impl GorcConfig {
+    /// Opens the keystore with the configured keyring backend
+    pub fn open_keyring(&self) -> Keyring {
+        Keyring::open(Path::new(&self.keystore), self.keyring_backend)
+    }
+
     fn load_secret_key(&self, name: String) -> k256::elliptic_curve::SecretKey<k256::Secp256k1> {
-        let keystore = Path::new(&self.keystore);
-        let keystore = FsKeyStore::create_or_open(keystore).expect("Could not open keystore");
-        let name = name.parse().expect("Could not parse name");
-        let key = keystore.load(&name).expect("Could not load key");
-        key.to_pem().parse().expect("Could not parse pem")
+        self.open_keyring().load(&name)
     }
 
     pub fn load_clarity_key(&self, name: String) -> clarity::PrivateKey {
@@ -33,10 +63,173 @@ impl GorcConfig {
         EthWallet::from(self.load_secret_key(name))
     }
 
-    pub fn load_deep_space_key(&self, name: String) -> cosmos_gravity::crypto::PrivateKey {
-        let key = self.load_secret_key(name).to_bytes();
-        let key = deep_space::utils::bytes_to_hex_str(&key);
-        key.parse().expect("Could not parse private key")
+    /// Loads the Ethereum signer configured in the ethereum.signer section. The key name
+    /// only applies to the local signer, where it names a key in the keystore.
+    pub async fn load_ethereum_signer(&self, name: String, chain_id: u64) -> EthSigner {
+        match &self.ethereum.signer {
+            EthereumSignerSection::Local => EthSigner::from(self.load_ethers_wallet(name)),
+            #[cfg(feature = "aws")]
+            EthereumSignerSection::AwsKms { key_id, region } => {
+                EthSigner::aws_kms(key_id.clone(), region.clone(), chain_id)
+                    .await
+                    .expect("Could not connect to AWS KMS signer")
+            }
+            #[cfg(not(feature = "aws"))]
+            EthereumSignerSection::AwsKms { .. } => {
+                panic!("AWS KMS signer requires gorc to be built with the aws feature")
+            }
+            EthereumSignerSection::Remote { url, public_key } => EthSigner::from(
+                RemoteSigner::new(url.clone(), public_key.clone())
+                    .expect("Could not create remote signer"),
+            ),
+        }
+        .with_chain_id(chain_id)
+    }
+
+    /// Builds the relayer fee floor from the configured price provider, returns None
+    /// if no price provider is configured in which case everything is relayed
+    pub fn load_fee_floor(&self, eth_client: EthClient) -> Result<Option<FeeFloor>, String> {
+        let price_provider: Arc<dyn PriceProvider> = match &self.relayer.price_provider {
+            PriceProviderSection::None => return Ok(None),
+            PriceProviderSection::CoinGecko { api_url, api_key } => Arc::new(
+                CoinGeckoPriceProvider::new(api_url.clone(), api_key.clone()),
+            ),
+            PriceProviderSection::Chainlink { feeds } => {
+                Arc::new(ChainlinkPriceProvider::new(eth_client, feeds.clone()))
+            }
+            PriceProviderSection::StaticFile { path } => Arc::new(
+                StaticPriceProvider::from_file(Path::new(path))
+                    .map_err(|e| format!("could not load static prices: {}", e))?,
+            ),
+        };
+
+        Ok(Some(FeeFloor::new(
+            price_provider,
+            self.relayer.min_fee_ratio,
+        )))
+    }
+
+    /// The relayer settings that can be changed by reloading the configuration while the
+    /// orchestrator is running
+    pub fn load_relayer_settings(&self, eth_client: EthClient) -> Result<RelayerSettings, String> {
+        Ok(RelayerSettings {
+            eth_gas_price_multiplier: self.ethereum.gas_price_multiplier,
+            eth_gas_multiplier: self.ethereum.gas_multiplier,
+            private_relay: self.load_private_relay()?,
+            fee_floor: self.load_fee_floor(eth_client)?,
+            bundler: self.load_bundler()?,
+        })
+    }
+
+    pub fn load_private_relay(&self) -> Result<Option<Provider<Http>>, String> {
+        self.ethereum
+            .private_relay_rpc
+            .as_ref()
+            .map(|url| {
+                Provider::<Http>::try_from(url.as_str())
+                    .map_err(|e| format!("invalid private relay RPC url {}: {}", url, e))
+            })
+            .transpose()
+    }
+
+    /// Connects to the configured ERC-4337 bundler, returns None if batches are to be
+    /// submitted as regular transactions
+    pub fn load_bundler(&self) -> Result<Option<Bundler>, String> {
+        let bundler = match &self.relayer.bundler {
+            Some(bundler) => bundler,
+            None => return Ok(None),
+        };
+        let provider = Provider::<Http>::try_from(bundler.url.as_str())
+            .map_err(|e| format!("invalid bundler url {}: {}", bundler.url, e))?;
+        let paymaster = match (&bundler.paymaster_url, &bundler.paymaster_and_data) {
+            (Some(url), _) => Paymaster::Rpc(
+                Provider::<Http>::try_from(url.as_str())
+                    .map_err(|e| format!("invalid paymaster url {}: {}", url, e))?,
+            ),
+            (None, Some(paymaster_and_data)) => Paymaster::Static(paymaster_and_data.clone()),
+            (None, None) => Paymaster::None,
+        };
+
+        Ok(Some(Bundler::new(
+            provider,
+            bundler.entry_point,
+            bundler.smart_account,
+            paymaster,
+        )))
+    }
+
+    /// Converts the gas_tank section into the orchestrator's gas tank monitoring config,
+    /// returns None if no gas tank monitoring is configured
+    pub fn load_gas_tank_config(&self) -> Option<GasTankConfig> {
+        self.gas_tank.as_ref().map(|gas_tank| GasTankConfig {
+            low_balance_threshold: ((gas_tank.low_balance_threshold * 1e18) as u128).into(),
+            webhook_url: gas_tank.webhook_url.clone(),
+            fee_swap: gas_tank.fee_swap.as_ref().map(|fee_swap| FeeSwapConfig {
+                router: fee_swap.router,
+                weth: fee_swap.weth,
+                tokens: fee_swap.tokens.clone(),
+                max_slippage_bps: fee_swap.max_slippage_bps,
+            }),
+        })
+    }
+
+    /// Converts the alerts section into the orchestrator's alerting config, returns None if
+    /// no alerts are configured
+    pub fn load_alerts_config(&self) -> Option<AlertsConfig> {
+        self.alerts.as_ref().map(|alerts| AlertsConfig {
+            webhooks: alerts
+                .webhooks
+                .iter()
+                .map(AlertWebhookSection::to_webhook)
+                .collect(),
+            slashing_margin_blocks: alerts.slashing_margin_blocks,
+            attestation_stall: Duration::from_secs(alerts.attestation_stall_secs),
+            batch_expiry_blocks: alerts.batch_expiry_blocks,
+            batch_gas_estimate: alerts.batch_gas_estimate,
+        })
+    }
+
+    /// Converts the chaos section into the orchestrator's chaos mode config, the section's
+    /// defaults are used if it is missing
+    pub fn load_chaos_config(&self) -> ChaosConfig {
+        let chaos = self.chaos.clone().unwrap_or_default();
+        ChaosConfig {
+            drop_probability: chaos.drop_probability,
+            reorder_probability: chaos.reorder_probability,
+            max_delay: Duration::from_secs(chaos.max_delay_secs),
+        }
+    }
+
+    /// Loads the Cosmos key, connecting to the Ledger device or remote signer holding it
+    /// when the keyring backend is ledger or remote
+    pub async fn load_deep_space_key(&self, name: String) -> PrivateKey {
+        let keyring = self.open_keyring();
+        let signer = match self.keyring_backend {
+            KeyringBackend::Ledger => {
+                let path = keyring.load_external(&name);
+                PrivateKey::external(
+                    LedgerSigner::connect(&path, self.cosmos.prefix.trim())
+                        .expect("Could not connect to Ledger"),
+                )
+            }
+            KeyringBackend::Remote => {
+                let url =
+                    self.cosmos.remote_signer_url.clone().expect(
+                        "cosmos.remote_signer_url is required by the remote keyring backend",
+                    );
+                PrivateKey::external(
+                    CosmosRemoteSigner::connect(url, keyring.load_external(&name))
+                        .await
+                        .expect("Could not connect to remote signer"),
+                )
+            }
+            _ => {
+                let key = self.load_secret_key(name).to_bytes();
+                let key = deep_space::utils::bytes_to_hex_str(&key);
+                return key.parse().expect("Could not parse private key");
+            }
+        };
+        signer.expect("Could not load Cosmos key")
     }
 }
 
@@ -44,10 +237,16 @@ impl Default for GorcConfig {
     fn default() -> Self {
         Self {
             keystore: "/tmp/keystore".to_owned(),
+            keyring_backend: KeyringBackend::default(),
+            log_level: None,
             gravity: GravitySection::default(),
             ethereum: EthereumSection::default(),
             cosmos: CosmosSection::default(),
             metrics: MetricsSection::default(),
+            relayer: RelayerSection::default(),
+            gas_tank: None,
+            alerts: None,
+            chaos: None,
         }
     }
 }
@@ -76,6 +275,20 @@ pub struct EthereumSection {
     pub gas_price_multiplier: f32,
     pub gas_multiplier: f32,
     pub blocks_to_search: u64,
+    pub signer: EthereumSignerSection,
+    /// when set, batches and logic calls are submitted through this private relay RPC
+    /// (for example Flashbots Protect) rather than the public mempool
+    pub private_relay_rpc: Option<String>,
+    /// file the oracle persists its last scanned block to, so a restart resumes from it
+    pub oracle_checkpoint_file: Option<String>,
+    /// block the Gravity contract was deployed at, the oracle never scans before it
+    pub contract_deployment_height: u64,
+    /// confirmations to wait for before claiming each type of event, defaults to a
+    /// delay chosen for the chain
+    pub claim_confirmations: ClaimConfirmationsSection,
+    /// when blocks are final enough to claim their events, it must match the finality the
+    /// chain is configured with for the Gravity contract's EVM chain
+    pub finality: FinalitySection,
 }
 
 impl Default for EthereumSection {
@@ -86,10 +299,83 @@ impl Default for EthereumSection {
             gas_price_multiplier: 1.0f32,
             gas_multiplier: 1.0f32,
             blocks_to_search: 5000,
+            signer: EthereumSignerSection::default(),
+            private_relay_rpc: None,
+            oracle_checkpoint_file: None,
+            contract_deployment_height: 0,
+            claim_confirmations: ClaimConfirmationsSection::default(),
+            finality: FinalitySection::default(),
         }
     }
 }
 
+#[derive(Clone, Copy, Debug, Deserialize, Serialize, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub enum FinalitySection {
+    /// wait for the claim confirmations past the chain head
+    Confirmations,
+    /// claim events up to the block tagged safe
+    Safe,
+    /// claim events up to the block tagged finalized, for optimistic rollups
+    Finalized,
+}
+
+impl Default for FinalitySection {
+    fn default() -> Self {
+        FinalitySection::Confirmations
+    }
+}
+
+impl FinalitySection {
+    pub fn to_finality(self) -> Finality {
+        match self {
+            FinalitySection::Confirmations => Finality::Confirmations,
+            FinalitySection::Safe => Finality::Safe,
+            FinalitySection::Finalized => Finality::Finalized,
+        }
+    }
+}
+
+#[derive(Clone, Debug, Default, Deserialize, Serialize)]
+#[serde(default, deny_unknown_fields)]
+pub struct ClaimConfirmationsSection {
+    pub send_to_cosmos: Option<u64>,
+    pub transaction_batch: Option<u64>,
+    pub erc20_deployed: Option<u64>,
+    pub logic_call: Option<u64>,
+    pub valset_updated: Option<u64>,
+}
+
+impl ClaimConfirmationsSection {
+    pub fn to_overrides(&self) -> ConfirmationOverrides {
+        ConfirmationOverrides {
+            send_to_cosmos: self.send_to_cosmos,
+            transaction_batch: self.transaction_batch,
+            erc20_deployed: self.erc20_deployed,
+            logic_call: self.logic_call,
+            valset_updated: self.valset_updated,
+        }
+    }
+}
+
+/// Where the Ethereum key used by the orchestrator is held
+#[derive(Clone, Debug, Deserialize, Serialize)]
+#[serde(tag = "type", rename_all = "snake_case")]
+pub enum EthereumSignerSection {
+    /// a key in the local keystore
+    Local,
+    /// an AWS KMS secp256k1 key
+    AwsKms { key_id: String, region: String },
+    /// a web3signer compatible signing service holding the key with this public key
+    Remote { url: String, public_key: String },
+}
+
+impl Default for EthereumSignerSection {
+    fn default() -> Self {
+        EthereumSignerSection::Local
+    }
+}
+
 #[derive(Clone, Debug, Deserialize, Serialize)]
 #[serde(default, deny_unknown_fields)]
 pub struct CosmosSection {
@@ -99,6 +385,8 @@ pub struct CosmosSection {
     pub gas_adjustment: f64,
     pub msg_batch_size: u32,
     pub gas_price: GasPrice,
+    /// the gravity.signer.v1.Signer service holding the keys of the remote keyring backend
+    pub remote_signer_url: Option<String>,
 }
 
 impl Default for CosmosSection {
@@ -110,6 +398,7 @@ impl Default for CosmosSection {
             gas_price: GasPrice::default(),
             gas_adjustment: 1.0f64,
             msg_batch_size: 5,
+            remote_signer_url: None,
         }
     }
 }
@@ -136,6 +425,206 @@ impl GasPrice {
     }
 }
 
+#[derive(Clone, Debug, Deserialize, Serialize)]
+#[serde(default, deny_unknown_fields)]
+pub struct RelayerSection {
+    /// where token prices used to value batch and logic call fees come from
+    pub price_provider: PriceProviderSection,
+    /// the minimum ratio of fee value to estimated submission cost required to relay,
+    /// only applies when a price provider is configured
+    pub min_fee_ratio: f64,
+    /// if set, relayers in the validator set take turns of this many seconds to submit
+    /// each batch and logic call instead of all racing to submit it
+    pub work_sharing_turn_secs: Option<u64>,
+    /// if set, batches are submitted as ERC-4337 user operations through this bundler
+    pub bundler: Option<BundlerSection>,
+}
+
+impl Default for RelayerSection {
+    fn default() -> Self {
+        Self {
+            price_provider: PriceProviderSection::default(),
+            min_fee_ratio: 1.0f64,
+            work_sharing_turn_secs: None,
+            bundler: None,
+        }
+    }
+}
+
+/// An ERC-4337 bundler and the smart account, owned by the orchestrator's Ethereum key,
+/// that batches are submitted from
+#[derive(Clone, Debug, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct BundlerSection {
+    pub url: String,
+    pub entry_point: EthAddress,
+    pub smart_account: EthAddress,
+    /// a paymaster service sponsoring user operations through pm_sponsorUserOperation
+    #[serde(default)]
+    pub paymaster_url: Option<String>,
+    /// fixed paymasterAndData attached to every user operation, used when no paymaster
+    /// service is configured
+    #[serde(default)]
+    pub paymaster_and_data: Option<Bytes>,
+}
+
+/// Where the relayer gets token prices from
+#[derive(Clone, Debug, Deserialize, Serialize)]
+#[serde(tag = "type", rename_all = "snake_case")]
+pub enum PriceProviderSection {
+    /// no prices, every batch and logic call is relayed regardless of fees
+    None,
+    /// the CoinGecko token price API
+    CoinGecko {
+        #[serde(default = "default_coingecko_api_url")]
+        api_url: String,
+        #[serde(default)]
+        api_key: Option<String>,
+    },
+    /// Chainlink token/ETH price feeds, keyed by token address
+    Chainlink {
+        feeds: HashMap<EthAddress, EthAddress>,
+    },
+    /// a JSON file mapping token addresses to their price in ETH
+    StaticFile { path: String },
+}
+
+impl Default for PriceProviderSection {
+    fn default() -> Self {
+        PriceProviderSection::None
+    }
+}
+
+fn default_coingecko_api_url() -> String {
+    COINGECKO_API_URL.to_owned()
+}
+
+#[derive(Clone, Debug, Deserialize, Serialize)]
+#[serde(default, deny_unknown_fields)]
+pub struct GasTankSection {
+    /// balance in ETH below which the gas tank is considered low
+    pub low_balance_threshold: f64,
+    /// url that receives a JSON POST when the balance drops below the threshold
+    pub webhook_url: Option<String>,
+    /// if set, earned fees are swapped to ETH while the balance is low
+    pub fee_swap: Option<FeeSwapSection>,
+}
+
+impl Default for GasTankSection {
+    fn default() -> Self {
+        Self {
+            low_balance_threshold: 0.1f64,
+            webhook_url: None,
+            fee_swap: None,
+        }
+    }
+}
+
+#[derive(Clone, Debug, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct FeeSwapSection {
+    /// a Uniswap V2 compatible router
+    pub router: EthAddress,
+    /// the wrapped ETH token the router swaps through
+    pub weth: EthAddress,
+    /// the ERC20 tokens whose balance is swapped to ETH
+    pub tokens: Vec<EthAddress>,
+    /// the maximum accepted slippage from the router's quote, in basis points
+    #[serde(default = "default_max_slippage_bps")]
+    pub max_slippage_bps: u64,
+}
+
+fn default_max_slippage_bps() -> u64 {
+    100
+}
+
+#[derive(Clone, Debug, Deserialize, Serialize)]
+#[serde(default, deny_unknown_fields)]
+pub struct AlertsSection {
+    /// where alerts are posted to when a critical bridge condition starts and once it is
+    /// resolved
+    pub webhooks: Vec<AlertWebhookSection>,
+    /// alert once an unsigned signer set, batch or logic call is this many Cosmos blocks
+    /// away from the end of its slashing window
+    pub slashing_margin_blocks: u64,
+    /// alert once the chain has observed no Ethereum event for this many seconds while the
+    /// Gravity contract has emitted newer ones
+    pub attestation_stall_secs: u64,
+    /// alert for batches timing out within this many Ethereum blocks whose fees don't cover
+    /// their cost, only checked when a price provider is configured
+    pub batch_expiry_blocks: u64,
+    /// the gas a batch submission is assumed to use when valuing its cost
+    pub batch_gas_estimate: u64,
+}
+
+impl Default for AlertsSection {
+    fn default() -> Self {
+        Self {
+            webhooks: Vec::new(),
+            slashing_margin_blocks: 1000,
+            attestation_stall_secs: 3600,
+            batch_expiry_blocks: 600,
+            batch_gas_estimate: 500000,
+        }
+    }
+}
+
+/// A webhook alerts are posted to
+#[derive(Clone, Debug, Deserialize, Serialize)]
+#[serde(tag = "type", rename_all = "snake_case")]
+pub enum AlertWebhookSection {
+    /// a Slack incoming webhook
+    Slack { url: String },
+    /// a PagerDuty Events API v2 integration
+    #[serde(rename = "pagerduty")]
+    PagerDuty {
+        routing_key: String,
+        #[serde(default = "default_pagerduty_url")]
+        url: String,
+    },
+    /// any service accepting the alert as a JSON object
+    Json { url: String },
+}
+
+impl AlertWebhookSection {
+    pub fn to_webhook(&self) -> AlertWebhook {
+        match self {
+            AlertWebhookSection::Slack { url } => AlertWebhook::Slack { url: url.clone() },
+            AlertWebhookSection::PagerDuty { routing_key, url } => AlertWebhook::PagerDuty {
+                url: url.clone(),
+                routing_key: routing_key.clone(),
+            },
+            AlertWebhookSection::Json { url } => AlertWebhook::Json { url: url.clone() },
+        }
+    }
+}
+
+fn default_pagerduty_url() -> String {
+    PAGERDUTY_EVENTS_URL.to_owned()
+}
+
+#[derive(Clone, Debug, Deserialize, Serialize)]
+#[serde(default, deny_unknown_fields)]
+pub struct ChaosSection {
+    /// the chance a set of confirmations is dropped, at most 0.5
+    pub drop_probability: f64,
+    /// the chance a set of confirmations is submitted out of order
+    pub reorder_probability: f64,
+    /// claims and confirmations are held back for a random time up to this long, at most
+    /// 600 seconds
+    pub max_delay_secs: u64,
+}
+
+impl Default for ChaosSection {
+    fn default() -> Self {
+        Self {
+            drop_probability: 0.1f64,
+            reorder_probability: 0.25f64,
+            max_delay_secs: 120,
+        }
+    }
+}
+
 #[derive(Clone, Debug, Deserialize, Serialize)]
 #[serde(default, deny_unknown_fields)]
 pub struct MetricsSection {
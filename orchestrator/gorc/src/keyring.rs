@@ -0,0 +1,262 @@
+//! Key storage for gorc. The `test` backend keeps keys as unencrypted PKCS8 PEM files in the
+//! keystore directory, the `file` backend keeps them as password encrypted (scrypt and
+//! AES-128-CTR) JSON keystore files so that no plaintext key material is written to disk.
+//! The `os` backend keeps keys in the operating system's credential store. The `ledger` and
+//! `remote` backends never see the key, the keystore only records where a Cosmos key is held:
+//! its derivation path on the Ledger device or its name at the remote signer.
+
+use gravity_utils::ethereum::{bytes_to_hex_str, hex_str_to_bytes};
+use k256::{pkcs8::ToPrivateKey, SecretKey};
+use rand_core::OsRng;
+use serde::{Deserialize, Serialize};
+use signatory::{FsKeyStore, KeyName};
+use std::fs;
+use std::path::{Path, PathBuf};
+
+/// Environment variable the file keyring password is read from, if it isn't set
+/// the password is prompted for
+pub const KEYRING_PASSWORD_ENV: &str = "GORC_KEYRING_PASSWORD";
+
+/// The service name keys are stored under in the operating system's credential store
+const OS_KEYRING_SERVICE: &str = "gorc";
+
+#[derive(Clone, Copy, Debug, Deserialize, Serialize, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub enum KeyringBackend {
+    /// unencrypted keys, only suitable for testing
+    Test,
+    /// password encrypted keys
+    File,
+    /// keys in the operating system's credential store
+    Os,
+    /// Cosmos keys held by a Ledger device running the Cosmos app
+    Ledger,
+    /// Cosmos keys held by a remote signer, see cosmos.remote_signer_url
+    Remote,
+}
+
+impl KeyringBackend {
+    /// The extension of the files recording the backend's keys in the keystore
+    fn extension(&self) -> &'static str {
+        match self {
+            KeyringBackend::Test => "pem",
+            KeyringBackend::File => "json",
+            KeyringBackend::Os => "os",
+            KeyringBackend::Ledger => "ledger",
+            KeyringBackend::Remote => "remote",
+        }
+    }
+
+    /// Whether the backend only records keys held elsewhere, these keys can not be loaded
+    pub fn is_external(&self) -> bool {
+        matches!(self, KeyringBackend::Ledger | KeyringBackend::Remote)
+    }
+}
+
+impl Default for KeyringBackend {
+    fn default() -> Self {
+        KeyringBackend::Test
+    }
+}
+
+pub struct Keyring {
+    dir: PathBuf,
+    backend: KeyringBackend,
+}
+
+impl Keyring {
+    pub fn open(keystore: &Path, backend: KeyringBackend) -> Self {
+        fs::create_dir_all(keystore).expect("Could not open keystore");
+        Keyring {
+            dir: keystore.to_path_buf(),
+            backend,
+        }
+    }
+
+    fn fs_keystore(&self) -> FsKeyStore {
+        FsKeyStore::create_or_open(&self.dir).expect("Could not open keystore")
+    }
+
+    fn key_file(&self, name: &str) -> PathBuf {
+        // names are validated like those of the test backend so they can't escape the keystore
+        let _: KeyName = name.parse().expect("Could not parse name");
+        self.dir
+            .join(format!("{}.{}", name, self.backend.extension()))
+    }
+
+    fn os_entry(name: &str) -> keyring::Entry {
+        keyring::Entry::new(OS_KEYRING_SERVICE, name)
+    }
+
+    pub fn exists(&self, name: &str) -> bool {
+        match self.backend {
+            KeyringBackend::Test => {
+                let name = name.parse().expect("Could not parse name");
+                self.fs_keystore().info(&name).is_ok()
+            }
+            _ => self.key_file(name).is_file(),
+        }
+    }
+
+    pub fn store(&self, name: &str, key: &SecretKey) {
+        match self.backend {
+            KeyringBackend::Test => {
+                let name = name.parse().expect("Could not parse name");
+                let key = key
+                    .to_pkcs8_der()
+                    .expect("Could not PKCS8 encod private key");
+                self.fs_keystore()
+                    .store(&name, &key)
+                    .expect("Could not store key");
+            }
+            KeyringBackend::File => {
+                let password = read_password("> Enter a password to encrypt the key with:\n");
+                let id = eth_keystore::encrypt_key(&self.dir, &mut OsRng, key.to_bytes(), password)
+                    .expect("Could not encrypt key");
+                fs::rename(self.dir.join(id), self.key_file(name)).expect("Could not store key");
+            }
+            KeyringBackend::Os => {
+                // the keystore file only lists the key, the key itself is in the credential store
+                let key_file = self.key_file(name);
+                Self::os_entry(name)
+                    .set_password(&bytes_to_hex_str(&key.to_bytes()))
+                    .expect("Could not store key");
+                fs::write(key_file, "").expect("Could not store key");
+            }
+            KeyringBackend::Ledger | KeyringBackend::Remote => {
+                panic!(
+                    "The {:?} keyring backend can not store private keys",
+                    self.backend
+                )
+            }
+        }
+    }
+
+    /// Records a key held outside of the keystore by the ledger or remote backend, the
+    /// location is the key's derivation path or its name at the remote signer
+    pub fn store_external(&self, name: &str, location: &str) {
+        assert!(
+            self.backend.is_external(),
+            "The {:?} keyring backend stores private keys",
+            self.backend
+        );
+        fs::write(self.key_file(name), location).expect("Could not store key");
+    }
+
+    /// Where a key of the ledger or remote backend is held, see `store_external`
+    pub fn load_external(&self, name: &str) -> String {
+        assert!(
+            self.backend.is_external(),
+            "The {:?} keyring backend stores private keys",
+            self.backend
+        );
+        let location = fs::read_to_string(self.key_file(name)).expect("Could not load key");
+        location.trim().to_string()
+    }
+
+    pub fn load(&self, name: &str) -> SecretKey {
+        match self.backend {
+            KeyringBackend::Test => {
+                let name = name.parse().expect("Could not parse name");
+                let key = self.fs_keystore().load(&name).expect("Could not load key");
+                key.to_pem().parse().expect("Could not parse pem")
+            }
+            KeyringBackend::File => {
+                let prompt = format!("> Enter the password for key {}:\n", name);
+                let password = read_password(&prompt);
+                let key = eth_keystore::decrypt_key(self.key_file(name), password)
+                    .expect("Could not decrypt key");
+                SecretKey::from_bytes(&key).expect("Could not parse key")
+            }
+            KeyringBackend::Os => {
+                assert!(self.exists(name), "Could not load key");
+                let key = Self::os_entry(name)
+                    .get_password()
+                    .expect("Could not load key");
+                let key = hex_str_to_bytes(&key).expect("Could not parse key");
+                SecretKey::from_bytes(&key).expect("Could not parse key")
+            }
+            KeyringBackend::Ledger | KeyringBackend::Remote => panic!(
+                "Keys of the {:?} keyring backend never leave their signer",
+                self.backend
+            ),
+        }
+    }
+
+    pub fn delete(&self, name: &str) {
+        match self.backend {
+            KeyringBackend::Test => {
+                let name = name.parse().expect("Could not parse name");
+                self.fs_keystore()
+                    .delete(&name)
+                    .expect("Could not delete key");
+            }
+            KeyringBackend::Os => {
+                let key_file = self.key_file(name);
+                Self::os_entry(name)
+                    .delete_password()
+                    .expect("Could not delete key");
+                fs::remove_file(key_file).expect("Could not delete key")
+            }
+            _ => fs::remove_file(self.key_file(name)).expect("Could not delete key"),
+        }
+    }
+
+    /// Moves a key to a new name, replacing any key already stored under it
+    pub fn rename(&self, name: &str, new_name: &str) {
+        match self.backend {
+            KeyringBackend::Test | KeyringBackend::Os => {
+                let key = self.load(name);
+                self.store(new_name, &key);
+                self.delete(name);
+            }
+            _ => fs::rename(self.key_file(name), self.key_file(new_name))
+                .expect("Could not rename key"),
+        }
+    }
+
+    /// The names of all stored keys
+    pub fn names(&self) -> Vec<String> {
+        let extension = self.backend.extension();
+
+        let mut names = Vec::new();
+        for entry in self.dir.read_dir().expect("Could not read keystore") {
+            let path = entry.unwrap().path();
+            if path.is_file() && path.extension().map_or(false, |e| e == extension) {
+                let name = path.file_stem().unwrap();
+                names.push(name.to_str().unwrap().to_string());
+            }
+        }
+        names
+    }
+}
+
+fn read_password(prompt: &str) -> String {
+    match std::env::var(KEYRING_PASSWORD_ENV) {
+        Ok(password) => password,
+        Err(_) => rpassword::read_password_from_tty(Some(prompt)).expect("Could not read password"),
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    #[should_panic(expected = "Could not parse name")]
+    fn test_key_file_rejects_path_traversal() {
+        let dir = std::env::temp_dir().join("gorc-keyring-test");
+        let keyring = Keyring::open(&dir, KeyringBackend::File);
+        keyring.key_file("../outside");
+    }
+
+    #[test]
+    fn test_key_file_extension() {
+        let dir = std::env::temp_dir().join("gorc-keyring-test");
+        let keyring = Keyring::open(&dir, KeyringBackend::Ledger);
+        assert_eq!(
+            keyring.key_file("orchestrator"),
+            dir.join("orchestrator.ledger")
+        );
+    }
+}
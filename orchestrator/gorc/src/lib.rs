@@ -19,5 +19,6 @@ pub mod application;
 pub mod commands;
 pub mod config;
 pub mod error;
+pub mod keyring;
 pub mod prelude;
 pub mod utils;
@@ -18,7 +18,7 @@ mod gravity_mod {
     use std::sync::Arc;
     pub static GRAVITY_ABI: ethers::contract::Lazy<ethers::core::abi::Abi> =
         ethers::contract::Lazy::new(|| {
-            serde_json :: from_str ("[\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_gravityId\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_powerThreshold\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"address[]\",\n        \"name\": \"_validators\",\n        \"type\": \"address[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_powers\",\n        \"type\": \"uint256[]\"\n      }\n    ],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"constructor\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"BatchTimedOut\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"IncorrectCheckpoint\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"cumulativePower\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"powerThreshold\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"InsufficientPower\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"newNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"currentNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"InvalidBatchNonce\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"InvalidLogicCallFees\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"newNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"currentNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"InvalidLogicCallNonce\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"InvalidLogicCallTransfers\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"InvalidSendToCosmos\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"InvalidSignature\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"newNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"currentNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"InvalidValsetNonce\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"LogicCallTimedOut\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"MalformedBatch\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"MalformedCurrentValidatorSet\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"MalformedNewValidatorSet\",\n    \"type\": \"error\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": false,\n        \"internalType\": \"string\",\n        \"name\": \"_cosmosDenom\",\n        \"type\": \"string\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"string\",\n        \"name\": \"_name\",\n        \"type\": \"string\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"string\",\n        \"name\": \"_symbol\",\n        \"type\": \"string\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint8\",\n        \"name\": \"_decimals\",\n        \"type\": \"uint8\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"ERC20DeployedEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": false,\n        \"internalType\": \"bytes32\",\n        \"name\": \"_invalidationId\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_invalidationNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"bytes\",\n        \"name\": \"_returnData\",\n        \"type\": \"bytes\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"LogicCallEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_sender\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"bytes32\",\n        \"name\": \"_destination\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_amount\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"SendToCosmosEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": true,\n        \"internalType\": \"uint256\",\n        \"name\": \"_batchNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_token\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"TransactionBatchExecutedEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": true,\n        \"internalType\": \"uint256\",\n        \"name\": \"_newValsetNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_rewardAmount\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"address\",\n        \"name\": \"_rewardToken\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"address[]\",\n        \"name\": \"_validators\",\n        \"type\": \"address[]\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_powers\",\n        \"type\": \"uint256[]\"\n      }\n    ],\n    \"name\": \"ValsetUpdatedEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"string\",\n        \"name\": \"_cosmosDenom\",\n        \"type\": \"string\"\n      },\n      {\n        \"internalType\": \"string\",\n        \"name\": \"_name\",\n        \"type\": \"string\"\n      },\n      {\n        \"internalType\": \"string\",\n        \"name\": \"_symbol\",\n        \"type\": \"string\"\n      },\n      {\n        \"internalType\": \"uint8\",\n        \"name\": \"_decimals\",\n        \"type\": \"uint8\"\n      }\n    ],\n    \"name\": \"deployERC20\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_erc20Address\",\n        \"type\": \"address\"\n      }\n    ],\n    \"name\": \"lastBatchNonce\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_invalidation_id\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"name\": \"lastLogicCallNonce\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_destination\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_amount\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"sendToCosmos\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_gravityId\",\n    \"outputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"name\": \"state_invalidationMapping\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"\",\n        \"type\": \"address\"\n      }\n    ],\n    \"name\": \"state_lastBatchNonces\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_lastEventNonce\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_lastValsetCheckpoint\",\n    \"outputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_lastValsetNonce\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_powerThreshold\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_currentValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint8\",\n            \"name\": \"v\",\n            \"type\": \"uint8\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"r\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"s\",\n            \"type\": \"bytes32\"\n          }\n        ],\n        \"internalType\": \"struct ValSignature[]\",\n        \"name\": \"_sigs\",\n        \"type\": \"tuple[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_amounts\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"internalType\": \"address[]\",\n        \"name\": \"_destinations\",\n        \"type\": \"address[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_fees\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_batchNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_batchTimeout\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"submitBatch\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_currentValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint8\",\n            \"name\": \"v\",\n            \"type\": \"uint8\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"r\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"s\",\n            \"type\": \"bytes32\"\n          }\n        ],\n        \"internalType\": \"struct ValSignature[]\",\n        \"name\": \"_sigs\",\n        \"type\": \"tuple[]\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"transferAmounts\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"transferTokenContracts\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"feeAmounts\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"feeTokenContracts\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"logicContractAddress\",\n            \"type\": \"address\"\n          },\n          {\n            \"internalType\": \"bytes\",\n            \"name\": \"payload\",\n            \"type\": \"bytes\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"timeOut\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"invalidationId\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"invalidationNonce\",\n            \"type\": \"uint256\"\n          }\n        ],\n        \"internalType\": \"struct LogicCallArgs\",\n        \"name\": \"_args\",\n        \"type\": \"tuple\"\n      }\n    ],\n    \"name\": \"submitLogicCall\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_currentValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint8\",\n            \"name\": \"v\",\n            \"type\": \"uint8\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"r\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"s\",\n            \"type\": \"bytes32\"\n          }\n        ],\n        \"internalType\": \"struct ValSignature[]\",\n        \"name\": \"_sigs\",\n        \"type\": \"tuple[]\"\n      },\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_theHash\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_powerThreshold\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"testCheckValidatorSignatures\",\n    \"outputs\": [],\n    \"stateMutability\": \"pure\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_valsetArgs\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_gravityId\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"name\": \"testMakeCheckpoint\",\n    \"outputs\": [],\n    \"stateMutability\": \"pure\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_newValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_currentValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint8\",\n            \"name\": \"v\",\n            \"type\": \"uint8\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"r\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"s\",\n            \"type\": \"bytes32\"\n          }\n        ],\n        \"internalType\": \"struct ValSignature[]\",\n        \"name\": \"_sigs\",\n        \"type\": \"tuple[]\"\n      }\n    ],\n    \"name\": \"updateValset\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  }\n]\n") . expect ("invalid abi")
+            serde_json :: from_str ("[\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_gravityId\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_powerThreshold\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"address[]\",\n        \"name\": \"_validators\",\n        \"type\": \"address[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_powers\",\n        \"type\": \"uint256[]\"\n      }\n    ],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"constructor\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"BatchTimedOut\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"ContractVersionAlreadyAnnounced\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"IncorrectCheckpoint\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"cumulativePower\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"powerThreshold\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"InsufficientPower\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"InsufficientTransferGas\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"newNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"currentNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"InvalidBatchNonce\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"InvalidLogicCallFees\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"newNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"currentNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"InvalidLogicCallNonce\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"InvalidLogicCallTransfers\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"InvalidSendToCosmos\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"InvalidSignature\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"newNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"currentNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"InvalidValsetNonce\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"LogicCallTimedOut\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"MalformedBatch\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"MalformedCurrentValidatorSet\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"MalformedNewValidatorSet\",\n    \"type\": \"error\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"NothingToClaim\",\n    \"type\": \"error\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_version\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"ContractVersionEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": true,\n        \"internalType\": \"uint256\",\n        \"name\": \"_batchNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_token\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"ERC1155BatchExecutedEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_destination\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_id\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_amount\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"ERC1155TransferFailedEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": false,\n        \"internalType\": \"string\",\n        \"name\": \"_cosmosDenom\",\n        \"type\": \"string\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"string\",\n        \"name\": \"_name\",\n        \"type\": \"string\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"string\",\n        \"name\": \"_symbol\",\n        \"type\": \"string\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint8\",\n        \"name\": \"_decimals\",\n        \"type\": \"uint8\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"ERC20DeployedEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": false,\n        \"internalType\": \"bytes32\",\n        \"name\": \"_invalidationId\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_invalidationNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"bytes\",\n        \"name\": \"_returnData\",\n        \"type\": \"bytes\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"LogicCallEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_sender\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"bytes32\",\n        \"name\": \"_destination\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_ids\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_amounts\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"SendERC1155ToCosmosEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_sender\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"bytes32\",\n        \"name\": \"_destination\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_amount\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"SendToCosmosEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": true,\n        \"internalType\": \"uint256\",\n        \"name\": \"_batchNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": true,\n        \"internalType\": \"address\",\n        \"name\": \"_token\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"TransactionBatchExecutedEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"anonymous\": false,\n    \"inputs\": [\n      {\n        \"indexed\": true,\n        \"internalType\": \"uint256\",\n        \"name\": \"_newValsetNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_eventNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256\",\n        \"name\": \"_rewardAmount\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"address\",\n        \"name\": \"_rewardToken\",\n        \"type\": \"address\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"address[]\",\n        \"name\": \"_validators\",\n        \"type\": \"address[]\"\n      },\n      {\n        \"indexed\": false,\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_powers\",\n        \"type\": \"uint256[]\"\n      }\n    ],\n    \"name\": \"ValsetUpdatedEvent\",\n    \"type\": \"event\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"CONTRACT_VERSION\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"ERC1155_TRANSFER_GAS\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"announceContractVersion\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_id\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_to\",\n        \"type\": \"address\"\n      }\n    ],\n    \"name\": \"claimERC1155\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"string\",\n        \"name\": \"_cosmosDenom\",\n        \"type\": \"string\"\n      },\n      {\n        \"internalType\": \"string\",\n        \"name\": \"_name\",\n        \"type\": \"string\"\n      },\n      {\n        \"internalType\": \"string\",\n        \"name\": \"_symbol\",\n        \"type\": \"string\"\n      },\n      {\n        \"internalType\": \"uint8\",\n        \"name\": \"_decimals\",\n        \"type\": \"uint8\"\n      }\n    ],\n    \"name\": \"deployERC20\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_erc20Address\",\n        \"type\": \"address\"\n      }\n    ],\n    \"name\": \"lastBatchNonce\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_invalidation_id\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"name\": \"lastLogicCallNonce\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"address\",\n        \"name\": \"\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"internalType\": \"bytes\",\n        \"name\": \"\",\n        \"type\": \"bytes\"\n      }\n    ],\n    \"name\": \"onERC1155BatchReceived\",\n    \"outputs\": [\n      {\n        \"internalType\": \"bytes4\",\n        \"name\": \"\",\n        \"type\": \"bytes4\"\n      }\n    ],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"address\",\n        \"name\": \"\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"bytes\",\n        \"name\": \"\",\n        \"type\": \"bytes\"\n      }\n    ],\n    \"name\": \"onERC1155Received\",\n    \"outputs\": [\n      {\n        \"internalType\": \"bytes4\",\n        \"name\": \"\",\n        \"type\": \"bytes4\"\n      }\n    ],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_destination\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_ids\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_amounts\",\n        \"type\": \"uint256[]\"\n      }\n    ],\n    \"name\": \"sendERC1155ToCosmos\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_destination\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_amount\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"sendToCosmos\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_contractVersionAnnounced\",\n    \"outputs\": [\n      {\n        \"internalType\": \"bool\",\n        \"name\": \"\",\n        \"type\": \"bool\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"address\",\n        \"name\": \"\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"state_erc1155Credits\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_gravityId\",\n    \"outputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"name\": \"state_invalidationMapping\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"address\",\n        \"name\": \"\",\n        \"type\": \"address\"\n      }\n    ],\n    \"name\": \"state_lastBatchNonces\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_lastEventNonce\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_lastValsetCheckpoint\",\n    \"outputs\": [\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_lastValsetNonce\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [],\n    \"name\": \"state_powerThreshold\",\n    \"outputs\": [\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_currentValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint8\",\n            \"name\": \"v\",\n            \"type\": \"uint8\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"r\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"s\",\n            \"type\": \"bytes32\"\n          }\n        ],\n        \"internalType\": \"struct ValSignature[]\",\n        \"name\": \"_sigs\",\n        \"type\": \"tuple[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_amounts\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"internalType\": \"address[]\",\n        \"name\": \"_destinations\",\n        \"type\": \"address[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_fees\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_batchNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_batchTimeout\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"submitBatch\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_currentValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint8\",\n            \"name\": \"v\",\n            \"type\": \"uint8\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"r\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"s\",\n            \"type\": \"bytes32\"\n          }\n        ],\n        \"internalType\": \"struct ValSignature[]\",\n        \"name\": \"_sigs\",\n        \"type\": \"tuple[]\"\n      },\n      {\n        \"internalType\": \"address[]\",\n        \"name\": \"_destinations\",\n        \"type\": \"address[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_ids\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"internalType\": \"uint256[]\",\n        \"name\": \"_amounts\",\n        \"type\": \"uint256[]\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_batchNonce\",\n        \"type\": \"uint256\"\n      },\n      {\n        \"internalType\": \"address\",\n        \"name\": \"_tokenContract\",\n        \"type\": \"address\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_batchTimeout\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"submitERC1155Batch\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_currentValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint8\",\n            \"name\": \"v\",\n            \"type\": \"uint8\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"r\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"s\",\n            \"type\": \"bytes32\"\n          }\n        ],\n        \"internalType\": \"struct ValSignature[]\",\n        \"name\": \"_sigs\",\n        \"type\": \"tuple[]\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"transferAmounts\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"transferTokenContracts\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"feeAmounts\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"feeTokenContracts\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"logicContractAddress\",\n            \"type\": \"address\"\n          },\n          {\n            \"internalType\": \"bytes\",\n            \"name\": \"payload\",\n            \"type\": \"bytes\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"timeOut\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"invalidationId\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"invalidationNonce\",\n            \"type\": \"uint256\"\n          }\n        ],\n        \"internalType\": \"struct LogicCallArgs\",\n        \"name\": \"_args\",\n        \"type\": \"tuple\"\n      }\n    ],\n    \"name\": \"submitLogicCall\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"internalType\": \"bytes4\",\n        \"name\": \"interfaceId\",\n        \"type\": \"bytes4\"\n      }\n    ],\n    \"name\": \"supportsInterface\",\n    \"outputs\": [\n      {\n        \"internalType\": \"bool\",\n        \"name\": \"\",\n        \"type\": \"bool\"\n      }\n    ],\n    \"stateMutability\": \"view\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_currentValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint8\",\n            \"name\": \"v\",\n            \"type\": \"uint8\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"r\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"s\",\n            \"type\": \"bytes32\"\n          }\n        ],\n        \"internalType\": \"struct ValSignature[]\",\n        \"name\": \"_sigs\",\n        \"type\": \"tuple[]\"\n      },\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_theHash\",\n        \"type\": \"bytes32\"\n      },\n      {\n        \"internalType\": \"uint256\",\n        \"name\": \"_powerThreshold\",\n        \"type\": \"uint256\"\n      }\n    ],\n    \"name\": \"testCheckValidatorSignatures\",\n    \"outputs\": [],\n    \"stateMutability\": \"pure\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_valsetArgs\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"internalType\": \"bytes32\",\n        \"name\": \"_gravityId\",\n        \"type\": \"bytes32\"\n      }\n    ],\n    \"name\": \"testMakeCheckpoint\",\n    \"outputs\": [],\n    \"stateMutability\": \"pure\",\n    \"type\": \"function\"\n  },\n  {\n    \"inputs\": [\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_newValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"address[]\",\n            \"name\": \"validators\",\n            \"type\": \"address[]\"\n          },\n          {\n            \"internalType\": \"uint256[]\",\n            \"name\": \"powers\",\n            \"type\": \"uint256[]\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"valsetNonce\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"uint256\",\n            \"name\": \"rewardAmount\",\n            \"type\": \"uint256\"\n          },\n          {\n            \"internalType\": \"address\",\n            \"name\": \"rewardToken\",\n            \"type\": \"address\"\n          }\n        ],\n        \"internalType\": \"struct ValsetArgs\",\n        \"name\": \"_currentValset\",\n        \"type\": \"tuple\"\n      },\n      {\n        \"components\": [\n          {\n            \"internalType\": \"uint8\",\n            \"name\": \"v\",\n            \"type\": \"uint8\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"r\",\n            \"type\": \"bytes32\"\n          },\n          {\n            \"internalType\": \"bytes32\",\n            \"name\": \"s\",\n            \"type\": \"bytes32\"\n          }\n        ],\n        \"internalType\": \"struct ValSignature[]\",\n        \"name\": \"_sigs\",\n        \"type\": \"tuple[]\"\n      }\n    ],\n    \"name\": \"updateValset\",\n    \"outputs\": [],\n    \"stateMutability\": \"nonpayable\",\n    \"type\": \"function\"\n  }\n]\n") . expect ("invalid abi")
         });
     #[derive(Clone)]
     pub struct Gravity<M>(ethers::contract::Contract<M>);
@@ -47,6 +47,39 @@ mod gravity_mod {
                 ethers::contract::Contract::new(address.into(), GRAVITY_ABI.clone(), client);
             Self(contract)
         }
+        #[doc = "Calls the contract's `CONTRACT_VERSION` (0x38b90333) function"]
+        pub fn contract_version(
+            &self,
+        ) -> ethers::contract::builders::ContractCall<M, ethers::core::types::U256> {
+            self.0
+                .method_hash([56, 185, 3, 51], ())
+                .expect("method not found (this should never happen)")
+        }
+        #[doc = "Calls the contract's `ERC1155_TRANSFER_GAS` (0xdd31c7f4) function"]
+        pub fn erc1155_transfer_gas(
+            &self,
+        ) -> ethers::contract::builders::ContractCall<M, ethers::core::types::U256> {
+            self.0
+                .method_hash([221, 49, 199, 244], ())
+                .expect("method not found (this should never happen)")
+        }
+        #[doc = "Calls the contract's `announceContractVersion` (0xef105f2b) function"]
+        pub fn announce_contract_version(&self) -> ethers::contract::builders::ContractCall<M, ()> {
+            self.0
+                .method_hash([239, 16, 95, 43], ())
+                .expect("method not found (this should never happen)")
+        }
+        #[doc = "Calls the contract's `claimERC1155` (0x9b7766e1) function"]
+        pub fn claim_erc1155(
+            &self,
+            token_contract: ethers::core::types::Address,
+            id: ethers::core::types::U256,
+            to: ethers::core::types::Address,
+        ) -> ethers::contract::builders::ContractCall<M, ()> {
+            self.0
+                .method_hash([155, 119, 102, 225], (token_contract, id, to))
+                .expect("method not found (this should never happen)")
+        }
         #[doc = "Calls the contract's `deployERC20` (0xf7955637) function"]
         pub fn deploy_erc20(
             &self,
@@ -77,6 +110,47 @@ mod gravity_mod {
                 .method_hash([201, 209, 148, 213], invalidation_id)
                 .expect("method not found (this should never happen)")
         }
+        #[doc = "Calls the contract's `onERC1155BatchReceived` (0xbc197c81) function"]
+        pub fn on_erc1155_batch_received(
+            &self,
+            p0: ethers::core::types::Address,
+            p1: ethers::core::types::Address,
+            p2: ::std::vec::Vec<ethers::core::types::U256>,
+            p3: ::std::vec::Vec<ethers::core::types::U256>,
+            p4: ethers::core::types::Bytes,
+        ) -> ethers::contract::builders::ContractCall<M, [u8; 4]> {
+            self.0
+                .method_hash([188, 25, 124, 129], (p0, p1, p2, p3, p4))
+                .expect("method not found (this should never happen)")
+        }
+        #[doc = "Calls the contract's `onERC1155Received` (0xf23a6e61) function"]
+        pub fn on_erc1155_received(
+            &self,
+            p0: ethers::core::types::Address,
+            p1: ethers::core::types::Address,
+            p2: ethers::core::types::U256,
+            p3: ethers::core::types::U256,
+            p4: ethers::core::types::Bytes,
+        ) -> ethers::contract::builders::ContractCall<M, [u8; 4]> {
+            self.0
+                .method_hash([242, 58, 110, 97], (p0, p1, p2, p3, p4))
+                .expect("method not found (this should never happen)")
+        }
+        #[doc = "Calls the contract's `sendERC1155ToCosmos` (0xb2723151) function"]
+        pub fn send_erc1155_to_cosmos(
+            &self,
+            token_contract: ethers::core::types::Address,
+            destination: [u8; 32],
+            ids: ::std::vec::Vec<ethers::core::types::U256>,
+            amounts: ::std::vec::Vec<ethers::core::types::U256>,
+        ) -> ethers::contract::builders::ContractCall<M, ()> {
+            self.0
+                .method_hash(
+                    [178, 114, 49, 81],
+                    (token_contract, destination, ids, amounts),
+                )
+                .expect("method not found (this should never happen)")
+        }
         #[doc = "Calls the contract's `sendToCosmos` (0x1ffbe7f9) function"]
         pub fn send_to_cosmos(
             &self,
@@ -88,6 +162,25 @@ mod gravity_mod {
                 .method_hash([31, 251, 231, 249], (token_contract, destination, amount))
                 .expect("method not found (this should never happen)")
         }
+        #[doc = "Calls the contract's `state_contractVersionAnnounced` (0xa82b09fb) function"]
+        pub fn state_contract_version_announced(
+            &self,
+        ) -> ethers::contract::builders::ContractCall<M, bool> {
+            self.0
+                .method_hash([168, 43, 9, 251], ())
+                .expect("method not found (this should never happen)")
+        }
+        #[doc = "Calls the contract's `state_erc1155Credits` (0x5f438386) function"]
+        pub fn state_erc1155_credits(
+            &self,
+            p0: ethers::core::types::Address,
+            p1: ethers::core::types::Address,
+            p2: ethers::core::types::U256,
+        ) -> ethers::contract::builders::ContractCall<M, ethers::core::types::U256> {
+            self.0
+                .method_hash([95, 67, 131, 134], (p0, p1, p2))
+                .expect("method not found (this should never happen)")
+        }
         #[doc = "Calls the contract's `state_gravityId` (0xbdda81d4) function"]
         pub fn state_gravity_id(&self) -> ethers::contract::builders::ContractCall<M, [u8; 32]> {
             self.0
@@ -172,6 +265,34 @@ mod gravity_mod {
                 )
                 .expect("method not found (this should never happen)")
         }
+        #[doc = "Calls the contract's `submitERC1155Batch` (0x3f743a03) function"]
+        pub fn submit_erc1155_batch(
+            &self,
+            current_valset: ValsetArgs,
+            sigs: ::std::vec::Vec<ValSignature>,
+            destinations: ::std::vec::Vec<ethers::core::types::Address>,
+            ids: ::std::vec::Vec<ethers::core::types::U256>,
+            amounts: ::std::vec::Vec<ethers::core::types::U256>,
+            batch_nonce: ethers::core::types::U256,
+            token_contract: ethers::core::types::Address,
+            batch_timeout: ethers::core::types::U256,
+        ) -> ethers::contract::builders::ContractCall<M, ()> {
+            self.0
+                .method_hash(
+                    [63, 116, 58, 3],
+                    (
+                        current_valset,
+                        sigs,
+                        destinations,
+                        ids,
+                        amounts,
+                        batch_nonce,
+                        token_contract,
+                        batch_timeout,
+                    ),
+                )
+                .expect("method not found (this should never happen)")
+        }
         #[doc = "Calls the contract's `submitLogicCall` (0x6941db93) function"]
         pub fn submit_logic_call(
             &self,
@@ -183,6 +304,15 @@ mod gravity_mod {
                 .method_hash([105, 65, 219, 147], (current_valset, sigs, args))
                 .expect("method not found (this should never happen)")
         }
+        #[doc = "Calls the contract's `supportsInterface` (0x01ffc9a7) function"]
+        pub fn supports_interface(
+            &self,
+            interface_id: [u8; 4],
+        ) -> ethers::contract::builders::ContractCall<M, bool> {
+            self.0
+                .method_hash([1, 255, 201, 167], interface_id)
+                .expect("method not found (this should never happen)")
+        }
         #[doc = "Calls the contract's `testCheckValidatorSignatures` (0x00901153) function"]
         pub fn test_check_validator_signatures(
             &self,
@@ -219,6 +349,24 @@ mod gravity_mod {
                 .method_hash([172, 166, 177, 193], (new_valset, current_valset, sigs))
                 .expect("method not found (this should never happen)")
         }
+        #[doc = "Gets the contract's `ContractVersionEvent` event"]
+        pub fn contract_version_event_filter(
+            &self,
+        ) -> ethers::contract::builders::Event<M, ContractVersionEventFilter> {
+            self.0.event()
+        }
+        #[doc = "Gets the contract's `ERC1155BatchExecutedEvent` event"]
+        pub fn erc1155_batch_executed_event_filter(
+            &self,
+        ) -> ethers::contract::builders::Event<M, Erc1155BatchExecutedEventFilter> {
+            self.0.event()
+        }
+        #[doc = "Gets the contract's `ERC1155TransferFailedEvent` event"]
+        pub fn erc1155_transfer_failed_event_filter(
+            &self,
+        ) -> ethers::contract::builders::Event<M, Erc1155TransferFailedEventFilter> {
+            self.0.event()
+        }
         #[doc = "Gets the contract's `ERC20DeployedEvent` event"]
         pub fn erc20_deployed_event_filter(
             &self,
@@ -231,6 +379,12 @@ mod gravity_mod {
         ) -> ethers::contract::builders::Event<M, LogicCallEventFilter> {
             self.0.event()
         }
+        #[doc = "Gets the contract's `SendERC1155ToCosmosEvent` event"]
+        pub fn send_erc1155_to_cosmos_event_filter(
+            &self,
+        ) -> ethers::contract::builders::Event<M, SendErc1155ToCosmosEventFilter> {
+            self.0.event()
+        }
         #[doc = "Gets the contract's `SendToCosmosEvent` event"]
         pub fn send_to_cosmos_event_filter(
             &self,
@@ -265,6 +419,70 @@ mod gravity_mod {
         serde :: Deserialize,
         serde :: Serialize,
     )]
+    #[ethevent(
+        name = "ContractVersionEvent",
+        abi = "ContractVersionEvent(uint256,uint256)"
+    )]
+    pub struct ContractVersionEventFilter {
+        pub version: ethers::core::types::U256,
+        pub event_nonce: ethers::core::types::U256,
+    }
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthEvent,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethevent(
+        name = "ERC1155BatchExecutedEvent",
+        abi = "ERC1155BatchExecutedEvent(uint256,address,uint256)"
+    )]
+    pub struct Erc1155BatchExecutedEventFilter {
+        #[ethevent(indexed)]
+        pub batch_nonce: ethers::core::types::U256,
+        #[ethevent(indexed)]
+        pub token: ethers::core::types::Address,
+        pub event_nonce: ethers::core::types::U256,
+    }
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthEvent,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethevent(
+        name = "ERC1155TransferFailedEvent",
+        abi = "ERC1155TransferFailedEvent(address,address,uint256,uint256)"
+    )]
+    pub struct Erc1155TransferFailedEventFilter {
+        #[ethevent(indexed)]
+        pub token_contract: ethers::core::types::Address,
+        #[ethevent(indexed)]
+        pub destination: ethers::core::types::Address,
+        pub id: ethers::core::types::U256,
+        pub amount: ethers::core::types::U256,
+    }
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthEvent,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
     #[ethevent(
         name = "ERC20DeployedEvent",
         abi = "ERC20DeployedEvent(string,address,string,string,uint8,uint256)"
@@ -310,6 +528,32 @@ mod gravity_mod {
         serde :: Deserialize,
         serde :: Serialize,
     )]
+    #[ethevent(
+        name = "SendERC1155ToCosmosEvent",
+        abi = "SendERC1155ToCosmosEvent(address,address,bytes32,uint256[],uint256[],uint256)"
+    )]
+    pub struct SendErc1155ToCosmosEventFilter {
+        #[ethevent(indexed)]
+        pub token_contract: ethers::core::types::Address,
+        #[ethevent(indexed)]
+        pub sender: ethers::core::types::Address,
+        #[ethevent(indexed)]
+        pub destination: [u8; 32],
+        pub ids: Vec<ethers::core::types::U256>,
+        pub amounts: Vec<ethers::core::types::U256>,
+        pub event_nonce: ethers::core::types::U256,
+    }
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthEvent,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
     #[ethevent(
         name = "SendToCosmosEvent",
         abi = "SendToCosmosEvent(address,address,bytes32,uint256,uint256)"
@@ -372,8 +616,12 @@ mod gravity_mod {
     }
     #[derive(Debug, Clone, PartialEq, Eq, ethers :: contract :: EthAbiType)]
     pub enum GravityEvents {
+        ContractVersionEventFilter(ContractVersionEventFilter),
+        Erc1155BatchExecutedEventFilter(Erc1155BatchExecutedEventFilter),
+        Erc1155TransferFailedEventFilter(Erc1155TransferFailedEventFilter),
         Erc20DeployedEventFilter(Erc20DeployedEventFilter),
         LogicCallEventFilter(LogicCallEventFilter),
+        SendErc1155ToCosmosEventFilter(SendErc1155ToCosmosEventFilter),
         SendToCosmosEventFilter(SendToCosmosEventFilter),
         TransactionBatchExecutedEventFilter(TransactionBatchExecutedEventFilter),
         ValsetUpdatedEventFilter(ValsetUpdatedEventFilter),
@@ -383,12 +631,24 @@ mod gravity_mod {
         where
             Self: Sized,
         {
+            if let Ok(decoded) = ContractVersionEventFilter::decode_log(log) {
+                return Ok(GravityEvents::ContractVersionEventFilter(decoded));
+            }
+            if let Ok(decoded) = Erc1155BatchExecutedEventFilter::decode_log(log) {
+                return Ok(GravityEvents::Erc1155BatchExecutedEventFilter(decoded));
+            }
+            if let Ok(decoded) = Erc1155TransferFailedEventFilter::decode_log(log) {
+                return Ok(GravityEvents::Erc1155TransferFailedEventFilter(decoded));
+            }
             if let Ok(decoded) = Erc20DeployedEventFilter::decode_log(log) {
                 return Ok(GravityEvents::Erc20DeployedEventFilter(decoded));
             }
             if let Ok(decoded) = LogicCallEventFilter::decode_log(log) {
                 return Ok(GravityEvents::LogicCallEventFilter(decoded));
             }
+            if let Ok(decoded) = SendErc1155ToCosmosEventFilter::decode_log(log) {
+                return Ok(GravityEvents::SendErc1155ToCosmosEventFilter(decoded));
+            }
             if let Ok(decoded) = SendToCosmosEventFilter::decode_log(log) {
                 return Ok(GravityEvents::SendToCosmosEventFilter(decoded));
             }
@@ -404,14 +664,78 @@ mod gravity_mod {
     impl ::std::fmt::Display for GravityEvents {
         fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
             match self {
+                GravityEvents::ContractVersionEventFilter(element) => element.fmt(f),
+                GravityEvents::Erc1155BatchExecutedEventFilter(element) => element.fmt(f),
+                GravityEvents::Erc1155TransferFailedEventFilter(element) => element.fmt(f),
                 GravityEvents::Erc20DeployedEventFilter(element) => element.fmt(f),
                 GravityEvents::LogicCallEventFilter(element) => element.fmt(f),
+                GravityEvents::SendErc1155ToCosmosEventFilter(element) => element.fmt(f),
                 GravityEvents::SendToCosmosEventFilter(element) => element.fmt(f),
                 GravityEvents::TransactionBatchExecutedEventFilter(element) => element.fmt(f),
                 GravityEvents::ValsetUpdatedEventFilter(element) => element.fmt(f),
             }
         }
     }
+    #[doc = "Container type for all input parameters for the `CONTRACT_VERSION`function with signature `CONTRACT_VERSION()` and selector `[56, 185, 3, 51]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(name = "CONTRACT_VERSION", abi = "CONTRACT_VERSION()")]
+    pub struct ContractVersionCall;
+    #[doc = "Container type for all input parameters for the `ERC1155_TRANSFER_GAS`function with signature `ERC1155_TRANSFER_GAS()` and selector `[221, 49, 199, 244]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(name = "ERC1155_TRANSFER_GAS", abi = "ERC1155_TRANSFER_GAS()")]
+    pub struct Erc1155TransferGasCall;
+    #[doc = "Container type for all input parameters for the `announceContractVersion`function with signature `announceContractVersion()` and selector `[239, 16, 95, 43]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(name = "announceContractVersion", abi = "announceContractVersion()")]
+    pub struct AnnounceContractVersionCall;
+    #[doc = "Container type for all input parameters for the `claimERC1155`function with signature `claimERC1155(address,uint256,address)` and selector `[155, 119, 102, 225]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(name = "claimERC1155", abi = "claimERC1155(address,uint256,address)")]
+    pub struct ClaimERC1155Call {
+        pub token_contract: ethers::core::types::Address,
+        pub id: ethers::core::types::U256,
+        pub to: ethers::core::types::Address,
+    }
     #[doc = "Container type for all input parameters for the `deployERC20`function with signature `deployERC20(string,string,string,uint8)` and selector `[247, 149, 86, 55]`"]
     #[derive(
         Clone,
@@ -463,6 +787,74 @@ mod gravity_mod {
     pub struct LastLogicCallNonceCall {
         pub invalidation_id: [u8; 32],
     }
+    #[doc = "Container type for all input parameters for the `onERC1155BatchReceived`function with signature `onERC1155BatchReceived(address,address,uint256[],uint256[],bytes)` and selector `[188, 25, 124, 129]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(
+        name = "onERC1155BatchReceived",
+        abi = "onERC1155BatchReceived(address,address,uint256[],uint256[],bytes)"
+    )]
+    pub struct OnERC1155BatchReceivedCall {
+        pub p0: ethers::core::types::Address,
+        pub p1: ethers::core::types::Address,
+        pub p2: ::std::vec::Vec<ethers::core::types::U256>,
+        pub p3: ::std::vec::Vec<ethers::core::types::U256>,
+        pub p4: ethers::core::types::Bytes,
+    }
+    #[doc = "Container type for all input parameters for the `onERC1155Received`function with signature `onERC1155Received(address,address,uint256,uint256,bytes)` and selector `[242, 58, 110, 97]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(
+        name = "onERC1155Received",
+        abi = "onERC1155Received(address,address,uint256,uint256,bytes)"
+    )]
+    pub struct OnERC1155ReceivedCall {
+        pub p0: ethers::core::types::Address,
+        pub p1: ethers::core::types::Address,
+        pub p2: ethers::core::types::U256,
+        pub p3: ethers::core::types::U256,
+        pub p4: ethers::core::types::Bytes,
+    }
+    #[doc = "Container type for all input parameters for the `sendERC1155ToCosmos`function with signature `sendERC1155ToCosmos(address,bytes32,uint256[],uint256[])` and selector `[178, 114, 49, 81]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(
+        name = "sendERC1155ToCosmos",
+        abi = "sendERC1155ToCosmos(address,bytes32,uint256[],uint256[])"
+    )]
+    pub struct SendERC1155ToCosmosCall {
+        pub token_contract: ethers::core::types::Address,
+        pub destination: [u8; 32],
+        pub ids: ::std::vec::Vec<ethers::core::types::U256>,
+        pub amounts: ::std::vec::Vec<ethers::core::types::U256>,
+    }
     #[doc = "Container type for all input parameters for the `sendToCosmos`function with signature `sendToCosmos(address,bytes32,uint256)` and selector `[31, 251, 231, 249]`"]
     #[derive(
         Clone,
@@ -481,6 +873,44 @@ mod gravity_mod {
         pub destination: [u8; 32],
         pub amount: ethers::core::types::U256,
     }
+    #[doc = "Container type for all input parameters for the `state_contractVersionAnnounced`function with signature `state_contractVersionAnnounced()` and selector `[168, 43, 9, 251]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(
+        name = "state_contractVersionAnnounced",
+        abi = "state_contractVersionAnnounced()"
+    )]
+    pub struct StateContractVersionAnnouncedCall;
+    #[doc = "Container type for all input parameters for the `state_erc1155Credits`function with signature `state_erc1155Credits(address,address,uint256)` and selector `[95, 67, 131, 134]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(
+        name = "state_erc1155Credits",
+        abi = "state_erc1155Credits(address,address,uint256)"
+    )]
+    pub struct StateErc1155CreditsCall(
+        pub ethers::core::types::Address,
+        pub ethers::core::types::Address,
+        pub ethers::core::types::U256,
+    );
     #[doc = "Container type for all input parameters for the `state_gravityId`function with signature `state_gravityId()` and selector `[189, 218, 129, 212]`"]
     #[derive(
         Clone,
@@ -611,6 +1041,32 @@ mod gravity_mod {
         pub token_contract: ethers::core::types::Address,
         pub batch_timeout: ethers::core::types::U256,
     }
+    #[doc = "Container type for all input parameters for the `submitERC1155Batch`function with signature `submitERC1155Batch((address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[],address[],uint256[],uint256[],uint256,address,uint256)` and selector `[63, 116, 58, 3]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(
+        name = "submitERC1155Batch",
+        abi = "submitERC1155Batch((address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[],address[],uint256[],uint256[],uint256,address,uint256)"
+    )]
+    pub struct SubmitERC1155BatchCall {
+        pub current_valset: ValsetArgs,
+        pub sigs: ::std::vec::Vec<ValSignature>,
+        pub destinations: ::std::vec::Vec<ethers::core::types::Address>,
+        pub ids: ::std::vec::Vec<ethers::core::types::U256>,
+        pub amounts: ::std::vec::Vec<ethers::core::types::U256>,
+        pub batch_nonce: ethers::core::types::U256,
+        pub token_contract: ethers::core::types::Address,
+        pub batch_timeout: ethers::core::types::U256,
+    }
     #[doc = "Container type for all input parameters for the `submitLogicCall`function with signature `submitLogicCall((address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[],(uint256[],address[],uint256[],address[],address,bytes,uint256,bytes32,uint256))` and selector `[105, 65, 219, 147]`"]
     #[derive(
         Clone,
@@ -632,6 +1088,22 @@ mod gravity_mod {
         pub sigs: ::std::vec::Vec<ValSignature>,
         pub args: LogicCallArgs,
     }
+    #[doc = "Container type for all input parameters for the `supportsInterface`function with signature `supportsInterface(bytes4)` and selector `[1, 255, 201, 167]`"]
+    #[derive(
+        Clone,
+        Debug,
+        Default,
+        Eq,
+        PartialEq,
+        ethers :: contract :: EthCall,
+        ethers :: contract :: EthDisplay,
+        serde :: Deserialize,
+        serde :: Serialize,
+    )]
+    #[ethcall(name = "supportsInterface", abi = "supportsInterface(bytes4)")]
+    pub struct SupportsInterfaceCall {
+        pub interface_id: [u8; 4],
+    }
     #[doc = "Container type for all input parameters for the `testCheckValidatorSignatures`function with signature `testCheckValidatorSignatures((address[],uint256[],uint256,uint256,address),(uint8,bytes32,bytes32)[],bytes32,uint256)` and selector `[0, 144, 17, 83]`"]
     #[derive(
         Clone,
@@ -697,10 +1169,19 @@ mod gravity_mod {
     }
     #[derive(Debug, Clone, PartialEq, Eq, ethers :: contract :: EthAbiType)]
     pub enum GravityCalls {
+        ContractVersion(ContractVersionCall),
+        Erc1155TransferGas(Erc1155TransferGasCall),
+        AnnounceContractVersion(AnnounceContractVersionCall),
+        ClaimERC1155(ClaimERC1155Call),
         DeployERC20(DeployERC20Call),
         LastBatchNonce(LastBatchNonceCall),
         LastLogicCallNonce(LastLogicCallNonceCall),
+        OnERC1155BatchReceived(OnERC1155BatchReceivedCall),
+        OnERC1155Received(OnERC1155ReceivedCall),
+        SendERC1155ToCosmos(SendERC1155ToCosmosCall),
         SendToCosmos(SendToCosmosCall),
+        StateContractVersionAnnounced(StateContractVersionAnnouncedCall),
+        StateErc1155Credits(StateErc1155CreditsCall),
         StateGravityId(StateGravityIdCall),
         StateInvalidationMapping(StateInvalidationMappingCall),
         StateLastBatchNonces(StateLastBatchNoncesCall),
@@ -709,13 +1190,35 @@ mod gravity_mod {
         StateLastValsetNonce(StateLastValsetNonceCall),
         StatePowerThreshold(StatePowerThresholdCall),
         SubmitBatch(SubmitBatchCall),
+        SubmitERC1155Batch(SubmitERC1155BatchCall),
         SubmitLogicCall(SubmitLogicCallCall),
+        SupportsInterface(SupportsInterfaceCall),
         TestCheckValidatorSignatures(TestCheckValidatorSignaturesCall),
         TestMakeCheckpoint(TestMakeCheckpointCall),
         UpdateValset(UpdateValsetCall),
     }
     impl ethers::core::abi::AbiDecode for GravityCalls {
         fn decode(data: impl AsRef<[u8]>) -> Result<Self, ethers::core::abi::AbiError> {
+            if let Ok(decoded) =
+                <ContractVersionCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::ContractVersion(decoded));
+            }
+            if let Ok(decoded) =
+                <Erc1155TransferGasCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::Erc1155TransferGas(decoded));
+            }
+            if let Ok(decoded) =
+                <AnnounceContractVersionCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::AnnounceContractVersion(decoded));
+            }
+            if let Ok(decoded) =
+                <ClaimERC1155Call as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::ClaimERC1155(decoded));
+            }
             if let Ok(decoded) =
                 <DeployERC20Call as ethers::core::abi::AbiDecode>::decode(data.as_ref())
             {
@@ -731,11 +1234,38 @@ mod gravity_mod {
             {
                 return Ok(GravityCalls::LastLogicCallNonce(decoded));
             }
+            if let Ok(decoded) =
+                <OnERC1155BatchReceivedCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::OnERC1155BatchReceived(decoded));
+            }
+            if let Ok(decoded) =
+                <OnERC1155ReceivedCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::OnERC1155Received(decoded));
+            }
+            if let Ok(decoded) =
+                <SendERC1155ToCosmosCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::SendERC1155ToCosmos(decoded));
+            }
             if let Ok(decoded) =
                 <SendToCosmosCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
             {
                 return Ok(GravityCalls::SendToCosmos(decoded));
             }
+            if let Ok(decoded) =
+                <StateContractVersionAnnouncedCall as ethers::core::abi::AbiDecode>::decode(
+                    data.as_ref(),
+                )
+            {
+                return Ok(GravityCalls::StateContractVersionAnnounced(decoded));
+            }
+            if let Ok(decoded) =
+                <StateErc1155CreditsCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::StateErc1155Credits(decoded));
+            }
             if let Ok(decoded) =
                 <StateGravityIdCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
             {
@@ -780,11 +1310,21 @@ mod gravity_mod {
             {
                 return Ok(GravityCalls::SubmitBatch(decoded));
             }
+            if let Ok(decoded) =
+                <SubmitERC1155BatchCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::SubmitERC1155Batch(decoded));
+            }
             if let Ok(decoded) =
                 <SubmitLogicCallCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
             {
                 return Ok(GravityCalls::SubmitLogicCall(decoded));
             }
+            if let Ok(decoded) =
+                <SupportsInterfaceCall as ethers::core::abi::AbiDecode>::decode(data.as_ref())
+            {
+                return Ok(GravityCalls::SupportsInterface(decoded));
+            }
             if let Ok(decoded) =
                 <TestCheckValidatorSignaturesCall as ethers::core::abi::AbiDecode>::decode(
                     data.as_ref(),
@@ -808,10 +1348,19 @@ mod gravity_mod {
     impl ethers::core::abi::AbiEncode for GravityCalls {
         fn encode(self) -> Vec<u8> {
             match self {
+                GravityCalls::ContractVersion(element) => element.encode(),
+                GravityCalls::Erc1155TransferGas(element) => element.encode(),
+                GravityCalls::AnnounceContractVersion(element) => element.encode(),
+                GravityCalls::ClaimERC1155(element) => element.encode(),
                 GravityCalls::DeployERC20(element) => element.encode(),
                 GravityCalls::LastBatchNonce(element) => element.encode(),
                 GravityCalls::LastLogicCallNonce(element) => element.encode(),
+                GravityCalls::OnERC1155BatchReceived(element) => element.encode(),
+                GravityCalls::OnERC1155Received(element) => element.encode(),
+                GravityCalls::SendERC1155ToCosmos(element) => element.encode(),
                 GravityCalls::SendToCosmos(element) => element.encode(),
+                GravityCalls::StateContractVersionAnnounced(element) => element.encode(),
+                GravityCalls::StateErc1155Credits(element) => element.encode(),
                 GravityCalls::StateGravityId(element) => element.encode(),
                 GravityCalls::StateInvalidationMapping(element) => element.encode(),
                 GravityCalls::StateLastBatchNonces(element) => element.encode(),
@@ -820,7 +1369,9 @@ mod gravity_mod {
                 GravityCalls::StateLastValsetNonce(element) => element.encode(),
                 GravityCalls::StatePowerThreshold(element) => element.encode(),
                 GravityCalls::SubmitBatch(element) => element.encode(),
+                GravityCalls::SubmitERC1155Batch(element) => element.encode(),
                 GravityCalls::SubmitLogicCall(element) => element.encode(),
+                GravityCalls::SupportsInterface(element) => element.encode(),
                 GravityCalls::TestCheckValidatorSignatures(element) => element.encode(),
                 GravityCalls::TestMakeCheckpoint(element) => element.encode(),
                 GravityCalls::UpdateValset(element) => element.encode(),
@@ -830,10 +1381,19 @@ mod gravity_mod {
     impl ::std::fmt::Display for GravityCalls {
         fn fmt(&self, f: &mut ::std::fmt::Formatter<'_>) -> ::std::fmt::Result {
             match self {
+                GravityCalls::ContractVersion(element) => element.fmt(f),
+                GravityCalls::Erc1155TransferGas(element) => element.fmt(f),
+                GravityCalls::AnnounceContractVersion(element) => element.fmt(f),
+                GravityCalls::ClaimERC1155(element) => element.fmt(f),
                 GravityCalls::DeployERC20(element) => element.fmt(f),
                 GravityCalls::LastBatchNonce(element) => element.fmt(f),
                 GravityCalls::LastLogicCallNonce(element) => element.fmt(f),
+                GravityCalls::OnERC1155BatchReceived(element) => element.fmt(f),
+                GravityCalls::OnERC1155Received(element) => element.fmt(f),
+                GravityCalls::SendERC1155ToCosmos(element) => element.fmt(f),
                 GravityCalls::SendToCosmos(element) => element.fmt(f),
+                GravityCalls::StateContractVersionAnnounced(element) => element.fmt(f),
+                GravityCalls::StateErc1155Credits(element) => element.fmt(f),
                 GravityCalls::StateGravityId(element) => element.fmt(f),
                 GravityCalls::StateInvalidationMapping(element) => element.fmt(f),
                 GravityCalls::StateLastBatchNonces(element) => element.fmt(f),
@@ -842,13 +1402,35 @@ mod gravity_mod {
                 GravityCalls::StateLastValsetNonce(element) => element.fmt(f),
                 GravityCalls::StatePowerThreshold(element) => element.fmt(f),
                 GravityCalls::SubmitBatch(element) => element.fmt(f),
+                GravityCalls::SubmitERC1155Batch(element) => element.fmt(f),
                 GravityCalls::SubmitLogicCall(element) => element.fmt(f),
+                GravityCalls::SupportsInterface(element) => element.fmt(f),
                 GravityCalls::TestCheckValidatorSignatures(element) => element.fmt(f),
                 GravityCalls::TestMakeCheckpoint(element) => element.fmt(f),
                 GravityCalls::UpdateValset(element) => element.fmt(f),
             }
         }
     }
+    impl ::std::convert::From<ContractVersionCall> for GravityCalls {
+        fn from(var: ContractVersionCall) -> Self {
+            GravityCalls::ContractVersion(var)
+        }
+    }
+    impl ::std::convert::From<Erc1155TransferGasCall> for GravityCalls {
+        fn from(var: Erc1155TransferGasCall) -> Self {
+            GravityCalls::Erc1155TransferGas(var)
+        }
+    }
+    impl ::std::convert::From<AnnounceContractVersionCall> for GravityCalls {
+        fn from(var: AnnounceContractVersionCall) -> Self {
+            GravityCalls::AnnounceContractVersion(var)
+        }
+    }
+    impl ::std::convert::From<ClaimERC1155Call> for GravityCalls {
+        fn from(var: ClaimERC1155Call) -> Self {
+            GravityCalls::ClaimERC1155(var)
+        }
+    }
     impl ::std::convert::From<DeployERC20Call> for GravityCalls {
         fn from(var: DeployERC20Call) -> Self {
             GravityCalls::DeployERC20(var)
@@ -864,11 +1446,36 @@ mod gravity_mod {
             GravityCalls::LastLogicCallNonce(var)
         }
     }
+    impl ::std::convert::From<OnERC1155BatchReceivedCall> for GravityCalls {
+        fn from(var: OnERC1155BatchReceivedCall) -> Self {
+            GravityCalls::OnERC1155BatchReceived(var)
+        }
+    }
+    impl ::std::convert::From<OnERC1155ReceivedCall> for GravityCalls {
+        fn from(var: OnERC1155ReceivedCall) -> Self {
+            GravityCalls::OnERC1155Received(var)
+        }
+    }
+    impl ::std::convert::From<SendERC1155ToCosmosCall> for GravityCalls {
+        fn from(var: SendERC1155ToCosmosCall) -> Self {
+            GravityCalls::SendERC1155ToCosmos(var)
+        }
+    }
     impl ::std::convert::From<SendToCosmosCall> for GravityCalls {
         fn from(var: SendToCosmosCall) -> Self {
             GravityCalls::SendToCosmos(var)
         }
     }
+    impl ::std::convert::From<StateContractVersionAnnouncedCall> for GravityCalls {
+        fn from(var: StateContractVersionAnnouncedCall) -> Self {
+            GravityCalls::StateContractVersionAnnounced(var)
+        }
+    }
+    impl ::std::convert::From<StateErc1155CreditsCall> for GravityCalls {
+        fn from(var: StateErc1155CreditsCall) -> Self {
+            GravityCalls::StateErc1155Credits(var)
+        }
+    }
     impl ::std::convert::From<StateGravityIdCall> for GravityCalls {
         fn from(var: StateGravityIdCall) -> Self {
             GravityCalls::StateGravityId(var)
@@ -909,11 +1516,21 @@ mod gravity_mod {
             GravityCalls::SubmitBatch(var)
         }
     }
+    impl ::std::convert::From<SubmitERC1155BatchCall> for GravityCalls {
+        fn from(var: SubmitERC1155BatchCall) -> Self {
+            GravityCalls::SubmitERC1155Batch(var)
+        }
+    }
     impl ::std::convert::From<SubmitLogicCallCall> for GravityCalls {
         fn from(var: SubmitLogicCallCall) -> Self {
             GravityCalls::SubmitLogicCall(var)
         }
     }
+    impl ::std::convert::From<SupportsInterfaceCall> for GravityCalls {
+        fn from(var: SupportsInterfaceCall) -> Self {
+            GravityCalls::SupportsInterface(var)
+        }
+    }
     impl ::std::convert::From<TestCheckValidatorSignaturesCall> for GravityCalls {
         fn from(var: TestCheckValidatorSignaturesCall) -> Self {
             GravityCalls::TestCheckValidatorSignatures(var)
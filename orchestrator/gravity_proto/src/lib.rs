@@ -8,6 +8,9 @@ pub use cosmos_sdk_proto;
 pub mod gravity {
     include!("prost/gravity.v1.rs");
 }
+pub mod signer {
+    include!("prost/gravity.signer.v1.rs");
+}
 
 use bytes::BytesMut;
 use prost::Message;
@@ -63,6 +66,39 @@ impl ToAny for gravity::ContractCallTxConfirmation {
     }
 }
 
+impl ToAny for gravity::ContractVersionEvent {
+    fn to_any(&self) -> Option<prost_types::Any> {
+        let mut buf = BytesMut::with_capacity(self.encoded_len());
+        self.encode(&mut buf).expect("encoding failed");
+        Some(Any {
+            type_url: "/gravity.v1.ContractVersionEvent".into(),
+            value: buf.to_vec(),
+        })
+    }
+}
+
+impl ToAny for gravity::Erc1155BatchExecutedEvent {
+    fn to_any(&self) -> Option<prost_types::Any> {
+        let mut buf = BytesMut::with_capacity(self.encoded_len());
+        self.encode(&mut buf).expect("encoding failed");
+        Some(Any {
+            type_url: "/gravity.v1.ERC1155BatchExecutedEvent".into(),
+            value: buf.to_vec(),
+        })
+    }
+}
+
+impl ToAny for gravity::Erc1155BatchTxConfirmation {
+    fn to_any(&self) -> Option<prost_types::Any> {
+        let mut buf = BytesMut::with_capacity(self.encoded_len());
+        self.encode(&mut buf).expect("encoding failed");
+        Some(Any {
+            type_url: "/gravity.v1.ERC1155BatchTxConfirmation".into(),
+            value: buf.to_vec(),
+        })
+    }
+}
+
 impl ToAny for gravity::Erc20DeployedEvent {
     fn to_any(&self) -> Option<prost_types::Any> {
         let mut buf = BytesMut::with_capacity(self.encoded_len());
@@ -74,6 +110,17 @@ impl ToAny for gravity::Erc20DeployedEvent {
     }
 }
 
+impl ToAny for gravity::SendErc1155ToCosmosEvent {
+    fn to_any(&self) -> Option<prost_types::Any> {
+        let mut buf = BytesMut::with_capacity(self.encoded_len());
+        self.encode(&mut buf).expect("encoding failed");
+        Some(Any {
+            type_url: "/gravity.v1.SendERC1155ToCosmosEvent".into(),
+            value: buf.to_vec(),
+        })
+    }
+}
+
 impl ToAny for gravity::SendToCosmosEvent {
     fn to_any(&self) -> Option<prost_types::Any> {
         let mut buf = BytesMut::with_capacity(self.encoded_len());
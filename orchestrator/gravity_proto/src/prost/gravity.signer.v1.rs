@@ -0,0 +1,106 @@
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PublicKeyRequest {
+    #[prost(string, tag = "1")]
+    pub key_name: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PublicKeyResponse {
+    /// the 33 byte compressed secp256k1 public key
+    #[prost(bytes = "vec", tag = "1")]
+    pub public_key: ::prost::alloc::vec::Vec<u8>,
+}
+/// SignRequest carries the serialized SignDoc of a SIGN_MODE_DIRECT transaction, the signer
+/// may decode it to apply its own policy before signing
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SignRequest {
+    #[prost(string, tag = "1")]
+    pub key_name: ::prost::alloc::string::String,
+    #[prost(bytes = "vec", tag = "2")]
+    pub sign_bytes: ::prost::alloc::vec::Vec<u8>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SignResponse {
+    /// the 64 byte r || s signature with s normalized to the lower half of the curve order
+    #[prost(bytes = "vec", tag = "1")]
+    pub signature: ::prost::alloc::vec::Vec<u8>,
+}
+#[doc = r" Generated client implementations."]
+pub mod signer_client {
+    #![allow(unused_variables, dead_code, missing_docs)]
+    use tonic::codegen::*;
+    #[doc = " Signer is the service a remote signer holding an orchestrator's Cosmos key implements,"]
+    #[doc = " the orchestrator then signs its transactions without the key ever being on its host."]
+    #[doc = " Keys are secp256k1 keys identified by a name chosen by the signer's operator."]
+    pub struct SignerClient<T> {
+        inner: tonic::client::Grpc<T>,
+    }
+    impl SignerClient<tonic::transport::Channel> {
+        #[doc = r" Attempt to create a new client by connecting to a given endpoint."]
+        pub async fn connect<D>(dst: D) -> Result<Self, tonic::transport::Error>
+        where
+            D: std::convert::TryInto<tonic::transport::Endpoint>,
+            D::Error: Into<StdError>,
+        {
+            let conn = tonic::transport::Endpoint::new(dst)?.connect().await?;
+            Ok(Self::new(conn))
+        }
+    }
+    impl<T> SignerClient<T>
+    where
+        T: tonic::client::GrpcService<tonic::body::BoxBody>,
+        T::ResponseBody: Body + HttpBody + Send + 'static,
+        T::Error: Into<StdError>,
+        <T::ResponseBody as HttpBody>::Error: Into<StdError> + Send,
+    {
+        pub fn new(inner: T) -> Self {
+            let inner = tonic::client::Grpc::new(inner);
+            Self { inner }
+        }
+        pub fn with_interceptor(inner: T, interceptor: impl Into<tonic::Interceptor>) -> Self {
+            let inner = tonic::client::Grpc::with_interceptor(inner, interceptor);
+            Self { inner }
+        }
+        #[doc = " PublicKey returns the public key of the named key"]
+        pub async fn public_key(
+            &mut self,
+            request: impl tonic::IntoRequest<super::PublicKeyRequest>,
+        ) -> Result<tonic::Response<super::PublicKeyResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.signer.v1.Signer/PublicKey");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        #[doc = " Sign signs the sha256 hash of the provided sign bytes with the named key"]
+        pub async fn sign(
+            &mut self,
+            request: impl tonic::IntoRequest<super::SignRequest>,
+        ) -> Result<tonic::Response<super::SignResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.signer.v1.Signer/Sign");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+    }
+    impl<T: Clone> Clone for SignerClient<T> {
+        fn clone(&self) -> Self {
+            Self {
+                inner: self.inner.clone(),
+            }
+        }
+    }
+    impl<T> std::fmt::Debug for SignerClient<T> {
+        fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+            write!(f, "SignerClient {{ ... }}")
+        }
+    }
+}
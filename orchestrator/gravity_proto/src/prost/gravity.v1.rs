@@ -9,6 +9,21 @@ pub struct EthereumEventVoteRecord {
     pub votes: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
     #[prost(bool, tag = "3")]
     pub accepted: bool,
+    /// set when governance rejected the record to unblock the chain's events
+    #[prost(bool, tag = "4")]
+    pub rejected: bool,
+    /// the Cosmos height of the first vote, zero for the records created before
+    /// it was recorded
+    #[prost(uint64, tag = "5")]
+    pub first_vote_height: u64,
+    /// the nonce of the signer set whose voter set indexes the vote bitmap, zero
+    /// when all the votes are in the votes list
+    #[prost(uint64, tag = "6")]
+    pub signer_set_nonce: u64,
+    /// the votes of the validators of the voter set, bit i set for the voter at
+    /// index i, the votes of other validators being in the votes list
+    #[prost(bytes = "vec", tag = "7")]
+    pub vote_bitmap: ::prost::alloc::vec::Vec<u8>,
 }
 /// LatestEthereumBlockHeight defines the latest observed ethereum block height
 /// and the corresponding timestamp value in nanoseconds.
@@ -40,6 +55,10 @@ pub struct SignerSetTx {
     pub height: u64,
     #[prost(message, repeated, tag = "3")]
     pub signers: ::prost::alloc::vec::Vec<EthereumSigner>,
+    /// the version of the encoding of the checkpoint, zero for the txs created
+    /// before checkpoints were versioned, which are encoded with the first version
+    #[prost(uint32, tag = "4")]
+    pub checkpoint_version: u32,
 }
 /// BatchTx represents a batch of transactions going from Cosmos to Ethereum.
 /// Batch txs are are identified by a unique hash and the token contract that is
@@ -56,6 +75,10 @@ pub struct BatchTx {
     pub token_contract: ::prost::alloc::string::String,
     #[prost(uint64, tag = "5")]
     pub height: u64,
+    /// the version of the encoding of the checkpoint, zero for the txs created
+    /// before checkpoints were versioned, which are encoded with the first version
+    #[prost(uint32, tag = "6")]
+    pub checkpoint_version: u32,
 }
 /// SendToEthereum represents an individual SendToEthereum from Cosmos to
 /// Ethereum
@@ -71,6 +94,67 @@ pub struct SendToEthereum {
     pub erc20_token: ::core::option::Option<Erc20Token>,
     #[prost(message, optional, tag = "5")]
     pub erc20_fee: ::core::option::Option<Erc20Token>,
+    /// the EVM chain the transfer goes to, zero for transfers to the default
+    /// chain queued before transfers were tagged
+    #[prost(uint64, tag = "6")]
+    pub evm_chain_id: u64,
+}
+/// ERC1155BatchTx is a batch of transfers of an ERC1155 token from Cosmos to
+/// Ethereum. Its checkpoint covers the ids and amounts of all its transfers,
+/// flattened into one entry per id, so any number of ids of the token move in a
+/// single batch without an ERC20 wrapper per id.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Erc1155BatchTx {
+    #[prost(uint64, tag = "1")]
+    pub batch_nonce: u64,
+    #[prost(uint64, tag = "2")]
+    pub timeout: u64,
+    #[prost(message, repeated, tag = "3")]
+    pub transactions: ::prost::alloc::vec::Vec<SendErc1155ToEthereum>,
+    #[prost(string, tag = "4")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "5")]
+    pub height: u64,
+    /// the version of the encoding of the checkpoint, zero for the txs created
+    /// before checkpoints were versioned, which are encoded with the first version
+    #[prost(uint32, tag = "6")]
+    pub checkpoint_version: u32,
+}
+/// SendERC1155ToEthereum is a transfer of ids of an ERC1155 token from Cosmos
+/// to Ethereum. ERC1155 transfers pay no bridge fee, a batch of them is created
+/// once the previous batch of the token has been executed or timed out.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SendErc1155ToEthereum {
+    #[prost(uint64, tag = "1")]
+    pub id: u64,
+    #[prost(string, tag = "2")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub ethereum_recipient: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "5")]
+    pub amounts: ::prost::alloc::vec::Vec<Erc1155Amount>,
+}
+/// ERC1155Amount is an amount of one id of an ERC1155 token
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Erc1155Amount {
+    #[prost(string, tag = "1")]
+    pub id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub amount: ::prost::alloc::string::String,
+}
+/// ERC1155Token is the id of an ERC1155 token vouchers are minted for. Their
+/// denom is gravity1155/ followed by the hex SHA256 hash of
+/// <evm chain id>/<contract>/<id>, ids being too long to fit in a denom.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Erc1155Token {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "2")]
+    pub contract: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub id: ::prost::alloc::string::String,
 }
 /// ContractCallTx represents an individual arbitrary logic call transaction
 /// from Cosmos to Ethereum.
@@ -92,6 +176,14 @@ pub struct ContractCallTx {
     pub fees: ::prost::alloc::vec::Vec<Erc20Token>,
     #[prost(uint64, tag = "8")]
     pub height: u64,
+    /// the account the tokens and fees are refunded to if the call times out,
+    /// empty if they aren't refunded
+    #[prost(string, tag = "9")]
+    pub refund_address: ::prost::alloc::string::String,
+    /// the version of the encoding of the checkpoint, zero for the txs created
+    /// before checkpoints were versioned, which are encoded with the first version
+    #[prost(uint32, tag = "10")]
+    pub checkpoint_version: u32,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Erc20Token {
@@ -118,216 +210,1177 @@ pub struct CommunityPoolEthereumSpendProposal {
     #[prost(message, optional, tag = "5")]
     pub bridge_fee: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
 }
-/// This format of the community spend Ethereum proposal is specifically for
-/// the CLI to allow simple text serialization.
+/// EVMChain describes an EVM chain bridged to by its own Gravity contract. The
+/// default chain is described by the gravity_id, bridge_ethereum_address and
+/// bridge_chain_id params, additional chains are added by governance.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct CommunityPoolEthereumSpendProposalForCli {
-    #[prost(string, tag = "1")]
-    pub title: ::prost::alloc::string::String,
+pub struct EvmChain {
+    #[prost(uint64, tag = "1")]
+    pub chain_id: u64,
     #[prost(string, tag = "2")]
-    pub description: ::prost::alloc::string::String,
+    pub name: ::prost::alloc::string::String,
+    /// like the gravity_id param this salts the signatures for the chain's
+    /// Gravity contract and must not be changed once the contract is deployed
     #[prost(string, tag = "3")]
-    pub recipient: ::prost::alloc::string::String,
+    pub gravity_id: ::prost::alloc::string::String,
     #[prost(string, tag = "4")]
-    pub amount: ::prost::alloc::string::String,
-    #[prost(string, tag = "5")]
-    pub bridge_fee: ::prost::alloc::string::String,
-    #[prost(string, tag = "6")]
-    pub deposit: ::prost::alloc::string::String,
+    pub bridge_ethereum_address: ::prost::alloc::string::String,
+    #[prost(enumeration = "Finality", tag = "5")]
+    pub finality: i32,
+    /// only used with FINALITY_CONFIRMATIONS
+    #[prost(uint64, tag = "6")]
+    pub minimum_confirmations: u64,
+    /// the minimum bridge fees transfers to the chain must pay, priced in the
+    /// chain's gas economics
+    #[prost(message, repeated, tag = "7")]
+    pub fee_floors: ::prost::alloc::vec::Vec<FeeFloor>,
+    /// the DepositAddressFactory deposit addresses of the chain are cloned by,
+    /// empty if the chain has none
+    #[prost(string, tag = "8")]
+    pub deposit_address_factory: ::prost::alloc::string::String,
+    /// the denoms whose ERC20s on the chain use other decimals than the denom
+    #[prost(message, repeated, tag = "9")]
+    pub token_decimals: ::prost::alloc::vec::Vec<TokenDecimals>,
+    /// the decimals of the chain's native gas token, zero for the usual 18
+    #[prost(uint32, tag = "10")]
+    pub native_decimals: u32,
+    /// caps on the amounts of tokens transferred to the chain, so an incident on
+    /// it can only drain so much before governance pauses it
+    #[prost(message, repeated, tag = "11")]
+    pub rate_limits: ::prost::alloc::vec::Vec<RateLimit>,
 }
-/// MsgSendToEthereum submits a SendToEthereum attempt to bridge an asset over to
-/// Ethereum. The SendToEthereum will be stored and then included in a batch and
-/// then submitted to Ethereum.
+/// RateLimit caps the amount of an ERC20, fees included, transferred to an EVM
+/// chain within a window of Cosmos blocks. Transfers that would take the total
+/// of the current window past the limit are rejected until the next window.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgSendToEthereum {
+pub struct RateLimit {
     #[prost(string, tag = "1")]
-    pub sender: ::prost::alloc::string::String,
+    pub token_contract: ::prost::alloc::string::String,
     #[prost(string, tag = "2")]
-    pub ethereum_recipient: ::prost::alloc::string::String,
-    #[prost(message, optional, tag = "3")]
-    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
-    #[prost(message, optional, tag = "4")]
-    pub bridge_fee: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
-}
-/// MsgSendToEthereumResponse returns the SendToEthereum transaction ID which
-/// will be included in the batch tx.
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgSendToEthereumResponse {
-    #[prost(uint64, tag = "1")]
-    pub id: u64,
+    pub limit: ::prost::alloc::string::String,
+    /// the length of a window in blocks
+    #[prost(uint64, tag = "3")]
+    pub window: u64,
 }
-/// MsgCancelSendToEthereum allows the sender to cancel its own outgoing
-/// SendToEthereum tx and recieve a refund of the tokens and bridge fees. This tx
-/// will only succeed if the SendToEthereum tx hasn't been batched to be
-/// processed and relayed to Ethereum.
+/// RateLimitUsage is the amount of a token transferred to an EVM chain since the
+/// start of the current window of its rate limit
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgCancelSendToEthereum {
+pub struct RateLimitUsage {
     #[prost(uint64, tag = "1")]
-    pub id: u64,
+    pub window_start: u64,
     #[prost(string, tag = "2")]
-    pub sender: ::prost::alloc::string::String,
+    pub amount: ::prost::alloc::string::String,
 }
+/// IBCForwardChannel is an IBC channel deposits can be transferred on to the
+/// counterparty chain in the same step. The 20 address bytes of the deposit's
+/// destination are the receiver there, bech32 encoded with the chain's prefix.
+/// Vouchers of transfers that time out or fail are refunded to the receiver's
+/// account on this chain.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgCancelSendToEthereumResponse {}
-/// MsgRequestBatchTx requests a batch of transactions with a given coin
-/// denomination to send across the bridge to Ethereum.
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgRequestBatchTx {
+pub struct IbcForwardChannel {
     #[prost(string, tag = "1")]
-    pub denom: ::prost::alloc::string::String,
-    #[prost(string, tag = "2")]
-    pub signer: ::prost::alloc::string::String,
-}
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgRequestBatchTxResponse {}
-/// MsgSubmitEthereumTxConfirmation submits an ethereum signature for a given
-/// validator
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgSubmitEthereumTxConfirmation {
-    /// TODO: can we make this take an array?
-    #[prost(message, optional, tag = "1")]
-    pub confirmation: ::core::option::Option<::prost_types::Any>,
+    pub channel_id: ::prost::alloc::string::String,
     #[prost(string, tag = "2")]
-    pub signer: ::prost::alloc::string::String,
+    pub bech32_prefix: ::prost::alloc::string::String,
+    /// how long the counterparty has to receive a transfer, in seconds
+    #[prost(uint64, tag = "3")]
+    pub timeout: u64,
+    /// the channel of the gravitycallback port the outcome of forwarded deposits
+    /// is sent on, empty if it isn't sent
+    #[prost(string, tag = "4")]
+    pub callback_channel_id: ::prost::alloc::string::String,
+    /// the contract on the callback channel's chain the outcome is addressed to
+    #[prost(string, tag = "5")]
+    pub callback_contract: ::prost::alloc::string::String,
 }
-/// ContractCallTxConfirmation is a signature on behalf of a validator for a
-/// ContractCallTx.
+/// ForwardedDeposit is a deposit transferred on over IBC whose transfer hasn't
+/// been acknowledged or timed out yet
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct ContractCallTxConfirmation {
-    #[prost(bytes = "vec", tag = "1")]
-    pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
+pub struct ForwardedDeposit {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
     #[prost(uint64, tag = "2")]
-    pub invalidation_nonce: u64,
+    pub event_nonce: u64,
     #[prost(string, tag = "3")]
-    pub ethereum_signer: ::prost::alloc::string::String,
-    #[prost(bytes = "vec", tag = "4")]
-    pub signature: ::prost::alloc::vec::Vec<u8>,
+    pub ethereum_sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub channel_id: ::prost::alloc::string::String,
+    /// the sequence of the transfer's packet
+    #[prost(uint64, tag = "5")]
+    pub sequence: u64,
+    #[prost(string, tag = "6")]
+    pub receiver: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "7")]
+    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
 }
-/// BatchTxConfirmation is a signature on behalf of a validator for a BatchTx.
+/// LogicCallTemplate is a logic call governance allows remote chains to make
+/// over IBC, with the tokens of an ICS-20 transfer whose memo names the
+/// template. The transferred tokens, less the fee, are sent to the logic
+/// contract along with the payload, and are refunded to the transfer's
+/// receiver if the call times out.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct BatchTxConfirmation {
+pub struct LogicCallTemplate {
     #[prost(string, tag = "1")]
-    pub token_contract: ::prost::alloc::string::String,
+    pub name: ::prost::alloc::string::String,
+    /// the EVM chain the call is made on, zero being the default chain
     #[prost(uint64, tag = "2")]
-    pub batch_nonce: u64,
+    pub evm_chain_id: u64,
     #[prost(string, tag = "3")]
-    pub ethereum_signer: ::prost::alloc::string::String,
+    pub logic_contract: ::prost::alloc::string::String,
     #[prost(bytes = "vec", tag = "4")]
-    pub signature: ::prost::alloc::vec::Vec<u8>,
+    pub payload: ::prost::alloc::vec::Vec<u8>,
 }
-/// SignerSetTxConfirmation is a signature on behalf of a validator for a
-/// SignerSetTx
+/// TokenDecimals scales the amounts of a denom bridged to an EVM chain whose
+/// ERC20 of it uses other decimals than the denom, e.g. a chain whose stablecoins
+/// have 18 decimals bridging a 6 decimal denom. ERC20 amounts, including those of
+/// outgoing txs and so their checkpoints, are the denom's amounts times
+/// 10^(erc20_decimals - denom_decimals). Deposits drop what is below the
+/// smallest unit of the denom. Changing the decimals of a denom with transfers
+/// pending refunds them at the new scale.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct SignerSetTxConfirmation {
-    #[prost(uint64, tag = "1")]
-    pub signer_set_nonce: u64,
-    #[prost(string, tag = "2")]
-    pub ethereum_signer: ::prost::alloc::string::String,
-    #[prost(bytes = "vec", tag = "3")]
-    pub signature: ::prost::alloc::vec::Vec<u8>,
+pub struct TokenDecimals {
+    #[prost(string, tag = "1")]
+    pub denom: ::prost::alloc::string::String,
+    #[prost(uint32, tag = "2")]
+    pub denom_decimals: u32,
+    #[prost(uint32, tag = "3")]
+    pub erc20_decimals: u32,
 }
+/// FeeFloor is the minimum bridge fee of transfers of an ERC20 to an EVM chain.
+/// Fees are paid in the transferred token, so the tokens with a floor are the
+/// fee tokens of the chain. Transfers of tokens without a floor may pay any fee.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgSubmitEthereumTxConfirmationResponse {}
-/// MsgSubmitEthereumEvent
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgSubmitEthereumEvent {
-    #[prost(message, optional, tag = "1")]
-    pub event: ::core::option::Option<::prost_types::Any>,
+pub struct FeeFloor {
+    #[prost(string, tag = "1")]
+    pub token_contract: ::prost::alloc::string::String,
     #[prost(string, tag = "2")]
-    pub signer: ::prost::alloc::string::String,
+    pub minimum_fee: ::prost::alloc::string::String,
 }
+/// AddEVMChainProposal adds an EVM chain to bridge to, once passed the chain
+/// gets its own signer set txs, batches and event nonces.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgSubmitEthereumEventResponse {}
-/// MsgDelegateKey allows validators to delegate their voting responsibilities
-/// to a given orchestrator address. This key is then used as an optional
-/// authentication method for attesting events from Ethereum.
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgDelegateKeys {
+pub struct AddEvmChainProposal {
     #[prost(string, tag = "1")]
-    pub validator_address: ::prost::alloc::string::String,
+    pub title: ::prost::alloc::string::String,
     #[prost(string, tag = "2")]
-    pub orchestrator_address: ::prost::alloc::string::String,
-    #[prost(string, tag = "3")]
-    pub ethereum_address: ::prost::alloc::string::String,
-    #[prost(bytes = "vec", tag = "4")]
-    pub eth_signature: ::prost::alloc::vec::Vec<u8>,
+    pub description: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "3")]
+    pub chain: ::core::option::Option<EvmChain>,
 }
+/// ContractMigrationProposal moves the bridge of an EVM chain to a newly deployed
+/// Gravity contract. Once passed no new batches or contract calls are created for
+/// the chain, and when the outstanding ones have executed or timed out the chain
+/// cuts over to the new contract, starting a new bridging epoch. From then on only
+/// events of the new contract, at or after its deployment height, are accepted.
+/// Funds held by the old contract must be moved to the new one out of band.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgDelegateKeysResponse {}
-/// DelegateKeysSignMsg defines the message structure an operator is expected to
-/// sign when submitting a MsgDelegateKeys message. The resulting signature
-/// should populate the eth_signature field.
+pub struct ContractMigrationProposal {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    /// zero selects the default chain
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "4")]
+    pub bridge_ethereum_address: ::prost::alloc::string::String,
+    /// the height the new contract was deployed at
+    #[prost(uint64, tag = "5")]
+    pub ethereum_height: u64,
+}
+/// ContractMigration is a migration to a new Gravity contract waiting for the
+/// outgoing txs of the current contract to drain.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DelegateKeysSignMsg {
+pub struct ContractMigration {
     #[prost(string, tag = "1")]
-    pub validator_address: ::prost::alloc::string::String,
+    pub bridge_ethereum_address: ::prost::alloc::string::String,
     #[prost(uint64, tag = "2")]
-    pub nonce: u64,
+    pub ethereum_height: u64,
+    /// the Cosmos height the migration was approved at
+    #[prost(uint64, tag = "3")]
+    pub height: u64,
 }
-/// Periodic update of latest observed Ethereum and Cosmos heights from the
-/// orchestrator
+/// BridgeContract is the Gravity contract an EVM chain is bridged through. The
+/// epoch is incremented by each completed contract migration, before the first
+/// migration the contract is the one described by the chain's EVMChain entry.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgEthereumHeightVote {
-    #[prost(uint64, tag = "1")]
+pub struct BridgeContract {
+    #[prost(string, tag = "1")]
+    pub bridge_ethereum_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub epoch: u64,
+    /// events below this height belong to a previous contract
+    #[prost(uint64, tag = "3")]
     pub ethereum_height: u64,
-    #[prost(string, tag = "2")]
-    pub signer: ::prost::alloc::string::String,
 }
+/// EVMChainPauseProposal pauses or resumes bridging to an EVM chain. While the
+/// chain is paused no transfers to it are accepted and no batches or contract
+/// calls are created for it. Its events are still accepted, so deposits made
+/// before the pause took effect are not stranded.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct MsgEthereumHeightVoteResponse {}
-////////////
-// Events //
-////////////
-
-/// SendToCosmosEvent is submitted when the SendToCosmosEvent is emitted by they
-/// gravity contract. ERC20 representation coins are minted to the cosmosreceiver
-/// address.
-#[derive(Clone, PartialEq, ::prost::Message)]
-pub struct SendToCosmosEvent {
-    #[prost(uint64, tag = "1")]
-    pub event_nonce: u64,
+pub struct EvmChainPauseProposal {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
     #[prost(string, tag = "2")]
-    pub token_contract: ::prost::alloc::string::String,
-    #[prost(string, tag = "3")]
-    pub amount: ::prost::alloc::string::String,
+    pub description: ::prost::alloc::string::String,
+    /// zero selects the default chain
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+    /// false resumes the chain
+    #[prost(bool, tag = "4")]
+    pub paused: bool,
+}
+/// GravityIDRotationProposal rotates the gravity id of an EVM chain, e.g. for a
+/// redeploy of its Gravity contract. Once passed the checkpoints of all outgoing
+/// txs are produced for the new id, while for the acceptance window confirmations
+/// signed for the previous id are still accepted so that orchestrators can move
+/// over to the new contract without stalling the bridge.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GravityIdRotationProposal {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    /// zero selects the default chain
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
     #[prost(string, tag = "4")]
-    pub ethereum_sender: ::prost::alloc::string::String,
-    #[prost(string, tag = "5")]
-    pub cosmos_receiver: ::prost::alloc::string::String,
-    #[prost(uint64, tag = "6")]
-    pub ethereum_height: u64,
+    pub gravity_id: ::prost::alloc::string::String,
+    /// the number of blocks the previous gravity id is still accepted for
+    #[prost(uint64, tag = "5")]
+    pub acceptance_window: u64,
 }
-/// BatchExecutedEvent claims that a batch of BatchTxExecutedal operations on the
-/// bridge contract was executed successfully on ETH
+/// GravityIDRotation is a rotation of an EVM chain's gravity id whose acceptance
+/// window hasn't ended yet.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct BatchExecutedEvent {
+pub struct GravityIdRotation {
     #[prost(string, tag = "1")]
-    pub token_contract: ::prost::alloc::string::String,
+    pub previous_gravity_id: ::prost::alloc::string::String,
+    /// the last Cosmos height confirmations for the previous id are accepted at
     #[prost(uint64, tag = "2")]
-    pub event_nonce: u64,
-    #[prost(uint64, tag = "3")]
-    pub ethereum_height: u64,
-    #[prost(uint64, tag = "4")]
-    pub batch_nonce: u64,
+    pub end_height: u64,
 }
-// ContractCallExecutedEvent describes a contract call that has been
-// successfully executed on Ethereum.
-
-/// NOTE: bytes.HexBytes is supposed to "help" with json encoding/decoding
-/// investigate?
+/// RelayerIncentiveProposal moves coins out of the community pool to the relayer
+/// reward account to subsidize relaying to an EVM chain. The amount is disbursed
+/// in equal parts every disbursement period, to the orchestrators of the chain's
+/// latest signer set in proportion to their power, until all of it is paid out.
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct ContractCallExecutedEvent {
-    #[prost(uint64, tag = "1")]
-    pub event_nonce: u64,
-    #[prost(bytes = "vec", tag = "2")]
-    pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
+pub struct RelayerIncentiveProposal {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    /// zero selects the default chain
     #[prost(uint64, tag = "3")]
-    pub invalidation_nonce: u64,
-    #[prost(uint64, tag = "4")]
-    pub ethereum_height: u64,
+    pub evm_chain_id: u64,
+    #[prost(message, repeated, tag = "4")]
+    pub amount: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    /// the number of blocks between disbursements
+    #[prost(uint64, tag = "5")]
+    pub disbursement_period: u64,
+    /// the number of disbursements the amount is split into
+    #[prost(uint64, tag = "6")]
+    pub disbursements: u64,
 }
-/// ERC20DeployedEvent is submitted when an ERC20 contract
-/// for a Cosmos SDK coin has been deployed on Ethereum.
+/// RelayerIncentive is the disbursement schedule of the coins a relayer incentive
+/// proposal funded. It is kept once paid out, as the record of the incentive.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RelayerIncentive {
+    #[prost(uint64, tag = "1")]
+    pub id: u64,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+    #[prost(message, repeated, tag = "3")]
+    pub amount: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    /// what has been paid out to orchestrators so far
+    #[prost(message, repeated, tag = "4")]
+    pub disbursed: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(uint64, tag = "5")]
+    pub disbursement_period: u64,
+    #[prost(uint64, tag = "6")]
+    pub disbursements: u64,
+    #[prost(uint64, tag = "7")]
+    pub disbursements_made: u64,
+    /// the Cosmos height of the next disbursement
+    #[prost(uint64, tag = "8")]
+    pub next_disbursement_height: u64,
+}
+/// EthereumEventRejectionProposal rejects the vote record of an event stuck at the
+/// next event nonce of an EVM chain, e.g. after a faulty orchestrator release split
+/// the votes so that no record can be observed. The nonce is then skipped without
+/// any of its events being applied, and the events after it are tallied again.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthereumEventRejectionProposal {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    /// zero selects the default chain
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "4")]
+    pub event_nonce: u64,
+    /// the hex encoded hash of the event whose vote record is rejected
+    #[prost(string, tag = "5")]
+    pub event_hash: ::prost::alloc::string::String,
+}
+/// IncidentRecoveryProposal mints bridged vouchers to an account or burns them
+/// from it, e.g. to reimburse users after an incident. It is the governance route
+/// to MsgMintVouchers and MsgBurnVouchers for as long as governance can't execute
+/// messages.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct IncidentRecoveryProposal {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(enumeration = "IncidentAction", tag = "3")]
+    pub action: i32,
+    #[prost(string, tag = "4")]
+    pub account: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "5")]
+    pub amount: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    /// kept in the incident record of the action
+    #[prost(string, tag = "6")]
+    pub reason: ::prost::alloc::string::String,
+}
+/// IncidentRecord is the entry of the incident log for a mint or burn of bridged
+/// vouchers by the authority of the module. The log is append only, records are
+/// never updated or removed.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct IncidentRecord {
+    #[prost(uint64, tag = "1")]
+    pub id: u64,
+    #[prost(enumeration = "IncidentAction", tag = "2")]
+    pub action: i32,
+    #[prost(string, tag = "3")]
+    pub authority: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub account: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "5")]
+    pub amount: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(string, tag = "6")]
+    pub reason: ::prost::alloc::string::String,
+    /// the Cosmos height the action was taken at
+    #[prost(uint64, tag = "7")]
+    pub height: u64,
+}
+/// BridgeReport summarizes the activity of the bridge over all EVM chains during
+/// a period of Cosmos blocks, from its start height to its end height included.
+/// The report of the period in progress is updated as the activity happens.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeReport {
+    #[prost(uint64, tag = "1")]
+    pub id: u64,
+    #[prost(uint64, tag = "2")]
+    pub start_height: u64,
+    #[prost(uint64, tag = "3")]
+    pub end_height: u64,
+    /// the sends to Ethereum created and the deposits to Cosmos observed
+    #[prost(uint64, tag = "4")]
+    pub sends_to_ethereum: u64,
+    #[prost(uint64, tag = "5")]
+    pub sends_to_cosmos: u64,
+    #[prost(uint64, tag = "6")]
+    pub batches_executed: u64,
+    #[prost(uint64, tag = "7")]
+    pub contract_calls_executed: u64,
+    /// the fees of the sends of the executed batches, in Cosmos denoms
+    #[prost(message, repeated, tag = "8")]
+    pub fees_collected: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    /// the validators slashed for missing outgoing tx signatures
+    #[prost(uint64, tag = "9")]
+    pub validators_slashed: u64,
+    /// anomalies governance may want to look into
+    #[prost(uint64, tag = "10")]
+    pub rejected_event_votes: u64,
+    #[prost(uint64, tag = "11")]
+    pub vetoed_outgoing_txs: u64,
+    #[prost(uint64, tag = "12")]
+    pub timed_out_outgoing_txs: u64,
+    #[prost(uint64, tag = "13")]
+    pub incident_records: u64,
+}
+/// TransferRecord is a completed transfer of the bridge history, a deposit to
+/// Cosmos or the execution of a batch on Ethereum, as observed by the validators
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TransferRecord {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub event_nonce: u64,
+    #[prost(uint64, tag = "3")]
+    pub ethereum_height: u64,
+    /// send_to_cosmos, send_erc1155_to_cosmos, batch_executed or
+    /// erc1155_batch_executed
+    #[prost(string, tag = "4")]
+    pub kind: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub token_contract: ::prost::alloc::string::String,
+    /// the amount of a deposit, as id:amount pairs for ERC1155 tokens
+    #[prost(string, tag = "6")]
+    pub amount: ::prost::alloc::string::String,
+    #[prost(string, tag = "7")]
+    pub ethereum_sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "8")]
+    pub cosmos_receiver: ::prost::alloc::string::String,
+    /// the nonce of an executed batch
+    #[prost(uint64, tag = "9")]
+    pub batch_nonce: u64,
+}
+/// PoolAggregate is the count and the fee total of the unbatched transfers of a
+/// token to a chain, kept up to date as transfers enter and leave the pool so the
+/// batch creation doesn't have to go through the pool
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PoolAggregate {
+    #[prost(uint64, tag = "1")]
+    pub count: u64,
+    /// the fees of the transfers, always zero for ERC1155 tokens
+    #[prost(string, tag = "2")]
+    pub total_fees: ::prost::alloc::string::String,
+}
+/// VoterSet is the validators of a signer set with their power at its creation,
+/// in the order of the signer set, that the vote bitmaps of the event vote
+/// records index
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct VoterSet {
+    #[prost(message, repeated, tag = "1")]
+    pub voters: ::prost::alloc::vec::Vec<Voter>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Voter {
+    #[prost(string, tag = "1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(int64, tag = "2")]
+    pub power: i64,
+}
+/// AttestationLatency tracks the Cosmos blocks between the first vote for the
+/// events of a chain and their observation, over the last observed events
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AttestationLatency {
+    /// the latencies of the last observed events, oldest first
+    #[prost(uint64, repeated, tag = "1")]
+    pub samples: ::prost::alloc::vec::Vec<u64>,
+    /// the percentiles of the samples, by nearest rank
+    #[prost(uint64, tag = "2")]
+    pub p50: u64,
+    #[prost(uint64, tag = "3")]
+    pub p90: u64,
+    #[prost(uint64, tag = "4")]
+    pub p99: u64,
+    #[prost(uint64, tag = "5")]
+    pub max: u64,
+}
+/// BridgeState is the bridge critical state committed to at the end of each
+/// block, the bridge state hash being the sha256 of its protobuf encoding
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeState {
+    #[prost(uint64, tag = "1")]
+    pub height: u64,
+    /// the state of each EVM chain, by chain id
+    #[prost(message, repeated, tag = "2")]
+    pub chains: ::prost::alloc::vec::Vec<EvmChainBridgeState>,
+    #[prost(uint64, tag = "3")]
+    pub last_outgoing_batch_nonce: u64,
+    #[prost(uint64, tag = "4")]
+    pub last_send_to_ethereum_id: u64,
+    /// the total supply of the vouchers of the tokens bridged from all chains
+    #[prost(message, repeated, tag = "5")]
+    pub voucher_supply: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// EVMChainBridgeState is the bridge critical state of an EVM chain, what the
+/// contract on the chain is expected to agree with
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EvmChainBridgeState {
+    #[prost(uint64, tag = "1")]
+    pub chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub last_observed_event_nonce: u64,
+    #[prost(uint64, tag = "3")]
+    pub latest_signer_set_tx_nonce: u64,
+    /// the checkpoint of the last signer set observed on the contract
+    #[prost(bytes = "vec", tag = "4")]
+    pub last_observed_checkpoint: ::prost::alloc::vec::Vec<u8>,
+    /// the cosmos originated coins locked for the chain
+    #[prost(message, repeated, tag = "5")]
+    pub escrowed: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// BridgeStateHash is the hash of the bridge state at the end of a block
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeStateHash {
+    #[prost(uint64, tag = "1")]
+    pub height: u64,
+    #[prost(bytes = "vec", tag = "2")]
+    pub hash: ::prost::alloc::vec::Vec<u8>,
+}
+/// This format of the community spend Ethereum proposal is specifically for
+/// the CLI to allow simple text serialization.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CommunityPoolEthereumSpendProposalForCli {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub recipient: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub amount: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub bridge_fee: ::prost::alloc::string::String,
+    #[prost(string, tag = "6")]
+    pub deposit: ::prost::alloc::string::String,
+}
+/// This format of the add EVM chain proposal is specifically for the CLI to
+/// allow simple text serialization.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AddEvmChainProposalForCli {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "3")]
+    pub chain: ::core::option::Option<EvmChain>,
+    #[prost(string, tag = "4")]
+    pub deposit: ::prost::alloc::string::String,
+}
+/// This format of the contract migration proposal is specifically for the CLI
+/// to allow simple text serialization.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ContractMigrationProposalForCli {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "4")]
+    pub bridge_ethereum_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "5")]
+    pub ethereum_height: u64,
+    #[prost(string, tag = "6")]
+    pub deposit: ::prost::alloc::string::String,
+}
+/// This format of the EVM chain pause proposal is specifically for the CLI to
+/// allow simple text serialization.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EvmChainPauseProposalForCli {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+    #[prost(bool, tag = "4")]
+    pub paused: bool,
+    #[prost(string, tag = "5")]
+    pub deposit: ::prost::alloc::string::String,
+}
+/// This format of the gravity id rotation proposal is specifically for the CLI
+/// to allow simple text serialization.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GravityIdRotationProposalForCli {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "4")]
+    pub gravity_id: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "5")]
+    pub acceptance_window: u64,
+    #[prost(string, tag = "6")]
+    pub deposit: ::prost::alloc::string::String,
+}
+/// UpdateParamsProposal replaces the params of the module, it is the governance
+/// route to MsgUpdateParams for as long as governance can't execute messages.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UpdateParamsProposal {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "3")]
+    pub params: ::core::option::Option<Params>,
+    /// the Cosmos height the params take effect at, zero to replace them as soon
+    /// as the proposal passes
+    #[prost(uint64, tag = "4")]
+    pub effective_height: u64,
+}
+/// ScheduledParamsUpdate is an update of the params waiting for the height it
+/// takes effect at. There is at most one, scheduling another update replaces it
+/// and updating the params right away cancels it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ScheduledParamsUpdate {
+    #[prost(message, optional, tag = "1")]
+    pub params: ::core::option::Option<Params>,
+    #[prost(uint64, tag = "2")]
+    pub height: u64,
+}
+/// This format of the update params proposal is specifically for the CLI to
+/// allow simple text serialization.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UpdateParamsProposalForCli {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "3")]
+    pub params: ::core::option::Option<Params>,
+    #[prost(string, tag = "4")]
+    pub deposit: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "5")]
+    pub effective_height: u64,
+}
+/// DepositAddress is the forwarding address deposits to a Cosmos recipient can
+/// be sent to on an EVM chain, for senders such as exchanges that can't set the
+/// destination of sendToCosmos. The address is a clone of the chain's
+/// DepositAddressFactory forwarder created with the recipient's destination as
+/// salt, anyone can have the factory deploy it and forward what it holds to the
+/// Gravity contract. Once registered the address is kept even if the factory of
+/// the chain changes.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DepositAddress {
+    #[prost(string, tag = "1")]
+    pub recipient: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub deposit_address: ::prost::alloc::string::String,
+}
+/// This format of the relayer incentive proposal is specifically for the CLI to
+/// allow simple text serialization.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RelayerIncentiveProposalForCli {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "4")]
+    pub amount: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "5")]
+    pub disbursement_period: u64,
+    #[prost(uint64, tag = "6")]
+    pub disbursements: u64,
+    #[prost(string, tag = "7")]
+    pub deposit: ::prost::alloc::string::String,
+}
+/// This format of the Ethereum event rejection proposal is specifically for the
+/// CLI to allow simple text serialization.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthereumEventRejectionProposalForCli {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "4")]
+    pub event_nonce: u64,
+    #[prost(string, tag = "5")]
+    pub event_hash: ::prost::alloc::string::String,
+    #[prost(string, tag = "6")]
+    pub deposit: ::prost::alloc::string::String,
+}
+/// This format of the incident recovery proposal is specifically for the CLI to
+/// allow simple text serialization.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct IncidentRecoveryProposalForCli {
+    #[prost(string, tag = "1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(enumeration = "IncidentAction", tag = "3")]
+    pub action: i32,
+    #[prost(string, tag = "4")]
+    pub account: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub amount: ::prost::alloc::string::String,
+    #[prost(string, tag = "6")]
+    pub reason: ::prost::alloc::string::String,
+    #[prost(string, tag = "7")]
+    pub deposit: ::prost::alloc::string::String,
+}
+/// Finality selects when an EVM chain's blocks are considered final enough for
+/// their events to be accepted.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum Finality {
+    /// a fixed number of blocks past the event's block, for chains with
+    /// probabilistic finality like Ethereum mainnet
+    Confirmations = 0,
+    /// the block tagged safe by the chain's node
+    Safe = 1,
+    /// the block tagged finalized by the chain's node, for optimistic rollups
+    /// this is the block whose batch is final on the settlement layer
+    Finalized = 2,
+}
+/// IncidentAction is a manual adjustment of the supply of bridged vouchers
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum IncidentAction {
+    Unspecified = 0,
+    /// vouchers minted to an account
+    Mint = 1,
+    /// vouchers burned from an account
+    Burn = 2,
+}
+/// MsgSendToEthereum submits a SendToEthereum attempt to bridge an asset over to
+/// Ethereum. The SendToEthereum will be stored and then included in a batch and
+/// then submitted to Ethereum.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSendToEthereum {
+    #[prost(string, tag = "1")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub ethereum_recipient: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "3")]
+    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(message, optional, tag = "4")]
+    pub bridge_fee: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(uint64, tag = "5")]
+    pub evm_chain_id: u64,
+}
+/// MsgSendToEthereumResponse returns the SendToEthereum transaction ID which
+/// will be included in the batch tx.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSendToEthereumResponse {
+    #[prost(uint64, tag = "1")]
+    pub id: u64,
+}
+/// MsgCancelSendToEthereum allows the sender to cancel its own outgoing
+/// SendToEthereum tx and recieve a refund of the tokens and bridge fees. This tx
+/// will only succeed if the SendToEthereum tx hasn't been batched to be
+/// processed and relayed to Ethereum.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgCancelSendToEthereum {
+    #[prost(uint64, tag = "1")]
+    pub id: u64,
+    #[prost(string, tag = "2")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgCancelSendToEthereumResponse {}
+/// MsgRequestBatchTx requests a batch of transactions with a given coin
+/// denomination to send across the bridge to Ethereum.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRequestBatchTx {
+    #[prost(string, tag = "1")]
+    pub denom: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub signer: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRequestBatchTxResponse {}
+/// MsgSubmitEthereumTxConfirmation submits an ethereum signature for a given
+/// validator
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitEthereumTxConfirmation {
+    /// TODO: can we make this take an array?
+    #[prost(message, optional, tag = "1")]
+    pub confirmation: ::core::option::Option<::prost_types::Any>,
+    #[prost(string, tag = "2")]
+    pub signer: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+}
+/// ContractCallTxConfirmation is a signature on behalf of a validator for a
+/// ContractCallTx.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ContractCallTxConfirmation {
+    #[prost(bytes = "vec", tag = "1")]
+    pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag = "2")]
+    pub invalidation_nonce: u64,
+    #[prost(string, tag = "3")]
+    pub ethereum_signer: ::prost::alloc::string::String,
+    #[prost(bytes = "vec", tag = "4")]
+    pub signature: ::prost::alloc::vec::Vec<u8>,
+}
+/// BatchTxConfirmation is a signature on behalf of a validator for a BatchTx.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchTxConfirmation {
+    #[prost(string, tag = "1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub batch_nonce: u64,
+    #[prost(string, tag = "3")]
+    pub ethereum_signer: ::prost::alloc::string::String,
+    #[prost(bytes = "vec", tag = "4")]
+    pub signature: ::prost::alloc::vec::Vec<u8>,
+}
+/// ERC1155BatchTxConfirmation is a signature on behalf of a validator for an
+/// ERC1155BatchTx.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Erc1155BatchTxConfirmation {
+    #[prost(string, tag = "1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub batch_nonce: u64,
+    #[prost(string, tag = "3")]
+    pub ethereum_signer: ::prost::alloc::string::String,
+    #[prost(bytes = "vec", tag = "4")]
+    pub signature: ::prost::alloc::vec::Vec<u8>,
+}
+/// SignerSetTxConfirmation is a signature on behalf of a validator for a
+/// SignerSetTx
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SignerSetTxConfirmation {
+    #[prost(uint64, tag = "1")]
+    pub signer_set_nonce: u64,
+    #[prost(string, tag = "2")]
+    pub ethereum_signer: ::prost::alloc::string::String,
+    #[prost(bytes = "vec", tag = "3")]
+    pub signature: ::prost::alloc::vec::Vec<u8>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitEthereumTxConfirmationResponse {}
+/// MsgSubmitEthereumEvent
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitEthereumEvent {
+    #[prost(message, optional, tag = "1")]
+    pub event: ::core::option::Option<::prost_types::Any>,
+    #[prost(string, tag = "2")]
+    pub signer: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitEthereumEventResponse {}
+/// MsgDelegateKey allows validators to delegate their voting responsibilities
+/// to a given orchestrator address. This key is then used as an optional
+/// authentication method for attesting events from Ethereum.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgDelegateKeys {
+    #[prost(string, tag = "1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub orchestrator_address: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub ethereum_address: ::prost::alloc::string::String,
+    #[prost(bytes = "vec", tag = "4")]
+    pub eth_signature: ::prost::alloc::vec::Vec<u8>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgDelegateKeysResponse {}
+/// DelegateKeysSignMsg defines the message structure an operator is expected to
+/// sign when submitting a MsgDelegateKeys message. The resulting signature
+/// should populate the eth_signature field.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysSignMsg {
+    #[prost(string, tag = "1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub nonce: u64,
+}
+/// Periodic update of latest observed Ethereum and Cosmos heights from the
+/// orchestrator
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgEthereumHeightVote {
+    #[prost(uint64, tag = "1")]
+    pub ethereum_height: u64,
+    #[prost(string, tag = "2")]
+    pub signer: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+    /// the finality the height was read with, it must match the chain's
+    #[prost(enumeration = "Finality", tag = "4")]
+    pub finality: i32,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgEthereumHeightVoteResponse {}
+/// MsgRequestDepositAddress registers the deposit address of the recipient on
+/// an EVM chain, deposits sent to it are credited to the recipient once
+/// forwarded to the Gravity contract. Requesting an address that is already
+/// registered returns it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRequestDepositAddress {
+    #[prost(string, tag = "1")]
+    pub recipient: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRequestDepositAddressResponse {
+    #[prost(string, tag = "1")]
+    pub deposit_address: ::prost::alloc::string::String,
+}
+/// MsgSendERC1155ToEthereum bridges vouchers of ids of an ERC1155 token back to
+/// the EVM chain they were deposited from. The vouchers are burned and the
+/// transfer is queued for the next ERC1155 batch of the token.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSendErc1155ToEthereum {
+    #[prost(string, tag = "1")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub ethereum_recipient: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "4")]
+    pub amounts: ::prost::alloc::vec::Vec<Erc1155Amount>,
+    #[prost(uint64, tag = "5")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSendErc1155ToEthereumResponse {
+    #[prost(uint64, tag = "1")]
+    pub id: u64,
+}
+/// MsgUpdateParams replaces the params of the module. Only the authority of the
+/// module, the governance module account unless configured otherwise, may send
+/// it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgUpdateParams {
+    #[prost(string, tag = "1")]
+    pub authority: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "2")]
+    pub params: ::core::option::Option<Params>,
+    /// the Cosmos height the params take effect at, in BeginBlock, so the change
+    /// can be coordinated with announcements and orchestrator releases. Zero
+    /// replaces the params right away.
+    #[prost(uint64, tag = "3")]
+    pub effective_height: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgUpdateParamsResponse {}
+/// MsgBridgeAdminPause pauses or unpauses bridging to an EVM chain. Only the
+/// bridge admin holding the pause permission may send it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBridgeAdminPause {
+    #[prost(string, tag = "1")]
+    pub admin: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+    #[prost(bool, tag = "3")]
+    pub paused: bool,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBridgeAdminPauseResponse {}
+/// MsgBridgeAdminSetRateLimits replaces the rate limits of an EVM chain. Only the
+/// bridge admin holding the rate limits permission may send it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBridgeAdminSetRateLimits {
+    #[prost(string, tag = "1")]
+    pub admin: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+    #[prost(message, repeated, tag = "3")]
+    pub rate_limits: ::prost::alloc::vec::Vec<RateLimit>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBridgeAdminSetRateLimitsResponse {}
+/// MsgBridgeAdminSetFeeFloors replaces the fee floors of an EVM chain. Only the
+/// bridge admin holding the fee floors permission may send it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBridgeAdminSetFeeFloors {
+    #[prost(string, tag = "1")]
+    pub admin: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+    #[prost(message, repeated, tag = "3")]
+    pub fee_floors: ::prost::alloc::vec::Vec<FeeFloor>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBridgeAdminSetFeeFloorsResponse {}
+/// MsgMintVouchers mints bridged vouchers to an account, e.g. to reimburse the
+/// losses of an incident. Only the authority of the module may send it, and the
+/// mint is kept in the incident log with its reason.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgMintVouchers {
+    #[prost(string, tag = "1")]
+    pub authority: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub recipient: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "3")]
+    pub amount: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(string, tag = "4")]
+    pub reason: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgMintVouchersResponse {
+    #[prost(uint64, tag = "1")]
+    pub incident_id: u64,
+}
+/// MsgBurnVouchers burns bridged vouchers from an account, e.g. vouchers minted
+/// for a deposit that was never made. Only the authority of the module may send
+/// it, and the burn is kept in the incident log with its reason.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBurnVouchers {
+    #[prost(string, tag = "1")]
+    pub authority: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub holder: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "3")]
+    pub amount: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(string, tag = "4")]
+    pub reason: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgBurnVouchersResponse {
+    #[prost(uint64, tag = "1")]
+    pub incident_id: u64,
+}
+/// MsgVetoBatchTx vetoes an outgoing batch during its veto delay, the sends of
+/// the batch being refunded to their senders. Only the veto council may send it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgVetoBatchTx {
+    #[prost(string, tag = "1")]
+    pub council: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "3")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "4")]
+    pub batch_nonce: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgVetoBatchTxResponse {}
+/// MsgVetoContractCallTx vetoes an outgoing contract call during its veto delay,
+/// its tokens and fees being refunded as if it timed out. Only the veto council
+/// may send it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgVetoContractCallTx {
+    #[prost(string, tag = "1")]
+    pub council: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+    #[prost(bytes = "vec", tag = "3")]
+    pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag = "4")]
+    pub invalidation_nonce: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgVetoContractCallTxResponse {}
+/// MsgInjectEthereumEvent observes an event as if all the bonded validators had
+/// voted for it, for local devnets without an Ethereum chain and orchestrators.
+/// It is only accepted by binaries built with the devnet build tag, and the
+/// event nonce must follow the last observed one of the chain.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgInjectEthereumEvent {
+    #[prost(message, optional, tag = "1")]
+    pub event: ::core::option::Option<::prost_types::Any>,
+    #[prost(string, tag = "2")]
+    pub signer: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgInjectEthereumEventResponse {}
+////////////
+// Events //
+////////////
+
+/// SendToCosmosEvent is submitted when the SendToCosmosEvent is emitted by they
+/// gravity contract. ERC20 representation coins are minted to the cosmosreceiver
+/// address.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SendToCosmosEvent {
+    #[prost(uint64, tag = "1")]
+    pub event_nonce: u64,
+    #[prost(string, tag = "2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub amount: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub ethereum_sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub cosmos_receiver: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "6")]
+    pub ethereum_height: u64,
+    /// the number of Ethereum confirmations the orchestrator observed when
+    /// submitting this event
+    #[prost(uint64, tag = "7")]
+    pub ethereum_confirmations: u64,
+    /// the EVM chain the deposit is routed on to, zero if it stays on Cosmos.
+    /// The receiver's address bytes are then the recipient on that chain.
+    #[prost(uint64, tag = "8")]
+    pub forward_evm_chain_id: u64,
+    /// the IBC channel the deposit is transferred on, empty if it stays on this
+    /// chain. Only channels in the ibc_forward_channels param are followed, and
+    /// not at all for deposits routed on to an EVM chain.
+    #[prost(string, tag = "9")]
+    pub forward_ibc_channel: ::prost::alloc::string::String,
+}
+/// BatchExecutedEvent claims that a batch of BatchTxExecutedal operations on the
+/// bridge contract was executed successfully on ETH
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchExecutedEvent {
+    #[prost(string, tag = "1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub event_nonce: u64,
+    #[prost(uint64, tag = "3")]
+    pub ethereum_height: u64,
+    #[prost(uint64, tag = "4")]
+    pub batch_nonce: u64,
+    /// the number of Ethereum confirmations the orchestrator observed when
+    /// submitting this event
+    #[prost(uint64, tag = "5")]
+    pub ethereum_confirmations: u64,
+}
+/// SendERC1155ToCosmosEvent is submitted when the gravity contract emits a
+/// SendERC1155ToCosmosEvent. Vouchers of each id are minted to the
+/// cosmos_receiver address.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SendErc1155ToCosmosEvent {
+    #[prost(uint64, tag = "1")]
+    pub event_nonce: u64,
+    #[prost(string, tag = "2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "3")]
+    pub amounts: ::prost::alloc::vec::Vec<Erc1155Amount>,
+    #[prost(string, tag = "4")]
+    pub ethereum_sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub cosmos_receiver: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "6")]
+    pub ethereum_height: u64,
+    /// the number of Ethereum confirmations the orchestrator observed when
+    /// submitting this event
+    #[prost(uint64, tag = "7")]
+    pub ethereum_confirmations: u64,
+}
+/// ERC1155BatchExecutedEvent claims that an ERC1155BatchTx was executed on
+/// Ethereum
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Erc1155BatchExecutedEvent {
+    #[prost(string, tag = "1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub event_nonce: u64,
+    #[prost(uint64, tag = "3")]
+    pub ethereum_height: u64,
+    #[prost(uint64, tag = "4")]
+    pub batch_nonce: u64,
+    /// the number of Ethereum confirmations the orchestrator observed when
+    /// submitting this event
+    #[prost(uint64, tag = "5")]
+    pub ethereum_confirmations: u64,
+}
+// ContractCallExecutedEvent describes a contract call that has been
+// successfully executed on Ethereum.
+
+/// NOTE: bytes.HexBytes is supposed to "help" with json encoding/decoding
+/// investigate?
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ContractCallExecutedEvent {
+    #[prost(uint64, tag = "1")]
+    pub event_nonce: u64,
+    #[prost(bytes = "vec", tag = "2")]
+    pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag = "3")]
+    pub invalidation_nonce: u64,
+    #[prost(uint64, tag = "4")]
+    pub ethereum_height: u64,
+    /// the number of Ethereum confirmations the orchestrator observed when
+    /// submitting this event
+    #[prost(uint64, tag = "5")]
+    pub ethereum_confirmations: u64,
+}
+/// ERC20DeployedEvent is submitted when an ERC20 contract
+/// for a Cosmos SDK coin has been deployed on Ethereum.
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Erc20DeployedEvent {
     #[prost(uint64, tag = "1")]
@@ -344,6 +1397,10 @@ pub struct Erc20DeployedEvent {
     pub erc20_decimals: u64,
     #[prost(uint64, tag = "7")]
     pub ethereum_height: u64,
+    /// the number of Ethereum confirmations the orchestrator observed when
+    /// submitting this event
+    #[prost(uint64, tag = "8")]
+    pub ethereum_confirmations: u64,
 }
 /// This informs the Cosmos module that a validator
 /// set has been updated.
@@ -357,6 +1414,25 @@ pub struct SignerSetTxExecutedEvent {
     pub ethereum_height: u64,
     #[prost(message, repeated, tag = "4")]
     pub members: ::prost::alloc::vec::Vec<EthereumSigner>,
+    /// the number of Ethereum confirmations the orchestrator observed when
+    /// submitting this event
+    #[prost(uint64, tag = "5")]
+    pub ethereum_confirmations: u64,
+}
+/// ContractVersionEvent informs the Cosmos module of the version of the Gravity
+/// contract, announced once by anyone calling announceContractVersion on it.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ContractVersionEvent {
+    #[prost(uint64, tag = "1")]
+    pub event_nonce: u64,
+    #[prost(uint64, tag = "2")]
+    pub version: u64,
+    #[prost(uint64, tag = "3")]
+    pub ethereum_height: u64,
+    /// the number of Ethereum confirmations the orchestrator observed when
+    /// submitting this event
+    #[prost(uint64, tag = "4")]
+    pub ethereum_confirmations: u64,
 }
 #[doc = r" Generated client implementations."]
 pub mod msg_client {
@@ -495,6 +1571,161 @@ pub mod msg_client {
                 http::uri::PathAndQuery::from_static("/gravity.v1.Msg/SubmitEthereumHeightVote");
             self.inner.unary(request.into_request(), path, codec).await
         }
+        pub async fn request_deposit_address(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgRequestDepositAddress>,
+        ) -> Result<tonic::Response<super::MsgRequestDepositAddressResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path =
+                http::uri::PathAndQuery::from_static("/gravity.v1.Msg/RequestDepositAddress");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn send_erc1155_to_ethereum(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgSendErc1155ToEthereum>,
+        ) -> Result<tonic::Response<super::MsgSendErc1155ToEthereumResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/SendERC1155ToEthereum");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn update_params(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgUpdateParams>,
+        ) -> Result<tonic::Response<super::MsgUpdateParamsResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/UpdateParams");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn bridge_admin_pause(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgBridgeAdminPause>,
+        ) -> Result<tonic::Response<super::MsgBridgeAdminPauseResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/BridgeAdminPause");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn bridge_admin_set_rate_limits(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgBridgeAdminSetRateLimits>,
+        ) -> Result<tonic::Response<super::MsgBridgeAdminSetRateLimitsResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/BridgeAdminSetRateLimits");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn bridge_admin_set_fee_floors(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgBridgeAdminSetFeeFloors>,
+        ) -> Result<tonic::Response<super::MsgBridgeAdminSetFeeFloorsResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/BridgeAdminSetFeeFloors");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn mint_vouchers(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgMintVouchers>,
+        ) -> Result<tonic::Response<super::MsgMintVouchersResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/MintVouchers");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn burn_vouchers(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgBurnVouchers>,
+        ) -> Result<tonic::Response<super::MsgBurnVouchersResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/BurnVouchers");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn veto_batch_tx(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgVetoBatchTx>,
+        ) -> Result<tonic::Response<super::MsgVetoBatchTxResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/VetoBatchTx");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn veto_contract_call_tx(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgVetoContractCallTx>,
+        ) -> Result<tonic::Response<super::MsgVetoContractCallTxResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/VetoContractCallTx");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn inject_ethereum_event(
+            &mut self,
+            request: impl tonic::IntoRequest<super::MsgInjectEthereumEvent>,
+        ) -> Result<tonic::Response<super::MsgInjectEthereumEventResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Msg/InjectEthereumEvent");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
     }
     impl<T: Clone> Clone for MsgClient<T> {
         fn clone(&self) -> Self {
@@ -571,69 +1802,690 @@ pub mod msg_client {
 /// The first three refer to not submitting a particular message, the third for
 /// submitting a different ethereum_signature for the same Ethereum event
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct Params {
-    #[prost(string, tag = "1")]
-    pub gravity_id: ::prost::alloc::string::String,
-    #[prost(string, tag = "2")]
-    pub contract_source_hash: ::prost::alloc::string::String,
+pub struct Params {
+    #[prost(string, tag = "1")]
+    pub gravity_id: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub contract_source_hash: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub bridge_ethereum_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "5")]
+    pub bridge_chain_id: u64,
+    #[prost(uint64, tag = "6")]
+    pub signed_signer_set_txs_window: u64,
+    #[prost(uint64, tag = "7")]
+    pub signed_batches_window: u64,
+    #[prost(uint64, tag = "8")]
+    pub ethereum_signatures_window: u64,
+    #[prost(uint64, tag = "10")]
+    pub target_eth_tx_timeout: u64,
+    #[prost(uint64, tag = "11")]
+    pub average_block_time: u64,
+    #[prost(uint64, tag = "12")]
+    pub average_ethereum_block_time: u64,
+    /// TODO: slash fraction for contract call txs too
+    #[prost(bytes = "vec", tag = "13")]
+    pub slash_fraction_signer_set_tx: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "14")]
+    pub slash_fraction_batch: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "15")]
+    pub slash_fraction_ethereum_signature: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes = "vec", tag = "16")]
+    pub slash_fraction_conflicting_ethereum_signature: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag = "17")]
+    pub unbond_slashing_signer_set_txs_window: u64,
+    /// the minimum number of blocks the last observed Ethereum height must be
+    /// past an event's block before the event is accepted
+    #[prost(uint64, tag = "18")]
+    pub minimum_ethereum_confirmations: u64,
+    /// when the default chain's events are final, with a tag based finality the
+    /// minimum confirmations must be zero
+    #[prost(enumeration = "Finality", tag = "19")]
+    pub ethereum_finality: i32,
+    /// the fee floors of the default chain
+    #[prost(message, repeated, tag = "20")]
+    pub ethereum_fee_floors: ::prost::alloc::vec::Vec<FeeFloor>,
+    /// the deposit address factory of the default chain
+    #[prost(string, tag = "21")]
+    pub ethereum_deposit_address_factory: ::prost::alloc::string::String,
+    /// the token decimals and native token decimals of the default chain
+    #[prost(message, repeated, tag = "22")]
+    pub ethereum_token_decimals: ::prost::alloc::vec::Vec<TokenDecimals>,
+    #[prost(uint32, tag = "23")]
+    pub ethereum_native_decimals: u32,
+    /// the rate limits of the default chain
+    #[prost(message, repeated, tag = "24")]
+    pub ethereum_rate_limits: ::prost::alloc::vec::Vec<RateLimit>,
+    /// the IBC channels deposits may be forwarded on
+    #[prost(message, repeated, tag = "25")]
+    pub ibc_forward_channels: ::prost::alloc::vec::Vec<IbcForwardChannel>,
+    /// the logic calls remote chains may make over IBC
+    #[prost(message, repeated, tag = "26")]
+    pub logic_call_templates: ::prost::alloc::vec::Vec<LogicCallTemplate>,
+    /// the authority allowed to take time-sensitive actions on the bridge without
+    /// waiting for a governance vote
+    #[prost(message, optional, tag = "27")]
+    pub bridge_admin: ::core::option::Option<BridgeAdmin>,
+    /// the contract versions features require, no outgoing txs of a feature are
+    /// created for chains whose attested contract version is lower
+    #[prost(message, repeated, tag = "28")]
+    pub minimum_contract_versions: ::prost::alloc::vec::Vec<MinimumContractVersion>,
+    /// the council allowed to veto outgoing batches and contract calls before
+    /// their signatures are collected
+    #[prost(message, optional, tag = "29")]
+    pub veto_council: ::core::option::Option<VetoCouncil>,
+    /// the number of Cosmos blocks covered by each bridge report, zero disables
+    /// the reports
+    #[prost(uint64, tag = "30")]
+    pub bridge_report_period: u64,
+    /// the caps on the unbatched transfers waiting in the pools of the EVM chains
+    #[prost(message, optional, tag = "31")]
+    pub pool_limits: ::core::option::Option<PoolLimits>,
+    /// reject the confirmations signing the checkpoints of outgoing txs on the
+    /// chains whose attested contract version verifies EIP-712 typed data
+    /// signatures, completing the migration of their orchestrators to typed data
+    #[prost(bool, tag = "32")]
+    pub typed_data_signatures_only: bool,
+    /// the scheme the validators sign outgoing txs with, which must be the one
+    /// the Gravity contracts verify
+    #[prost(enumeration = "SignatureSchemeType", tag = "33")]
+    pub signature_scheme: i32,
+    /// the heights the checkpoint versions activate at, the outgoing txs created
+    /// from an activation height on are encoded with its version
+    #[prost(message, repeated, tag = "34")]
+    pub checkpoint_version_activations: ::prost::alloc::vec::Vec<CheckpointVersionActivation>,
+}
+/// CheckpointVersionActivation is the height from which the outgoing txs are
+/// encoded with a checkpoint version, set by governance once the contracts and
+/// orchestrators support the version
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CheckpointVersionActivation {
+    #[prost(uint32, tag = "1")]
+    pub version: u32,
+    #[prost(uint64, tag = "2")]
+    pub height: u64,
+}
+/// MinimumContractVersion is the lowest Gravity contract version able to verify
+/// the checkpoints of a feature
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MinimumContractVersion {
+    #[prost(enumeration = "ContractFeature", tag = "1")]
+    pub feature: i32,
+    #[prost(uint64, tag = "2")]
+    pub version: u64,
+}
+/// BridgeAdmin is an account, for example a DAO contract or a group account,
+/// allowed to take the time-sensitive actions it is permitted on the bridge.
+/// Structural changes to the bridge remain with governance, which also sets the
+/// admin and its permissions. An empty address leaves the bridge without admin.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeAdmin {
+    #[prost(string, tag = "1")]
+    pub address: ::prost::alloc::string::String,
+    #[prost(enumeration = "BridgeAdminPermission", repeated, tag = "2")]
+    pub permissions: ::prost::alloc::vec::Vec<i32>,
+}
+/// VetoCouncil is an account designated by governance, typically a group
+/// account, allowed to veto outgoing batches and contract calls during the veto
+/// delay following their creation. Their signatures are only collected once the
+/// delay has passed, as a human firewall against anomalous large withdrawals. An
+/// empty address leaves outgoing txs without delay.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct VetoCouncil {
+    #[prost(string, tag = "1")]
+    pub address: ::prost::alloc::string::String,
+    /// the number of Cosmos blocks outgoing batches and contract calls wait for
+    /// signatures, less than the signed batches window
+    #[prost(uint64, tag = "2")]
+    pub veto_delay: u64,
+}
+/// PoolLimits caps the unbatched transfers waiting in the pool of each EVM
+/// chain, so that the pools can't grow past what batching keeps up with. A
+/// transfer to a pool at one of its caps is rejected, or with above_median_fee
+/// only accepted if its fee is above the median fee of the pool of its token,
+/// crowding out the cheaper transfers waiting to be batched. Zero leaves a cap
+/// unset.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PoolLimits {
+    /// the maximum number of unbatched transfers to a chain, all tokens included
+    #[prost(uint64, tag = "1")]
+    pub max_entries: u64,
+    /// the maximum number of unbatched transfers of a token to a chain
+    #[prost(uint64, tag = "2")]
+    pub max_token_entries: u64,
+    #[prost(bool, tag = "3")]
+    pub above_median_fee: bool,
+}
+/// BridgeAdminPermission is an action the bridge admin may be permitted to take
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum BridgeAdminPermission {
+    Unspecified = 0,
+    /// pausing and unpausing EVM chains
+    Pause = 1,
+    /// replacing the rate limits of EVM chains
+    RateLimits = 2,
+    /// replacing the fee floors of EVM chains
+    FeeFloors = 3,
+}
+/// ContractFeature is a feature whose checkpoints older Gravity contracts can't
+/// verify
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum ContractFeature {
+    Unspecified = 0,
+    /// batches of ERC1155 transfers
+    Erc1155Batches = 1,
+    /// arbitrary logic calls
+    ContractCalls = 2,
+}
+/// SignatureSchemeType selects how the validators sign outgoing txs and how
+/// their signatures are verified and submitted to the Gravity contracts
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum SignatureSchemeType {
+    /// a recoverable secp256k1 signature of each validator's Ethereum key,
+    /// submitted one by one
+    Ecdsa = 0,
+}
+/// GenesisState struct
+/// TODO: this need to be audited and potentially simplified using the new
+/// interfaces
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GenesisState {
+    #[prost(message, optional, tag = "1")]
+    pub params: ::core::option::Option<Params>,
+    #[prost(uint64, tag = "2")]
+    pub last_observed_event_nonce: u64,
+    #[prost(message, repeated, tag = "3")]
+    pub outgoing_txs: ::prost::alloc::vec::Vec<::prost_types::Any>,
+    #[prost(message, repeated, tag = "4")]
+    pub confirmations: ::prost::alloc::vec::Vec<::prost_types::Any>,
+    #[prost(message, repeated, tag = "9")]
+    pub ethereum_event_vote_records: ::prost::alloc::vec::Vec<EthereumEventVoteRecord>,
+    #[prost(message, repeated, tag = "10")]
+    pub delegate_keys: ::prost::alloc::vec::Vec<MsgDelegateKeys>,
+    #[prost(message, repeated, tag = "11")]
+    pub erc20_to_denoms: ::prost::alloc::vec::Vec<Erc20ToDenom>,
+    #[prost(message, repeated, tag = "12")]
+    pub unbatched_send_to_ethereum_txs: ::prost::alloc::vec::Vec<SendToEthereum>,
+    /// the state of the EVM chains added by governance, the fields above hold
+    /// the state of the default chain
+    #[prost(message, repeated, tag = "13")]
+    pub evm_chains: ::prost::alloc::vec::Vec<EvmChainGenesisState>,
+    #[prost(message, optional, tag = "14")]
+    pub bridge_contract: ::core::option::Option<BridgeContract>,
+    #[prost(message, optional, tag = "15")]
+    pub contract_migration: ::core::option::Option<ContractMigration>,
+    #[prost(bool, tag = "16")]
+    pub paused: bool,
+    #[prost(message, optional, tag = "17")]
+    pub gravity_id_rotation: ::core::option::Option<GravityIdRotation>,
+    #[prost(message, repeated, tag = "18")]
+    pub deposit_addresses: ::prost::alloc::vec::Vec<DepositAddress>,
+    #[prost(message, repeated, tag = "19")]
+    pub erc1155_tokens: ::prost::alloc::vec::Vec<Erc1155Token>,
+    #[prost(message, repeated, tag = "20")]
+    pub unbatched_send_erc1155_to_ethereum_txs: ::prost::alloc::vec::Vec<SendErc1155ToEthereum>,
+    /// the deposits forwarded over IBC whose transfers are in flight
+    #[prost(message, repeated, tag = "21")]
+    pub forwarded_deposits: ::prost::alloc::vec::Vec<ForwardedDeposit>,
+    /// the relayer incentives of all EVM chains
+    #[prost(message, repeated, tag = "22")]
+    pub relayer_incentives: ::prost::alloc::vec::Vec<RelayerIncentive>,
+    #[prost(uint64, tag = "23")]
+    pub contract_version: u64,
+    /// the incident log of manual voucher mints and burns
+    #[prost(message, repeated, tag = "24")]
+    pub incident_records: ::prost::alloc::vec::Vec<IncidentRecord>,
+    #[prost(message, optional, tag = "25")]
+    pub scheduled_params_update: ::core::option::Option<ScheduledParamsUpdate>,
+    /// the bridge reports of the last closed periods
+    #[prost(message, repeated, tag = "26")]
+    pub bridge_reports: ::prost::alloc::vec::Vec<BridgeReport>,
+    #[prost(message, optional, tag = "27")]
+    pub last_observed_ethereum_height: ::core::option::Option<LatestEthereumBlockHeight>,
+    #[prost(uint64, tag = "28")]
+    pub latest_signer_set_tx_nonce: u64,
+    #[prost(uint64, tag = "29")]
+    pub last_slashed_outgoing_tx_height: u64,
+    #[prost(message, optional, tag = "30")]
+    pub last_observed_signer_set_tx: ::core::option::Option<SignerSetTx>,
+    #[prost(message, repeated, tag = "31")]
+    pub last_event_nonces: ::prost::alloc::vec::Vec<ValidatorEventNonce>,
+    #[prost(message, repeated, tag = "32")]
+    pub ethereum_height_votes: ::prost::alloc::vec::Vec<EthereumHeightVote>,
+    #[prost(message, repeated, tag = "33")]
+    pub rate_limit_usages: ::prost::alloc::vec::Vec<TokenRateLimitUsage>,
+    /// the counters shared by all EVM chains
+    #[prost(uint64, tag = "34")]
+    pub last_send_to_ethereum_id: u64,
+    #[prost(uint64, tag = "35")]
+    pub last_outgoing_batch_nonce: u64,
+    #[prost(uint64, tag = "36")]
+    pub last_unbonding_block_height: u64,
+    #[prost(uint64, tag = "37")]
+    pub last_relayer_incentive_id: u64,
+    #[prost(uint64, tag = "38")]
+    pub last_incident_record_id: u64,
+    /// the bridge report of the period in progress
+    #[prost(message, optional, tag = "39")]
+    pub current_bridge_report: ::core::option::Option<BridgeReport>,
+    /// the state of an already deployed contract the default chain takes over,
+    /// imported in place of replaying its history, never exported as the state it
+    /// sets is
+    #[prost(message, optional, tag = "40")]
+    pub contract_bootstrap: ::core::option::Option<ContractBootstrap>,
+    #[prost(message, optional, tag = "41")]
+    pub attestation_latency: ::core::option::Option<AttestationLatency>,
+}
+/// EVMChainGenesisState is the genesis state of an additional EVM chain
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EvmChainGenesisState {
+    #[prost(message, optional, tag = "1")]
+    pub chain: ::core::option::Option<EvmChain>,
+    #[prost(uint64, tag = "2")]
+    pub last_observed_event_nonce: u64,
+    #[prost(message, repeated, tag = "3")]
+    pub outgoing_txs: ::prost::alloc::vec::Vec<::prost_types::Any>,
+    #[prost(message, repeated, tag = "4")]
+    pub confirmations: ::prost::alloc::vec::Vec<::prost_types::Any>,
+    #[prost(message, repeated, tag = "5")]
+    pub ethereum_event_vote_records: ::prost::alloc::vec::Vec<EthereumEventVoteRecord>,
+    #[prost(message, repeated, tag = "6")]
+    pub erc20_to_denoms: ::prost::alloc::vec::Vec<Erc20ToDenom>,
+    #[prost(message, repeated, tag = "7")]
+    pub unbatched_send_to_ethereum_txs: ::prost::alloc::vec::Vec<SendToEthereum>,
+    #[prost(message, optional, tag = "8")]
+    pub bridge_contract: ::core::option::Option<BridgeContract>,
+    #[prost(message, optional, tag = "9")]
+    pub contract_migration: ::core::option::Option<ContractMigration>,
+    #[prost(bool, tag = "10")]
+    pub paused: bool,
+    #[prost(message, optional, tag = "11")]
+    pub gravity_id_rotation: ::core::option::Option<GravityIdRotation>,
+    #[prost(message, repeated, tag = "12")]
+    pub deposit_addresses: ::prost::alloc::vec::Vec<DepositAddress>,
+    #[prost(message, repeated, tag = "13")]
+    pub unbatched_send_erc1155_to_ethereum_txs: ::prost::alloc::vec::Vec<SendErc1155ToEthereum>,
+    #[prost(uint64, tag = "14")]
+    pub contract_version: u64,
+    #[prost(message, optional, tag = "15")]
+    pub last_observed_ethereum_height: ::core::option::Option<LatestEthereumBlockHeight>,
+    #[prost(uint64, tag = "16")]
+    pub latest_signer_set_tx_nonce: u64,
+    #[prost(uint64, tag = "17")]
+    pub last_slashed_outgoing_tx_height: u64,
+    #[prost(message, optional, tag = "18")]
+    pub last_observed_signer_set_tx: ::core::option::Option<SignerSetTx>,
+    #[prost(message, repeated, tag = "19")]
+    pub last_event_nonces: ::prost::alloc::vec::Vec<ValidatorEventNonce>,
+    #[prost(message, repeated, tag = "20")]
+    pub ethereum_height_votes: ::prost::alloc::vec::Vec<EthereumHeightVote>,
+    #[prost(message, repeated, tag = "21")]
+    pub rate_limit_usages: ::prost::alloc::vec::Vec<TokenRateLimitUsage>,
+    #[prost(message, optional, tag = "22")]
+    pub contract_bootstrap: ::core::option::Option<ContractBootstrap>,
+    #[prost(message, optional, tag = "23")]
+    pub attestation_latency: ::core::option::Option<AttestationLatency>,
+}
+/// ValidatorEventNonce is the nonce of the last event a validator voted for
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ValidatorEventNonce {
+    #[prost(string, tag = "1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub nonce: u64,
+}
+/// EthereumHeightVote is the last Ethereum height a validator voted for
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthereumHeightVote {
+    #[prost(string, tag = "1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "2")]
+    pub height: ::core::option::Option<LatestEthereumBlockHeight>,
+}
+/// TokenRateLimitUsage is what has been transferred of a rate limited token in
+/// its current window
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TokenRateLimitUsage {
+    #[prost(string, tag = "1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "2")]
+    pub usage: ::core::option::Option<RateLimitUsage>,
+}
+/// ContractBootstrap is the state of an already deployed gravity contract read at
+/// an Ethereum height, imported so that a new chain can take the contract over
+/// without replaying its events. The signer set of the contract must be one the
+/// delegate ethereum keys of the new chain can update.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ContractBootstrap {
+    #[prost(uint64, tag = "1")]
+    pub ethereum_height: u64,
+    /// state_lastEventNonce of the contract
+    #[prost(uint64, tag = "2")]
+    pub last_event_nonce: u64,
+    /// state_lastValsetNonce of the contract
+    #[prost(uint64, tag = "3")]
+    pub last_signer_set_nonce: u64,
+    #[prost(message, repeated, tag = "4")]
+    pub tokens: ::prost::alloc::vec::Vec<ContractToken>,
+}
+/// ContractToken is the balance the contract holds of an ERC20 token and the
+/// nonce of the last batch of the token it executed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ContractToken {
+    #[prost(string, tag = "1")]
+    pub contract: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub balance: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub last_batch_nonce: u64,
+}
+/// This records the relationship between an ERC20 token and the denom
+/// of the corresponding Cosmos originated asset
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Erc20ToDenom {
+    #[prost(string, tag = "1")]
+    pub erc20: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub denom: ::prost::alloc::string::String,
+}
+/// EventSetDelegateKeys is emitted when a validator sets its delegate keys
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSetDelegateKeys {
+    #[prost(string, tag = "1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub orchestrator_address: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub ethereum_address: ::prost::alloc::string::String,
+}
+/// EventSendToEthereum is emitted when a transfer is added to the pool of a
+/// chain
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthereum {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub id: u64,
+    #[prost(string, tag = "3")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub ethereum_recipient: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "5")]
+    pub erc20_token: ::core::option::Option<Erc20Token>,
+    #[prost(message, optional, tag = "6")]
+    pub erc20_fee: ::core::option::Option<Erc20Token>,
+}
+/// EventSendToEthereumCancelled is emitted when the sender of a pooled
+/// transfer cancels it and is refunded
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendToEthereumCancelled {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub id: u64,
+    #[prost(string, tag = "3")]
+    pub sender: ::prost::alloc::string::String,
+}
+/// EventSendERC1155ToEthereum is emitted when an ERC1155 transfer is added to
+/// the pool of a chain
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSendErc1155ToEthereum {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub id: u64,
+    #[prost(string, tag = "3")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub ethereum_recipient: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "6")]
+    pub amounts: ::prost::alloc::vec::Vec<Erc1155Amount>,
+}
+/// EventOutgoingBatch is emitted when a batch is created
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingBatch {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub batch_nonce: u64,
+    #[prost(uint64, tag = "4")]
+    pub timeout: u64,
+    #[prost(uint64, repeated, tag = "5")]
+    pub send_to_ethereum_ids: ::prost::alloc::vec::Vec<u64>,
+}
+/// EventOutgoingBatchCanceled is emitted when a batch is canceled, its
+/// transfers returned to the pool
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingBatchCanceled {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub batch_nonce: u64,
+}
+/// EventOutgoingBatchExecuted is emitted when the execution of a batch is
+/// observed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingBatchExecuted {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub batch_nonce: u64,
+}
+/// EventOutgoingERC1155Batch is emitted when an ERC1155 batch is created
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingErc1155Batch {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub batch_nonce: u64,
+    #[prost(uint64, tag = "4")]
+    pub timeout: u64,
+    #[prost(uint64, repeated, tag = "5")]
+    pub send_to_ethereum_ids: ::prost::alloc::vec::Vec<u64>,
+}
+/// EventOutgoingERC1155BatchCanceled is emitted when an ERC1155 batch is
+/// canceled, its transfers returned to the pool
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingErc1155BatchCanceled {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub batch_nonce: u64,
+}
+/// EventOutgoingERC1155BatchExecuted is emitted when the execution of an
+/// ERC1155 batch is observed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingErc1155BatchExecuted {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(string, tag = "2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub batch_nonce: u64,
+}
+/// EventSignerSetTx is emitted when a signer set tx is created
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventSignerSetTx {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub nonce: u64,
+    #[prost(uint64, tag = "3")]
+    pub height: u64,
+    #[prost(message, repeated, tag = "4")]
+    pub signers: ::prost::alloc::vec::Vec<EthereumSigner>,
+}
+/// EventContractCallTx is emitted when a contract call is created
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventContractCallTx {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(bytes = "vec", tag = "2")]
+    pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag = "3")]
+    pub invalidation_nonce: u64,
+    #[prost(string, tag = "4")]
+    pub address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "5")]
+    pub timeout: u64,
+}
+/// EventContractCallTxCanceled is emitted when a timed out or vetoed contract
+/// call is canceled
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventContractCallTxCanceled {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(bytes = "vec", tag = "2")]
+    pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag = "3")]
+    pub invalidation_nonce: u64,
+}
+/// EventContractCallTxExecuted is emitted when the execution of a contract
+/// call is observed
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventContractCallTxExecuted {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(bytes = "vec", tag = "2")]
+    pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag = "3")]
+    pub invalidation_nonce: u64,
+}
+/// EventEthereumTxConfirmation is emitted when a validator's signature of an
+/// outgoing tx is accepted
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventEthereumTxConfirmation {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(bytes = "vec", tag = "2")]
+    pub store_index: ::prost::alloc::vec::Vec<u8>,
+    #[prost(string, tag = "3")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub ethereum_signer: ::prost::alloc::string::String,
+}
+/// EventEthereumEventVote is emitted when a validator votes for an event
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventEthereumEventVote {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub event_nonce: u64,
+    #[prost(bytes = "vec", tag = "3")]
+    pub event_hash: ::prost::alloc::vec::Vec<u8>,
+    #[prost(string, tag = "4")]
+    pub validator_address: ::prost::alloc::string::String,
+}
+/// EventEthereumEventObserved is emitted when an event has the votes to be
+/// observed and is applied
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventEthereumEventObserved {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub event_nonce: u64,
+    #[prost(bytes = "vec", tag = "3")]
+    pub event_hash: ::prost::alloc::vec::Vec<u8>,
     #[prost(string, tag = "4")]
-    pub bridge_ethereum_address: ::prost::alloc::string::String,
+    pub event_type: ::prost::alloc::string::String,
     #[prost(uint64, tag = "5")]
-    pub bridge_chain_id: u64,
-    #[prost(uint64, tag = "6")]
-    pub signed_signer_set_txs_window: u64,
-    #[prost(uint64, tag = "7")]
-    pub signed_batches_window: u64,
-    #[prost(uint64, tag = "8")]
-    pub ethereum_signatures_window: u64,
-    #[prost(uint64, tag = "10")]
-    pub target_eth_tx_timeout: u64,
-    #[prost(uint64, tag = "11")]
-    pub average_block_time: u64,
-    #[prost(uint64, tag = "12")]
-    pub average_ethereum_block_time: u64,
-    /// TODO: slash fraction for contract call txs too
-    #[prost(bytes = "vec", tag = "13")]
-    pub slash_fraction_signer_set_tx: ::prost::alloc::vec::Vec<u8>,
-    #[prost(bytes = "vec", tag = "14")]
-    pub slash_fraction_batch: ::prost::alloc::vec::Vec<u8>,
-    #[prost(bytes = "vec", tag = "15")]
-    pub slash_fraction_ethereum_signature: ::prost::alloc::vec::Vec<u8>,
-    #[prost(bytes = "vec", tag = "16")]
-    pub slash_fraction_conflicting_ethereum_signature: ::prost::alloc::vec::Vec<u8>,
-    #[prost(uint64, tag = "17")]
-    pub unbond_slashing_signer_set_txs_window: u64,
+    pub ethereum_height: u64,
 }
-/// GenesisState struct
-/// TODO: this need to be audited and potentially simplified using the new
-/// interfaces
+/// EventEthereumEventRejected is emitted when governance rejects the vote
+/// record of an event, skipping its nonce
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct GenesisState {
-    #[prost(message, optional, tag = "1")]
-    pub params: ::core::option::Option<Params>,
+pub struct EventEthereumEventRejected {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
     #[prost(uint64, tag = "2")]
-    pub last_observed_event_nonce: u64,
-    #[prost(message, repeated, tag = "3")]
-    pub outgoing_txs: ::prost::alloc::vec::Vec<::prost_types::Any>,
-    #[prost(message, repeated, tag = "4")]
-    pub confirmations: ::prost::alloc::vec::Vec<::prost_types::Any>,
-    #[prost(message, repeated, tag = "9")]
-    pub ethereum_event_vote_records: ::prost::alloc::vec::Vec<EthereumEventVoteRecord>,
-    #[prost(message, repeated, tag = "10")]
-    pub delegate_keys: ::prost::alloc::vec::Vec<MsgDelegateKeys>,
-    #[prost(message, repeated, tag = "11")]
-    pub erc20_to_denoms: ::prost::alloc::vec::Vec<Erc20ToDenom>,
-    #[prost(message, repeated, tag = "12")]
-    pub unbatched_send_to_ethereum_txs: ::prost::alloc::vec::Vec<SendToEthereum>,
+    pub event_nonce: u64,
+    #[prost(bytes = "vec", tag = "3")]
+    pub event_hash: ::prost::alloc::vec::Vec<u8>,
 }
-/// This records the relationship between an ERC20 token and the denom
-/// of the corresponding Cosmos originated asset
+/// EventDepositObserved is emitted when the vouchers or escrowed coins of an
+/// observed deposit are credited to its receiver
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct Erc20ToDenom {
-    #[prost(string, tag = "1")]
-    pub erc20: ::prost::alloc::string::String,
-    #[prost(string, tag = "2")]
+pub struct EventDepositObserved {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub event_nonce: u64,
+    #[prost(string, tag = "3")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub ethereum_sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub cosmos_receiver: ::prost::alloc::string::String,
+    #[prost(string, tag = "6")]
     pub denom: ::prost::alloc::string::String,
+    #[prost(string, tag = "7")]
+    pub amount: ::prost::alloc::string::String,
+}
+/// EventERC1155DepositObserved is emitted when the vouchers of an observed
+/// ERC1155 deposit are credited to its receiver
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventErc1155DepositObserved {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(uint64, tag = "2")]
+    pub event_nonce: u64,
+    #[prost(string, tag = "3")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub ethereum_sender: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub cosmos_receiver: ::prost::alloc::string::String,
+    #[prost(message, repeated, tag = "6")]
+    pub amounts: ::prost::alloc::vec::Vec<Erc1155Amount>,
+}
+/// EventBlockSummary is emitted at the end of every block with the counts of
+/// the bridge activity of the block over all EVM chains, so that monitors can
+/// follow the bridge with a single subscription
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventBlockSummary {
+    /// the sends to Ethereum added to the pools
+    #[prost(uint64, tag = "1")]
+    pub new_sends: u64,
+    /// the sends included in the batches created
+    #[prost(uint64, tag = "2")]
+    pub batched_txs: u64,
+    /// the events observed and applied
+    #[prost(uint64, tag = "3")]
+    pub observed_events: u64,
+    /// the signatures of outgoing txs accepted
+    #[prost(uint64, tag = "4")]
+    pub confirmations: u64,
+}
+/// EventOutgoingTxRelayable is emitted once the signatures of an outgoing tx
+/// pass the power threshold of the Gravity contract, with the calldata of the
+/// contract call relaying it so that relayers can submit it as is
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EventOutgoingTxRelayable {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(bytes = "vec", tag = "2")]
+    pub store_index: ::prost::alloc::vec::Vec<u8>,
+    /// the address of the Gravity contract the calldata is submitted to
+    #[prost(string, tag = "3")]
+    pub contract_address: ::prost::alloc::string::String,
+    /// the ABI encoded call of the contract, signatures included
+    #[prost(bytes = "vec", tag = "4")]
+    pub calldata: ::prost::alloc::vec::Vec<u8>,
 }
 ///  rpc Params
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -642,15 +2494,23 @@ pub struct ParamsRequest {}
 pub struct ParamsResponse {
     #[prost(message, optional, tag = "1")]
     pub params: ::core::option::Option<Params>,
+    /// the update of the params waiting for its height, if any
+    #[prost(message, optional, tag = "2")]
+    pub scheduled_update: ::core::option::Option<ScheduledParamsUpdate>,
 }
 ///  rpc SignerSetTx
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct SignerSetTxRequest {
     #[prost(uint64, tag = "1")]
     pub signer_set_nonce: u64,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct LatestSignerSetTxRequest {}
+pub struct LatestSignerSetTxRequest {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+}
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct SignerSetTxResponse {
     #[prost(message, optional, tag = "1")]
@@ -663,6 +2523,8 @@ pub struct BatchTxRequest {
     pub token_contract: ::prost::alloc::string::String,
     #[prost(uint64, tag = "2")]
     pub batch_nonce: u64,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct BatchTxResponse {
@@ -676,6 +2538,8 @@ pub struct ContractCallTxRequest {
     pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
     #[prost(uint64, tag = "2")]
     pub invalidation_nonce: u64,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct ContractCallTxResponse {
@@ -687,6 +2551,8 @@ pub struct ContractCallTxResponse {
 pub struct SignerSetTxConfirmationsRequest {
     #[prost(uint64, tag = "1")]
     pub signer_set_nonce: u64,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct SignerSetTxConfirmationsResponse {
@@ -698,42 +2564,45 @@ pub struct SignerSetTxConfirmationsResponse {
 pub struct SignerSetTxsRequest {
     #[prost(message, optional, tag = "1")]
     pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct SignerSetTxsResponse {
     #[prost(message, repeated, tag = "1")]
     pub signer_sets: ::prost::alloc::vec::Vec<SignerSetTx>,
     #[prost(message, optional, tag = "2")]
-    pub pagination:
-        ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
 }
 ///  rpc BatchTxs
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct BatchTxsRequest {
     #[prost(message, optional, tag = "1")]
     pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct BatchTxsResponse {
     #[prost(message, repeated, tag = "1")]
     pub batches: ::prost::alloc::vec::Vec<BatchTx>,
     #[prost(message, optional, tag = "2")]
-    pub pagination:
-        ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
 }
 ///  rpc ContractCallTxs
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct ContractCallTxsRequest {
     #[prost(message, optional, tag = "1")]
     pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct ContractCallTxsResponse {
     #[prost(message, repeated, tag = "1")]
     pub calls: ::prost::alloc::vec::Vec<ContractCallTx>,
     #[prost(message, optional, tag = "2")]
-    pub pagination:
-        ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
 }
 // NOTE(levi) pending queries: this is my address; what do I need to sign??
 // why orchestrator key? hot, signing thing all the time so validator key can be
@@ -746,6 +2615,8 @@ pub struct UnsignedSignerSetTxsRequest {
     /// orchestrator address or the corresponding validator address
     #[prost(string, tag = "1")]
     pub address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct UnsignedSignerSetTxsResponse {
@@ -758,6 +2629,8 @@ pub struct UnsignedBatchTxsRequest {
     /// orchestrator address or the corresponding validator address
     #[prost(string, tag = "1")]
     pub address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct UnsignedBatchTxsResponse {
@@ -770,6 +2643,8 @@ pub struct UnsignedBatchTxsResponse {
 pub struct UnsignedContractCallTxsRequest {
     #[prost(string, tag = "1")]
     pub address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct UnsignedContractCallTxsResponse {
@@ -777,7 +2652,10 @@ pub struct UnsignedContractCallTxsResponse {
     pub calls: ::prost::alloc::vec::Vec<ContractCallTx>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct BatchTxFeesRequest {}
+pub struct BatchTxFeesRequest {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+}
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct BatchTxFeesResponse {
     #[prost(message, repeated, tag = "1")]
@@ -789,6 +2667,8 @@ pub struct ContractCallTxConfirmationsRequest {
     pub invalidation_scope: ::prost::alloc::vec::Vec<u8>,
     #[prost(uint64, tag = "2")]
     pub invalidation_nonce: u64,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct ContractCallTxConfirmationsResponse {
@@ -801,6 +2681,8 @@ pub struct BatchTxConfirmationsRequest {
     pub batch_nonce: u64,
     #[prost(string, tag = "2")]
     pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct BatchTxConfirmationsResponse {
@@ -811,6 +2693,8 @@ pub struct BatchTxConfirmationsResponse {
 pub struct LastSubmittedEthereumEventRequest {
     #[prost(string, tag = "1")]
     pub address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct LastSubmittedEthereumEventResponse {
@@ -821,6 +2705,8 @@ pub struct LastSubmittedEthereumEventResponse {
 pub struct Erc20ToDenomRequest {
     #[prost(string, tag = "1")]
     pub erc20: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Erc20ToDenomResponse {
@@ -828,11 +2714,18 @@ pub struct Erc20ToDenomResponse {
     pub denom: ::prost::alloc::string::String,
     #[prost(bool, tag = "2")]
     pub cosmos_originated: bool,
+    /// the ICS-20 path and base denom on its origin chain of an IBC voucher denom
+    #[prost(string, tag = "3")]
+    pub ibc_path: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub ibc_base_denom: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct DenomToErc20ParamsRequest {
     #[prost(string, tag = "1")]
     pub denom: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct DenomToErc20ParamsResponse {
@@ -844,97 +2737,347 @@ pub struct DenomToErc20ParamsResponse {
     pub erc20_symbol: ::prost::alloc::string::String,
     #[prost(uint64, tag = "4")]
     pub erc20_decimals: u64,
+    /// the ICS-20 path and base denom on its origin chain of an IBC voucher denom,
+    /// the ERC20 of which is named after them
+    #[prost(string, tag = "5")]
+    pub ibc_path: ::prost::alloc::string::String,
+    #[prost(string, tag = "6")]
+    pub ibc_base_denom: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DenomToErc20Request {
+    #[prost(string, tag = "1")]
+    pub denom: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DenomToErc20Response {
+    #[prost(string, tag = "1")]
+    pub erc20: ::prost::alloc::string::String,
+    #[prost(bool, tag = "2")]
+    pub cosmos_originated: bool,
+    /// the ICS-20 path and base denom on its origin chain of an IBC voucher denom
+    #[prost(string, tag = "3")]
+    pub ibc_path: ::prost::alloc::string::String,
+    #[prost(string, tag = "4")]
+    pub ibc_base_denom: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysByValidatorRequest {
+    #[prost(string, tag = "1")]
+    pub validator_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysByValidatorResponse {
+    #[prost(string, tag = "1")]
+    pub eth_address: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub orchestrator_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysByEthereumSignerRequest {
+    #[prost(string, tag = "1")]
+    pub ethereum_signer: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysByEthereumSignerResponse {
+    #[prost(string, tag = "1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub orchestrator_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysByOrchestratorRequest {
+    #[prost(string, tag = "1")]
+    pub orchestrator_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysByOrchestratorResponse {
+    #[prost(string, tag = "1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub ethereum_signer: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysRequest {}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub delegate_keys: ::prost::alloc::vec::Vec<MsgDelegateKeys>,
+}
+/// NOTE: if there is no sender address, return all
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchedSendToEthereumsRequest {
+    /// todo: figure out how to paginate given n Batches with m Send To Ethereums
+    ///  cosmos.base.query.v1beta1.PageRequest pagination = 2;
+    #[prost(string, tag = "1")]
+    pub sender_address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchedSendToEthereumsResponse {
+    ///  cosmos.base.query.v1beta1.PageResponse pagination = 2;
+    #[prost(message, repeated, tag = "1")]
+    pub send_to_ethereums: ::prost::alloc::vec::Vec<SendToEthereum>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UnbatchedSendToEthereumsRequest {
+    #[prost(string, tag = "1")]
+    pub sender_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct UnbatchedSendToEthereumsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub send_to_ethereums: ::prost::alloc::vec::Vec<SendToEthereum>,
+    #[prost(message, optional, tag = "2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct LastObservedEthereumHeightRequest {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct LastObservedEthereumHeightResponse {
+    #[prost(message, optional, tag = "1")]
+    pub last_observed_ethereum_height: ::core::option::Option<LatestEthereumBlockHeight>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeContractRequest {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeContractResponse {
+    #[prost(message, optional, tag = "1")]
+    pub bridge_contract: ::core::option::Option<BridgeContract>,
+    /// set while the chain is waiting to cut over to a new contract
+    #[prost(message, optional, tag = "2")]
+    pub pending_migration: ::core::option::Option<ContractMigration>,
+    /// the version of the contract attested by its ContractVersionEvent, zero
+    /// until one is observed
+    #[prost(uint64, tag = "3")]
+    pub contract_version: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DepositAddressRequest {
+    #[prost(string, tag = "1")]
+    pub recipient: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DepositAddressResponse {
+    /// the address the recipient registered, empty if it has none
+    #[prost(string, tag = "1")]
+    pub deposit_address: ::prost::alloc::string::String,
+    /// the address the chain's factory derives for the recipient, a request
+    /// registers it
+    #[prost(string, tag = "2")]
+    pub derived_deposit_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EvmChainsRequest {}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EvmChainsResponse {
+    /// the default chain first followed by the ones added by governance
+    #[prost(message, repeated, tag = "1")]
+    pub chains: ::prost::alloc::vec::Vec<EvmChainStatus>,
+}
+/// EVMChainStatus describes an EVM chain along with the progress of its bridge
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EvmChainStatus {
+    #[prost(message, optional, tag = "1")]
+    pub chain: ::core::option::Option<EvmChain>,
+    #[prost(uint64, tag = "2")]
+    pub last_observed_ethereum_height: u64,
+    #[prost(uint64, tag = "3")]
+    pub last_observed_event_nonce: u64,
+    /// paused by governance
+    #[prost(bool, tag = "4")]
+    pub paused: bool,
+    /// waiting to cut over to a new contract, no new outgoing txs are created
+    /// in the meantime
+    #[prost(bool, tag = "5")]
+    pub migrating: bool,
+    /// set while confirmations for the chain's previous gravity id are accepted
+    #[prost(message, optional, tag = "6")]
+    pub gravity_id_rotation: ::core::option::Option<GravityIdRotation>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Erc1155BatchTxsRequest {
+    #[prost(message, optional, tag = "1")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Erc1155BatchTxsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub batches: ::prost::alloc::vec::Vec<Erc1155BatchTx>,
+    #[prost(message, optional, tag = "2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DenomToErc20Request {
-    #[prost(string, tag = "1")]
-    pub denom: ::prost::alloc::string::String,
+pub struct Erc1155BatchTxConfirmationsRequest {
+    #[prost(uint64, tag = "1")]
+    pub batch_nonce: u64,
+    #[prost(string, tag = "2")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "3")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DenomToErc20Response {
-    #[prost(string, tag = "1")]
-    pub erc20: ::prost::alloc::string::String,
-    #[prost(bool, tag = "2")]
-    pub cosmos_originated: bool,
+pub struct Erc1155BatchTxConfirmationsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub signatures: ::prost::alloc::vec::Vec<Erc1155BatchTxConfirmation>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DelegateKeysByValidatorRequest {
+pub struct UnsignedErc1155BatchTxsRequest {
+    /// either the orchestrator address or the corresponding validator address
     #[prost(string, tag = "1")]
-    pub validator_address: ::prost::alloc::string::String,
+    pub address: ::prost::alloc::string::String,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DelegateKeysByValidatorResponse {
-    #[prost(string, tag = "1")]
-    pub eth_address: ::prost::alloc::string::String,
-    #[prost(string, tag = "2")]
-    pub orchestrator_address: ::prost::alloc::string::String,
+pub struct UnsignedErc1155BatchTxsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub batches: ::prost::alloc::vec::Vec<Erc1155BatchTx>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DelegateKeysByEthereumSignerRequest {
+pub struct Erc1155TokenRequest {
     #[prost(string, tag = "1")]
-    pub ethereum_signer: ::prost::alloc::string::String,
+    pub denom: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DelegateKeysByEthereumSignerResponse {
-    #[prost(string, tag = "1")]
-    pub validator_address: ::prost::alloc::string::String,
-    #[prost(string, tag = "2")]
-    pub orchestrator_address: ::prost::alloc::string::String,
+pub struct Erc1155TokenResponse {
+    #[prost(message, optional, tag = "1")]
+    pub token: ::core::option::Option<Erc1155Token>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DelegateKeysByOrchestratorRequest {
-    #[prost(string, tag = "1")]
-    pub orchestrator_address: ::prost::alloc::string::String,
+pub struct RelayerIncentivesRequest {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DelegateKeysByOrchestratorResponse {
-    #[prost(string, tag = "1")]
-    pub validator_address: ::prost::alloc::string::String,
-    #[prost(string, tag = "2")]
-    pub ethereum_signer: ::prost::alloc::string::String,
+pub struct RelayerIncentivesResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub incentives: ::prost::alloc::vec::Vec<RelayerIncentive>,
+    /// the balance of the relayer reward account, shared by the incentives of all
+    /// chains
+    #[prost(message, repeated, tag = "2")]
+    pub reward_account_balance: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DelegateKeysRequest {}
+pub struct SimulateParamsChangeRequest {
+    #[prost(message, optional, tag = "1")]
+    pub params: ::core::option::Option<Params>,
+}
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct DelegateKeysResponse {
+pub struct SimulateParamsChangeResponse {
+    /// the pending batches of the default chain whose checkpoints no longer match
+    /// the contract, because the gravity id or the bridge contract changes
     #[prost(message, repeated, tag = "1")]
-    pub delegate_keys: ::prost::alloc::vec::Vec<MsgDelegateKeys>,
+    pub invalidated_batches: ::prost::alloc::vec::Vec<BatchTx>,
+    #[prost(message, repeated, tag = "2")]
+    pub invalidated_erc1155_batches: ::prost::alloc::vec::Vec<Erc1155BatchTx>,
+    /// the bonded validators that would be slashed at the next block for missing
+    /// signatures of outgoing txs older than the signed batches window
+    #[prost(string, repeated, tag = "3")]
+    pub slashable_validators: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
 }
-/// NOTE: if there is no sender address, return all
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct BatchedSendToEthereumsRequest {
-    /// todo: figure out how to paginate given n Batches with m Send To Ethereums
-    ///  cosmos.base.query.v1beta1.PageRequest pagination = 2;
-    #[prost(string, tag = "1")]
-    pub sender_address: ::prost::alloc::string::String,
+pub struct IncidentRecordsRequest {}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct IncidentRecordsResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub records: ::prost::alloc::vec::Vec<IncidentRecord>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct BatchedSendToEthereumsResponse {
-    ///  cosmos.base.query.v1beta1.PageResponse pagination = 2;
+pub struct BridgeReportsRequest {}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeReportsResponse {
+    /// the reports of the last closed periods, oldest first
     #[prost(message, repeated, tag = "1")]
-    pub send_to_ethereums: ::prost::alloc::vec::Vec<SendToEthereum>,
+    pub reports: ::prost::alloc::vec::Vec<BridgeReport>,
+    /// the report of the period in progress
+    #[prost(message, optional, tag = "2")]
+    pub current: ::core::option::Option<BridgeReport>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct UnbatchedSendToEthereumsRequest {
-    #[prost(string, tag = "1")]
-    pub sender_address: ::prost::alloc::string::String,
+pub struct BridgeStateHashRequest {}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BridgeStateHashResponse {
+    #[prost(message, optional, tag = "1")]
+    pub hash: ::core::option::Option<BridgeStateHash>,
     #[prost(message, optional, tag = "2")]
+    pub state: ::core::option::Option<BridgeState>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TransferHistoryRequest {
+    #[prost(message, optional, tag = "1")]
     pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+    #[prost(uint64, tag = "2")]
+    pub evm_chain_id: u64,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct UnbatchedSendToEthereumsResponse {
+pub struct TransferHistoryResponse {
     #[prost(message, repeated, tag = "1")]
-    pub send_to_ethereums: ::prost::alloc::vec::Vec<SendToEthereum>,
+    pub records: ::prost::alloc::vec::Vec<TransferRecord>,
     #[prost(message, optional, tag = "2")]
-    pub pagination:
-        ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct LastObservedEthereumHeightRequest {}
+pub struct AttestationLatencyRequest {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+}
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct LastObservedEthereumHeightResponse {
+pub struct AttestationLatencyResponse {
     #[prost(message, optional, tag = "1")]
-    pub last_observed_ethereum_height: ::core::option::Option<LatestEthereumBlockHeight>,
+    pub latency: ::core::option::Option<AttestationLatency>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RelayCalldataRequest {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(bytes = "vec", tag = "2")]
+    pub store_index: ::prost::alloc::vec::Vec<u8>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RelayCalldataResponse {
+    /// the address of the Gravity contract the calldata is submitted to
+    #[prost(string, tag = "1")]
+    pub contract_address: ::prost::alloc::string::String,
+    #[prost(bytes = "vec", tag = "2")]
+    pub calldata: ::prost::alloc::vec::Vec<u8>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TypedDataRequest {
+    #[prost(uint64, tag = "1")]
+    pub evm_chain_id: u64,
+    #[prost(bytes = "vec", tag = "2")]
+    pub store_index: ::prost::alloc::vec::Vec<u8>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TypedDataResponse {
+    /// the typed data as the JSON argument of eth_signTypedData_v4
+    #[prost(string, tag = "1")]
+    pub typed_data: ::prost::alloc::string::String,
+    /// the digest signed
+    #[prost(bytes = "vec", tag = "2")]
+    pub digest: ::prost::alloc::vec::Vec<u8>,
+    /// whether the chain accepts confirmations signing the typed data, that is
+    /// its attested contract version verifies them
+    #[prost(bool, tag = "3")]
+    pub accepted: bool,
 }
 #[doc = r" Generated client implementations."]
 pub mod query_client {
@@ -1182,6 +3325,63 @@ pub mod query_client {
                 http::uri::PathAndQuery::from_static("/gravity.v1.Query/UnsignedContractCallTxs");
             self.inner.unary(request.into_request(), path, codec).await
         }
+        #[doc = " ERC1155 batches, the signatures on them and the tokens behind vouchers"]
+        pub async fn erc1155_batch_txs(
+            &mut self,
+            request: impl tonic::IntoRequest<super::Erc1155BatchTxsRequest>,
+        ) -> Result<tonic::Response<super::Erc1155BatchTxsResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/ERC1155BatchTxs");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn erc1155_batch_tx_confirmations(
+            &mut self,
+            request: impl tonic::IntoRequest<super::Erc1155BatchTxConfirmationsRequest>,
+        ) -> Result<tonic::Response<super::Erc1155BatchTxConfirmationsResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/ERC1155BatchTxConfirmations");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn unsigned_erc1155_batch_txs(
+            &mut self,
+            request: impl tonic::IntoRequest<super::UnsignedErc1155BatchTxsRequest>,
+        ) -> Result<tonic::Response<super::UnsignedErc1155BatchTxsResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/UnsignedERC1155BatchTxs");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn erc1155_token(
+            &mut self,
+            request: impl tonic::IntoRequest<super::Erc1155TokenRequest>,
+        ) -> Result<tonic::Response<super::Erc1155TokenResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/ERC1155Token");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
         pub async fn last_submitted_ethereum_event(
             &mut self,
             request: impl tonic::IntoRequest<super::LastSubmittedEthereumEventRequest>,
@@ -1376,6 +3576,181 @@ pub mod query_client {
             );
             self.inner.unary(request.into_request(), path, codec).await
         }
+        pub async fn bridge_contract(
+            &mut self,
+            request: impl tonic::IntoRequest<super::BridgeContractRequest>,
+        ) -> Result<tonic::Response<super::BridgeContractResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/BridgeContract");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn evm_chains(
+            &mut self,
+            request: impl tonic::IntoRequest<super::EvmChainsRequest>,
+        ) -> Result<tonic::Response<super::EvmChainsResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/EVMChains");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn deposit_address(
+            &mut self,
+            request: impl tonic::IntoRequest<super::DepositAddressRequest>,
+        ) -> Result<tonic::Response<super::DepositAddressResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/DepositAddress");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn relayer_incentives(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RelayerIncentivesRequest>,
+        ) -> Result<tonic::Response<super::RelayerIncentivesResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/RelayerIncentives");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn simulate_params_change(
+            &mut self,
+            request: impl tonic::IntoRequest<super::SimulateParamsChangeRequest>,
+        ) -> Result<tonic::Response<super::SimulateParamsChangeResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/SimulateParamsChange");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn incident_records(
+            &mut self,
+            request: impl tonic::IntoRequest<super::IncidentRecordsRequest>,
+        ) -> Result<tonic::Response<super::IncidentRecordsResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/IncidentRecords");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn bridge_reports(
+            &mut self,
+            request: impl tonic::IntoRequest<super::BridgeReportsRequest>,
+        ) -> Result<tonic::Response<super::BridgeReportsResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/BridgeReports");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn bridge_state_hash(
+            &mut self,
+            request: impl tonic::IntoRequest<super::BridgeStateHashRequest>,
+        ) -> Result<tonic::Response<super::BridgeStateHashResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/BridgeStateHash");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        pub async fn transfer_history(
+            &mut self,
+            request: impl tonic::IntoRequest<super::TransferHistoryRequest>,
+        ) -> Result<tonic::Response<super::TransferHistoryResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/TransferHistory");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        #[doc = " AttestationLatency returns the blocks the last observed events of the"]
+        #[doc = " chain took to be observed from their first vote, with their percentiles"]
+        pub async fn attestation_latency(
+            &mut self,
+            request: impl tonic::IntoRequest<super::AttestationLatencyRequest>,
+        ) -> Result<tonic::Response<super::AttestationLatencyResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/AttestationLatency");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        #[doc = " RelayCalldata returns the ABI encoded call of the Gravity contract"]
+        #[doc = " relaying the outgoing tx at the store index, once its signatures pass the"]
+        #[doc = " power threshold of the contract"]
+        pub async fn relay_calldata(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RelayCalldataRequest>,
+        ) -> Result<tonic::Response<super::RelayCalldataResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/RelayCalldata");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
+        #[doc = " TypedData returns the EIP-712 typed data of the outgoing tx at the store"]
+        #[doc = " index under the current gravity id, for signers to review what they sign"]
+        pub async fn typed_data(
+            &mut self,
+            request: impl tonic::IntoRequest<super::TypedDataRequest>,
+        ) -> Result<tonic::Response<super::TypedDataResponse>, tonic::Status> {
+            self.inner.ready().await.map_err(|e| {
+                tonic::Status::new(
+                    tonic::Code::Unknown,
+                    format!("Service was not ready: {}", e.into()),
+                )
+            })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static("/gravity.v1.Query/TypedData");
+            self.inner.unary(request.into_request(), path, codec).await
+        }
     }
     impl<T: Clone> Clone for QueryClient<T> {
         fn clone(&self) -> Self {
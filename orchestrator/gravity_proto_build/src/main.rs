@@ -49,14 +49,24 @@ fn compile_protos(out_dir: &Path, tmp_dir: &Path) {
     gravity_proto_dir.push("module/proto/gravity/v1");
     let mut gravity_proto_include_dir = root.clone();
     gravity_proto_include_dir.push("module/proto");
-    let mut third_party_proto_include_dir = root;
+    let mut third_party_proto_include_dir = root.clone();
     third_party_proto_include_dir.push("module/third_party/proto");
+    // the remote signer service is implemented outside of the chain, so its proto lives
+    // with the orchestrator
+    let mut signer_proto_dir = root.clone();
+    signer_proto_dir.push("orchestrator/proto/gravity/signer/v1");
+    let mut signer_proto_include_dir = root;
+    signer_proto_include_dir.push("orchestrator/proto");
 
     // Paths
-    let proto_paths = [gravity_proto_dir];
+    let proto_paths = [gravity_proto_dir, signer_proto_dir];
     // we need to have an include which is just the folder of our protos to satisfy protoc
     // which insists that any passed file be included in a directory passed as an include
-    let proto_include_paths = [gravity_proto_include_dir, third_party_proto_include_dir];
+    let proto_include_paths = [
+        gravity_proto_include_dir,
+        third_party_proto_include_dir,
+        signer_proto_include_dir,
+    ];
 
     // List available proto files
     let mut protos: Vec<PathBuf> = vec![];
@@ -3,6 +3,7 @@
 //! by trying more than one thing to handle potentially misconfigured inputs.
 
 use crate::ethereum::format_eth_address;
+use crate::signer::EthSigner;
 use deep_space::client::ChainStatus;
 use deep_space::Address as CosmosAddress;
 use deep_space::Contact;
@@ -16,10 +17,32 @@ use std::convert::TryFrom;
 use std::process::exit;
 use std::sync::Arc;
 use std::time::Duration;
+use tokio::sync::watch;
 use tokio::time::sleep as delay_for;
 use tonic::transport::Channel;
 use url::Url;
 
+/// The RPC connections of the orchestrator's loops. A loop given an endpoints channel
+/// switches to the endpoints sent on it at the start of its next iteration, which lets a
+/// configuration reload change the RPC endpoints without restarting the loop.
+#[derive(Clone)]
+pub struct Endpoints {
+    pub contact: Contact,
+    pub eth_client: Arc<SignerMiddleware<Provider<Http>, EthSigner>>,
+    pub grpc: GravityQueryClient<Channel>,
+}
+
+/// Returns the endpoints sent on the channel since it was last checked, if any
+pub fn updated_endpoints(updates: &mut Option<watch::Receiver<Endpoints>>) -> Option<Endpoints> {
+    let updates = updates.as_mut()?;
+    if !updates.has_changed().unwrap_or(false) {
+        return None;
+    }
+    let endpoints = updates.borrow_and_update().clone();
+    info!("Switching to reloaded RPC endpoints");
+    Some(endpoints)
+}
+
 pub struct Connections {
     pub eth_provider: Option<Provider<Http>>,
     pub grpc: Option<GravityQueryClient<Channel>>,
@@ -352,11 +375,10 @@ pub async fn check_for_fee_denom(fee_denom: &str, address: CosmosAddress, contac
     }
 }
 
-// TODO(bolten): is using LocalWallet too specific?
 /// Checks the user has some Ethereum in their address to pay for things
 pub async fn check_for_eth(
     address: EthAddress,
-    eth_client: Arc<SignerMiddleware<Provider<Http>, LocalWallet>>,
+    eth_client: Arc<SignerMiddleware<Provider<Http>, EthSigner>>,
 ) {
     let balance = eth_client.get_balance(address, None).await.unwrap();
     if balance == 0u8.into() {
@@ -1,5 +1,6 @@
 //! for things that don't belong in the cosmos or ethereum libraries but also don't belong
 //! in a function specific library
+use crate::signer::{EthSigner, EthSignerError};
 use clarity::Error as ClarityError;
 use deep_space::error::AddressError as CosmosAddressError;
 use deep_space::error::CosmosGrpcError;
@@ -29,17 +30,19 @@ pub enum GravityError {
     CosmosGrpcError(CosmosGrpcError),
     CosmosAddressError(CosmosAddressError),
     CosmosPrivateKeyError(CosmosPrivateKeyError),
+    CosmosSignerError(String),
     EthereumBadDataError(String),
-    EthereumRestError(SignerMiddlewareError<Provider<Http>, LocalWallet>),
+    EthereumRestError(SignerMiddlewareError<Provider<Http>, EthSigner>),
     EthersAbiError(EthersAbiError),
     EthersContractAbiError(EthersContractAbiError),
-    EthersContractError(ContractError<SignerMiddleware<Provider<Http>, LocalWallet>>),
+    EthersContractError(ContractError<SignerMiddleware<Provider<Http>, EthSigner>>),
     EthersGasOracleError(EthersGasOracleError),
     EthersParseAddressError(EthersParseAddressError),
     EthersParseUintError(EthersParseUintError),
     EthersProviderError(EthersProviderError),
     EthersSignatureError(EthersSignatureError),
     EthersWalletError(EthersWalletError),
+    EthSignerError(EthSignerError),
     EtherscanError(EtherscanError),
     GravityContractError(String),
     InvalidArgumentError(String),
@@ -56,6 +59,7 @@ pub enum GravityError {
     ParseIntError(ParseIntError),
     FromUtf8Error(FromUtf8Error),
     OverflowError(String),
+    PriceProviderError(String),
 }
 
 impl fmt::Display for GravityError {
@@ -67,6 +71,7 @@ impl fmt::Display for GravityError {
             GravityError::CosmosPrivateKeyError(val) => {
                 write!(f, "Cosmos private key error:  {}", val)
             }
+            GravityError::CosmosSignerError(val) => write!(f, "Cosmos signer error: {}", val),
             GravityError::EthereumBadDataError(val) => {
                 write!(f, "Received unexpected data from Ethereum: {}", val)
             }
@@ -88,6 +93,7 @@ impl fmt::Display for GravityError {
             GravityError::EthersProviderError(val) => write!(f, "Ethers provider error: {}", val),
             GravityError::EthersSignatureError(val) => write!(f, "Ethers signature error: {}", val),
             GravityError::EthersWalletError(val) => write!(f, "Ethers wallet error: {}", val),
+            GravityError::EthSignerError(val) => write!(f, "Ethereum signer error: {}", val),
             GravityError::EtherscanError(val) => write!(f, "Etherscan error: {}", val),
             GravityError::GravityContractError(val) => write!(f, "Gravity contract error: {}", val),
             GravityError::InvalidArgumentError(val) => write!(f, "Invalid argument error: {}", val),
@@ -113,6 +119,7 @@ impl fmt::Display for GravityError {
                 write!(f, "Failed to parse bytes to UTF-8: {}", val)
             }
             GravityError::OverflowError(val) => write!(f, "Overflow error: {}", val),
+            GravityError::PriceProviderError(val) => write!(f, "Price provider error: {}", val),
         }
     }
 }
@@ -149,8 +156,8 @@ impl From<ClarityError> for GravityError {
     }
 }
 
-impl From<SignerMiddlewareError<Provider<Http>, LocalWallet>> for GravityError {
-    fn from(error: SignerMiddlewareError<Provider<Http>, LocalWallet>) -> Self {
+impl From<SignerMiddlewareError<Provider<Http>, EthSigner>> for GravityError {
+    fn from(error: SignerMiddlewareError<Provider<Http>, EthSigner>) -> Self {
         GravityError::EthereumRestError(error)
     }
 }
@@ -167,8 +174,8 @@ impl From<EthersContractAbiError> for GravityError {
     }
 }
 
-impl From<ContractError<SignerMiddleware<Provider<Http>, LocalWallet>>> for GravityError {
-    fn from(error: ContractError<SignerMiddleware<Provider<Http>, LocalWallet>>) -> Self {
+impl From<ContractError<SignerMiddleware<Provider<Http>, EthSigner>>> for GravityError {
+    fn from(error: ContractError<SignerMiddleware<Provider<Http>, EthSigner>>) -> Self {
         GravityError::EthersContractError(error)
     }
 }
@@ -209,6 +216,12 @@ impl From<EthersWalletError> for GravityError {
     }
 }
 
+impl From<EthSignerError> for GravityError {
+    fn from(error: EthSignerError) -> Self {
+        GravityError::EthSignerError(error)
+    }
+}
+
 impl From<EtherscanError> for GravityError {
     fn from(error: EtherscanError) -> Self {
         GravityError::EtherscanError(error)
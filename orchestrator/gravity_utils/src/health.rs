@@ -0,0 +1,119 @@
+//! Health of the orchestrator loops, served by the orchestrator on /healthz. Each loop records
+//! the time of its last successful iteration and the loops that talk to both chains record
+//! whether they could reach them, so that a stuck or disconnected orchestrator can be detected
+//! by liveness probes and uptime checks rather than only by reading its logs.
+
+use lazy_static::lazy_static;
+use std::collections::BTreeMap;
+use std::sync::Mutex;
+use std::time::{Duration, SystemTime, UNIX_EPOCH};
+
+pub const ORACLE_LOOP: &str = "eth_oracle";
+pub const SIGNER_LOOP: &str = "eth_signer";
+pub const RELAYER_LOOP: &str = "relayer";
+
+lazy_static! {
+    static ref HEALTH: Mutex<HealthState> = Mutex::new(HealthState::default());
+}
+
+#[derive(Default)]
+struct HealthState {
+    // loop name to the time it was registered and the time of its last success
+    loops: BTreeMap<&'static str, (SystemTime, Option<SystemTime>)>,
+    ethereum_connected: bool,
+    cosmos_connected: bool,
+    signing_backlog: SigningBacklog,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize)]
+pub struct SigningBacklog {
+    pub valsets: usize,
+    pub batches: usize,
+    pub logic_calls: usize,
+}
+
+#[derive(Clone, Debug, PartialEq, Serialize)]
+pub struct LoopHealth {
+    /// unix time of the last successful iteration, None if there hasn't been one
+    pub last_success: Option<u64>,
+    pub healthy: bool,
+}
+
+#[derive(Clone, Debug, PartialEq, Serialize)]
+pub struct HealthReport {
+    pub healthy: bool,
+    pub ethereum_connected: bool,
+    pub cosmos_connected: bool,
+    pub loops: BTreeMap<String, LoopHealth>,
+    pub signing_backlog: SigningBacklog,
+}
+
+/// Registers a loop so that it is reported on, and considered unhealthy should it not
+/// succeed in time, from now on
+pub fn register_loop(name: &'static str) {
+    let mut health = HEALTH.lock().unwrap();
+    health.loops.insert(name, (SystemTime::now(), None));
+}
+
+pub fn record_loop_success(name: &'static str) {
+    let mut health = HEALTH.lock().unwrap();
+    let now = SystemTime::now();
+    let entry = health.loops.entry(name).or_insert((now, None));
+    entry.1 = Some(now);
+}
+
+pub fn set_connection_status(ethereum_connected: bool, cosmos_connected: bool) {
+    let mut health = HEALTH.lock().unwrap();
+    health.ethereum_connected = ethereum_connected;
+    health.cosmos_connected = cosmos_connected;
+}
+
+pub fn set_unsigned_valsets(v: usize) {
+    HEALTH.lock().unwrap().signing_backlog.valsets = v;
+}
+
+pub fn set_unsigned_batches(v: usize) {
+    HEALTH.lock().unwrap().signing_backlog.batches = v;
+}
+
+pub fn set_unsigned_logic_calls(v: usize) {
+    HEALTH.lock().unwrap().signing_backlog.logic_calls = v;
+}
+
+/// Reports the current health, loops that haven't succeeded within max_loop_age are
+/// unhealthy and the orchestrator is only healthy if all loops are and both chains
+/// are reachable
+pub fn health_report(max_loop_age: Duration) -> HealthReport {
+    let health = HEALTH.lock().unwrap();
+    let now = SystemTime::now();
+
+    let loops: BTreeMap<String, LoopHealth> = health
+        .loops
+        .iter()
+        .map(|(name, (registered, last_success))| {
+            let since = last_success.unwrap_or(*registered);
+            let age = now.duration_since(since).unwrap_or_default();
+            let loop_health = LoopHealth {
+                last_success: last_success.map(unix_time),
+                healthy: age <= max_loop_age,
+            };
+            (name.to_string(), loop_health)
+        })
+        .collect();
+
+    HealthReport {
+        healthy: health.ethereum_connected
+            && health.cosmos_connected
+            && loops.values().all(|l| l.healthy),
+        ethereum_connected: health.ethereum_connected,
+        cosmos_connected: health.cosmos_connected,
+        loops,
+        signing_backlog: health.signing_backlog.clone(),
+    }
+}
+
+fn unix_time(time: SystemTime) -> u64 {
+    time.duration_since(UNIX_EPOCH)
+        .unwrap_or_default()
+        .as_secs()
+}
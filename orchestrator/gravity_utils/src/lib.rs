@@ -8,5 +8,9 @@ extern crate log;
 pub mod connection_prep;
 pub mod error;
 pub mod ethereum;
+pub mod health;
+pub mod logging;
 pub mod message_signatures;
+pub mod metrics;
+pub mod signer;
 pub mod types;
@@ -0,0 +1,160 @@
+//! Log output for the orchestrator binaries. By default logs are human readable text, setting
+//! GRAVITY_LOG_FORMAT=json instead writes one JSON object per line for log aggregators. Log
+//! messages carry correlation fields as key=value pairs (event_nonce=12, batch_nonce=3, ...),
+//! in json format these are also lifted into fields of their own so that a single deposit,
+//! withdrawal batch or logic call can be traced through the oracle, signer and relayer loops.
+//! The log level is configured with RUST_LOG in the usual env_logger syntax, in json format it
+//! can also be changed at runtime with set_log_filter.
+
+use env_logger::filter::{Builder as FilterBuilder, Filter};
+use env_logger::Env;
+use lazy_static::lazy_static;
+use log::{Log, Metadata, Record};
+use serde_json::{Map, Value};
+use std::io::Write;
+use std::sync::RwLock;
+use std::time::{SystemTime, UNIX_EPOCH};
+
+/// Environment variable selecting the log format, either text or json
+pub const LOG_FORMAT_ENV: &str = "GRAVITY_LOG_FORMAT";
+
+/// Correlation fields lifted out of log messages in json format
+pub const CORRELATION_KEYS: &[&str] = &[
+    "event_nonce",
+    "valset_nonce",
+    "batch_nonce",
+    "token_contract",
+    "invalidation_id",
+    "invalidation_nonce",
+    "checkpoint",
+    "tx_hash",
+];
+
+lazy_static! {
+    // the filter of the json logger, None until it is installed
+    static ref JSON_FILTER: RwLock<Option<Filter>> = RwLock::new(None);
+}
+
+#[derive(Debug, Clone, Copy, PartialEq)]
+pub enum LogFormat {
+    Text,
+    Json,
+}
+
+impl LogFormat {
+    /// The log format selected by GRAVITY_LOG_FORMAT, defaulting to text
+    pub fn from_env() -> Self {
+        match std::env::var(LOG_FORMAT_ENV) {
+            Ok(format) if format.eq_ignore_ascii_case("json") => LogFormat::Json,
+            _ => LogFormat::Text,
+        }
+    }
+}
+
+/// Installs the global logger in the format selected by GRAVITY_LOG_FORMAT, filtering
+/// with RUST_LOG or default_filter if it is not set
+pub fn init_logger(default_filter: &str) {
+    match LogFormat::from_env() {
+        LogFormat::Text => {
+            env_logger::Builder::from_env(Env::default().default_filter_or(default_filter)).init()
+        }
+        LogFormat::Json => init_json_logger(default_filter),
+    }
+}
+
+/// Installs the json logger as the global logger
+pub fn init_json_logger(default_filter: &str) {
+    let filters = std::env::var("RUST_LOG").unwrap_or_else(|_| default_filter.to_string());
+    set_log_filter(&filters);
+    log::set_boxed_logger(Box::new(JsonLogger)).expect("logger already initialized");
+}
+
+/// Replaces the filter of the json logger, filters use the RUST_LOG syntax
+pub fn set_log_filter(filters: &str) {
+    let filter = FilterBuilder::new().parse(filters).build();
+    log::set_max_level(filter.filter());
+    *JSON_FILTER.write().unwrap() = Some(filter);
+}
+
+struct JsonLogger;
+
+impl Log for JsonLogger {
+    fn enabled(&self, metadata: &Metadata) -> bool {
+        match &*JSON_FILTER.read().unwrap() {
+            Some(filter) => filter.enabled(metadata),
+            None => false,
+        }
+    }
+
+    fn log(&self, record: &Record) {
+        let enabled = match &*JSON_FILTER.read().unwrap() {
+            Some(filter) => filter.matches(record),
+            None => false,
+        };
+        if !enabled {
+            return;
+        }
+
+        let line = format_json_record(record);
+        let stderr = std::io::stderr();
+        let mut stderr = stderr.lock();
+        let _ = writeln!(stderr, "{}", line);
+    }
+
+    fn flush(&self) {}
+}
+
+fn format_json_record(record: &Record) -> String {
+    let message = record.args().to_string();
+    let timestamp = SystemTime::now()
+        .duration_since(UNIX_EPOCH)
+        .unwrap_or_default()
+        .as_millis() as u64;
+
+    let mut object = Map::new();
+    object.insert("timestamp_ms".to_string(), timestamp.into());
+    object.insert("level".to_string(), record.level().to_string().into());
+    object.insert("target".to_string(), record.target().into());
+    for (key, value) in correlation_fields(&message) {
+        object.insert(key, value.into());
+    }
+    object.insert("message".to_string(), message.into());
+
+    Value::Object(object).to_string()
+}
+
+/// Extracts the known key=value correlation fields from a log message
+pub fn correlation_fields(message: &str) -> Vec<(String, String)> {
+    let mut fields = Vec::new();
+    for token in message.split_whitespace() {
+        if let Some((key, value)) = token.split_once('=') {
+            let value = value.trim_end_matches(|c: char| c == ',' || c == '.' || c == ')');
+            if CORRELATION_KEYS.contains(&key) && !value.is_empty() {
+                fields.push((key.to_string(), value.to_string()));
+            }
+        }
+    }
+    fields
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_correlation_fields() {
+        let fields = correlation_fields(
+            "Oracle observed batch with batch_nonce=4, token_contract=0xabc and event_nonce=17.",
+        );
+        assert_eq!(
+            fields,
+            vec![
+                ("batch_nonce".to_string(), "4".to_string()),
+                ("token_contract".to_string(), "0xabc".to_string()),
+                ("event_nonce".to_string(), "17".to_string()),
+            ]
+        );
+
+        assert!(correlation_fields("unrelated=1 message").is_empty());
+    }
+}
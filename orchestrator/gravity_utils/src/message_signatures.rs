@@ -1,4 +1,4 @@
-use crate::types::{LogicCall, TransactionBatch, Valset};
+use crate::types::{Erc1155Batch, LogicCall, TransactionBatch, Valset};
 use ethers::core::abi::{self, Token};
 use ethers::prelude::*;
 use ethers::utils::hash_message;
@@ -258,6 +258,72 @@ async fn test_specific_batch_signature() {
     assert_eq!(eth_address, eth_signature.recover(checkpoint_hash).unwrap());
 }
 
+/// takes the required input data and produces the required signature to confirm an ERC1155
+/// batch on the Gravity Ethereum contract, the ids of each transfer are flattened into one
+/// entry per id as submitted to submitERC1155Batch
+pub fn encode_erc1155_batch_confirm(gravity_id: String, batch: Erc1155Batch) -> Vec<u8> {
+    let (destinations, ids, amounts) = batch.get_checkpoint_values_tokens();
+
+    abi::encode(&[
+        Token::FixedBytes(gravity_id.into_bytes()),
+        Token::FixedBytes("erc1155Batch".to_string().into_bytes()),
+        destinations,
+        ids,
+        amounts,
+        Token::Uint(batch.nonce.into()),
+        Token::Address(batch.token_contract),
+        Token::Uint(batch.batch_timeout.into()),
+    ])
+}
+
+pub fn encode_erc1155_batch_confirm_hashed(gravity_id: String, batch: Erc1155Batch) -> Vec<u8> {
+    let digest = keccak256(encode_erc1155_batch_confirm(gravity_id, batch).as_slice());
+    hash_message(digest).as_bytes().to_vec()
+}
+
+#[test]
+fn test_erc1155_batch_checkpoint() {
+    use crate::{ethereum::hex_str_to_bytes, types::Erc1155BatchTransaction};
+
+    // the same batch as TestERC1155BatchTxCheckpoint in x/gravity/types
+    let correct_hash: Vec<u8> =
+        hex_str_to_bytes("0xf5b87c24dea4a63573a121e68621b8753151a62d91a984d30af76afe8bc56d4e")
+            .unwrap();
+    let token_contract = "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"
+        .parse()
+        .unwrap();
+    let sender: deep_space::Address = "cosmos1g0etv93428tvxqftnmj25jn06mz6dtdasj5nz7"
+        .parse()
+        .unwrap();
+
+    let batch = Erc1155Batch {
+        nonce: 1u64,
+        batch_timeout: 2111u64,
+        transactions: vec![
+            Erc1155BatchTransaction {
+                id: 1u64,
+                sender,
+                ethereum_recipient: "0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39"
+                    .parse()
+                    .unwrap(),
+                amounts: vec![(1u64.into(), 10u64.into()), (7u64.into(), 1u64.into())],
+            },
+            Erc1155BatchTransaction {
+                id: 2u64,
+                sender,
+                ethereum_recipient: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+                    .parse()
+                    .unwrap(),
+                amounts: vec![(1u64.into(), 5u64.into())],
+            },
+        ],
+        token_contract,
+    };
+
+    let checkpoint = encode_erc1155_batch_confirm("foo".to_string(), batch);
+    assert_eq!(correct_hash, keccak256(&checkpoint).to_vec());
+}
+
 /// takes the required input data and produces the required signature to confirm a logic
 /// call on the Gravity Ethereum contract. This value will then be signed before being
 /// submitted to Cosmos, verified, and then relayed to Ethereum
@@ -0,0 +1,143 @@
+//! Prometheus metrics shared between the orchestrator crates. These are registered to the
+//! default registry so they are served alongside the orchestrator's own metrics by the
+//! orchestrator metrics endpoint.
+
+use crate::ethereum::downcast_to_u64;
+use ethers::prelude::*;
+use lazy_static::lazy_static;
+use prometheus::*;
+
+pub const RELAY_KIND_BATCH: &str = "batch";
+pub const RELAY_KIND_ERC1155_BATCH: &str = "erc1155_batch";
+pub const RELAY_KIND_LOGIC_CALL: &str = "logic_call";
+pub const RELAY_KIND_VALSET: &str = "valset";
+
+// Counters
+lazy_static! {
+    pub static ref COSMOS_TX_FAILURES: IntCounter = register_int_counter!(opts!(
+        "cosmos_tx_failures",
+        "cosmos transactions that failed to be delivered",
+        labels! {"chain" => "cosmos"}
+    ))
+    .unwrap();
+    static ref ETHEREUM_GAS_SPENT: IntCounterVec = register_int_counter_vec!(
+        opts!(
+            "ethereum_gas_spent",
+            "gas used by relayed ethereum transactions",
+            labels! {"chain" => "ethereum"}
+        ),
+        &["kind"]
+    )
+    .unwrap();
+    static ref ETHEREUM_FEES_SPENT_GWEI: IntCounterVec = register_int_counter_vec!(
+        opts!(
+            "ethereum_fees_spent_gwei",
+            "fees in gwei paid by relayed ethereum transactions",
+            labels! {"chain" => "ethereum"}
+        ),
+        &["kind"]
+    )
+    .unwrap();
+    static ref RELAY_ATTEMPTS: IntCounterVec = register_int_counter_vec!(
+        opts!(
+            "relay_attempts",
+            "ethereum transactions submitted by the relayer",
+            labels! {"chain" => "ethereum"}
+        ),
+        &["kind"]
+    )
+    .unwrap();
+    static ref RELAY_SUCCESSES: IntCounterVec = register_int_counter_vec!(
+        opts!(
+            "relay_successes",
+            "ethereum transactions submitted by the relayer that updated the contract nonce",
+            labels! {"chain" => "ethereum"}
+        ),
+        &["kind"]
+    )
+    .unwrap();
+}
+
+// Gauges
+lazy_static! {
+    static ref ETHEREUM_NONCE: IntGauge = register_int_gauge!(opts!(
+        "ethereum_nonce",
+        "transaction count of the ethereum key used for relaying",
+        labels! {"chain" => "ethereum"}
+    ))
+    .unwrap();
+}
+
+pub fn inc_relay_attempts(kind: &str) {
+    RELAY_ATTEMPTS.with_label_values(&[kind]).inc();
+}
+
+pub fn inc_relay_successes(kind: &str) {
+    RELAY_SUCCESSES.with_label_values(&[kind]).inc();
+}
+
+/// Records the gas used and the fee paid by a relayed transaction given its receipt
+pub fn record_gas_spent(kind: &str, receipt: &TransactionReceipt) {
+    if let Some(gas_used) = receipt.gas_used {
+        ETHEREUM_GAS_SPENT
+            .with_label_values(&[kind])
+            .inc_by(downcast_to_u64(gas_used).unwrap_or(0));
+
+        if let Some(gas_price) = receipt.effective_gas_price {
+            let fee_gwei = gas_used.saturating_mul(gas_price) / U256::exp10(9);
+            ETHEREUM_FEES_SPENT_GWEI
+                .with_label_values(&[kind])
+                .inc_by(downcast_to_u64(fee_gwei).unwrap_or(0));
+        }
+    }
+}
+
+pub fn set_ethereum_nonce(v: U256) {
+    ETHEREUM_NONCE.set(v.to_string().parse().unwrap_or(-1));
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_record_gas_spent() {
+        let receipt = TransactionReceipt {
+            gas_used: Some(21_000.into()),
+            effective_gas_price: Some(U256::from(50) * U256::exp10(9)),
+            ..Default::default()
+        };
+        record_gas_spent("test", &receipt);
+        record_gas_spent("test", &receipt);
+
+        assert_eq!(
+            ETHEREUM_GAS_SPENT.with_label_values(&["test"]).get(),
+            42_000
+        );
+        assert_eq!(
+            ETHEREUM_FEES_SPENT_GWEI.with_label_values(&["test"]).get(),
+            2_100_000
+        );
+    }
+
+    #[test]
+    fn test_record_gas_spent_without_gas_price() {
+        // pre London receipts carry no effective gas price
+        let receipt = TransactionReceipt {
+            gas_used: Some(21_000.into()),
+            ..Default::default()
+        };
+        record_gas_spent("legacy", &receipt);
+
+        assert_eq!(
+            ETHEREUM_GAS_SPENT.with_label_values(&["legacy"]).get(),
+            21_000
+        );
+        assert_eq!(
+            ETHEREUM_FEES_SPENT_GWEI
+                .with_label_values(&["legacy"])
+                .get(),
+            0
+        );
+    }
+}
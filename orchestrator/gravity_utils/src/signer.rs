@@ -0,0 +1,371 @@
+//! Ethereum signing backends for the orchestrator. The bridge hot key may be kept in the local
+//! keystore, in AWS KMS, or in a web3signer compatible remote signer. In the latter two cases
+//! the private key never touches the machine doing the relaying.
+
+use crate::ethereum::{bytes_to_hex_str, hex_str_to_bytes};
+use async_trait::async_trait;
+use ethers::prelude::*;
+use ethers::types::transaction::eip2718::TypedTransaction;
+use ethers::types::transaction::eip712::Eip712;
+use ethers::utils::keccak256;
+use std::convert::TryFrom;
+use std::fmt;
+
+#[cfg(feature = "aws")]
+use ethers::signers::{AwsSigner, AwsSignerError};
+
+/// The prefix prepended to messages before signing by `eth_sign`, see EIP-191
+const ETH_MESSAGE_PREFIX: &str = "\x19Ethereum Signed Message:\n";
+
+#[derive(Debug)]
+pub enum EthSignerError {
+    LocalError(WalletError),
+    #[cfg(feature = "aws")]
+    AwsKmsError(AwsSignerError),
+    RemoteError(String),
+}
+
+impl fmt::Display for EthSignerError {
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result {
+        match self {
+            EthSignerError::LocalError(val) => write!(f, "Local signer error: {}", val),
+            #[cfg(feature = "aws")]
+            EthSignerError::AwsKmsError(val) => write!(f, "AWS KMS signer error: {}", val),
+            EthSignerError::RemoteError(val) => write!(f, "Remote signer error: {}", val),
+        }
+    }
+}
+
+impl std::error::Error for EthSignerError {}
+
+impl From<WalletError> for EthSignerError {
+    fn from(error: WalletError) -> Self {
+        EthSignerError::LocalError(error)
+    }
+}
+
+#[cfg(feature = "aws")]
+impl From<AwsSignerError> for EthSignerError {
+    fn from(error: AwsSignerError) -> Self {
+        EthSignerError::AwsKmsError(error)
+    }
+}
+
+impl From<reqwest::Error> for EthSignerError {
+    fn from(error: reqwest::Error) -> Self {
+        EthSignerError::RemoteError(error.to_string())
+    }
+}
+
+/// EthSigner is the signer used by the orchestrator for all Ethereum transactions and
+/// confirmation signatures, dispatching to the configured backend
+#[derive(Clone, Debug)]
+pub enum EthSigner {
+    Local(LocalWallet),
+    #[cfg(feature = "aws")]
+    AwsKms(AwsSigner<'static>),
+    Remote(RemoteSigner),
+}
+
+impl EthSigner {
+    /// Connects to the KMS key with the provided id. The KMS client is leaked so that the
+    /// signer may be used for the lifetime of the process.
+    #[cfg(feature = "aws")]
+    pub async fn aws_kms(
+        key_id: String,
+        region: String,
+        chain_id: u64,
+    ) -> Result<Self, EthSignerError> {
+        let region: rusoto_core::Region = region
+            .parse()
+            .map_err(|e| EthSignerError::RemoteError(format!("invalid AWS region: {}", e)))?;
+        let kms: &'static rusoto_kms::KmsClient =
+            Box::leak(Box::new(rusoto_kms::KmsClient::new(region)));
+
+        Ok(EthSigner::AwsKms(
+            AwsSigner::new(kms, key_id, chain_id).await?,
+        ))
+    }
+}
+
+impl From<LocalWallet> for EthSigner {
+    fn from(wallet: LocalWallet) -> Self {
+        EthSigner::Local(wallet)
+    }
+}
+
+impl From<RemoteSigner> for EthSigner {
+    fn from(signer: RemoteSigner) -> Self {
+        EthSigner::Remote(signer)
+    }
+}
+
+#[async_trait]
+impl Signer for EthSigner {
+    type Error = EthSignerError;
+
+    async fn sign_message<S: Send + Sync + AsRef<[u8]>>(
+        &self,
+        message: S,
+    ) -> Result<Signature, Self::Error> {
+        match self {
+            EthSigner::Local(signer) => Ok(signer.sign_message(message).await?),
+            #[cfg(feature = "aws")]
+            EthSigner::AwsKms(signer) => Ok(signer.sign_message(message).await?),
+            EthSigner::Remote(signer) => signer.sign_message(message).await,
+        }
+    }
+
+    async fn sign_transaction(&self, message: &TypedTransaction) -> Result<Signature, Self::Error> {
+        match self {
+            EthSigner::Local(signer) => Ok(signer.sign_transaction(message).await?),
+            #[cfg(feature = "aws")]
+            EthSigner::AwsKms(signer) => Ok(signer.sign_transaction(message).await?),
+            EthSigner::Remote(signer) => signer.sign_transaction(message).await,
+        }
+    }
+
+    async fn sign_typed_data<T: Eip712 + Send + Sync>(
+        &self,
+        payload: &T,
+    ) -> Result<Signature, Self::Error> {
+        match self {
+            EthSigner::Local(signer) => Ok(signer.sign_typed_data(payload).await?),
+            #[cfg(feature = "aws")]
+            EthSigner::AwsKms(signer) => Ok(signer.sign_typed_data(payload).await?),
+            EthSigner::Remote(signer) => signer.sign_typed_data(payload).await,
+        }
+    }
+
+    fn address(&self) -> Address {
+        match self {
+            EthSigner::Local(signer) => signer.address(),
+            #[cfg(feature = "aws")]
+            EthSigner::AwsKms(signer) => signer.address(),
+            EthSigner::Remote(signer) => signer.address,
+        }
+    }
+
+    fn chain_id(&self) -> u64 {
+        match self {
+            EthSigner::Local(signer) => signer.chain_id(),
+            #[cfg(feature = "aws")]
+            EthSigner::AwsKms(signer) => signer.chain_id(),
+            EthSigner::Remote(signer) => signer.chain_id,
+        }
+    }
+
+    fn with_chain_id<T: Into<u64>>(self, chain_id: T) -> Self {
+        match self {
+            EthSigner::Local(signer) => EthSigner::Local(signer.with_chain_id(chain_id)),
+            #[cfg(feature = "aws")]
+            EthSigner::AwsKms(signer) => EthSigner::AwsKms(signer.with_chain_id(chain_id)),
+            EthSigner::Remote(mut signer) => {
+                signer.chain_id = chain_id.into();
+                EthSigner::Remote(signer)
+            }
+        }
+    }
+}
+
+/// RemoteSigner signs using the eth1 signing endpoint of a web3signer compatible service,
+/// which signs the keccak256 hash of the submitted data with the key identified by its
+/// public key
+#[derive(Clone, Debug)]
+pub struct RemoteSigner {
+    client: reqwest::Client,
+    url: String,
+    public_key: String,
+    address: Address,
+    chain_id: u64,
+}
+
+#[derive(Serialize)]
+struct RemoteSignRequest {
+    data: String,
+}
+
+impl RemoteSigner {
+    /// Creates a new remote signer for the hex encoded, uncompressed secp256k1 public key
+    /// held by the signing service at url
+    pub fn new(url: String, public_key: String) -> Result<Self, EthSignerError> {
+        let key_bytes = hex_str_to_bytes(&public_key)
+            .map_err(|e| EthSignerError::RemoteError(format!("invalid public key: {}", e)))?;
+        // the address is the last 20 bytes of the hash of the public key without its
+        // leading 0x04 tag
+        let key_bytes = match key_bytes.len() {
+            65 if key_bytes[0] == 4 => &key_bytes[1..],
+            64 => &key_bytes[..],
+            _ => {
+                return Err(EthSignerError::RemoteError(format!(
+                    "expected an uncompressed public key, got {} bytes",
+                    key_bytes.len()
+                )))
+            }
+        };
+        let address = Address::from_slice(&keccak256(key_bytes)[12..]);
+
+        Ok(RemoteSigner {
+            client: reqwest::Client::new(),
+            url: url.trim_end_matches('/').to_owned(),
+            public_key,
+            address,
+            chain_id: 1,
+        })
+    }
+
+    /// Requests a signature over keccak256(data) from the remote signer and checks that
+    /// it was produced by the expected key
+    async fn sign_preimage(&self, data: &[u8]) -> Result<Signature, EthSignerError> {
+        let response = self
+            .client
+            .post(format!("{}/api/v1/eth1/sign/{}", self.url, self.public_key))
+            .json(&RemoteSignRequest {
+                data: format!("0x{}", bytes_to_hex_str(data)),
+            })
+            .send()
+            .await?
+            .error_for_status()?
+            .text()
+            .await?;
+
+        let signature_bytes = hex_str_to_bytes(response.trim().trim_matches('"')).map_err(|e| {
+            EthSignerError::RemoteError(format!("invalid signature response: {}", e))
+        })?;
+        let mut signature = Signature::try_from(signature_bytes.as_slice())
+            .map_err(|e| EthSignerError::RemoteError(e.to_string()))?;
+        normalize_recovery_id(&mut signature);
+
+        signature
+            .verify(H256::from(keccak256(data)), self.address)
+            .map_err(|e| EthSignerError::RemoteError(format!("signature mismatch: {}", e)))?;
+
+        Ok(signature)
+    }
+
+    async fn sign_message<S: Send + Sync + AsRef<[u8]>>(
+        &self,
+        message: S,
+    ) -> Result<Signature, EthSignerError> {
+        let message = message.as_ref();
+        let mut data = format!("{}{}", ETH_MESSAGE_PREFIX, message.len()).into_bytes();
+        data.extend_from_slice(message);
+
+        self.sign_preimage(&data).await
+    }
+
+    async fn sign_transaction(&self, tx: &TypedTransaction) -> Result<Signature, EthSignerError> {
+        let mut signature = self.sign_preimage(&tx.rlp(self.chain_id)).await?;
+        signature.v = eip155_recovery_id(signature.v, self.chain_id);
+
+        Ok(signature)
+    }
+
+    async fn sign_typed_data<T: Eip712 + Send + Sync>(
+        &self,
+        payload: &T,
+    ) -> Result<Signature, EthSignerError> {
+        let domain_separator = payload
+            .domain()
+            .map_err(|e| EthSignerError::RemoteError(e.to_string()))?
+            .separator();
+        let struct_hash = payload
+            .struct_hash()
+            .map_err(|e| EthSignerError::RemoteError(e.to_string()))?;
+
+        self.sign_preimage(&eip712_preimage(&domain_separator, &struct_hash))
+            .await
+    }
+}
+
+/// Some signers return the raw 0 or 1 recovery id, Ethereum expects 27 or 28
+fn normalize_recovery_id(signature: &mut Signature) {
+    if signature.v < 27 {
+        signature.v += 27;
+    }
+}
+
+/// Applies EIP-155 replay protection to a 27 or 28 recovery id
+fn eip155_recovery_id(v: u64, chain_id: u64) -> u64 {
+    v - 27 + 35 + chain_id * 2
+}
+
+/// The data hashed and signed for EIP-712 typed data, see the EIP's encoding of signTypedData
+fn eip712_preimage(domain_separator: &[u8; 32], struct_hash: &[u8; 32]) -> Vec<u8> {
+    let mut data = vec![0x19, 0x01];
+    data.extend_from_slice(domain_separator);
+    data.extend_from_slice(struct_hash);
+    data
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::convert::TryInto;
+
+    // the public key of the secret key 1, that is the secp256k1 generator point
+    const PUBLIC_KEY: &str = "0x0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8";
+    const ADDRESS: &str = "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf";
+
+    #[test]
+    fn test_remote_signer_address() {
+        let signer = RemoteSigner::new("http://localhost:9000/".into(), PUBLIC_KEY.into()).unwrap();
+        assert_eq!(signer.address, ADDRESS.parse().unwrap());
+        assert_eq!(signer.url, "http://localhost:9000");
+
+        // the 0x04 tag is optional
+        let untagged = format!("0x{}", &PUBLIC_KEY[4..]);
+        let signer = RemoteSigner::new("http://localhost:9000".into(), untagged).unwrap();
+        assert_eq!(signer.address, ADDRESS.parse().unwrap());
+    }
+
+    #[test]
+    fn test_remote_signer_rejects_compressed_keys() {
+        let compressed = "0x0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798";
+        assert!(RemoteSigner::new("http://localhost:9000".into(), compressed.into()).is_err());
+        assert!(RemoteSigner::new("http://localhost:9000".into(), "0xzz".into()).is_err());
+    }
+
+    #[test]
+    fn test_normalize_recovery_id() {
+        for (v, expected) in [(0, 27), (1, 28), (27, 27), (28, 28)] {
+            let mut signature = Signature {
+                r: U256::one(),
+                s: U256::one(),
+                v,
+            };
+            normalize_recovery_id(&mut signature);
+            assert_eq!(signature.v, expected);
+        }
+    }
+
+    #[test]
+    fn test_eip155_recovery_id() {
+        assert_eq!(eip155_recovery_id(27, 1), 37);
+        assert_eq!(eip155_recovery_id(28, 1), 38);
+        assert_eq!(eip155_recovery_id(27, 5), 45);
+        assert_eq!(eip155_recovery_id(28, 5), 46);
+    }
+
+    #[test]
+    fn test_eip712_preimage() {
+        // the Mail example of the EIP-712 specification
+        let domain_separator: [u8; 32] =
+            hex_str_to_bytes("0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f")
+                .unwrap()
+                .try_into()
+                .unwrap();
+        let struct_hash: [u8; 32] =
+            hex_str_to_bytes("0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e")
+                .unwrap()
+                .try_into()
+                .unwrap();
+
+        let preimage = eip712_preimage(&domain_separator, &struct_hash);
+        assert_eq!(&preimage[..2], &[0x19, 0x01]);
+        assert_eq!(
+            bytes_to_hex_str(&keccak256(&preimage)),
+            "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"
+        );
+    }
+}
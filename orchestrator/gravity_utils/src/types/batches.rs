@@ -152,3 +152,124 @@ impl Confirm for BatchConfirmResponse {
         self.eth_signature
     }
 }
+
+/// This represents an individual transfer of ids of an ERC1155 token being bridged over
+/// to Ethereum, parallel is SendERC1155ToEthereum in x/gravity
+#[derive(Serialize, Deserialize, Debug, Clone)]
+pub struct Erc1155BatchTransaction {
+    pub id: u64,
+    pub sender: CosmosAddress,
+    pub ethereum_recipient: EthAddress,
+    /// the ids transferred, each with the amount of it
+    pub amounts: Vec<(U256, U256)>,
+}
+
+impl Erc1155BatchTransaction {
+    pub fn from_proto(
+        input: gravity_proto::gravity::SendErc1155ToEthereum,
+    ) -> Result<Self, GravityError> {
+        let mut amounts = Vec::new();
+        for amount in input.amounts {
+            amounts.push((
+                U256::from_dec_str(amount.id.as_str())?,
+                U256::from_dec_str(amount.amount.as_str())?,
+            ));
+        }
+
+        Ok(Erc1155BatchTransaction {
+            id: input.id,
+            sender: input.sender.parse()?,
+            ethereum_recipient: input.ethereum_recipient.parse()?,
+            amounts,
+        })
+    }
+}
+
+/// A batch of transfers of an ERC1155 token, these pay no fees
+#[derive(Serialize, Deserialize, Debug, Default, Clone)]
+pub struct Erc1155Batch {
+    pub nonce: u64,
+    pub batch_timeout: u64,
+    pub transactions: Vec<Erc1155BatchTransaction>,
+    pub token_contract: EthAddress,
+}
+
+impl Erc1155Batch {
+    /// extracts the destinations, ids and amounts as submitted to the Ethereum contract
+    /// and used for signatures, there is one entry per id of each transfer
+    pub fn get_checkpoint_values(&self) -> (Vec<EthAddress>, Vec<U256>, Vec<U256>) {
+        let mut destinations = Vec::new();
+        let mut ids = Vec::new();
+        let mut amounts = Vec::new();
+        for tx in self.transactions.iter() {
+            for (id, amount) in tx.amounts.iter() {
+                destinations.push(tx.ethereum_recipient);
+                ids.push(*id);
+                amounts.push(*amount);
+            }
+        }
+
+        (destinations, ids, amounts)
+    }
+
+    pub fn get_checkpoint_values_tokens(&self) -> (Token, Token, Token) {
+        let (destinations, ids, amounts) = self.get_checkpoint_values();
+
+        (
+            Token::Array(destinations.into_iter().map(Token::Address).collect()),
+            Token::Array(ids.into_iter().map(Token::Uint).collect()),
+            Token::Array(amounts.into_iter().map(Token::Uint).collect()),
+        )
+    }
+
+    pub fn from_proto(input: gravity_proto::gravity::Erc1155BatchTx) -> Result<Self, GravityError> {
+        if input.transactions.is_empty() {
+            return Err(GravityError::InvalidBridgeStateError(
+                "ERC1155 batch containing no transactions!".to_string(),
+            ));
+        }
+
+        let mut transactions = Vec::new();
+        for tx in input.transactions {
+            transactions.push(Erc1155BatchTransaction::from_proto(tx)?);
+        }
+
+        Ok(Erc1155Batch {
+            nonce: input.batch_nonce,
+            batch_timeout: input.timeout,
+            transactions,
+            token_contract: input.token_contract.parse()?,
+        })
+    }
+}
+
+/// the response we get when querying for an ERC1155 batch confirmation
+#[derive(Serialize, Deserialize, Debug, Clone)]
+pub struct Erc1155BatchConfirmResponse {
+    pub nonce: u64,
+    pub token_contract: EthAddress,
+    pub ethereum_signer: EthAddress,
+    pub eth_signature: EthSignature,
+}
+
+impl Erc1155BatchConfirmResponse {
+    pub fn from_proto(
+        input: gravity_proto::gravity::Erc1155BatchTxConfirmation,
+    ) -> Result<Self, GravityError> {
+        Ok(Erc1155BatchConfirmResponse {
+            nonce: input.batch_nonce,
+            token_contract: input.token_contract.parse()?,
+            ethereum_signer: input.ethereum_signer.parse()?,
+            eth_signature: EthSignature::try_from(input.signature.as_slice())?,
+        })
+    }
+}
+
+impl Confirm for Erc1155BatchConfirmResponse {
+    fn get_eth_address(&self) -> EthAddress {
+        self.ethereum_signer
+    }
+    fn get_signature(&self) -> EthSignature {
+        self.eth_signature
+    }
+}
@@ -85,6 +85,39 @@ pub trait EventNonceFilter: Sized {
     }
 }
 
+/// The number of Ethereum confirmations the oracle waits for before submitting a claim
+/// for each type of event, these are also reported in the submitted claims
+#[derive(Debug, Default, Clone, Copy, Eq, PartialEq)]
+pub struct EventConfirmations {
+    pub send_to_cosmos: u64,
+    pub transaction_batch: u64,
+    pub erc20_deployed: u64,
+    pub logic_call: u64,
+    pub valset_updated: u64,
+}
+
+impl EventConfirmations {
+    /// The same number of confirmations for every type of event
+    pub fn uniform(confirmations: u64) -> Self {
+        EventConfirmations {
+            send_to_cosmos: confirmations,
+            transaction_batch: confirmations,
+            erc20_deployed: confirmations,
+            logic_call: confirmations,
+            valset_updated: confirmations,
+        }
+    }
+
+    /// The fewest confirmations required for any type of event
+    pub fn min(&self) -> u64 {
+        self.send_to_cosmos
+            .min(self.transaction_batch)
+            .min(self.erc20_deployed)
+            .min(self.logic_call)
+            .min(self.valset_updated)
+    }
+}
+
 /// A parsed struct representing the Ethereum event fired by the Gravity contract
 /// when the validator set is updated. Reward amount and reward token are included
 /// as part of the contract-defined type, but currently they will always be zeroed
@@ -216,6 +249,14 @@ pub struct SendToCosmosEvent {
     pub event_nonce: U256,
     /// The block height this event occurred at
     pub block_height: U256,
+    /// The EVM chain the deposit is routed on to through Cosmos, zero if it stays there.
+    /// It is carried big endian in bytes 4..12 of the bytes32 destination, the address
+    /// in its last 20 bytes is then the recipient on that chain.
+    pub forward_evm_chain_id: u64,
+    /// The IBC channel the deposit is transferred on from Cosmos, empty if it isn't.
+    /// Bytes 0..4 of the destination carry the channel's number plus one big endian,
+    /// zero leaving the deposit on Cosmos.
+    pub forward_ibc_channel: String,
 }
 
 impl FromLogWithPrefix for SendToCosmosEvent {
@@ -229,11 +270,28 @@ impl FromLogWithPrefix for SendToCosmosEvent {
             amount: event.amount,
             event_nonce: event.event_nonce,
             block_height: block_height_from_log(input)?,
+            forward_evm_chain_id: forward_evm_chain_id(&event.destination),
+            forward_ibc_channel: forward_ibc_channel(&event.destination),
         })
     }
 }
 
 impl FromLogsWithPrefix for SendToCosmosEvent {}
+
+fn forward_evm_chain_id(destination: &[u8; 32]) -> u64 {
+    let mut chain_id = [0u8; 8];
+    chain_id.copy_from_slice(&destination[4..12]);
+    u64::from_be_bytes(chain_id)
+}
+
+fn forward_ibc_channel(destination: &[u8; 32]) -> String {
+    let mut channel = [0u8; 4];
+    channel.copy_from_slice(&destination[0..4]);
+    match u32::from_be_bytes(channel) {
+        0 => String::new(),
+        channel => format!("channel-{}", channel - 1),
+    }
+}
 impl EventNonce for SendToCosmosEvent {
     fn get_event_nonce(&self) -> U256 {
         self.event_nonce
@@ -241,6 +299,122 @@ impl EventNonce for SendToCosmosEvent {
 }
 impl EventNonceFilter for SendToCosmosEvent {}
 
+/// A parsed struct representing the Ethereum event fired by the Gravity contract when
+/// an ERC1155 batch is executed.
+#[derive(Serialize, Deserialize, Debug, Default, Clone, Eq, PartialEq, Hash)]
+pub struct Erc1155BatchExecutedEvent {
+    /// the nonce of the batch, ERC1155 batches share their nonces with the ERC20 batches
+    pub batch_nonce: U256,
+    /// The block height this event occurred at
+    pub block_height: U256,
+    /// The ERC1155 token contract of the batch
+    pub token_contract: EthAddress,
+    /// the event nonce representing a unique ordering of events coming out
+    /// of the Gravity solidity contract
+    pub event_nonce: U256,
+}
+
+impl FromLog for Erc1155BatchExecutedEvent {
+    fn from_log(input: &Log) -> Result<Erc1155BatchExecutedEvent, GravityError> {
+        let event: Erc1155BatchExecutedEventFilter = log_to_ethers_event(input)?;
+
+        Ok(Erc1155BatchExecutedEvent {
+            batch_nonce: event.batch_nonce,
+            block_height: block_height_from_log(input)?,
+            token_contract: event.token,
+            event_nonce: event.event_nonce,
+        })
+    }
+}
+
+impl FromLogs for Erc1155BatchExecutedEvent {}
+impl EventNonce for Erc1155BatchExecutedEvent {
+    fn get_event_nonce(&self) -> U256 {
+        self.event_nonce
+    }
+}
+impl EventNonceFilter for Erc1155BatchExecutedEvent {}
+
+/// A parsed struct representing the Ethereum event fired by the Gravity contract when its
+/// version is announced
+#[derive(Serialize, Deserialize, Debug, Default, Clone, Eq, PartialEq, Hash)]
+pub struct ContractVersionEvent {
+    /// the version of the Gravity contract
+    pub version: U256,
+    /// The block height this event occurred at
+    pub block_height: U256,
+    /// the event nonce representing a unique ordering of events coming out
+    /// of the Gravity solidity contract
+    pub event_nonce: U256,
+}
+
+impl FromLog for ContractVersionEvent {
+    fn from_log(input: &Log) -> Result<ContractVersionEvent, GravityError> {
+        let event: ContractVersionEventFilter = log_to_ethers_event(input)?;
+
+        Ok(ContractVersionEvent {
+            version: event.version,
+            block_height: block_height_from_log(input)?,
+            event_nonce: event.event_nonce,
+        })
+    }
+}
+
+impl FromLogs for ContractVersionEvent {}
+impl EventNonce for ContractVersionEvent {
+    fn get_event_nonce(&self) -> U256 {
+        self.event_nonce
+    }
+}
+impl EventNonceFilter for ContractVersionEvent {}
+
+/// A parsed struct representing the Ethereum event fired when someone deposits ids of an
+/// ERC1155 token on the Gravity contract
+#[derive(Serialize, Deserialize, Debug, Clone, Eq, PartialEq, Hash)]
+pub struct SendErc1155ToCosmosEvent {
+    /// The ERC1155 token contract address for the deposit
+    pub token_contract: EthAddress,
+    /// The Ethereum Sender
+    pub sender: EthAddress,
+    /// The Cosmos destination
+    pub destination: CosmosAddress,
+    /// The ids deposited, each with the amount of it
+    pub amounts: Vec<(U256, U256)>,
+    /// The transaction's nonce, used to make sure there can be no accidental duplication
+    pub event_nonce: U256,
+    /// The block height this event occurred at
+    pub block_height: U256,
+}
+
+impl FromLogWithPrefix for SendErc1155ToCosmosEvent {
+    fn from_log(input: &Log, prefix: &str) -> Result<SendErc1155ToCosmosEvent, GravityError> {
+        let event: SendErc1155ToCosmosEventFilter = log_to_ethers_event(input)?;
+        if event.ids.len() != event.amounts.len() {
+            return Err(GravityError::InvalidEventLogError(format!(
+                "SendERC1155ToCosmosEvent ids and amounts have different length: {:?}",
+                event
+            )));
+        }
+
+        Ok(SendErc1155ToCosmosEvent {
+            token_contract: event.token_contract,
+            sender: event.sender,
+            destination: CosmosAddress::from_slice(&event.destination[12..32], prefix)?,
+            amounts: event.ids.into_iter().zip(event.amounts).collect(),
+            event_nonce: event.event_nonce,
+            block_height: block_height_from_log(input)?,
+        })
+    }
+}
+
+impl FromLogsWithPrefix for SendErc1155ToCosmosEvent {}
+impl EventNonce for SendErc1155ToCosmosEvent {
+    fn get_event_nonce(&self) -> U256 {
+        self.event_nonce
+    }
+}
+impl EventNonceFilter for SendErc1155ToCosmosEvent {}
+
 /// A parsed struct representing the Ethereum event fired when someone uses the Gravity
 /// contract to deploy a new ERC20 contract representing a Cosmos asset
 #[derive(Serialize, Deserialize, Debug, Default, Clone, Eq, PartialEq, Hash)]
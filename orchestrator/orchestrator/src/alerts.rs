@@ -0,0 +1,555 @@
+//! Webhook alerts for the bridge conditions an operator must act on before they cost the
+//! validator or stall the bridge: outgoing txs left unsigned close to the end of their slashing
+//! window, the chain no longer observing the contract's events, batches about to time out
+//! with fees too low for anyone to relay them, and the chain having observed events the
+//! contract never emitted. Alerts are posted to Slack incoming webhooks, the PagerDuty Events
+//! API or as plain JSON, once when a condition starts and again once it is resolved.
+
+use cosmos_gravity::crypto::PrivateKey as CosmosPrivateKey;
+use cosmos_gravity::query::{
+    get_gravity_params, get_last_observed_event_nonce, get_latest_transaction_batches,
+    get_native_decimals, get_unsigned_outgoing_tx_heights,
+};
+use deep_space::address::Address;
+use deep_space::client::ChainStatus;
+use deep_space::Contact;
+use ethereum_gravity::one_native_f32;
+use ethereum_gravity::types::EthClient;
+use ethereum_gravity::utils::{get_event_nonce, get_gas_price};
+use ethers::prelude::*;
+use ethers::types::Address as EthAddress;
+use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
+use gravity_utils::connection_prep::{updated_endpoints, Endpoints};
+use gravity_utils::error::GravityError;
+use gravity_utils::ethereum::{downcast_to_f32, format_eth_address};
+use relayer::price_provider::FeeFloor;
+use serde_json::{json, Value};
+use std::collections::{HashMap, HashSet};
+use std::time::{Duration, Instant};
+use tokio::sync::watch;
+use tokio::time::sleep as delay_for;
+use tonic::transport::Channel;
+
+/// How often the alert conditions are checked
+pub const ALERTS_LOOP_SPEED: Duration = Duration::from_secs(60);
+/// The PagerDuty Events API v2 endpoint
+pub const PAGERDUTY_EVENTS_URL: &str = "https://events.pagerduty.com/v2/enqueue";
+
+#[derive(Clone, Debug)]
+pub struct AlertsConfig {
+    /// where alerts are posted to
+    pub webhooks: Vec<AlertWebhook>,
+    /// alert once an unsigned outgoing tx is this many Cosmos blocks away from slashing
+    pub slashing_margin_blocks: u64,
+    /// alert once the chain has observed no event for this long while the contract has
+    /// emitted newer ones
+    pub attestation_stall: Duration,
+    /// alert for unprofitable batches timing out within this many Ethereum blocks
+    pub batch_expiry_blocks: u64,
+    /// the gas a batch submission is assumed to use when comparing its fees to its cost
+    pub batch_gas_estimate: u64,
+}
+
+/// A webhook alerts are posted to, in the format of the service behind it
+#[derive(Clone, Debug)]
+pub enum AlertWebhook {
+    /// a Slack incoming webhook, or any service accepting its payload
+    Slack { url: String },
+    /// the PagerDuty Events API v2, alerts are resolved through their dedup key
+    PagerDuty { url: String, routing_key: String },
+    /// the alert as a JSON object
+    Json { url: String },
+}
+
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, Serialize)]
+#[serde(rename_all = "snake_case")]
+pub enum AlertKind {
+    ImminentSlashing,
+    AttestationHalted,
+    UnprofitableExpiringBatch,
+    NonceDivergence,
+}
+
+#[derive(Clone, Debug, PartialEq)]
+pub struct Alert {
+    pub kind: AlertKind,
+    /// identifies the condition across checks, so that it is only alerted once
+    pub key: String,
+    pub summary: String,
+}
+
+impl Alert {
+    fn new(kind: AlertKind, key: String, summary: String) -> Self {
+        Alert { kind, key, summary }
+    }
+}
+
+impl AlertWebhook {
+    fn url(&self) -> &str {
+        match self {
+            AlertWebhook::Slack { url } => url,
+            AlertWebhook::PagerDuty { url, .. } => url,
+            AlertWebhook::Json { url } => url,
+        }
+    }
+
+    /// The body posted to the webhook when the alert starts or is resolved, source
+    /// identifies the orchestrator sending it
+    fn payload(&self, alert: &Alert, resolved: bool, source: &str) -> Value {
+        match self {
+            AlertWebhook::Slack { .. } => {
+                let text = if resolved {
+                    format!("Resolved on {}: {}", source, alert.summary)
+                } else {
+                    format!("Gravity bridge alert on {}: {}", source, alert.summary)
+                };
+                json!({ "text": text })
+            }
+            AlertWebhook::PagerDuty { routing_key, .. } => json!({
+                "routing_key": routing_key,
+                "event_action": if resolved { "resolve" } else { "trigger" },
+                "dedup_key": format!("{}:{}", source, alert.key),
+                "payload": {
+                    "summary": alert.summary,
+                    "source": source,
+                    "severity": "critical",
+                    "class": alert.kind,
+                },
+            }),
+            AlertWebhook::Json { .. } => json!({
+                "kind": alert.kind,
+                "key": alert.key,
+                "status": if resolved { "resolved" } else { "firing" },
+                "summary": alert.summary,
+                "source": source,
+            }),
+        }
+    }
+}
+
+/// Checks the alert conditions and posts the alerts that started or were resolved since the
+/// last check to the configured webhooks. Alerts whose condition couldn't be checked are
+/// kept as they were.
+#[allow(clippy::too_many_arguments)]
+pub async fn alerts_main_loop(
+    cosmos_key: CosmosPrivateKey,
+    contact: Contact,
+    eth_client: EthClient,
+    grpc_client: GravityQueryClient<Channel>,
+    gravity_contract_address: EthAddress,
+    config: AlertsConfig,
+    fee_floor: Option<FeeFloor>,
+    mut endpoint_updates: Option<watch::Receiver<Endpoints>>,
+) {
+    let mut contact = contact;
+    let mut eth_client = eth_client;
+    let mut grpc_client = grpc_client;
+    let our_cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
+    let source = our_cosmos_address.to_string();
+    let http_client = reqwest::Client::new();
+    // gas costs are priced in the chain's native gas token, which may not use 18 decimals
+    let native_unit = match get_native_decimals(&mut grpc_client).await {
+        Ok(decimals) => one_native_f32(decimals),
+        Err(e) => {
+            error!(
+                "Failed to get the native token decimals, alerts disabled {:?}",
+                e
+            );
+            return;
+        }
+    };
+
+    let mut active: HashMap<String, Alert> = HashMap::new();
+    // the last event nonce the chain observed and since when
+    let mut observed_progress: Option<(u64, Instant)> = None;
+
+    loop {
+        if let Some(endpoints) = updated_endpoints(&mut endpoint_updates) {
+            contact = endpoints.contact;
+            eth_client = endpoints.eth_client;
+            grpc_client = endpoints.grpc;
+        }
+
+        let mut alerts = Vec::new();
+        let mut checked = HashSet::new();
+
+        match check_imminent_slashing(
+            &contact,
+            &mut grpc_client,
+            our_cosmos_address,
+            config.slashing_margin_blocks,
+        )
+        .await
+        {
+            Ok(found) => {
+                checked.insert(AlertKind::ImminentSlashing);
+                alerts.extend(found);
+            }
+            Err(e) => warn!("Could not check for imminent slashing {:?}", e),
+        }
+
+        match check_event_nonces(
+            eth_client.clone(),
+            &mut grpc_client,
+            gravity_contract_address,
+            config.attestation_stall,
+            &mut observed_progress,
+        )
+        .await
+        {
+            Ok(found) => {
+                checked.insert(AlertKind::AttestationHalted);
+                checked.insert(AlertKind::NonceDivergence);
+                alerts.extend(found);
+            }
+            Err(e) => warn!("Could not check event nonce progress {:?}", e),
+        }
+
+        if let Some(fee_floor) = &fee_floor {
+            match check_expiring_batches(
+                eth_client.clone(),
+                &mut grpc_client,
+                fee_floor,
+                &config,
+                native_unit,
+            )
+            .await
+            {
+                Ok(found) => {
+                    checked.insert(AlertKind::UnprofitableExpiringBatch);
+                    alerts.extend(found);
+                }
+                Err(e) => warn!("Could not check for expiring batches {:?}", e),
+            }
+        }
+
+        let (started, resolved) = update_active_alerts(&mut active, alerts, &checked);
+        for alert in started.iter() {
+            warn!("Alert: {}", alert.summary);
+        }
+        for alert in resolved.iter() {
+            info!("Alert resolved: {}", alert.summary);
+        }
+        for webhook in config.webhooks.iter() {
+            for alert in started.iter() {
+                send_alert(&http_client, webhook, alert, false, &source).await;
+            }
+            for alert in resolved.iter() {
+                send_alert(&http_client, webhook, alert, true, &source).await;
+            }
+        }
+
+        delay_for(ALERTS_LOOP_SPEED).await;
+    }
+}
+
+/// Replaces the active alerts of the checked kinds with the alerts found, returns the alerts
+/// that started and those that were resolved
+fn update_active_alerts(
+    active: &mut HashMap<String, Alert>,
+    found: Vec<Alert>,
+    checked: &HashSet<AlertKind>,
+) -> (Vec<Alert>, Vec<Alert>) {
+    let found_keys: HashSet<String> = found.iter().map(|alert| alert.key.clone()).collect();
+    let resolved_keys: Vec<String> = active
+        .values()
+        .filter(|alert| checked.contains(&alert.kind) && !found_keys.contains(&alert.key))
+        .map(|alert| alert.key.clone())
+        .collect();
+    let resolved = resolved_keys
+        .iter()
+        .filter_map(|key| active.remove(key))
+        .collect();
+
+    let mut started = Vec::new();
+    for alert in found {
+        if !active.contains_key(&alert.key) {
+            active.insert(alert.key.clone(), alert.clone());
+            started.push(alert);
+        }
+    }
+    (started, resolved)
+}
+
+async fn send_alert(
+    http_client: &reqwest::Client,
+    webhook: &AlertWebhook,
+    alert: &Alert,
+    resolved: bool,
+    source: &str,
+) {
+    let res = http_client
+        .post(webhook.url())
+        .json(&webhook.payload(alert, resolved, source))
+        .send()
+        .await
+        .and_then(|r| r.error_for_status());
+    if let Err(e) = res {
+        error!("Failed to send alert to {}: {}", webhook.url(), e);
+    }
+}
+
+async fn check_imminent_slashing(
+    contact: &Contact,
+    grpc_client: &mut GravityQueryClient<Channel>,
+    address: Address,
+    margin: u64,
+) -> Result<Vec<Alert>, GravityError> {
+    let height = match contact.get_chain_status().await? {
+        ChainStatus::Moving { block_height } => block_height,
+        _ => return Ok(Vec::new()),
+    };
+    let params = get_gravity_params(grpc_client).await?;
+    let (signer_set_heights, outgoing_tx_heights) =
+        get_unsigned_outgoing_tx_heights(grpc_client, address).await?;
+
+    let blocks_left = |heights: &[u64], window: u64| {
+        heights
+            .iter()
+            .map(|created| (created + window).saturating_sub(height))
+            .min()
+    };
+    Ok(slashing_alert(
+        blocks_left(&signer_set_heights, params.signed_signer_set_txs_window),
+        blocks_left(&outgoing_tx_heights, params.signed_batches_window),
+        margin,
+    )
+    .into_iter()
+    .collect())
+}
+
+/// Alerts if the oldest unsigned signer set tx or outgoing tx is within the margin of being
+/// slashed, given the blocks left before each is
+fn slashing_alert(
+    signer_set_blocks_left: Option<u64>,
+    outgoing_tx_blocks_left: Option<u64>,
+    margin: u64,
+) -> Option<Alert> {
+    let blocks_left = match (signer_set_blocks_left, outgoing_tx_blocks_left) {
+        (Some(a), Some(b)) => a.min(b),
+        (Some(a), None) | (None, Some(a)) => a,
+        (None, None) => return None,
+    };
+    if blocks_left > margin {
+        return None;
+    }
+    Some(Alert::new(
+        AlertKind::ImminentSlashing,
+        "imminent_slashing".to_string(),
+        format!(
+            "unsigned outgoing txs will be slashed in {} blocks, check the signer",
+            blocks_left
+        ),
+    ))
+}
+
+async fn check_event_nonces(
+    eth_client: EthClient,
+    grpc_client: &mut GravityQueryClient<Channel>,
+    gravity_contract_address: EthAddress,
+    attestation_stall: Duration,
+    observed_progress: &mut Option<(u64, Instant)>,
+) -> Result<Vec<Alert>, GravityError> {
+    let contract_nonce = get_event_nonce(gravity_contract_address, eth_client).await?;
+    let observed_nonce = get_last_observed_event_nonce(grpc_client).await?;
+
+    let since = match observed_progress {
+        Some((nonce, since)) if *nonce == observed_nonce => *since,
+        _ => {
+            let now = Instant::now();
+            *observed_progress = Some((observed_nonce, now));
+            now
+        }
+    };
+    Ok(event_nonce_alerts(
+        gravity_contract_address,
+        contract_nonce,
+        observed_nonce,
+        since.elapsed(),
+        attestation_stall,
+    ))
+}
+
+/// Alerts if the chain observed events past the contract's nonce, which it can only have
+/// done on a different contract or a reorganized chain, or if it observed no event for
+/// the stall duration while the contract emitted newer ones
+fn event_nonce_alerts(
+    gravity_contract_address: EthAddress,
+    contract_nonce: u64,
+    observed_nonce: u64,
+    stalled_for: Duration,
+    attestation_stall: Duration,
+) -> Vec<Alert> {
+    let mut alerts = Vec::new();
+    if observed_nonce > contract_nonce {
+        alerts.push(Alert::new(
+            AlertKind::NonceDivergence,
+            "nonce_divergence".to_string(),
+            format!(
+                "the chain observed event nonce {} but Gravity contract {} is at event nonce {}",
+                observed_nonce,
+                format_eth_address(gravity_contract_address),
+                contract_nonce
+            ),
+        ));
+    } else if observed_nonce < contract_nonce && stalled_for >= attestation_stall {
+        alerts.push(Alert::new(
+            AlertKind::AttestationHalted,
+            "attestation_halted".to_string(),
+            format!(
+                "the chain observed no event for {} seconds, it is at event nonce {} and the contract at {}",
+                stalled_for.as_secs(),
+                observed_nonce,
+                contract_nonce
+            ),
+        ));
+    }
+    alerts
+}
+
+async fn check_expiring_batches(
+    eth_client: EthClient,
+    grpc_client: &mut GravityQueryClient<Channel>,
+    fee_floor: &FeeFloor,
+    config: &AlertsConfig,
+    native_unit: f32,
+) -> Result<Vec<Alert>, GravityError> {
+    let height = eth_client.get_block_number().await?.as_u64();
+    let batches = get_latest_transaction_batches(grpc_client).await?;
+    let gas_price = get_gas_price(eth_client.clone()).await?;
+    let cost =
+        downcast_to_f32(gas_price * U256::from(config.batch_gas_estimate)).ok_or_else(|| {
+            GravityError::OverflowError(format!("Batch cost at gas price {} overflowed", gas_price))
+        })? / native_unit;
+
+    let mut alerts = Vec::new();
+    for batch in batches {
+        if batch.batch_timeout <= height
+            || batch.batch_timeout - height > config.batch_expiry_blocks
+        {
+            continue;
+        }
+        if fee_floor
+            .is_profitable(&[batch.total_fee.clone()], cost.into(), eth_client.clone())
+            .await
+        {
+            continue;
+        }
+        let token_contract = format_eth_address(batch.token_contract);
+        alerts.push(Alert::new(
+            AlertKind::UnprofitableExpiringBatch,
+            format!("unprofitable_expiring_batch:{}:{}", token_contract, batch.nonce),
+            format!(
+                "batch token_contract={} batch_nonce={} times out in {} blocks and its fees of {} don't cover its cost",
+                token_contract,
+                batch.nonce,
+                batch.batch_timeout - height,
+                batch.total_fee.amount
+            ),
+        ));
+    }
+    Ok(alerts)
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    fn test_alert(kind: AlertKind, key: &str) -> Alert {
+        Alert::new(kind, key.to_string(), key.to_string())
+    }
+
+    #[test]
+    fn test_slashing_alert() {
+        assert_eq!(slashing_alert(None, None, 100), None);
+        assert_eq!(slashing_alert(Some(101), None, 100), None);
+        assert!(slashing_alert(Some(101), Some(100), 100).is_some());
+        assert!(slashing_alert(None, Some(0), 100).is_some());
+    }
+
+    #[test]
+    fn test_event_nonce_alerts() {
+        let contract = EthAddress::zero();
+        let stall = Duration::from_secs(600);
+
+        assert!(event_nonce_alerts(contract, 5, 5, stall, stall).is_empty());
+        // the contract is ahead, but not for long enough
+        assert!(event_nonce_alerts(contract, 6, 5, Duration::from_secs(10), stall).is_empty());
+
+        let alerts = event_nonce_alerts(contract, 6, 5, stall, stall);
+        assert_eq!(alerts.len(), 1);
+        assert_eq!(alerts[0].kind, AlertKind::AttestationHalted);
+
+        let alerts = event_nonce_alerts(contract, 5, 6, Duration::from_secs(0), stall);
+        assert_eq!(alerts.len(), 1);
+        assert_eq!(alerts[0].kind, AlertKind::NonceDivergence);
+    }
+
+    #[test]
+    fn test_update_active_alerts() {
+        let mut active = HashMap::new();
+        let all_kinds: HashSet<AlertKind> = vec![
+            AlertKind::ImminentSlashing,
+            AlertKind::AttestationHalted,
+            AlertKind::UnprofitableExpiringBatch,
+            AlertKind::NonceDivergence,
+        ]
+        .into_iter()
+        .collect();
+
+        let slashing = test_alert(AlertKind::ImminentSlashing, "imminent_slashing");
+        let batch = test_alert(AlertKind::UnprofitableExpiringBatch, "batch");
+
+        let (started, resolved) = update_active_alerts(
+            &mut active,
+            vec![slashing.clone(), batch.clone()],
+            &all_kinds,
+        );
+        assert_eq!(started.len(), 2);
+        assert!(resolved.is_empty());
+
+        // still active conditions are not alerted again
+        let (started, resolved) =
+            update_active_alerts(&mut active, vec![slashing.clone()], &all_kinds);
+        assert!(started.is_empty());
+        assert_eq!(resolved, vec![batch.clone()]);
+
+        // alerts of kinds that couldn't be checked are kept
+        let checked = vec![AlertKind::UnprofitableExpiringBatch]
+            .into_iter()
+            .collect();
+        let (started, resolved) = update_active_alerts(&mut active, Vec::new(), &checked);
+        assert!(started.is_empty());
+        assert!(resolved.is_empty());
+        assert!(active.contains_key("imminent_slashing"));
+    }
+
+    #[test]
+    fn test_webhook_payload() {
+        let alert = test_alert(AlertKind::NonceDivergence, "nonce_divergence");
+
+        let slack = AlertWebhook::Slack {
+            url: "http://localhost".to_string(),
+        };
+        assert!(slack.payload(&alert, false, "val")["text"]
+            .as_str()
+            .unwrap()
+            .contains("nonce_divergence"));
+
+        let pagerduty = AlertWebhook::PagerDuty {
+            url: PAGERDUTY_EVENTS_URL.to_string(),
+            routing_key: "key".to_string(),
+        };
+        let trigger = pagerduty.payload(&alert, false, "val");
+        let resolve = pagerduty.payload(&alert, true, "val");
+        assert_eq!(trigger["event_action"], "trigger");
+        assert_eq!(resolve["event_action"], "resolve");
+        assert_eq!(trigger["dedup_key"], resolve["dedup_key"]);
+        assert_eq!(trigger["payload"]["class"], "nonce_divergence");
+
+        let generic = AlertWebhook::Json {
+            url: "http://localhost".to_string(),
+        };
+        assert_eq!(generic.payload(&alert, true, "val")["status"], "resolved");
+    }
+}
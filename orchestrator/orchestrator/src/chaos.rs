@@ -0,0 +1,278 @@
+//! Chaos mode for testnets. The oracle's claims and the signer's confirmations are passed
+//! through a stage that randomly holds them back, drops or reorders them before they reach
+//! the send loop, exercising the chain's timeout, slashing and attestation expiry handling
+//! under adverse but recoverable conditions. Claims are only ever delayed and keep their
+//! order, the chain rejects claims that skip an event nonce and the oracle would stall until
+//! it resyncs. Confirmations may also be dropped or reordered since the signer signs
+//! everything still unsigned again on its next loop.
+
+use deep_space::Msg;
+use rand::rngs::StdRng;
+use rand::{Rng, SeedableRng};
+use std::cmp::max;
+use std::time::Duration;
+use tokio::sync::mpsc::{Receiver, Sender};
+use tokio::time::{sleep_until, Instant};
+
+/// The highest chance a set of confirmations may be dropped with, above it the validator
+/// would miss most of its signing windows
+pub const MAX_DROP_PROBABILITY: f64 = 0.5;
+/// The longest a set of messages may be held back, well within the slashing windows and
+/// attestation expiry of any sane chain configuration
+pub const MAX_DELAY: Duration = Duration::from_secs(600);
+/// Chain IDs of the EVM mainnets chaos mode refuses to run on
+pub const MAINNET_CHAIN_IDS: &[u64] = &[1, 10, 56, 100, 137, 250, 8453, 42161, 43114];
+
+#[derive(Clone, Debug)]
+pub struct ChaosConfig {
+    /// the chance a set of confirmations is dropped
+    pub drop_probability: f64,
+    /// the chance a set of confirmations is held independently of the sets around it, so
+    /// that it may be submitted before or after them
+    pub reorder_probability: f64,
+    /// every set of messages is held back for a random time up to this long
+    pub max_delay: Duration,
+}
+
+impl ChaosConfig {
+    /// Checks the config is within the safe bounds and the Ethereum chain is not a mainnet
+    pub fn validate(&self, chain_id: u64) -> Result<(), String> {
+        if MAINNET_CHAIN_IDS.contains(&chain_id) {
+            return Err(format!(
+                "chaos mode may only run on testnets, chain {} is a mainnet",
+                chain_id
+            ));
+        }
+        if !(0.0..=MAX_DROP_PROBABILITY).contains(&self.drop_probability) {
+            return Err(format!(
+                "drop probability {} is not between 0 and {}",
+                self.drop_probability, MAX_DROP_PROBABILITY
+            ));
+        }
+        if !(0.0..=1.0).contains(&self.reorder_probability) {
+            return Err(format!(
+                "reorder probability {} is not between 0 and 1",
+                self.reorder_probability
+            ));
+        }
+        if self.max_delay > MAX_DELAY {
+            return Err(format!(
+                "max delay of {}s is longer than {}s",
+                self.max_delay.as_secs(),
+                MAX_DELAY.as_secs()
+            ));
+        }
+        Ok(())
+    }
+}
+
+/// The kind of messages a chaos stage is placed in front of
+#[derive(Clone, Copy, Debug, PartialEq)]
+pub enum Submissions {
+    /// the oracle's event claims and height votes
+    Claims,
+    /// the signer's confirmations of outgoing txs
+    Confirmations,
+}
+
+#[derive(Clone, Copy, Debug, PartialEq)]
+enum Action {
+    Drop,
+    Hold { delay: Duration, in_order: bool },
+}
+
+fn choose_action<R: Rng>(config: &ChaosConfig, submissions: Submissions, rng: &mut R) -> Action {
+    let delay = Duration::from_millis(rng.gen_range(0..=config.max_delay.as_millis() as u64));
+    match submissions {
+        Submissions::Claims => Action::Hold {
+            delay,
+            in_order: true,
+        },
+        Submissions::Confirmations => {
+            if rng.gen_bool(config.drop_probability) {
+                Action::Drop
+            } else {
+                Action::Hold {
+                    delay,
+                    in_order: !rng.gen_bool(config.reorder_probability),
+                }
+            }
+        }
+    }
+}
+
+/// Returns when a set held for delay is released, sets held in order are never released
+/// before the previous one
+fn release_at(
+    now: Instant,
+    delay: Duration,
+    in_order: bool,
+    last_in_order: &mut Instant,
+) -> Instant {
+    if !in_order {
+        return now + delay;
+    }
+    *last_in_order = max(now + delay, *last_in_order);
+    *last_in_order
+}
+
+struct Held {
+    release_at: Instant,
+    messages: Vec<Msg>,
+}
+
+/// Passes the messages received on rx to tx, dropping, delaying and reordering them as
+/// configured for the kind of submissions
+pub async fn chaos_main_loop(
+    config: ChaosConfig,
+    submissions: Submissions,
+    mut rx: Receiver<Vec<Msg>>,
+    tx: Sender<Vec<Msg>>,
+) {
+    let mut rng = StdRng::from_entropy();
+    let mut held: Vec<Held> = Vec::new();
+    let mut last_in_order = Instant::now();
+
+    loop {
+        let next_release = held.iter().map(|h| h.release_at).min();
+        tokio::select! {
+            messages = rx.recv() => {
+                let messages = match messages {
+                    Some(messages) => messages,
+                    None => break,
+                };
+                match choose_action(&config, submissions, &mut rng) {
+                    Action::Drop => {
+                        warn!("Chaos: dropping {} {:?} messages", messages.len(), submissions);
+                    }
+                    Action::Hold { delay, in_order } => {
+                        info!(
+                            "Chaos: holding {} {:?} messages for {}ms{}",
+                            messages.len(),
+                            submissions,
+                            delay.as_millis(),
+                            if in_order { "" } else { " out of order" }
+                        );
+                        let release_at =
+                            release_at(Instant::now(), delay, in_order, &mut last_in_order);
+                        held.push(Held { release_at, messages });
+                    }
+                }
+            }
+            _ = sleep_until(next_release.unwrap_or_else(Instant::now)), if next_release.is_some() => {
+                if !release(&mut held, Some(Instant::now()), &tx).await {
+                    return;
+                }
+            }
+        }
+    }
+
+    // the producer has stopped, whatever is still held is submitted right away
+    release(&mut held, None, &tx).await;
+}
+
+/// Sends the held sets due by now, or all of them, in the order of their release. Returns
+/// false once the send loop is gone.
+async fn release(held: &mut Vec<Held>, now: Option<Instant>, tx: &Sender<Vec<Msg>>) -> bool {
+    // the sort is stable so sets released at the same time keep the order they came in
+    held.sort_by_key(|h| h.release_at);
+    let due = match now {
+        Some(now) => held.iter().take_while(|h| h.release_at <= now).count(),
+        None => held.len(),
+    };
+    for h in held.drain(..due) {
+        if tx.send(h.messages).await.is_err() {
+            error!("Chaos: the send loop has stopped, dropping the held messages");
+            return false;
+        }
+    }
+    true
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    fn test_config() -> ChaosConfig {
+        ChaosConfig {
+            drop_probability: 0.5,
+            reorder_probability: 0.5,
+            max_delay: Duration::from_secs(60),
+        }
+    }
+
+    #[test]
+    fn test_validate() {
+        assert!(test_config().validate(5).is_ok());
+        assert!(test_config().validate(1).is_err());
+        assert!(ChaosConfig {
+            drop_probability: 0.6,
+            ..test_config()
+        }
+        .validate(5)
+        .is_err());
+        assert!(ChaosConfig {
+            reorder_probability: -0.1,
+            ..test_config()
+        }
+        .validate(5)
+        .is_err());
+        assert!(ChaosConfig {
+            max_delay: MAX_DELAY + Duration::from_secs(1),
+            ..test_config()
+        }
+        .validate(5)
+        .is_err());
+    }
+
+    #[test]
+    fn test_claims_are_only_delayed() {
+        let config = test_config();
+        let mut rng = StdRng::seed_from_u64(1);
+        for _ in 0..1000 {
+            match choose_action(&config, Submissions::Claims, &mut rng) {
+                Action::Hold { delay, in_order } => {
+                    assert!(in_order);
+                    assert!(delay <= config.max_delay);
+                }
+                Action::Drop => panic!("a claim was dropped"),
+            }
+        }
+    }
+
+    #[test]
+    fn test_confirmations_are_dropped_and_reordered() {
+        let config = test_config();
+        let mut rng = StdRng::seed_from_u64(1);
+        let actions: Vec<Action> = (0..1000)
+            .map(|_| choose_action(&config, Submissions::Confirmations, &mut rng))
+            .collect();
+        assert!(actions.contains(&Action::Drop));
+        assert!(actions.iter().any(|a| matches!(
+            a,
+            Action::Hold {
+                in_order: false,
+                ..
+            }
+        )));
+        assert!(actions
+            .iter()
+            .any(|a| matches!(a, Action::Hold { in_order: true, .. })));
+    }
+
+    #[test]
+    fn test_release_at() {
+        let now = Instant::now();
+        let mut last_in_order = now;
+
+        let first = release_at(now, Duration::from_secs(30), true, &mut last_in_order);
+        assert_eq!(first, now + Duration::from_secs(30));
+        // a set held in order for less is still released after the one before it
+        let second = release_at(now, Duration::from_secs(10), true, &mut last_in_order);
+        assert_eq!(second, first);
+        // a set held out of order may overtake it
+        let third = release_at(now, Duration::from_secs(10), false, &mut last_in_order);
+        assert_eq!(third, now + Duration::from_secs(10));
+        assert_eq!(last_in_order, first);
+    }
+}
@@ -1,26 +1,29 @@
 //! Ethereum Event watcher watches for events such as a deposit to the Gravity Ethereum contract or a validator set update
 //! or a transaction batch update. It then responds to these events by performing actions on the Cosmos chain if required
 
-use crate::get_with_retry::get_block_number_with_retry;
 use crate::get_with_retry::get_chain_id_with_retry;
+use crate::get_with_retry::get_final_block_number_with_retry;
 use crate::metrics;
 use cosmos_gravity::build;
 use cosmos_gravity::crypto::PrivateKey as CosmosPrivateKey;
 use cosmos_gravity::query::get_last_event_nonce;
 use deep_space::{Contact, Msg};
 use ethereum_gravity::types::EthClient;
+use ethereum_gravity::utils::get_event_nonce;
 use ethers::prelude::*;
 use ethers::types::Address as EthAddress;
 use gravity_abi::gravity::*;
 use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
-use gravity_utils::ethereum::downcast_to_u64;
+use gravity_proto::gravity::Finality;
+use gravity_utils::ethereum::{downcast_to_u64, format_eth_address};
 use gravity_utils::types::EventNonceFilter;
 use gravity_utils::types::{FromLogs, FromLogsWithPrefix};
 use gravity_utils::{
     error::GravityError,
     ethereum::bytes_to_hex_str,
     types::{
-        Erc20DeployedEvent, LogicCallExecutedEvent, SendToCosmosEvent,
+        ContractVersionEvent, Erc1155BatchExecutedEvent, Erc20DeployedEvent, EventConfirmations,
+        LogicCallExecutedEvent, SendErc1155ToCosmosEvent, SendToCosmosEvent,
         TransactionBatchExecutedEvent, ValsetUpdatedEvent,
     },
 };
@@ -28,6 +31,15 @@ use std::{result::Result, time};
 use tonic::transport::Channel;
 
 #[allow(clippy::too_many_arguments)]
+/// The progress made by a call to check_for_events
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub struct CheckedEvents {
+    /// the block the next search for events should start at
+    pub last_checked_block: U64,
+    /// the highest event nonce relayed for the blocks searched so far
+    pub last_event_nonce: u64,
+}
+
 pub async fn check_for_events(
     eth_client: EthClient,
     contact: &Contact,
@@ -36,13 +48,15 @@ pub async fn check_for_events(
     cosmos_key: CosmosPrivateKey,
     starting_block: U64,
     blocks_to_search: U64,
-    block_delay: U64,
+    confirmations: &EventConfirmations,
+    finality: Finality,
     msg_sender: tokio::sync::mpsc::Sender<Vec<Msg>>,
-) -> Result<U64, GravityError> {
+) -> Result<CheckedEvents, GravityError> {
     let prefix = contact.get_prefix();
     let our_cosmos_address = cosmos_key.to_address(&prefix).unwrap();
-    let latest_block = get_block_number_with_retry(eth_client.clone()).await;
-    let latest_block = latest_block - block_delay;
+    // with a tag based finality confirmations are counted from the tagged block
+    let chain_head = get_final_block_number_with_retry(eth_client.clone(), finality).await;
+    let latest_block = chain_head.saturating_sub(confirmations.min().into());
 
     let mut ending_block = starting_block + blocks_to_search;
     if ending_block > latest_block {
@@ -69,6 +83,15 @@ pub async fn check_for_events(
     let mut valset_updated_filter = Filter::new()
         .address(filter_gravity_contract_address.clone())
         .event(&ValsetUpdatedEventFilter::abi_signature());
+    let mut erc1155_deposit_filter = Filter::new()
+        .address(filter_gravity_contract_address.clone())
+        .event(&SendErc1155ToCosmosEventFilter::abi_signature());
+    let mut erc1155_batch_filter = Filter::new()
+        .address(filter_gravity_contract_address.clone())
+        .event(&Erc1155BatchExecutedEventFilter::abi_signature());
+    let mut contract_version_filter = Filter::new()
+        .address(filter_gravity_contract_address.clone())
+        .event(&ContractVersionEventFilter::abi_signature());
 
     let search_range = starting_block..ending_block;
 
@@ -78,6 +101,9 @@ pub async fn check_for_events(
     send_to_cosmos_filter = send_to_cosmos_filter.select(search_range.clone());
     transaction_batch_filter = transaction_batch_filter.select(search_range.clone());
     valset_updated_filter = valset_updated_filter.select(search_range.clone());
+    erc1155_deposit_filter = erc1155_deposit_filter.select(search_range.clone());
+    erc1155_batch_filter = erc1155_batch_filter.select(search_range.clone());
+    contract_version_filter = contract_version_filter.select(search_range.clone());
 
     let erc20_deployed_events = eth_client.get_logs(&erc20_deployed_filter).await?;
     debug!("ERC20 events detected {:?}", erc20_deployed_events);
@@ -105,6 +131,28 @@ pub async fn check_for_events(
     let valset_updated_events = ValsetUpdatedEvent::from_logs(&valset_updated_events)?;
     debug!("parsed valsets {:?}", valset_updated_events);
 
+    let erc1155_deposit_events = eth_client.get_logs(&erc1155_deposit_filter).await?;
+    debug!(
+        "ERC1155 deposit events detected {:?}",
+        erc1155_deposit_events
+    );
+    let erc1155_deposit_events =
+        SendErc1155ToCosmosEvent::from_logs(&erc1155_deposit_events, &prefix)?;
+    debug!("parsed erc1155 deposits {:?}", erc1155_deposit_events);
+
+    let erc1155_batch_events = eth_client.get_logs(&erc1155_batch_filter).await?;
+    debug!("ERC1155 batch events detected {:?}", erc1155_batch_events);
+    let erc1155_batch_events = Erc1155BatchExecutedEvent::from_logs(&erc1155_batch_events)?;
+    debug!("parsed erc1155 batches {:?}", erc1155_batch_events);
+
+    let contract_version_events = eth_client.get_logs(&contract_version_filter).await?;
+    debug!(
+        "Contract version events detected {:?}",
+        contract_version_events
+    );
+    let contract_version_events = ContractVersionEvent::from_logs(&contract_version_events)?;
+    debug!("parsed contract versions {:?}", contract_version_events);
+
     // note that starting block overlaps with our last checked block, because we have to deal with
     // the possibility that the relayer was killed after relaying only one of multiple events in a single
     // block, so we also need this routine so make sure we don't send in the first event in this hypothetical
@@ -112,24 +160,93 @@ pub async fn check_for_events(
     // atomicly but lets not take that risk.
     let last_event_nonce = get_last_event_nonce(grpc_client, our_cosmos_address).await?;
     metrics::set_cosmos_last_event_nonce(last_event_nonce);
+    match get_event_nonce(gravity_contract_address, eth_client.clone()).await {
+        Ok(ethereum_event_nonce) => metrics::set_claim_lag(ethereum_event_nonce, last_event_nonce),
+        Err(e) => warn!("Could not get the Gravity contract event nonce {:?}", e),
+    }
 
-    let erc20_deployed_events: Vec<Erc20DeployedEvent> =
+    let mut erc20_deployed_events: Vec<Erc20DeployedEvent> =
         Erc20DeployedEvent::filter_by_event_nonce(last_event_nonce, &erc20_deployed_events);
-    let logic_call_events: Vec<LogicCallExecutedEvent> =
+    let mut logic_call_events: Vec<LogicCallExecutedEvent> =
         LogicCallExecutedEvent::filter_by_event_nonce(last_event_nonce, &logic_call_events);
-    let send_to_cosmos_events: Vec<SendToCosmosEvent> =
+    let mut send_to_cosmos_events: Vec<SendToCosmosEvent> =
         SendToCosmosEvent::filter_by_event_nonce(last_event_nonce, &send_to_cosmos_events);
-    let transaction_batch_events: Vec<TransactionBatchExecutedEvent> =
+    let mut transaction_batch_events: Vec<TransactionBatchExecutedEvent> =
         TransactionBatchExecutedEvent::filter_by_event_nonce(
             last_event_nonce,
             &transaction_batch_events,
         );
-    let valset_updated_events: Vec<ValsetUpdatedEvent> =
+    let mut valset_updated_events: Vec<ValsetUpdatedEvent> =
         ValsetUpdatedEvent::filter_by_event_nonce(last_event_nonce, &valset_updated_events);
+    let mut erc1155_deposit_events: Vec<SendErc1155ToCosmosEvent> =
+        SendErc1155ToCosmosEvent::filter_by_event_nonce(last_event_nonce, &erc1155_deposit_events);
+    let mut erc1155_batch_events: Vec<Erc1155BatchExecutedEvent> =
+        Erc1155BatchExecutedEvent::filter_by_event_nonce(last_event_nonce, &erc1155_batch_events);
+    let mut contract_version_events: Vec<ContractVersionEvent> =
+        ContractVersionEvent::filter_by_event_nonce(last_event_nonce, &contract_version_events);
+
+    // claims must be submitted in event nonce order, so an event that does not have enough
+    // confirmations yet holds back every later event and the next search resumes at its block
+    let mut unconfirmed: Vec<(U256, U256)> = Vec::new();
+    let mut check = |event_nonce: U256, block_height: U256, required: u64| {
+        if block_height + U256::from(required) > U256::from(chain_head.as_u64()) {
+            unconfirmed.push((event_nonce, block_height));
+        }
+    };
+    for e in erc20_deployed_events.iter() {
+        check(e.event_nonce, e.block_height, confirmations.erc20_deployed);
+    }
+    for e in logic_call_events.iter() {
+        check(e.event_nonce, e.block_height, confirmations.logic_call);
+    }
+    for e in send_to_cosmos_events.iter() {
+        check(e.event_nonce, e.block_height, confirmations.send_to_cosmos);
+    }
+    for e in transaction_batch_events.iter() {
+        check(
+            e.event_nonce,
+            e.block_height,
+            confirmations.transaction_batch,
+        );
+    }
+    for e in valset_updated_events.iter() {
+        check(e.event_nonce, e.block_height, confirmations.valset_updated);
+    }
+    // erc1155 transfers wait as long as their erc20 counterparts
+    for e in erc1155_deposit_events.iter() {
+        check(e.event_nonce, e.block_height, confirmations.send_to_cosmos);
+    }
+    for e in erc1155_batch_events.iter() {
+        check(
+            e.event_nonce,
+            e.block_height,
+            confirmations.transaction_batch,
+        );
+    }
+    // like an erc20 deploy the announcement of the contract version only changes what the
+    // module will create
+    for e in contract_version_events.iter() {
+        check(e.event_nonce, e.block_height, confirmations.erc20_deployed);
+    }
+    if let Some((cutoff_nonce, cutoff_block)) = unconfirmed.into_iter().min() {
+        debug!(
+            "Waiting for more confirmations of event_nonce={} at block {}",
+            cutoff_nonce, cutoff_block
+        );
+        erc20_deployed_events.retain(|e| e.event_nonce < cutoff_nonce);
+        logic_call_events.retain(|e| e.event_nonce < cutoff_nonce);
+        send_to_cosmos_events.retain(|e| e.event_nonce < cutoff_nonce);
+        transaction_batch_events.retain(|e| e.event_nonce < cutoff_nonce);
+        valset_updated_events.retain(|e| e.event_nonce < cutoff_nonce);
+        erc1155_deposit_events.retain(|e| e.event_nonce < cutoff_nonce);
+        erc1155_batch_events.retain(|e| e.event_nonce < cutoff_nonce);
+        contract_version_events.retain(|e| e.event_nonce < cutoff_nonce);
+        ending_block = ending_block.min(U64::from(cutoff_block.as_u64()));
+    }
 
     for erc20_deployed_event in erc20_deployed_events.iter() {
         info!(
-            "Oracle observed ERC20 deploy with denom {}, erc20 name {}, symbol {}, and event_nonce {}",
+            "Oracle observed ERC20 deploy with denom {}, erc20 name {}, symbol {}, and event_nonce={}",
             erc20_deployed_event.cosmos_denom,
             erc20_deployed_event.name,
             erc20_deployed_event.symbol,
@@ -139,7 +256,7 @@ pub async fn check_for_events(
 
     for logic_call_event in logic_call_events.iter() {
         info!(
-            "Oracle observed logic call execution with invalidation_id {}, invalidation_nonce {}, and event_nonce {}",
+            "Oracle observed logic call execution with invalidation_id={}, invalidation_nonce={}, and event_nonce={}",
             bytes_to_hex_str(&logic_call_event.invalidation_id),
             logic_call_event.invalidation_nonce,
             logic_call_event.event_nonce
@@ -148,7 +265,7 @@ pub async fn check_for_events(
 
     for send_to_cosmos_event in send_to_cosmos_events.iter() {
         info!(
-            "Oracle observed send to cosmos event with ethereum sender {}, cosmos receiver {}, amount {}, and event nonce {}",
+            "Oracle observed send to cosmos event with ethereum sender {}, cosmos receiver {}, amount {}, and event_nonce={}",
             send_to_cosmos_event.sender,
             send_to_cosmos_event.destination,
             send_to_cosmos_event.amount,
@@ -158,16 +275,16 @@ pub async fn check_for_events(
 
     for transaction_batch_event in transaction_batch_events.iter() {
         info!(
-            "Oracle observed batch with batch_nonce {}, erc20 {}, and event_nonce {}",
+            "Oracle observed batch with batch_nonce={}, token_contract={}, and event_nonce={}",
             transaction_batch_event.batch_nonce,
-            transaction_batch_event.erc20,
+            format_eth_address(transaction_batch_event.erc20),
             transaction_batch_event.event_nonce
         );
     }
 
     for valset_updated_event in valset_updated_events.iter() {
         info!(
-            "Oracle observed valset update with valset_nonce {}, event_nonce {}, block_height {}, and members {:?}",
+            "Oracle observed valset update with valset_nonce={}, event_nonce={}, block_height {}, and members {:?}",
             valset_updated_event.valset_nonce,
             valset_updated_event.event_nonce,
             valset_updated_event.block_height,
@@ -175,11 +292,41 @@ pub async fn check_for_events(
         )
     }
 
+    for erc1155_deposit_event in erc1155_deposit_events.iter() {
+        info!(
+            "Oracle observed erc1155 send to cosmos event with token_contract={}, ethereum sender {}, cosmos receiver {}, amounts {:?}, and event_nonce={}",
+            format_eth_address(erc1155_deposit_event.token_contract),
+            erc1155_deposit_event.sender,
+            erc1155_deposit_event.destination,
+            erc1155_deposit_event.amounts,
+            erc1155_deposit_event.event_nonce
+        );
+    }
+
+    for erc1155_batch_event in erc1155_batch_events.iter() {
+        info!(
+            "Oracle observed erc1155 batch with batch_nonce={}, token_contract={}, and event_nonce={}",
+            erc1155_batch_event.batch_nonce,
+            format_eth_address(erc1155_batch_event.token_contract),
+            erc1155_batch_event.event_nonce
+        );
+    }
+
+    for contract_version_event in contract_version_events.iter() {
+        info!(
+            "Oracle observed contract version {} with event_nonce={}",
+            contract_version_event.version, contract_version_event.event_nonce
+        );
+    }
+
     if !erc20_deployed_events.is_empty()
         || !logic_call_events.is_empty()
         || !send_to_cosmos_events.is_empty()
         || !transaction_batch_events.is_empty()
         || !valset_updated_events.is_empty()
+        || !erc1155_deposit_events.is_empty()
+        || !erc1155_batch_events.is_empty()
+        || !contract_version_events.is_empty()
     {
         let messages = build::ethereum_event_messages(
             contact,
@@ -189,6 +336,10 @@ pub async fn check_for_events(
             erc20_deployed_events.to_owned(),
             logic_call_events.to_owned(),
             valset_updated_events.to_owned(),
+            erc1155_deposit_events.to_owned(),
+            erc1155_batch_events.to_owned(),
+            contract_version_events.to_owned(),
+            U256::from(chain_head.as_u64()),
         );
 
         info!("Sending {} messages to cosmos", messages.len());
@@ -235,11 +386,72 @@ pub async fn check_for_events(
                 format!("Claims did not process, trying to update but still on {}, trying again in a moment", last_event_nonce),
             ));
         } else {
-            info!("Claims processed, new nonce: {}", new_event_nonce);
+            info!("Claims processed, new event_nonce={}", new_event_nonce);
         }
     }
 
-    Ok(ending_block)
+    let relayed_event_nonce = erc20_deployed_events
+        .iter()
+        .map(|e| e.event_nonce)
+        .chain(logic_call_events.iter().map(|e| e.event_nonce))
+        .chain(send_to_cosmos_events.iter().map(|e| e.event_nonce))
+        .chain(transaction_batch_events.iter().map(|e| e.event_nonce))
+        .chain(valset_updated_events.iter().map(|e| e.event_nonce))
+        .chain(erc1155_deposit_events.iter().map(|e| e.event_nonce))
+        .chain(erc1155_batch_events.iter().map(|e| e.event_nonce))
+        .chain(contract_version_events.iter().map(|e| e.event_nonce))
+        .max()
+        .and_then(downcast_to_u64)
+        .unwrap_or(last_event_nonce);
+
+    Ok(CheckedEvents {
+        last_checked_block: ending_block,
+        last_event_nonce: relayed_event_nonce,
+    })
+}
+
+/// Per event type overrides of the number of confirmations the oracle waits for, event
+/// types without an override use the block delay for the chain
+#[derive(Debug, Default, Clone, Copy, PartialEq)]
+pub struct ConfirmationOverrides {
+    pub send_to_cosmos: Option<u64>,
+    pub transaction_batch: Option<u64>,
+    pub erc20_deployed: Option<u64>,
+    pub logic_call: Option<u64>,
+    pub valset_updated: Option<u64>,
+}
+
+impl ConfirmationOverrides {
+    pub fn apply(&self, block_delay: u64) -> EventConfirmations {
+        EventConfirmations {
+            send_to_cosmos: self.send_to_cosmos.unwrap_or(block_delay),
+            transaction_batch: self.transaction_batch.unwrap_or(block_delay),
+            erc20_deployed: self.erc20_deployed.unwrap_or(block_delay),
+            logic_call: self.logic_call.unwrap_or(block_delay),
+            valset_updated: self.valset_updated.unwrap_or(block_delay),
+        }
+    }
+}
+
+/// Returns the number of the newest block that is final with the given finality, the chain
+/// head when finality is counted in confirmations
+pub async fn get_final_block_number(
+    eth_client: EthClient,
+    finality: Finality,
+) -> Result<U64, GravityError> {
+    // the block tags are newer than the ethers BlockNumber type
+    let tag = match finality {
+        Finality::Confirmations => return Ok(eth_client.get_block_number().await?),
+        Finality::Safe => "safe",
+        Finality::Finalized => "finalized",
+    };
+    let block: Option<Block<TxHash>> = eth_client
+        .provider()
+        .request("eth_getBlockByNumber", (tag, false))
+        .await?;
+    block.and_then(|block| block.number).ok_or_else(|| {
+        GravityError::EthereumBadDataError(format!("Ethereum node has no {} block", tag))
+    })
 }
 
 /// The number of blocks behind the 'latest block' on Ethereum our event checking should be.
@@ -0,0 +1,243 @@
+//! Monitoring of the ETH balance of the orchestrator's Ethereum address. Relaying stops once
+//! this address runs out of ETH, so operators may configure a webhook that is alerted when the
+//! balance drops below a threshold and optionally have the ERC20 fees earned by relaying
+//! swapped back into ETH through a Uniswap V2 compatible router.
+
+use crate::metrics;
+use ethereum_gravity::erc20_utils::{
+    approve_erc20_transfers, check_erc20_approved, get_erc20_balance,
+};
+use ethereum_gravity::types::EthClient;
+use ethers::abi::parse_abi;
+use ethers::prelude::*;
+use ethers::types::Address as EthAddress;
+use gravity_utils::error::GravityError;
+use gravity_utils::ethereum::format_eth_address;
+use std::time::{Duration, SystemTime, UNIX_EPOCH};
+use tokio::time::sleep as delay_for;
+
+/// How often the balance is checked
+pub const GAS_TANK_LOOP_SPEED: Duration = Duration::from_secs(60);
+/// How long to wait for approval and swap transactions to be included
+const SWAP_TIMEOUT: Duration = Duration::from_secs(120);
+/// How long a submitted swap remains valid for
+const SWAP_DEADLINE: Duration = Duration::from_secs(600);
+
+#[derive(Clone, Debug)]
+pub struct GasTankConfig {
+    /// balance in wei below which the gas tank is considered low
+    pub low_balance_threshold: U256,
+    /// url that receives a JSON POST when the balance drops below the threshold
+    pub webhook_url: Option<String>,
+    /// if set, earned fees are swapped to ETH while the balance is low
+    pub fee_swap: Option<FeeSwapConfig>,
+}
+
+#[derive(Clone, Debug)]
+pub struct FeeSwapConfig {
+    /// a Uniswap V2 compatible router
+    pub router: EthAddress,
+    /// the wrapped ETH token the router swaps through
+    pub weth: EthAddress,
+    /// the ERC20 tokens whose whole balance is swapped to ETH
+    pub tokens: Vec<EthAddress>,
+    /// the maximum accepted slippage from the router's quote, in basis points
+    pub max_slippage_bps: u64,
+}
+
+#[derive(Serialize)]
+struct LowBalanceAlert {
+    address: String,
+    balance_wei: String,
+    threshold_wei: String,
+}
+
+pub async fn gas_tank_main_loop(eth_client: EthClient, config: GasTankConfig, dry_run: bool) {
+    let our_address = eth_client.address();
+    let http_client = reqwest::Client::new();
+    // only alert once each time the balance drops below the threshold
+    let mut alerted = false;
+
+    loop {
+        match eth_client.get_balance(our_address, None).await {
+            Ok(balance) => {
+                metrics::set_ethereum_bal(balance);
+                if balance < config.low_balance_threshold {
+                    warn!(
+                        "Ethereum balance of {} is {} wei, below the configured threshold of {} wei",
+                        format_eth_address(our_address),
+                        balance,
+                        config.low_balance_threshold
+                    );
+                    if !alerted {
+                        if let Some(webhook_url) = &config.webhook_url {
+                            send_low_balance_alert(
+                                &http_client,
+                                webhook_url,
+                                our_address,
+                                balance,
+                                config.low_balance_threshold,
+                            )
+                            .await;
+                        }
+                        alerted = true;
+                    }
+
+                    if let Some(fee_swap) = &config.fee_swap {
+                        swap_fees_for_eth(eth_client.clone(), fee_swap, dry_run).await;
+                    }
+                } else {
+                    alerted = false;
+                }
+            }
+            Err(e) => warn!("Could not get Ethereum balance {:?}", e),
+        }
+
+        delay_for(GAS_TANK_LOOP_SPEED).await;
+    }
+}
+
+async fn send_low_balance_alert(
+    http_client: &reqwest::Client,
+    webhook_url: &str,
+    address: EthAddress,
+    balance: U256,
+    threshold: U256,
+) {
+    let alert = LowBalanceAlert {
+        address: format_eth_address(address),
+        balance_wei: balance.to_string(),
+        threshold_wei: threshold.to_string(),
+    };
+
+    let res = http_client
+        .post(webhook_url)
+        .json(&alert)
+        .send()
+        .await
+        .and_then(|r| r.error_for_status());
+    if let Err(e) = res {
+        error!("Failed to send low balance alert to {}: {}", webhook_url, e);
+    }
+}
+
+/// The least ETH accepted for a swap quoted at quote wei, a slippage above 100% accepts any
+/// amount
+fn min_amount_out(quote: U256, max_slippage_bps: u64) -> U256 {
+    quote * U256::from(10000u64.saturating_sub(max_slippage_bps)) / U256::from(10000u64)
+}
+
+async fn swap_fees_for_eth(eth_client: EthClient, fee_swap: &FeeSwapConfig, dry_run: bool) {
+    for token in fee_swap.tokens.iter() {
+        if let Err(e) = swap_token_for_eth(eth_client.clone(), fee_swap, *token, dry_run).await {
+            error!("Failed to swap {} fees for ETH: {}", token, e);
+        }
+    }
+}
+
+async fn swap_token_for_eth(
+    eth_client: EthClient,
+    fee_swap: &FeeSwapConfig,
+    token: EthAddress,
+    dry_run: bool,
+) -> Result<(), GravityError> {
+    let our_address = eth_client.address();
+    let balance = get_erc20_balance(token, our_address, eth_client.clone()).await?;
+    if balance == 0u8.into() {
+        return Ok(());
+    }
+
+    let router_abi = parse_abi(&[
+        "function getAmountsOut(uint256 amountIn, address[] path) external view returns (uint256[] amounts)",
+        "function swapExactTokensForETH(uint256 amountIn, uint256 amountOutMin, address[] path, address to, uint256 deadline) external returns (uint256[] amounts)",
+    ])?;
+    let router = Contract::new(fee_swap.router, router_abi, eth_client.clone());
+    let path = vec![token, fee_swap.weth];
+
+    let amounts: Vec<U256> = router
+        .method("getAmountsOut", (balance, path.clone()))?
+        .call()
+        .await?;
+    let quote = match amounts.last() {
+        Some(quote) => *quote,
+        None => {
+            return Err(GravityError::EthereumBadDataError(
+                "router returned no amounts".to_string(),
+            ))
+        }
+    };
+    let min_amount_out = min_amount_out(quote, fee_swap.max_slippage_bps);
+
+    if dry_run {
+        info!(
+            "Dry run: would have swapped {} of {} for at least {} wei",
+            balance, token, min_amount_out
+        );
+        return Ok(());
+    }
+
+    if !check_erc20_approved(
+        token,
+        fee_swap.router,
+        our_address,
+        balance,
+        eth_client.clone(),
+    )
+    .await?
+    {
+        approve_erc20_transfers(
+            token,
+            fee_swap.router,
+            Some(SWAP_TIMEOUT),
+            eth_client.clone(),
+        )
+        .await?;
+    }
+
+    let deadline = SystemTime::now()
+        .duration_since(UNIX_EPOCH)
+        .unwrap_or_default()
+        + SWAP_DEADLINE;
+    let contract_call = router.method::<_, Vec<U256>>(
+        "swapExactTokensForETH",
+        (
+            balance,
+            min_amount_out,
+            path,
+            our_address,
+            U256::from(deadline.as_secs()),
+        ),
+    )?;
+
+    let pending_tx = contract_call.send().await?;
+    let tx_hash = *pending_tx;
+    info!(
+        "Swapping {} of {} for at least {} wei with txid {}",
+        balance, token, min_amount_out, tx_hash
+    );
+    match tokio::time::timeout(SWAP_TIMEOUT, pending_tx).await?? {
+        Some(_) => Ok(()),
+        None => Err(GravityError::GravityContractError(format!(
+            "Did not receive transaction receipt when swapping {}: {}",
+            token, tx_hash
+        ))),
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_min_amount_out() {
+        let quote = U256::exp10(18);
+        assert_eq!(min_amount_out(quote, 0), quote);
+        // 0.5%
+        assert_eq!(min_amount_out(quote, 50), U256::from(995) * U256::exp10(15));
+        assert_eq!(min_amount_out(quote, 10000), U256::zero());
+        assert_eq!(min_amount_out(quote, 20000), U256::zero());
+
+        // the result is rounded down so the swap never demands more than the quote allows
+        assert_eq!(min_amount_out(U256::from(199), 50), U256::from(198));
+    }
+}
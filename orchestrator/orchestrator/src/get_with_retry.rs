@@ -1,9 +1,11 @@
 //! Basic utility functions to stubbornly get data
+use crate::ethereum_event_watcher::get_final_block_number;
 use cosmos_gravity::query::get_last_event_nonce;
 use deep_space::address::Address as CosmosAddress;
 use ethereum_gravity::types::EthClient;
 use ethers::prelude::*;
 use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
+use gravity_proto::gravity::Finality;
 use std::time::Duration;
 use tokio::time::sleep as delay_for;
 use tonic::transport::Channel;
@@ -21,6 +23,20 @@ pub async fn get_block_number_with_retry(eth_client: EthClient) -> U64 {
     res.unwrap()
 }
 
+/// gets the newest final block number, no matter how long it takes
+pub async fn get_final_block_number_with_retry(eth_client: EthClient, finality: Finality) -> U64 {
+    let mut res = get_final_block_number(eth_client.clone(), finality).await;
+    while res.is_err() {
+        error!(
+            "Failed to get the latest {:?} block! Is your Eth node working? {:?}",
+            finality, res
+        );
+        delay_for(RETRY_TIME).await;
+        res = get_final_block_number(eth_client.clone(), finality).await;
+    }
+    res.unwrap()
+}
+
 /// gets the last event nonce, no matter how long it takes.
 pub async fn get_last_event_nonce_with_retry(
     client: &mut GravityQueryClient<Channel>,
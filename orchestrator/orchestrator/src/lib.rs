@@ -9,12 +9,18 @@
 //!   * Access to an Cosmos chain RPC server
 //!   * Access to an Ethereum chain RPC server
 
+pub mod alerts;
+pub mod chaos;
 pub mod ethereum_event_watcher;
+pub mod gas_tank;
 pub mod get_with_retry;
 pub mod main_loop;
 pub mod metrics;
+pub mod oracle_checkpoint;
 pub mod oracle_resync;
 
 #[macro_use]
 extern crate log;
+#[macro_use]
+extern crate serde_derive;
 extern crate prometheus;
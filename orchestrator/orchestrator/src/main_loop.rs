@@ -3,33 +3,47 @@
 //! the 'Orchestrator' runs not only these two roles but also the untrusted role of a relayer, that does not need any permissions
 //! and has its own crate and binary so that anyone may run it.
 
-use crate::ethereum_event_watcher::get_block_delay;
+use crate::ethereum_event_watcher::{get_block_delay, ConfirmationOverrides};
 use crate::metrics;
 use crate::{
-    ethereum_event_watcher::check_for_events, metrics::metrics_main_loop,
-    oracle_resync::get_last_checked_block,
+    alerts::{alerts_main_loop, AlertsConfig},
+    chaos::{chaos_main_loop, ChaosConfig, Submissions},
+    ethereum_event_watcher::check_for_events,
+    gas_tank::{gas_tank_main_loop, GasTankConfig},
+    get_with_retry::{get_final_block_number_with_retry, get_last_event_nonce_with_retry},
+    metrics::metrics_main_loop,
+    oracle_checkpoint::{load_checkpoint, update_checkpoint, verify_checkpoint},
+    oracle_resync::{backfill_events, get_last_checked_block},
 };
 use cosmos_gravity::crypto::PrivateKey as CosmosPrivateKey;
 use cosmos_gravity::send::send_main_loop;
 use cosmos_gravity::{
     build,
     query::{
-        get_oldest_unsigned_logic_call, get_oldest_unsigned_transaction_batch,
-        get_oldest_unsigned_valsets,
+        get_bridge_contract, get_oldest_unsigned_logic_call, get_oldest_unsigned_valsets,
+        get_unsigned_erc1155_batches, get_unsigned_transaction_batches,
     },
 };
 use deep_space::client::ChainStatus;
 use deep_space::error::CosmosGrpcError;
 use deep_space::{Contact, Msg};
 use ethereum_gravity::types::EthClient;
+use ethereum_gravity::user_operation::Bundler;
 use ethereum_gravity::utils::get_gravity_id;
 use ethers::{prelude::*, types::Address as EthAddress};
 use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
-use gravity_utils::ethereum::bytes_to_hex_str;
+use gravity_proto::gravity::Finality;
+use gravity_utils::connection_prep::{updated_endpoints, Endpoints};
+use gravity_utils::ethereum::{bytes_to_hex_str, format_eth_address};
+use gravity_utils::health;
 use relayer::main_loop::relayer_main_loop;
+use relayer::price_provider::FeeFloor;
+use relayer::settings::RelayerSettings;
 use std::convert::TryInto;
+use std::path::PathBuf;
 use std::process::exit;
 use std::{net, time::Duration};
+use tokio::sync::watch;
 use tokio::time::sleep as delay_for;
 use tonic::transport::Channel;
 
@@ -39,11 +53,15 @@ use tonic::transport::Channel;
 pub const ETH_SIGNER_LOOP_SPEED: Duration = Duration::from_secs(11);
 pub const ETH_ORACLE_LOOP_SPEED: Duration = Duration::from_secs(13);
 
+/// The number of message sets that may be waiting for submission to Cosmos
+const MSG_QUEUE_SIZE: usize = 16;
+
 /// This loop combines the three major roles required to make
 /// up the 'Orchestrator', all three of these are async loops
 /// meaning they will occupy the same thread, but since they do
 /// very little actual cpu bound work and spend the vast majority
 /// of all execution time sleeping this shouldn't be an issue at all.
+/// RPC endpoints sent on the endpoints channel replace the connections of every loop.
 #[allow(clippy::many_single_char_names)]
 #[allow(clippy::too_many_arguments)]
 pub async fn orchestrator_main_loop(
@@ -60,8 +78,45 @@ pub async fn orchestrator_main_loop(
     gas_adjustment: f64,
     relayer_opt_out: bool,
     cosmos_msg_batch_size: u32,
+    private_relay: Option<Provider<Http>>,
+    checkpoint_file: Option<PathBuf>,
+    contract_deployment_height: u64,
+    confirmation_overrides: ConfirmationOverrides,
+    finality: Finality,
+    fee_floor: Option<FeeFloor>,
+    dry_run: bool,
+    gas_tank: Option<GasTankConfig>,
+    alerts: Option<AlertsConfig>,
+    chaos: Option<ChaosConfig>,
+    work_sharing_turn: Option<Duration>,
+    bundler: Option<Bundler>,
+    relayer_settings: Option<watch::Receiver<RelayerSettings>>,
+    endpoints: Option<watch::Receiver<Endpoints>>,
 ) {
-    let (tx, rx) = tokio::sync::mpsc::channel(1);
+    if dry_run {
+        warn!("Running in dry run mode, no transactions will be sent to Cosmos or Ethereum");
+    }
+    // the oracle's progress is not real in dry run mode since its claims are never
+    // submitted, so it must not be checkpointed
+    let checkpoint_file = if dry_run { None } else { checkpoint_file };
+
+    // messages queue up here while a Cosmos transaction is in flight and are
+    // submitted together once it is included
+    let (tx, rx) = tokio::sync::mpsc::channel(MSG_QUEUE_SIZE);
+
+    // in chaos mode the oracle's claims and the signer's confirmations each pass through
+    // a chaos stage on their way to the send loop
+    let (claims_tx, claims_rx) = tokio::sync::mpsc::channel(MSG_QUEUE_SIZE);
+    let (confirmations_tx, confirmations_rx) = tokio::sync::mpsc::channel(MSG_QUEUE_SIZE);
+    let (oracle_tx, signer_tx) = if chaos.is_some() {
+        warn!("Running in chaos mode, claims and confirmations will be delayed, dropped and reordered");
+        (claims_tx, confirmations_tx)
+    } else {
+        (tx.clone(), tx.clone())
+    };
+
+    health::register_loop(health::ORACLE_LOOP);
+    health::register_loop(health::SIGNER_LOOP);
 
     let a = send_main_loop(
         &contact,
@@ -70,6 +125,8 @@ pub async fn orchestrator_main_loop(
         rx,
         gas_adjustment,
         cosmos_msg_batch_size.try_into().unwrap(),
+        dry_run,
+        endpoints.clone(),
     );
 
     let b = eth_oracle_main_loop(
@@ -79,7 +136,12 @@ pub async fn orchestrator_main_loop(
         grpc_client.clone(),
         gravity_contract_address,
         blocks_to_search,
-        tx.clone(),
+        oracle_tx,
+        checkpoint_file,
+        contract_deployment_height,
+        confirmation_overrides,
+        finality,
+        endpoints.clone(),
     );
 
     let c = eth_signer_main_loop(
@@ -88,11 +150,52 @@ pub async fn orchestrator_main_loop(
         eth_client.clone(),
         grpc_client.clone(),
         gravity_contract_address,
-        tx.clone(),
+        signer_tx,
+        endpoints.clone(),
     );
 
     let d = metrics_main_loop(metrics_listen);
 
+    let f = async {
+        if let Some(gas_tank) = gas_tank {
+            gas_tank_main_loop(eth_client.clone(), gas_tank, dry_run).await;
+        }
+    };
+
+    // the relayer takes the fee floor and endpoints, the alerts keep their own
+    let alerts_fee_floor = fee_floor.clone();
+    let alerts_endpoints = endpoints.clone();
+    let g = async {
+        if let Some(alerts) = alerts {
+            alerts_main_loop(
+                cosmos_key,
+                contact.clone(),
+                eth_client.clone(),
+                grpc_client.clone(),
+                gravity_contract_address,
+                alerts,
+                alerts_fee_floor,
+                alerts_endpoints,
+            )
+            .await;
+        }
+    };
+
+    let h = async {
+        if let Some(chaos) = chaos {
+            futures::future::join(
+                chaos_main_loop(chaos.clone(), Submissions::Claims, claims_rx, tx.clone()),
+                chaos_main_loop(
+                    chaos,
+                    Submissions::Confirmations,
+                    confirmations_rx,
+                    tx.clone(),
+                ),
+            )
+            .await;
+        }
+    };
+
     if !relayer_opt_out {
         let e = relayer_main_loop(
             eth_client.clone(),
@@ -100,10 +203,17 @@ pub async fn orchestrator_main_loop(
             gravity_contract_address,
             eth_gas_price_multiplier,
             eth_gas_multiplier,
+            private_relay,
+            fee_floor,
+            dry_run,
+            work_sharing_turn,
+            bundler,
+            relayer_settings,
+            endpoints,
         );
-        futures::future::join5(a, b, c, d, e).await;
+        futures::future::join4(futures::future::join5(a, b, c, d, e), f, g, h).await;
     } else {
-        futures::future::join4(a, b, c, d).await;
+        futures::future::join3(futures::future::join5(a, b, c, d, f), g, h).await;
     }
 }
 
@@ -124,37 +234,95 @@ pub async fn eth_oracle_main_loop(
     gravity_contract_address: EthAddress,
     blocks_to_search: u64,
     msg_sender: tokio::sync::mpsc::Sender<Vec<Msg>>,
+    checkpoint_file: Option<PathBuf>,
+    contract_deployment_height: u64,
+    confirmation_overrides: ConfirmationOverrides,
+    finality: Finality,
+    mut endpoint_updates: Option<watch::Receiver<Endpoints>>,
 ) {
+    let mut contact = contact;
+    let mut eth_client = eth_client;
     let our_cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
-    let block_delay = match get_block_delay(eth_client.clone()).await {
-        Ok(block_delay) => block_delay,
-        Err(e) => {
-            error!(
-                "Error encountered when retrieving block delay, cannot continue: {}",
-                e
+    // a tagged block can't be reorganized away, so there is no need to wait past it
+    let block_delay = match finality {
+        Finality::Confirmations => match get_block_delay(eth_client.clone()).await {
+            Ok(block_delay) => block_delay,
+            Err(e) => {
+                error!(
+                    "Error encountered when retrieving block delay, cannot continue: {}",
+                    e
+                );
+                exit(1);
+            }
+        },
+        _ => 0u8.into(),
+    };
+    info!("Oracle using {:?} finality", finality);
+    let confirmations = confirmation_overrides.apply(block_delay.as_u64());
+    info!("Oracle waiting for event confirmations {:?}", confirmations);
+    let mut grpc_client = grpc_client;
+    let checkpoint = match checkpoint_file
+        .as_deref()
+        .and_then(|path| load_checkpoint(path, gravity_contract_address))
+    {
+        Some(checkpoint) => {
+            let last_event_nonce =
+                get_last_event_nonce_with_retry(&mut grpc_client, our_cosmos_address).await;
+            verify_checkpoint(&checkpoint, last_event_nonce)
+        }
+        None => None,
+    };
+    let last_checked_block = match checkpoint {
+        Some(last_checked_block) => {
+            info!(
+                "Oracle resuming from checkpointed block {}",
+                last_checked_block
             );
-            exit(1);
+            last_checked_block
+        }
+        None => {
+            get_last_checked_block(
+                grpc_client.clone(),
+                our_cosmos_address,
+                gravity_contract_address,
+                eth_client.clone(),
+                blocks_to_search,
+                contract_deployment_height,
+            )
+            .await
         }
     };
-    let mut last_checked_block = get_last_checked_block(
-        grpc_client.clone(),
-        our_cosmos_address,
-        gravity_contract_address,
+    let mut last_checked_block = backfill_events(
         eth_client.clone(),
+        &contact,
+        &mut grpc_client,
+        gravity_contract_address,
+        cosmos_key,
+        last_checked_block,
         blocks_to_search,
+        &confirmations,
+        finality,
+        msg_sender.clone(),
+        checkpoint_file.as_deref(),
     )
     .await;
     info!("Oracle resync complete, Oracle now operational");
-    let mut grpc_client = grpc_client;
     let mut loop_count: u32 = 0;
 
     loop {
+        if let Some(endpoints) = updated_endpoints(&mut endpoint_updates) {
+            contact = endpoints.contact;
+            eth_client = endpoints.eth_client;
+            grpc_client = endpoints.grpc;
+        }
+
         let (async_resp, _) = tokio::join!(
             async {
                 let latest_eth_block = eth_client.get_block_number().await;
                 let latest_cosmos_block = contact.get_chain_status().await;
                 match (latest_eth_block, latest_cosmos_block) {
                     (Ok(latest_eth_block), Ok(ChainStatus::Moving { block_height })) => {
+                        health::set_connection_status(true, true);
                         metrics::set_cosmos_block_height(block_height);
                         metrics::set_ethereum_block_height(latest_eth_block.as_u64());
                         trace!(
@@ -167,10 +335,20 @@ pub async fn eth_oracle_main_loop(
                         // subtract the block delay based on the environment, in order to have
                         // more confidence we are attesting to a height that has not been re-orged
                         if loop_count % HEIGHT_UPDATE_INTERVAL == 0 {
+                            check_bridge_contract(&mut grpc_client, gravity_contract_address).await;
+
+                            let final_eth_block = match finality {
+                                Finality::Confirmations => latest_eth_block,
+                                _ => {
+                                    get_final_block_number_with_retry(eth_client.clone(), finality)
+                                        .await
+                                }
+                            };
                             let messages = build::ethereum_vote_height_messages(
                                 &contact,
                                 cosmos_key,
-                                latest_eth_block - block_delay,
+                                final_eth_block - block_delay,
+                                finality,
                             )
                             .await;
 
@@ -181,26 +359,31 @@ pub async fn eth_oracle_main_loop(
                         }
                     }
                     (Ok(_latest_eth_block), Ok(ChainStatus::Syncing)) => {
+                        health::set_connection_status(true, false);
                         warn!("Cosmos node syncing, Eth oracle paused");
                         delay_for(DELAY).await;
                     }
                     (Ok(_latest_eth_block), Ok(ChainStatus::WaitingToStart)) => {
+                        health::set_connection_status(true, false);
                         warn!("Cosmos node syncing waiting for chain start, Eth oracle paused");
                         delay_for(DELAY).await;
                     }
                     (Ok(_), Err(_)) => {
                         metrics::COSMOS_UNAVAILABLE.inc();
+                        health::set_connection_status(true, false);
                         warn!("Could not contact Cosmos grpc, trying again");
                         delay_for(DELAY).await;
                     }
                     (Err(_), Ok(_)) => {
                         metrics::ETHEREUM_UNAVAILABLE.inc();
+                        health::set_connection_status(false, true);
                         warn!("Could not contact Eth node, trying again");
                         delay_for(DELAY).await;
                     }
                     (Err(_), Err(_)) => {
                         metrics::COSMOS_UNAVAILABLE.inc();
                         metrics::ETHEREUM_UNAVAILABLE.inc();
+                        health::set_connection_status(false, false);
                         error!("Could not reach Ethereum or Cosmos rpc!");
                         delay_for(DELAY).await;
                     }
@@ -215,12 +398,22 @@ pub async fn eth_oracle_main_loop(
                     cosmos_key,
                     last_checked_block,
                     blocks_to_search.into(),
-                    block_delay,
+                    &confirmations,
+                    finality,
                     msg_sender.clone(),
                 )
                 .await
                 {
-                    Ok(new_block) => last_checked_block = new_block,
+                    Ok(checked) => {
+                        health::record_loop_success(health::ORACLE_LOOP);
+                        last_checked_block = checked.last_checked_block;
+                        update_checkpoint(
+                            checkpoint_file.as_deref(),
+                            gravity_contract_address,
+                            last_checked_block,
+                            checked.last_event_nonce,
+                        );
+                    }
                     Err(e) => {
                         metrics::ETHEREUM_EVENT_CHECK_FAILURES.inc();
                         error!("Failed to get events for block range, Check your Eth node and Cosmos gRPC {:?}", e);
@@ -240,6 +433,34 @@ pub async fn eth_oracle_main_loop(
     }
 }
 
+/// Stops the orchestrator once the chain has cut over to a new Gravity contract, the
+/// events and signatures of the old contract are no longer accepted. On restart the
+/// orchestrator picks up the new contract from the chain.
+async fn check_bridge_contract(
+    grpc_client: &mut GravityQueryClient<Channel>,
+    gravity_contract_address: EthAddress,
+) {
+    let contract = match get_bridge_contract(grpc_client).await {
+        Ok(contract) => contract,
+        Err(e) => {
+            warn!("Could not query the bridge contract {:?}", e);
+            return;
+        }
+    };
+    let address = match contract.bridge_ethereum_address.parse::<EthAddress>() {
+        Ok(address) => address,
+        Err(_) => return,
+    };
+    if contract.epoch > 0 && address != gravity_contract_address {
+        error!(
+            "The chain migrated to Gravity contract {} in bridging epoch {}, restart the orchestrator to follow it",
+            format_eth_address(address),
+            contract.epoch
+        );
+        exit(1);
+    }
+}
+
 /// The eth_signer simply signs off on any batches or validator sets provided by the validator
 /// since these are provided directly by a trusted Cosmsos node they can simply be assumed to be
 /// valid and signed off on.
@@ -251,7 +472,10 @@ pub async fn eth_signer_main_loop(
     grpc_client: GravityQueryClient<Channel>,
     contract_address: EthAddress,
     msg_sender: tokio::sync::mpsc::Sender<Vec<Msg>>,
+    mut endpoint_updates: Option<watch::Receiver<Endpoints>>,
 ) {
+    let mut contact = contact;
+    let mut eth_client = eth_client;
     let our_cosmos_address = cosmos_key.to_address(&contact.get_prefix()).unwrap();
     let mut grpc_client = grpc_client;
 
@@ -263,12 +487,19 @@ pub async fn eth_signer_main_loop(
     let gravity_id = gravity_id.unwrap();
 
     loop {
+        if let Some(endpoints) = updated_endpoints(&mut endpoint_updates) {
+            contact = endpoints.contact;
+            eth_client = endpoints.eth_client;
+            grpc_client = endpoints.grpc;
+        }
+
         let (async_resp, _) = tokio::join!(
             async {
                 let latest_eth_block = eth_client.get_block_number().await;
                 let latest_cosmos_block = contact.get_chain_status().await;
                 match (latest_eth_block, latest_cosmos_block) {
                     (Ok(latest_eth_block), Ok(ChainStatus::Moving { block_height })) => {
+                        health::set_connection_status(true, true);
                         metrics::set_cosmos_block_height(block_height);
                         metrics::set_ethereum_block_height(latest_eth_block.as_u64());
                         trace!(
@@ -278,26 +509,31 @@ pub async fn eth_signer_main_loop(
                         );
                     }
                     (Ok(_latest_eth_block), Ok(ChainStatus::Syncing)) => {
+                        health::set_connection_status(true, false);
                         warn!("Cosmos node syncing, Eth signer paused");
                         delay_for(DELAY).await;
                     }
                     (Ok(_latest_eth_block), Ok(ChainStatus::WaitingToStart)) => {
+                        health::set_connection_status(true, false);
                         warn!("Cosmos node syncing waiting for chain start, Eth signer paused");
                         delay_for(DELAY).await;
                     }
                     (Ok(_), Err(_)) => {
                         metrics::COSMOS_UNAVAILABLE.inc();
+                        health::set_connection_status(true, false);
                         warn!("Could not contact Cosmos grpc, trying again");
                         delay_for(DELAY).await;
                     }
                     (Err(_), Ok(_)) => {
                         metrics::ETHEREUM_UNAVAILABLE.inc();
+                        health::set_connection_status(false, true);
                         warn!("Could not contact Eth node, trying again");
                         delay_for(DELAY).await;
                     }
                     (Err(_), Err(_)) => {
                         metrics::COSMOS_UNAVAILABLE.inc();
                         metrics::ETHEREUM_UNAVAILABLE.inc();
+                        health::set_connection_status(false, false);
                         error!("Could not reach Ethereum or Cosmos rpc!");
                         delay_for(DELAY).await;
                     }
@@ -306,11 +542,14 @@ pub async fn eth_signer_main_loop(
                 // sign the last unsigned valsets
                 match get_oldest_unsigned_valsets(&mut grpc_client, our_cosmos_address).await {
                     Ok(valsets) => {
+                        metrics::set_unsigned_valsets(valsets.len());
+                        health::set_unsigned_valsets(valsets.len());
+                        health::record_loop_success(health::SIGNER_LOOP);
                         if valsets.is_empty() {
                             trace!("No validator sets to sign, node is caught up!")
                         } else {
                             info!(
-                                "Sending {} valset confirms starting with {}",
+                                "Sending {} valset confirms starting with valset_nonce={}",
                                 valsets.len(),
                                 valsets[0].nonce
                             );
@@ -338,13 +577,17 @@ pub async fn eth_signer_main_loop(
                 }
 
                 // sign the last unsigned batch, TODO check if we already have signed this
-                match get_oldest_unsigned_transaction_batch(&mut grpc_client, our_cosmos_address)
-                    .await
-                {
+                let unsigned_batches =
+                    get_unsigned_transaction_batches(&mut grpc_client, our_cosmos_address).await;
+                if let Ok(batches) = &unsigned_batches {
+                    metrics::set_unsigned_batches(batches.len());
+                    health::set_unsigned_batches(batches.len());
+                }
+                match unsigned_batches.map(|batches| batches.into_iter().next()) {
                     Ok(Some(last_unsigned_batch)) => {
                         info!(
-                            "Sending batch confirm for {}:{} fees {} timeout {}",
-                            last_unsigned_batch.token_contract,
+                            "Sending batch confirm for token_contract={} batch_nonce={} fees {} timeout {}",
+                            format_eth_address(last_unsigned_batch.token_contract),
                             last_unsigned_batch.nonce,
                             last_unsigned_batch.total_fee.amount,
                             last_unsigned_batch.batch_timeout,
@@ -373,12 +616,45 @@ pub async fn eth_signer_main_loop(
                     }
                 }
 
+                match get_unsigned_erc1155_batches(&mut grpc_client, our_cosmos_address)
+                    .await
+                    .map(|batches| batches.into_iter().next())
+                {
+                    Ok(Some(last_unsigned_batch)) => {
+                        info!(
+                            "Sending erc1155 batch confirm for token_contract={} batch_nonce={} timeout {}",
+                            format_eth_address(last_unsigned_batch.token_contract),
+                            last_unsigned_batch.nonce,
+                            last_unsigned_batch.batch_timeout,
+                        );
+                        let messages = build::erc1155_batch_tx_confirmation_messages(
+                            &contact,
+                            eth_client.clone(),
+                            vec![last_unsigned_batch],
+                            cosmos_key,
+                            gravity_id.clone(),
+                        )
+                        .await;
+                        msg_sender
+                            .send(messages)
+                            .await
+                            .expect("Could not send messages");
+                    }
+                    Ok(None) => {}
+                    Err(e) => error!(
+                        "Failed to get unsigned ERC1155 Batches, check your Cosmos gRPC {:?}",
+                        e
+                    ),
+                }
+
                 let logic_calls =
                     get_oldest_unsigned_logic_call(&mut grpc_client, our_cosmos_address).await;
                 if let Ok(logic_calls) = logic_calls {
+                    metrics::set_unsigned_logic_calls(logic_calls.len());
+                    health::set_unsigned_logic_calls(logic_calls.len());
                     for logic_call in logic_calls {
                         info!(
-                            "Sending Logic call confirm for {}:{}",
+                            "Sending logic call confirm for invalidation_id={} invalidation_nonce={}",
                             bytes_to_hex_str(&logic_call.invalidation_id),
                             logic_call.invalidation_nonce
                         );
@@ -1,11 +1,18 @@
-use std::{convert::TryInto, net};
+use std::{convert::TryInto, net, time::Duration};
 
 use axum::prelude::*;
 use ethers::prelude::*;
-use hyper::Server;
+use gravity_utils::health;
+use hyper::{Server, StatusCode};
 use lazy_static::lazy_static;
 use prometheus::*;
 
+/// Loops that haven't completed an iteration successfully for this long are reported
+/// as unhealthy on /healthz
+pub const HEALTH_MAX_LOOP_AGE: Duration = Duration::from_secs(300);
+
+/// Serves prometheus metrics on / and /metrics, and a JSON health report on /healthz
+/// that responds with 503 while the orchestrator is unhealthy
 pub async fn metrics_main_loop(addr: &net::SocketAddr) {
     let get_metrics = || async {
         let mut buffer = Vec::new();
@@ -15,7 +22,19 @@ pub async fn metrics_main_loop(addr: &net::SocketAddr) {
         String::from_utf8(buffer.clone()).unwrap()
     };
 
-    let app = route("/", get(get_metrics));
+    let get_health = || async {
+        let report = health::health_report(HEALTH_MAX_LOOP_AGE);
+        let status = if report.healthy {
+            StatusCode::OK
+        } else {
+            StatusCode::SERVICE_UNAVAILABLE
+        };
+        (status, serde_json::to_string(&report).unwrap())
+    };
+
+    let app = route("/", get(get_metrics))
+        .route("/metrics", get(get_metrics))
+        .route("/healthz", get(get_health));
 
     info!("metrics listening on {}", addr);
     Server::bind(addr)
@@ -162,6 +181,30 @@ lazy_static! {
         labels! {"chain" => "ethereum"}
     ))
     .unwrap();
+    static ref CLAIM_LAG: IntGauge = register_int_gauge!(opts!(
+        "claim_lag",
+        "difference between the gravity contract event nonce and the last event nonce committed by this validator",
+        labels! {"chain" => "cosmos"}
+    ))
+    .unwrap();
+    static ref UNSIGNED_BATCHES: IntGauge = register_int_gauge!(opts!(
+        "unsigned_batches",
+        "batches awaiting a signature from this validator",
+        labels! {"chain" => "cosmos"}
+    ))
+    .unwrap();
+    static ref UNSIGNED_LOGIC_CALLS: IntGauge = register_int_gauge!(opts!(
+        "unsigned_logic_calls",
+        "logic calls awaiting a signature from this validator",
+        labels! {"chain" => "cosmos"}
+    ))
+    .unwrap();
+    static ref UNSIGNED_VALSETS: IntGauge = register_int_gauge!(opts!(
+        "unsigned_valsets",
+        "valsets awaiting a signature from this validator",
+        labels! {"chain" => "cosmos"}
+    ))
+    .unwrap();
     static ref ETHEREUM_BAL: IntGauge = register_int_gauge!(opts!(
         "ethereum_balance",
         "orchestrator_key current ethereum_balance",
@@ -239,6 +282,25 @@ pub fn set_ethereum_bal(v: U256) {
     set_u256(&ETHEREUM_BAL, v);
 }
 
+// Unlike the gauges above, these can decrease so they are set unconditionally
+
+pub fn set_claim_lag(ethereum_event_nonce: u64, cosmos_event_nonce: u64) {
+    let lag = ethereum_event_nonce.saturating_sub(cosmos_event_nonce);
+    CLAIM_LAG.set(lag.try_into().unwrap_or(-1));
+}
+
+pub fn set_unsigned_batches(v: usize) {
+    UNSIGNED_BATCHES.set(v.try_into().unwrap_or(-1));
+}
+
+pub fn set_unsigned_logic_calls(v: usize) {
+    UNSIGNED_LOGIC_CALLS.set(v.try_into().unwrap_or(-1));
+}
+
+pub fn set_unsigned_valsets(v: usize) {
+    UNSIGNED_VALSETS.set(v.try_into().unwrap_or(-1));
+}
+
 fn set_u64(gauge: &IntGauge, value: u64) {
     let v = value.try_into().unwrap_or(-1);
     if v > gauge.get() {
@@ -252,3 +314,33 @@ fn set_u256(gauge: &IntGauge, value: U256) {
         gauge.set(v);
     }
 }
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_set_claim_lag() {
+        set_claim_lag(12, 10);
+        assert_eq!(CLAIM_LAG.get(), 2);
+
+        // the lag shrinks as the validator catches up, unlike the height gauges
+        set_claim_lag(12, 12);
+        assert_eq!(CLAIM_LAG.get(), 0);
+
+        // the contract nonce lags behind the chain's while the Ethereum node is syncing
+        set_claim_lag(9, 12);
+        assert_eq!(CLAIM_LAG.get(), 0);
+    }
+
+    #[test]
+    fn test_set_u64_only_increases() {
+        let gauge = IntGauge::new("test_set_u64", "test gauge").unwrap();
+        set_u64(&gauge, 5);
+        set_u64(&gauge, 3);
+        assert_eq!(gauge.get(), 5);
+
+        set_u256(&gauge, U256::from(7));
+        assert_eq!(gauge.get(), 7);
+    }
+}
@@ -0,0 +1,157 @@
+//! Persists the last Ethereum block the oracle has fully scanned and relayed events for, so that
+//! a restarted orchestrator can resume from where it stopped instead of searching the chain
+//! history for its last relayed event. Relaying only succeeds once the claims land on chain,
+//! so a checkpoint also records the event nonce it expects the validator to have submitted and
+//! is only trusted once the chain has caught up with it.
+
+use ethers::types::{Address as EthAddress, U64};
+use std::fs;
+use std::io;
+use std::path::Path;
+
+#[derive(Debug, Clone, PartialEq, Serialize, Deserialize)]
+pub struct OracleCheckpoint {
+    /// the Gravity contract the checkpoint was taken for, a checkpoint for any
+    /// other contract is ignored
+    pub gravity_contract: EthAddress,
+    pub last_checked_block: u64,
+    /// the highest event nonce relayed for the blocks up to last_checked_block, missing
+    /// from checkpoints written by older versions which are then not trusted
+    #[serde(default)]
+    pub last_event_nonce: Option<u64>,
+}
+
+/// Loads the checkpoint stored at path, returning None if there is no usable checkpoint
+/// for the provided Gravity contract
+pub fn load_checkpoint(path: &Path, gravity_contract: EthAddress) -> Option<OracleCheckpoint> {
+    let contents = match fs::read_to_string(path) {
+        Ok(contents) => contents,
+        Err(e) if e.kind() == io::ErrorKind::NotFound => return None,
+        Err(e) => {
+            warn!("Could not read oracle checkpoint {}: {}", path.display(), e);
+            return None;
+        }
+    };
+
+    let checkpoint: OracleCheckpoint = match serde_json::from_str(&contents) {
+        Ok(checkpoint) => checkpoint,
+        Err(e) => {
+            warn!(
+                "Ignoring invalid oracle checkpoint {}: {}",
+                path.display(),
+                e
+            );
+            return None;
+        }
+    };
+
+    if checkpoint.gravity_contract != gravity_contract {
+        warn!(
+            "Ignoring oracle checkpoint {} taken for Gravity contract {}",
+            path.display(),
+            checkpoint.gravity_contract
+        );
+        return None;
+    }
+
+    Some(checkpoint)
+}
+
+/// Returns the block to resume from if the checkpoint is trustworthy given the event nonce
+/// last submitted by the validator on chain. A checkpoint ahead of the chain means claims
+/// sent before it was written never landed, and resuming from it would skip their events.
+pub fn verify_checkpoint(checkpoint: &OracleCheckpoint, last_event_nonce: u64) -> Option<U64> {
+    match checkpoint.last_event_nonce {
+        Some(expected) if expected <= last_event_nonce => {
+            Some(checkpoint.last_checked_block.into())
+        }
+        Some(expected) => {
+            warn!(
+                "Ignoring oracle checkpoint at block {}, it expects event_nonce={} but the last submitted event_nonce={}",
+                checkpoint.last_checked_block, expected, last_event_nonce
+            );
+            None
+        }
+        None => None,
+    }
+}
+
+/// Stores the checkpoint if a checkpoint file is configured, logging rather than failing
+/// on errors since the checkpoint is only an optimization
+pub fn update_checkpoint(
+    path: Option<&Path>,
+    gravity_contract: EthAddress,
+    last_checked_block: U64,
+    last_event_nonce: u64,
+) {
+    if let Some(path) = path {
+        if let Err(e) =
+            save_checkpoint(path, gravity_contract, last_checked_block, last_event_nonce)
+        {
+            warn!("Could not save oracle checkpoint {}: {}", path.display(), e);
+        }
+    }
+}
+
+/// Stores the checkpoint at path. The checkpoint is written to a temporary file first and
+/// then renamed over the previous one so a crash mid-write can not corrupt it.
+pub fn save_checkpoint(
+    path: &Path,
+    gravity_contract: EthAddress,
+    last_checked_block: U64,
+    last_event_nonce: u64,
+) -> io::Result<()> {
+    let checkpoint = OracleCheckpoint {
+        gravity_contract,
+        last_checked_block: last_checked_block.as_u64(),
+        last_event_nonce: Some(last_event_nonce),
+    };
+    let contents = serde_json::to_string(&checkpoint)?;
+
+    let tmp_path = path.with_extension("tmp");
+    fs::write(&tmp_path, contents)?;
+    fs::rename(&tmp_path, path)
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    fn checkpoint(last_event_nonce: Option<u64>) -> OracleCheckpoint {
+        OracleCheckpoint {
+            gravity_contract: EthAddress::repeat_byte(1),
+            last_checked_block: 1000,
+            last_event_nonce,
+        }
+    }
+
+    #[test]
+    fn test_verify_checkpoint() {
+        let block: U64 = 1000u64.into();
+        assert_eq!(verify_checkpoint(&checkpoint(Some(5)), 5), Some(block));
+        assert_eq!(verify_checkpoint(&checkpoint(Some(5)), 7), Some(block));
+        // claims relayed before the checkpoint was written never landed
+        assert_eq!(verify_checkpoint(&checkpoint(Some(5)), 4), None);
+        // checkpoints from older versions can not be verified
+        assert_eq!(verify_checkpoint(&checkpoint(None), 7), None);
+    }
+
+    #[test]
+    fn test_checkpoint_round_trip() {
+        let path = std::env::temp_dir().join(format!("oracle-checkpoint-{}", std::process::id()));
+        let contract = EthAddress::repeat_byte(1);
+        save_checkpoint(&path, contract, 1000u64.into(), 5).unwrap();
+
+        assert_eq!(load_checkpoint(&path, contract), Some(checkpoint(Some(5))));
+        assert_eq!(load_checkpoint(&path, EthAddress::repeat_byte(2)), None);
+
+        // checkpoints written before event nonces were recorded still load
+        fs::write(
+            &path,
+            r#"{"gravity_contract":"0x0101010101010101010101010101010101010101","last_checked_block":1000}"#,
+        )
+        .unwrap();
+        assert_eq!(load_checkpoint(&path, contract), Some(checkpoint(None)));
+        fs::remove_file(&path).unwrap();
+    }
+}
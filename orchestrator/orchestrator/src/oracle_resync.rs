@@ -1,29 +1,40 @@
+use cosmos_gravity::crypto::PrivateKey as CosmosPrivateKey;
 use deep_space::address::Address as CosmosAddress;
+use deep_space::{Contact, Msg};
 use ethereum_gravity::types::EthClient;
 use ethers::prelude::*;
 use ethers::types::Address as EthAddress;
 use gravity_abi::gravity::*;
 use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
+use gravity_proto::gravity::Finality;
 use gravity_utils::types::{
-    Erc20DeployedEvent, LogicCallExecutedEvent, SendToCosmosEvent, TransactionBatchExecutedEvent,
-    ValsetUpdatedEvent,
+    ContractVersionEvent, Erc1155BatchExecutedEvent, Erc20DeployedEvent, EventConfirmations,
+    LogicCallExecutedEvent, SendErc1155ToCosmosEvent, SendToCosmosEvent,
+    TransactionBatchExecutedEvent, ValsetUpdatedEvent,
 };
 use gravity_utils::types::{FromLog, FromLogWithPrefix};
+use std::path::Path;
 use tokio::time::sleep as delay_for;
 use tonic::transport::Channel;
 
+use crate::ethereum_event_watcher::check_for_events;
 use crate::get_with_retry::get_block_number_with_retry;
+use crate::get_with_retry::get_final_block_number_with_retry;
 use crate::get_with_retry::get_last_event_nonce_with_retry;
 use crate::get_with_retry::RETRY_TIME;
+use crate::oracle_checkpoint::update_checkpoint;
 
 /// This function retrieves the last event nonce that we have relayed to Cosmos
-/// it then uses the Ethereum indexes to find what block the last event we relayed is in
+/// it then uses the Ethereum indexes to find what block the last event we relayed is in.
+/// If the Gravity contract deployment height is known (non-zero) the search never goes
+/// further back than it, and an oracle that has never relayed an event starts there directly.
 pub async fn get_last_checked_block(
     grpc_client: GravityQueryClient<Channel>,
     our_cosmos_address: CosmosAddress,
     gravity_contract_address: EthAddress,
     eth_client: EthClient,
     blocks_to_search: u64,
+    contract_deployment_height: u64,
 ) -> U64 {
     // TODO(bolten): original version of this used a 120 second timeout when querying
     // the eth chain, should we replicate that in eth_client?
@@ -37,6 +48,13 @@ pub async fn get_last_checked_block(
     // zero event nonce (it's pre-incremented in the solidity contract) we have to go
     // and look for event nonce one.
     if last_event_nonce == 0u8.into() {
+        if contract_deployment_height > 0 {
+            info!(
+                "Oracle has not relayed any events, starting from the Gravity contract deployment at block {}",
+                contract_deployment_height
+            );
+            return contract_deployment_height.into();
+        }
         last_event_nonce = 1u8.into();
     }
 
@@ -57,17 +75,29 @@ pub async fn get_last_checked_block(
     let mut valset_updated_filter = Filter::new()
         .address(filter_gravity_contract_address.clone())
         .event(&ValsetUpdatedEventFilter::abi_signature());
+    let mut erc1155_deposit_filter = Filter::new()
+        .address(filter_gravity_contract_address.clone())
+        .event(&SendErc1155ToCosmosEventFilter::abi_signature());
+    let mut erc1155_batch_filter = Filter::new()
+        .address(filter_gravity_contract_address.clone())
+        .event(&Erc1155BatchExecutedEventFilter::abi_signature());
+    let mut contract_version_filter = Filter::new()
+        .address(filter_gravity_contract_address.clone())
+        .event(&ContractVersionEventFilter::abi_signature());
 
     let mut end_search_block = get_block_number_with_retry(eth_client.clone()).await;
     let blocks_to_search: U64 = blocks_to_search.into();
+    let contract_deployment_height: U64 = contract_deployment_height.into();
 
-    while end_search_block > 0u8.into() {
+    while end_search_block > contract_deployment_height {
         info!(
             "Oracle is resyncing, looking back into the history to find our last event nonce {}, on block {}",
             last_event_nonce, end_search_block
         );
 
-        let start_search_block = end_search_block.saturating_sub(blocks_to_search);
+        let start_search_block = end_search_block
+            .saturating_sub(blocks_to_search)
+            .max(contract_deployment_height);
         let search_range = start_search_block..end_search_block;
 
         // select uses an inclusive version of the range
@@ -76,11 +106,17 @@ pub async fn get_last_checked_block(
         send_to_cosmos_filter = send_to_cosmos_filter.select(search_range.clone());
         transaction_batch_filter = transaction_batch_filter.select(search_range.clone());
         valset_updated_filter = valset_updated_filter.select(search_range.clone());
+        erc1155_deposit_filter = erc1155_deposit_filter.select(search_range.clone());
+        erc1155_batch_filter = erc1155_batch_filter.select(search_range.clone());
+        contract_version_filter = contract_version_filter.select(search_range.clone());
 
         let erc20_deployed_events = eth_client.get_logs(&erc20_deployed_filter).await;
         let logic_call_events = eth_client.get_logs(&logic_call_filter).await;
         let send_to_cosmos_events = eth_client.get_logs(&send_to_cosmos_filter).await;
         let transaction_batch_events = eth_client.get_logs(&transaction_batch_filter).await;
+        let erc1155_deposit_events = eth_client.get_logs(&erc1155_deposit_filter).await;
+        let erc1155_batch_events = eth_client.get_logs(&erc1155_batch_filter).await;
+        let contract_version_events = eth_client.get_logs(&contract_version_filter).await;
         // valset update events have one special property that is useful to us in this handler:
         // a valset update event for nonce 0 is emitted in the contract constructor meaning once you
         // find that event you can exit the search with confidence that you have not missed any events
@@ -91,6 +127,9 @@ pub async fn get_last_checked_block(
             || logic_call_events.is_err()
             || send_to_cosmos_events.is_err()
             || transaction_batch_events.is_err()
+            || erc1155_deposit_events.is_err()
+            || erc1155_batch_events.is_err()
+            || contract_version_events.is_err()
             || valset_updated_events.is_err()
         {
             error!("Failed to get blockchain events while resyncing, is your Eth node working? If you see only one of these it's fine");
@@ -102,6 +141,9 @@ pub async fn get_last_checked_block(
         let logic_call_events = logic_call_events.unwrap();
         let send_to_cosmos_events = send_to_cosmos_events.unwrap();
         let transaction_batch_events = transaction_batch_events.unwrap();
+        let erc1155_deposit_events = erc1155_deposit_events.unwrap();
+        let erc1155_batch_events = erc1155_batch_events.unwrap();
+        let contract_version_events = contract_version_events.unwrap();
         let mut valset_updated_events = valset_updated_events.unwrap();
 
         // look for and return the block number of the event last seen on the Cosmos chain
@@ -176,6 +218,55 @@ pub async fn get_last_checked_block(
             }
         }
 
+        for event in erc1155_deposit_events {
+            let prefix = our_cosmos_address.get_prefix();
+            match SendErc1155ToCosmosEvent::from_log(&event, prefix.as_str()) {
+                Ok(send) => {
+                    trace!(
+                        "{} erc1155 send to Cosmos event nonce, {} last event nonce",
+                        send.event_nonce,
+                        last_event_nonce
+                    );
+                    if send.event_nonce == last_event_nonce && event.block_number.is_some() {
+                        return event.block_number.unwrap();
+                    }
+                }
+                Err(e) => error!("Got SendERC1155ToCosmosEvent that we can't parse: {}", e),
+            }
+        }
+
+        for event in erc1155_batch_events {
+            match Erc1155BatchExecutedEvent::from_log(&event) {
+                Ok(batch) => {
+                    trace!(
+                        "{} erc1155 batch event nonce, {} last event nonce",
+                        batch.event_nonce,
+                        last_event_nonce
+                    );
+                    if batch.event_nonce == last_event_nonce && event.block_number.is_some() {
+                        return event.block_number.unwrap();
+                    }
+                }
+                Err(e) => error!("Got ERC1155BatchExecutedEvent that we can't parse: {}", e),
+            }
+        }
+
+        for event in contract_version_events {
+            match ContractVersionEvent::from_log(&event) {
+                Ok(version) => {
+                    trace!(
+                        "{} contract version event nonce, {} last event nonce",
+                        version.event_nonce,
+                        last_event_nonce
+                    );
+                    if version.event_nonce == last_event_nonce && event.block_number.is_some() {
+                        return event.block_number.unwrap();
+                    }
+                }
+                Err(e) => error!("Got ContractVersionEvent that we can't parse: {}", e),
+            }
+        }
+
         // this reverse solves a very specific bug, we use the properties of the first valsets for edgecase
         // handling here, but events come in chronological order, so if we don't reverse the iterator
         // we will encounter the first validator sets first and exit early and incorrectly.
@@ -218,3 +309,67 @@ pub async fn get_last_checked_block(
     // the entire history to 'prove' it.
     panic!("You have reached the end of block history without finding the Gravity contract deploy event! You must have the wrong contract address!");
 }
+
+/// Scans and relays events from last_checked_block up to the latest block one window of
+/// blocks_to_search at a time, without the oracle loop delay in between windows, so that an
+/// oracle starting far behind the chain head catches up quickly. Progress is checkpointed
+/// after every window so a crash during the backfill does not rescan relayed blocks.
+#[allow(clippy::too_many_arguments)]
+pub async fn backfill_events(
+    eth_client: EthClient,
+    contact: &Contact,
+    grpc_client: &mut GravityQueryClient<Channel>,
+    gravity_contract_address: EthAddress,
+    cosmos_key: CosmosPrivateKey,
+    last_checked_block: U64,
+    blocks_to_search: u64,
+    confirmations: &EventConfirmations,
+    finality: Finality,
+    msg_sender: tokio::sync::mpsc::Sender<Vec<Msg>>,
+    checkpoint_file: Option<&Path>,
+) -> U64 {
+    let mut last_checked_block = last_checked_block;
+    loop {
+        let latest_block = get_final_block_number_with_retry(eth_client.clone(), finality).await;
+        let latest_block = latest_block.saturating_sub(confirmations.min().into());
+        if latest_block.saturating_sub(last_checked_block) <= blocks_to_search.into() {
+            return last_checked_block;
+        }
+
+        info!(
+            "Oracle is backfilling events from block {}, latest block is {}",
+            last_checked_block, latest_block
+        );
+        match check_for_events(
+            eth_client.clone(),
+            contact,
+            grpc_client,
+            gravity_contract_address,
+            cosmos_key,
+            last_checked_block,
+            blocks_to_search.into(),
+            confirmations,
+            finality,
+            msg_sender.clone(),
+        )
+        .await
+        {
+            Ok(checked) => {
+                last_checked_block = checked.last_checked_block;
+                update_checkpoint(
+                    checkpoint_file,
+                    gravity_contract_address,
+                    last_checked_block,
+                    checked.last_event_nonce,
+                );
+            }
+            Err(e) => {
+                error!(
+                    "Failed to backfill events, check your Eth node and Cosmos gRPC {:?}",
+                    e
+                );
+                delay_for(RETRY_TIME).await;
+            }
+        }
+    }
+}
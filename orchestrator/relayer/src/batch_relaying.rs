@@ -1,13 +1,15 @@
+use crate::price_provider::FeeFloor;
+use crate::work_sharing::{batch_id, WorkSharing};
 use cosmos_gravity::query::get_latest_transaction_batches;
 use cosmos_gravity::query::get_transaction_batch_signatures;
 use ethereum_gravity::{
-    one_eth_f32, submit_batch::send_eth_transaction_batch, types::EthClient,
-    utils::get_tx_batch_nonce,
+    submit_batch::send_eth_transaction_batch, types::EthClient, user_operation::Bundler,
+    utils::get_tx_batch_nonce, utils::get_valset_nonce,
 };
 use ethers::prelude::*;
 use ethers::types::Address as EthAddress;
 use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
-use gravity_utils::ethereum::downcast_to_f32;
+use gravity_utils::ethereum::{downcast_to_f32, format_eth_address};
 use gravity_utils::message_signatures::encode_tx_batch_confirm_hashed;
 use gravity_utils::types::{BatchConfirmResponse, TransactionBatch, Valset};
 use rayon::iter::{IntoParallelRefMutIterator, ParallelIterator};
@@ -27,7 +29,10 @@ struct SubmittableBatch {
 /// far as signatures and then make requests to Ethereum to determine which are
 /// valid to submit given the current chain state. From there we simulate a submission
 /// and if that succeeds and we like the gas cost we complete the relaying process and
-/// actually submit the data to Ethereum
+/// actually submit the data to Ethereum. If a fee floor is provided batches whose fees
+/// are worth less than the floor requires are not submitted, and if work sharing is enabled
+/// batches are only submitted once it's our turn. If a bundler is provided batches are
+/// submitted as ERC-4337 user operations through it
 #[allow(clippy::too_many_arguments)]
 pub async fn relay_batches(
     // the validator set currently in the contract on Ethereum
@@ -39,6 +44,12 @@ pub async fn relay_batches(
     timeout: Duration,
     eth_gas_price_multiplier: f32,
     eth_gas_multiplier: f32,
+    native_unit: f32,
+    private_relay: Option<Provider<Http>>,
+    fee_floor: Option<FeeFloor>,
+    dry_run: bool,
+    work_sharing: &mut Option<WorkSharing>,
+    bundler: Option<Bundler>,
 ) {
     let possible_batches =
         get_batches_and_signatures(current_valset.clone(), grpc_client, gravity_id.clone()).await;
@@ -53,7 +64,13 @@ pub async fn relay_batches(
         timeout,
         eth_gas_price_multiplier,
         eth_gas_multiplier,
+        native_unit,
         possible_batches,
+        private_relay,
+        fee_floor,
+        dry_run,
+        work_sharing,
+        bundler,
     )
     .await;
 }
@@ -96,14 +113,17 @@ async fn get_batches_and_signatures(
                 list.push(SubmittableBatch { batch, sigs });
             } else {
                 warn!(
-                    "Batch {}/{} can not be submitted yet, waiting for more signatures",
-                    batch.token_contract, batch.nonce
+                    "Batch token_contract={} batch_nonce={} can not be submitted yet, waiting for more signatures",
+                    format_eth_address(batch.token_contract),
+                    batch.nonce
                 );
             }
         } else {
             error!(
-                "could not get signatures for {}:{} with {:?}",
-                batch.token_contract, batch.nonce, sigs
+                "could not get signatures for token_contract={} batch_nonce={} with {:?}",
+                format_eth_address(batch.token_contract),
+                batch.nonce,
+                sigs
             );
         }
     }
@@ -136,7 +156,13 @@ async fn submit_batches(
     timeout: Duration,
     eth_gas_price_multiplier: f32,
     eth_gas_multiplier: f32,
+    native_unit: f32,
     possible_batches: HashMap<EthAddress, Vec<SubmittableBatch>>,
+    private_relay: Option<Provider<Http>>,
+    fee_floor: Option<FeeFloor>,
+    dry_run: bool,
+    work_sharing: &mut Option<WorkSharing>,
+    bundler: Option<Bundler>,
 ) {
     let ethereum_block_height = if let Ok(bn) = eth_client.get_block_number().await {
         bn
@@ -168,14 +194,38 @@ async fn submit_batches(
 
             if oldest_signed_batch.batch_timeout < ethereum_block_height.as_u64() {
                 warn!(
-                    "Batch {}/{} has timed out and can not be submitted",
-                    oldest_signed_batch.nonce, oldest_signed_batch.token_contract
+                    "Batch token_contract={} batch_nonce={} has timed out and can not be submitted",
+                    format_eth_address(oldest_signed_batch.token_contract),
+                    oldest_signed_batch.nonce
                 );
                 continue;
             }
 
             let latest_cosmos_batch_nonce = oldest_signed_batch.clone().nonce;
             if latest_cosmos_batch_nonce > latest_ethereum_batch {
+                if let Some(work_sharing) = work_sharing.as_mut() {
+                    let id = batch_id(
+                        oldest_signed_batch.token_contract,
+                        oldest_signed_batch.nonce,
+                    );
+                    if !work_sharing.should_relay(&current_valset, &id) {
+                        continue;
+                    }
+                }
+
+                if !batch_signatures_still_valid(
+                    &current_valset,
+                    &oldest_signed_batch,
+                    &oldest_signatures,
+                    &gravity_id,
+                    gravity_contract_address,
+                    eth_client.clone(),
+                )
+                .await
+                {
+                    continue;
+                }
+
                 let cost = ethereum_gravity::submit_batch::estimate_tx_batch_cost(
                     current_valset.clone(),
                     oldest_signed_batch.clone(),
@@ -205,16 +255,47 @@ async fn submit_batches(
                 let gas_as_f32 = downcast_to_f32(cost.gas).unwrap(); // same as above re: total cost
 
                 info!(
-                    "We have detected latest batch {} but latest on Ethereum is {} This batch is estimated to cost {} Gas / {:.4} ETH to submit",
+                    "We have detected latest batch_nonce={} but latest on Ethereum is {} This batch is estimated to cost {} Gas / {:.4} ETH to submit",
                     latest_cosmos_batch_nonce,
                     latest_ethereum_batch,
                     cost.gas_price.clone(),
-                    total_cost / one_eth_f32()
+                    total_cost / native_unit
                 );
 
+                if let Some(fee_floor) = &fee_floor {
+                    let cost_in_eth =
+                        total_cost * eth_gas_price_multiplier * eth_gas_multiplier / native_unit;
+                    if !fee_floor
+                        .is_profitable(
+                            &[oldest_signed_batch.total_fee.clone()],
+                            cost_in_eth.into(),
+                            eth_client.clone(),
+                        )
+                        .await
+                    {
+                        info!(
+                            "Batch token_contract={} batch_nonce={} fees are below the fee floor, skipping",
+                            format_eth_address(oldest_signed_batch.token_contract),
+                            oldest_signed_batch.nonce
+                        );
+                        continue;
+                    }
+                }
+
                 cost.gas_price = ((gas_price_as_f32 * eth_gas_price_multiplier) as u128).into();
                 cost.gas = ((gas_as_f32 * eth_gas_multiplier) as u128).into();
 
+                if dry_run {
+                    info!(
+                        "Dry run: would have submitted batch token_contract={} batch_nonce={} with {} gas at gas price {}",
+                        format_eth_address(oldest_signed_batch.token_contract),
+                        oldest_signed_batch.nonce,
+                        cost.gas,
+                        cost.gas_price
+                    );
+                    continue;
+                }
+
                 let res = send_eth_transaction_batch(
                     current_valset.clone(),
                     oldest_signed_batch,
@@ -224,6 +305,8 @@ async fn submit_batches(
                     gravity_id.clone(),
                     cost,
                     eth_client.clone(),
+                    private_relay.clone(),
+                    bundler.clone(),
                 )
                 .await;
 
@@ -234,3 +317,138 @@ async fn submit_batches(
         }
     }
 }
+
+/// Checks right before submission that the batch can still be executed against the validator
+/// set in the Gravity contract. The contract's valset nonce is queried so that a valset update
+/// landing after current_valset was fetched is noticed, and the signature array is rebuilt
+/// exactly as it will be submitted to make sure it still carries enough power. Submitting in
+/// either case would only produce a reverted transaction.
+async fn batch_signatures_still_valid(
+    current_valset: &Valset,
+    batch: &TransactionBatch,
+    sigs: &[BatchConfirmResponse],
+    gravity_id: &str,
+    gravity_contract_address: EthAddress,
+    eth_client: EthClient,
+) -> bool {
+    let contract_valset_nonce = match get_valset_nonce(gravity_contract_address, eth_client).await {
+        Ok(nonce) => nonce,
+        Err(e) => {
+            warn!(
+                "Could not get the valset nonce of the Gravity contract, not submitting batch_nonce={}: {:?}",
+                batch.nonce, e
+            );
+            return false;
+        }
+    };
+    signatures_match_contract_valset(
+        contract_valset_nonce,
+        current_valset,
+        batch,
+        sigs,
+        gravity_id,
+    )
+}
+
+/// The synchronous half of batch_signatures_still_valid, given the valset nonce currently in
+/// the Gravity contract
+fn signatures_match_contract_valset(
+    contract_valset_nonce: u64,
+    current_valset: &Valset,
+    batch: &TransactionBatch,
+    sigs: &[BatchConfirmResponse],
+    gravity_id: &str,
+) -> bool {
+    if contract_valset_nonce != current_valset.nonce {
+        info!(
+            "Valset valset_nonce={} on Ethereum supersedes the signers of batch token_contract={} batch_nonce={} at valset_nonce={}, skipping",
+            contract_valset_nonce,
+            format_eth_address(batch.token_contract),
+            batch.nonce,
+            current_valset.nonce
+        );
+        return false;
+    }
+
+    let hash = encode_tx_batch_confirm_hashed(gravity_id.to_string(), batch.clone());
+    if let Err(e) = current_valset.order_sigs(&hash, sigs) {
+        warn!(
+            "Batch token_contract={} batch_nonce={} does not have enough valid signatures for valset_nonce={}, skipping: {:?}",
+            format_eth_address(batch.token_contract),
+            batch.nonce,
+            current_valset.nonce,
+            e
+        );
+        return false;
+    }
+
+    true
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use ethers::utils::keccak256;
+    use gravity_utils::message_signatures::encode_tx_batch_confirm;
+    use gravity_utils::types::{Erc20Token, ValsetMember, TOTAL_GRAVITY_POWER};
+
+    fn test_batch() -> TransactionBatch {
+        let token_contract = EthAddress::repeat_byte(0xaa);
+        TransactionBatch {
+            nonce: 3,
+            batch_timeout: 1000,
+            transactions: Vec::new(),
+            total_fee: Erc20Token {
+                amount: 10u64.into(),
+                token_contract_address: token_contract,
+            },
+            token_contract,
+        }
+    }
+
+    async fn sign_batch(wallet: &LocalWallet, batch: &TransactionBatch) -> BatchConfirmResponse {
+        let checkpoint = keccak256(encode_tx_batch_confirm("foo".to_string(), batch.clone()));
+        BatchConfirmResponse {
+            nonce: batch.nonce,
+            token_contract: batch.token_contract,
+            ethereum_signer: wallet.address(),
+            eth_signature: wallet.sign_message(checkpoint).await.unwrap(),
+        }
+    }
+
+    #[tokio::test]
+    async fn test_signatures_match_contract_valset() {
+        let wallet: LocalWallet =
+            "0x0000000000000000000000000000000000000000000000000000000000000001"
+                .parse()
+                .unwrap();
+        let valset = Valset {
+            nonce: 5,
+            members: vec![ValsetMember {
+                power: TOTAL_GRAVITY_POWER,
+                eth_address: Some(wallet.address()),
+            }],
+        };
+        let batch = test_batch();
+        let sigs = vec![sign_batch(&wallet, &batch).await];
+
+        assert!(signatures_match_contract_valset(
+            5, &valset, &batch, &sigs, "foo"
+        ));
+        // a valset update landed on Ethereum after the valset was fetched
+        assert!(!signatures_match_contract_valset(
+            6, &valset, &batch, &sigs, "foo"
+        ));
+        // signatures made for another gravity id don't recover to the valset's members
+        assert!(!signatures_match_contract_valset(
+            5, &valset, &batch, &sigs, "bar"
+        ));
+        assert!(!signatures_match_contract_valset(
+            5,
+            &valset,
+            &batch,
+            &[],
+            "foo"
+        ));
+    }
+}
@@ -0,0 +1,212 @@
+use crate::work_sharing::{batch_id, WorkSharing};
+use cosmos_gravity::query::{get_erc1155_batch_signatures, get_latest_erc1155_batches};
+use ethereum_gravity::{
+    submit_erc1155_batch::{estimate_erc1155_batch_cost, send_eth_erc1155_batch},
+    types::EthClient,
+    utils::{get_tx_batch_nonce, get_valset_nonce},
+};
+use ethers::prelude::*;
+use ethers::types::Address as EthAddress;
+use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
+use gravity_utils::ethereum::{downcast_to_f32, format_eth_address};
+use gravity_utils::message_signatures::encode_erc1155_batch_confirm_hashed;
+use gravity_utils::types::{Erc1155Batch, Erc1155BatchConfirmResponse, Valset};
+use std::collections::BTreeMap;
+use std::time::Duration;
+use tonic::transport::Channel;
+
+/// This function relays ERC1155 batches from Cosmos to Ethereum, in the same way relay_batches
+/// relays ERC20 batches: the batches with enough signatures of the current valset are submitted
+/// oldest first for each token contract. ERC1155 batches pay no fees so, like valsets, they are
+/// relayed regardless of the fee floor, and they are always submitted directly rather than
+/// through a bundler
+#[allow(clippy::too_many_arguments)]
+pub async fn relay_erc1155_batches(
+    // the validator set currently in the contract on Ethereum
+    current_valset: Valset,
+    eth_client: EthClient,
+    grpc_client: &mut GravityQueryClient<Channel>,
+    gravity_contract_address: EthAddress,
+    gravity_id: String,
+    timeout: Duration,
+    eth_gas_price_multiplier: f32,
+    eth_gas_multiplier: f32,
+    native_unit: f32,
+    private_relay: Option<Provider<Http>>,
+    dry_run: bool,
+    work_sharing: &mut Option<WorkSharing>,
+) {
+    let latest_batches = match get_latest_erc1155_batches(grpc_client).await {
+        Ok(batches) => batches,
+        Err(e) => {
+            error!("Error while retrieving latest erc1155 batches: {:?}", e);
+            return;
+        }
+    };
+    debug!("Latest erc1155 batches {:?}", latest_batches);
+
+    // the submittable batches of each token contract, oldest first so that submitting one
+    // never invalidates another
+    let mut possible_batches: BTreeMap<
+        EthAddress,
+        BTreeMap<u64, (Erc1155Batch, Vec<Erc1155BatchConfirmResponse>)>,
+    > = BTreeMap::new();
+    for batch in latest_batches {
+        let sigs =
+            get_erc1155_batch_signatures(grpc_client, batch.nonce, batch.token_contract).await;
+        match sigs {
+            Ok(sigs) => {
+                let hash = encode_erc1155_batch_confirm_hashed(gravity_id.clone(), batch.clone());
+                if current_valset.order_sigs(&hash, &sigs).is_ok() {
+                    possible_batches
+                        .entry(batch.token_contract)
+                        .or_default()
+                        .insert(batch.nonce, (batch, sigs));
+                } else {
+                    warn!(
+                        "Erc1155Batch token_contract={} batch_nonce={} can not be submitted yet, waiting for more signatures",
+                        format_eth_address(batch.token_contract),
+                        batch.nonce
+                    );
+                }
+            }
+            Err(e) => error!(
+                "could not get signatures for erc1155 batch token_contract={} batch_nonce={} with {:?}",
+                format_eth_address(batch.token_contract),
+                batch.nonce,
+                e
+            ),
+        }
+    }
+    if possible_batches.is_empty() {
+        return;
+    }
+
+    let ethereum_block_height = if let Ok(bn) = eth_client.get_block_number().await {
+        bn
+    } else {
+        error!("Failed to get eth block height, is your eth node working?");
+        return;
+    };
+
+    for (token_contract, batches) in possible_batches {
+        let latest_ethereum_batch =
+            match get_tx_batch_nonce(gravity_contract_address, token_contract, eth_client.clone())
+                .await
+            {
+                Ok(nonce) => nonce,
+                Err(e) => {
+                    error!("Failed to get latest Ethereum erc1155 batch with {:?}", e);
+                    return;
+                }
+            };
+
+        for (nonce, (batch, sigs)) in batches {
+            if nonce <= latest_ethereum_batch {
+                continue;
+            }
+            if batch.batch_timeout < ethereum_block_height.as_u64() {
+                warn!(
+                    "Erc1155Batch token_contract={} batch_nonce={} has timed out and can not be submitted",
+                    format_eth_address(token_contract),
+                    nonce
+                );
+                continue;
+            }
+
+            if let Some(work_sharing) = work_sharing.as_mut() {
+                if !work_sharing.should_relay(&current_valset, &batch_id(token_contract, nonce)) {
+                    continue;
+                }
+            }
+
+            // a valset update landing after current_valset was fetched would make the
+            // submission revert
+            match get_valset_nonce(gravity_contract_address, eth_client.clone()).await {
+                Ok(contract_valset_nonce) if contract_valset_nonce == current_valset.nonce => {}
+                Ok(contract_valset_nonce) => {
+                    info!(
+                        "Valset valset_nonce={} on Ethereum supersedes the signers of erc1155 batch token_contract={} batch_nonce={}, skipping",
+                        contract_valset_nonce,
+                        format_eth_address(token_contract),
+                        nonce
+                    );
+                    continue;
+                }
+                Err(e) => {
+                    warn!(
+                        "Could not get the valset nonce of the Gravity contract, not submitting erc1155 batch_nonce={}: {:?}",
+                        nonce, e
+                    );
+                    continue;
+                }
+            }
+
+            let cost = estimate_erc1155_batch_cost(
+                current_valset.clone(),
+                batch.clone(),
+                &sigs,
+                gravity_contract_address,
+                gravity_id.clone(),
+                eth_client.clone(),
+            )
+            .await;
+            if cost.is_err() {
+                error!("Erc1155Batch cost estimate failed with {:?}", cost);
+                continue;
+            }
+
+            let mut cost = cost.unwrap();
+            let total_cost = downcast_to_f32(cost.get_total());
+            if total_cost.is_none() {
+                error!(
+                    "Total gas cost greater than f32 max, skipping erc1155 batch submission: {}",
+                    nonce
+                );
+                continue;
+            }
+            let total_cost = total_cost.unwrap();
+            let gas_price_as_f32 = downcast_to_f32(cost.gas_price).unwrap(); // if the total cost isn't greater, this isn't
+            let gas_as_f32 = downcast_to_f32(cost.gas).unwrap(); // same as above re: total cost
+
+            info!(
+                "We have detected latest erc1155 batch_nonce={} but latest on Ethereum is {} This batch is estimated to cost {} Gas / {:.4} ETH to submit",
+                nonce,
+                latest_ethereum_batch,
+                cost.gas_price.clone(),
+                total_cost / native_unit
+            );
+
+            cost.gas_price = ((gas_price_as_f32 * eth_gas_price_multiplier) as u128).into();
+            cost.gas = ((gas_as_f32 * eth_gas_multiplier) as u128).into();
+
+            if dry_run {
+                info!(
+                    "Dry run: would have submitted erc1155 batch token_contract={} batch_nonce={} with {} gas at gas price {}",
+                    format_eth_address(token_contract),
+                    nonce,
+                    cost.gas,
+                    cost.gas_price
+                );
+                continue;
+            }
+
+            let res = send_eth_erc1155_batch(
+                current_valset.clone(),
+                batch,
+                &sigs,
+                timeout,
+                gravity_contract_address,
+                gravity_id.clone(),
+                cost,
+                eth_client.clone(),
+                private_relay.clone(),
+            )
+            .await;
+
+            if res.is_err() {
+                warn!("Erc1155Batch submission failed with {:?}", res);
+            }
+        }
+    }
+}
@@ -1,8 +1,12 @@
 pub mod batch_relaying;
+pub mod erc1155_batch_relaying;
 pub mod find_latest_valset;
 pub mod logic_call_relaying;
 pub mod main_loop;
+pub mod price_provider;
+pub mod settings;
 pub mod valset_relaying;
+pub mod work_sharing;
 
 #[macro_use]
 extern crate log;
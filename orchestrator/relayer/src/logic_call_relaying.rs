@@ -1,11 +1,13 @@
 use crate::main_loop::LOOP_SPEED;
+use crate::price_provider::FeeFloor;
+use crate::work_sharing::{logic_call_id, WorkSharing};
 use cosmos_gravity::query::{get_latest_logic_calls, get_logic_call_signatures};
 use ethereum_gravity::logic_call::LogicCallSkips;
-use ethereum_gravity::one_eth_f32;
 use ethereum_gravity::utils::handle_contract_error;
 use ethereum_gravity::{
     logic_call::send_eth_logic_call, types::EthClient, utils::get_logic_call_nonce,
 };
+use ethers::prelude::*;
 use ethers::types::Address as EthAddress;
 use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
 use gravity_utils::ethereum::{bytes_to_hex_str, downcast_to_f32};
@@ -25,7 +27,12 @@ pub async fn relay_logic_calls(
     timeout: Duration,
     eth_gas_price_multiplier: f32,
     eth_gas_multiplier: f32,
+    native_unit: f32,
     logic_call_skips: &mut LogicCallSkips,
+    private_relay: Option<Provider<Http>>,
+    fee_floor: Option<FeeFloor>,
+    dry_run: bool,
+    work_sharing: &mut Option<WorkSharing>,
 ) {
     let latest_calls = match get_latest_logic_calls(grpc_client).await {
         Ok(calls) => {
@@ -41,7 +48,7 @@ pub async fn relay_logic_calls(
     let mut oldest_signatures: Option<Vec<LogicCallConfirmResponse>> = None;
     for call in latest_calls {
         if logic_call_skips.permanently_skipped(&call) {
-            info!("LogicCall {}/{} permanently skipped until oracle confirms or on-chain timeout after eth height {}",
+            info!("LogicCall invalidation_id={} invalidation_nonce={} permanently skipped until oracle confirms or on-chain timeout after eth height {}",
                 bytes_to_hex_str(&call.invalidation_id), call.invalidation_nonce, call.timeout
             );
             continue;
@@ -50,7 +57,7 @@ pub async fn relay_logic_calls(
         let skips_left: u64 = logic_call_skips.skips_left(&call).into();
         if skips_left > 0 {
             warn!(
-                "Skipping LogicCall {}/{} with eth timeout {}, estimated next retry after minimum of {} seconds",
+                "Skipping LogicCall invalidation_id={} invalidation_nonce={} with eth timeout {}, estimated next retry after minimum of {} seconds",
                 bytes_to_hex_str(&call.invalidation_id), call.invalidation_nonce, call.timeout, skips_left * LOOP_SPEED.as_secs()
             );
             logic_call_skips.skip(&call);
@@ -72,14 +79,14 @@ pub async fn relay_logic_calls(
                 oldest_signatures = Some(sigs);
             } else {
                 warn!(
-                    "LogicCall {}/{} can not be submitted yet, waiting for more signatures",
+                    "LogicCall invalidation_id={} invalidation_nonce={} can not be submitted yet, waiting for more signatures",
                     bytes_to_hex_str(&call.invalidation_id),
                     call.invalidation_nonce
                 );
             }
         } else {
             error!(
-                "could not get signatures for {}/{} with {:?}",
+                "could not get signatures for invalidation_id={} invalidation_nonce={} with {:?}",
                 bytes_to_hex_str(&call.invalidation_id),
                 call.invalidation_nonce,
                 sigs
@@ -109,6 +116,16 @@ pub async fn relay_logic_calls(
     let latest_ethereum_call = latest_ethereum_call.unwrap();
     let latest_cosmos_call_nonce = oldest_signed_call.clone().invalidation_nonce;
     if latest_cosmos_call_nonce > latest_ethereum_call {
+        if let Some(work_sharing) = work_sharing.as_mut() {
+            let id = logic_call_id(
+                &oldest_signed_call.invalidation_id,
+                oldest_signed_call.invalidation_nonce,
+            );
+            if !work_sharing.should_relay(&current_valset, &id) {
+                return;
+            }
+        }
+
         let cost = ethereum_gravity::logic_call::estimate_logic_call_cost(
             current_valset.clone(),
             oldest_signed_call.clone(),
@@ -145,16 +162,48 @@ pub async fn relay_logic_calls(
         let gas_as_f32 = downcast_to_f32(cost.gas).unwrap(); // same as above re: total cost
 
         info!(
-            "We have detected latest LogicCall {} but latest on Ethereum is {} This LogicCall is estimated to cost {} Gas / {:.4} ETH to submit",
+            "We have detected latest LogicCall invalidation_nonce={} but latest on Ethereum is {} This LogicCall is estimated to cost {} Gas / {:.4} ETH to submit",
             latest_cosmos_call_nonce,
             latest_ethereum_call,
             cost.gas_price.clone(),
-            total_cost / one_eth_f32(),
+            total_cost / native_unit,
         );
 
+        if let Some(fee_floor) = &fee_floor {
+            let cost_in_eth =
+                total_cost * eth_gas_price_multiplier * eth_gas_multiplier / native_unit;
+            if !fee_floor
+                .is_profitable(
+                    &oldest_signed_call.fees,
+                    cost_in_eth.into(),
+                    eth_client.clone(),
+                )
+                .await
+            {
+                info!(
+                    "LogicCall invalidation_id={} invalidation_nonce={} fees are below the fee floor, skipping",
+                    bytes_to_hex_str(&oldest_signed_call.invalidation_id),
+                    oldest_signed_call.invalidation_nonce
+                );
+                logic_call_skips.skip(&oldest_signed_call);
+                return;
+            }
+        }
+
         cost.gas_price = ((gas_price_as_f32 * eth_gas_price_multiplier) as u128).into();
         cost.gas = ((gas_as_f32 * eth_gas_multiplier) as u128).into();
 
+        if dry_run {
+            info!(
+                "Dry run: would have submitted LogicCall invalidation_id={} invalidation_nonce={} with {} gas at gas price {}",
+                bytes_to_hex_str(&oldest_signed_call.invalidation_id),
+                oldest_signed_call.invalidation_nonce,
+                cost.gas,
+                cost.gas_price
+            );
+            return;
+        }
+
         let res = send_eth_logic_call(
             current_valset,
             oldest_signed_call.clone(),
@@ -164,6 +213,7 @@ pub async fn relay_logic_calls(
             gravity_id.clone(),
             cost,
             eth_client.clone(),
+            private_relay,
             logic_call_skips,
         )
         .await;
@@ -1,22 +1,27 @@
+use std::convert::TryFrom;
 use std::sync::Arc;
+use std::time::Duration;
 
 use crate::main_loop::relayer_main_loop;
 use crate::main_loop::LOOP_SPEED;
 use docopt::Docopt;
-use env_logger::Env;
 use ethers::prelude::*;
 use ethers::signers::LocalWallet as EthWallet;
 use ethers::types::Address as EthAddress;
 use gravity_utils::{
     connection_prep::{check_for_eth, create_rpc_connections, wait_for_cosmos_node_ready},
     ethereum::{downcast_to_u64, format_eth_address},
+    signer::EthSigner,
 };
 
 pub mod batch_relaying;
 pub mod find_latest_valset;
 pub mod logic_call_relaying;
 pub mod main_loop;
+pub mod price_provider;
+pub mod settings;
 pub mod valset_relaying;
+pub mod work_sharing;
 
 #[macro_use]
 extern crate serde_derive;
@@ -32,11 +37,14 @@ struct Args {
     flag_address_prefix: String,
     flag_ethereum_rpc: String,
     flag_contract_address: String,
+    flag_private_relay_rpc: Option<String>,
+    flag_dry_run: bool,
+    flag_work_sharing_turn: Option<u64>,
 }
 
 lazy_static! {
     pub static ref USAGE: String = format!(
-    "Usage: {} --ethereum-key=<key> --cosmos-grpc=<url> --address-prefix=<prefix> --ethereum-rpc=<url> --contract-address=<addr>
+    "Usage: {} --ethereum-key=<key> --cosmos-grpc=<url> --address-prefix=<prefix> --ethereum-rpc=<url> --contract-address=<addr> [--private-relay-rpc=<url>] [--dry-run] [--work-sharing-turn=<secs>]
         Options:
             -h --help                    Show this screen.
             --ethereum-key=<ekey>        An Ethereum private key containing non-trivial funds
@@ -44,6 +52,11 @@ lazy_static! {
             --address-prefix=<prefix>    The prefix for addresses on this Cosmos chain
             --ethereum-rpc=<eurl>        The Ethereum RPC url, Geth light clients work and sync fast
             --contract-address=<addr>    The Ethereum contract address for Gravity
+            --private-relay-rpc=<url>    An optional private relay RPC url (such as Flashbots Protect)
+                                         batches and logic calls are submitted to instead of the public mempool
+            --dry-run                    Plan and log relays without submitting anything to Ethereum
+            --work-sharing-turn=<secs>   Take turns of this many seconds with the other relayers in the
+                                         validator set rather than all submitting the same batches
         About:
             The Gravity relayer component, responsible for relaying data from the Cosmos blockchain
             to the Ethereum blockchain, cosmos key and fees are optional since they are only used
@@ -59,7 +72,7 @@ lazy_static! {
 
 #[tokio::main]
 async fn main() {
-    env_logger::Builder::from_env(Env::default().default_filter_or("info")).init();
+    gravity_utils::logging::init_logger("info");
     // On Linux static builds we need to probe ssl certs path to be able to
     // do TLS stuff.
     openssl_probe::init_ssl_cert_env_vars();
@@ -89,8 +102,10 @@ async fn main() {
         .await
         .expect("Could not retrieve chain ID during relayer start");
     let chain_id = downcast_to_u64(chain_id).expect("Chain ID overflowed when downcasting to u64");
-    let eth_client =
-        SignerMiddleware::new(provider, ethereum_wallet.clone().with_chain_id(chain_id));
+    let eth_client = SignerMiddleware::new(
+        provider,
+        EthSigner::from(ethereum_wallet.with_chain_id(chain_id)),
+    );
     let eth_client = Arc::new(eth_client);
 
     let public_eth_key = eth_client.address();
@@ -105,12 +120,27 @@ async fn main() {
     wait_for_cosmos_node_ready(&contact).await;
     check_for_eth(public_eth_key, eth_client.clone()).await;
 
+    let private_relay = args.flag_private_relay_rpc.map(|url| {
+        info!(
+            "Submitting batches and logic calls through private relay {}",
+            url
+        );
+        Provider::<Http>::try_from(url.as_str()).expect("Invalid private relay RPC url")
+    });
+
     relayer_main_loop(
         eth_client,
         connections.grpc.unwrap(),
         gravity_contract_address,
         1.1f32,
         1.1f32,
+        private_relay,
+        None,
+        args.flag_dry_run,
+        args.flag_work_sharing_turn.map(Duration::from_secs),
+        None,
+        None,
+        None,
     )
     .await
 }
@@ -1,25 +1,52 @@
 use crate::{
-    batch_relaying::relay_batches, find_latest_valset::find_latest_valset,
-    logic_call_relaying::relay_logic_calls, valset_relaying::relay_valsets,
+    batch_relaying::relay_batches, erc1155_batch_relaying::relay_erc1155_batches,
+    find_latest_valset::find_latest_valset, logic_call_relaying::relay_logic_calls,
+    price_provider::FeeFloor, settings::RelayerSettings, valset_relaying::relay_valsets,
+    work_sharing::WorkSharing,
 };
-use ethereum_gravity::{logic_call::LogicCallSkips, types::EthClient, utils::get_gravity_id};
+use cosmos_gravity::query::get_native_decimals;
+use ethereum_gravity::{
+    logic_call::LogicCallSkips, one_native_f32, types::EthClient, user_operation::Bundler,
+    utils::get_gravity_id,
+};
+use ethers::prelude::*;
 use ethers::types::Address as EthAddress;
 use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
+use gravity_utils::connection_prep::{updated_endpoints, Endpoints};
+use gravity_utils::{health, metrics};
 use std::time::Duration;
+use tokio::sync::watch;
 use tonic::transport::Channel;
 
 pub const LOOP_SPEED: Duration = Duration::from_secs(17);
 pub const PENDING_TX_TIMEOUT: Duration = Duration::from_secs(120);
 
 /// This function contains the orchestrator primary loop, it is broken out of the main loop so that
-/// it can be called in the test runner for easier orchestration of multi-node tests
+/// it can be called in the test runner for easier orchestration of multi-node tests. If a private
+/// relay is provided, batches and logic calls are submitted through it instead of the public mempool.
+/// If a fee floor is provided, batches and logic calls that don't pay enough fees are not relayed.
+/// In dry run mode everything up to submission is performed but nothing is sent to Ethereum.
+/// If a work sharing turn duration is provided relayers in the validator set take turns
+/// submitting each batch and logic call instead of all racing to submit it. If a bundler is
+/// provided batches are submitted as ERC-4337 user operations so a paymaster can sponsor them.
+/// If a settings channel is provided, settings sent on it replace the gas multipliers, private
+/// relay, fee floor and bundler from the next loop iteration on, endpoints sent on the endpoints
+/// channel likewise replace the Ethereum and Cosmos gRPC connections
 #[allow(unused_variables)]
+#[allow(clippy::too_many_arguments)]
 pub async fn relayer_main_loop(
-    eth_client: EthClient,
+    mut eth_client: EthClient,
     grpc_client: GravityQueryClient<Channel>,
     gravity_contract_address: EthAddress,
-    eth_gas_price_multiplier: f32,
-    eth_gas_multiplier: f32,
+    mut eth_gas_price_multiplier: f32,
+    mut eth_gas_multiplier: f32,
+    mut private_relay: Option<Provider<Http>>,
+    mut fee_floor: Option<FeeFloor>,
+    dry_run: bool,
+    work_sharing_turn: Option<Duration>,
+    mut bundler: Option<Bundler>,
+    mut settings_updates: Option<watch::Receiver<RelayerSettings>>,
+    mut endpoint_updates: Option<watch::Receiver<Endpoints>>,
 ) {
     let mut grpc_client = grpc_client;
     let gravity_id = get_gravity_id(gravity_contract_address, eth_client.clone()).await;
@@ -28,11 +55,52 @@ pub async fn relayer_main_loop(
         return;
     }
     let gravity_id = gravity_id.unwrap();
+    // gas costs are priced in the chain's native gas token, which may not use 18 decimals
+    let native_unit = match get_native_decimals(&mut grpc_client).await {
+        Ok(decimals) => one_native_f32(decimals),
+        Err(e) => {
+            error!(
+                "Failed to get the native token decimals, check your Cosmos gRPC {:?}",
+                e
+            );
+            return;
+        }
+    };
     let mut logic_call_skips = LogicCallSkips::new();
+    let mut work_sharing =
+        work_sharing_turn.map(|turn| WorkSharing::new(eth_client.address(), turn));
+    health::register_loop(health::RELAYER_LOOP);
 
     loop {
+        if let Some(endpoints) = updated_endpoints(&mut endpoint_updates) {
+            eth_client = endpoints.eth_client;
+            grpc_client = endpoints.grpc;
+        }
+        if let Some(updates) = settings_updates.as_mut() {
+            if updates.has_changed().unwrap_or(false) {
+                let settings = updates.borrow_and_update().clone();
+                info!(
+                    "Applying new relayer settings, gas price multiplier {} gas multiplier {}",
+                    settings.eth_gas_price_multiplier, settings.eth_gas_multiplier
+                );
+                eth_gas_price_multiplier = settings.eth_gas_price_multiplier;
+                eth_gas_multiplier = settings.eth_gas_multiplier;
+                private_relay = settings.private_relay;
+                fee_floor = settings.fee_floor;
+                bundler = settings.bundler;
+            }
+        }
+
         let (async_resp, _) = tokio::join!(
             async {
+                match eth_client
+                    .get_transaction_count(eth_client.address(), None)
+                    .await
+                {
+                    Ok(nonce) => metrics::set_ethereum_nonce(nonce),
+                    Err(e) => warn!("Could not get Ethereum transaction count {:?}", e),
+                }
+
                 let current_eth_valset = find_latest_valset(
                     &mut grpc_client,
                     gravity_contract_address,
@@ -54,6 +122,8 @@ pub async fn relayer_main_loop(
                     PENDING_TX_TIMEOUT,
                     eth_gas_price_multiplier,
                     eth_gas_multiplier,
+                    native_unit,
+                    dry_run,
                 )
                 .await;
 
@@ -66,6 +136,28 @@ pub async fn relayer_main_loop(
                     PENDING_TX_TIMEOUT,
                     eth_gas_price_multiplier,
                     eth_gas_multiplier,
+                    native_unit,
+                    private_relay.clone(),
+                    fee_floor.clone(),
+                    dry_run,
+                    &mut work_sharing,
+                    bundler.clone(),
+                )
+                .await;
+
+                relay_erc1155_batches(
+                    current_eth_valset.clone(),
+                    eth_client.clone(),
+                    &mut grpc_client,
+                    gravity_contract_address,
+                    gravity_id.clone(),
+                    PENDING_TX_TIMEOUT,
+                    eth_gas_price_multiplier,
+                    eth_gas_multiplier,
+                    native_unit,
+                    private_relay.clone(),
+                    dry_run,
+                    &mut work_sharing,
                 )
                 .await;
 
@@ -78,9 +170,16 @@ pub async fn relayer_main_loop(
                     PENDING_TX_TIMEOUT,
                     eth_gas_price_multiplier,
                     eth_gas_multiplier,
+                    native_unit,
                     &mut logic_call_skips,
+                    private_relay.clone(),
+                    fee_floor.clone(),
+                    dry_run,
+                    &mut work_sharing,
                 )
                 .await;
+
+                health::record_loop_success(health::RELAYER_LOOP);
             },
             tokio::time::sleep(LOOP_SPEED)
         );
@@ -0,0 +1,395 @@
+//! Token prices used by the relayer to decide whether the fees paid by a batch or logic call
+//! cover the cost of submitting it to Ethereum. Prices are always denominated in ETH per whole
+//! token so they can be compared directly against gas costs. Several implementations are
+//! provided so operators that can't reach a particular external API can choose another.
+
+use async_trait::async_trait;
+use ethereum_gravity::types::EthClient;
+use ethers::abi::{parse_abi, Abi};
+use ethers::prelude::*;
+use ethers::types::Address as EthAddress;
+use gravity_abi::erc20::ERC20;
+use gravity_utils::error::GravityError;
+use gravity_utils::ethereum::downcast_to_f32;
+use gravity_utils::types::Erc20Token;
+use std::collections::HashMap;
+use std::fs;
+use std::path::Path;
+use std::sync::{Arc, Mutex};
+use std::time::{Duration, Instant};
+
+pub const COINGECKO_API_URL: &str = "https://api.coingecko.com/api/v3";
+/// how long a price fetched from CoinGecko is reused before it is requested again, this keeps
+/// us well within the public API rate limits
+pub const COINGECKO_CACHE_DURATION: Duration = Duration::from_secs(300);
+
+/// PriceProvider returns the price of one whole unit of an ERC20 token in ETH
+#[async_trait]
+pub trait PriceProvider: Send + Sync {
+    async fn get_price_in_eth(&self, token: EthAddress) -> Result<f64, GravityError>;
+}
+
+/// Prices from the CoinGecko token price API
+pub struct CoinGeckoPriceProvider {
+    client: reqwest::Client,
+    api_url: String,
+    api_key: Option<String>,
+    cache: Mutex<HashMap<EthAddress, (Instant, f64)>>,
+}
+
+impl CoinGeckoPriceProvider {
+    /// Creates a provider for the CoinGecko API at api_url, the api key is only
+    /// required by the pro API
+    pub fn new(api_url: String, api_key: Option<String>) -> Self {
+        CoinGeckoPriceProvider {
+            client: reqwest::Client::new(),
+            api_url: api_url.trim_end_matches('/').to_owned(),
+            api_key,
+            cache: Mutex::new(HashMap::new()),
+        }
+    }
+}
+
+#[async_trait]
+impl PriceProvider for CoinGeckoPriceProvider {
+    async fn get_price_in_eth(&self, token: EthAddress) -> Result<f64, GravityError> {
+        if let Some((fetched, price)) = self.cache.lock().unwrap().get(&token) {
+            if fetched.elapsed() < COINGECKO_CACHE_DURATION {
+                return Ok(*price);
+            }
+        }
+
+        let token_key = format!("{:?}", token);
+        let mut request = self
+            .client
+            .get(format!("{}/simple/token_price/ethereum", self.api_url))
+            .query(&[
+                ("contract_addresses", token_key.as_str()),
+                ("vs_currencies", "eth"),
+            ]);
+        if let Some(api_key) = &self.api_key {
+            request = request.header("x-cg-pro-api-key", api_key);
+        }
+
+        let response: HashMap<String, HashMap<String, f64>> = request
+            .send()
+            .await
+            .and_then(|r| r.error_for_status())
+            .map_err(|e| GravityError::PriceProviderError(e.to_string()))?
+            .json()
+            .await
+            .map_err(|e| GravityError::PriceProviderError(e.to_string()))?;
+
+        let price = coingecko_price(&response, token)?;
+        self.cache
+            .lock()
+            .unwrap()
+            .insert(token, (Instant::now(), price));
+
+        Ok(price)
+    }
+}
+
+/// Extracts a token's ETH price from a CoinGecko simple token price response, which is keyed
+/// by the lowercase token address
+fn coingecko_price(
+    response: &HashMap<String, HashMap<String, f64>>,
+    token: EthAddress,
+) -> Result<f64, GravityError> {
+    response
+        .get(&format!("{:?}", token))
+        .and_then(|prices| prices.get("eth"))
+        .copied()
+        .ok_or_else(|| {
+            GravityError::PriceProviderError(format!("CoinGecko has no price for {}", token))
+        })
+}
+
+/// Prices read on chain from Chainlink token/ETH aggregators
+pub struct ChainlinkPriceProvider {
+    eth_client: EthClient,
+    aggregator_abi: Abi,
+    // token address to the address of its token/ETH price feed
+    feeds: HashMap<EthAddress, EthAddress>,
+}
+
+impl ChainlinkPriceProvider {
+    pub fn new(eth_client: EthClient, feeds: HashMap<EthAddress, EthAddress>) -> Self {
+        let aggregator_abi = parse_abi(&[
+            "function decimals() external view returns (uint8)",
+            "function latestRoundData() external view returns (uint80, int256, uint256, uint256, uint80)",
+        ])
+        .expect("invalid Chainlink aggregator abi");
+
+        ChainlinkPriceProvider {
+            eth_client,
+            aggregator_abi,
+            feeds,
+        }
+    }
+}
+
+#[async_trait]
+impl PriceProvider for ChainlinkPriceProvider {
+    async fn get_price_in_eth(&self, token: EthAddress) -> Result<f64, GravityError> {
+        let feed = self.feeds.get(&token).ok_or_else(|| {
+            GravityError::PriceProviderError(format!("No Chainlink feed configured for {}", token))
+        })?;
+        let aggregator = Contract::new(*feed, self.aggregator_abi.clone(), self.eth_client.clone());
+
+        let decimals: u8 = aggregator.method("decimals", ())?.call().await?;
+        let (_, answer, _, _, _): (u128, I256, U256, U256, u128) =
+            aggregator.method("latestRoundData", ())?.call().await?;
+        if answer <= I256::zero() {
+            return Err(GravityError::PriceProviderError(format!(
+                "Chainlink feed {} returned non positive answer {}",
+                feed, answer
+            )));
+        }
+
+        let answer = downcast_to_f32(answer.into_raw()).ok_or_else(|| {
+            GravityError::OverflowError(format!("Chainlink feed {} answer overflowed", feed))
+        })?;
+        Ok(answer as f64 / 10f64.powi(decimals.into()))
+    }
+}
+
+/// Fixed prices loaded from a JSON file mapping token addresses to their price in ETH,
+/// for example {"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48": 0.0005}
+pub struct StaticPriceProvider {
+    prices: HashMap<EthAddress, f64>,
+}
+
+impl StaticPriceProvider {
+    pub fn new(prices: HashMap<EthAddress, f64>) -> Self {
+        StaticPriceProvider { prices }
+    }
+
+    pub fn from_file(path: &Path) -> Result<Self, GravityError> {
+        let contents = fs::read_to_string(path).map_err(|e| {
+            GravityError::PriceProviderError(format!("could not read {}: {}", path.display(), e))
+        })?;
+        let prices: HashMap<EthAddress, f64> = serde_json::from_str(&contents).map_err(|e| {
+            GravityError::PriceProviderError(format!("could not parse {}: {}", path.display(), e))
+        })?;
+
+        Ok(StaticPriceProvider::new(prices))
+    }
+}
+
+#[async_trait]
+impl PriceProvider for StaticPriceProvider {
+    async fn get_price_in_eth(&self, token: EthAddress) -> Result<f64, GravityError> {
+        self.prices.get(&token).copied().ok_or_else(|| {
+            GravityError::PriceProviderError(format!("No static price configured for {}", token))
+        })
+    }
+}
+
+/// FeeFloor decides whether the fees offered for a batch or logic call make it worth
+/// relaying, by valuing the fees with a PriceProvider and comparing them to the cost
+/// of submission
+#[derive(Clone)]
+pub struct FeeFloor {
+    price_provider: Arc<dyn PriceProvider>,
+    // the minimum ratio of fee value to submission cost
+    min_fee_ratio: f64,
+    decimals: Arc<Mutex<HashMap<EthAddress, u8>>>,
+}
+
+impl FeeFloor {
+    pub fn new(price_provider: Arc<dyn PriceProvider>, min_fee_ratio: f64) -> Self {
+        FeeFloor {
+            price_provider,
+            min_fee_ratio,
+            decimals: Arc::new(Mutex::new(HashMap::new())),
+        }
+    }
+
+    async fn get_decimals(
+        &self,
+        token: EthAddress,
+        eth_client: EthClient,
+    ) -> Result<u8, GravityError> {
+        if let Some(decimals) = self.decimals.lock().unwrap().get(&token) {
+            return Ok(*decimals);
+        }
+
+        let decimals = ERC20::new(token, eth_client).decimals().call().await?;
+        self.decimals.lock().unwrap().insert(token, decimals);
+
+        Ok(decimals)
+    }
+
+    /// Returns the total value in ETH of the provided fees
+    pub async fn get_fees_value_in_eth(
+        &self,
+        fees: &[Erc20Token],
+        eth_client: EthClient,
+    ) -> Result<f64, GravityError> {
+        let mut total = 0f64;
+        for fee in fees {
+            let token = fee.token_contract_address;
+            let price = self.price_provider.get_price_in_eth(token).await?;
+            let decimals = self.get_decimals(token, eth_client.clone()).await?;
+            let amount = downcast_to_f32(fee.amount).ok_or_else(|| {
+                GravityError::OverflowError(format!("Fee amount {} overflowed", fee.amount))
+            })?;
+
+            total += amount as f64 / 10f64.powi(decimals.into()) * price;
+        }
+
+        Ok(total)
+    }
+
+    /// Returns true if the fees are worth at least min_fee_ratio times the cost in ETH.
+    /// If the fees can't be priced the submission is not considered profitable.
+    pub async fn is_profitable(
+        &self,
+        fees: &[Erc20Token],
+        cost_in_eth: f64,
+        eth_client: EthClient,
+    ) -> bool {
+        match self.get_fees_value_in_eth(fees, eth_client).await {
+            Ok(value) => {
+                debug!(
+                    "Fees are worth {:.6} ETH against an estimated cost of {:.6} ETH",
+                    value, cost_in_eth
+                );
+                value >= cost_in_eth * self.min_fee_ratio
+            }
+            Err(e) => {
+                warn!("Could not determine the value of fees {:?}: {}", fees, e);
+                false
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use gravity_utils::signer::EthSigner;
+    use std::convert::TryFrom;
+
+    const USDC: &str = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48";
+    const LINK: &str = "0x514910771af9ca656af840dff83e8264ecf986ca";
+
+    // an Ethereum client that is never used to reach a node, decimals are cached up front
+    fn test_eth_client() -> EthClient {
+        let provider = Provider::<Http>::try_from("http://localhost:8545").unwrap();
+        let wallet: LocalWallet =
+            "0x0000000000000000000000000000000000000000000000000000000000000001"
+                .parse()
+                .unwrap();
+        Arc::new(SignerMiddleware::new(provider, EthSigner::from(wallet)))
+    }
+
+    fn test_fee_floor(min_fee_ratio: f64) -> FeeFloor {
+        let prices = vec![
+            (USDC.parse().unwrap(), 0.0005),
+            (LINK.parse().unwrap(), 0.004),
+        ];
+        let floor = FeeFloor::new(
+            Arc::new(StaticPriceProvider::new(prices.into_iter().collect())),
+            min_fee_ratio,
+        );
+        floor.decimals.lock().unwrap().extend(vec![
+            (USDC.parse().unwrap(), 6),
+            (LINK.parse().unwrap(), 18),
+        ]);
+        floor
+    }
+
+    fn fee(token: &str, amount: U256) -> Erc20Token {
+        Erc20Token {
+            amount,
+            token_contract_address: token.parse().unwrap(),
+        }
+    }
+
+    #[test]
+    fn test_coingecko_price() {
+        let response: HashMap<String, HashMap<String, f64>> =
+            serde_json::from_str(&format!(r#"{{"{}": {{"eth": 0.0005}}}}"#, USDC)).unwrap();
+
+        assert_eq!(
+            coingecko_price(&response, USDC.parse().unwrap()).unwrap(),
+            0.0005
+        );
+        assert!(coingecko_price(&response, LINK.parse().unwrap()).is_err());
+    }
+
+    #[tokio::test]
+    async fn test_static_price_provider_from_file() {
+        let path = std::env::temp_dir().join("gravity-static-prices.json");
+        fs::write(&path, format!(r#"{{"{}": 0.0005}}"#, USDC)).unwrap();
+        let provider = StaticPriceProvider::from_file(&path).unwrap();
+
+        assert_eq!(
+            provider
+                .get_price_in_eth(USDC.parse().unwrap())
+                .await
+                .unwrap(),
+            0.0005
+        );
+        assert!(provider
+            .get_price_in_eth(LINK.parse().unwrap())
+            .await
+            .is_err());
+
+        fs::write(&path, "not json").unwrap();
+        assert!(StaticPriceProvider::from_file(&path).is_err());
+        assert!(StaticPriceProvider::from_file(Path::new("/nonexistent/prices.json")).is_err());
+    }
+
+    #[tokio::test]
+    async fn test_fees_value_in_eth() {
+        let floor = test_fee_floor(1.0);
+        let fees = vec![
+            // 2000 USDC at 0.0005 ETH
+            fee(USDC, U256::from(2_000_000_000u64)),
+            // 50 LINK at 0.004 ETH
+            fee(LINK, U256::from(50) * U256::exp10(18)),
+        ];
+
+        let value = floor
+            .get_fees_value_in_eth(&fees, test_eth_client())
+            .await
+            .unwrap();
+        assert!((value - 1.2).abs() < 1e-6);
+    }
+
+    #[tokio::test]
+    async fn test_is_profitable() {
+        let fees = vec![fee(USDC, U256::from(2_000_000_000u64))];
+
+        // the fees are worth 1 ETH
+        assert!(
+            test_fee_floor(1.0)
+                .is_profitable(&fees, 1.0, test_eth_client())
+                .await
+        );
+        assert!(
+            test_fee_floor(1.5)
+                .is_profitable(&fees, 0.6, test_eth_client())
+                .await
+        );
+        assert!(
+            !test_fee_floor(1.5)
+                .is_profitable(&fees, 0.7, test_eth_client())
+                .await
+        );
+
+        // fees in a token without a price are never considered profitable
+        let unpriced = vec![fee(
+            "0x6b175474e89094c44da98b954eedeac495271d0f",
+            U256::from(1),
+        )];
+        assert!(
+            !test_fee_floor(0.0)
+                .is_profitable(&unpriced, 0.0, test_eth_client())
+                .await
+        );
+    }
+}
@@ -0,0 +1,17 @@
+//! Relayer settings that can be replaced while the relayer is running. The relayer loop
+//! takes an optional watch channel of settings and applies the latest ones at the start of
+//! each iteration, so a configuration reload changes how batches and logic calls are relayed
+//! without restarting any of the orchestrator's loops.
+
+use crate::price_provider::FeeFloor;
+use ethereum_gravity::user_operation::Bundler;
+use ethers::prelude::*;
+
+#[derive(Clone)]
+pub struct RelayerSettings {
+    pub eth_gas_price_multiplier: f32,
+    pub eth_gas_multiplier: f32,
+    pub private_relay: Option<Provider<Http>>,
+    pub fee_floor: Option<FeeFloor>,
+    pub bundler: Option<Bundler>,
+}
@@ -5,7 +5,7 @@ use std::time::Duration;
 
 use cosmos_gravity::query::get_latest_valset;
 use cosmos_gravity::query::{get_all_valset_confirms, get_valset};
-use ethereum_gravity::{one_eth_f32, types::EthClient, valset_update::send_eth_valset_update};
+use ethereum_gravity::{types::EthClient, valset_update::send_eth_valset_update};
 use ethers::types::Address as EthAddress;
 use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
 use gravity_utils::{
@@ -16,6 +16,7 @@ use tonic::transport::Channel;
 
 /// Check the last validator set on Ethereum, if it's lower than our latest validator
 /// set then we should package and submit the update as an Ethereum transaction
+#[allow(clippy::too_many_arguments)]
 pub async fn relay_valsets(
     // the validator set currently in the contract on Ethereum
     current_eth_valset: Valset,
@@ -26,6 +27,8 @@ pub async fn relay_valsets(
     timeout: Duration,
     eth_gas_price_multiplier: f32,
     eth_gas_multiplier: f32,
+    native_unit: f32,
+    dry_run: bool,
 ) {
     // we have to start with the current ethereum valset, we need to know what's currently
     // in the contract in order to determine if a new validator set is valid.
@@ -159,7 +162,7 @@ pub async fn relay_valsets(
 
         if cost.is_err() {
             error!(
-                "Valset cost estimate for Nonce {} failed with {:?}",
+                "Valset cost estimate for valset_nonce={} failed with {:?}",
                 latest_cosmos_valset.nonce, cost
             );
             return;
@@ -178,15 +181,23 @@ pub async fn relay_valsets(
         let gas_as_f32 = downcast_to_f32(cost.gas).unwrap(); // same as above re: total cost
 
         info!(
-           "We have detected latest valset {} but latest on Ethereum is {} This valset is estimated to cost {} Gas / {:.4} ETH to submit",
+           "We have detected latest valset_nonce={} but latest on Ethereum is {} This valset is estimated to cost {} Gas / {:.4} ETH to submit",
             latest_cosmos_valset.nonce, current_eth_valset.nonce,
             cost.gas_price.clone(),
-            total_cost / one_eth_f32()
+            total_cost / native_unit
         );
 
         cost.gas_price = ((gas_price_as_f32 * eth_gas_price_multiplier) as u128).into();
         cost.gas = ((gas_as_f32 * eth_gas_multiplier) as u128).into();
 
+        if dry_run {
+            info!(
+                "Dry run: would have submitted valset_nonce={} with {} gas at gas price {}",
+                latest_cosmos_valset.nonce, cost.gas, cost.gas_price
+            );
+            return;
+        }
+
         let relay_response = send_eth_valset_update(
             latest_cosmos_valset.clone(),
             current_eth_valset.clone(),
@@ -200,7 +211,7 @@ pub async fn relay_valsets(
         .await;
 
         info!(
-            "relay_response {:?} (latest_cosmos_valset.nonce {} current_eth_valset.nonce {})",
+            "relay_response {:?} for valset_nonce={} (current_eth_valset.nonce {})",
             relay_response, latest_cosmos_valset.nonce, current_eth_valset.nonce,
         );
     }
@@ -0,0 +1,161 @@
+//! Coordination between relayers so that a batch or logic call is normally submitted by only
+//! one of them. For every relayable item each member of the current validator set is given a
+//! deterministic rank by hashing the item's id with the member's address, and a relayer may
+//! only submit once the item has been pending for its rank times the turn duration. The first
+//! ranked relayer submits immediately, and if it is offline the next ranked one takes over after
+//! a turn, so progress only ever depends on a single honest relayer. Relayers outside of the
+//! validator set go last. No messages are exchanged, every relayer computes the same schedule
+//! from the validator set it already has to observe.
+
+use ethers::types::Address as EthAddress;
+use ethers::utils::keccak256;
+use gravity_utils::ethereum::bytes_to_hex_str;
+use gravity_utils::types::Valset;
+use std::collections::HashMap;
+use std::time::{Duration, Instant};
+
+/// Items pending for longer than this are forgotten, they have long since been relayed
+/// or timed out
+const FORGET_AFTER: Duration = Duration::from_secs(86400);
+
+/// WorkSharing tracks when relayable items were first seen and decides whether it's
+/// our turn to relay them
+pub struct WorkSharing {
+    our_address: EthAddress,
+    turn_duration: Duration,
+    first_seen: HashMap<Vec<u8>, Instant>,
+}
+
+impl WorkSharing {
+    pub fn new(our_address: EthAddress, turn_duration: Duration) -> Self {
+        WorkSharing {
+            our_address,
+            turn_duration,
+            first_seen: HashMap::new(),
+        }
+    }
+
+    /// Returns true if we may relay the item with the provided id given the current
+    /// validator set on Ethereum
+    pub fn should_relay(&mut self, valset: &Valset, item_id: &[u8]) -> bool {
+        self.first_seen
+            .retain(|_, seen| seen.elapsed() < FORGET_AFTER);
+        let first_seen = *self
+            .first_seen
+            .entry(item_id.to_vec())
+            .or_insert_with(Instant::now);
+
+        let rank = relayer_rank(valset, item_id, self.our_address);
+        let our_turn = self.turn_duration * rank;
+        if first_seen.elapsed() >= our_turn {
+            true
+        } else {
+            debug!(
+                "Waiting for our turn at rank {} to relay {}, {:?} left",
+                rank,
+                bytes_to_hex_str(item_id),
+                our_turn.saturating_sub(first_seen.elapsed())
+            );
+            false
+        }
+    }
+}
+
+/// Id of a batch for the purpose of work sharing
+pub fn batch_id(token_contract: EthAddress, nonce: u64) -> Vec<u8> {
+    let mut id = b"batch".to_vec();
+    id.extend_from_slice(token_contract.as_bytes());
+    id.extend_from_slice(&nonce.to_be_bytes());
+    id
+}
+
+/// Id of a logic call for the purpose of work sharing
+pub fn logic_call_id(invalidation_id: &[u8], invalidation_nonce: u64) -> Vec<u8> {
+    let mut id = b"logic_call".to_vec();
+    id.extend_from_slice(invalidation_id);
+    id.extend_from_slice(&invalidation_nonce.to_be_bytes());
+    id
+}
+
+/// The position of address in the relaying order for item_id, relayers that are not
+/// members of the validator set rank after all members
+fn relayer_rank(valset: &Valset, item_id: &[u8], address: EthAddress) -> u32 {
+    let mut members: Vec<(EthAddress, [u8; 32])> = valset
+        .members
+        .iter()
+        .filter_map(|member| member.eth_address)
+        .map(|member| {
+            let mut preimage = item_id.to_vec();
+            preimage.extend_from_slice(member.as_bytes());
+            (member, keccak256(preimage))
+        })
+        .collect();
+    members.sort_by(|a, b| a.1.cmp(&b.1));
+
+    let rank = members
+        .iter()
+        .position(|(member, _)| *member == address)
+        .unwrap_or(members.len());
+    rank as u32
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use gravity_utils::types::ValsetMember;
+
+    fn test_valset(count: u8) -> Valset {
+        Valset {
+            nonce: 1,
+            members: (1..=count)
+                .map(|i| ValsetMember {
+                    power: 1000,
+                    eth_address: Some(EthAddress::repeat_byte(i)),
+                })
+                .collect(),
+        }
+    }
+
+    #[test]
+    fn test_relayer_rank_is_a_permutation() {
+        let valset = test_valset(5);
+        let id = batch_id(EthAddress::repeat_byte(0xaa), 7);
+
+        let mut ranks: Vec<u32> = valset
+            .members
+            .iter()
+            .map(|m| relayer_rank(&valset, &id, m.eth_address.unwrap()))
+            .collect();
+        ranks.sort_unstable();
+        assert_eq!(ranks, vec![0, 1, 2, 3, 4]);
+
+        assert_eq!(relayer_rank(&valset, &id, EthAddress::repeat_byte(0xff)), 5);
+    }
+
+    #[test]
+    fn test_first_ranked_relayer_relays_immediately() {
+        let valset = test_valset(3);
+        let id = logic_call_id(&[1, 2, 3], 1);
+        let first = valset
+            .members
+            .iter()
+            .map(|m| m.eth_address.unwrap())
+            .find(|a| relayer_rank(&valset, &id, *a) == 0)
+            .unwrap();
+        let second = valset
+            .members
+            .iter()
+            .map(|m| m.eth_address.unwrap())
+            .find(|a| relayer_rank(&valset, &id, *a) == 1)
+            .unwrap();
+
+        let mut ours = WorkSharing::new(first, Duration::from_secs(60));
+        assert!(ours.should_relay(&valset, &id));
+
+        let mut theirs = WorkSharing::new(second, Duration::from_secs(60));
+        assert!(!theirs.should_relay(&valset, &id));
+
+        let mut no_turns = WorkSharing::new(second, Duration::from_secs(0));
+        assert!(no_turns.should_relay(&valset, &id));
+    }
+}
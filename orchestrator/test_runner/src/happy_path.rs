@@ -438,6 +438,8 @@ async fn submit_duplicate_erc20_send(
         sender: ethereum_sender,
         destination: receiver,
         amount,
+        forward_evm_chain_id: 0,
+        forward_ibc_channel: String::new(),
     };
 
     // iterate through all validators and try to send an event with duplicate nonce
@@ -452,6 +454,10 @@ async fn submit_duplicate_erc20_send(
             vec![],
             vec![],
             vec![],
+            vec![],
+            vec![],
+            vec![],
+            event.block_height,
         );
 
         let gas_price = get_gas_price();
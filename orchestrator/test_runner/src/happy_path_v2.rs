@@ -16,6 +16,7 @@ use gravity_proto::gravity::{
     query_client::QueryClient as GravityQueryClient, DenomToErc20Request,
 };
 use gravity_utils::ethereum::downcast_to_u64;
+use gravity_utils::signer::EthSigner;
 use std::str::FromStr;
 use std::sync::Arc;
 use tonic::transport::Channel;
@@ -37,7 +38,7 @@ pub async fn happy_path_test_v2(
     let chain_id = downcast_to_u64(chain_id).expect("Chain ID overflowed when downcasting to u64");
     let eth_client = Arc::new(SignerMiddleware::new(
         provider,
-        eth_wallet.with_chain_id(chain_id),
+        EthSigner::from(eth_wallet.with_chain_id(chain_id)),
     ));
     let starting_event_nonce = get_event_nonce(gravity_address, eth_client.clone())
         .await
@@ -73,6 +74,7 @@ pub async fn happy_path_test_v2(
             let res = grpc_client
                 .denom_to_erc20(DenomToErc20Request {
                     denom: token_to_send_to_eth.clone(),
+                    evm_chain_id: 0,
                 })
                 .await;
 
@@ -23,6 +23,7 @@ use ethers::providers::Provider;
 use ethers::types::Address as EthAddress;
 use gravity_proto::gravity::query_client::QueryClient as GravityQueryClient;
 use gravity_utils::ethereum::hex_str_to_bytes;
+use gravity_utils::signer::EthSigner;
 use happy_path::happy_path_test;
 use happy_path_v2::happy_path_test_v2;
 use orch_keys_update::orch_keys_update;
@@ -72,9 +73,10 @@ lazy_static! {
     static ref MINER_WALLET: LocalWallet = LocalWallet::from((*MINER_PRIVATE_KEY).clone());
     static ref MINER_ADDRESS: EthAddress = (*MINER_WALLET).address();
     static ref MINER_PROVIDER: Provider<Http> = Provider::<Http>::try_from((*ETH_NODE).clone()).unwrap();
-    static ref MINER_SIGNER: SignerMiddleware<Provider<Http>, LocalWallet> =
-        SignerMiddleware::new((*MINER_PROVIDER).clone(), (*MINER_WALLET).clone());
-    static ref MINER_CLIENT: EthClient = Arc::new((*MINER_SIGNER).clone());
+    static ref MINER_CLIENT: EthClient = Arc::new(SignerMiddleware::new(
+        (*MINER_PROVIDER).clone(),
+        EthSigner::from((*MINER_WALLET).clone()),
+    ));
 
 }
 
@@ -12,6 +12,7 @@ use ethers::prelude::*;
 use ethers::types::Address as EthAddress;
 use futures::future::join_all;
 use gravity_utils::ethereum::downcast_to_u64;
+use gravity_utils::signer::EthSigner;
 use std::{collections::HashSet, str::FromStr, sync::Arc, time::Duration};
 
 const TIMEOUT: Duration = Duration::from_secs(120);
@@ -77,7 +78,7 @@ pub async fn transaction_stress_test(
                 downcast_to_u64(chain_id).expect("Chain ID overflowed when downcasting to u64");
             let eth_client = Arc::new(SignerMiddleware::new(
                 provider,
-                eth_wallet.with_chain_id(chain_id),
+                EthSigner::from(eth_wallet.with_chain_id(chain_id)),
             ));
             let fut = send_to_cosmos(
                 *token,
@@ -229,7 +230,10 @@ pub async fn transaction_stress_test(
     // we should find a batch nonce greater than zero since all the batches
     // executed
     let eth_wallet = LocalWallet::from(keys[0].eth_key.clone());
-    let eth_client = Arc::new(SignerMiddleware::new(eth_provider.clone(), eth_wallet));
+    let eth_client = Arc::new(SignerMiddleware::new(
+        eth_provider.clone(),
+        EthSigner::from(eth_wallet),
+    ));
     for token in erc20_addresses {
         assert!(
             get_tx_batch_nonce(gravity_address, token, eth_client.clone())
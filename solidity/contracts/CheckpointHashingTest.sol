@@ -0,0 +1,162 @@
+pragma solidity 0.8.10;
+
+import "./Gravity.sol";
+
+// Computes the hashes Gravity.sol has the validators sign, with the same abi.encode
+// argument lists, so that the checkpoints of the module can be checked against them.
+// The typed data digests are those of the TypedData library Gravity.sol verifies.
+contract CheckpointHashingTest {
+	function valsetCheckpoint(ValsetArgs calldata _valsetArgs, bytes32 _gravityId)
+		external
+		pure
+		returns (bytes32)
+	{
+		// bytes32 encoding of the string "checkpoint"
+		bytes32 methodName = 0x636865636b706f696e7400000000000000000000000000000000000000000000;
+
+		return
+			keccak256(
+				abi.encode(
+					_gravityId,
+					methodName,
+					_valsetArgs.valsetNonce,
+					_valsetArgs.validators,
+					_valsetArgs.powers,
+					_valsetArgs.rewardAmount,
+					_valsetArgs.rewardToken
+				)
+			);
+	}
+
+	function batchCheckpoint(
+		bytes32 _gravityId,
+		uint256[] calldata _amounts,
+		address[] calldata _destinations,
+		uint256[] calldata _fees,
+		uint256 _batchNonce,
+		address _tokenContract,
+		uint256 _batchTimeout
+	) external pure returns (bytes32) {
+		return
+			keccak256(
+				abi.encode(
+					_gravityId,
+					// bytes32 encoding of "transactionBatch"
+					0x7472616e73616374696f6e426174636800000000000000000000000000000000,
+					_amounts,
+					_destinations,
+					_fees,
+					_batchNonce,
+					_tokenContract,
+					_batchTimeout
+				)
+			);
+	}
+
+	function erc1155BatchCheckpoint(
+		bytes32 _gravityId,
+		address[] calldata _destinations,
+		uint256[] calldata _ids,
+		uint256[] calldata _amounts,
+		uint256 _batchNonce,
+		address _tokenContract,
+		uint256 _batchTimeout
+	) external pure returns (bytes32) {
+		return
+			keccak256(
+				abi.encode(
+					_gravityId,
+					// bytes32 encoding of "erc1155Batch"
+					0x6572633131353542617463680000000000000000000000000000000000000000,
+					_destinations,
+					_ids,
+					_amounts,
+					_batchNonce,
+					_tokenContract,
+					_batchTimeout
+				)
+			);
+	}
+
+	function logicCallCheckpoint(bytes32 _gravityId, LogicCallArgs calldata _args)
+		external
+		pure
+		returns (bytes32)
+	{
+		return
+			keccak256(
+				abi.encode(
+					_gravityId,
+					// bytes32 encoding of "logicCall"
+					0x6c6f67696343616c6c0000000000000000000000000000000000000000000000,
+					_args.transferAmounts,
+					_args.transferTokenContracts,
+					_args.feeAmounts,
+					_args.feeTokenContracts,
+					_args.logicContractAddress,
+					_args.payload,
+					_args.timeOut,
+					_args.invalidationId,
+					_args.invalidationNonce
+				)
+			);
+	}
+
+	function valsetTypedDigest(ValsetArgs calldata _valsetArgs, bytes32 _gravityId)
+		external
+		view
+		returns (bytes32)
+	{
+		return TypedData.valsetDigest(_valsetArgs, _gravityId);
+	}
+
+	function batchTypedDigest(
+		bytes32 _gravityId,
+		uint256[] calldata _amounts,
+		address[] calldata _destinations,
+		uint256[] calldata _fees,
+		uint256 _batchNonce,
+		address _tokenContract,
+		uint256 _batchTimeout
+	) external view returns (bytes32) {
+		return
+			TypedData.batchDigest(
+				_amounts,
+				_destinations,
+				_fees,
+				_batchNonce,
+				_tokenContract,
+				_batchTimeout,
+				_gravityId
+			);
+	}
+
+	function erc1155BatchTypedDigest(
+		bytes32 _gravityId,
+		address[] calldata _destinations,
+		uint256[] calldata _ids,
+		uint256[] calldata _amounts,
+		uint256 _batchNonce,
+		address _tokenContract,
+		uint256 _batchTimeout
+	) external view returns (bytes32) {
+		return
+			TypedData.erc1155BatchDigest(
+				_destinations,
+				_ids,
+				_amounts,
+				_batchNonce,
+				_tokenContract,
+				_batchTimeout,
+				_gravityId
+			);
+	}
+
+	function logicCallTypedDigest(bytes32 _gravityId, LogicCallArgs calldata _args)
+		external
+		view
+		returns (bytes32)
+	{
+		return TypedData.logicCallDigest(_args, _gravityId);
+	}
+}
@@ -0,0 +1,84 @@
+pragma solidity 0.8.10;
+
+import "@openzeppelin/contracts/proxy/Clones.sol";
+import "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import "@openzeppelin/contracts/token/ERC20/utils/SafeERC20.sol";
+
+error AlreadyInitialized();
+error NothingToForward();
+
+interface IGravity {
+	function sendToCosmos(
+		address _tokenContract,
+		bytes32 _destination,
+		uint256 _amount
+	) external;
+}
+
+// DepositForwarder is the implementation behind each deposit address. A deposit address
+// holds whatever is sent to it until someone forwards it to the Gravity contract, where it
+// is credited to the destination the address was created for.
+contract DepositForwarder {
+	using SafeERC20 for IERC20;
+
+	address public state_gravity;
+	bytes32 public state_destination;
+
+	// Called by the factory in the same transaction that clones the forwarder, so the
+	// destination of a deposit address can't be taken over
+	function initialize(address _gravity, bytes32 _destination) external {
+		if (state_gravity != address(0)) {
+			revert AlreadyInitialized();
+		}
+		state_gravity = _gravity;
+		state_destination = _destination;
+	}
+
+	function forward(address _tokenContract) external {
+		uint256 balance = IERC20(_tokenContract).balanceOf(address(this));
+		if (balance == 0) {
+			revert NothingToForward();
+		}
+		IERC20(_tokenContract).safeIncreaseAllowance(state_gravity, balance);
+		IGravity(state_gravity).sendToCosmos(_tokenContract, state_destination, balance);
+	}
+}
+
+// DepositAddressFactory gives each sendToCosmos destination its own deposit address, so
+// senders that can't call sendToCosmos themselves, like exchange withdrawals, can still
+// bridge to Cosmos. The address is a clone of the forwarder created with the destination
+// as salt, the Gravity module derives it the same way to register it for the recipient.
+// The forwarder is the first contract the factory creates, the module relies on this to
+// find it.
+contract DepositAddressFactory {
+	address public immutable state_gravity;
+	address public immutable state_forwarder;
+
+	event DepositAddressDeployed(bytes32 indexed _destination, address _depositAddress);
+
+	constructor(address _gravity) {
+		state_gravity = _gravity;
+		state_forwarder = address(new DepositForwarder());
+	}
+
+	function depositAddress(bytes32 _destination) public view returns (address) {
+		return Clones.predictDeterministicAddress(state_forwarder, _destination);
+	}
+
+	function deploy(bytes32 _destination) public returns (address) {
+		address deposit = Clones.cloneDeterministic(state_forwarder, _destination);
+		DepositForwarder(deposit).initialize(state_gravity, _destination);
+		emit DepositAddressDeployed(_destination, deposit);
+		return deposit;
+	}
+
+	// Forwards the deposit address's balance of the token to the Gravity contract, the
+	// address is deployed first if nothing has been forwarded from it yet
+	function forward(bytes32 _destination, address _tokenContract) external {
+		address deposit = depositAddress(_destination);
+		if (deposit.code.length == 0) {
+			deploy(_destination);
+		}
+		DepositForwarder(deposit).forward(_tokenContract);
+	}
+}
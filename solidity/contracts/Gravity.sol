@@ -6,6 +6,8 @@ import "@openzeppelin/contracts/token/ERC20/utils/SafeERC20.sol";
 import "@openzeppelin/contracts/utils/Address.sol";
 import "@openzeppelin/contracts/security/ReentrancyGuard.sol";
 import "@openzeppelin/contracts/utils/cryptography/ECDSA.sol";
+import "@openzeppelin/contracts/token/ERC1155/IERC1155.sol";
+import "@openzeppelin/contracts/token/ERC1155/utils/ERC1155Holder.sol";
 import "./CosmosToken.sol";
 
 error InvalidSignature();
@@ -22,6 +24,9 @@ error MalformedBatch();
 error InsufficientPower(uint256 cumulativePower, uint256 powerThreshold);
 error BatchTimedOut();
 error LogicCallTimedOut();
+error InsufficientTransferGas();
+error NothingToClaim();
+error ContractVersionAlreadyAnnounced();
 
 // This is being used purely to avoid stack too deep errors
 struct LogicCallArgs {
@@ -62,7 +67,136 @@ struct ValSignature {
 	bytes32 s;
 }
 
-contract Gravity is ReentrancyGuard {
+// The EIP-712 typed data the validators may sign instead of the checkpoints, carrying the
+// same arguments so that signers can review what they approve. The domain has the chain id
+// of the contract and the gravity id as its salt, like the checkpoints have it. The module
+// builds the same typed data in x/gravity/types/eip712.go.
+library TypedData {
+	bytes32 internal constant DOMAIN_TYPEHASH =
+		keccak256("EIP712Domain(string name,string version,uint256 chainId,bytes32 salt)");
+	bytes32 internal constant VALSET_TYPEHASH =
+		keccak256(
+			"Valset(uint256 valsetNonce,address[] validators,uint256[] powers,uint256 rewardAmount,address rewardToken)"
+		);
+	bytes32 internal constant BATCH_TYPEHASH =
+		keccak256(
+			"TransactionBatch(uint256[] amounts,address[] destinations,uint256[] fees,uint256 batchNonce,address tokenContract,uint256 batchTimeout)"
+		);
+	bytes32 internal constant ERC1155_BATCH_TYPEHASH =
+		keccak256(
+			"ERC1155Batch(address[] destinations,uint256[] ids,uint256[] amounts,uint256 batchNonce,address tokenContract,uint256 batchTimeout)"
+		);
+	bytes32 internal constant LOGIC_CALL_TYPEHASH =
+		keccak256(
+			"LogicCall(uint256[] transferAmounts,address[] transferTokenContracts,uint256[] feeAmounts,address[] feeTokenContracts,address logicContractAddress,bytes payload,uint256 timeOut,bytes32 invalidationId,uint256 invalidationNonce)"
+		);
+
+	function digest(bytes32 _gravityId, bytes32 _structHash) internal view returns (bytes32) {
+		bytes32 domainSeparator = keccak256(
+			abi.encode(
+				DOMAIN_TYPEHASH,
+				keccak256("Gravity Bridge"),
+				keccak256("1"),
+				block.chainid,
+				_gravityId
+			)
+		);
+		return keccak256(abi.encodePacked("\x19\x01", domainSeparator, _structHash));
+	}
+
+	// arrays of static values are hashed as the concatenation of their 32 byte elements,
+	// which abi.encodePacked pads them to
+
+	function valsetDigest(ValsetArgs calldata _valsetArgs, bytes32 _gravityId)
+		internal
+		view
+		returns (bytes32)
+	{
+		bytes32 structHash = keccak256(
+			abi.encode(
+				VALSET_TYPEHASH,
+				_valsetArgs.valsetNonce,
+				keccak256(abi.encodePacked(_valsetArgs.validators)),
+				keccak256(abi.encodePacked(_valsetArgs.powers)),
+				_valsetArgs.rewardAmount,
+				_valsetArgs.rewardToken
+			)
+		);
+		return digest(_gravityId, structHash);
+	}
+
+	// the gravity id comes last so that callers evaluate it, a constant, at the top of
+	// their stack
+	function batchDigest(
+		uint256[] calldata _amounts,
+		address[] calldata _destinations,
+		uint256[] calldata _fees,
+		uint256 _batchNonce,
+		address _tokenContract,
+		uint256 _batchTimeout,
+		bytes32 _gravityId
+	) internal view returns (bytes32) {
+		bytes32 structHash = keccak256(
+			abi.encode(
+				BATCH_TYPEHASH,
+				keccak256(abi.encodePacked(_amounts)),
+				keccak256(abi.encodePacked(_destinations)),
+				keccak256(abi.encodePacked(_fees)),
+				_batchNonce,
+				_tokenContract,
+				_batchTimeout
+			)
+		);
+		return digest(_gravityId, structHash);
+	}
+
+	function erc1155BatchDigest(
+		address[] calldata _destinations,
+		uint256[] calldata _ids,
+		uint256[] calldata _amounts,
+		uint256 _batchNonce,
+		address _tokenContract,
+		uint256 _batchTimeout,
+		bytes32 _gravityId
+	) internal view returns (bytes32) {
+		bytes32 structHash = keccak256(
+			abi.encode(
+				ERC1155_BATCH_TYPEHASH,
+				keccak256(abi.encodePacked(_destinations)),
+				keccak256(abi.encodePacked(_ids)),
+				keccak256(abi.encodePacked(_amounts)),
+				_batchNonce,
+				_tokenContract,
+				_batchTimeout
+			)
+		);
+		return digest(_gravityId, structHash);
+	}
+
+	function logicCallDigest(LogicCallArgs memory _args, bytes32 _gravityId)
+		internal
+		view
+		returns (bytes32)
+	{
+		bytes32 structHash = keccak256(
+			abi.encode(
+				LOGIC_CALL_TYPEHASH,
+				keccak256(abi.encodePacked(_args.transferAmounts)),
+				keccak256(abi.encodePacked(_args.transferTokenContracts)),
+				keccak256(abi.encodePacked(_args.feeAmounts)),
+				keccak256(abi.encodePacked(_args.feeTokenContracts)),
+				_args.logicContractAddress,
+				keccak256(_args.payload),
+				_args.timeOut,
+				_args.invalidationId,
+				_args.invalidationNonce
+			)
+		);
+		return digest(_gravityId, structHash);
+	}
+}
+
+contract Gravity is ReentrancyGuard, ERC1155Holder {
 	using SafeMath for uint256;
 	using SafeERC20 for IERC20;
 
@@ -74,6 +208,21 @@ contract Gravity is ReentrancyGuard {
 	// event nonce zero is reserved by the Cosmos module as a special
 	// value indicating that no events have yet been submitted
 	uint256 public state_lastEventNonce = 1;
+	// The ERC1155 ids of batch transfers which their recipient rejected, by token contract,
+	// recipient and id, for the recipient to claim with claimERC1155
+	mapping(address => mapping(address => mapping(uint256 => uint256))) public state_erc1155Credits;
+
+	// The version of this contract, attested on Cosmos through announceContractVersion so that
+	// the module only produces checkpoints for features the deployed contract can verify.
+	// Version 2 is the first to support ERC1155 batches, version 3 the first to verify
+	// EIP-712 typed data signatures.
+	uint256 public constant CONTRACT_VERSION = 3;
+	// Set once the version is announced, it can't change so it is only announced once
+	bool public state_contractVersionAnnounced;
+
+	// The gas each transfer of an ERC1155 batch is given, recipients that need more than this
+	// to accept the ids are credited them instead
+	uint256 public constant ERC1155_TRANSFER_GAS = 200000;
 
 	// These are set once at initialization
 	uint256 public state_powerThreshold;
@@ -115,12 +264,35 @@ contract Gravity is ReentrancyGuard {
 		address[] _validators,
 		uint256[] _powers
 	);
+	// ERC1155 transfers from Cosmos reuse the batch nonces of ERC20 batches, a token
+	// contract is only ever one of the two
+	event ERC1155BatchExecutedEvent(
+		uint256 indexed _batchNonce,
+		address indexed _token,
+		uint256 _eventNonce
+	);
+	event SendERC1155ToCosmosEvent(
+		address indexed _tokenContract,
+		address indexed _sender,
+		bytes32 indexed _destination,
+		uint256[] _ids,
+		uint256[] _amounts,
+		uint256 _eventNonce
+	);
+	// A transfer of an ERC1155 batch failed and its ids were credited to the recipient instead
+	event ERC1155TransferFailedEvent(
+		address indexed _tokenContract,
+		address indexed _destination,
+		uint256 _id,
+		uint256 _amount
+	);
 	event LogicCallEvent(
 		bytes32 _invalidationId,
 		uint256 _invalidationNonce,
 		bytes _returnData,
 		uint256 _eventNonce
 	);
+	event ContractVersionEvent(uint256 _version, uint256 _eventNonce);
 
 	// TEST FIXTURES
 	// These are here to make it easier to measure gas usage. They should be removed before production
@@ -134,7 +306,7 @@ contract Gravity is ReentrancyGuard {
 		bytes32 _theHash,
 		uint256 _powerThreshold
 	) external pure {
-		checkValidatorSignatures(_currentValset, _sigs, _theHash, _powerThreshold);
+		checkValidatorSignatures(_currentValset, _sigs, _theHash, bytes32(0), _powerThreshold);
 	}
 
 	// END TEST FIXTURES
@@ -147,16 +319,20 @@ contract Gravity is ReentrancyGuard {
 		return state_invalidationMapping[_invalidation_id];
 	}
 
-	// Utility function to verify geth style signatures
+	// Utility function to verify geth style signatures of the hash, or signatures of the
+	// EIP-712 typed data digest
 	function verifySig(
 		address _signer,
 		bytes32 _theHash,
+		bytes32 _typedDigest,
 		ValSignature calldata _sig
 	) private pure returns (bool) {
 		bytes32 messageDigest = keccak256(
 			abi.encodePacked("\x19Ethereum Signed Message:\n32", _theHash)
 		);
-		return _signer == ECDSA.recover(messageDigest, _sig.v, _sig.r, _sig.s);
+		return
+			_signer == ECDSA.recover(messageDigest, _sig.v, _sig.r, _sig.s) ||
+			_signer == ECDSA.recover(_typedDigest, _sig.v, _sig.r, _sig.s);
 	}
 
 
@@ -212,6 +388,8 @@ contract Gravity is ReentrancyGuard {
 		ValSignature[] calldata _sigs,
 		// This is what we are checking they have signed
 		bytes32 _theHash,
+		// Or the digest of its typed data they may have signed instead
+		bytes32 _typedDigest,
 		uint256 _powerThreshold
 	) private pure {
 		uint256 cumulativePower = 0;
@@ -221,7 +399,7 @@ contract Gravity is ReentrancyGuard {
 			// (In a valid signature, it is either 27 or 28)
 			if (_sigs[i].v != 0) {
 				// Check that the current validator has signed off on the hash
-				if (!verifySig(_currentValset.validators[i], _theHash, _sigs[i])) {
+				if (!verifySig(_currentValset.validators[i], _theHash, _typedDigest, _sigs[i])) {
 					revert InvalidSignature();
 				}
 
@@ -309,7 +487,13 @@ function updateValset(
 		// Check that enough current validators have signed off on the new validator set
 		bytes32 newCheckpoint = makeCheckpoint(_newValset, state_gravityId);
 
-		checkValidatorSignatures(_currentValset, _sigs, newCheckpoint, state_powerThreshold);
+		checkValidatorSignatures(
+			_currentValset,
+			_sigs,
+			newCheckpoint,
+			TypedData.valsetDigest(_newValset, state_gravityId),
+			state_powerThreshold
+		);
 
 		// ACTIONS
 
@@ -413,6 +597,15 @@ function updateValset(
 						_batchTimeout
 					)
 				),
+				TypedData.batchDigest(
+					_amounts,
+					_destinations,
+					_fees,
+					_batchNonce,
+					_tokenContract,
+					_batchTimeout,
+					state_gravityId
+				),
 				state_powerThreshold
 			);
 
@@ -441,6 +634,144 @@ function updateValset(
 		}
 	}	
 
+	// submitERC1155Batch sends the ids of an ERC1155 token in a batch of Cosmos -> Ethereum
+	// transfers to their destinations. Each entry moves one id to one destination, the
+	// batch pays no fees. It is approved by the current Cosmos validator set like submitBatch.
+	function submitERC1155Batch(
+		// The validators that approve the batch
+		ValsetArgs calldata _currentValset,
+		// These are arrays of the parts of the validators signatures
+		ValSignature[] calldata _sigs,
+		// The batch of transfers
+		address[] calldata _destinations,
+		uint256[] calldata _ids,
+		uint256[] calldata _amounts,
+		uint256 _batchNonce,
+		address _tokenContract,
+		// a block height beyond which this batch is not valid
+		uint256 _batchTimeout
+	) external nonReentrant {
+		// CHECKS scoped to reduce stack depth
+		{
+			// Check that the batch nonce is higher than the last nonce for this token
+			if (_batchNonce <= state_lastBatchNonces[_tokenContract]) {
+				revert InvalidBatchNonce({
+					newNonce: _batchNonce,
+					currentNonce: state_lastBatchNonces[_tokenContract]
+				});
+			}
+
+			// Check that the batch nonce is less than one million nonces forward from the old one
+			if (_batchNonce > state_lastBatchNonces[_tokenContract] + 1000000) {
+				revert InvalidBatchNonce({
+					newNonce: _batchNonce,
+					currentNonce: state_lastBatchNonces[_tokenContract]
+				});
+			}
+
+			// Check that the block height is less than the timeout height
+			if (block.number >= _batchTimeout) {
+				revert BatchTimedOut();
+			}
+
+			// Check that current validators, powers, and signatures (v,r,s) set is well-formed
+			validateValset(_currentValset, _sigs);
+
+			// Check that the supplied current validator set matches the saved checkpoint
+			if (makeCheckpoint(_currentValset, state_gravityId) != state_lastValsetCheckpoint) {
+				revert IncorrectCheckpoint();
+			}
+
+			// Check that the transfer batch is well-formed
+			if (_destinations.length != _ids.length || _destinations.length != _amounts.length) {
+				revert MalformedBatch();
+			}
+
+			// Check that enough current validators have signed off on the batch and valset
+			checkValidatorSignatures(
+				_currentValset,
+				_sigs,
+				keccak256(
+					abi.encode(
+						state_gravityId,
+						// bytes32 encoding of "erc1155Batch"
+						0x6572633131353542617463680000000000000000000000000000000000000000,
+						_destinations,
+						_ids,
+						_amounts,
+						_batchNonce,
+						_tokenContract,
+						_batchTimeout
+					)
+				),
+				TypedData.erc1155BatchDigest(
+					_destinations,
+					_ids,
+					_amounts,
+					_batchNonce,
+					_tokenContract,
+					_batchTimeout,
+					state_gravityId
+				),
+				state_powerThreshold
+			);
+
+			// ACTIONS
+
+			// Store batch nonce
+			state_lastBatchNonces[_tokenContract] = _batchNonce;
+
+			// A recipient that rejects its ids, or a token that fails to move them, must not
+			// hold up the rest of the batch, which can't be resubmitted any other way. The ids
+			// of a failed transfer are credited to the recipient to claim later.
+			for (uint256 i = 0; i < _destinations.length; i++) {
+				// Every transfer must be given all of its gas, otherwise a relayer could make
+				// transfers fail by submitting the batch with too little
+				if (gasleft() < (ERC1155_TRANSFER_GAS * 64) / 63 + 10000) {
+					revert InsufficientTransferGas();
+				}
+				try
+					IERC1155(_tokenContract).safeTransferFrom{ gas: ERC1155_TRANSFER_GAS }(
+						address(this),
+						_destinations[i],
+						_ids[i],
+						_amounts[i],
+						""
+					)
+				{} catch {
+					state_erc1155Credits[_tokenContract][_destinations[i]][_ids[i]] += _amounts[i];
+					emit ERC1155TransferFailedEvent(
+						_tokenContract,
+						_destinations[i],
+						_ids[i],
+						_amounts[i]
+					);
+				}
+			}
+		}
+
+		// LOGS scoped to reduce stack depth
+		{
+			state_lastEventNonce = state_lastEventNonce + 1;
+			emit ERC1155BatchExecutedEvent(_batchNonce, _tokenContract, state_lastEventNonce);
+		}
+	}
+
+	// claimERC1155 sends the ids of a token credited to the caller, by batch transfers it
+	// rejected, to the given address
+	function claimERC1155(
+		address _tokenContract,
+		uint256 _id,
+		address _to
+	) external nonReentrant {
+		uint256 amount = state_erc1155Credits[_tokenContract][msg.sender][_id];
+		if (amount == 0) {
+			revert NothingToClaim();
+		}
+		state_erc1155Credits[_tokenContract][msg.sender][_id] = 0;
+		IERC1155(_tokenContract).safeTransferFrom(address(this), _to, _id, amount, "");
+	}
+
 	// This makes calls to contracts that execute arbitrary logic
 	// First, it gives the logic contract some tokens
 	// Then, it gives msg.senders tokens for fees
@@ -516,6 +847,7 @@ function updateValset(
 				_sigs,
 				// Get hash of the transaction batch and checkpoint
 				argsHash,
+				TypedData.logicCallDigest(_args, state_gravityId),
 				state_powerThreshold
 			);
 		}
@@ -588,6 +920,65 @@ function updateValset(
 		);
 	}
 
+	// sendERC1155ToCosmos locks the ids of an ERC1155 token, vouchers of each are minted to
+	// the destination on Cosmos. Every id may only be sent once per call and must arrive
+	// with a non zero amount, the Cosmos module would reject the event otherwise.
+	function sendERC1155ToCosmos(
+		address _tokenContract,
+		bytes32 _destination,
+		uint256[] calldata _ids,
+		uint256[] calldata _amounts
+	) public nonReentrant {
+		if (_ids.length == 0 || _ids.length != _amounts.length) {
+			revert InvalidSendToCosmos();
+		}
+
+		address[] memory holders = new address[](_ids.length);
+		for (uint256 i = 0; i < _ids.length; i++) {
+			holders[i] = address(this);
+			for (uint256 j = 0; j < i; j++) {
+				if (_ids[i] == _ids[j]) {
+					revert InvalidSendToCosmos();
+				}
+			}
+		}
+
+		// as in sendToCosmos, what arrived is what is credited
+		uint256[] memory ourStartingBalances = IERC1155(_tokenContract).balanceOfBatch(holders, _ids);
+		IERC1155(_tokenContract).safeBatchTransferFrom(msg.sender, address(this), _ids, _amounts, "");
+		uint256[] memory ourEndingBalances = IERC1155(_tokenContract).balanceOfBatch(holders, _ids);
+
+		uint256[] memory received = new uint256[](_ids.length);
+		for (uint256 i = 0; i < _ids.length; i++) {
+			if (ourEndingBalances[i] <= ourStartingBalances[i]) {
+				revert InvalidSendToCosmos();
+			}
+			received[i] = ourEndingBalances[i] - ourStartingBalances[i];
+		}
+
+		state_lastEventNonce = state_lastEventNonce + 1;
+		emit SendERC1155ToCosmosEvent(
+			_tokenContract,
+			msg.sender,
+			_destination,
+			_ids,
+			received,
+			state_lastEventNonce
+		);
+	}
+
+	// announceContractVersion lets the Cosmos module know the version of this contract. Anyone
+	// can call it, but only once since the version can't be changed, so that announcements
+	// can't be used to flood the Cosmos module with events.
+	function announceContractVersion() external {
+		if (state_contractVersionAnnounced) {
+			revert ContractVersionAlreadyAnnounced();
+		}
+		state_contractVersionAnnounced = true;
+		state_lastEventNonce = state_lastEventNonce + 1;
+		emit ContractVersionEvent(CONTRACT_VERSION, state_lastEventNonce);
+	}
+
 	function deployERC20(
 		string calldata _cosmosDenom,
 		string calldata _name,
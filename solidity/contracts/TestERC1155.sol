@@ -0,0 +1,11 @@
+pragma solidity ^0.8.0;
+import "@openzeppelin/contracts/token/ERC1155/ERC1155.sol";
+
+// A testing ERC1155 with two fungible ids and one non fungible id
+contract TestERC1155 is ERC1155 {
+	constructor() public ERC1155("https://example.com/{id}.json") {
+		_mint(msg.sender, 1, 10000, "");
+		_mint(msg.sender, 2, 10000, "");
+		_mint(msg.sender, 3, 1, "");
+	}
+}
@@ -0,0 +1,35 @@
+//SPDX-License-Identifier: Apache-2.0
+pragma solidity 0.8.10;
+import "./Gravity.sol";
+
+// An ERC1155 recipient that rejects every transfer, it can still claim what Gravity credits it
+contract TestERC1155Rejecter {
+	function onERC1155Received(
+		address,
+		address,
+		uint256,
+		uint256,
+		bytes calldata
+	) external pure returns (bytes4) {
+		revert("TestERC1155Rejecter: rejected");
+	}
+
+	function onERC1155BatchReceived(
+		address,
+		address,
+		uint256[] calldata,
+		uint256[] calldata,
+		bytes calldata
+	) external pure returns (bytes4) {
+		revert("TestERC1155Rejecter: rejected");
+	}
+
+	function claim(
+		Gravity _gravity,
+		address _tokenContract,
+		uint256 _id,
+		address _to
+	) external {
+		_gravity.claimERC1155(_tokenContract, _id, _to);
+	}
+}
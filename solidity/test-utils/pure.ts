@@ -52,6 +52,45 @@ export async function signHash(signers: Signer[], hash: string) {
   return sigs;
 }
 
+// signTypedDataValset signs the EIP-712 typed data of a valset, which Gravity.sol accepts
+// in place of a signature of its checkpoint
+export async function signTypedDataValset(
+  signers: Signer[],
+  valset: {
+    validators: string[];
+    powers: number[];
+    valsetNonce: number;
+    rewardAmount: number;
+    rewardToken: string;
+  },
+  gravityId: string
+) {
+  const domain = {
+    name: "Gravity Bridge",
+    version: "1",
+    chainId: (await ethers.provider.getNetwork()).chainId,
+    salt: gravityId,
+  };
+  const types = {
+    Valset: [
+      { name: "valsetNonce", type: "uint256" },
+      { name: "validators", type: "address[]" },
+      { name: "powers", type: "uint256[]" },
+      { name: "rewardAmount", type: "uint256" },
+      { name: "rewardToken", type: "address" },
+    ],
+  };
+
+  let sigs: Sig[] = [];
+  for (let i = 0; i < signers.length; i = i + 1) {
+    const sig = await (signers[i] as any)._signTypedData(domain, types, valset);
+    const splitSig = ethers.utils.splitSignature(sig);
+    sigs.push({ v: splitSig.v!, r: splitSig.r, s: splitSig.s });
+  }
+
+  return sigs;
+}
+
 export function makeTxBatchHash(
   amounts: number[],
   destinations: string[],
@@ -0,0 +1,120 @@
+import chai from "chai";
+import { ethers } from "hardhat";
+import { solidity } from "ethereum-waffle";
+import { readFileSync } from "fs";
+import { join } from "path";
+import { CheckpointHashingTest } from "../typechain/CheckpointHashingTest";
+
+chai.use(solidity);
+const { expect } = chai;
+
+// Vectors of the checkpoints computed by the module, regenerate them with
+// go test ./x/gravity/types -run TestCheckpointVectors -update-checkpoint-vectors
+const vectors = JSON.parse(
+  readFileSync(
+    join(__dirname, "../../module/x/gravity/types/testdata/checkpoint_vectors.json"),
+    "utf8"
+  )
+);
+
+describe("Checkpoint vectors", function () {
+  let hashing: CheckpointHashingTest;
+  const gravityId = vectors.gravity_id;
+
+  before(async function () {
+    // the typed data digests are computed under the chain id of the hardhat network
+    expect((await ethers.provider.getNetwork()).chainId).to.equal(vectors.chain_id);
+
+    const CheckpointHashingTest = await ethers.getContractFactory("CheckpointHashingTest");
+    hashing = (await CheckpointHashingTest.deploy()) as CheckpointHashingTest;
+    await hashing.deployed();
+  });
+
+  it("matches the valset checkpoints and typed data of the module", async function () {
+    for (const v of vectors.valsets) {
+      const valsetArgs = {
+        validators: v.validators,
+        powers: v.powers,
+        valsetNonce: v.valset_nonce,
+        rewardAmount: v.reward_amount,
+        rewardToken: v.reward_token,
+      };
+      expect(await hashing.valsetCheckpoint(valsetArgs, gravityId)).to.equal(v.checkpoint);
+      expect(await hashing.valsetTypedDigest(valsetArgs, gravityId)).to.equal(
+        v.typed_data_digest
+      );
+    }
+  });
+
+  it("matches the batch checkpoints and typed data of the module", async function () {
+    for (const v of vectors.batches) {
+      expect(
+        await hashing.batchCheckpoint(
+          gravityId,
+          v.amounts,
+          v.destinations,
+          v.fees,
+          v.batch_nonce,
+          v.token_contract,
+          v.batch_timeout
+        )
+      ).to.equal(v.checkpoint);
+      expect(
+        await hashing.batchTypedDigest(
+          gravityId,
+          v.amounts,
+          v.destinations,
+          v.fees,
+          v.batch_nonce,
+          v.token_contract,
+          v.batch_timeout
+        )
+      ).to.equal(v.typed_data_digest);
+    }
+  });
+
+  it("matches the ERC1155 batch checkpoints and typed data of the module", async function () {
+    for (const v of vectors.erc1155_batches) {
+      expect(
+        await hashing.erc1155BatchCheckpoint(
+          gravityId,
+          v.destinations,
+          v.ids,
+          v.amounts,
+          v.batch_nonce,
+          v.token_contract,
+          v.batch_timeout
+        )
+      ).to.equal(v.checkpoint);
+      expect(
+        await hashing.erc1155BatchTypedDigest(
+          gravityId,
+          v.destinations,
+          v.ids,
+          v.amounts,
+          v.batch_nonce,
+          v.token_contract,
+          v.batch_timeout
+        )
+      ).to.equal(v.typed_data_digest);
+    }
+  });
+
+  it("matches the logic call checkpoints and typed data of the module", async function () {
+    for (const v of vectors.logic_calls) {
+      const args = {
+        transferAmounts: v.transfer_amounts,
+        transferTokenContracts: v.transfer_token_contracts,
+        feeAmounts: v.fee_amounts,
+        feeTokenContracts: v.fee_token_contracts,
+        logicContractAddress: v.logic_contract_address,
+        payload: v.payload,
+        timeOut: v.time_out,
+        invalidationId: v.invalidation_id,
+        invalidationNonce: v.invalidation_nonce,
+      };
+      expect(await hashing.logicCallCheckpoint(gravityId, args)).to.equal(v.checkpoint);
+      expect(await hashing.logicCallTypedDigest(gravityId, args)).to.equal(v.typed_data_digest);
+    }
+  });
+});
@@ -0,0 +1,29 @@
+import chai from "chai";
+import { ethers } from "hardhat";
+import { solidity } from "ethereum-waffle";
+
+import { deployContracts } from "../test-utils";
+import { examplePowers } from "../test-utils/pure";
+
+chai.use(solidity);
+const { expect } = chai;
+
+describe("contract version tests", function () {
+  it("announces the contract version to Cosmos", async function () {
+    const signers = await ethers.getSigners();
+    const gravityId = ethers.utils.formatBytes32String("foo");
+    let powers = examplePowers();
+    let validators = signers.slice(0, powers.length);
+    const powerThreshold = 6666;
+    const { gravity } = await deployContracts(gravityId, validators, powers, powerThreshold);
+
+    // anyone may announce it, but only once
+    expect((await gravity.functions.state_contractVersionAnnounced())[0]).to.equal(false);
+    await expect(gravity.connect(signers[10]).functions.announceContractVersion())
+      .to.emit(gravity, "ContractVersionEvent").withArgs(3, 2);
+    expect((await gravity.functions.state_contractVersionAnnounced())[0]).to.equal(true);
+    await expect(gravity.functions.announceContractVersion())
+      .to.be.revertedWith("ContractVersionAlreadyAnnounced()");
+    expect((await gravity.functions.state_lastEventNonce())[0]).to.equal(2);
+  });
+});
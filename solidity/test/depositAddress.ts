@@ -0,0 +1,79 @@
+import chai from "chai";
+import { ethers } from "hardhat";
+import { solidity } from "ethereum-waffle";
+
+import { deployContracts } from "../test-utils";
+import { examplePowers } from "../test-utils/pure";
+
+chai.use(solidity);
+const { expect } = chai;
+
+
+async function runTest(opts: {}) {
+
+
+  // Prep and deploy contracts
+  // =========================
+  const signers = await ethers.getSigners();
+  const gravityId = ethers.utils.formatBytes32String("foo");
+  let powers = examplePowers();
+  let validators = signers.slice(0, powers.length);
+  const powerThreshold = 6666;
+  const { gravity, testERC20 } = await deployContracts(gravityId, validators, powers, powerThreshold);
+
+  const DepositAddressFactory = await ethers.getContractFactory("DepositAddressFactory");
+  const factory = await DepositAddressFactory.deploy(gravity.address);
+  await factory.deployed();
+
+  // the Gravity module finds the forwarder at the factory's first creation address
+  expect((await factory.functions.state_forwarder())[0]).to.equal(
+    ethers.utils.getContractAddress({ from: factory.address, nonce: 1 })
+  );
+
+
+  // Deposit to the address of a Cosmos recipient
+  // ============================================
+  // cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn, left padded like a sendToCosmos destination
+  const destination = ethers.utils.hexZeroPad("0xedcde49dc4c8d7ce40a353d47ae64fec07079e98", 32);
+  const depositAddress = (await factory.functions.depositAddress(destination))[0];
+
+  await testERC20.functions.transfer(depositAddress, 1000);
+  await expect(factory.functions.forward(destination, testERC20.address))
+    .to.emit(factory, "DepositAddressDeployed").withArgs(destination, depositAddress)
+    .and.to.emit(gravity, "SendToCosmosEvent").withArgs(
+      testERC20.address,
+      depositAddress,
+      destination,
+      1000,
+      2
+    );
+
+  expect((await testERC20.functions.balanceOf(gravity.address))[0]).to.equal(1000);
+  expect((await testERC20.functions.balanceOf(depositAddress))[0]).to.equal(0);
+
+
+  // Deposit again, the address is already deployed
+  // ==============================================
+  await testERC20.functions.transfer(depositAddress, 500);
+  await expect(factory.functions.forward(destination, testERC20.address))
+    .to.emit(gravity, "SendToCosmosEvent").withArgs(
+      testERC20.address,
+      depositAddress,
+      destination,
+      500,
+      3
+    );
+  expect((await testERC20.functions.balanceOf(gravity.address))[0]).to.equal(1500);
+
+  await expect(factory.functions.forward(destination, testERC20.address)).to.be.revertedWith("NothingToForward()");
+  await expect(factory.functions.deploy(destination)).to.be.reverted;
+
+  const forwarder = await ethers.getContractAt("DepositForwarder", depositAddress);
+  await expect(forwarder.functions.initialize(signers[0].address, destination)).to.be.revertedWith("AlreadyInitialized()");
+}
+
+describe("deposit address tests", function () {
+  it("works right", async function () {
+    await runTest({})
+  });
+});
@@ -0,0 +1,249 @@
+import chai from "chai";
+import { ethers } from "hardhat";
+import { solidity } from "ethereum-waffle";
+
+import { deployContracts } from "../test-utils";
+import {
+  getSignerAddresses,
+  signHash,
+  examplePowers,
+  ZeroAddress,
+} from "../test-utils/pure";
+
+chai.use(solidity);
+const { expect } = chai;
+
+
+async function runTest(opts: {}) {
+
+
+  // Prep and deploy contracts
+  // =========================
+  const signers = await ethers.getSigners();
+  const gravityId = ethers.utils.formatBytes32String("foo");
+  let powers = examplePowers();
+  let validators = signers.slice(0, powers.length);
+  const powerThreshold = 6666;
+  const { gravity } = await deployContracts(gravityId, validators, powers, powerThreshold);
+
+  const TestERC1155 = await ethers.getContractFactory("TestERC1155");
+  const testERC1155 = await TestERC1155.deploy();
+  await testERC1155.deployed();
+  const owner = await signers[0].getAddress();
+
+
+  // Deposit ids to Cosmos
+  // =====================
+  const destination = ethers.utils.formatBytes32String("myCosmosAddress");
+  await testERC1155.functions.setApprovalForAll(gravity.address, true);
+  await expect(gravity.functions.sendERC1155ToCosmos(testERC1155.address, destination, [1, 3], [100, 1]))
+    .to.emit(gravity, "SendERC1155ToCosmosEvent").withArgs(
+      testERC1155.address,
+      owner,
+      destination,
+      [1, 3],
+      [100, 1],
+      2
+    );
+  expect((await testERC1155.functions.balanceOf(gravity.address, 1))[0]).to.equal(100);
+  expect((await testERC1155.functions.balanceOf(gravity.address, 3))[0]).to.equal(1);
+
+  // the Cosmos module rejects repeated ids and zero amounts
+  await expect(gravity.functions.sendERC1155ToCosmos(testERC1155.address, destination, [1, 1], [1, 1]))
+    .to.be.revertedWith("InvalidSendToCosmos()");
+  await expect(gravity.functions.sendERC1155ToCosmos(testERC1155.address, destination, [2], [0]))
+    .to.be.revertedWith("InvalidSendToCosmos()");
+
+
+  // Send them back in a batch
+  // =========================
+  const destinations = await getSignerAddresses([signers[5], signers[5], signers[6]]);
+  const ids = [1, 3, 1];
+  const amounts = [60, 1, 40];
+  const batchNonce = 1;
+  const batchTimeout = ethers.provider.blockNumber + 1000;
+
+  const methodName = ethers.utils.formatBytes32String("erc1155Batch");
+  let abiEncoded = ethers.utils.defaultAbiCoder.encode(
+    [
+      "bytes32",
+      "bytes32",
+      "address[]",
+      "uint256[]",
+      "uint256[]",
+      "uint256",
+      "address",
+      "uint256",
+    ],
+    [
+      gravityId,
+      methodName,
+      destinations,
+      ids,
+      amounts,
+      batchNonce,
+      testERC1155.address,
+      batchTimeout,
+    ]
+  );
+  let digest = ethers.utils.keccak256(abiEncoded);
+  let sigs = await signHash(validators, digest);
+
+  let valset = {
+    validators: await getSignerAddresses(validators),
+    powers,
+    valsetNonce: 0,
+    rewardAmount: 0,
+    rewardToken: ZeroAddress
+  }
+
+  await expect(gravity.submitERC1155Batch(
+    valset,
+    sigs,
+    destinations,
+    ids,
+    amounts.slice(1),
+    batchNonce,
+    testERC1155.address,
+    batchTimeout
+  )).to.be.revertedWith("MalformedBatch()");
+
+  await expect(gravity.submitERC1155Batch(
+    valset,
+    sigs,
+    destinations,
+    ids,
+    amounts,
+    batchNonce,
+    testERC1155.address,
+    batchTimeout
+  )).to.emit(gravity, "ERC1155BatchExecutedEvent").withArgs(batchNonce, testERC1155.address, 3);
+
+  expect((await testERC1155.functions.balanceOf(destinations[0], 1))[0]).to.equal(60);
+  expect((await testERC1155.functions.balanceOf(destinations[0], 3))[0]).to.equal(1);
+  expect((await testERC1155.functions.balanceOf(destinations[2], 1))[0]).to.equal(40);
+  expect((await testERC1155.functions.balanceOf(gravity.address, 1))[0]).to.equal(0);
+  expect((await gravity.functions.lastBatchNonce(testERC1155.address))[0]).to.equal(batchNonce);
+
+  // the batch can't be replayed
+  await expect(gravity.submitERC1155Batch(
+    valset,
+    sigs,
+    destinations,
+    ids,
+    amounts,
+    batchNonce,
+    testERC1155.address,
+    batchTimeout
+  )).to.be.revertedWith("InvalidBatchNonce(1, 1)");
+}
+
+async function runRejectedTransferTest() {
+
+
+  // Prep and deploy contracts
+  // =========================
+  const signers = await ethers.getSigners();
+  const gravityId = ethers.utils.formatBytes32String("foo");
+  let powers = examplePowers();
+  let validators = signers.slice(0, powers.length);
+  const powerThreshold = 6666;
+  const { gravity } = await deployContracts(gravityId, validators, powers, powerThreshold);
+
+  const TestERC1155 = await ethers.getContractFactory("TestERC1155");
+  const testERC1155 = await TestERC1155.deploy();
+  await testERC1155.deployed();
+
+  const TestERC1155Rejecter = await ethers.getContractFactory("TestERC1155Rejecter");
+  const rejecter = await TestERC1155Rejecter.deploy();
+  await rejecter.deployed();
+
+  const destination = ethers.utils.formatBytes32String("myCosmosAddress");
+  await testERC1155.functions.setApprovalForAll(gravity.address, true);
+  await gravity.functions.sendERC1155ToCosmos(testERC1155.address, destination, [1], [100]);
+
+
+  // A batch with a recipient rejecting its ids
+  // ==========================================
+  const destinations = [rejecter.address, await signers[5].getAddress()];
+  const ids = [1, 1];
+  const amounts = [60, 40];
+  const batchNonce = 1;
+  const batchTimeout = ethers.provider.blockNumber + 1000;
+
+  const methodName = ethers.utils.formatBytes32String("erc1155Batch");
+  let abiEncoded = ethers.utils.defaultAbiCoder.encode(
+    [
+      "bytes32",
+      "bytes32",
+      "address[]",
+      "uint256[]",
+      "uint256[]",
+      "uint256",
+      "address",
+      "uint256",
+    ],
+    [
+      gravityId,
+      methodName,
+      destinations,
+      ids,
+      amounts,
+      batchNonce,
+      testERC1155.address,
+      batchTimeout,
+    ]
+  );
+  let digest = ethers.utils.keccak256(abiEncoded);
+  let sigs = await signHash(validators, digest);
+
+  let valset = {
+    validators: await getSignerAddresses(validators),
+    powers,
+    valsetNonce: 0,
+    rewardAmount: 0,
+    rewardToken: ZeroAddress
+  }
+
+  // the rest of the batch goes through, the rejected ids are credited to the recipient
+  await expect(gravity.submitERC1155Batch(
+    valset,
+    sigs,
+    destinations,
+    ids,
+    amounts,
+    batchNonce,
+    testERC1155.address,
+    batchTimeout
+  )).to.emit(gravity, "ERC1155TransferFailedEvent").withArgs(testERC1155.address, rejecter.address, 1, 60)
+    .and.to.emit(gravity, "ERC1155BatchExecutedEvent").withArgs(batchNonce, testERC1155.address, 3);
+
+  expect((await testERC1155.functions.balanceOf(destinations[1], 1))[0]).to.equal(40);
+  expect((await testERC1155.functions.balanceOf(rejecter.address, 1))[0]).to.equal(0);
+  expect((await testERC1155.functions.balanceOf(gravity.address, 1))[0]).to.equal(60);
+  expect((await gravity.functions.state_erc1155Credits(testERC1155.address, rejecter.address, 1))[0]).to.equal(60);
+
+
+  // Claim the credit elsewhere
+  // ==========================
+  const claimer = await signers[6].getAddress();
+  await expect(gravity.functions.claimERC1155(testERC1155.address, 1, claimer))
+    .to.be.revertedWith("NothingToClaim()");
+  await rejecter.functions.claim(gravity.address, testERC1155.address, 1, claimer);
+
+  expect((await testERC1155.functions.balanceOf(claimer, 1))[0]).to.equal(60);
+  expect((await testERC1155.functions.balanceOf(gravity.address, 1))[0]).to.equal(0);
+  expect((await gravity.functions.state_erc1155Credits(testERC1155.address, rejecter.address, 1))[0]).to.equal(0);
+  await expect(rejecter.functions.claim(gravity.address, testERC1155.address, 1, claimer))
+    .to.be.revertedWith("NothingToClaim()");
+}
+
+describe("erc1155 tests", function () {
+  it("works right", async function () {
+    await runTest({})
+  });
+
+  it("credits rejected transfers", async function () {
+    await runRejectedTransferTest()
+  });
+});
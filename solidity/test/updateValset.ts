@@ -7,6 +7,7 @@ import {
   getSignerAddresses,
   makeCheckpoint,
   signHash,
+  signTypedDataValset,
   examplePowers,
   ZeroAddress,
   parseEvent,
@@ -28,6 +29,8 @@ async function runTest(opts: {
   withReward?: boolean;
   notEnoughPowerNewSet?: boolean;
   zeroLengthValset?: boolean;
+  typedDataSigs?: boolean;
+  badTypedDataSig?: boolean;
 }) {
   const signers = await ethers.getSigners();
   const gravityId = ethers.utils.formatBytes32String("foo");
@@ -126,6 +129,18 @@ async function runTest(opts: {
   );
 
   let sigs = await signHash(validators, checkpoint);
+  if (opts.typedDataSigs) {
+    // the validators may sign either, half of them sign the typed data
+    const typedSigs = await signTypedDataValset(validators, newValset, gravityId);
+    for (let i = 0; i < sigs.length; i += 2) {
+      sigs[i] = typedSigs[i];
+    }
+  }
+  if (opts.badTypedDataSig) {
+    // a typed data signature of another valset
+    const otherValset = { ...newValset, valsetNonce: newValset.valsetNonce + 1 };
+    sigs[0] = (await signTypedDataValset(validators, otherValset, gravityId))[0];
+  }
   if (opts.badValidatorSig) {
     // Switch the first sig for the second sig to screw things up
     sigs[1].v = sigs[0].v;
@@ -222,6 +237,16 @@ describe("updateValset tests", function () {
     );
   });
 
+  it("allows typed data sigs", async function () {
+    await runTest({ typedDataSigs: true });
+  });
+
+  it("throws on typed data sig of another valset", async function () {
+    await expect(runTest({ badTypedDataSig: true })).to.be.revertedWith(
+      "InvalidSignature()"
+    );
+  });
+
   it("allows zeroed sig", async function () {
     await runTest({ zeroedValidatorSig: true });
   });